@@ -19,6 +19,7 @@ func cancelCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		cancelJobCmd(),
+		cancelJobArrayCmd(),
 		cancelJobSetCmd(),
 		cancelExecutorCmd(),
 		cancelQueueCmd(),
@@ -46,6 +47,26 @@ func cancelJobCmd() *cobra.Command {
 	return cmd
 }
 
+func cancelJobArrayCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job-array <queue> <job-set> <array-id>",
+		Short: "Cancels all jobs in a job array.",
+		Long:  `Cancels all jobs making up a job array by providing queue, job-set and the array id returned on submission.`,
+		Args:  cobra.ExactArgs(3),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue := args[0]
+			jobSetId := args[1]
+			arrayId := args[2]
+			return a.CancelJobArray(queue, jobSetId, arrayId)
+		},
+	}
+	return cmd
+}
+
 func cancelJobSetCmd() *cobra.Command {
 	a := armadactl.New()
 	cmd := &cobra.Command{
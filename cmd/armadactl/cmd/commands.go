@@ -54,11 +54,13 @@ func getCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get",
 		Short: "Retrieve information about armada resource",
-		Long:  "Retrieve information about armada resource. Supported: queue, queues, scheduling-report, queue-report, job-report",
+		Long:  "Retrieve information about armada resource. Supported: queue, queues, jobs, job-errors, scheduling-report, queue-report, job-report",
 	}
 	cmd.AddCommand(
 		queueGetCmd(),
 		queuesGetCmd(),
+		jobsGetCmd(),
+		jobErrorsGetCmd(),
 		getSchedulingReportCmd(armadactl.New()),
 		getQueueSchedulingReportCmd(armadactl.New()),
 		getJobSchedulingReportCmd(armadactl.New()),
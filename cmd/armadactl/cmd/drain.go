@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func drainCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Stop scheduling onto and empty resources",
+		Long:  "Stop scheduling onto and empty resources. Supported: node",
+	}
+	cmd.AddCommand(drainNodeCmd(a))
+	return cmd
+}
+
+func undrainCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "undrain",
+		Short: "Resume scheduling onto drained resources",
+		Long:  "Resume scheduling onto drained resources. Supported: node",
+	}
+	cmd.AddCommand(undrainNodeCmd(a))
+	return cmd
+}
+
+func drainNodeCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "node <executor> [<node_name>]",
+		Aliases: []string{"nodes"},
+		Short:   "Drain nodes of an executor",
+		Long: `Drain either a single node of an executor, or all of its nodes matching a label selector.
+
+No new jobs are scheduled onto draining nodes. With --preempt, preemptible jobs running on them are preempted;
+all other jobs are left to finish. With --wait, progress is reported until no jobs remain on the drained nodes
+and the pods of any preempted jobs have exited, or until --timeout has elapsed.`,
+		Args: cobra.RangeArgs(1, 2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executor, nodeName, nodeSelector, err := parseDrainTarget(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason: %s", err)
+			} else if reason == "" {
+				return fmt.Errorf("a reason must be provided when draining nodes")
+			}
+
+			preempt, err := cmd.Flags().GetBool("preempt")
+			if err != nil {
+				return fmt.Errorf("error reading preempt flag: %s", err)
+			}
+
+			wait, err := cmd.Flags().GetBool("wait")
+			if err != nil {
+				return fmt.Errorf("error reading wait flag: %s", err)
+			}
+
+			pollInterval, err := cmd.Flags().GetDuration("poll-interval")
+			if err != nil {
+				return fmt.Errorf("error reading poll-interval: %s", err)
+			}
+
+			timeout, err := cmd.Flags().GetDuration("timeout")
+			if err != nil {
+				return fmt.Errorf("error reading timeout: %s", err)
+			}
+
+			if err := a.DrainNodes(executor, nodeName, nodeSelector, preempt, reason); err != nil {
+				return err
+			}
+			if wait {
+				return a.WaitForNodeDrain(executor, nodeName, pollInterval, timeout)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("selector", "l", "", "Drain all nodes of the executor matching this comma separated list of labels, e.g. rack=a,zone=b. Mutually exclusive with providing a node name.")
+	cmd.Flags().String("reason", "", "Reason for draining the nodes. Required.")
+	cmd.Flags().Bool("preempt", false, "Preempt preemptible jobs running on the drained nodes rather than waiting for them to finish")
+	cmd.Flags().Bool("wait", false, "Report progress until no jobs remain on the drained nodes")
+	cmd.Flags().Duration("poll-interval", 10*time.Second, "How often to check progress when --wait is set")
+	cmd.Flags().Duration("timeout", time.Hour, "How long to wait for the nodes to drain when --wait is set; 0 waits indefinitely")
+	return cmd
+}
+
+func undrainNodeCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "node <executor> [<node_name>]",
+		Aliases: []string{"nodes"},
+		Short:   "Stop draining nodes of an executor",
+		Long:    "Stop draining nodes of an executor. The node name or selector must match the one used to drain the nodes.",
+		Args:    cobra.RangeArgs(1, 2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executor, nodeName, nodeSelector, err := parseDrainTarget(cmd, args)
+			if err != nil {
+				return err
+			}
+			return a.UndrainNodes(executor, nodeName, nodeSelector)
+		},
+	}
+	cmd.Flags().StringP("selector", "l", "", "Stop draining the nodes drained with this comma separated list of labels. Mutually exclusive with providing a node name.")
+	return cmd
+}
+
+// parseDrainTarget returns the executor, and exactly one of a node name or a node selector, from the arguments of a (un)drain command.
+func parseDrainTarget(cmd *cobra.Command, args []string) (string, string, map[string]string, error) {
+	executor := args[0]
+	if executor == "" {
+		return "", "", nil, fmt.Errorf("provided executor name is invalid: %s", executor)
+	}
+	nodeName := ""
+	if len(args) > 1 {
+		nodeName = args[1]
+	}
+
+	selector, err := cmd.Flags().GetString("selector")
+	if err != nil {
+		return "", "", nil, fmt.Errorf("error reading selector: %s", err)
+	}
+	var nodeSelector map[string]string
+	if selector != "" {
+		nodeSelector, err = labels.ConvertSelectorToLabelsMap(selector)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("provided selector is invalid: %s", err)
+		}
+	}
+
+	if nodeName == "" && len(nodeSelector) == 0 {
+		return "", "", nil, fmt.Errorf("either a node name or a selector must be provided")
+	} else if nodeName != "" && len(nodeSelector) > 0 {
+		return "", "", nil, fmt.Errorf("you can provide either a node name or a selector, but not both")
+	}
+	return executor, nodeName, nodeSelector, nil
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func TestDrainNode(t *testing.T) {
+	tests := map[string]struct {
+		args                 []string
+		expectError          bool
+		expectedNodeName     string
+		expectedNodeSelector map[string]string
+		expectedPreempt      bool
+	}{
+		"by node name": {
+			args:             []string{"executor-1", "node-1", "--reason", "maintenance"},
+			expectedNodeName: "node-1",
+		},
+		"by selector with preemption": {
+			args:                 []string{"executor-1", "--selector", "rack=a,zone=b", "--reason", "maintenance", "--preempt"},
+			expectedNodeSelector: map[string]string{"rack": "a", "zone": "b"},
+			expectedPreempt:      true,
+		},
+		"missing reason": {
+			args:        []string{"executor-1", "node-1"},
+			expectError: true,
+		},
+		"missing node name and selector": {
+			args:        []string{"executor-1", "--reason", "maintenance"},
+			expectError: true,
+		},
+		"both node name and selector": {
+			args:        []string{"executor-1", "node-1", "--selector", "rack=a", "--reason", "maintenance"},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := armadactl.New()
+			a.Out = io.Discard
+			called := false
+			a.Params.ExecutorAPI.DrainNodes = func(executor string, nodeName string, nodeSelector map[string]string, preempt bool, reason string) error {
+				called = true
+				assert.Equal(t, "executor-1", executor)
+				assert.Equal(t, tc.expectedNodeName, nodeName)
+				assert.Equal(t, tc.expectedNodeSelector, nodeSelector)
+				assert.Equal(t, tc.expectedPreempt, preempt)
+				assert.Equal(t, "maintenance", reason)
+				return nil
+			}
+
+			cmd := drainNodeCmd(a)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+			if tc.expectError {
+				require.Error(t, err)
+				assert.False(t, called)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, called)
+			}
+		})
+	}
+}
+
+func TestUndrainNode(t *testing.T) {
+	a := armadactl.New()
+	a.Out = io.Discard
+	called := false
+	a.Params.ExecutorAPI.UndrainNodes = func(executor string, nodeName string, nodeSelector map[string]string) error {
+		called = true
+		assert.Equal(t, "executor-1", executor)
+		assert.Equal(t, "node-1", nodeName)
+		assert.Nil(t, nodeSelector)
+		return nil
+	}
+
+	cmd := undrainNodeCmd(a)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	cmd.SetArgs([]string{"executor-1", "node-1"})
+	require.NoError(t, cmd.Execute())
+	assert.True(t, called)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func describeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show details of an Armada resource",
+		Long:  "Show details of an Armada resource. Supported: job",
+	}
+	cmd.AddCommand(jobDescribeCmd())
+	return cmd
+}
+
+func jobsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "jobs <job-id> [<job-id>...]",
+		Short: "Gets the state of jobs.",
+		Long:  "Gets the queue, job set, state and latest run of each of the given jobs.",
+		Args:  cobra.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := getOutput(cmd, armadactl.JobOutputs)
+			if err != nil {
+				return err
+			}
+			return a.GetJobs(args, output)
+		},
+	}
+	addOutputFlag(cmd, armadactl.JobOutputs)
+	return cmd
+}
+
+func jobDescribeCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job <job-id>",
+		Short: "Shows the details of a job.",
+		Long:  "Shows the spec and state of a job, every run of the job including the node and pool it ran on and its exit code, and the error the job failed with, if any.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := getOutput(cmd, armadactl.JobOutputs)
+			if err != nil {
+				return err
+			}
+			return a.DescribeJob(strings.TrimSpace(args[0]), output)
+		},
+	}
+	addOutputFlag(cmd, armadactl.JobOutputs)
+	return cmd
+}
+
+func jobErrorsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job-errors <job-id> [<job-id>...]",
+		Short: "Gets the errors jobs failed with.",
+		Args:  cobra.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := getOutput(cmd, armadactl.JobOutputs)
+			if err != nil {
+				return err
+			}
+			return a.GetJobErrors(args, output)
+		},
+	}
+	addOutputFlag(cmd, armadactl.JobOutputs)
+	return cmd
+}
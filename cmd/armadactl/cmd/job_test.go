@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func TestGetOutput(t *testing.T) {
+	tests := map[string]struct {
+		args          []string
+		expected      string
+		expectedError bool
+	}{
+		"default":            {args: nil, expected: armadactl.OutputTable},
+		"json":               {args: []string{"-o", "json"}, expected: armadactl.OutputJson},
+		"yaml":               {args: []string{"--output", "yaml"}, expected: armadactl.OutputYaml},
+		"case insensitive":   {args: []string{"--output", "YAML"}, expected: armadactl.OutputYaml},
+		"unsupported format": {args: []string{"--output", "text"}, expectedError: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := jobDescribeCmd()
+			require.NoError(t, cmd.ParseFlags(tc.args))
+			output, err := getOutput(cmd, armadactl.JobOutputs)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
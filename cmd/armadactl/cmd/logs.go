@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func logsCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "logs <job-id>",
+		Short: "Print the logs of a job.",
+		Long: `Prints the logs of the latest run of a job, as served by the Binoculars instance of the cluster it ran on.
+Requires binocularsUrlPattern to be set in the armadactl config.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId := args[0]
+
+			follow, err := cmd.Flags().GetBool("follow")
+			if err != nil {
+				return fmt.Errorf("error reading follow: %s", err)
+			}
+
+			container, err := cmd.Flags().GetString("container")
+			if err != nil {
+				return fmt.Errorf("error reading container: %s", err)
+			}
+
+			timestamps, err := cmd.Flags().GetBool("timestamps")
+			if err != nil {
+				return fmt.Errorf("error reading timestamps: %s", err)
+			}
+
+			return a.Logs(jobId, container, follow, timestamps)
+		},
+	}
+	cmd.Flags().BoolP("follow", "f", false, "Stream new log lines as they are written")
+	cmd.Flags().String("container", "", "Container to print logs for. Required if the job's pod has more than one container")
+	cmd.Flags().Bool("timestamps", false, "Include timestamps on each line")
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogs(t *testing.T) {
+	tests := map[string]struct {
+		flags      []flag
+		follow     bool
+		container  string
+		timestamps bool
+	}{
+		"default flags":    {nil, false, "", false},
+		"valid follow":     {[]flag{{"follow", "true"}}, true, "", false},
+		"valid container":  {[]flag{{"container", "sidecar"}}, false, "sidecar", false},
+		"valid timestamps": {[]flag{{"timestamps", "true"}}, false, "", true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := logsCmd()
+			cmd.SetOut(io.Discard)
+			for _, flag := range test.flags {
+				require.NoError(t, cmd.Flags().Set(flag.name, flag.value))
+			}
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+				follow, err := cmd.Flags().GetBool("follow")
+				require.NoError(t, err)
+				require.Equal(t, test.follow, follow)
+
+				container, err := cmd.Flags().GetString("container")
+				require.NoError(t, err)
+				require.Equal(t, test.container, container)
+
+				timestamps, err := cmd.Flags().GetBool("timestamps")
+				require.NoError(t, err)
+				require.Equal(t, test.timestamps, timestamps)
+				return nil
+			}
+			cmd.RunE = func(cmd *cobra.Command, args []string) error {
+				return nil
+			}
+			cmd.SetArgs([]string{"01f3j0g1md4qx7z5qb148qnh4r"})
+			require.NoError(t, cmd.Execute())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+// addOutputFlag adds the --output flag to cmd, accepting any of outputs and defaulting to the first of them.
+func addOutputFlag(cmd *cobra.Command, outputs []string) {
+	cmd.Flags().StringP("output", "o", outputs[0], "output format; one of "+armadactl.DescribeOutputs(outputs))
+}
+
+// getOutput returns the value of the --output flag of cmd, or an error if it isn't one of outputs.
+func getOutput(cmd *cobra.Command, outputs []string) (string, error) {
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+	output = strings.ToLower(strings.TrimSpace(output))
+	if err := armadactl.ValidateOutput(output, outputs); err != nil {
+		return "", err
+	}
+	return output, nil
+}
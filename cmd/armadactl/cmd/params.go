@@ -6,7 +6,9 @@ import (
 	"github.com/armadaproject/armada/internal/armadactl"
 	"github.com/armadaproject/armada/pkg/client"
 	ce "github.com/armadaproject/armada/pkg/client/executor"
+	cj "github.com/armadaproject/armada/pkg/client/job"
 	cq "github.com/armadaproject/armada/pkg/client/queue"
+	cu "github.com/armadaproject/armada/pkg/client/usage"
 )
 
 // initParams initialises the command parameters, flags, and a configuration file.
@@ -32,11 +34,18 @@ func initParams(cmd *cobra.Command, params *armadactl.Params) error {
 	params.QueueAPI.Preempt = cq.Preempt(client.ExtractCommandlineArmadaApiConnectionDetails)
 	params.QueueAPI.Cancel = cq.Cancel(client.ExtractCommandlineArmadaApiConnectionDetails)
 
+	params.JobAPI.GetDetails = cj.GetDetails(client.ExtractCommandlineArmadaApiConnectionDetails)
+	params.JobAPI.GetErrors = cj.GetErrors(client.ExtractCommandlineArmadaApiConnectionDetails)
+
+	params.UsageAPI.Get = cu.Get(client.ExtractCommandlineArmadaApiConnectionDetails)
+
 	params.ExecutorAPI.Cordon = ce.CordonExecutor(client.ExtractCommandlineArmadaApiConnectionDetails)
 	params.ExecutorAPI.Uncordon = ce.UncordonExecutor(client.ExtractCommandlineArmadaApiConnectionDetails)
 
 	params.ExecutorAPI.CancelOnExecutor = ce.CancelOnExecutor(client.ExtractCommandlineArmadaApiConnectionDetails)
 	params.ExecutorAPI.PreemptOnExecutor = ce.PreemptOnExecutor(client.ExtractCommandlineArmadaApiConnectionDetails)
+	params.ExecutorAPI.DrainNodes = ce.DrainNodes(client.ExtractCommandlineArmadaApiConnectionDetails)
+	params.ExecutorAPI.UndrainNodes = ce.UndrainNodes(client.ExtractCommandlineArmadaApiConnectionDetails)
 
 	return nil
 }
@@ -63,6 +63,26 @@ Job priority is evaluated inside queue, queue has its own priority.  Any labels
 				return fmt.Errorf("error converting queue labels to map: %s", err)
 			}
 
+			parent, err := cmd.Flags().GetString("parent")
+			if err != nil {
+				return fmt.Errorf("error reading parent: %s", err)
+			}
+
+			quotas, err := cmd.Flags().GetStringArray("quota")
+			if err != nil {
+				return fmt.Errorf("error reading quota: %s", err)
+			}
+
+			borrowingEnabled, err := cmd.Flags().GetBool("quota-borrowing")
+			if err != nil {
+				return fmt.Errorf("error reading quota-borrowing: %s", err)
+			}
+
+			quotaByPool, err := utils.QuotaSliceAsMap(quotas, borrowingEnabled)
+			if err != nil {
+				return fmt.Errorf("error converting queue quotas to map: %s", err)
+			}
+
 			newQueue, err := queue.NewQueue(&api.Queue{
 				Name:           name,
 				PriorityFactor: priorityFactor,
@@ -70,6 +90,8 @@ Job priority is evaluated inside queue, queue has its own priority.  Any labels
 				GroupOwners:    groups,
 				Cordoned:       cordoned,
 				Labels:         labelsAsMap,
+				Parent:         parent,
+				QuotaByPool:    quotaByPool,
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -83,6 +105,9 @@ Job priority is evaluated inside queue, queue has its own priority.  Any labels
 	cmd.Flags().StringSlice("group-owners", []string{}, "Comma separated list of queue group owners, defaults to empty list.")
 	cmd.Flags().Bool("cordon", false, "Used to pause scheduling on specified queue. Defaults to false.")
 	cmd.Flags().StringSliceP("labels", "l", []string{}, "Comma separated list of key-value queue labels, for example: armadaproject.io/submitter=airflow. Defaults to empty list.")
+	cmd.Flags().String("parent", "", "Name of the queue to nest this queue under; its fair share is then divided among the queues nested under it. Defaults to none.")
+	cmd.Flags().StringArray("quota", []string{}, "Absolute resources guaranteed to the queue in a pool, for example: gpu-pool:cpu=2000,nvidia.com/gpu=64. May be repeated for different pools. Defaults to no quota.")
+	cmd.Flags().Bool("quota-borrowing", false, "Allow the queue to use idle resources beyond its quotas; borrowed resources may be preempted. Defaults to false.")
 	return cmd
 }
 
@@ -124,9 +149,19 @@ func queueGetCmdWithApp(a *armadactl.App) *cobra.Command {
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
+
+			tree, err := cmd.Flags().GetBool("tree")
+			if err != nil {
+				return fmt.Errorf("error reading tree flag: %s", err)
+			}
+			if tree {
+				return a.GetQueueSubtree(name)
+			}
+
 			return a.GetQueue(name)
 		},
 	}
+	cmd.Flags().Bool("tree", false, "Show the queue and the queues nested under it as a tree. Defaults to false.")
 	return cmd
 }
 
@@ -168,17 +203,27 @@ func queuesGetCmdWithApp(a *armadactl.App) *cobra.Command {
 				return fmt.Errorf("you can select either with a set of queue names or a set of queue labels, but not both")
 			}
 
-			return a.GetAllQueues(&armadactl.QueueQueryArgs{
+			tree, err := cmd.Flags().GetBool("tree")
+			if err != nil {
+				return fmt.Errorf("error reading tree flag: %s", err)
+			}
+
+			args := &armadactl.QueueQueryArgs{
 				InQueueNames:      queues,
 				ContainsAllLabels: labels,
 				InvertResult:      inverse,
 				OnlyCordoned:      onlyCordoned,
-			})
+			}
+			if tree {
+				return a.GetQueueTree(args)
+			}
+			return a.GetAllQueues(args)
 		},
 	}
 	cmd.Flags().StringSliceP("match-labels", "l", []string{}, "Select queues by label.")
 	cmd.Flags().Bool("inverse", false, "Inverts result to get all queues that don't match the specified criteria. Defaults to false.")
 	cmd.Flags().Bool("only-cordoned", false, "Only returns queues that are cordoned. Defaults to false.")
+	cmd.Flags().Bool("tree", false, "Show queues as a tree, in which each queue is nested under its parent. Defaults to false.")
 
 	return cmd
 }
@@ -230,6 +275,26 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 				return fmt.Errorf("error converting queue labels to map: %s", err)
 			}
 
+			parent, err := cmd.Flags().GetString("parent")
+			if err != nil {
+				return fmt.Errorf("error reading parent: %s", err)
+			}
+
+			quotas, err := cmd.Flags().GetStringArray("quota")
+			if err != nil {
+				return fmt.Errorf("error reading quota: %s", err)
+			}
+
+			borrowingEnabled, err := cmd.Flags().GetBool("quota-borrowing")
+			if err != nil {
+				return fmt.Errorf("error reading quota-borrowing: %s", err)
+			}
+
+			quotaByPool, err := utils.QuotaSliceAsMap(quotas, borrowingEnabled)
+			if err != nil {
+				return fmt.Errorf("error converting queue quotas to map: %s", err)
+			}
+
 			newQueue, err := queue.NewQueue(&api.Queue{
 				Name:           name,
 				PriorityFactor: priorityFactor,
@@ -237,6 +302,8 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 				GroupOwners:    groups,
 				Cordoned:       cordoned,
 				Labels:         labelsAsMap,
+				Parent:         parent,
+				QuotaByPool:    quotaByPool,
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -251,5 +318,8 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 	cmd.Flags().StringSlice("group-owners", []string{}, "Comma separated list of queue group owners, defaults to empty list.")
 	cmd.Flags().Bool("cordon", false, "Used to pause scheduling on specified queue. Defaults to false.")
 	cmd.Flags().StringSliceP("labels", "l", []string{}, "Comma separated list of key-value queue labels, for example: armadaproject.io/submitter=airflow. Defaults to empty list.")
+	cmd.Flags().String("parent", "", "Name of the queue to nest this queue under; its fair share is then divided among the queues nested under it. Defaults to none.")
+	cmd.Flags().StringArray("quota", []string{}, "Absolute resources guaranteed to the queue in a pool, for example: gpu-pool:cpu=2000,nvidia.com/gpu=64. May be repeated for different pools. Defaults to no quota.")
+	cmd.Flags().Bool("quota-borrowing", false, "Allow the queue to use idle resources beyond its quotas; borrowed resources may be preempted. Defaults to false.")
 	return cmd
 }
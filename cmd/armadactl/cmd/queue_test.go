@@ -18,6 +18,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/armadactl"
 	"github.com/armadaproject/armada/pkg/client/queue"
@@ -91,6 +92,49 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateWithQuota(t *testing.T) {
+	a := armadactl.New()
+	cmd := queueCreateCmdWithApp(a)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		a.Params.QueueAPI.Create = func(q queue.Queue) error {
+			a.Out = io.Discard
+			require.Len(t, q.QuotaByPool, 2)
+			require.True(t, q.QuotaByPool["cpu-pool"].BorrowingEnabled)
+			require.Equal(t, resource.MustParse("2000"), q.QuotaByPool["cpu-pool"].Guaranteed["cpu"])
+			require.Equal(t, resource.MustParse("64"), q.QuotaByPool["gpu-pool"].Guaranteed["nvidia.com/gpu"])
+			require.Equal(t, resource.MustParse("1Ti"), q.QuotaByPool["gpu-pool"].Guaranteed["memory"])
+			return nil
+		}
+		return nil
+	}
+	cmd.SetArgs([]string{"arbitrary"})
+	require.NoError(t, cmd.Flags().Set("quota", "cpu-pool:cpu=2000"))
+	require.NoError(t, cmd.Flags().Set("quota", "gpu-pool:nvidia.com/gpu=64,memory=1Ti"))
+	require.NoError(t, cmd.Flags().Set("quota-borrowing", "true"))
+	require.NoError(t, cmd.Execute())
+}
+
+func TestCreateWithInvalidQuota(t *testing.T) {
+	for _, quota := range []string{"cpu=2000", "pool:cpu", "pool:cpu=lots"} {
+		t.Run(quota, func(t *testing.T) {
+			a := armadactl.New()
+			cmd := queueCreateCmdWithApp(a)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+				a.Params.QueueAPI.Create = func(q queue.Queue) error {
+					t.Fatal("queue must not be created")
+					return nil
+				}
+				return nil
+			}
+			cmd.SetArgs([]string{"arbitrary"})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			require.NoError(t, cmd.Flags().Set("quota", quota))
+			require.Error(t, cmd.Execute())
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	// Create app object, cobra command, and hijack the app setup process to insert a
 	// function that does validation
@@ -13,10 +13,11 @@ func reprioritizeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reprioritize",
 		Short: "Reprioritize jobs in Armada",
-		Long:  `Change the priority of a single job or entire job-set. Supported: job, job-set`,
+		Long:  `Change the priority of a single job, job array or entire job-set. Supported: job, job-array, job-set`,
 	}
 	cmd.AddCommand(
 		reprioritizeJobCmd(),
+		reprioritizeJobArrayCmd(),
 		reprioritizeJobSetCmd(),
 	)
 
@@ -49,6 +50,31 @@ func reprioritizeJobCmd() *cobra.Command {
 	return cmd
 }
 
+func reprioritizeJobArrayCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job-array <queue> <job-set> <array-id> <priority>",
+		Short: `Change the priority of all jobs in a job array.`,
+		Args:  cobra.ExactArgs(4),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue := args[0]
+			jobSet := args[1]
+			arrayId := args[2]
+			priorityString := args[3]
+			priorityFactor, err := strconv.ParseFloat(priorityString, 64)
+			if err != nil {
+				return fmt.Errorf("error converting %s to float64: %s", priorityString, err)
+			}
+
+			return a.ReprioritizeJobArray(queue, jobSet, arrayId, priorityFactor)
+		},
+	}
+	return cmd
+}
+
 func reprioritizeJobSetCmd() *cobra.Command {
 	a := armadactl.New()
 	cmd := &cobra.Command{
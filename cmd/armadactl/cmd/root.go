@@ -23,15 +23,20 @@ func RootCmd() *cobra.Command {
 		deleteCmd(),
 		updateCmd(),
 		getCmd(),
+		describeCmd(),
 		reprioritizeCmd(),
 		submitCmd(),
 		versionCmd(),
 		watchCmd(),
+		logsCmd(),
 		configCmd(armadactl.New()),
 		preemptCmd(),
 		docsCmd(),
 		cordon(),
 		uncordon(),
+		drainCmd(),
+		undrainCmd(),
+		usageCmd(armadactl.New()),
 	)
 
 	return cmd
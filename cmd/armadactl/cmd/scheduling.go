@@ -24,12 +24,20 @@ func getSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 				return err
 			}
 
+			output, err := getOutput(cmd, armadactl.ReportOutputs)
+			if err != nil {
+				return err
+			}
+
 			queueName, err := cmd.Flags().GetString("queue")
 			if err != nil {
 				return err
 			}
 			queueName = strings.TrimSpace(queueName)
 			if queueName != "" {
+				if output != armadactl.OutputText {
+					return fmt.Errorf("--output %s is not supported with --queue; use `queue-report` instead", output)
+				}
 				fmt.Print("This flag is deprecated and will be removed in a future release. Please use `queue-report` instead.\n")
 				return a.GetSchedulingReportForQueue(queueName, int32(verbosity))
 			}
@@ -40,11 +48,14 @@ func getSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 			}
 			jobId = strings.TrimSpace(jobId)
 			if jobId != "" {
+				if output != armadactl.OutputText {
+					return fmt.Errorf("--output %s is not supported with --job-id; use `job-report` instead", output)
+				}
 				fmt.Print("This flag is deprecated and will be removed in a future release. Please use `job-report` instead.\n")
 				return a.GetSchedulingReportForJob(jobId, int32(verbosity))
 			}
 
-			return a.GetSchedulingReport(int32(verbosity))
+			return a.GetSchedulingReport(int32(verbosity), output)
 		},
 	}
 
@@ -53,6 +64,7 @@ func getSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 	cmd.Flags().String("queue", "", "get scheduler reports relevant for this queue; mutually exclusive with --job-id")
 	cmd.Flags().String("job-id", "", "get scheduler reports relevant for this job; mutually exclusive with --queue")
 	cmd.MarkFlagsMutuallyExclusive("queue", "job-id")
+	addOutputFlag(cmd, armadactl.ReportOutputs)
 
 	return cmd
 }
@@ -71,14 +83,20 @@ func getQueueSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 				return err
 			}
 
+			output, err := getOutput(cmd, armadactl.ReportOutputs)
+			if err != nil {
+				return err
+			}
+
 			queueName := args[0]
 			queueName = strings.TrimSpace(queueName)
 
-			return a.GetQueueSchedulingReport(queueName, int32(verbosity))
+			return a.GetQueueSchedulingReport(queueName, int32(verbosity), output)
 		},
 	}
 
 	cmd.Flags().CountP("verbose", "v", "report verbosity; repeat (e.g., -vvv) to increase verbosity")
+	addOutputFlag(cmd, armadactl.ReportOutputs)
 
 	return cmd
 }
@@ -92,10 +110,16 @@ func getJobSchedulingReportCmd(a *armadactl.App) *cobra.Command {
 			return initParams(cmd, a.Params)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := getOutput(cmd, armadactl.ReportOutputs)
+			if err != nil {
+				return err
+			}
+
 			jobId := args[0]
 			jobId = strings.TrimSpace(jobId)
-			return a.GetJobSchedulingReport(jobId)
+			return a.GetJobSchedulingReport(jobId, output)
 		},
 	}
+	addOutputFlag(cmd, armadactl.ReportOutputs)
 	return cmd
 }
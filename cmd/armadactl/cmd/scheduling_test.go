@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func TestGetOutput_Reports(t *testing.T) {
+	tests := map[string]struct {
+		args          []string
+		expected      string
+		expectedError bool
+	}{
+		"default":            {args: nil, expected: armadactl.OutputText},
+		"text":               {args: []string{"--output", "text"}, expected: armadactl.OutputText},
+		"json":               {args: []string{"-o", "json"}, expected: armadactl.OutputJson},
+		"case insensitive":   {args: []string{"--output", "JSON"}, expected: armadactl.OutputJson},
+		"unsupported format": {args: []string{"--output", "yaml"}, expectedError: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd := getJobSchedulingReportCmd(armadactl.New())
+			require.NoError(t, cmd.ParseFlags(tc.args))
+			output, err := getOutput(cmd, armadactl.ReportOutputs)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+const usageDateFormat = "2006-01-02"
+
+func usageCmd(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report resources allocated to queues",
+		Long: `Report the resources allocated to queues per pool and day, in resource-hours; e.g., cpu in core-hours and memory
+in byte-hours. Days are in UTC. Usage of all queues you may watch is reported unless --queue is given.
+
+Use -o csv to export usage as CSV, optionally to a file with --file.`,
+		Example: `armadactl usage --from 2024-03-01 --to 2024-03-31 --queue team-a --queue team-b
+armadactl usage --from 2024-03-01 -o csv --file usage.csv`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := cmd.Flags().GetString("from")
+			if err != nil {
+				return fmt.Errorf("error reading from: %s", err)
+			}
+			to, err := cmd.Flags().GetString("to")
+			if err != nil {
+				return fmt.Errorf("error reading to: %s", err)
+			}
+			from, to, err = usageDateRange(from, to, time.Now())
+			if err != nil {
+				return err
+			}
+
+			queues, err := cmd.Flags().GetStringSlice("queue")
+			if err != nil {
+				return fmt.Errorf("error reading queue: %s", err)
+			}
+			pools, err := cmd.Flags().GetStringSlice("pool")
+			if err != nil {
+				return fmt.Errorf("error reading pool: %s", err)
+			}
+
+			output, err := getOutput(cmd, armadactl.UsageOutputs)
+			if err != nil {
+				return err
+			}
+
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("error reading file: %s", err)
+			}
+			if file != "" {
+				f, err := os.Create(file)
+				if err != nil {
+					return fmt.Errorf("error creating %s: %s", file, err)
+				}
+				defer f.Close()
+				a.Out = f
+			}
+
+			return a.GetUsage(from, to, queues, pools, output)
+		},
+	}
+	cmd.Flags().String("from", "", "First day, formatted as YYYY-MM-DD, to report usage of. Defaults to the first day of the month of --to.")
+	cmd.Flags().String("to", "", "Last day, formatted as YYYY-MM-DD, to report usage of, inclusive. Defaults to today.")
+	cmd.Flags().StringSlice("queue", nil, "Only report usage of these queues")
+	cmd.Flags().StringSlice("pool", nil, "Only report usage in these pools")
+	addOutputFlag(cmd, armadactl.UsageOutputs)
+	cmd.Flags().String("file", "", "Write the report to this file rather than standard out")
+	return cmd
+}
+
+// usageDateRange returns the first and last day to report usage of, defaulting to the month to date.
+func usageDateRange(from string, to string, now time.Time) (string, string, error) {
+	end := now.UTC()
+	if to != "" {
+		var err error
+		if end, err = time.Parse(usageDateFormat, to); err != nil {
+			return "", "", fmt.Errorf("provided to date %q is invalid; must be formatted as YYYY-MM-DD", to)
+		}
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != "" {
+		var err error
+		if start, err = time.Parse(usageDateFormat, from); err != nil {
+			return "", "", fmt.Errorf("provided from date %q is invalid; must be formatted as YYYY-MM-DD", from)
+		}
+	}
+	if start.After(end) {
+		return "", "", fmt.Errorf("from date %s is after to date %s", start.Format(usageDateFormat), end.Format(usageDateFormat))
+	}
+	return start.Format(usageDateFormat), end.Format(usageDateFormat), nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestUsage(t *testing.T) {
+	tests := map[string]struct {
+		args            []string
+		expectError     bool
+		expectedRequest *api.UsageRequest
+	}{
+		"date range, queues and pools": {
+			args: []string{"--from", "2024-03-01", "--to", "2024-03-31", "--queue", "queue-a,queue-b", "--pool", "cpu"},
+			expectedRequest: &api.UsageRequest{
+				StartDate: "2024-03-01",
+				EndDate:   "2024-03-31",
+				Queues:    []string{"queue-a", "queue-b"},
+				Pools:     []string{"cpu"},
+			},
+		},
+		"repeated queue flag": {
+			args:            []string{"--to", "2024-03-15", "--queue", "queue-a", "--queue", "queue-b", "-o", "CSV"},
+			expectedRequest: &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-15", Queues: []string{"queue-a", "queue-b"}, Pools: []string{}},
+		},
+		"invalid date": {
+			args:        []string{"--from", "01/03/2024"},
+			expectError: true,
+		},
+		"from after to": {
+			args:        []string{"--from", "2024-03-02", "--to", "2024-03-01"},
+			expectError: true,
+		},
+		"unsupported output": {
+			args:        []string{"--to", "2024-03-15", "-o", "text"},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := armadactl.New()
+			a.Out = io.Discard
+			var request *api.UsageRequest
+			a.Params.UsageAPI.Get = func(r *api.UsageRequest) (*api.UsageResponse, error) {
+				request = r
+				return &api.UsageResponse{}, nil
+			}
+
+			cmd := usageCmd(a)
+			cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Nil(t, request)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedRequest, request)
+			}
+		})
+	}
+}
+
+func TestUsageToFile(t *testing.T) {
+	a := armadactl.New()
+	a.Params.UsageAPI.Get = func(r *api.UsageRequest) (*api.UsageResponse, error) {
+		return &api.UsageResponse{
+			Usage: []*api.QueueUsage{{Queue: "queue-a", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"cpu": 24}}},
+		}, nil
+	}
+	file := filepath.Join(t.TempDir(), "usage.csv")
+
+	cmd := usageCmd(a)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error { return nil }
+	cmd.SetArgs([]string{"--from", "2024-03-01", "--to", "2024-03-01", "-o", "csv", "--file", file})
+	require.NoError(t, cmd.Execute())
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "date,queue,pool,resource,resource_hours\n2024-03-01,queue-a,cpu,cpu,24\n", string(b))
+}
+
+func TestUsageDateRange(t *testing.T) {
+	now := time.Date(2024, 3, 15, 23, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+	from, to, err := usageDateRange("", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", from)
+	assert.Equal(t, "2024-03-16", to)
+}
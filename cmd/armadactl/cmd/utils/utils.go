@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -27,6 +29,35 @@ func LabelSliceAsMap(labels []string) (map[string]string, error) {
 	return mapToReturn, nil
 }
 
+// QuotaSliceAsMap parses quotas of the form <pool>:<resource>=<quantity>,<resource>=<quantity>,...
+// and returns a map from pool name to quota.
+func QuotaSliceAsMap(quotas []string, borrowingEnabled bool) (map[string]*api.QueueQuota, error) {
+	mapToReturn := make(map[string]*api.QueueQuota, len(quotas))
+	for _, quota := range quotas {
+		pool, resources, ok := strings.Cut(quota, ":")
+		if !ok || pool == "" || resources == "" {
+			return nil, fmt.Errorf("invalid quota: %s", quota)
+		}
+		if _, ok := mapToReturn[pool]; ok {
+			return nil, fmt.Errorf("duplicate quota for pool %s", pool)
+		}
+		guaranteed := make(map[string]*resource.Quantity)
+		for _, r := range strings.Split(resources, ",") {
+			name, value, ok := strings.Cut(r, "=")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("invalid quota resource %s for pool %s", r, pool)
+			}
+			q, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid quantity for resource %s in pool %s: %s", name, pool, err)
+			}
+			guaranteed[name] = &q
+		}
+		mapToReturn[pool] = &api.QueueQuota{Guaranteed: guaranteed, BorrowingEnabled: borrowingEnabled}
+	}
+	return mapToReturn, nil
+}
+
 type ActiveJobState string
 
 const (
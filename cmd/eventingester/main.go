@@ -13,6 +13,8 @@ import (
 
 const (
 	CustomConfigLocation string = "config"
+	MigrateDatabase             = "migrateDatabase"
+	BackfillJobSets             = "backfillJobSets"
 )
 
 func init() {
@@ -21,6 +23,8 @@ func init() {
 		[]string{},
 		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
 	)
+	pflag.Bool(MigrateDatabase, false, "Migrate the Postgres event database instead of running the ingester")
+	pflag.Bool(BackfillJobSets, false, "Record the job sets of the events already stored in Redis by queue instead of running the ingester")
 	pflag.Parse()
 }
 
@@ -32,5 +36,13 @@ func main() {
 	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
 
 	common.LoadConfig(&config, "./config/eventingester", userSpecifiedConfigs)
+	if viper.GetBool(MigrateDatabase) {
+		eventingester.MigrateDatabase(&config)
+		return
+	}
+	if viper.GetBool(BackfillJobSets) {
+		eventingester.BackfillJobSets(&config)
+		return
+	}
 	eventingester.Run(&config)
 }
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/notificationingester"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+)
+
+const (
+	CustomConfigLocation string = "config"
+	MigrateDatabase             = "migrateDatabase"
+)
+
+func init() {
+	pflag.StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	pflag.Bool(MigrateDatabase, false, "Migrate the notification database instead of running the ingester")
+	pflag.Parse()
+}
+
+func main() {
+	logging.MustConfigureApplicationLogging()
+	common.BindCommandlineArguments()
+
+	var config configuration.NotificationIngesterConfiguration
+	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
+
+	common.LoadConfig(&config, "./config/notificationingester", userSpecifiedConfigs)
+	if viper.GetBool(MigrateDatabase) {
+		notificationingester.MigrateDatabase(&config)
+		return
+	}
+	notificationingester.Run(&config)
+}
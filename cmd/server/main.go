@@ -93,6 +93,7 @@ func main() {
 		api.RegisterSubmitHandler,
 		api.RegisterEventHandler,
 		api.RegisterJobsHandler,
+		api.RegisterUsageHandler,
 		schedulerobjects.RegisterSchedulerReportingHandler,
 	)
 	defer shutdownGateway()
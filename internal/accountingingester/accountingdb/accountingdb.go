@@ -0,0 +1,242 @@
+package accountingdb
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/accountingingester/metrics"
+	"github.com/armadaproject/armada/internal/accountingingester/model"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	log "github.com/armadaproject/armada/internal/common/logging"
+)
+
+// AccountingDb integrates the resources allocated to queues in each scheduling cycle into resource-hours per queue,
+// pool and day.
+//
+// The allocations of a cycle are accounted for from the time of the cycle until the time of the next cycle of the same
+// pool, so the latest cycle of each pool is stored until the next one arrives. Usage is updated and the latest cycle
+// replaced in a single transaction, and cycles no later than the stored cycle are ignored, so storing a batch more
+// than once doesn't account for usage twice.
+type AccountingDb struct {
+	db                 *pgxpool.Pool
+	maxCycleInterval   time.Duration
+	fatalErrors        []*regexp.Regexp
+	metrics            *commonmetrics.Metrics
+	intialRetryBackoff time.Duration
+	maxRetryBackoff    time.Duration
+}
+
+func NewAccountingDb(
+	db *pgxpool.Pool,
+	maxCycleInterval time.Duration,
+	fatalErrors []*regexp.Regexp,
+	metrics *commonmetrics.Metrics,
+	intialRetryBackoff time.Duration,
+	maxRetryBackoff time.Duration,
+) *AccountingDb {
+	return &AccountingDb{
+		db:                 db,
+		maxCycleInterval:   maxCycleInterval,
+		fatalErrors:        fatalErrors,
+		metrics:            metrics,
+		intialRetryBackoff: intialRetryBackoff,
+		maxRetryBackoff:    maxRetryBackoff,
+	}
+}
+
+// usageKey identifies the usage of a resource by a queue in a pool on a day.
+type usageKey struct {
+	// Midnight, in UTC, at the start of the day
+	Day      time.Time
+	Queue    string
+	Pool     string
+	Resource string
+}
+
+func (a *AccountingDb) Store(ctx *armadacontext.Context, batch *model.CycleBatch) error {
+	if len(batch.Cycles) == 0 {
+		return nil
+	}
+	return ingest.WithRetry(func() (bool, error) {
+		latestCycles, err := a.store(ctx, batch.Cycles)
+		if err != nil {
+			a.metrics.RecordDBError(commonmetrics.DBOperationUpsert)
+			return a.isRetryableError(err), err
+		}
+		for _, cycle := range latestCycles {
+			metrics.RecordLatestCycleTime(cycle.Pool, cycle.Time)
+		}
+		return false, nil
+	}, a.intialRetryBackoff, a.maxRetryBackoff)
+}
+
+// store accounts for the usage of the given cycles and returns the latest cycle stored for each pool.
+func (a *AccountingDb) store(ctx *armadacontext.Context, cycles []*model.Cycle) ([]*model.Cycle, error) {
+	cyclesByPool := make(map[string][]*model.Cycle)
+	for _, cycle := range cycles {
+		cyclesByPool[cycle.Pool] = append(cyclesByPool[cycle.Pool], cycle)
+	}
+	pools := maps.Keys(cyclesByPool)
+	slices.Sort(pools)
+
+	var latestCycles []*model.Cycle
+	err := pgx.BeginTxFunc(ctx, a.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		latestCycles = nil
+		usage := make(map[usageKey]float64)
+		for _, pool := range pools {
+			previous, err := getPoolCycle(ctx, tx, pool)
+			if err != nil {
+				return err
+			}
+			latest := accountCycles(usage, previous, cyclesByPool[pool], a.maxCycleInterval)
+			if latest != previous {
+				latestCycles = append(latestCycles, latest)
+			}
+		}
+		if err := upsertUsage(ctx, tx, usage); err != nil {
+			return err
+		}
+		for _, cycle := range latestCycles {
+			if err := upsertPoolCycle(ctx, tx, cycle); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return latestCycles, nil
+}
+
+// accountCycles adds to usage the resource-hours allocated by each cycle until the next, starting from previous,
+// the latest cycle of the pool accounted for so far, which may be nil. Each interval is capped at maxCycleInterval.
+// Cycles no later than the latest cycle accounted for are ignored. Returns the latest cycle.
+func accountCycles(usage map[usageKey]float64, previous *model.Cycle, cycles []*model.Cycle, maxCycleInterval time.Duration) *model.Cycle {
+	cycles = slices.Clone(cycles)
+	sort.SliceStable(cycles, func(i, j int) bool {
+		return cycles[i].Time.Before(cycles[j].Time)
+	})
+	latest := previous
+	for _, cycle := range cycles {
+		if latest != nil {
+			if !cycle.Time.After(latest.Time) {
+				continue
+			}
+			to := cycle.Time
+			if maxTo := latest.Time.Add(maxCycleInterval); to.After(maxTo) {
+				to = maxTo
+			}
+			accrue(usage, latest, to)
+		}
+		latest = cycle
+	}
+	return latest
+}
+
+// accrue adds to usage the resource-hours allocated by cycle between the time of the cycle and to, split by day.
+func accrue(usage map[usageKey]float64, cycle *model.Cycle, to time.Time) {
+	from := cycle.Time.UTC()
+	to = to.UTC()
+	for from.Before(to) {
+		day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+		end := day.AddDate(0, 0, 1)
+		if to.Before(end) {
+			end = to
+		}
+		hours := end.Sub(from).Hours()
+		for queue, allocation := range cycle.AllocationByQueue {
+			for resource, amount := range allocation {
+				usage[usageKey{Day: day, Queue: queue, Pool: cycle.Pool, Resource: resource}] += amount * hours
+			}
+		}
+		from = end
+	}
+}
+
+func getPoolCycle(ctx *armadacontext.Context, tx pgx.Tx, pool string) (*model.Cycle, error) {
+	cycle := &model.Cycle{Pool: pool}
+	err := tx.QueryRow(ctx,
+		"SELECT cycle_time, allocation FROM pool_cycle WHERE pool = $1 FOR UPDATE",
+		pool,
+	).Scan(&cycle.Time, &cycle.AllocationByQueue)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	cycle.Time = cycle.Time.UTC()
+	return cycle, nil
+}
+
+func upsertPoolCycle(ctx *armadacontext.Context, tx pgx.Tx, cycle *model.Cycle) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO pool_cycle (pool, cycle_time, allocation) VALUES ($1, $2, $3)
+		ON CONFLICT (pool) DO UPDATE SET cycle_time = EXCLUDED.cycle_time, allocation = EXCLUDED.allocation`,
+		cycle.Pool, cycle.Time, cycle.AllocationByQueue,
+	)
+	return errors.WithStack(err)
+}
+
+func upsertUsage(ctx *armadacontext.Context, tx pgx.Tx, usage map[usageKey]float64) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	keys := maps.Keys(usage)
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if !a.Day.Equal(b.Day) {
+			return a.Day.Before(b.Day)
+		}
+		if a.Queue != b.Queue {
+			return a.Queue < b.Queue
+		}
+		if a.Pool != b.Pool {
+			return a.Pool < b.Pool
+		}
+		return a.Resource < b.Resource
+	})
+	days := make([]time.Time, len(keys))
+	queues := make([]string, len(keys))
+	pools := make([]string, len(keys))
+	resources := make([]string, len(keys))
+	resourceHours := make([]float64, len(keys))
+	for i, key := range keys {
+		days[i] = key.Day
+		queues[i] = key.Queue
+		pools[i] = key.Pool
+		resources[i] = key.Resource
+		resourceHours[i] = usage[key]
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO queue_usage (day, queue, pool, resource, resource_hours)
+		SELECT * FROM unnest($1::date[], $2::text[], $3::text[], $4::text[], $5::double precision[])
+		ON CONFLICT (day, queue, pool, resource) DO UPDATE SET resource_hours = queue_usage.resource_hours + EXCLUDED.resource_hours`,
+		days, queues, pools, resources, resourceHours,
+	)
+	return errors.WithStack(err)
+}
+
+// isRetryableError returns true if the error doesn't match the list of fatal errors
+func (a *AccountingDb) isRetryableError(err error) bool {
+	if err == nil {
+		return true
+	}
+	msg := err.Error()
+	for _, r := range a.fatalErrors {
+		if r.MatchString(msg) {
+			log.Infof("Error %s matched regex %s and so will be considered fatal", msg, r)
+			return false
+		}
+	}
+	return true
+}
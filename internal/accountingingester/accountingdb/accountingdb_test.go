@@ -0,0 +1,154 @@
+package accountingdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/accountingingester/model"
+	"github.com/armadaproject/armada/internal/accountingingester/schema"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+)
+
+var (
+	baseTime = time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC)
+	day1     = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2     = time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func cycle(pool string, t time.Time, allocationByQueue map[string]map[string]float64) *model.Cycle {
+	return &model.Cycle{Pool: pool, Time: t, AllocationByQueue: allocationByQueue}
+}
+
+func TestAccountCycles(t *testing.T) {
+	first := cycle("cpu", baseTime, map[string]map[string]float64{
+		"queue-a": {"cpu": 2, "memory": 1024},
+		"queue-b": {"cpu": 1},
+	})
+	second := cycle("cpu", baseTime.Add(3*time.Hour), map[string]map[string]float64{
+		"queue-a": {"cpu": 4},
+	})
+	third := cycle("cpu", baseTime.Add(4*time.Hour), map[string]map[string]float64{})
+
+	tests := map[string]struct {
+		previous         *model.Cycle
+		cycles           []*model.Cycle
+		maxCycleInterval time.Duration
+		expectedUsage    map[usageKey]float64
+		expectedLatest   *model.Cycle
+	}{
+		"first cycle of a pool": {
+			cycles:           []*model.Cycle{first},
+			maxCycleInterval: time.Hour,
+			expectedUsage:    map[usageKey]float64{},
+			expectedLatest:   first,
+		},
+		"usage is split by day": {
+			previous:         first,
+			cycles:           []*model.Cycle{second},
+			maxCycleInterval: 24 * time.Hour,
+			expectedUsage: map[usageKey]float64{
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "cpu"}:    4,
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "memory"}: 2048,
+				{Day: day1, Queue: "queue-b", Pool: "cpu", Resource: "cpu"}:    2,
+				{Day: day2, Queue: "queue-a", Pool: "cpu", Resource: "cpu"}:    2,
+				{Day: day2, Queue: "queue-a", Pool: "cpu", Resource: "memory"}: 1024,
+				{Day: day2, Queue: "queue-b", Pool: "cpu", Resource: "cpu"}:    1,
+			},
+			expectedLatest: second,
+		},
+		"cycles are accounted for in order": {
+			cycles:           []*model.Cycle{third, first, second},
+			maxCycleInterval: 24 * time.Hour,
+			expectedUsage: map[usageKey]float64{
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "cpu"}:    4,
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "memory"}: 2048,
+				{Day: day1, Queue: "queue-b", Pool: "cpu", Resource: "cpu"}:    2,
+				{Day: day2, Queue: "queue-a", Pool: "cpu", Resource: "cpu"}:    6,
+				{Day: day2, Queue: "queue-a", Pool: "cpu", Resource: "memory"}: 1024,
+				{Day: day2, Queue: "queue-b", Pool: "cpu", Resource: "cpu"}:    1,
+			},
+			expectedLatest: third,
+		},
+		"intervals are capped": {
+			previous:         first,
+			cycles:           []*model.Cycle{second},
+			maxCycleInterval: 30 * time.Minute,
+			expectedUsage: map[usageKey]float64{
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "cpu"}:    1,
+				{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "memory"}: 512,
+				{Day: day1, Queue: "queue-b", Pool: "cpu", Resource: "cpu"}:    0.5,
+			},
+			expectedLatest: second,
+		},
+		"cycles already accounted for are ignored": {
+			previous:         second,
+			cycles:           []*model.Cycle{first, second},
+			maxCycleInterval: 24 * time.Hour,
+			expectedUsage:    map[usageKey]float64{},
+			expectedLatest:   second,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			usage := make(map[usageKey]float64)
+			latest := accountCycles(usage, tc.previous, tc.cycles, tc.maxCycleInterval)
+			assert.Equal(t, tc.expectedUsage, usage)
+			assert.Equal(t, tc.expectedLatest, latest)
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		accountingDb := NewAccountingDb(db, 24*time.Hour, nil, metrics.NewMetrics("test_accounting_db_"), time.Millisecond, time.Millisecond)
+		batch := &model.CycleBatch{Cycles: []*model.Cycle{
+			cycle("cpu", baseTime, map[string]map[string]float64{"queue-a": {"cpu": 2}}),
+			cycle("gpu", baseTime, map[string]map[string]float64{"queue-a": {"nvidia.com/gpu": 1}}),
+		}}
+		require.NoError(t, accountingDb.Store(ctx, batch))
+
+		batch = &model.CycleBatch{Cycles: []*model.Cycle{
+			cycle("cpu", baseTime.Add(time.Hour), map[string]map[string]float64{"queue-a": {"cpu": 4}}),
+			cycle("gpu", baseTime.Add(30*time.Minute), map[string]map[string]float64{}),
+		}}
+		require.NoError(t, accountingDb.Store(ctx, batch))
+		// Storing a batch again doesn't account for its usage twice
+		require.NoError(t, accountingDb.Store(ctx, batch))
+
+		batch = &model.CycleBatch{Cycles: []*model.Cycle{
+			cycle("cpu", baseTime.Add(3*time.Hour), map[string]map[string]float64{}),
+		}}
+		require.NoError(t, accountingDb.Store(ctx, batch))
+
+		type usageRow struct {
+			Day           time.Time
+			Queue         string
+			Pool          string
+			Resource      string
+			ResourceHours float64
+		}
+		rows, err := db.Query(ctx, "SELECT day, queue, pool, resource, resource_hours FROM queue_usage ORDER BY day, queue, pool, resource")
+		require.NoError(t, err)
+		usage, err := pgx.CollectRows(rows, pgx.RowToStructByPos[usageRow])
+		require.NoError(t, err)
+		assert.Equal(t, []usageRow{
+			{Day: day1, Queue: "queue-a", Pool: "cpu", Resource: "cpu", ResourceHours: 2 + 4},
+			{Day: day1, Queue: "queue-a", Pool: "gpu", Resource: "nvidia.com/gpu", ResourceHours: 0.5},
+			{Day: day2, Queue: "queue-a", Pool: "cpu", Resource: "cpu", ResourceHours: 4},
+		}, usage)
+
+		var cycleTime time.Time
+		require.NoError(t, db.QueryRow(ctx, "SELECT cycle_time FROM pool_cycle WHERE pool = 'cpu'").Scan(&cycleTime))
+		assert.Equal(t, baseTime.Add(3*time.Hour), cycleTime.UTC())
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package configuration
+
+import (
+	"time"
+
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
+	"github.com/armadaproject/armada/internal/server/configuration"
+)
+
+type AccountingIngesterConfiguration struct {
+	// Database configuration
+	Postgres configuration.PostgresConfig
+	// Metrics configuration
+	MetricsPort uint16
+	// General Pulsar configuration
+	Pulsar commonconfig.PulsarConfig
+	// Pulsar subscription name
+	SubscriptionName string `validate:"required"`
+	// Number of messages that will be batched together before being inserted into the database
+	BatchSize int `validate:"gt=0"`
+	// Maximum time since the last batch before a batch will be inserted into the database
+	BatchDuration time.Duration
+	// The resources allocated to queues in a scheduling cycle are assumed to be allocated until the next cycle of the
+	// same pool, for at most this long. This bounds the usage accounted for while the scheduler isn't running cycles,
+	// e.g. because it's down.
+	MaxCycleInterval time.Duration `validate:"gt=0"`
+	// List of Regexes which will identify fatal errors when inserting into postgres
+	FatalInsertionErrors []string
+	// If non-nil, configures pprof profiling
+	Profiling *profilingconfig.ProfilingConfig
+}
@@ -0,0 +1,59 @@
+package convert
+
+import (
+	"github.com/armadaproject/armada/internal/accountingingester/model"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/pkg/metricevents"
+)
+
+// CycleConverter converts metric events into the allocations of the scheduling cycles they describe.
+type CycleConverter struct {
+	metrics *metrics.Metrics
+}
+
+func NewCycleConverter(metrics *metrics.Metrics) ingest.InstructionConverter[*model.CycleBatch, *metricevents.Event] {
+	return &CycleConverter{metrics: metrics}
+}
+
+func (c *CycleConverter) Convert(ctx *armadacontext.Context, events *utils.EventsWithIds[*metricevents.Event]) *model.CycleBatch {
+	batch := &model.CycleBatch{MessageIds: events.MessageIds}
+	for _, event := range events.Events {
+		cycleMetrics := event.GetCycleMetrics()
+		if cycleMetrics == nil {
+			continue
+		}
+		if cycleMetrics.CycleTime == nil {
+			c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+			ctx.Warnf("Ignoring cycle metrics of pool %s as they have no cycle time", cycleMetrics.Pool)
+			continue
+		}
+		batch.Cycles = append(batch.Cycles, &model.Cycle{
+			Pool:              cycleMetrics.Pool,
+			Time:              protoutil.ToStdTime(cycleMetrics.CycleTime),
+			AllocationByQueue: allocationByQueue(cycleMetrics),
+		})
+	}
+	return batch
+}
+
+// allocationByQueue returns the non-zero billable allocation of each queue in the cycle.
+func allocationByQueue(cycleMetrics *metricevents.CycleMetrics) map[string]map[string]float64 {
+	result := make(map[string]map[string]float64, len(cycleMetrics.QueueMetrics))
+	for queue, queueMetrics := range cycleMetrics.QueueMetrics {
+		allocation := make(map[string]float64)
+		for resource, quantity := range queueMetrics.GetBillableAllocationByResourceType() {
+			if quantity == nil || quantity.IsZero() {
+				continue
+			}
+			allocation[resource] = quantity.AsApproximateFloat64()
+		}
+		if len(allocation) > 0 {
+			result[queue] = allocation
+		}
+	}
+	return result
+}
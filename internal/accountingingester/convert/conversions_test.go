@@ -0,0 +1,75 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/accountingingester/model"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	"github.com/armadaproject/armada/internal/common/pointer"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/pkg/metricevents"
+)
+
+var cycleTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestConvert(t *testing.T) {
+	events := &utils.EventsWithIds[*metricevents.Event]{
+		Events: []*metricevents.Event{
+			{
+				Created: protoutil.ToTimestamp(cycleTime),
+				Event: &metricevents.Event_CycleMetrics{CycleMetrics: &metricevents.CycleMetrics{
+					Pool: "cpu",
+					QueueMetrics: map[string]*metricevents.QueueMetrics{
+						"queue-a": {
+							BillableAllocationByResourceType: map[string]*resource.Quantity{
+								"cpu":            pointer.MustParseResource("1500m"),
+								"memory":         pointer.MustParseResource("2Gi"),
+								"nvidia.com/gpu": pointer.MustParseResource("0"),
+							},
+							// Only billable allocations are accounted for
+							DemandByResourceType: map[string]*resource.Quantity{
+								"cpu": pointer.MustParseResource("10"),
+							},
+						},
+						// Queues with nothing allocated are omitted
+						"queue-b": {
+							BillableAllocationByResourceType: map[string]*resource.Quantity{
+								"cpu": pointer.MustParseResource("0"),
+							},
+						},
+					},
+					CycleTime: protoutil.ToTimestamp(cycleTime),
+				}},
+			},
+			// Cycles without a time are ignored
+			{
+				Created: protoutil.ToTimestamp(cycleTime),
+				Event:   &metricevents.Event_CycleMetrics{CycleMetrics: &metricevents.CycleMetrics{Pool: "gpu"}},
+			},
+		},
+		MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1), pulsarutils.NewMessageId(2)},
+	}
+
+	batch := NewCycleConverter(metrics.NewMetrics("test_accounting_")).Convert(armadacontext.Background(), events)
+
+	assert.Equal(t, &model.CycleBatch{
+		MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1), pulsarutils.NewMessageId(2)},
+		Cycles: []*model.Cycle{
+			{
+				Pool: "cpu",
+				Time: cycleTime,
+				AllocationByQueue: map[string]map[string]float64{
+					"queue-a": {"cpu": 1.5, "memory": 2 * 1024 * 1024 * 1024},
+				},
+			},
+		},
+	}, batch)
+}
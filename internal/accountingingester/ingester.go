@@ -0,0 +1,100 @@
+package accountingingester
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/accountingingester/accountingdb"
+	"github.com/armadaproject/armada/internal/accountingingester/configuration"
+	"github.com/armadaproject/armada/internal/accountingingester/convert"
+	"github.com/armadaproject/armada/internal/accountingingester/metrics"
+	"github.com/armadaproject/armada/internal/accountingingester/model"
+	"github.com/armadaproject/armada/internal/accountingingester/schema"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/app"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/ingest/metricevents"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/profiling"
+	metriceventsapi "github.com/armadaproject/armada/pkg/metricevents"
+)
+
+// Run will create a pipeline that will take the scheduling cycle metrics published by the scheduler from Pulsar and
+// integrate the resources allocated to each queue into usage in the accounting database. This pipeline will run until
+// a SIGTERM is received
+func Run(config *configuration.AccountingIngesterConfiguration) {
+	log.Info("Accounting Ingester Starting")
+
+	if err := config.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Expose profiling endpoints if enabled.
+	err := profiling.SetupPprof(config.Profiling, armadacontext.Background(), nil)
+	if err != nil {
+		log.Fatalf("Pprof setup failed, exiting, %v", err)
+	}
+
+	metrics := metrics.Get()
+
+	fatalRegexes := make([]*regexp.Regexp, len(config.FatalInsertionErrors))
+	for i, str := range config.FatalInsertionErrors {
+		rgx, err := regexp.Compile(str)
+		if err != nil {
+			log.Errorf("Error compiling regex %s", str)
+			panic(err)
+		}
+		fatalRegexes[i] = rgx
+	}
+
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		panic(errors.WithMessage(err, "Error opening connection to postgres"))
+	}
+	defer db.Close()
+	accountingDb := accountingdb.NewAccountingDb(db, config.MaxCycleInterval, fatalRegexes, metrics, 100*time.Millisecond, 60*time.Second)
+
+	converter := convert.NewCycleConverter(metrics)
+
+	// Start metric server
+	shutdownMetricServer := common.ServeMetrics(config.MetricsPort)
+	defer shutdownMetricServer()
+
+	// Cycles of a pool must be accounted for in order, so a failover subscription is used
+	ingester := ingest.NewIngestionPipeline[*model.CycleBatch, *metriceventsapi.Event](
+		config.Pulsar,
+		config.Pulsar.MetricEventsTopic,
+		config.SubscriptionName,
+		config.BatchSize,
+		config.BatchDuration,
+		pulsar.Failover,
+		metricevents.EventCounter,
+		metricevents.MessageUnmarshaller,
+		metricevents.BatchMerger,
+		metricevents.BatchMetricPublisher,
+		converter,
+		accountingDb,
+		metrics,
+	)
+	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
+	}
+}
+
+// MigrateDatabase applies the migrations of the accounting database.
+func MigrateDatabase(config *configuration.AccountingIngesterConfiguration) {
+	ctx := armadacontext.Background()
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		panic(errors.WithMessage(err, "Error opening connection to postgres"))
+	}
+	defer db.Close()
+	if err := schema.Migrate(ctx, db); err != nil {
+		panic(errors.WithMessage(err, "Error migrating accounting database"))
+	}
+}
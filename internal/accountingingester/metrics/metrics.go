@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+)
+
+var m = metrics.NewMetrics(metrics.ArmadaAccountingIngesterMetricsPrefix)
+
+func Get() *metrics.Metrics {
+	return m
+}
+
+var latestCycleTime = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: metrics.ArmadaAccountingIngesterMetricsPrefix + "latest_cycle_time_seconds",
+		Help: "Unix time of the latest scheduling cycle stored for each pool; usage is accounted for up to this time",
+	},
+	[]string{"pool"},
+)
+
+func RecordLatestCycleTime(pool string, cycleTime time.Time) {
+	latestCycleTime.With(map[string]string{"pool": pool}).Set(float64(cycleTime.Unix()))
+}
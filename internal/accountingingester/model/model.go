@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+// CycleBatch holds the scheduling cycles of a batch of pulsar messages
+type CycleBatch struct {
+	MessageIds []pulsar.MessageID
+	Cycles     []*Cycle
+}
+
+func (b *CycleBatch) GetMessageIDs() []pulsar.MessageID {
+	return b.MessageIds
+}
+
+// Cycle holds the resources allocated to each queue in a pool as of a scheduling cycle.
+type Cycle struct {
+	Pool string
+	// Time from which the allocations hold
+	Time time.Time
+	// Amount of each resource allocated to each queue, indexed by queue and then by resource,
+	// in the base unit of the resource (e.g. cores for cpu)
+	AllocationByQueue map[string]map[string]float64
+}
@@ -0,0 +1,19 @@
+-- Resource-hours allocated to each queue in each pool, by day
+CREATE TABLE IF NOT EXISTS queue_usage (
+    day            date             NOT NULL,
+    queue          varchar(512)     NOT NULL,
+    pool           varchar(512)     NOT NULL,
+    resource       varchar(253)     NOT NULL,
+    resource_hours double precision NOT NULL,
+    PRIMARY KEY (day, queue, pool, resource)
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_usage_queue_day ON queue_usage (queue, day);
+
+-- The latest scheduling cycle of each pool, whose allocations are accounted for once the next cycle is known
+CREATE TABLE IF NOT EXISTS pool_cycle (
+    pool       varchar(512) NOT NULL PRIMARY KEY,
+    cycle_time timestamp    NOT NULL,
+    -- Amount of each resource allocated to each queue, as a JSON object indexed by queue and then by resource
+    allocation jsonb        NOT NULL
+);
@@ -19,7 +19,9 @@ import (
 
 	"github.com/armadaproject/armada/pkg/client"
 	"github.com/armadaproject/armada/pkg/client/executor"
+	"github.com/armadaproject/armada/pkg/client/job"
 	"github.com/armadaproject/armada/pkg/client/queue"
+	"github.com/armadaproject/armada/pkg/client/usage"
 )
 
 type App struct {
@@ -41,6 +43,8 @@ type Params struct {
 	ApiConnectionDetails *client.ApiConnectionDetails
 	QueueAPI             *QueueAPI
 	ExecutorAPI          *ExecutorAPI
+	JobAPI               *JobAPI
+	UsageAPI             *UsageAPI
 }
 
 // QueueAPI struct holds pointers to functions that are called by armadactl.
@@ -60,11 +64,26 @@ type QueueAPI struct {
 	Preempt  queue.PreemptAPI
 }
 
+// JobAPI struct holds pointers to functions used to query jobs, which are defined in /pkg/client/job.
+// As with QueueAPI, they are user-replaceable to facilitate testing.
+type JobAPI struct {
+	GetDetails job.GetDetailsAPI
+	GetErrors  job.GetErrorsAPI
+}
+
+// UsageAPI struct holds pointers to functions used to query queue usage, which are defined in /pkg/client/usage.
+// As with QueueAPI, they are user-replaceable to facilitate testing.
+type UsageAPI struct {
+	Get usage.GetAPI
+}
+
 type ExecutorAPI struct {
 	Cordon            executor.CordonAPI
 	Uncordon          executor.UncordonAPI
 	CancelOnExecutor  executor.CancelAPI
 	PreemptOnExecutor executor.PreemptAPI
+	DrainNodes        executor.DrainAPI
+	UndrainNodes      executor.UndrainAPI
 }
 
 // New instantiates an App with default parameters, including standard output
@@ -77,5 +96,7 @@ func New() *App {
 	}
 	app.Params.QueueAPI = &QueueAPI{}
 	app.Params.ExecutorAPI = &ExecutorAPI{}
+	app.Params.JobAPI = &JobAPI{}
+	app.Params.UsageAPI = &UsageAPI{}
 	return app
 }
@@ -35,6 +35,28 @@ func (a *App) CancelJob(queue string, jobSetId string, jobId string) (outerErr e
 	})
 }
 
+func (a *App) CancelJobArray(queue string, jobSetId string, arrayId string) (outerErr error) {
+	apiConnectionDetails := a.Params.ApiConnectionDetails
+
+	fmt.Fprintf(a.Out, "Requesting cancellation of job array matching queue: %s, job set: %s, and array ID: %s\n", queue, jobSetId, arrayId)
+	return client.WithSubmitClient(apiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		_, err := c.CancelJobs(ctx, &api.JobCancelRequest{
+			ArrayId:  arrayId,
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error cancelling job array matching queue: %s, job set: %s, and array id: %s", queue, jobSetId, arrayId)
+		}
+
+		fmt.Fprintf(a.Out, "Requested cancellation for job array %s\n", arrayId)
+		return nil
+	})
+}
+
 func (a *App) CancelJobSet(queue string, jobSetId string) (outerErr error) {
 	apiConnectionDetails := a.Params.ApiConnectionDetails
 
@@ -0,0 +1,114 @@
+package armadactl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// DrainNodes marks either the named node or all nodes matching nodeSelector on executor as draining.
+func (a *App) DrainNodes(executor string, nodeName string, nodeSelector map[string]string, preempt bool, reason string) error {
+	fmt.Fprintf(a.Out, "Draining %s on executor %s (preempt: %t)\n", describeDrainTarget(nodeName, nodeSelector), executor, preempt)
+	if err := a.Params.ExecutorAPI.DrainNodes(executor, nodeName, nodeSelector, preempt, reason); err != nil {
+		return fmt.Errorf("error draining nodes on executor %s: %s", executor, err)
+	}
+	return nil
+}
+
+// UndrainNodes removes a drain previously created with the same executor, nodeName and nodeSelector.
+func (a *App) UndrainNodes(executor string, nodeName string, nodeSelector map[string]string) error {
+	fmt.Fprintf(a.Out, "Undraining %s on executor %s\n", describeDrainTarget(nodeName, nodeSelector), executor)
+	if err := a.Params.ExecutorAPI.UndrainNodes(executor, nodeName, nodeSelector); err != nil {
+		return fmt.Errorf("error undraining nodes on executor %s: %s", executor, err)
+	}
+	return nil
+}
+
+// WaitForNodeDrain polls the scheduling reports, printing the number of jobs and runs remaining on each draining node
+// of executor, until none remain or timeout has elapsed. If nodeName is empty, all draining nodes of the executor are
+// considered. A timeout of zero means waiting indefinitely.
+func (a *App) WaitForNodeDrain(executor string, nodeName string, pollInterval time.Duration, timeout time.Duration) error {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	lastProgress := ""
+	for {
+		nodes, err := a.getDrainingNodes(executor, nodeName)
+		if err != nil {
+			return fmt.Errorf("error fetching drain progress: %s", err)
+		}
+		progress, done := renderDrainProgress(nodes)
+		if progress != lastProgress {
+			fmt.Fprint(a.Out, progress)
+			lastProgress = progress
+		}
+		if done {
+			return nil
+		}
+		if !deadline.IsZero() && time.Now().Add(pollInterval).After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for nodes to drain", timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func (a *App) getDrainingNodes(executor string, nodeName string) ([]*schedulerobjects.DrainingNodeReport, error) {
+	var nodes []*schedulerobjects.DrainingNodeReport
+	err := client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+		report, err := c.GetSchedulingReport(ctx, &schedulerobjects.SchedulingReportRequest{})
+		if err != nil {
+			return err
+		}
+		nodes = drainingNodesFromReport(report, executor, nodeName)
+		return nil
+	})
+	return nodes, err
+}
+
+// drainingNodesFromReport returns the draining nodes of executor across all pools of report,
+// restricted to nodeName if it is non-empty.
+func drainingNodesFromReport(report *schedulerobjects.SchedulingReport, executor string, nodeName string) []*schedulerobjects.DrainingNodeReport {
+	var nodes []*schedulerobjects.DrainingNodeReport
+	for _, pool := range report.Pools {
+		for _, node := range pool.DrainingNodes {
+			if node.Executor == executor && (nodeName == "" || node.Node == nodeName) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes
+}
+
+// renderDrainProgress returns a human-readable summary of nodes, and whether all of them are empty.
+// Nodes are only empty once the executor no longer reports any runs on them, i.e., once the pods of preempted jobs have
+// exited. No nodes being reported means the scheduler hasn't yet picked up the drain.
+func renderDrainProgress(nodes []*schedulerobjects.DrainingNodeReport) (string, bool) {
+	if len(nodes) == 0 {
+		return "Waiting for the scheduler to start draining\n", false
+	}
+	progress := ""
+	done := true
+	for _, node := range nodes {
+		progress += fmt.Sprintf("%s: %d jobs, %d runs remaining\n", node.Node, len(node.RemainingJobIds), len(node.RemainingRunIds))
+		if len(node.RemainingJobIds) > 0 || len(node.RemainingRunIds) > 0 {
+			done = false
+		}
+	}
+	if done {
+		progress += "All draining nodes are empty\n"
+	}
+	return progress, done
+}
+
+func describeDrainTarget(nodeName string, nodeSelector map[string]string) string {
+	if nodeName != "" {
+		return fmt.Sprintf("node %s", nodeName)
+	}
+	return fmt.Sprintf("nodes matching %v", nodeSelector)
+}
@@ -0,0 +1,62 @@
+package armadactl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+func TestDrainingNodesFromReport(t *testing.T) {
+	report := &schedulerobjects.SchedulingReport{
+		Pools: []*schedulerobjects.PoolSchedulingReport{
+			{
+				Pool: "pool-1",
+				DrainingNodes: []*schedulerobjects.DrainingNodeReport{
+					{Executor: "executor-1", Node: "node-1", RemainingJobIds: []string{"job-1"}},
+					{Executor: "executor-2", Node: "node-2"},
+				},
+			},
+			{
+				Pool: "pool-2",
+				DrainingNodes: []*schedulerobjects.DrainingNodeReport{
+					{Executor: "executor-1", Node: "node-3"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"node-1", "node-3"}, nodeNames(drainingNodesFromReport(report, "executor-1", "")))
+	assert.Equal(t, []string{"node-3"}, nodeNames(drainingNodesFromReport(report, "executor-1", "node-3")))
+	assert.Empty(t, drainingNodesFromReport(report, "executor-3", ""))
+}
+
+func TestRenderDrainProgress(t *testing.T) {
+	progress, done := renderDrainProgress(nil)
+	assert.False(t, done)
+	assert.Equal(t, "Waiting for the scheduler to start draining\n", progress)
+
+	progress, done = renderDrainProgress([]*schedulerobjects.DrainingNodeReport{
+		{Node: "node-1", RemainingJobIds: []string{"job-1", "job-2"}, RemainingRunIds: []string{"run-1", "run-2"}},
+		{Node: "node-2"},
+	})
+	assert.False(t, done)
+	assert.Equal(t, "node-1: 2 jobs, 2 runs remaining\nnode-2: 0 jobs, 0 runs remaining\n", progress)
+
+	// Nodes aren't empty until the pods of preempted jobs have exited.
+	progress, done = renderDrainProgress([]*schedulerobjects.DrainingNodeReport{{Node: "node-1", RemainingRunIds: []string{"run-1"}}})
+	assert.False(t, done)
+	assert.Equal(t, "node-1: 0 jobs, 1 runs remaining\n", progress)
+
+	progress, done = renderDrainProgress([]*schedulerobjects.DrainingNodeReport{{Node: "node-1"}})
+	assert.True(t, done)
+	assert.Equal(t, "node-1: 0 jobs, 0 runs remaining\nAll draining nodes are empty\n", progress)
+}
+
+func nodeNames(nodes []*schedulerobjects.DrainingNodeReport) []string {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Node
+	}
+	return names
+}
@@ -0,0 +1,232 @@
+package armadactl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	goslices "golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobOutputs are the formats GetJobs, GetJobErrors and DescribeJob can print in; the first is the default.
+var JobOutputs = []string{OutputTable, OutputJson, OutputYaml}
+
+// jobDescription is the structured output of DescribeJob.
+type jobDescription struct {
+	Details json.RawMessage `json:"details"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// GetJobs prints the state of each of the given jobs.
+// Jobs that can't be found are reported in the returned error, after the jobs that were found have been printed.
+func (a *App) GetJobs(jobIds []string, output string) error {
+	detailsById, err := a.Params.JobAPI.GetDetails(jobIds, false, false)
+	if err != nil {
+		return errors.Errorf("[armadactl.GetJobs] error getting jobs: %s", err)
+	}
+	var jobs []*api.JobDetails
+	var missingJobIds []string
+	for _, jobId := range jobIds {
+		if details, ok := detailsById[jobId]; ok {
+			jobs = append(jobs, details)
+		} else {
+			missingJobIds = append(missingJobIds, jobId)
+		}
+	}
+
+	if output == OutputTable {
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "JOB ID\tQUEUE\tJOB SET\tSTATE\tSUBMITTED\tLAST TRANSITION\tLATEST RUN")
+		for _, job := range jobs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				job.JobId, job.Queue, job.Jobset, job.State, formatTimestamp(job.SubmittedTs), formatTimestamp(job.LastTransitionTs), job.LatestRunId)
+		}
+		if err := w.Flush(); err != nil {
+			return errors.WithStack(err)
+		}
+	} else {
+		marshalledJobs := make([]json.RawMessage, len(jobs))
+		for i, job := range jobs {
+			if marshalledJobs[i], err = marshalProto(job); err != nil {
+				return err
+			}
+		}
+		if err := a.printStructured(marshalledJobs, output); err != nil {
+			return err
+		}
+	}
+
+	if len(missingJobIds) > 0 {
+		return errors.Errorf("[armadactl.GetJobs] jobs not found: %s", strings.Join(missingJobIds, ", "))
+	}
+	return nil
+}
+
+// DescribeJob prints the spec, state, runs and error of a job.
+func (a *App) DescribeJob(jobId string, output string) error {
+	detailsById, err := a.Params.JobAPI.GetDetails([]string{jobId}, true, true)
+	if err != nil {
+		return errors.Errorf("[armadactl.DescribeJob] error getting job %s: %s", jobId, err)
+	}
+	details, ok := detailsById[jobId]
+	if !ok {
+		return errors.Errorf("[armadactl.DescribeJob] error getting job %s: job not found", jobId)
+	}
+	errorsById, err := a.Params.JobAPI.GetErrors([]string{jobId})
+	if err != nil {
+		return errors.Errorf("[armadactl.DescribeJob] error getting error of job %s: %s", jobId, err)
+	}
+	jobError := errorsById[jobId]
+
+	if output != OutputTable {
+		marshalledDetails, err := marshalProto(details)
+		if err != nil {
+			return err
+		}
+		return a.printStructured(jobDescription{Details: marshalledDetails, Error: jobError}, output)
+	}
+
+	w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "Job ID:\t%s\n", details.JobId)
+	fmt.Fprintf(w, "Queue:\t%s\n", details.Queue)
+	fmt.Fprintf(w, "Job Set:\t%s\n", details.Jobset)
+	fmt.Fprintf(w, "Namespace:\t%s\n", details.Namespace)
+	fmt.Fprintf(w, "State:\t%s\n", details.State)
+	fmt.Fprintf(w, "Submitted:\t%s\n", formatTimestamp(details.SubmittedTs))
+	fmt.Fprintf(w, "Last Transition:\t%s\n", formatTimestamp(details.LastTransitionTs))
+	if details.CancelTs != nil {
+		fmt.Fprintf(w, "Cancelled:\t%s by %s: %s\n", formatTimestamp(details.CancelTs), details.CancelUser, details.CancelReason)
+	}
+	if spec := details.JobSpec; spec != nil {
+		fmt.Fprintf(w, "Priority:\t%v\n", spec.Priority)
+		if podSpec := spec.GetMainPodSpec(); podSpec != nil {
+			fmt.Fprintf(w, "Priority Class:\t%s\n", podSpec.PriorityClassName)
+			for _, container := range podSpec.Containers {
+				fmt.Fprintf(w, "Container %s:\t%s\n", container.Name, container.Image)
+			}
+		}
+		if requirements := spec.SchedulingResourceRequirements; requirements != nil {
+			fmt.Fprintf(w, "Requests:\t%s\n", formatResourceList(requirements.Requests))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Fprintln(a.Out, "Runs:")
+	if len(details.JobRuns) == 0 {
+		fmt.Fprintln(a.Out, "  <none>")
+	} else {
+		w = tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "  RUN ID\tSTATE\tCLUSTER\tPOOL\tNODE\tLEASED\tSTARTED\tFINISHED\tEXIT CODE")
+		for _, run := range details.JobRuns {
+			exitCode := ""
+			if run.State == api.JobRunState_RUN_STATE_SUCCEEDED || run.State == api.JobRunState_RUN_STATE_FAILED {
+				exitCode = fmt.Sprintf("%d", run.ExitCode)
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				run.RunId, formatRunState(run.State), run.Cluster, run.Pool, run.Node,
+				formatTimestamp(run.LeasedTs), formatTimestamp(run.StartedTs), formatTimestamp(run.FinishedTs), exitCode)
+		}
+		if err := w.Flush(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if jobError != "" {
+		fmt.Fprintln(a.Out, "Error:")
+		printIndented(a.Out, jobError, "  ")
+	}
+	return nil
+}
+
+// GetJobErrors prints the error each of the given jobs failed with, if any.
+func (a *App) GetJobErrors(jobIds []string, output string) error {
+	errorsById, err := a.Params.JobAPI.GetErrors(jobIds)
+	if err != nil {
+		return errors.Errorf("[armadactl.GetJobErrors] error getting job errors: %s", err)
+	}
+	if output != OutputTable {
+		return a.printStructured(errorsById, output)
+	}
+
+	w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB ID\tERROR")
+	for _, jobId := range jobIds {
+		jobError, ok := errorsById[jobId]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\n", jobId, strings.Join(strings.Fields(jobError), " "))
+	}
+	return errors.WithStack(w.Flush())
+}
+
+// printStructured prints v as indented JSON, or as YAML if output is OutputYaml.
+func (a *App) printStructured(v any, output string) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if output == OutputYaml {
+		if b, err = yaml.JSONToYAML(b); err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = a.Out.Write(b)
+		return errors.WithStack(err)
+	}
+	fmt.Fprintln(a.Out, string(b))
+	return nil
+}
+
+// marshalProto marshals msg to JSON using the field names and encodings of the gRPC gateway.
+func marshalProto(msg proto.Message) (json.RawMessage, error) {
+	var sb strings.Builder
+	if err := (&jsonpb.Marshaler{}).Marshal(&sb, msg); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return json.RawMessage(sb.String()), nil
+}
+
+func formatTimestamp(ts *types.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return protoutil.ToStdTime(ts).UTC().Format(time.RFC3339)
+}
+
+func formatRunState(state api.JobRunState) string {
+	name := state.String()
+	name = strings.TrimPrefix(name, "RUN_STATE_")
+	return strings.TrimPrefix(name, "RUNS_STATE_")
+}
+
+func formatResourceList(resources v1.ResourceList) string {
+	names := maps.Keys(resources)
+	goslices.Sort(names)
+	formatted := make([]string, len(names))
+	for i, name := range names {
+		q := resources[name]
+		formatted[i] = fmt.Sprintf("%s=%s", name, q.String())
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func printIndented(w io.Writer, s string, indent string) {
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		fmt.Fprintf(w, "%s%s\n", indent, line)
+	}
+}
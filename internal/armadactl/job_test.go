@@ -0,0 +1,174 @@
+package armadactl
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var testJobDetails = &api.JobDetails{
+	JobId:            "job-1",
+	Queue:            "queue-a",
+	Jobset:           "set-a",
+	Namespace:        "ns",
+	State:            api.JobState_FAILED,
+	SubmittedTs:      protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	LastTransitionTs: protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)),
+	LatestRunId:      "run-2",
+	JobSpec: &api.Job{
+		Priority: 1,
+		PodSpec: &v1.PodSpec{
+			PriorityClassName: "armada-default",
+			Containers:        []v1.Container{{Name: "main", Image: "alpine:3"}},
+		},
+		SchedulingResourceRequirements: &v1.ResourceRequirements{
+			Requests: v1.ResourceList{
+				"memory": resource.MustParse("1Gi"),
+				"cpu":    resource.MustParse("1"),
+			},
+		},
+	},
+	JobRuns: []*api.JobRunDetails{
+		{
+			RunId:      "run-2",
+			JobId:      "job-1",
+			State:      api.JobRunState_RUN_STATE_FAILED,
+			Cluster:    "cluster-a",
+			Pool:       "cpu",
+			Node:       "node-2",
+			StartedTs:  protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC)),
+			FinishedTs: protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)),
+			ExitCode:   137,
+		},
+		{
+			RunId:   "run-1",
+			JobId:   "job-1",
+			State:   api.JobRunState_RUN_STATE_PREEMPTED,
+			Cluster: "cluster-a",
+			Pool:    "cpu",
+			Node:    "node-1",
+		},
+	},
+}
+
+func newTestJobApp(out *bytes.Buffer) *App {
+	a := New()
+	a.Out = out
+	a.Params.JobAPI.GetDetails = func(jobIds []string, expandJobSpec bool, expandJobRun bool) (map[string]*api.JobDetails, error) {
+		detailsById := make(map[string]*api.JobDetails)
+		for _, jobId := range jobIds {
+			if jobId == testJobDetails.JobId {
+				details := *testJobDetails
+				if !expandJobSpec {
+					details.JobSpec = nil
+				}
+				if !expandJobRun {
+					details.JobRuns = nil
+				}
+				detailsById[jobId] = &details
+			}
+		}
+		return detailsById, nil
+	}
+	a.Params.JobAPI.GetErrors = func(jobIds []string) (map[string]string, error) {
+		return map[string]string{testJobDetails.JobId: "OOMKilled\ncontainer main exceeded its memory limit"}, nil
+	}
+	return a
+}
+
+func TestGetJobs(t *testing.T) {
+	var out bytes.Buffer
+	a := newTestJobApp(&out)
+
+	err := a.GetJobs([]string{"job-1", "job-2"}, OutputTable)
+	assert.ErrorContains(t, err, "jobs not found: job-2")
+	assert.Equal(t,
+		"JOB ID  QUEUE    JOB SET  STATE   SUBMITTED             LAST TRANSITION       LATEST RUN\n"+
+			"job-1   queue-a  set-a    FAILED  2024-01-01T00:00:00Z  2024-01-01T00:05:00Z  run-2\n",
+		out.String(),
+	)
+
+	out.Reset()
+	require.NoError(t, a.GetJobs([]string{"job-1"}, OutputJson))
+	var jobs []map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &jobs))
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "job-1", jobs[0]["jobId"])
+	assert.Equal(t, "FAILED", jobs[0]["state"])
+	assert.Equal(t, "2024-01-01T00:00:00Z", jobs[0]["submittedTs"])
+}
+
+func TestDescribeJob(t *testing.T) {
+	var out bytes.Buffer
+	a := newTestJobApp(&out)
+
+	require.NoError(t, a.DescribeJob("job-1", OutputTable))
+	assert.Equal(t,
+		"Job ID:           job-1\n"+
+			"Queue:            queue-a\n"+
+			"Job Set:          set-a\n"+
+			"Namespace:        ns\n"+
+			"State:            FAILED\n"+
+			"Submitted:        2024-01-01T00:00:00Z\n"+
+			"Last Transition:  2024-01-01T00:05:00Z\n"+
+			"Priority:         1\n"+
+			"Priority Class:   armada-default\n"+
+			"Container main:   alpine:3\n"+
+			"Requests:         cpu=1, memory=1Gi\n"+
+			"Runs:\n"+
+			"  RUN ID  STATE      CLUSTER    POOL  NODE    LEASED  STARTED               FINISHED              EXIT CODE\n"+
+			"  run-2   FAILED     cluster-a  cpu   node-2          2024-01-01T00:02:00Z  2024-01-01T00:05:00Z  137\n"+
+			"  run-1   PREEMPTED  cluster-a  cpu   node-1                                                      \n"+
+			"Error:\n"+
+			"  OOMKilled\n"+
+			"  container main exceeded its memory limit\n",
+		out.String(),
+	)
+
+	out.Reset()
+	require.NoError(t, a.DescribeJob("job-1", OutputYaml))
+	var description struct {
+		Details struct {
+			JobRuns []struct {
+				Pool     string `json:"pool"`
+				ExitCode int32  `json:"exitCode"`
+			} `json:"jobRuns"`
+		} `json:"details"`
+		Error string `json:"error"`
+	}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &description))
+	require.Len(t, description.Details.JobRuns, 2)
+	assert.Equal(t, "cpu", description.Details.JobRuns[0].Pool)
+	assert.Equal(t, int32(137), description.Details.JobRuns[0].ExitCode)
+	assert.Equal(t, "OOMKilled\ncontainer main exceeded its memory limit", description.Error)
+
+	assert.ErrorContains(t, a.DescribeJob("job-2", OutputTable), "job not found")
+}
+
+func TestGetJobErrors(t *testing.T) {
+	var out bytes.Buffer
+	a := newTestJobApp(&out)
+
+	require.NoError(t, a.GetJobErrors([]string{"job-1"}, OutputTable))
+	assert.Equal(t,
+		"JOB ID  ERROR\n"+
+			"job-1   OOMKilled container main exceeded its memory limit\n",
+		out.String(),
+	)
+
+	out.Reset()
+	require.NoError(t, a.GetJobErrors([]string{"job-1"}, OutputJson))
+	var errorsById map[string]string
+	require.NoError(t, json.Unmarshal(out.Bytes(), &errorsById))
+	assert.Equal(t, map[string]string{"job-1": "OOMKilled\ncontainer main exceeded its memory limit"}, errorsById)
+}
@@ -0,0 +1,72 @@
+package armadactl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+const binocularsClusterPlaceholder = "{CLUSTER_ID}"
+
+// Logs prints the log of the latest run of a job.
+// The log is fetched from the Binoculars instance of the cluster the run was scheduled on.
+func (a *App) Logs(jobId string, container string, follow bool, timestamps bool) error {
+	if a.Params.ApiConnectionDetails.BinocularsUrlPattern == "" {
+		return fmt.Errorf("binocularsUrlPattern must be set in the armadactl config to fetch logs")
+	}
+
+	detailsById, err := a.Params.JobAPI.GetDetails([]string{jobId}, false, true)
+	if err != nil {
+		return fmt.Errorf("error getting details of job %s: %s", jobId, err)
+	}
+	jobDetails, ok := detailsById[jobId]
+	if !ok {
+		return fmt.Errorf("job %s not found", jobId)
+	}
+	cluster := ""
+	for _, run := range jobDetails.JobRuns {
+		if run.RunId == jobDetails.LatestRunId {
+			cluster = run.Cluster
+		}
+	}
+	if cluster == "" {
+		return fmt.Errorf("job %s has not been scheduled on a cluster yet", jobId)
+	}
+
+	binocularsConnectionDetails := *a.Params.ApiConnectionDetails
+	binocularsConnectionDetails.ArmadaUrl = strings.ReplaceAll(
+		a.Params.ApiConnectionDetails.BinocularsUrlPattern, binocularsClusterPlaceholder, cluster)
+	return client.WithConnection(&binocularsConnectionDetails, func(conn *grpc.ClientConn) error {
+		stream, err := binoculars.NewBinocularsClient(conn).StreamLogs(armadacontext.Background(), &binoculars.StreamLogsRequest{
+			JobId:        jobId,
+			RunId:        jobDetails.LatestRunId,
+			PodNamespace: jobDetails.Namespace,
+			Container:    container,
+			Follow:       follow,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching logs for job %s: %s", jobId, err)
+		}
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("error fetching logs for job %s: %s", jobId, err)
+			}
+			for _, line := range response.Log {
+				if timestamps {
+					fmt.Fprintf(a.Out, "%s %s\n", line.Timestamp, line.Line)
+				} else {
+					fmt.Fprintln(a.Out, line.Line)
+				}
+			}
+		}
+	})
+}
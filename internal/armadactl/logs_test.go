@@ -0,0 +1,50 @@
+package armadactl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+func TestLogs_FailsBeforeContactingBinoculars(t *testing.T) {
+	tests := map[string]struct {
+		jobId         string
+		latestRunId   string
+		expectedError string
+	}{
+		"job not found": {
+			jobId:         "missing-job",
+			latestRunId:   testJobDetails.LatestRunId,
+			expectedError: "job missing-job not found",
+		},
+		"job not scheduled": {
+			jobId:         testJobDetails.JobId,
+			expectedError: "job job-1 has not been scheduled on a cluster yet",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			a := New()
+			a.Out = &out
+			a.Params.ApiConnectionDetails = &client.ApiConnectionDetails{
+				BinocularsUrlPattern: "binoculars-" + binocularsClusterPlaceholder + ":50051",
+			}
+			a.Params.JobAPI.GetDetails = func(jobIds []string, expandJobSpec bool, expandJobRun bool) (map[string]*api.JobDetails, error) {
+				assert.Equal(t, []string{tc.jobId}, jobIds)
+				assert.True(t, expandJobRun)
+				details := *testJobDetails
+				details.LatestRunId = tc.latestRunId
+				return map[string]*api.JobDetails{details.JobId: &details}, nil
+			}
+
+			err := a.Logs(tc.jobId, "", false, false)
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Empty(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package armadactl
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	goslices "golang.org/x/exp/slices"
+)
+
+// Formats in which armadactl can print its output. Each command supports a subset of these.
+const (
+	// OutputText prints a human-readable report.
+	OutputText = "text"
+	// OutputTable prints a human-readable table.
+	OutputTable = "table"
+	// OutputJson prints the API objects as JSON.
+	OutputJson = "json"
+	// OutputYaml prints the API objects as YAML.
+	OutputYaml = "yaml"
+	// OutputCsv prints a table as CSV.
+	OutputCsv = "csv"
+)
+
+// ValidateOutput returns an error if output isn't one of the formats supported by a command.
+func ValidateOutput(output string, supported []string) error {
+	if !goslices.Contains(supported, output) {
+		return errors.Errorf("unsupported output format %q; must be one of %s", output, DescribeOutputs(supported))
+	}
+	return nil
+}
+
+// DescribeOutputs lists formats for use in help and error messages, e.g., "table, json or yaml".
+func DescribeOutputs(outputs []string) string {
+	if len(outputs) < 2 {
+		return strings.Join(outputs, "")
+	}
+	return strings.Join(outputs[:len(outputs)-1], ", ") + " or " + outputs[len(outputs)-1]
+}
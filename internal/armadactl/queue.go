@@ -2,6 +2,7 @@ package armadactl
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/armadaproject/armada/internal/common/slices"
@@ -131,6 +132,75 @@ func (a *App) GetAllQueues(args *QueueQueryArgs) error {
 	return nil
 }
 
+// GetQueueTree prints the queues matching args as a tree, in which each queue is nested under its parent.
+// Queues whose parent doesn't match args are shown at the top level.
+func (a *App) GetQueueTree(args *QueueQueryArgs) error {
+	queues, err := a.getAllQueuesAsAPIQueue(args)
+	if err != nil {
+		return errors.Errorf("error getting all queues: %s", err)
+	}
+	printQueueTree(a.Out, queues, "")
+	return nil
+}
+
+// GetQueueSubtree prints the queue with the given name, and all queues nested under it, as a tree.
+func (a *App) GetQueueSubtree(name string) error {
+	queues, err := a.Params.QueueAPI.GetAll()
+	if err != nil {
+		return errors.Errorf("[armadactl.GetQueueSubtree] error getting queues: %s", err)
+	}
+	if !goslices.ContainsFunc(queues, func(q *api.Queue) bool { return q.Name == name }) {
+		return errors.Errorf("[armadactl.GetQueueSubtree] error getting queue %s: queue not found", name)
+	}
+	printQueueTree(a.Out, queues, name)
+	return nil
+}
+
+// printQueueTree prints queues as a tree, rooted at root if non-empty and at all top-level queues otherwise.
+func printQueueTree(w io.Writer, queues []*api.Queue, root string) {
+	queueNames := make(map[string]bool, len(queues))
+	for _, q := range queues {
+		queueNames[q.Name] = true
+	}
+	var roots []string
+	childrenByQueue := make(map[string][]string)
+	for _, q := range queues {
+		if q.Parent != "" && queueNames[q.Parent] {
+			childrenByQueue[q.Parent] = append(childrenByQueue[q.Parent], q.Name)
+		} else {
+			roots = append(roots, q.Name)
+		}
+	}
+	for _, children := range childrenByQueue {
+		goslices.Sort(children)
+	}
+	goslices.Sort(roots)
+	if root != "" {
+		roots = []string{root}
+	}
+
+	seen := make(map[string]bool)
+	var printSubtree func(name string, prefix string, childPrefix string)
+	printSubtree = func(name string, prefix string, childPrefix string) {
+		fmt.Fprintf(w, "%s%s\n", prefix, name)
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		children := childrenByQueue[name]
+		for i, child := range children {
+			if i == len(children)-1 {
+				printSubtree(child, childPrefix+"└── ", childPrefix+"    ")
+			} else {
+				printSubtree(child, childPrefix+"├── ", childPrefix+"│   ")
+			}
+		}
+	}
+	for _, name := range roots {
+		printSubtree(name, "", "")
+	}
+}
+
 func headerYaml() string {
 	b, err := yaml.Marshal(client.Resource{
 		Version: client.APIVersionV1,
@@ -1,6 +1,7 @@
 package armadactl
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -506,3 +507,42 @@ func TestQueueFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueTree(t *testing.T) {
+	queues := []*api.Queue{
+		{Name: "user-b", Parent: "project"},
+		{Name: "team"},
+		{Name: "project", Parent: "team"},
+		{Name: "user-a", Parent: "project"},
+		{Name: "other"},
+		{Name: "orphan", Parent: "deleted"},
+	}
+	tests := map[string]struct {
+		root     string
+		expected string
+	}{
+		"all queues": {
+			expected: `orphan
+other
+team
+└── project
+    ├── user-a
+    └── user-b
+`,
+		},
+		"subtree": {
+			root: "project",
+			expected: `project
+├── user-a
+└── user-b
+`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var sb strings.Builder
+			printQueueTree(&sb, queues, tc.root)
+			assert.Equal(t, tc.expected, sb.String())
+		})
+	}
+}
@@ -66,6 +66,32 @@ func (a *App) ReprioritizeJob(queue string, jobSet string, jobId string, priorit
 	})
 }
 
+// ReprioritizeJobArray sets the priority of all jobs in the job array identified by arrayId to priorityFactor
+func (a *App) ReprioritizeJobArray(queue string, jobSet string, arrayId string, priorityFactor float64) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		req := api.JobReprioritizeRequest{
+			Queue:       queue,
+			JobSetId:    jobSet,
+			ArrayId:     arrayId,
+			NewPriority: priorityFactor,
+		}
+		result, err := c.ReprioritizeJobs(ctx, &req)
+		if err != nil {
+			return errors.WithMessagef(err, "error reprioritising jobs matching array ID: %s\n", arrayId)
+		}
+
+		err = a.writeResults(result.ReprioritizationResults)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
 func (a *App) writeResults(results map[string]string) error {
 	if len(results) == 0 {
 		return errors.Errorf("no jobs were reprioritized")
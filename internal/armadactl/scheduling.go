@@ -3,12 +3,19 @@ package armadactl
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/client"
 )
 
-func (a *App) executeGetSchedulingReport(request *schedulerobjects.SchedulingReportRequest) error {
+// ReportOutputs are the formats scheduling reports can be printed in; the first is the default.
+var ReportOutputs = []string{OutputText, OutputJson}
+
+func (a *App) executeGetSchedulingReport(request *schedulerobjects.SchedulingReportRequest, output string) error {
 	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
 		ctx, cancel := common.ContextWithDefaultTimeout()
 		defer cancel()
@@ -16,6 +23,9 @@ func (a *App) executeGetSchedulingReport(request *schedulerobjects.SchedulingRep
 		if err != nil {
 			return err
 		}
+		if output == OutputJson {
+			return a.printReportJson(&schedulerobjects.SchedulingReport{Pools: report.Pools})
+		}
 		fmt.Fprint(a.Out, report.Report)
 		return nil
 	})
@@ -32,6 +42,7 @@ func (a *App) GetSchedulingReportForQueue(queueName string, verbosity int32) err
 
 			Verbosity: verbosity,
 		},
+		OutputText,
 	)
 }
 
@@ -46,18 +57,20 @@ func (a *App) GetSchedulingReportForJob(jobId string, verbosity int32) error {
 
 			Verbosity: verbosity,
 		},
+		OutputText,
 	)
 }
 
-func (a *App) GetSchedulingReport(verbosity int32) error {
+func (a *App) GetSchedulingReport(verbosity int32, output string) error {
 	return a.executeGetSchedulingReport(
 		&schedulerobjects.SchedulingReportRequest{
 			Verbosity: verbosity,
 		},
+		output,
 	)
 }
 
-func (a *App) GetQueueSchedulingReport(queueName string, verbosity int32) error {
+func (a *App) GetQueueSchedulingReport(queueName string, verbosity int32, output string) error {
 	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
 		ctx, cancel := common.ContextWithDefaultTimeout()
 		defer cancel()
@@ -65,12 +78,15 @@ func (a *App) GetQueueSchedulingReport(queueName string, verbosity int32) error
 		if err != nil {
 			return err
 		}
+		if output == OutputJson {
+			return a.printReportJson(&schedulerobjects.QueueReport{Pools: report.Pools})
+		}
 		fmt.Fprint(a.Out, report.Report)
 		return nil
 	})
 }
 
-func (a *App) GetJobSchedulingReport(jobId string) error {
+func (a *App) GetJobSchedulingReport(jobId string, output string) error {
 	return client.WithSchedulerReportingClient(a.Params.ApiConnectionDetails, func(c schedulerobjects.SchedulerReportingClient) error {
 		ctx, cancel := common.ContextWithDefaultTimeout()
 		defer cancel()
@@ -78,7 +94,20 @@ func (a *App) GetJobSchedulingReport(jobId string) error {
 		if err != nil {
 			return err
 		}
+		if output == OutputJson {
+			return a.printReportJson(&schedulerobjects.JobReport{Pools: report.Pools})
+		}
 		fmt.Fprint(a.Out, report.Report)
 		return nil
 	})
 }
+
+// printReportJson prints the structured part of a report as indented JSON.
+func (a *App) printReportJson(report proto.Message) error {
+	marshaler := jsonpb.Marshaler{Indent: "  "}
+	if err := marshaler.Marshal(a.Out, report); err != nil {
+		return errors.WithStack(err)
+	}
+	fmt.Fprintln(a.Out)
+	return nil
+}
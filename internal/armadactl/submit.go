@@ -50,6 +50,11 @@ func (a *App) Submit(path string, dryRun bool) error {
 			for _, jobResponseItem := range response.JobResponseItems {
 				if jobResponseItem.Error != "" {
 					fmt.Fprintf(a.Out, "Error submitting job: %s\n", jobResponseItem.Error)
+				} else if len(jobResponseItem.ArrayJobIds) > 0 {
+					fmt.Fprintf(
+						a.Out, "Submitted job array with id %s and %d jobs to job set %s\n",
+						jobResponseItem.JobId, len(jobResponseItem.ArrayJobIds), request.JobSetId,
+					)
 				} else {
 					fmt.Fprintf(a.Out, "Submitted job with id %s to job set %s\n", jobResponseItem.JobId, request.JobSetId)
 				}
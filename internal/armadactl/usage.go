@@ -0,0 +1,78 @@
+package armadactl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	goslices "golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// UsageOutputs are the formats GetUsage can print in; the first is the default.
+var UsageOutputs = []string{OutputTable, OutputJson, OutputYaml, OutputCsv}
+
+// GetUsage prints the resources allocated to queues per pool and day between startDate and endDate, inclusive.
+// Dates are formatted as YYYY-MM-DD in UTC. Empty queues or pools select all queues or pools.
+func (a *App) GetUsage(startDate string, endDate string, queues []string, pools []string, output string) error {
+	response, err := a.Params.UsageAPI.Get(&api.UsageRequest{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Queues:    queues,
+		Pools:     pools,
+	})
+	if err != nil {
+		return errors.Errorf("[armadactl.GetUsage] error getting usage: %s", err)
+	}
+
+	switch output {
+	case OutputTable:
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "DATE\tQUEUE\tPOOL\tRESOURCE\tRESOURCE HOURS")
+		for _, usage := range response.Usage {
+			for _, resource := range sortedResources(usage) {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					usage.Date, usage.Queue, usage.Pool, resource, formatResourceHours(usage.ResourceHours[resource]))
+			}
+		}
+		return errors.WithStack(w.Flush())
+	case OutputCsv:
+		w := csv.NewWriter(a.Out)
+		if err := w.Write([]string{"date", "queue", "pool", "resource", "resource_hours"}); err != nil {
+			return errors.WithStack(err)
+		}
+		for _, usage := range response.Usage {
+			for _, resource := range sortedResources(usage) {
+				record := []string{usage.Date, usage.Queue, usage.Pool, resource, formatResourceHours(usage.ResourceHours[resource])}
+				if err := w.Write(record); err != nil {
+					return errors.WithStack(err)
+				}
+			}
+		}
+		w.Flush()
+		return errors.WithStack(w.Error())
+	default:
+		marshalledUsage := make([]json.RawMessage, len(response.Usage))
+		for i, usage := range response.Usage {
+			if marshalledUsage[i], err = marshalProto(usage); err != nil {
+				return err
+			}
+		}
+		return a.printStructured(marshalledUsage, output)
+	}
+}
+
+func sortedResources(usage *api.QueueUsage) []string {
+	resources := maps.Keys(usage.ResourceHours)
+	goslices.Sort(resources)
+	return resources
+}
+
+func formatResourceHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', -1, 64)
+}
@@ -0,0 +1,61 @@
+package armadactl
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func newTestUsageApp(out *bytes.Buffer, request **api.UsageRequest) *App {
+	a := New()
+	a.Out = out
+	a.Params.UsageAPI.Get = func(r *api.UsageRequest) (*api.UsageResponse, error) {
+		*request = r
+		return &api.UsageResponse{
+			Usage: []*api.QueueUsage{
+				{Queue: "queue-a", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"memory": 1073741824, "cpu": 1.5}},
+				{Queue: "queue-b", Pool: "gpu", Date: "2024-03-02", ResourceHours: map[string]float64{"nvidia.com/gpu": 8}},
+			},
+		}, nil
+	}
+	return a
+}
+
+func TestGetUsage(t *testing.T) {
+	var out bytes.Buffer
+	var request *api.UsageRequest
+	a := newTestUsageApp(&out, &request)
+
+	require.NoError(t, a.GetUsage("2024-03-01", "2024-03-31", []string{"queue-a", "queue-b"}, nil, OutputTable))
+	assert.Equal(t, &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31", Queues: []string{"queue-a", "queue-b"}}, request)
+	assert.Equal(t,
+		"DATE        QUEUE    POOL  RESOURCE        RESOURCE HOURS\n"+
+			"2024-03-01  queue-a  cpu   cpu             1.5\n"+
+			"2024-03-01  queue-a  cpu   memory          1073741824\n"+
+			"2024-03-02  queue-b  gpu   nvidia.com/gpu  8\n",
+		out.String(),
+	)
+
+	out.Reset()
+	require.NoError(t, a.GetUsage("2024-03-01", "2024-03-31", nil, nil, OutputCsv))
+	assert.Equal(t,
+		"date,queue,pool,resource,resource_hours\n"+
+			"2024-03-01,queue-a,cpu,cpu,1.5\n"+
+			"2024-03-01,queue-a,cpu,memory,1073741824\n"+
+			"2024-03-02,queue-b,gpu,nvidia.com/gpu,8\n",
+		out.String(),
+	)
+
+	out.Reset()
+	require.NoError(t, a.GetUsage("2024-03-01", "2024-03-31", nil, []string{"gpu"}, OutputJson))
+	var usage []map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &usage))
+	require.Len(t, usage, 2)
+	assert.Equal(t, "queue-b", usage[1]["queue"])
+	assert.Equal(t, map[string]any{"nvidia.com/gpu": float64(8)}, usage[1]["resourceHours"])
+}
@@ -3,6 +3,7 @@ package configuration
 import (
 	"github.com/armadaproject/armada/internal/common/auth/configuration"
 	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
+	logarchiveconfig "github.com/armadaproject/armada/internal/common/logarchive/configuration"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 )
 
@@ -20,6 +21,8 @@ type BinocularsConfig struct {
 	Grpc             grpcconfig.GrpcConfig
 	ImpersonateUsers bool
 	Kubernetes       KubernetesConfiguration
+	// If configured, logs of deleted pods are read from the archive executors upload them to.
+	LogArchive logarchiveconfig.LogArchiveConfig
 }
 
 type KubernetesConfiguration struct {
@@ -10,6 +10,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/cluster"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
+	"github.com/armadaproject/armada/internal/common/logarchive"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api/binoculars"
 )
@@ -42,7 +43,16 @@ func StartUp(config *configuration.BinocularsConfig) (func(), *sync.WaitGroup) {
 		config.Auth.PermissionClaimMapping,
 	)
 
-	logService := service.NewKubernetesLogService(kubernetesClientProvider)
+	var logArchive logarchive.Archive
+	if config.LogArchive.Enabled() {
+		logArchive, err = logarchive.New(config.LogArchive)
+		if err != nil {
+			log.Errorf("Failed to create log archive %s", err)
+			os.Exit(-1)
+		}
+	}
+
+	logService := service.NewKubernetesLogService(kubernetesClientProvider, logArchive)
 	cordonService := service.NewKubernetesCordonService(config.Cordon, permissionsChecker, kubernetesClientProvider)
 	binocularsServer := server.NewBinocularsServer(logService, cordonService)
 	binoculars.RegisterBinocularsServer(grpcServer, binocularsServer)
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gogo/protobuf/types"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/armadaproject/armada/internal/binoculars/service"
 	"github.com/armadaproject/armada/internal/common"
@@ -30,6 +31,8 @@ func (b *BinocularsServer) Logs(ctx context.Context, request *binoculars.LogRequ
 
 	logLines, err := b.logService.GetLogs(armadacontext.FromGrpcCtx(ctx), &service.LogParams{
 		Principal:  principal,
+		JobId:      request.JobId,
+		RunId:      request.RunId,
 		Namespace:  request.PodNamespace,
 		PodName:    common.PodNamePrefix + request.JobId + "-" + strconv.Itoa(int(request.PodNumber)),
 		SinceTime:  request.SinceTime,
@@ -42,6 +45,25 @@ func (b *BinocularsServer) Logs(ctx context.Context, request *binoculars.LogRequ
 	return &binoculars.LogResponse{Log: logLines}, nil
 }
 
+func (b *BinocularsServer) StreamLogs(request *binoculars.StreamLogsRequest, stream binoculars.Binoculars_StreamLogsServer) error {
+	principal := auth.GetPrincipal(stream.Context())
+
+	return b.logService.StreamLogs(armadacontext.FromGrpcCtx(stream.Context()), &service.LogParams{
+		Principal: principal,
+		JobId:     request.JobId,
+		RunId:     request.RunId,
+		Namespace: request.PodNamespace,
+		PodName:   common.PodNamePrefix + request.JobId + "-" + strconv.Itoa(int(request.PodNumber)),
+		SinceTime: request.SinceTime,
+		LogOptions: &v1.PodLogOptions{
+			Container: request.Container,
+			Follow:    request.Follow,
+		},
+		Offset:     request.Offset,
+		LimitBytes: request.LimitBytes,
+	}, stream.Send)
+}
+
 func (b *BinocularsServer) Cordon(ctx context.Context, request *binoculars.CordonRequest) (*types.Empty, error) {
 	err := b.cordonService.CordonNode(armadacontext.FromGrpcCtx(ctx), request)
 	if err != nil {
@@ -1,43 +1,63 @@
 package service
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/cluster"
+	"github.com/armadaproject/armada/internal/common/logarchive"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api/binoculars"
 )
 
 type LogService interface {
 	GetLogs(ctx *armadacontext.Context, params *LogParams) ([]*binoculars.LogLine, error)
+	StreamLogs(ctx *armadacontext.Context, params *LogParams, send func(*binoculars.StreamLogsResponse) error) error
 }
 
 type LogParams struct {
-	Principal  auth.Principal
+	Principal auth.Principal
+	JobId     string
+	// RunId selects the archived log to return once the pod has been deleted. If empty, the latest archived run is used.
+	RunId      string
 	Namespace  string
 	PodName    string
 	SinceTime  string
 	LogOptions *v1.PodLogOptions
+	// Offset and LimitBytes are only used when streaming logs.
+	Offset     int64
+	LimitBytes int64
 }
 
 type KubernetesLogService struct {
 	clientProvider cluster.KubernetesClientProvider
+	// If set, logs are read from logArchive once the pod has been deleted.
+	logArchive logarchive.Archive
 }
 
-const MaxLogBytes = 2000000
+const (
+	MaxLogBytes = 2000000
+	// Maximum number of log lines sent in a single StreamLogsResponse.
+	MaxStreamedLogLines = 1000
+)
 
-func NewKubernetesLogService(clientProvider cluster.KubernetesClientProvider) *KubernetesLogService {
-	return &KubernetesLogService{clientProvider: clientProvider}
+func NewKubernetesLogService(clientProvider cluster.KubernetesClientProvider, logArchive logarchive.Archive) *KubernetesLogService {
+	return &KubernetesLogService{clientProvider: clientProvider, logArchive: logArchive}
 }
 
 func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogParams) ([]*binoculars.LogLine, error) {
@@ -46,14 +66,7 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 		return nil, err
 	}
 
-	since, err := time.Parse(time.RFC3339Nano, params.SinceTime)
-	if err == nil {
-		params.LogOptions.SinceTime = &metav1.Time{Time: since}
-	} else {
-		if params.SinceTime != "" {
-			log.Warnf("failed to parse since time for pod %s: %v", params.PodName, err)
-		}
-	}
+	setSinceTime(params)
 
 	limitBytes := int64(MaxLogBytes)
 	params.LogOptions.Follow = false
@@ -70,10 +83,10 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 
 	result := req.Do(ctx)
 	if err := result.Error(); err != nil {
-		if errors.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "The pod with these logs doesn't exist - this is likely because the job has finished and the pod has been cleaned up")
+		if apierrors.IsNotFound(err) && l.logArchive != nil {
+			return l.getArchivedLogs(ctx, client, params)
 		}
-		return nil, err
+		return nil, podLogsError(err)
 	}
 
 	rawLog, err := result.Raw()
@@ -93,6 +106,255 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 	return logLines, nil
 }
 
+// StreamLogs sends the log of a pod, starting params.Offset bytes into the log, in batches of at most MaxStreamedLogLines lines.
+// If params.LogOptions.Follow is set, lines are sent as they are written until the container exits or ctx is cancelled.
+func (l *KubernetesLogService) StreamLogs(ctx *armadacontext.Context, params *LogParams, send func(*binoculars.StreamLogsResponse) error) error {
+	client, err := l.clientProvider.ClientForUser(params.Principal.GetName(), params.Principal.GetGroupNames())
+	if err != nil {
+		return err
+	}
+
+	setSinceTime(params)
+
+	limitBytes := params.LimitBytes
+	if limitBytes <= 0 && !params.LogOptions.Follow {
+		limitBytes = MaxLogBytes
+	}
+	params.LogOptions.Timestamps = true
+	params.LogOptions.LimitBytes = nil
+
+	if params.Namespace == "" {
+		params.Namespace = "default"
+	}
+
+	stream, err := client.CoreV1().
+		Pods(params.Namespace).
+		GetLogs(params.PodName, params.LogOptions).
+		Stream(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) && l.logArchive != nil {
+			return l.streamArchivedLogs(ctx, client, params, limitBytes, send)
+		}
+		return podLogsError(err)
+	}
+	defer stream.Close()
+
+	return streamLogLines(stream, params.Offset, limitBytes, send, func(err error) {
+		log.Errorf(
+			"failed to parse log line for namespace: %q, pod: %q: %v",
+			params.Namespace,
+			params.PodName,
+			err)
+	})
+}
+
+func (l *KubernetesLogService) getArchivedLogs(ctx *armadacontext.Context, client kubernetes.Interface, params *LogParams) ([]*binoculars.LogLine, error) {
+	var logLines []*binoculars.LogLine
+	err := l.streamArchivedLogs(ctx, client, params, MaxLogBytes, func(response *binoculars.StreamLogsResponse) error {
+		logLines = append(logLines, response.Log...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logLines, nil
+}
+
+// streamArchivedLogs sends the archived log of a job run whose pod has been deleted.
+// Since the pod no longer exists, the principal must be allowed to read the logs of pods in the namespace the log was
+// archived from; the namespace given in params isn't trusted.
+func (l *KubernetesLogService) streamArchivedLogs(
+	ctx *armadacontext.Context,
+	client kubernetes.Interface,
+	params *LogParams,
+	limitBytes int64,
+	send func(*binoculars.StreamLogsResponse) error,
+) error {
+	key, err := l.archivedLogKey(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   key.Namespace,
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !review.Status.Allowed {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to read pod logs in namespace %s", params.Principal.GetName(), key.Namespace)
+	}
+
+	archivedLog, err := l.logArchive.Get(ctx, key)
+	if errors.Is(err, logarchive.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "The pod with these logs doesn't exist and no logs of container %s of run %s have been archived", key.Container, key.RunId)
+	} else if err != nil {
+		return err
+	}
+	defer archivedLog.Close()
+
+	var reader io.Reader = archivedLog
+	if params.LogOptions.SinceTime != nil {
+		if reader, err = skipLinesBefore(archivedLog, params.LogOptions.SinceTime.Time); err != nil {
+			return err
+		}
+	}
+	return streamLogLines(reader, params.Offset, limitBytes, send, func(err error) {
+		log.Errorf("failed to parse archived log line of %s: %v", key, err)
+	})
+}
+
+// archivedLogKey returns the key of the archived log requested by params, as stored in the archive.
+// The container may be omitted if the run has a single container.
+func (l *KubernetesLogService) archivedLogKey(ctx *armadacontext.Context, params *LogParams) (logarchive.LogKey, error) {
+	runId := params.RunId
+	var runLogs []logarchive.ArchivedLog
+	if runId == "" {
+		latestRunLogs, err := logarchive.LatestRun(ctx, l.logArchive, params.JobId)
+		if errors.Is(err, logarchive.ErrNotFound) {
+			return logarchive.LogKey{}, status.Errorf(codes.NotFound, "The pod with these logs doesn't exist and no logs of job %s have been archived", params.JobId)
+		} else if err != nil {
+			return logarchive.LogKey{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		runId = latestRunLogs[0].Key.RunId
+		runLogs = latestRunLogs
+	} else {
+		jobLogs, err := l.logArchive.List(ctx, params.JobId)
+		if err != nil {
+			return logarchive.LogKey{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		for _, jobLog := range jobLogs {
+			if jobLog.Key.RunId == runId {
+				runLogs = append(runLogs, jobLog)
+			}
+		}
+	}
+
+	if container := params.LogOptions.Container; container != "" {
+		for _, runLog := range runLogs {
+			if runLog.Key.Container == container {
+				return runLog.Key, nil
+			}
+		}
+		return logarchive.LogKey{}, status.Errorf(
+			codes.NotFound, "The pod with these logs doesn't exist and no logs of container %s of run %s have been archived", container, runId)
+	}
+	if len(runLogs) == 0 {
+		return logarchive.LogKey{}, status.Errorf(codes.NotFound, "The pod with these logs doesn't exist and no logs of run %s have been archived", runId)
+	}
+	if len(runLogs) > 1 {
+		containers := make([]string, len(runLogs))
+		for i, runLog := range runLogs {
+			containers[i] = runLog.Key.Container
+		}
+		return logarchive.LogKey{}, status.Errorf(
+			codes.InvalidArgument, "a container must be specified, since logs of containers %s are archived", strings.Join(containers, ", "))
+	}
+	return runLogs[0].Key, nil
+}
+
+// skipLinesBefore returns a reader over r skipping the leading log lines timestamped before since.
+// Log lines are assumed to be in timestamp order.
+func skipLinesBefore(r io.Reader, since time.Time) (io.Reader, error) {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		timestamp, _, _ := strings.Cut(string(line), " ")
+		if t, parseErr := time.Parse(time.RFC3339Nano, timestamp); parseErr != nil || !t.Before(since) {
+			return io.MultiReader(bytes.NewReader(line), reader), nil
+		}
+		if err == io.EOF {
+			return reader, nil
+		}
+	}
+}
+
+// streamLogLines reads raw log lines from r and sends those starting at or after offset.
+// Reading stops once limitBytes bytes have been sent, unless limitBytes is zero.
+// A batch is sent whenever it's full or no more data is immediately available, so followed logs are sent promptly.
+func streamLogLines(
+	r io.Reader,
+	offset int64,
+	limitBytes int64,
+	send func(*binoculars.StreamLogsResponse) error,
+	onParseError func(error),
+) error {
+	reader := bufio.NewReader(r)
+	position := int64(0)
+	sentBytes := int64(0)
+	var batch []*binoculars.LogLine
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := send(&binoculars.StreamLogsResponse{Log: batch, NextOffset: position})
+		batch = nil
+		return err
+	}
+
+	for {
+		rawLine, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		if len(rawLine) > 0 {
+			lineBytes := int64(len(rawLine))
+			if position >= offset {
+				// Always send at least one line, so callers paging through the log make progress.
+				if limitBytes > 0 && sentBytes > 0 && sentBytes+lineBytes > limitBytes {
+					break
+				}
+				sentBytes += lineBytes
+				if line := strings.TrimSuffix(rawLine, "\n"); line != "" {
+					if logLine, err := splitLine(line); err != nil {
+						onParseError(err)
+					} else {
+						batch = append(batch, logLine)
+					}
+				}
+			}
+			position += lineBytes
+		}
+		if readErr == io.EOF || (limitBytes > 0 && sentBytes >= limitBytes) {
+			break
+		}
+		if len(batch) >= MaxStreamedLogLines || reader.Buffered() == 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+func setSinceTime(params *LogParams) {
+	since, err := time.Parse(time.RFC3339Nano, params.SinceTime)
+	if err == nil {
+		params.LogOptions.SinceTime = &metav1.Time{Time: since}
+	} else {
+		if params.SinceTime != "" {
+			log.Warnf("failed to parse since time for pod %s: %v", params.PodName, err)
+		}
+	}
+}
+
+func podLogsError(err error) error {
+	if apierrors.IsNotFound(err) {
+		return status.Error(codes.NotFound, "The pod with these logs doesn't exist - this is likely because the job has finished and the pod has been cleaned up")
+	}
+	return err
+}
+
 func ConvertLogs(rawLog []byte) ([]*binoculars.LogLine, []error) {
 	lines := strings.Split(string(rawLog), "\n")
 	// If log is larger than MAX_PAYLOAD_SIZE, discard last lines until it is smaller or equal to MAX_PAYLOAD_SIZE
@@ -6,6 +6,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/logarchive"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
 )
 
 func TestConvertLogs_ReturnsLogLineWithTime(t *testing.T) {
@@ -106,3 +119,216 @@ func TestConvertLogs_LargerThanMaxBytesTruncatesLogs(t *testing.T) {
 	assert.Len(t, logLines, nLines, fmt.Sprintf("should be %d, is %d", nLines, len(logLines)))
 	assert.Len(t, errs, 0)
 }
+
+func TestStreamLogLines(t *testing.T) {
+	rawLog := "2022-02-08T11:32:21.183268868Z first\n" +
+		"2022-02-08T11:32:22.183268868Z second\n" +
+		"not a log line\n" +
+		"2022-02-08T11:32:23.183268868Z third\n" +
+		"2022-02-08T11:32:24.183268868Z fourth"
+	lineLength := int64(len("2022-02-08T11:32:21.183268868Z first\n"))
+
+	tests := map[string]struct {
+		offset             int64
+		limitBytes         int64
+		expectedLines      []string
+		expectedNextOffset int64
+		expectedParseErrs  int
+	}{
+		"whole log": {
+			expectedLines:      []string{"first", "second", "third", "fourth"},
+			expectedNextOffset: int64(len(rawLog)),
+			expectedParseErrs:  1,
+		},
+		"from offset": {
+			offset:             lineLength,
+			expectedLines:      []string{"second", "third", "fourth"},
+			expectedNextOffset: int64(len(rawLog)),
+			expectedParseErrs:  1,
+		},
+		"offset within a line skips that line": {
+			offset:             lineLength - 1,
+			expectedLines:      []string{"second", "third", "fourth"},
+			expectedNextOffset: int64(len(rawLog)),
+			expectedParseErrs:  1,
+		},
+		"limited": {
+			limitBytes:         2*lineLength + 1,
+			expectedLines:      []string{"first", "second"},
+			expectedNextOffset: 2*lineLength + 1,
+		},
+		"limit smaller than first line": {
+			limitBytes:         1,
+			expectedLines:      []string{"first"},
+			expectedNextOffset: lineLength,
+		},
+		"offset past end": {
+			offset: int64(len(rawLog)) + 10,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lines []string
+			var nextOffset int64
+			parseErrs := 0
+			err := streamLogLines(
+				strings.NewReader(rawLog),
+				tc.offset,
+				tc.limitBytes,
+				func(response *binoculars.StreamLogsResponse) error {
+					for _, line := range response.Log {
+						lines = append(lines, line.Line)
+					}
+					nextOffset = response.NextOffset
+					return nil
+				},
+				func(error) { parseErrs++ },
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLines, lines)
+			assert.Equal(t, tc.expectedNextOffset, nextOffset)
+			assert.Equal(t, tc.expectedParseErrs, parseErrs)
+		})
+	}
+}
+
+func TestStreamLogLines_Batches(t *testing.T) {
+	line := "2022-02-08T11:32:21.183268868Z hello\n"
+	nLines := MaxStreamedLogLines + 10
+	var batchSizes []int
+	err := streamLogLines(
+		strings.NewReader(strings.Repeat(line, nLines)),
+		0,
+		0,
+		func(response *binoculars.StreamLogsResponse) error {
+			batchSizes = append(batchSizes, len(response.Log))
+			return nil
+		},
+		func(error) {},
+	)
+	require.NoError(t, err)
+	for _, size := range batchSizes {
+		assert.LessOrEqual(t, size, MaxStreamedLogLines)
+	}
+	total := 0
+	for _, size := range batchSizes {
+		total += size
+	}
+	assert.Equal(t, nLines, total)
+}
+
+func TestStreamArchivedLogs(t *testing.T) {
+	archive, err := logarchive.NewFilesystemArchive(t.TempDir())
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+	put := func(runId string, container string, log string) {
+		key := logarchive.LogKey{JobId: "job", RunId: runId, Namespace: "team-a", Container: container}
+		require.NoError(t, archive.Put(ctx, key, strings.NewReader(log)))
+	}
+	put("run-1", "main", "2022-02-08T11:32:21.183268868Z run-1\n")
+	put("run-2", "main", "2022-02-08T11:32:21.183268868Z before\n2022-02-08T11:32:23.183268868Z after\n")
+	put("run-2", "sidecar", "2022-02-08T11:32:21.183268868Z sidecar\n")
+
+	tests := map[string]struct {
+		runId         string
+		container     string
+		sinceTime     string
+		allowed       bool
+		expectedLines []string
+		expectedCode  codes.Code
+	}{
+		"specific run": {
+			runId:         "run-1",
+			allowed:       true,
+			expectedLines: []string{"run-1"},
+		},
+		"container": {
+			runId:         "run-2",
+			container:     "sidecar",
+			allowed:       true,
+			expectedLines: []string{"sidecar"},
+		},
+		"since time": {
+			runId:         "run-2",
+			container:     "main",
+			sinceTime:     "2022-02-08T11:32:22Z",
+			allowed:       true,
+			expectedLines: []string{"after"},
+		},
+		"ambiguous container": {
+			runId:        "run-2",
+			allowed:      true,
+			expectedCode: codes.InvalidArgument,
+		},
+		"missing container": {
+			runId:        "run-1",
+			container:    "sidecar",
+			allowed:      true,
+			expectedCode: codes.NotFound,
+		},
+		"not allowed": {
+			runId:        "run-1",
+			allowed:      false,
+			expectedCode: codes.PermissionDenied,
+		},
+		"missing run": {
+			runId:        "run-3",
+			allowed:      true,
+			expectedCode: codes.NotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				assert.Equal(t, "log", review.Spec.ResourceAttributes.Subresource)
+				// Access is checked against the namespace the log was archived from, not the one requested.
+				assert.Equal(t, "team-a", review.Spec.ResourceAttributes.Namespace)
+				review.Status.Allowed = tc.allowed
+				return true, review, nil
+			})
+			logService := NewKubernetesLogService(&FakeClientProvider{FakeClient: client}, archive)
+			params := &LogParams{
+				Principal:  auth.NewStaticPrincipal("alice", "test", nil),
+				JobId:      "job",
+				RunId:      tc.runId,
+				Namespace:  "default",
+				SinceTime:  tc.sinceTime,
+				LogOptions: &v1.PodLogOptions{Container: tc.container},
+			}
+			setSinceTime(params)
+
+			var lines []string
+			err := logService.streamArchivedLogs(ctx, client, params, 0, func(response *binoculars.StreamLogsResponse) error {
+				for _, line := range response.Log {
+					lines = append(lines, line.Line)
+				}
+				return nil
+			})
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLines, lines)
+		})
+	}
+}
+
+func TestArchivedLogKey_LatestRun(t *testing.T) {
+	archive, err := logarchive.NewFilesystemArchive(t.TempDir())
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+	logService := NewKubernetesLogService(&FakeClientProvider{}, archive)
+	params := &LogParams{JobId: "job", LogOptions: &v1.PodLogOptions{}}
+
+	_, err = logService.archivedLogKey(ctx, params)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	key := logarchive.LogKey{JobId: "job", RunId: "run-1", Namespace: "default", Container: "main"}
+	require.NoError(t, archive.Put(ctx, key, strings.NewReader("")))
+	actual, err := logService.archivedLogKey(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, key, actual)
+}
@@ -52,7 +52,11 @@ type LDAPConfig struct {
 	Username        string
 	Password        string
 	GroupSearchBase string
-	CacheExpiry     time.Duration
+	// Used to look up the groups of users whose tickets don't include a PAC.
+	UserSearchBase string
+	// The attribute of user entries holding their Kerberos principal; defaults to krbPrincipalName.
+	PrincipalAttribute string
+	CacheExpiry        time.Duration
 }
 
 type KubernetesAuthConfig struct {
@@ -0,0 +1,328 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/service"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+const KerberosAuthServiceName = "Kerberos"
+
+// GroupResolver resolves the groups of the user a Kerberos ticket was issued to.
+type GroupResolver interface {
+	// GroupNames maps the group SIDs found in a ticket's PAC, as issued by Active Directory, to group names.
+	GroupNames(sids []string) ([]string, error)
+	// PrincipalGroupNames returns the names of the groups principal, e.g., alice@EXAMPLE.COM, is a member of.
+	// It's used for tickets without a PAC, such as those issued by an MIT KDC.
+	PrincipalGroupNames(principal string) ([]string, error)
+}
+
+// KerberosAuthService authenticates SPNEGO tokens sent in a "Negotiate" authorization header.
+// Tokens are validated against the service keys stored in a keytab.
+// If a GroupResolver is provided, the group SIDs found in the ticket's PAC are resolved to group names;
+// for tickets without a PAC, the groups of the ticket's client principal are looked up instead.
+type KerberosAuthService struct {
+	settings        *service.Settings
+	userNameSuffix  string
+	groupNameSuffix string
+	groupResolver   GroupResolver
+}
+
+func NewKerberosAuthService(config *configuration.KerberosAuthenticationConfig) (*KerberosAuthService, error) {
+	kt, err := keytab.Load(config.KeytabLocation)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error loading keytab %s", config.KeytabLocation)
+	}
+	var groupResolver GroupResolver
+	if config.LDAP.URL != "" {
+		groupResolver = NewLDAPGroupResolver(config.LDAP)
+	}
+	return NewKerberosAuthServiceWithKeytab(kt, config, groupResolver), nil
+}
+
+func NewKerberosAuthServiceWithKeytab(
+	kt *keytab.Keytab,
+	config *configuration.KerberosAuthenticationConfig,
+	groupResolver GroupResolver,
+) *KerberosAuthService {
+	var settings []func(*service.Settings)
+	if config.PrincipalName != "" {
+		settings = append(settings, service.KeytabPrincipal(config.PrincipalName))
+	}
+	return &KerberosAuthService{
+		settings:        service.NewSettings(kt, settings...),
+		userNameSuffix:  config.UserNameSuffix,
+		groupNameSuffix: config.GroupNameSuffix,
+		groupResolver:   groupResolver,
+	}
+}
+
+func (authService *KerberosAuthService) Authenticate(_ context.Context, authHeader string) (Principal, error) {
+	authHeaderSplits := strings.SplitN(authHeader, " ", 2)
+	if len(authHeaderSplits) < 2 || !strings.EqualFold(authHeaderSplits[0], "negotiate") {
+		return nil, &armadaerrors.ErrMissingCredentials{
+			AuthService: KerberosAuthServiceName,
+			Message:     "negotiate auth header not found",
+		}
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(authHeaderSplits[1])
+	if err != nil {
+		return nil, authService.invalidCredentials("", err.Error())
+	}
+	var token spnego.SPNEGOToken
+	if err := token.Unmarshal(payload); err != nil {
+		return nil, authService.invalidCredentials("", err.Error())
+	}
+	if !token.Init {
+		return nil, authService.invalidCredentials("", "expected a negotiation init token")
+	}
+	var krb5Token spnego.KRB5Token
+	if err := krb5Token.Unmarshal(token.NegTokenInit.MechTokenBytes); err != nil {
+		return nil, authService.invalidCredentials("", err.Error())
+	}
+	if !krb5Token.IsAPReq() {
+		return nil, authService.invalidCredentials("", "expected a kerberos AP_REQ")
+	}
+
+	ok, creds, err := service.VerifyAPREQ(&krb5Token.APReq, authService.settings)
+	if err != nil || !ok {
+		message := "ticket could not be verified"
+		if err != nil {
+			message = err.Error()
+		}
+		return nil, authService.invalidCredentials(krb5Token.APReq.Authenticator.CName.PrincipalNameString(), message)
+	}
+
+	groups := []string{}
+	if authService.groupResolver != nil {
+		groupNames, err := authService.resolveGroupNames(creds)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error resolving groups of %s", creds.UserName())
+		}
+		for _, groupName := range groupNames {
+			groups = append(groups, groupName+authService.groupNameSuffix)
+		}
+	}
+	return NewStaticPrincipal(creds.UserName()+authService.userNameSuffix, KerberosAuthServiceName, groups), nil
+}
+
+func (authService *KerberosAuthService) resolveGroupNames(creds *credentials.Credentials) ([]string, error) {
+	if _, hasPAC := creds.Attributes()[credentials.AttributeKeyADCredentials]; !hasPAC {
+		return authService.groupResolver.PrincipalGroupNames(creds.UserName() + "@" + creds.Realm())
+	}
+	if sids := creds.GetADCredentials().GroupMembershipSIDs; len(sids) > 0 {
+		return authService.groupResolver.GroupNames(sids)
+	}
+	return nil, nil
+}
+
+func (authService *KerberosAuthService) invalidCredentials(username string, message string) error {
+	return &armadaerrors.ErrInvalidCredentials{
+		Username:    username,
+		AuthService: KerberosAuthServiceName,
+		Message:     message,
+	}
+}
+
+// LDAPConnection is the subset of *ldap.Conn used to resolve groups.
+type LDAPConnection interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// LDAPGroupResolver resolves group SIDs by searching for groups with a matching objectSid under GroupSearchBase.
+// Principals are resolved by searching for the user with a matching PrincipalAttribute under UserSearchBase,
+// and taking the groups listed in the user's memberOf attribute or, if there are none, the groups under GroupSearchBase listing the user as a member.
+// Results, including SIDs and principals with no matching group, are cached for CacheExpiry.
+type LDAPGroupResolver struct {
+	config configuration.LDAPConfig
+	dial   func(url string) (LDAPConnection, error)
+	cache  *cache.Cache
+}
+
+func NewLDAPGroupResolver(config configuration.LDAPConfig) *LDAPGroupResolver {
+	return NewLDAPGroupResolverWithDialer(config, func(url string) (LDAPConnection, error) {
+		return ldap.DialURL(url)
+	})
+}
+
+func NewLDAPGroupResolverWithDialer(config configuration.LDAPConfig, dial func(url string) (LDAPConnection, error)) *LDAPGroupResolver {
+	return &LDAPGroupResolver{
+		config: config,
+		dial:   dial,
+		cache:  cache.New(config.CacheExpiry, config.CacheExpiry),
+	}
+}
+
+// principalCacheKeyPrefix distinguishes cached principals from cached SIDs.
+const principalCacheKeyPrefix = "principal:"
+
+func (r *LDAPGroupResolver) GroupNames(sids []string) ([]string, error) {
+	groupNames := make([]string, 0, len(sids))
+	var uncachedSids []string
+	for _, sid := range sids {
+		if cached, ok := r.cache.Get(sid); ok {
+			if groupName := cached.(string); groupName != "" {
+				groupNames = append(groupNames, groupName)
+			}
+		} else {
+			uncachedSids = append(uncachedSids, sid)
+		}
+	}
+	if len(uncachedSids) == 0 {
+		return groupNames, nil
+	}
+
+	conn, err := r.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	for _, sid := range uncachedSids {
+		groupName, err := r.searchGroupName(conn, sid)
+		if err != nil {
+			return nil, err
+		}
+		r.cache.SetDefault(sid, groupName)
+		if groupName != "" {
+			groupNames = append(groupNames, groupName)
+		}
+	}
+	return groupNames, nil
+}
+
+func (r *LDAPGroupResolver) PrincipalGroupNames(principal string) ([]string, error) {
+	if cached, ok := r.cache.Get(principalCacheKeyPrefix + principal); ok {
+		return cached.([]string), nil
+	}
+	conn, err := r.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	groupNames, err := r.searchPrincipalGroupNames(conn, principal)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.SetDefault(principalCacheKeyPrefix+principal, groupNames)
+	return groupNames, nil
+}
+
+func (r *LDAPGroupResolver) connect() (LDAPConnection, error) {
+	conn, err := r.dial(r.config.URL)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error connecting to %s", r.config.URL)
+	}
+	if err := conn.Bind(r.config.Username, r.config.Password); err != nil {
+		conn.Close()
+		return nil, errors.WithMessagef(err, "error binding to %s as %s", r.config.URL, r.config.Username)
+	}
+	return conn, nil
+}
+
+// searchPrincipalGroupNames returns the common names of the groups the user with the given principal is a member of,
+// or an empty slice if there is no such user.
+func (r *LDAPGroupResolver) searchPrincipalGroupNames(conn LDAPConnection, principal string) ([]string, error) {
+	principalAttribute := r.config.PrincipalAttribute
+	if principalAttribute == "" {
+		principalAttribute = "krbPrincipalName"
+	}
+	userResult, err := conn.Search(ldap.NewSearchRequest(
+		r.config.UserSearchBase,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		0,
+		0,
+		false,
+		fmt.Sprintf("(%s=%s)", principalAttribute, ldap.EscapeFilter(principal)),
+		[]string{"memberOf"},
+		nil,
+	))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error searching for user %s", principal)
+	}
+	if len(userResult.Entries) == 0 {
+		return []string{}, nil
+	}
+	user := userResult.Entries[0]
+
+	if memberOf := user.GetAttributeValues("memberOf"); len(memberOf) > 0 {
+		groupNames := make([]string, 0, len(memberOf))
+		for _, groupDN := range memberOf {
+			if groupName := commonName(groupDN); groupName != "" {
+				groupNames = append(groupNames, groupName)
+			}
+		}
+		return groupNames, nil
+	}
+
+	groupResult, err := conn.Search(ldap.NewSearchRequest(
+		r.config.GroupSearchBase,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		0,
+		0,
+		false,
+		fmt.Sprintf("(member=%s)", ldap.EscapeFilter(user.DN)),
+		[]string{"cn"},
+		nil,
+	))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error searching for groups of %s", user.DN)
+	}
+	groupNames := make([]string, 0, len(groupResult.Entries))
+	for _, entry := range groupResult.Entries {
+		groupNames = append(groupNames, entry.GetAttributeValue("cn"))
+	}
+	return groupNames, nil
+}
+
+// commonName returns the value of the cn attribute of the first RDN of dn, or an empty string if there's none.
+func commonName(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return ""
+	}
+	for _, attribute := range parsed.RDNs[0].Attributes {
+		if strings.EqualFold(attribute.Type, "cn") {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
+// searchGroupName returns the common name of the group with the given SID, or an empty string if there is no such group.
+func (r *LDAPGroupResolver) searchGroupName(conn LDAPConnection, sid string) (string, error) {
+	result, err := conn.Search(ldap.NewSearchRequest(
+		r.config.GroupSearchBase,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		0,
+		0,
+		false,
+		fmt.Sprintf("(&(objectClass=group)(objectSid=%s))", ldap.EscapeFilter(sid)),
+		[]string{"cn"},
+		nil,
+	))
+	if err != nil {
+		return "", errors.WithMessagef(err, "error searching for group %s", sid)
+	}
+	if len(result.Entries) == 0 {
+		return "", nil
+	}
+	return result.Entries[0].GetAttributeValue("cn"), nil
+}
@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/jcmturner/gokrb5/v8/client"
+	krb5config "github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/jcmturner/gokrb5/v8/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+const (
+	testRealm            = "EXAMPLE.COM"
+	testServicePrincipal = "HTTP/armada.example.com"
+)
+
+func TestKerberosAuthService(t *testing.T) {
+	serviceKeytab := testKeytab(t, testServicePrincipal, "service-password")
+	authService := NewKerberosAuthServiceWithKeytab(serviceKeytab, &configuration.KerberosAuthenticationConfig{
+		PrincipalName:  testServicePrincipal,
+		UserNameSuffix: "@example.com",
+	}, nil)
+
+	principal, err := authService.Authenticate(context.Background(), testNegotiateHeader(t, "alice", serviceKeytab))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", principal.GetName())
+	assert.Equal(t, KerberosAuthServiceName, principal.GetAuthMethod())
+	assert.Equal(t, []string{EveryoneGroup}, principal.GetGroupNames())
+
+	// A ticket encrypted with a key the service doesn't know must be rejected.
+	otherKeytab := testKeytab(t, testServicePrincipal, "other-password")
+	_, err = authService.Authenticate(context.Background(), testNegotiateHeader(t, "alice", otherKeytab))
+	var invalidCredsErr *armadaerrors.ErrInvalidCredentials
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	_, err = authService.Authenticate(context.Background(), "Negotiate bm90IGEgdG9rZW4=")
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	_, err = authService.Authenticate(context.Background(), "Basic cm9vdDp0b29y")
+	var missingCredsErr *armadaerrors.ErrMissingCredentials
+	assert.ErrorAs(t, err, &missingCredsErr)
+}
+
+func TestKerberosAuthService_ResolvesGroupsOfPrincipalWithoutPAC(t *testing.T) {
+	serviceKeytab := testKeytab(t, testServicePrincipal, "service-password")
+	resolver := &fakeGroupResolver{groupsByPrincipal: map[string][]string{"alice@" + testRealm: {"armada-users"}}}
+	authService := NewKerberosAuthServiceWithKeytab(serviceKeytab, &configuration.KerberosAuthenticationConfig{
+		PrincipalName:   testServicePrincipal,
+		GroupNameSuffix: "@example.com",
+	}, resolver)
+
+	principal, err := authService.Authenticate(context.Background(), testNegotiateHeader(t, "alice", serviceKeytab))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", principal.GetName())
+	assert.ElementsMatch(t, []string{EveryoneGroup, "armada-users@example.com"}, principal.GetGroupNames())
+}
+
+func TestLDAPGroupResolver(t *testing.T) {
+	conn := &fakeLDAPConnection{
+		groupsBySid: map[string]string{
+			"S-1-5-21-1-1001": "armada-users",
+			"S-1-5-21-1-1002": "armada-admins",
+		},
+	}
+	dials := 0
+	resolver := NewLDAPGroupResolverWithDialer(configuration.LDAPConfig{
+		URL:             "ldap://ldap.example.com",
+		Username:        "cn=armada",
+		Password:        "secret",
+		GroupSearchBase: "ou=groups,dc=example,dc=com",
+		CacheExpiry:     time.Minute,
+	}, func(url string) (LDAPConnection, error) {
+		dials++
+		return conn, nil
+	})
+
+	groups, err := resolver.GroupNames([]string{"S-1-5-21-1-1001", "S-1-5-21-1-1002", "S-1-5-21-1-1003"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"armada-users", "armada-admins"}, groups)
+	assert.Equal(t, "cn=armada", conn.boundAs)
+	assert.Equal(t, 3, conn.searches)
+
+	// All SIDs, including the unknown one, are now cached.
+	groups, err = resolver.GroupNames([]string{"S-1-5-21-1-1003", "S-1-5-21-1-1002"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"armada-admins"}, groups)
+	assert.Equal(t, 1, dials)
+	assert.Equal(t, 3, conn.searches)
+}
+
+func TestLDAPGroupResolver_PrincipalGroupNames(t *testing.T) {
+	conn := &fakeLDAPConnection{
+		users: []fakeLDAPUser{
+			{
+				dn:        "uid=alice,ou=users,dc=example,dc=com",
+				principal: "alice@EXAMPLE.COM",
+				memberOf:  []string{"cn=armada-users,ou=groups,dc=example,dc=com", "cn=armada-admins,ou=groups,dc=example,dc=com"},
+			},
+			{
+				dn:        "uid=bob,ou=users,dc=example,dc=com",
+				principal: "bob@EXAMPLE.COM",
+			},
+		},
+		groupMembers: map[string][]string{
+			"armada-users": {"uid=bob,ou=users,dc=example,dc=com"},
+		},
+	}
+	dials := 0
+	resolver := NewLDAPGroupResolverWithDialer(configuration.LDAPConfig{
+		URL:             "ldap://ldap.example.com",
+		GroupSearchBase: "ou=groups,dc=example,dc=com",
+		UserSearchBase:  "ou=users,dc=example,dc=com",
+		CacheExpiry:     time.Minute,
+	}, func(url string) (LDAPConnection, error) {
+		dials++
+		return conn, nil
+	})
+
+	// Groups are taken from memberOf if the user has it, and otherwise found by searching for groups with the user as a member.
+	groups, err := resolver.PrincipalGroupNames("alice@EXAMPLE.COM")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"armada-users", "armada-admins"}, groups)
+	groups, err = resolver.PrincipalGroupNames("bob@EXAMPLE.COM")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"armada-users"}, groups)
+	groups, err = resolver.PrincipalGroupNames("carol@EXAMPLE.COM")
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+	assert.Equal(t, 3, dials)
+
+	groups, err = resolver.PrincipalGroupNames("alice@EXAMPLE.COM")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"armada-users", "armada-admins"}, groups)
+	assert.Equal(t, 3, dials)
+}
+
+// testKeytab returns a keytab containing an aes256 key for principal derived from password.
+func testKeytab(t *testing.T, principal string, password string) *keytab.Keytab {
+	kt := keytab.New()
+	require.NoError(t, kt.AddEntry(principal, testRealm, password, time.Now(), 1, etypeID.AES256_CTS_HMAC_SHA1_96))
+	return kt
+}
+
+// testNegotiateHeader plays the role of the KDC by issuing a service ticket for username encrypted with the key in serviceKeytab,
+// and returns the authorization header a client would send with that ticket.
+func testNegotiateHeader(t *testing.T, username string, serviceKeytab *keytab.Keytab) string {
+	now := time.Now().UTC()
+	cname := types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, username)
+	sname := types.NewPrincipalName(nametype.KRB_NT_SRV_INST, testServicePrincipal)
+	ticket, sessionKey, err := messages.NewTicket(
+		cname, testRealm, sname, testRealm,
+		types.NewKrbFlags(), serviceKeytab, etypeID.AES256_CTS_HMAC_SHA1_96, 1,
+		now, now, now.Add(time.Hour), now.Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	cl := client.NewWithPassword(username, testRealm, "unused", krb5config.New())
+	negTokenInit, err := spnego.NewNegTokenInitKRB5(cl, ticket, sessionKey)
+	require.NoError(t, err)
+	token := spnego.SPNEGOToken{Init: true, NegTokenInit: negTokenInit}
+	payload, err := token.Marshal()
+	require.NoError(t, err)
+	return "Negotiate " + base64.StdEncoding.EncodeToString(payload)
+}
+
+// fakeGroupResolver resolves the groups of principals from a map.
+type fakeGroupResolver struct {
+	groupsByPrincipal map[string][]string
+}
+
+func (r *fakeGroupResolver) GroupNames(_ []string) ([]string, error) {
+	return nil, nil
+}
+
+func (r *fakeGroupResolver) PrincipalGroupNames(principal string) ([]string, error) {
+	return r.groupsByPrincipal[principal], nil
+}
+
+// fakeLDAPConnection stands in for a directory server holding groups keyed by SID,
+// users keyed by principal, and the members of groups keyed by the groups' common names.
+type fakeLDAPConnection struct {
+	groupsBySid  map[string]string
+	users        []fakeLDAPUser
+	groupMembers map[string][]string
+	boundAs      string
+	searches     int
+}
+
+type fakeLDAPUser struct {
+	dn        string
+	principal string
+	memberOf  []string
+}
+
+var (
+	objectSidFilter = regexp.MustCompile(`\(objectSid=([^)]*)\)`)
+	principalFilter = regexp.MustCompile(`^\(krbPrincipalName=([^)]*)\)$`)
+	memberFilter    = regexp.MustCompile(`^\(member=([^)]*)\)$`)
+)
+
+func (c *fakeLDAPConnection) Bind(username, _ string) error {
+	c.boundAs = username
+	return nil
+}
+
+func (c *fakeLDAPConnection) Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.searches++
+	result := &ldap.SearchResult{}
+	if match := principalFilter.FindStringSubmatch(searchRequest.Filter); len(match) == 2 {
+		for _, user := range c.users {
+			if user.principal == match[1] {
+				result.Entries = append(result.Entries, ldap.NewEntry(user.dn, map[string][]string{"memberOf": user.memberOf}))
+			}
+		}
+		return result, nil
+	}
+	if match := memberFilter.FindStringSubmatch(searchRequest.Filter); len(match) == 2 {
+		for groupName, members := range c.groupMembers {
+			if slices.Contains(members, match[1]) {
+				result.Entries = append(result.Entries, ldap.NewEntry(
+					"cn="+groupName+","+searchRequest.BaseDN,
+					map[string][]string{"cn": {groupName}},
+				))
+			}
+		}
+		return result, nil
+	}
+	match := objectSidFilter.FindStringSubmatch(searchRequest.Filter)
+	if len(match) < 2 {
+		return result, nil
+	}
+	if groupName, ok := c.groupsBySid[match[1]]; ok {
+		result.Entries = append(result.Entries, ldap.NewEntry(
+			"cn="+groupName+","+searchRequest.BaseDN,
+			map[string][]string{"cn": {groupName}},
+		))
+	}
+	return result, nil
+}
+
+func (c *fakeLDAPConnection) Close() error {
+	return nil
+}
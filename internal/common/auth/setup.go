@@ -29,6 +29,14 @@ func ConfigureAuth(config configuration.AuthConfig) ([]AuthService, error) {
 		authServices = append(authServices, openIdAuthService)
 	}
 
+	if config.Kerberos.KeytabLocation != "" {
+		kerberosAuthService, err := NewKerberosAuthService(&config.Kerberos)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error initialising kerberos auth")
+		}
+		authServices = append(authServices, kerberosAuthService)
+	}
+
 	if config.AnonymousAuth {
 		authServices = append(authServices, &AnonymousAuthService{})
 	}
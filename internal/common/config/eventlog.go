@@ -0,0 +1,22 @@
+package config
+
+import "time"
+
+// EventLogConfig configures the embedded event log, which can be used in place of Pulsar; e.g., for local development
+// or small deployments. If enabled, it must be enabled for every component, and every component must use the same
+// database.
+type EventLogConfig struct {
+	// If true, events are published to and consumed from the event log rather than Pulsar
+	Enabled bool
+	// Connection details of the Postgres database storing the event log. This database should be used by nothing else.
+	Connection map[string]string
+	// Number of partitions a topic is created with. Ignored for topics that already exist. Defaults to 16.
+	NumPartitions int `validate:"gte=0"`
+	// How long consumers wait before reading a partition again once they've consumed all of its events. Defaults to
+	// 100ms.
+	PollInterval time.Duration
+	// Maximum number of events consumers read from a partition at a time. Defaults to 1000.
+	FetchSize int `validate:"gte=0"`
+	// How often consumers delete the events of their topic that every subscription has acknowledged. Defaults to 1m.
+	PruneInterval time.Duration
+}
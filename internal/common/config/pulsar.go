@@ -43,4 +43,6 @@ type PulsarConfig struct {
 	BackoffTime time.Duration
 	// Number of pulsar messages that will be queued by the pulsar consumer.
 	ReceiverQueueSize int
+	// Embedded event log to publish and consume events with instead of Pulsar
+	EventLog EventLogConfig
 }
@@ -0,0 +1,261 @@
+package eventlog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/util"
+)
+
+// Consumer consumes a topic of the log for a subscription. It implements the parts of pulsar.Consumer used by the
+// ingestion pipeline.
+//
+// As with a Pulsar failover subscription, only one consumer of a subscription receives messages at a time; others wait
+// until it's closed. Acknowledgements are committed to the log periodically, so messages may be redelivered to the next
+// consumer if a consumer stops without being closed. If a consumer loses the lock of its subscription, e.g., because
+// its database connection is closed, it stops receiving messages and closes its channel, as another consumer may
+// then acquire the lock. While consuming, consumers periodically prune messages every subscription has acknowledged.
+type Consumer struct {
+	log          *Log
+	topic        string
+	subscription string
+	messages     chan pulsar.ConsumerMessage
+	// Offset of the latest acknowledged message of each partition, for partitions with acknowledgements not yet
+	// committed to the log.
+	acknowledged map[int]int64
+	mutex        sync.Mutex
+	cancel       func()
+	closing      chan struct{}
+	done         chan struct{}
+}
+
+// Subscribe returns a Consumer receiving messages of topic for subscription. Messages are received from the first the
+// subscription hasn't acknowledged, so from the earliest message of each partition if the subscription is new. Up to
+// receiverQueueSize messages are buffered.
+func (l *Log) Subscribe(ctx *armadacontext.Context, topic string, subscription string, receiverQueueSize int) (*Consumer, error) {
+	numPartitions, err := l.Partitions(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.Register(ctx, topic, subscription, numPartitions); err != nil {
+		return nil, err
+	}
+	ctx, cancel := armadacontext.WithCancel(ctx)
+	consumer := &Consumer{
+		log:          l,
+		topic:        topic,
+		subscription: subscription,
+		messages:     make(chan pulsar.ConsumerMessage, receiverQueueSize),
+		acknowledged: map[int]int64{},
+		cancel:       cancel,
+		closing:      make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	go consumer.run(ctx, numPartitions)
+	return consumer, nil
+}
+
+// Chan returns the channel messages are received on. It's closed once the consumer stops receiving messages.
+func (c *Consumer) Chan() <-chan pulsar.ConsumerMessage {
+	return c.messages
+}
+
+// AckID acknowledges the message with the given id, and all messages of the same partition before it.
+func (c *Consumer) AckID(id pulsar.MessageID) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.acknowledged[int(id.PartitionIdx())] = id.EntryID()
+	return nil
+}
+
+// Close stops receiving messages, commits all acknowledgements and releases the subscription. It must be called once
+// the consumer is no longer used, even if the context it was created with has been cancelled.
+func (c *Consumer) Close() {
+	c.cancel()
+	close(c.closing)
+	<-c.done
+}
+
+func (c *Consumer) run(ctx *armadacontext.Context, numPartitions int) {
+	defer close(c.done)
+
+	conn := c.lock(ctx)
+	if conn == nil {
+		close(c.messages)
+		return
+	}
+	defer c.unlock(conn)
+	log.Infof("%s - Consuming %d event log partitions for subscription %s", c.topic, numPartitions, c.subscription)
+
+	// Consuming stops if the lock is lost, as well as when ctx is cancelled.
+	ctx, stopConsuming := armadacontext.WithCancel(ctx)
+	defer stopConsuming()
+
+	wg := sync.WaitGroup{}
+	for partition := 0; partition < numPartitions; partition++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.consumePartition(ctx, partition)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		commitTicker := time.NewTicker(c.log.pollInterval)
+		defer commitTicker.Stop()
+		lockCheckTicker := time.NewTicker(c.log.lockCheckInterval)
+		defer lockCheckTicker.Stop()
+		pruneTicker := time.NewTicker(c.log.pruneInterval)
+		defer pruneTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-commitTicker.C:
+				c.commit(ctx)
+			case <-lockCheckTicker.C:
+				if err := c.checkLock(ctx, conn); err != nil && ctx.Err() == nil {
+					log.WithError(err).Errorf("%s - Lost the lock of event log subscription %s; stopping consuming", c.topic, c.subscription)
+					stopConsuming()
+					return
+				}
+			case <-pruneTicker.C:
+				if numPruned, err := c.log.Prune(ctx, c.topic); err != nil && ctx.Err() == nil {
+					log.WithError(err).Warnf("%s - Error pruning event log", c.topic)
+				} else if numPruned > 0 {
+					log.Infof("%s - Pruned %d acknowledged event log messages", c.topic, numPruned)
+				}
+			}
+		}
+	}()
+	wg.Wait()
+	close(c.messages)
+
+	// Messages already received may still be acknowledged until the consumer is closed, so the lock is held until then.
+	<-c.closing
+	c.commit(armadacontext.Background())
+}
+
+// lock blocks until the consumer holds the lock of its subscription, returning the connection holding it, or nil if
+// ctx is cancelled first.
+func (c *Consumer) lock(ctx *armadacontext.Context) *pgxpool.Conn {
+	var conn *pgxpool.Conn
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			var err error
+			conn, err = c.log.db.Acquire(ctx)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext($1))`, c.lockKey()); err != nil {
+				conn.Release()
+				conn = nil
+				return errors.WithStack(err)
+			}
+			return nil
+		},
+		func(err error) {
+			log.WithError(err).Warnf("%s - Error locking event log subscription %s; backing off for %s", c.topic, c.subscription, c.log.pollInterval)
+			time.Sleep(c.log.pollInterval)
+		},
+	)
+	return conn
+}
+
+// checkLock returns an error if conn no longer holds the lock of the subscription. The lock is released if the
+// connection's session ends, e.g., because the database restarted, in which case querying the connection fails.
+func (c *Consumer) checkLock(ctx *armadacontext.Context, conn *pgxpool.Conn) error {
+	// pg_advisory_lock with a single bigint key stores its high and low 32 bits in classid and objid.
+	var held bool
+	err := conn.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM pg_locks
+			WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND granted AND objsubid = 1
+				AND classid = ((key >> 32) & 4294967295)::oid AND objid = (key & 4294967295)::oid
+		)
+		FROM (SELECT hashtext($1)::bigint AS key) AS k`,
+		c.lockKey()).Scan(&held)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !held {
+		return errors.New("the subscription's lock is no longer held by this consumer's connection")
+	}
+	return nil
+}
+
+func (c *Consumer) unlock(conn *pgxpool.Conn) {
+	ctx := armadacontext.Background()
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, c.lockKey()); err != nil {
+		log.WithError(err).Warnf("%s - Error unlocking event log subscription %s; closing connection", c.topic, c.subscription)
+		// Closing the connection releases the lock.
+		_ = conn.Conn().Close(ctx)
+	}
+	conn.Release()
+}
+
+func (c *Consumer) lockKey() string {
+	return c.topic + "/" + c.subscription
+}
+
+func (c *Consumer) consumePartition(ctx *armadacontext.Context, partition int) {
+	var offset int64
+	util.RetryUntilSuccess(
+		ctx,
+		func() error {
+			var err error
+			offset, err = c.log.NextOffset(ctx, c.topic, c.subscription, partition)
+			return err
+		},
+		func(err error) {
+			log.WithError(err).Warnf("%s - Error reading offset of partition %d; backing off for %s", c.topic, partition, c.log.pollInterval)
+			time.Sleep(c.log.pollInterval)
+		},
+	)
+	for ctx.Err() == nil {
+		messages, err := c.log.Read(ctx, c.topic, partition, offset, c.log.fetchSize)
+		if err != nil && ctx.Err() == nil {
+			log.WithError(err).Warnf("%s - Error reading partition %d; backing off for %s", c.topic, partition, c.log.pollInterval)
+		}
+		for _, message := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			case c.messages <- pulsar.ConsumerMessage{Message: message}:
+				offset = message.offset + 1
+			}
+		}
+		if len(messages) < c.log.fetchSize {
+			select {
+			case <-ctx.Done():
+			case <-time.After(c.log.pollInterval):
+			}
+		}
+	}
+}
+
+// commit commits acknowledgements to the log. Acknowledgements that fail to be committed are retried next time.
+func (c *Consumer) commit(ctx *armadacontext.Context) {
+	c.mutex.Lock()
+	acknowledged := c.acknowledged
+	c.acknowledged = map[int]int64{}
+	c.mutex.Unlock()
+	for partition, offset := range acknowledged {
+		if err := c.log.Acknowledge(ctx, c.topic, c.subscription, partition, offset); err != nil {
+			log.WithError(err).Warnf("%s - Error committing acknowledgements of partition %d", c.topic, partition)
+			c.mutex.Lock()
+			if _, ok := c.acknowledged[partition]; !ok {
+				c.acknowledged[partition] = offset
+			}
+			c.mutex.Unlock()
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+const subscription = "ingester"
+
+func receive(t *testing.T, consumer *Consumer, n int) []pulsar.Message {
+	var messages []pulsar.Message
+	for len(messages) < n {
+		select {
+		case msg := <-consumer.Chan():
+			messages = append(messages, msg.Message)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for messages", "received %d of %d", len(messages), n)
+		}
+	}
+	return messages
+}
+
+func payloads(messages []pulsar.Message) []string {
+	result := make([]string, len(messages))
+	for i, message := range messages {
+		result[i] = string(message.Payload())
+	}
+	return result
+}
+
+func TestConsumer(t *testing.T) {
+	withTestLog(t, 1, func(ctx *armadacontext.Context, log *Log) {
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		for _, payload := range []string{"1", "2", "3", "4", "5"} {
+			require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte(payload)}))
+		}
+
+		// New subscriptions start from the earliest message, reading each partition in order.
+		consumer, err := log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		messages := receive(t, consumer, 3)
+		assert.Equal(t, []string{"1", "2", "3"}, payloads(messages))
+		assert.Equal(t, int32(0), messages[2].ID().PartitionIdx())
+		assert.Equal(t, int64(2), messages[2].ID().EntryID())
+		require.NoError(t, consumer.AckID(messages[1].ID()))
+		consumer.Close()
+
+		offset, err := log.NextOffset(ctx, topic, subscription, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), offset)
+
+		// Consumers of the same subscription resume after the last acknowledged message, and receive messages
+		// published while they're consuming.
+		consumer, err = log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		defer consumer.Close()
+		require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte("6")}))
+		assert.Equal(t, []string{"3", "4", "5", "6"}, payloads(receive(t, consumer, 4)))
+	})
+}
+
+func TestConsumer_OneConsumerPerSubscription(t *testing.T) {
+	withTestLog(t, 1, func(ctx *armadacontext.Context, log *Log) {
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte("1")}))
+
+		first, err := log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		messages := receive(t, first, 1)
+		require.NoError(t, first.AckID(messages[0].ID()))
+
+		second, err := log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		defer second.Close()
+		require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte("2")}))
+
+		// Only the first consumer receives messages until it's closed.
+		assert.Equal(t, []string{"2"}, payloads(receive(t, first, 1)))
+		select {
+		case <-second.Chan():
+			assert.Fail(t, "second consumer received a message while the first was consuming")
+		case <-time.After(100 * time.Millisecond):
+		}
+		first.Close()
+		assert.Equal(t, []string{"2"}, payloads(receive(t, second, 1)))
+	})
+}
+
+func TestConsumer_PrunesAcknowledgedMessages(t *testing.T) {
+	withTestLog(t, 1, func(ctx *armadacontext.Context, log *Log) {
+		log.pruneInterval = 10 * time.Millisecond
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		for _, payload := range []string{"1", "2", "3"} {
+			require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte(payload)}))
+		}
+
+		consumer, err := log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		defer consumer.Close()
+		messages := receive(t, consumer, 3)
+		require.NoError(t, consumer.AckID(messages[1].ID()))
+		assert.Eventually(t, func() bool {
+			remaining, err := log.Read(ctx, topic, 0, 0, 10)
+			return err == nil && len(remaining) == 1 && remaining[0].offset == 2
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestConsumer_StopsWhenLockLost(t *testing.T) {
+	withTestLog(t, 1, func(ctx *armadacontext.Context, log *Log) {
+		log.lockCheckInterval = 10 * time.Millisecond
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte("1")}))
+
+		consumer, err := log.Subscribe(ctx, topic, subscription, 10)
+		require.NoError(t, err)
+		defer consumer.Close()
+		receive(t, consumer, 1)
+
+		// Ending the session holding the lock releases it, so the consumer must stop.
+		_, err = log.db.Exec(ctx, `
+			SELECT pg_terminate_backend(pid) FROM pg_locks
+			WHERE locktype = 'advisory' AND pid <> pg_backend_pid()`)
+		require.NoError(t, err)
+		select {
+		case _, ok := <-consumer.Chan():
+			assert.False(t, ok)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "channel wasn't closed")
+		}
+	})
+}
+
+func TestConsumer_ClosesChannelWhenContextCancelled(t *testing.T) {
+	withTestLog(t, 2, func(ctx *armadacontext.Context, log *Log) {
+		consumerCtx, cancel := armadacontext.WithCancel(ctx)
+		consumer, err := log.Subscribe(consumerCtx, topic, subscription, 10)
+		require.NoError(t, err)
+		cancel()
+		select {
+		case _, ok := <-consumer.Chan():
+			assert.False(t, ok)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "channel wasn't closed")
+		}
+		consumer.Close()
+	})
+}
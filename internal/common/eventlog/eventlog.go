@@ -0,0 +1,264 @@
+// Package eventlog is an event log stored in Postgres, which Armada can publish events to and consume them from in
+// place of Pulsar. Like a partitioned Pulsar topic, each topic of the log is split into partitions; messages are routed
+// to a partition by the hash of their key, or explicitly, and are consumed from each partition in the order in which
+// they were published.
+package eventlog
+
+import (
+	"embed"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	"github.com/armadaproject/armada/internal/common/database"
+	psutils "github.com/armadaproject/armada/internal/common/pulsarutils/utils"
+	"github.com/armadaproject/armada/internal/server/configuration"
+)
+
+const (
+	defaultNumPartitions     = 16
+	defaultPollInterval      = 100 * time.Millisecond
+	defaultFetchSize         = 1000
+	defaultPruneInterval     = time.Minute
+	defaultLockCheckInterval = 5 * time.Second
+)
+
+//go:embed migrations/*.sql
+var fs embed.FS
+
+// Record is a message to be appended to the log.
+type Record struct {
+	Key        string
+	Payload    []byte
+	Properties map[string]string
+}
+
+// Log is a partitioned event log stored in Postgres.
+type Log struct {
+	db            *pgxpool.Pool
+	numPartitions int
+	pollInterval  time.Duration
+	fetchSize     int
+	pruneInterval time.Duration
+	// How often consumers check that they still hold the lock of their subscription.
+	lockCheckInterval time.Duration
+	clock             clock.Clock
+}
+
+// Open connects to and migrates the event log configured by config. The returned Log must be closed once it's no
+// longer used.
+func Open(ctx *armadacontext.Context, config commonconfig.EventLogConfig) (*Log, error) {
+	db, err := database.OpenPgxPool(configuration.PostgresConfig{Connection: config.Connection})
+	if err != nil {
+		return nil, errors.WithMessage(err, "error opening event log database")
+	}
+	if err := Migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, errors.WithMessage(err, "error migrating event log database")
+	}
+	return New(db, config), nil
+}
+
+// New returns a Log stored in db, which must already have been migrated.
+func New(db *pgxpool.Pool, config commonconfig.EventLogConfig) *Log {
+	log := &Log{
+		db:                db,
+		numPartitions:     config.NumPartitions,
+		pollInterval:      config.PollInterval,
+		fetchSize:         config.FetchSize,
+		pruneInterval:     config.PruneInterval,
+		lockCheckInterval: defaultLockCheckInterval,
+		clock:             clock.RealClock{},
+	}
+	if log.numPartitions == 0 {
+		log.numPartitions = defaultNumPartitions
+	}
+	if log.pollInterval == 0 {
+		log.pollInterval = defaultPollInterval
+	}
+	if log.fetchSize == 0 {
+		log.fetchSize = defaultFetchSize
+	}
+	if log.pruneInterval == 0 {
+		log.pruneInterval = defaultPruneInterval
+	}
+	return log
+}
+
+// Migrate applies the migrations of the event log database.
+func Migrate(ctx *armadacontext.Context, db database.Querier) error {
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	return database.UpdateDatabase(ctx, db, migrations)
+}
+
+func WithTestDb(action func(db *pgxpool.Pool) error) error {
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	return database.WithTestDb(migrations, action)
+}
+
+func (l *Log) Close() {
+	l.db.Close()
+}
+
+// Partitions returns the number of partitions of topic, creating the topic with the configured number of partitions
+// if it doesn't exist.
+func (l *Log) Partitions(ctx *armadacontext.Context, topic string) (int, error) {
+	_, err := l.db.Exec(ctx, `
+		WITH created AS (
+			INSERT INTO event_log_topic (name, num_partitions) VALUES ($1, $2)
+			ON CONFLICT (name) DO NOTHING
+			RETURNING name, num_partitions
+		)
+		INSERT INTO event_log_partition (topic, partition, next_offset)
+		SELECT name, generate_series(0, num_partitions - 1), 0 FROM created`,
+		topic, l.numPartitions)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	var numPartitions int
+	err = l.db.QueryRow(ctx, `SELECT num_partitions FROM event_log_topic WHERE name = $1`, topic).Scan(&numPartitions)
+	return numPartitions, errors.WithStack(err)
+}
+
+// Append appends records, in order, to a partition of topic. Either all records are appended or none are.
+func (l *Log) Append(ctx *armadacontext.Context, topic string, partition int, records ...*Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return pgx.BeginTxFunc(ctx, l.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// Locks the partition until the transaction ends, such that offsets are committed in the order they're given.
+		var nextOffset int64
+		err := tx.QueryRow(ctx, `
+			UPDATE event_log_partition SET next_offset = next_offset + $3
+			WHERE topic = $1 AND partition = $2
+			RETURNING next_offset`,
+			topic, partition, len(records)).Scan(&nextOffset)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.Errorf("partition %d of topic %s doesn't exist", partition, topic)
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+		offset := nextOffset - int64(len(records))
+		publishTime := l.clock.Now().UTC()
+		rows := make([][]any, len(records))
+		for i, record := range records {
+			properties := record.Properties
+			if properties == nil {
+				properties = map[string]string{}
+			}
+			rawProperties, err := json.Marshal(properties)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			rows[i] = []any{topic, partition, offset + int64(i), record.Key, record.Payload, rawProperties, publishTime}
+		}
+		_, err = tx.CopyFrom(
+			ctx,
+			pgx.Identifier{"event_log_message"},
+			[]string{"topic", "partition", "message_offset", "key", "payload", "properties", "publish_time"},
+			pgx.CopyFromRows(rows),
+		)
+		return errors.WithStack(err)
+	})
+}
+
+// Read returns up to limit messages of a partition of topic, in order, starting at fromOffset.
+func (l *Log) Read(ctx *armadacontext.Context, topic string, partition int, fromOffset int64, limit int) ([]*Message, error) {
+	rows, err := l.db.Query(ctx, `
+		SELECT message_offset, key, payload, properties, publish_time
+		FROM event_log_message
+		WHERE topic = $1 AND partition = $2 AND message_offset >= $3
+		ORDER BY message_offset
+		LIMIT $4`,
+		topic, partition, fromOffset, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+	var messages []*Message
+	for rows.Next() {
+		message := &Message{topic: topic, partition: partition}
+		var rawProperties []byte
+		if err := rows.Scan(&message.offset, &message.key, &message.payload, &rawProperties, &message.publishTime); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := json.Unmarshal(rawProperties, &message.properties); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		message.publishTime = message.publishTime.UTC()
+		messages = append(messages, message)
+	}
+	return messages, errors.WithStack(rows.Err())
+}
+
+// NextOffset returns the offset of the first message of a partition of topic that subscription hasn't acknowledged.
+func (l *Log) NextOffset(ctx *armadacontext.Context, topic string, subscription string, partition int) (int64, error) {
+	var offset int64
+	err := l.db.QueryRow(ctx, `
+		SELECT next_offset FROM event_log_subscription
+		WHERE topic = $1 AND subscription = $2 AND partition = $3`,
+		topic, subscription, partition).Scan(&offset)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return offset, errors.WithStack(err)
+}
+
+// Acknowledge records that subscription has consumed the messages of a partition of topic up to and including offset.
+func (l *Log) Acknowledge(ctx *armadacontext.Context, topic string, subscription string, partition int, offset int64) error {
+	_, err := l.db.Exec(ctx, `
+		INSERT INTO event_log_subscription (topic, subscription, partition, next_offset)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (topic, subscription, partition) DO UPDATE
+		SET next_offset = GREATEST(event_log_subscription.next_offset, EXCLUDED.next_offset)`,
+		topic, subscription, partition, offset+1)
+	return errors.WithStack(err)
+}
+
+// Register records subscription as a subscription of topic, which has numPartitions partitions, if it isn't already.
+// Messages aren't pruned until every registered subscription has acknowledged them.
+func (l *Log) Register(ctx *armadacontext.Context, topic string, subscription string, numPartitions int) error {
+	_, err := l.db.Exec(ctx, `
+		INSERT INTO event_log_subscription (topic, subscription, partition, next_offset)
+		SELECT $1, $2, generate_series(0, $3 - 1), 0
+		ON CONFLICT (topic, subscription, partition) DO NOTHING`,
+		topic, subscription, numPartitions)
+	return errors.WithStack(err)
+}
+
+// Prune deletes the messages of topic that every subscription of it has acknowledged, and returns the number of
+// messages deleted. Messages of partitions no subscription has registered for are kept.
+func (l *Log) Prune(ctx *armadacontext.Context, topic string) (int64, error) {
+	result, err := l.db.Exec(ctx, `
+		DELETE FROM event_log_message AS m
+		USING (
+			SELECT partition, MIN(next_offset) AS next_offset
+			FROM event_log_subscription
+			WHERE topic = $1
+			GROUP BY partition
+		) AS s
+		WHERE m.topic = $1 AND m.partition = s.partition AND m.message_offset < s.next_offset`,
+		topic)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return result.RowsAffected(), nil
+}
+
+// PartitionForKey returns the partition messages with the given key are routed to. As with Pulsar's default router,
+// messages with the same key always go to the same partition.
+func PartitionForKey(key string, numPartitions int) int {
+	return int(psutils.JavaStringHash(key) % uint32(numPartitions))
+}
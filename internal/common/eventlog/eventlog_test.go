@@ -0,0 +1,175 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+)
+
+const topic = "events"
+
+var baseTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func withTestLog(t *testing.T, numPartitions int, action func(ctx *armadacontext.Context, log *Log)) {
+	err := WithTestDb(func(db *pgxpool.Pool) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+		defer cancel()
+		log := New(db, commonconfig.EventLogConfig{
+			NumPartitions: numPartitions,
+			PollInterval:  10 * time.Millisecond,
+			FetchSize:     2,
+		})
+		log.clock = clock.NewFakeClock(baseTime)
+		action(ctx, log)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestNew_Defaults(t *testing.T) {
+	log := New(nil, commonconfig.EventLogConfig{})
+	assert.Equal(t, defaultNumPartitions, log.numPartitions)
+	assert.Equal(t, defaultPollInterval, log.pollInterval)
+	assert.Equal(t, defaultFetchSize, log.fetchSize)
+	assert.Equal(t, defaultPruneInterval, log.pruneInterval)
+}
+
+func TestPartitionForKey(t *testing.T) {
+	for _, key := range []string{"", "job-set-a", "job-set-b"} {
+		partition := PartitionForKey(key, 16)
+		assert.GreaterOrEqual(t, partition, 0)
+		assert.Less(t, partition, 16)
+		assert.Equal(t, partition, PartitionForKey(key, 16))
+	}
+	assert.Equal(t, 0, PartitionForKey("", 16))
+}
+
+func TestPartitions(t *testing.T) {
+	withTestLog(t, 4, func(ctx *armadacontext.Context, log *Log) {
+		numPartitions, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		assert.Equal(t, 4, numPartitions)
+
+		// Topics keep the number of partitions they were created with.
+		log.numPartitions = 8
+		numPartitions, err = log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		assert.Equal(t, 4, numPartitions)
+
+		numPartitions, err = log.Partitions(ctx, "other")
+		require.NoError(t, err)
+		assert.Equal(t, 8, numPartitions)
+	})
+}
+
+func TestAppendAndRead(t *testing.T) {
+	withTestLog(t, 2, func(ctx *armadacontext.Context, log *Log) {
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+
+		require.NoError(t, log.Append(ctx, topic, 1,
+			&Record{Key: "a", Payload: []byte("1")},
+			&Record{Key: "b", Payload: []byte("2"), Properties: map[string]string{"foo": "bar"}},
+		))
+		require.NoError(t, log.Append(ctx, topic, 1, &Record{Key: "a", Payload: []byte("3")}))
+		require.NoError(t, log.Append(ctx, topic, 0, &Record{Key: "c", Payload: []byte("4")}))
+
+		messages, err := log.Read(ctx, topic, 1, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*Message{
+			{topic: topic, partition: 1, offset: 0, key: "a", payload: []byte("1"), properties: map[string]string{}, publishTime: baseTime},
+			{topic: topic, partition: 1, offset: 1, key: "b", payload: []byte("2"), properties: map[string]string{"foo": "bar"}, publishTime: baseTime},
+			{topic: topic, partition: 1, offset: 2, key: "a", payload: []byte("3"), properties: map[string]string{}, publishTime: baseTime},
+		}, messages)
+
+		messages, err = log.Read(ctx, topic, 1, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, int64(1), messages[0].offset)
+
+		messages, err = log.Read(ctx, topic, 0, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, int64(0), messages[0].offset)
+		assert.Equal(t, "c", messages[0].Key())
+	})
+}
+
+func TestAppend_UnknownPartition(t *testing.T) {
+	withTestLog(t, 2, func(ctx *armadacontext.Context, log *Log) {
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		assert.Error(t, log.Append(ctx, topic, 2, &Record{Key: "a", Payload: []byte("1")}))
+		assert.Error(t, log.Append(ctx, "other", 0, &Record{Key: "a", Payload: []byte("1")}))
+	})
+}
+
+func TestPrune(t *testing.T) {
+	withTestLog(t, 2, func(ctx *armadacontext.Context, log *Log) {
+		_, err := log.Partitions(ctx, topic)
+		require.NoError(t, err)
+		for _, payload := range []string{"1", "2", "3"} {
+			require.NoError(t, log.Append(ctx, topic, 0, &Record{Payload: []byte(payload)}))
+			require.NoError(t, log.Append(ctx, topic, 1, &Record{Payload: []byte(payload)}))
+		}
+
+		// Nothing is pruned until a subscription has registered.
+		numPruned, err := log.Prune(ctx, topic)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), numPruned)
+
+		require.NoError(t, log.Register(ctx, topic, "a", 2))
+		require.NoError(t, log.Register(ctx, topic, "b", 2))
+		require.NoError(t, log.Acknowledge(ctx, topic, "a", 0, 2))
+		require.NoError(t, log.Acknowledge(ctx, topic, "b", 0, 0))
+		require.NoError(t, log.Acknowledge(ctx, topic, "a", 1, 1))
+
+		// Only messages acknowledged by both subscriptions are pruned.
+		numPruned, err = log.Prune(ctx, topic)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), numPruned)
+		messages, err := log.Read(ctx, topic, 0, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		assert.Equal(t, int64(1), messages[0].offset)
+		messages, err = log.Read(ctx, topic, 1, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, messages, 3)
+
+		// Registering again doesn't reset a subscription's offsets.
+		require.NoError(t, log.Register(ctx, topic, "a", 2))
+		offset, err := log.NextOffset(ctx, topic, "a", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), offset)
+	})
+}
+
+func TestAcknowledge(t *testing.T) {
+	withTestLog(t, 2, func(ctx *armadacontext.Context, log *Log) {
+		offset, err := log.NextOffset(ctx, topic, "subscription", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), offset)
+
+		require.NoError(t, log.Acknowledge(ctx, topic, "subscription", 0, 5))
+		offset, err = log.NextOffset(ctx, topic, "subscription", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), offset)
+
+		// Acknowledging an earlier message doesn't move the subscription back.
+		require.NoError(t, log.Acknowledge(ctx, topic, "subscription", 0, 2))
+		offset, err = log.NextOffset(ctx, topic, "subscription", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), offset)
+
+		offset, err = log.NextOffset(ctx, topic, "other", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), offset)
+	})
+}
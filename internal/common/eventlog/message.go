@@ -0,0 +1,88 @@
+package eventlog
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+)
+
+// Message is a message read from the log. It implements pulsar.Message, so it can be processed by code written for
+// messages consumed from Pulsar; its ID is made up of its partition and its offset, as the entry id.
+type Message struct {
+	topic       string
+	partition   int
+	offset      int64
+	key         string
+	payload     []byte
+	properties  map[string]string
+	publishTime time.Time
+}
+
+func (m *Message) Topic() string {
+	return m.topic
+}
+
+func (m *Message) ProducerName() string {
+	return ""
+}
+
+func (m *Message) Properties() map[string]string {
+	return m.properties
+}
+
+func (m *Message) Payload() []byte {
+	return m.payload
+}
+
+func (m *Message) ID() pulsar.MessageID {
+	return pulsar.NewMessageID(0, m.offset, -1, int32(m.partition))
+}
+
+func (m *Message) PublishTime() time.Time {
+	return m.publishTime
+}
+
+func (m *Message) EventTime() time.Time {
+	return time.Time{}
+}
+
+func (m *Message) Key() string {
+	return m.key
+}
+
+func (m *Message) OrderingKey() string {
+	return ""
+}
+
+func (m *Message) RedeliveryCount() uint32 {
+	return 0
+}
+
+func (m *Message) IsReplicated() bool {
+	return false
+}
+
+func (m *Message) GetReplicatedFrom() string {
+	return ""
+}
+
+func (m *Message) GetSchemaValue(_ interface{}) error {
+	return errors.New("event log messages have no schema")
+}
+
+func (m *Message) SchemaVersion() []byte {
+	return nil
+}
+
+func (m *Message) GetEncryptionContext() *pulsar.EncryptionContext {
+	return nil
+}
+
+func (m *Message) Index() *uint64 {
+	return nil
+}
+
+func (m *Message) BrokerPublishTime() *time.Time {
+	return nil
+}
@@ -0,0 +1,34 @@
+-- Tables are created only if they don't exist as every component migrates the event log when it starts.
+CREATE TABLE IF NOT EXISTS event_log_topic (
+    name           text    PRIMARY KEY,
+    num_partitions integer NOT NULL
+);
+
+-- The offset the next message published to each partition is given. Publishers lock the row of a partition until
+-- their messages are committed, so messages become visible in offset order.
+CREATE TABLE IF NOT EXISTS event_log_partition (
+    topic       text    NOT NULL,
+    partition   integer NOT NULL,
+    next_offset bigint  NOT NULL,
+    PRIMARY KEY (topic, partition)
+);
+
+CREATE TABLE IF NOT EXISTS event_log_message (
+    topic          text      NOT NULL,
+    partition      integer   NOT NULL,
+    message_offset bigint    NOT NULL,
+    key            text      NOT NULL,
+    payload        bytea     NOT NULL,
+    properties     jsonb     NOT NULL,
+    publish_time   timestamp NOT NULL,
+    PRIMARY KEY (topic, partition, message_offset)
+);
+
+-- The offset of the next message each subscription will consume from each partition.
+CREATE TABLE IF NOT EXISTS event_log_subscription (
+    topic        text    NOT NULL,
+    subscription text    NOT NULL,
+    partition    integer NOT NULL,
+    next_offset  bigint  NOT NULL,
+    PRIMARY KEY (topic, subscription, partition)
+);
@@ -0,0 +1,92 @@
+package eventlog
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	psutils "github.com/armadaproject/armada/internal/common/pulsarutils/utils"
+)
+
+// Publisher is an implementation of pulsarutils.Publisher that appends events to a topic of the log. Events are
+// routed to partitions by key, so events with the same key are consumed in the order in which they were published.
+type Publisher[T utils.ArmadaEvent] struct {
+	log           *Log
+	topic         string
+	numPartitions int
+	preProcessor  psutils.PreProcessor[T]
+	keyRetriever  psutils.KeyRetriever[T]
+}
+
+func NewPublisher[T utils.ArmadaEvent](
+	ctx *armadacontext.Context,
+	log *Log,
+	topic string,
+	preProcessor psutils.PreProcessor[T],
+	keyRetriever psutils.KeyRetriever[T],
+) (*Publisher[T], error) {
+	numPartitions, err := log.Partitions(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	return &Publisher[T]{
+		log:           log,
+		topic:         topic,
+		numPartitions: numPartitions,
+		preProcessor:  preProcessor,
+		keyRetriever:  keyRetriever,
+	}, nil
+}
+
+// PublishMessages appends events to the log, after pre-processing them. Events appended to the same partition are
+// appended atomically.
+func (p *Publisher[T]) PublishMessages(ctx *armadacontext.Context, events ...T) error {
+	processed, err := p.preProcessor(events)
+	if err != nil {
+		return err
+	}
+	recordsByPartition := make(map[int][]*Record)
+	for _, event := range processed {
+		record, err := p.toRecord(event)
+		if err != nil {
+			return err
+		}
+		partition := PartitionForKey(record.Key, p.numPartitions)
+		recordsByPartition[partition] = append(recordsByPartition[partition], record)
+	}
+	for partition, records := range recordsByPartition {
+		if err := p.log.Append(ctx, p.topic, partition, records...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishToPartition appends event to the given partition, regardless of its key.
+func (p *Publisher[T]) PublishToPartition(ctx *armadacontext.Context, partition int, event T) error {
+	record, err := p.toRecord(event)
+	if err != nil {
+		return err
+	}
+	return p.log.Append(ctx, p.topic, partition, record)
+}
+
+// NumPartitions returns the number of partitions of the topic events are published to.
+func (p *Publisher[T]) NumPartitions() int {
+	return p.numPartitions
+}
+
+// Close does nothing, as the log is owned by the caller.
+func (p *Publisher[T]) Close() {}
+
+func (p *Publisher[T]) toRecord(event T) (*Record, error) {
+	// Given we know ArmadaEvents only consist of generated proto Messages, all of which implement proto.Message
+	bytes, err := proto.Marshal(any(event).(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		Key:     p.keyRetriever(event),
+		Payload: bytes,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package eventlog
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/pulsarutils/jobsetevents"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func eventSequence(jobSet string, jobIds ...string) *armadaevents.EventSequence {
+	sequence := &armadaevents.EventSequence{Queue: "queue", JobSetName: jobSet}
+	for _, jobId := range jobIds {
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Event: &armadaevents.EventSequence_Event_CancelJob{CancelJob: &armadaevents.CancelJob{JobId: jobId}},
+		})
+	}
+	return sequence
+}
+
+func readSequences(t *testing.T, ctx *armadacontext.Context, log *Log, partition int) []*armadaevents.EventSequence {
+	messages, err := log.Read(ctx, topic, partition, 0, 100)
+	require.NoError(t, err)
+	var sequences []*armadaevents.EventSequence
+	for _, message := range messages {
+		sequence := &armadaevents.EventSequence{}
+		require.NoError(t, proto.Unmarshal(message.Payload(), sequence))
+		sequences = append(sequences, sequence)
+	}
+	return sequences
+}
+
+func TestPublisher_PublishMessages(t *testing.T) {
+	withTestLog(t, 4, func(ctx *armadacontext.Context, log *Log) {
+		publisher, err := NewPublisher[*armadaevents.EventSequence](
+			ctx,
+			log,
+			topic,
+			jobsetevents.NewPreProcessor(1, 1024*1024),
+			jobsetevents.RetrieveKey,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 4, publisher.NumPartitions())
+
+		require.NoError(t, publisher.PublishMessages(ctx, eventSequence("job-set-a", "1", "2"), eventSequence("job-set-b", "3")))
+		require.NoError(t, publisher.PublishMessages(ctx, eventSequence("job-set-a", "4")))
+
+		// The pre-processor splits sequences into one per event; those of a job set are on its partition, in order.
+		partitionA := PartitionForKey("job-set-a", 4)
+		var jobIdsA []string
+		for _, sequence := range readSequences(t, ctx, log, partitionA) {
+			if sequence.JobSetName == "job-set-a" {
+				require.Len(t, sequence.Events, 1)
+				jobIdsA = append(jobIdsA, sequence.Events[0].GetCancelJob().JobId)
+			}
+		}
+		assert.Equal(t, []string{"1", "2", "4"}, jobIdsA)
+
+		partitionB := PartitionForKey("job-set-b", 4)
+		var jobIdsB []string
+		for _, sequence := range readSequences(t, ctx, log, partitionB) {
+			if sequence.JobSetName == "job-set-b" {
+				jobIdsB = append(jobIdsB, sequence.Events[0].GetCancelJob().JobId)
+			}
+		}
+		assert.Equal(t, []string{"3"}, jobIdsB)
+	})
+}
+
+func TestPublisher_PublishToPartition(t *testing.T) {
+	withTestLog(t, 4, func(ctx *armadacontext.Context, log *Log) {
+		publisher, err := NewPublisher[*armadaevents.EventSequence](
+			ctx,
+			log,
+			topic,
+			jobsetevents.NewPreProcessor(1, 1024*1024),
+			jobsetevents.RetrieveKey,
+		)
+		require.NoError(t, err)
+
+		for partition := 0; partition < 4; partition++ {
+			require.NoError(t, publisher.PublishToPartition(ctx, partition, eventSequence("job-set-a", "1")))
+		}
+		for partition := 0; partition < 4; partition++ {
+			assert.Len(t, readSequences(t, ctx, log, partition), 1)
+		}
+	})
+}
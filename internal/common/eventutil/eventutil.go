@@ -18,6 +18,7 @@ import (
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/controlplaneevents"
+	"github.com/armadaproject/armada/pkg/metricevents"
 )
 
 // UnmarshalEventSequence returns an EventSequence object contained in a byte buffer
@@ -88,6 +89,28 @@ func UnmarshalControlPlaneEvent(payload []byte) (*controlplaneevents.Event, erro
 	return event, nil
 }
 
+// UnmarshalMetricEvent returns a metric Event object contained in a byte buffer
+// after validating that the resulting metric Event is valid.
+func UnmarshalMetricEvent(payload []byte) (*metricevents.Event, error) {
+	event := &metricevents.Event{}
+	err := proto.Unmarshal(payload, event)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	if event.Event == nil {
+		err = &armadaerrors.ErrInvalidArgument{
+			Name:    "Event",
+			Value:   nil,
+			Message: "no event in metric event",
+		}
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	return event, nil
+}
+
 // ShortSequenceString returns a short string representation of an events sequence.
 // To be used for logging, for example.
 func ShortSequenceString(sequence *armadaevents.EventSequence) string {
@@ -116,10 +139,12 @@ func ApiJobFromLogSubmitJob(ownerId string, groups []string, queueName string, j
 	podSpec := mainObject.PodSpec.PodSpec
 
 	// The job submit message contains a bag of additional k8s objects to create as part of the job.
-	// Currently, these must be of type pod spec, service spec, or ingress spec. These are stored in a single slice.
-	// Here, we separate them by type for inclusion in the API job.
+	// Currently, these must be of type pod spec, service spec, ingress spec, config map, or secret. These are stored in
+	// a single slice. Here, we separate them by type for inclusion in the API job.
+	// Secrets are omitted, so that their contents aren't exposed by APIs returning jobs.
 	k8sServices := make([]*v1.Service, 0)
 	k8sIngresses := make([]*networking.Ingress, 0)
+	var k8sConfigMaps []*v1.ConfigMap
 	k8sPodSpecs := make([]*v1.PodSpec, 0)
 	k8sPodSpecs = append(k8sPodSpecs, podSpec)
 	for _, object := range e.Objects {
@@ -137,6 +162,15 @@ func ApiJobFromLogSubmitJob(ownerId string, groups []string, queueName string, j
 			})
 		case *armadaevents.KubernetesObject_PodSpec:
 			k8sPodSpecs = append(k8sPodSpecs, o.PodSpec.PodSpec)
+		case *armadaevents.KubernetesObject_ConfigMap:
+			k8sConfigMaps = append(k8sConfigMaps, &v1.ConfigMap{
+				ObjectMeta: k8sObjectMeta,
+				Immutable:  o.ConfigMap.Immutable,
+				Data:       o.ConfigMap.Data,
+				BinaryData: o.ConfigMap.BinaryData,
+			})
+		case *armadaevents.KubernetesObject_Secret:
+			// Omitted, as above.
 		default:
 			return nil, &armadaerrors.ErrInvalidArgument{
 				Name:    "Objects",
@@ -170,9 +204,10 @@ func ApiJobFromLogSubmitJob(ownerId string, groups []string, queueName string, j
 		Labels:      e.ObjectMeta.Labels,
 		Annotations: e.ObjectMeta.Annotations,
 
-		K8SIngress: k8sIngresses,
-		K8SService: k8sServices,
-		Priority:   float64(e.Priority),
+		K8SIngress:   k8sIngresses,
+		K8SService:   k8sServices,
+		K8SConfigMap: k8sConfigMaps,
+		Priority:     float64(e.Priority),
 
 		PodSpec:                        podSpec,
 		PodSpecs:                       podSpecs,
@@ -181,6 +216,9 @@ func ApiJobFromLogSubmitJob(ownerId string, groups []string, queueName string, j
 		Created:                  protoutil.ToTimestamp(time),
 		Owner:                    ownerId,
 		QueueOwnershipUserGroups: groups,
+
+		Dependencies:            e.Dependencies,
+		DependencyFailurePolicy: api.DependencyFailurePolicy(e.DependencyFailurePolicy),
 	}, nil
 }
 
@@ -0,0 +1,68 @@
+package eventutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// JobArrayElementId returns the id of the element at the given index of the job array with the given id.
+func JobArrayElementId(arrayId string, index uint32) string {
+	return fmt.Sprintf("%s-%d", arrayId, index)
+}
+
+// ParseJobArrayElementId splits a job id created by JobArrayElementId into the array id and index.
+// The final return value is false if jobId is not the id of a job array element.
+func ParseJobArrayElementId(jobId string) (string, uint32, bool) {
+	i := strings.LastIndexByte(jobId, '-')
+	if i <= 0 || i == len(jobId)-1 {
+		return "", 0, false
+	}
+	index, err := strconv.ParseUint(jobId[i+1:], 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+	return jobId[:i], uint32(index), true
+}
+
+// ExpandJobArray returns the jobs making up the job array submitted by e.
+// If e isn't a job array, i.e., if ArraySize is zero, a slice containing only e is returned.
+// Otherwise, one copy of e is returned per element, with the job id set to the id of that element,
+// the array id and index added as annotations, and the index exposed to all containers via an environment variable.
+func ExpandJobArray(e *armadaevents.SubmitJob) []*armadaevents.SubmitJob {
+	if e.ArraySize == 0 {
+		return []*armadaevents.SubmitJob{e}
+	}
+	jobs := make([]*armadaevents.SubmitJob, e.ArraySize)
+	for i := uint32(0); i < e.ArraySize; i++ {
+		job := proto.Clone(e).(*armadaevents.SubmitJob)
+		job.JobId = JobArrayElementId(e.JobId, i)
+		job.ArraySize = 0
+		index := strconv.FormatUint(uint64(i), 10)
+		if job.ObjectMeta == nil {
+			job.ObjectMeta = &armadaevents.ObjectMeta{}
+		}
+		if job.ObjectMeta.Annotations == nil {
+			job.ObjectMeta.Annotations = make(map[string]string, 2)
+		}
+		job.ObjectMeta.Annotations[configuration.JobArrayIdAnnotation] = e.JobId
+		job.ObjectMeta.Annotations[configuration.JobArrayIndexAnnotation] = index
+		if podSpec := job.GetMainObject().GetPodSpec().GetPodSpec(); podSpec != nil {
+			env := v1.EnvVar{Name: configuration.JobArrayIndexEnvVar, Value: index}
+			for j := range podSpec.InitContainers {
+				podSpec.InitContainers[j].Env = append(podSpec.InitContainers[j].Env, env)
+			}
+			for j := range podSpec.Containers {
+				podSpec.Containers[j].Env = append(podSpec.Containers[j].Env, env)
+			}
+		}
+		jobs[i] = job
+	}
+	return jobs
+}
@@ -0,0 +1,103 @@
+package eventutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestParseJobArrayElementId(t *testing.T) {
+	tests := map[string]struct {
+		jobId           string
+		expectedArrayId string
+		expectedIndex   uint32
+		expectedOk      bool
+	}{
+		"element": {
+			jobId:           JobArrayElementId("01gkv9idvzk3k2i6s5tbg9qq8c", 12),
+			expectedArrayId: "01gkv9idvzk3k2i6s5tbg9qq8c",
+			expectedIndex:   12,
+			expectedOk:      true,
+		},
+		"plain job id": {
+			jobId: "01gkv9idvzk3k2i6s5tbg9qq8c",
+		},
+		"non-numeric suffix": {
+			jobId: "01gkv9idvzk3k2i6s5tbg9qq8c-abc",
+		},
+		"trailing separator": {
+			jobId: "01gkv9idvzk3k2i6s5tbg9qq8c-",
+		},
+		"leading separator": {
+			jobId: "-1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			arrayId, index, ok := ParseJobArrayElementId(tc.jobId)
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedArrayId, arrayId)
+			assert.Equal(t, tc.expectedIndex, index)
+		})
+	}
+}
+
+func TestExpandJobArray_NotAnArray(t *testing.T) {
+	e := &armadaevents.SubmitJob{JobId: "01gkv9idvzk3k2i6s5tbg9qq8c"}
+	jobs := ExpandJobArray(e)
+	require.Len(t, jobs, 1)
+	assert.Same(t, e, jobs[0])
+}
+
+func TestExpandJobArray(t *testing.T) {
+	const arrayId = "01gkv9idvzk3k2i6s5tbg9qq8c"
+	e := &armadaevents.SubmitJob{
+		JobId:     arrayId,
+		ArraySize: 3,
+		Priority:  2,
+		MainObject: &armadaevents.KubernetesMainObject{
+			Object: &armadaevents.KubernetesMainObject_PodSpec{
+				PodSpec: &armadaevents.PodSpecWithAvoidList{
+					PodSpec: &v1.PodSpec{
+						InitContainers: []v1.Container{{Name: "init"}},
+						Containers: []v1.Container{
+							{Name: "a", Env: []v1.EnvVar{{Name: "FOO", Value: "bar"}}},
+							{Name: "b"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	jobs := ExpandJobArray(e)
+	require.Len(t, jobs, 3)
+	for i, job := range jobs {
+		index := []string{"0", "1", "2"}[i]
+		assert.Equal(t, arrayId+"-"+index, job.JobId)
+		assert.Equal(t, uint32(0), job.ArraySize)
+		assert.Equal(t, uint32(2), job.Priority)
+		assert.Equal(
+			t,
+			map[string]string{
+				configuration.JobArrayIdAnnotation:    arrayId,
+				configuration.JobArrayIndexAnnotation: index,
+			},
+			job.ObjectMeta.Annotations,
+		)
+		podSpec := job.GetMainObject().GetPodSpec().GetPodSpec()
+		env := v1.EnvVar{Name: configuration.JobArrayIndexEnvVar, Value: index}
+		assert.Equal(t, []v1.EnvVar{env}, podSpec.InitContainers[0].Env)
+		assert.Equal(t, []v1.EnvVar{{Name: "FOO", Value: "bar"}, env}, podSpec.Containers[0].Env)
+		assert.Equal(t, []v1.EnvVar{env}, podSpec.Containers[1].Env)
+	}
+
+	// The original event must not be modified.
+	assert.Nil(t, e.ObjectMeta)
+	assert.Len(t, e.GetMainObject().GetPodSpec().GetPodSpec().Containers[0].Env, 1)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
 	"github.com/armadaproject/armada/internal/common/ingest/utils"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -23,6 +24,12 @@ type HasPulsarMessageIds interface {
 	GetMessageIDs() []pulsar.MessageID
 }
 
+// Consumer is the part of pulsar.Consumer used by the pipeline; it's also implemented by eventlog.Consumer.
+type Consumer interface {
+	Chan() <-chan pulsar.ConsumerMessage
+	AckID(pulsar.MessageID) error
+}
+
 // EventCounter determines the true count of events, as some utils.ArmadaEvent can contain nested events
 type EventCounter[T utils.ArmadaEvent] func(events *utils.EventsWithIds[T]) int
 
@@ -74,7 +81,7 @@ type IngestionPipeline[T HasPulsarMessageIds, U utils.ArmadaEvent] struct {
 	metricPublisher        BatchMetricPublisher[U]
 	converter              InstructionConverter[T, U]
 	sink                   Sink[T]
-	consumer               pulsar.Consumer // for test purposes only
+	consumer               Consumer // for test purposes only
 }
 
 // NewIngestionPipeline creates an IngestionPipeline that processes all pulsar messages
@@ -117,7 +124,7 @@ func (i *IngestionPipeline[T, U]) Run(ctx *armadacontext.Context) error {
 	wg.Add(1)
 
 	if i.consumer == nil {
-		consumer, closePulsar, err := i.subscribe()
+		consumer, closePulsar, err := i.subscribe(ctx)
 		if err != nil {
 			return err
 		}
@@ -243,7 +250,11 @@ func (i *IngestionPipeline[T, U]) Run(ctx *armadacontext.Context) error {
 	return nil
 }
 
-func (i *IngestionPipeline[T, U]) subscribe() (pulsar.Consumer, func(), error) {
+func (i *IngestionPipeline[T, U]) subscribe(ctx *armadacontext.Context) (Consumer, func(), error) {
+	if i.pulsarConfig.EventLog.Enabled {
+		return i.subscribeToEventLog(ctx)
+	}
+
 	// Subscribe to Pulsar and receive messages
 	pulsarClient, err := pulsarutils.NewPulsarClient(&i.pulsarConfig)
 	if err != nil {
@@ -266,3 +277,21 @@ func (i *IngestionPipeline[T, U]) subscribe() (pulsar.Consumer, func(), error) {
 		pulsarClient.Close()
 	}, nil
 }
+
+func (i *IngestionPipeline[T, U]) subscribeToEventLog(ctx *armadacontext.Context) (Consumer, func(), error) {
+	eventLog, err := eventlog.Open(ctx, i.pulsarConfig.EventLog)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "Error opening event log")
+	}
+
+	consumer, err := eventLog.Subscribe(ctx, i.pulsarTopic, i.pulsarSubscriptionName, i.pulsarConfig.ReceiverQueueSize)
+	if err != nil {
+		eventLog.Close()
+		return nil, nil, errors.WithMessage(err, "Error creating event log consumer")
+	}
+
+	return consumer, func() {
+		consumer.Close()
+		eventLog.Close()
+	}, nil
+}
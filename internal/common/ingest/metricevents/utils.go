@@ -0,0 +1,56 @@
+package metricevents
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/pkg/metricevents"
+)
+
+func MessageUnmarshaller(msg pulsar.ConsumerMessage, metrics *commonmetrics.Metrics) *utils.EventsWithIds[*metricevents.Event] {
+	events := make([]*metricevents.Event, 0, 1)
+	messageIds := make([]pulsar.MessageID, 0, 1)
+
+	messageIds = append(messageIds, msg.ID())
+
+	// Try and unmarshall the proto
+	event, err := eventutil.UnmarshalMetricEvent(msg.Payload())
+	if err != nil {
+		metrics.RecordPulsarMessageError(commonmetrics.PulsarMessageErrorDeserialization)
+		log.WithError(err).Warnf("Could not unmarshal proto for msg %s", msg.ID())
+	} else {
+		events = append(events, event)
+	}
+	return &utils.EventsWithIds[*metricevents.Event]{
+		Events: events, MessageIds: messageIds,
+	}
+}
+
+func BatchMerger(nestedEvents []*utils.EventsWithIds[*metricevents.Event]) *utils.EventsWithIds[*metricevents.Event] {
+	combinedEvents := make([]*metricevents.Event, 0)
+	messageIds := []pulsar.MessageID{}
+	for _, eventsWithIds := range nestedEvents {
+		combinedEvents = append(combinedEvents, eventsWithIds.Events...)
+		messageIds = append(messageIds, eventsWithIds.MessageIds...)
+	}
+	return &utils.EventsWithIds[*metricevents.Event]{
+		Events: combinedEvents, MessageIds: messageIds,
+	}
+}
+
+func BatchMetricPublisher(metrics *commonmetrics.Metrics, batch *utils.EventsWithIds[*metricevents.Event]) {
+	countOfEventsByType := map[string]int{}
+	for _, event := range batch.Events {
+		typeString := event.GetEventName()
+		countOfEventsByType[typeString] = countOfEventsByType[typeString] + 1
+		metrics.RecordMetricEventProcessed(typeString)
+	}
+	log.Infof("Batch being processed contains metric events of types %v", countOfEventsByType)
+}
+
+func EventCounter(events *utils.EventsWithIds[*metricevents.Event]) int {
+	return len(events.Events)
+}
@@ -14,19 +14,24 @@ const (
 	DBOperationRead                   DBOperation        = "read"
 	DBOperationInsert                 DBOperation        = "insert"
 	DBOperationUpdate                 DBOperation        = "update"
+	DBOperationUpsert                 DBOperation        = "upsert"
+	DBOperationDelete                 DBOperation        = "delete"
 	DBOperationCreateTempTable        DBOperation        = "create_temp_table"
 	PulsarMessageErrorDeserialization PulsarMessageError = "deserialization"
 	PulsarMessageErrorProcessing      PulsarMessageError = "processing"
 )
 
 const (
-	ArmadaLookoutIngesterMetricsPrefix = "armada_lookout_ingester_v2_"
-	ArmadaEventIngesterMetricsPrefix   = "armada_event_ingester_"
+	ArmadaLookoutIngesterMetricsPrefix      = "armada_lookout_ingester_v2_"
+	ArmadaEventIngesterMetricsPrefix        = "armada_event_ingester_"
+	ArmadaNotificationIngesterMetricsPrefix = "armada_notification_ingester_"
+	ArmadaAccountingIngesterMetricsPrefix   = "armada_accounting_ingester_"
 )
 
 const (
 	JobSetEventsLabel       = "jobSet"
 	ControlPlaneEventsLabel = "controlPlane"
+	MetricEventsLabel       = "metric"
 )
 
 type Metrics struct {
@@ -91,3 +96,7 @@ func (m *Metrics) RecordEventSequenceProcessed(queue string, msgType string) {
 func (m *Metrics) RecordControlPlaneEventProcessed(msgType string) {
 	m.eventsProcessed.With(map[string]string{"queue": "N/A", "eventType": ControlPlaneEventsLabel, "msgType": msgType}).Inc()
 }
+
+func (m *Metrics) RecordMetricEventProcessed(msgType string) {
+	m.eventsProcessed.With(map[string]string{"queue": "N/A", "eventType": MetricEventsLabel, "msgType": msgType}).Inc()
+}
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/common/pointer"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -47,6 +48,11 @@ const (
 	ExecutorCordonReason = "bad executor"
 )
 
+const (
+	DrainNodeName   = "testNode"
+	NodeDrainReason = "node maintenance"
+)
+
 var (
 	PartitionMarkerGroupIdUuid = uuid.MustParse(PartitionMarkerGroupId)
 	PriorityClassName          = "test-priority"
@@ -251,6 +257,15 @@ var JobCancelRequested = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobArrayCancelRequested = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_CancelJobArray{
+		CancelJobArray: &armadaevents.CancelJobArray{
+			ArrayId: JobId,
+		},
+	},
+}
+
 var JobSetCancelRequested = &armadaevents.EventSequence_Event{
 	Created: testfixtures.BasetimeProto,
 	Event: &armadaevents.EventSequence_Event_CancelJobSet{
@@ -339,6 +354,16 @@ var JobReprioritiseRequested = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobArrayReprioritiseRequested = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_ReprioritiseJobArray{
+		ReprioritiseJobArray: &armadaevents.ReprioritiseJobArray{
+			ArrayId:  JobId,
+			Priority: NewPriority,
+		},
+	},
+}
+
 var JobSetReprioritiseRequested = &armadaevents.EventSequence_Event{
 	Created: testfixtures.BasetimeProto,
 	Event: &armadaevents.EventSequence_Event_ReprioritiseJobSet{
@@ -378,6 +403,24 @@ var JobRunPreempted = &armadaevents.EventSequence_Event{
 	},
 }
 
+var ResourceUtilisation = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_ResourceUtilisation{
+		ResourceUtilisation: &armadaevents.ResourceUtilisation{
+			JobId: JobId,
+			RunId: RunId,
+			MaxResourcesForPeriod: map[string]*resource.Quantity{
+				"cpu":    pointer.MustParseResource("1500m"),
+				"memory": pointer.MustParseResource("2Gi"),
+			},
+			AvgResourcesForPeriod: map[string]*resource.Quantity{
+				"cpu":    pointer.MustParseResource("500m"),
+				"memory": pointer.MustParseResource("1Gi"),
+			},
+		},
+	},
+}
+
 var JobRunFailed = &armadaevents.EventSequence_Event{
 	Created: testfixtures.BasetimeProto,
 	Event: &armadaevents.EventSequence_Event_JobRunErrors{
@@ -439,6 +482,25 @@ var JobRejected = &armadaevents.EventSequence_Event{
 	},
 }
 
+var JobDependencyFailed = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_JobErrors{
+		JobErrors: &armadaevents.JobErrors{
+			JobId: JobId,
+			Errors: []*armadaevents.Error{
+				{
+					Terminal: true,
+					Reason: &armadaevents.Error_JobDependencyFailed{
+						JobDependencyFailed: &armadaevents.JobDependencyFailed{
+							Message: ErrMsg,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
 var JobFailed = &armadaevents.EventSequence_Event{
 	Created: testfixtures.BasetimeProto,
 	Event: &armadaevents.EventSequence_Event_JobErrors{
@@ -499,6 +561,36 @@ var DeleteExecutorSettings = &controlplaneevents.Event{
 	},
 }
 
+var UpsertNodeDrain = &controlplaneevents.Event{
+	Event: &controlplaneevents.Event_NodeDrainUpsert{
+		NodeDrainUpsert: &controlplaneevents.NodeDrainUpsert{
+			Name:     ExecutorId,
+			NodeName: DrainNodeName,
+			Preempt:  true,
+			Reason:   NodeDrainReason,
+		},
+	},
+}
+
+var UpsertNodeDrainBySelector = &controlplaneevents.Event{
+	Event: &controlplaneevents.Event_NodeDrainUpsert{
+		NodeDrainUpsert: &controlplaneevents.NodeDrainUpsert{
+			Name:         ExecutorId,
+			NodeSelector: map[string]string{"zone": "b", "rack": "a"},
+			Reason:       NodeDrainReason,
+		},
+	},
+}
+
+var DeleteNodeDrain = &controlplaneevents.Event{
+	Event: &controlplaneevents.Event_NodeDrainDelete{
+		NodeDrainDelete: &controlplaneevents.NodeDrainDelete{
+			Name:     ExecutorId,
+			NodeName: DrainNodeName,
+		},
+	},
+}
+
 var PreemptOnExecutor = &controlplaneevents.Event{
 	Event: &controlplaneevents.Event_PreemptOnExecutor{
 		PreemptOnExecutor: &controlplaneevents.PreemptOnExecutor{
@@ -0,0 +1,29 @@
+// Package jobsetevents defines where the event ingester stores the events of each job set,
+// such that the events API can read them back.
+package jobsetevents
+
+const (
+	// Prefix of the Redis stream holding the events of each job set.
+	RedisEventStreamPrefix = "Events:"
+	// Prefix of the Redis sorted set of each queue holding the ids of its job sets,
+	// scored by when they were last written to.
+	RedisJobSetsPrefix = "JobSets:"
+	// Postgres channel notified with NotificationPayload whenever events of a job set are stored.
+	PostgresNotificationChannel = "job_set_events"
+)
+
+// RedisEventStreamKey returns the key of the Redis stream holding the events of a job set.
+func RedisEventStreamKey(queue, jobSetId string) string {
+	return RedisEventStreamPrefix + queue + ":" + jobSetId
+}
+
+// RedisQueueJobSetsKey returns the key of the Redis sorted set holding the ids of the job sets of a queue.
+func RedisQueueJobSetsKey(queue string) string {
+	return RedisJobSetsPrefix + queue
+}
+
+// NotificationPayload returns the payload of the notification sent on PostgresNotificationChannel
+// when events of a job set are stored.
+func NotificationPayload(queue, jobSetId string) string {
+	return queue + ":" + jobSetId
+}
@@ -0,0 +1,140 @@
+package logarchive
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logarchive/configuration"
+)
+
+// ErrNotFound is returned when there's no archived log for a given key.
+var ErrNotFound = errors.New("archived log not found")
+
+// Archive stores the logs of the containers of finished job runs.
+type Archive interface {
+	// Put stores the log of a container, replacing any log already stored under the same key.
+	Put(ctx *armadacontext.Context, key LogKey, log io.Reader) error
+	// Get returns the log stored under key, or ErrNotFound if there is none.
+	Get(ctx *armadacontext.Context, key LogKey) (io.ReadCloser, error)
+	// List returns all logs stored for a job.
+	List(ctx *armadacontext.Context, jobId string) ([]ArchivedLog, error)
+	// DeleteArchivedBefore deletes all logs archived before cutoff and returns the number of logs deleted.
+	DeleteArchivedBefore(ctx *armadacontext.Context, cutoff time.Time) (int, error)
+}
+
+// LogKey identifies the log of a single container of a job run.
+type LogKey struct {
+	JobId string
+	RunId string
+	// Namespace of the pod the log was read from. Readers must be allowed to read pod logs in this namespace.
+	Namespace string
+	Container string
+}
+
+// ArchivedLog describes a log held in an Archive.
+type ArchivedLog struct {
+	Key        LogKey
+	ArchivedAt time.Time
+}
+
+const logSuffix = ".log"
+
+// path returns the location of the log relative to the root of the archive, i.e.
+// <jobId>/<runId>/<namespace>/<container>.log. The key must be valid.
+func (k LogKey) path() string {
+	return path.Join(k.JobId, k.RunId, k.Namespace, k.Container+logSuffix)
+}
+
+// Validate returns an error unless every part of the key is an id or a DNS label, so keys can't address anything
+// outside the archive.
+func (k LogKey) Validate() error {
+	if err := validateId("job id", k.JobId); err != nil {
+		return err
+	}
+	if err := validateId("run id", k.RunId); err != nil {
+		return err
+	}
+	if errs := validation.IsDNS1123Label(k.Namespace); len(errs) > 0 {
+		return errors.Errorf("invalid namespace %q: %s", k.Namespace, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsDNS1123Label(k.Container); len(errs) > 0 {
+		return errors.Errorf("invalid container name %q: %s", k.Container, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// validIdPattern matches job and run ids, which are ULIDs and UUIDs respectively.
+var validIdPattern = regexp.MustCompile(`^[0-9A-Za-z-]{1,64}$`)
+
+func validateId(kind string, id string) error {
+	if !validIdPattern.MatchString(id) {
+		return errors.Errorf("invalid %s %q", kind, id)
+	}
+	return nil
+}
+
+func (k LogKey) String() string {
+	return k.path()
+}
+
+func keyFromPath(p string) (LogKey, bool) {
+	parts := strings.Split(p, "/")
+	if len(parts) != 4 || !strings.HasSuffix(parts[3], logSuffix) {
+		return LogKey{}, false
+	}
+	key := LogKey{
+		JobId:     parts[0],
+		RunId:     parts[1],
+		Namespace: parts[2],
+		Container: strings.TrimSuffix(parts[3], logSuffix),
+	}
+	return key, key.Validate() == nil
+}
+
+// New returns the Archive described by config.
+func New(config configuration.LogArchiveConfig) (Archive, error) {
+	switch {
+	case config.S3 != nil && config.Filesystem != nil:
+		return nil, fmt.Errorf("only one of s3 and filesystem log archives may be configured")
+	case config.S3 != nil:
+		return NewS3Archive(*config.S3)
+	case config.Filesystem != nil:
+		return NewFilesystemArchive(config.Filesystem.Directory)
+	default:
+		return nil, fmt.Errorf("no log archive configured")
+	}
+}
+
+// LatestRun returns the logs of the most recently archived run of a job, or ErrNotFound if no logs of the job are archived.
+func LatestRun(ctx *armadacontext.Context, archive Archive, jobId string) ([]ArchivedLog, error) {
+	logs, err := archive.List(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	latestRunId := ""
+	var latestArchivedAt time.Time
+	for _, log := range logs {
+		if latestRunId == "" || log.ArchivedAt.After(latestArchivedAt) {
+			latestRunId = log.Key.RunId
+			latestArchivedAt = log.ArchivedAt
+		}
+	}
+	if latestRunId == "" {
+		return nil, ErrNotFound
+	}
+	var latestRunLogs []ArchivedLog
+	for _, log := range logs {
+		if log.Key.RunId == latestRunId {
+			latestRunLogs = append(latestRunLogs, log)
+		}
+	}
+	return latestRunLogs, nil
+}
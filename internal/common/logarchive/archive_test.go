@@ -0,0 +1,298 @@
+package logarchive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logarchive/configuration"
+)
+
+func TestFilesystemArchive(t *testing.T) {
+	archive, err := New(configuration.LogArchiveConfig{
+		Filesystem: &configuration.FilesystemConfig{Directory: t.TempDir()},
+	})
+	require.NoError(t, err)
+	testArchive(t, archive)
+}
+
+func TestS3Archive(t *testing.T) {
+	server := httptest.NewServer(newFakeS3())
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	archive, err := New(configuration.LogArchiveConfig{
+		S3: &configuration.S3Config{
+			Endpoint:        endpoint.Host,
+			Region:          "us-east-1",
+			Bucket:          "logs",
+			Prefix:          "armada",
+			AccessKeyId:     "access-key",
+			SecretAccessKey: "secret-key",
+		},
+	})
+	require.NoError(t, err)
+	testArchive(t, archive)
+}
+
+func TestNew_RequiresExactlyOneBackend(t *testing.T) {
+	_, err := New(configuration.LogArchiveConfig{})
+	assert.Error(t, err)
+
+	_, err = New(configuration.LogArchiveConfig{
+		S3:         &configuration.S3Config{Bucket: "logs"},
+		Filesystem: &configuration.FilesystemConfig{Directory: t.TempDir()},
+	})
+	assert.Error(t, err)
+}
+
+// testArchive checks the behaviour every Archive implementation must have.
+func testArchive(t *testing.T, archive Archive) {
+	ctx := armadacontext.Background()
+	firstRun := LogKey{JobId: "job-1", RunId: "run-1", Namespace: "default", Container: "main"}
+	secondRunMain := LogKey{JobId: "job-1", RunId: "run-2", Namespace: "default", Container: "main"}
+	secondRunSidecar := LogKey{JobId: "job-1", RunId: "run-2", Namespace: "default", Container: "sidecar"}
+	otherJob := LogKey{JobId: "job-2", RunId: "run-3", Namespace: "default", Container: "main"}
+
+	_, err := archive.Get(ctx, firstRun)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = LatestRun(ctx, archive, firstRun.JobId)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, archive.Put(ctx, firstRun, strings.NewReader("first run\n")))
+	// Make sure runs archived later have a later modification time, even on filesystems with coarse timestamps.
+	time.Sleep(1100 * time.Millisecond)
+	require.NoError(t, archive.Put(ctx, secondRunMain, strings.NewReader("second run\n")))
+	require.NoError(t, archive.Put(ctx, secondRunSidecar, strings.NewReader("sidecar\n")))
+	require.NoError(t, archive.Put(ctx, otherJob, strings.NewReader("other job\n")))
+
+	assertLog(t, archive, firstRun, "first run\n")
+	assertLog(t, archive, secondRunSidecar, "sidecar\n")
+
+	// Putting a log again replaces it.
+	require.NoError(t, archive.Put(ctx, secondRunMain, strings.NewReader("second run, again\n")))
+	assertLog(t, archive, secondRunMain, "second run, again\n")
+
+	logs, err := archive.List(ctx, "job-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []LogKey{firstRun, secondRunMain, secondRunSidecar}, keys(logs))
+
+	latest, err := LatestRun(ctx, archive, "job-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []LogKey{secondRunMain, secondRunSidecar}, keys(latest))
+
+	deleted, err := archive.DeleteArchivedBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	deleted, err = archive.DeleteArchivedBefore(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 4, deleted)
+	logs, err = archive.List(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
+func TestLogKey_Validate(t *testing.T) {
+	valid := LogKey{JobId: "01gkv9k0m4d7c8bxgkhgtyvcz3", RunId: "123e4567-e89b-12d3-a456-426614174000", Namespace: "default", Container: "main"}
+	assert.NoError(t, valid.Validate())
+
+	invalid := map[string]func(key *LogKey){
+		"job id traversal":    func(key *LogKey) { key.JobId = ".." },
+		"job id separator":    func(key *LogKey) { key.JobId = "job/other" },
+		"empty job id":        func(key *LogKey) { key.JobId = "" },
+		"run id traversal":    func(key *LogKey) { key.RunId = "../../etc" },
+		"namespace traversal": func(key *LogKey) { key.Namespace = ".." },
+		"missing namespace":   func(key *LogKey) { key.Namespace = "" },
+		"container separator": func(key *LogKey) { key.Container = "main/../../x" },
+		"container dot":       func(key *LogKey) { key.Container = "main.x" },
+	}
+	for name, mutate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			key := valid
+			mutate(&key)
+			assert.Error(t, key.Validate())
+		})
+	}
+}
+
+func TestFilesystemArchive_RejectsInvalidKeys(t *testing.T) {
+	ctx := armadacontext.Background()
+	archive, err := NewFilesystemArchive(t.TempDir())
+	require.NoError(t, err)
+	key := LogKey{JobId: "..", RunId: "..", Namespace: "default", Container: "main"}
+	assert.Error(t, archive.Put(ctx, key, strings.NewReader("log\n")))
+	_, err = archive.Get(ctx, key)
+	assert.Error(t, err)
+	_, err = archive.List(ctx, "../..")
+	assert.Error(t, err)
+}
+
+func assertLog(t *testing.T, archive Archive, key LogKey, expected string) {
+	reader, err := archive.Get(armadacontext.Background(), key)
+	require.NoError(t, err)
+	defer reader.Close()
+	actual, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+}
+
+func keys(logs []ArchivedLog) []LogKey {
+	result := make([]LogKey, len(logs))
+	for i, log := range logs {
+		result[i] = log.Key
+	}
+	return result
+}
+
+// fakeS3 is an in-memory stand-in for an S3-compatible object store.
+// It supports just enough of the API for S3Archive and doesn't check signatures.
+type fakeS3 struct {
+	objects map[string]fakeS3Object
+	mu      sync.Mutex
+}
+
+type fakeS3Object struct {
+	data         []byte
+	lastModified time.Time
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string]fakeS3Object{}}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Requests are path-style, i.e. /<bucket>/<key>.
+	bucketAndKey := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(bucketAndKey) == 1 || bucketAndKey[1] == "" {
+		if r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2" {
+			s.list(w, bucketAndKey[0], r.URL.Query().Get("prefix"))
+			return
+		}
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	objectName := bucketAndKey[0] + "/" + bucketAndKey[1]
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readS3Body(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.objects[objectName] = fakeS3Object{data: data, lastModified: time.Now().UTC()}
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodHead, http.MethodGet:
+		object, ok := s.objects[objectName]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+			}
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", object.lastModified.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.data)
+		}
+	case http.MethodDelete:
+		delete(s.objects, objectName)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (s *fakeS3) list(w http.ResponseWriter, bucket string, prefix string) {
+	type content struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+		StorageClass string
+	}
+	result := struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Name        string
+		Prefix      string
+		KeyCount    int
+		MaxKeys     int
+		IsTruncated bool
+		Contents    []content
+	}{Name: bucket, Prefix: prefix, MaxKeys: 1000}
+	objectNames := make([]string, 0, len(s.objects))
+	for objectName := range s.objects {
+		objectNames = append(objectNames, objectName)
+	}
+	sort.Strings(objectNames)
+	for _, objectName := range objectNames {
+		key := strings.TrimPrefix(objectName, bucket+"/")
+		if !strings.HasPrefix(objectName, bucket+"/") || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		object := s.objects[objectName]
+		result.Contents = append(result.Contents, content{
+			Key:          key,
+			LastModified: object.lastModified.Format("2006-01-02T15:04:05.000Z"),
+			ETag:         `"etag"`,
+			Size:         len(object.data),
+			StorageClass: "STANDARD",
+		})
+	}
+	result.KeyCount = len(result.Contents)
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+// readS3Body returns the content of a PUT request, decoding it if it was sent with aws-chunked encoding.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	var data bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(header), ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk header %q", header)
+		}
+		if size == 0 {
+			return data.Bytes(), nil
+		}
+		if _, err := io.CopyN(&data, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package configuration
+
+import "time"
+
+// LogArchiveConfig configures where the logs of finished job runs are archived.
+// At most one of S3 and Filesystem should be set. If neither is set, logs aren't archived.
+type LogArchiveConfig struct {
+	S3         *S3Config
+	Filesystem *FilesystemConfig
+	// Logs archived for longer than this are deleted. If zero, archived logs are kept forever.
+	Retention time.Duration
+}
+
+func (c LogArchiveConfig) Enabled() bool {
+	return c.S3 != nil || c.Filesystem != nil
+}
+
+// S3Config configures an S3-compatible object store, e.g. AWS S3 or MinIO.
+type S3Config struct {
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prepended to the key of every archived log.
+	Prefix          string
+	AccessKeyId     string
+	SecretAccessKey string
+	UseSSL          bool
+}
+
+// FilesystemConfig configures archiving logs to a directory, e.g. a mounted network volume.
+type FilesystemConfig struct {
+	Directory string
+}
@@ -0,0 +1,126 @@
+package logarchive
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// FilesystemArchive stores logs as files under a directory, e.g. a mounted network volume.
+type FilesystemArchive struct {
+	directory string
+}
+
+func NewFilesystemArchive(directory string) (*FilesystemArchive, error) {
+	if directory == "" {
+		return nil, errors.New("log archive directory must be set")
+	}
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, errors.WithMessagef(err, "error creating log archive directory %s", directory)
+	}
+	return &FilesystemArchive{directory: directory}, nil
+}
+
+func (a *FilesystemArchive) Put(_ *armadacontext.Context, key LogKey, log io.Reader) error {
+	if err := key.Validate(); err != nil {
+		return err
+	}
+	filePath := a.filePath(key)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+	// Write to a temporary file first, so readers never see a partially written log.
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".archive-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, log); err != nil {
+		_ = tmp.Close()
+		return errors.WithMessagef(err, "error archiving log %s", key)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp.Name(), filePath))
+}
+
+func (a *FilesystemArchive) Get(_ *armadacontext.Context, key LogKey) (io.ReadCloser, error) {
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(a.filePath(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, errors.WithStack(err)
+}
+
+func (a *FilesystemArchive) List(_ *armadacontext.Context, jobId string) ([]ArchivedLog, error) {
+	if err := validateId("job id", jobId); err != nil {
+		return nil, err
+	}
+	return a.list(filepath.Join(a.directory, jobId))
+}
+
+func (a *FilesystemArchive) DeleteArchivedBefore(_ *armadacontext.Context, cutoff time.Time) (int, error) {
+	logs, err := a.list(a.directory)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, log := range logs {
+		if !log.ArchivedAt.Before(cutoff) {
+			continue
+		}
+		filePath := a.filePath(log.Key)
+		if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return deleted, errors.WithStack(err)
+		}
+		deleted++
+		// Remove the namespace, run and job directories once they're empty; this fails harmlessly if they aren't.
+		for dir, i := filepath.Dir(filePath), 0; i < 3; dir, i = filepath.Dir(dir), i+1 {
+			_ = os.Remove(dir)
+		}
+	}
+	return deleted, nil
+}
+
+func (a *FilesystemArchive) list(root string) ([]ArchivedLog, error) {
+	var logs []ArchivedLog
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(a.directory, filePath)
+		if err != nil {
+			return err
+		}
+		key, ok := keyFromPath(filepath.ToSlash(relativePath))
+		if !ok {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		logs = append(logs, ArchivedLog{Key: key, ArchivedAt: info.ModTime()})
+		return nil
+	})
+	return logs, errors.WithStack(err)
+}
+
+func (a *FilesystemArchive) filePath(key LogKey) string {
+	return filepath.Join(a.directory, filepath.FromSlash(key.path()))
+}
@@ -0,0 +1,140 @@
+package logarchive
+
+import (
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logarchive/configuration"
+)
+
+// S3Archive stores logs as objects in a bucket of an S3-compatible object store.
+type S3Archive struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3Archive(config configuration.S3Config) (*S3Archive, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("log archive bucket must be set")
+	}
+	creds := credentials.NewStaticV4(config.AccessKeyId, config.SecretAccessKey, "")
+	if config.AccessKeyId == "" {
+		// Fall back to credentials from the environment or, when running in AWS, the instance's IAM role.
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.IAM{},
+		})
+	}
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error creating s3 client for %s", config.Endpoint)
+	}
+	return &S3Archive{
+		client: client,
+		bucket: config.Bucket,
+		prefix: strings.Trim(config.Prefix, "/"),
+	}, nil
+}
+
+func (a *S3Archive) Put(ctx *armadacontext.Context, key LogKey, log io.Reader) error {
+	if err := key.Validate(); err != nil {
+		return err
+	}
+	// Uploads of unknown size are buffered in memory in very large parts, so spool the log to disk first.
+	tmp, err := os.CreateTemp("", "armada-log-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, log)
+	if err != nil {
+		return errors.WithMessagef(err, "error reading log %s", key)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = a.client.PutObject(ctx, a.bucket, a.objectName(key), tmp, size, minio.PutObjectOptions{
+		ContentType: "text/plain",
+	})
+	return errors.WithMessagef(err, "error archiving log %s", key)
+}
+
+func (a *S3Archive) Get(ctx *armadacontext.Context, key LogKey) (io.ReadCloser, error) {
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	objectName := a.objectName(key)
+	// GetObject doesn't fail until the object is read, so check whether it exists first.
+	if _, err := a.client.StatObject(ctx, a.bucket, objectName, minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+	object, err := a.client.GetObject(ctx, a.bucket, objectName, minio.GetObjectOptions{})
+	return object, errors.WithStack(err)
+}
+
+func (a *S3Archive) List(ctx *armadacontext.Context, jobId string) ([]ArchivedLog, error) {
+	if err := validateId("job id", jobId); err != nil {
+		return nil, err
+	}
+	return a.list(ctx, path.Join(a.prefix, jobId)+"/")
+}
+
+func (a *S3Archive) DeleteArchivedBefore(ctx *armadacontext.Context, cutoff time.Time) (int, error) {
+	prefix := ""
+	if a.prefix != "" {
+		prefix = a.prefix + "/"
+	}
+	logs, err := a.list(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, log := range logs {
+		if !log.ArchivedAt.Before(cutoff) {
+			continue
+		}
+		if err := a.client.RemoveObject(ctx, a.bucket, a.objectName(log.Key), minio.RemoveObjectOptions{}); err != nil {
+			return deleted, errors.WithStack(err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (a *S3Archive) list(ctx *armadacontext.Context, prefix string) ([]ArchivedLog, error) {
+	var logs []ArchivedLog
+	for object := range a.client.ListObjects(ctx, a.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, errors.WithStack(object.Err)
+		}
+		key, ok := keyFromPath(strings.TrimPrefix(strings.TrimPrefix(object.Key, a.prefix), "/"))
+		if !ok {
+			continue
+		}
+		logs = append(logs, ArchivedLog{Key: key, ArchivedAt: object.LastModified})
+	}
+	return logs, nil
+}
+
+func (a *S3Archive) objectName(key LogKey) string {
+	return path.Join(a.prefix, key.path())
+}
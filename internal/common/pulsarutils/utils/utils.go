@@ -13,3 +13,13 @@ func NoOpPreProcessor[T utils.ArmadaEvent](msgs []T) ([]T, error) {
 
 // KeyRetriever retrieves the pulsar message key given the event being published
 type KeyRetriever[T utils.ArmadaEvent] func(T) string
+
+// JavaStringHash is the default hashing algorithm used by Pulsar
+// copied from https://github.com/apache/pulsar-client-go/blob/master/pulsar/internal/hash.go
+func JavaStringHash(s string) uint32 {
+	var h uint32
+	for i, size := 0, len(s); i < size; i++ {
+		h = 31*h + uint32(s[i])
+	}
+	return h
+}
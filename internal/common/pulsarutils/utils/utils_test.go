@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJavaStringHash(t *testing.T) {
+	javaHashValues := map[string]uint32{"": 0x0, "hello": 0x5e918d2, "test": 0x364492}
+	for str, expectedHash := range javaHashValues {
+		t.Run(str, func(t *testing.T) {
+			assert.Equal(t, expectedHash, JavaStringHash(str))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
@@ -31,6 +33,9 @@ type PriorityClass struct {
 	// The scheduler first tries to schedule jobs of this priority class as
 	// "home" jobs, and then tries the elements of this slice in order.
 	AwayNodeTypes []AwayNodeType `validate:"dive"`
+	// If non-zero, jobs of this priority class are given notice of this duration before being preempted,
+	// during which they may, e.g., checkpoint their progress. Jobs are killed once the notice period has elapsed.
+	PreemptionNoticePeriod time.Duration `validate:"gte=0"`
 }
 
 func (priorityClass PriorityClass) Equal(other PriorityClass) bool {
@@ -40,6 +45,9 @@ func (priorityClass PriorityClass) Equal(other PriorityClass) bool {
 	if priorityClass.Preemptible != other.Preemptible {
 		return false
 	}
+	if priorityClass.PreemptionNoticePeriod != other.PreemptionNoticePeriod {
+		return false
+	}
 	if !maps.Equal(priorityClass.MaximumResourceFractionPerQueue, other.MaximumResourceFractionPerQueue) {
 		return false
 	}
@@ -7,14 +7,26 @@ import (
 
 	commonconfig "github.com/armadaproject/armada/internal/common/config"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
+	"github.com/armadaproject/armada/internal/server/configuration"
+)
+
+// Backends in which events can be stored; the same as the backends the events API can read from.
+const (
+	EventStoreBackendRedis    = configuration.EventsApiBackendRedis
+	EventStoreBackendPostgres = configuration.EventsApiBackendPostgres
 )
 
 type EventIngesterConfiguration struct {
+	// Backend in which events are stored; one of "redis" (the default) or "postgres".
+	// The events API must be configured to read from the same backend.
+	Backend string `validate:"omitempty,oneof=redis postgres"`
 	// Database configuration
 	Redis redis.UniversalOptions
 	// Database configuration - write to a second redis with this option
 	// Nearly always not set, but can be useful if migrating the armada control plane to another kubernetes cluster
 	RedisReplica redis.UniversalOptions
+	// Database configuration if Backend is "postgres"
+	Postgres configuration.PostgresConfig
 	// Metrics configuration
 	MetricsPort uint16
 	// General Pulsar configuration
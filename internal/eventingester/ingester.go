@@ -12,6 +12,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/app"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/ingest"
 	"github.com/armadaproject/armada/internal/common/ingest/jobsetevents"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -20,6 +21,7 @@ import (
 	"github.com/armadaproject/armada/internal/eventingester/convert"
 	"github.com/armadaproject/armada/internal/eventingester/metrics"
 	"github.com/armadaproject/armada/internal/eventingester/model"
+	"github.com/armadaproject/armada/internal/eventingester/schema"
 	"github.com/armadaproject/armada/internal/eventingester/store"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
@@ -47,28 +49,38 @@ func Run(config *configuration.EventIngesterConfiguration) {
 		fatalRegexes[i] = rgx
 	}
 
-	db := redis.NewUniversalClient(&config.Redis)
-	defer func() {
-		if err := db.Close(); err != nil {
-			log.WithError(err).Error("failed to close events Redis client")
+	var eventDb ingest.Sink[*model.BatchUpdate]
+	if config.Backend == configuration.EventStoreBackendPostgres {
+		db, err := database.OpenPgxPool(config.Postgres)
+		if err != nil {
+			panic(errors.WithMessage(err, "Error opening connection to postgres"))
 		}
-	}()
-
-	dbs := []redis.UniversalClient{db}
-	dbNames := []string{"main"}
-
-	if len(config.RedisReplica.Addrs) > 0 {
-		db2 := redis.NewUniversalClient(&config.RedisReplica)
+		defer db.Close()
+		eventDb = store.NewPostgresEventStore(db, config.EventRetentionPolicy, fatalRegexes, 100*time.Millisecond, 60*time.Second)
+	} else {
+		db := redis.NewUniversalClient(&config.Redis)
 		defer func() {
-			if err := db2.Close(); err != nil {
-				log.WithError(err).Error("failed to close events Redis replica client")
+			if err := db.Close(); err != nil {
+				log.WithError(err).Error("failed to close events Redis client")
 			}
 		}()
-		dbs = append(dbs, db2)
-		dbNames = append(dbNames, "replica")
-	}
 
-	eventDb := store.NewRedisEventStore(dbs, dbNames, config.EventRetentionPolicy, fatalRegexes, 100*time.Millisecond, 60*time.Second)
+		dbs := []redis.UniversalClient{db}
+		dbNames := []string{"main"}
+
+		if len(config.RedisReplica.Addrs) > 0 {
+			db2 := redis.NewUniversalClient(&config.RedisReplica)
+			defer func() {
+				if err := db2.Close(); err != nil {
+					log.WithError(err).Error("failed to close events Redis replica client")
+				}
+			}()
+			dbs = append(dbs, db2)
+			dbNames = append(dbNames, "replica")
+		}
+
+		eventDb = store.NewRedisEventStore(dbs, dbNames, config.EventRetentionPolicy, fatalRegexes, 100*time.Millisecond, 60*time.Second)
+	}
 
 	// Turn the messages into event rows
 	compressor, err := compress.NewZlibCompressor(config.MinMessageCompressionSize)
@@ -101,3 +113,44 @@ func Run(config *configuration.EventIngesterConfiguration) {
 		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
 	}
 }
+
+// MigrateDatabase applies the migrations of the Postgres event store.
+func MigrateDatabase(config *configuration.EventIngesterConfiguration) {
+	ctx := armadacontext.Background()
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		panic(errors.WithMessage(err, "Error opening connection to postgres"))
+	}
+	defer db.Close()
+	if err := schema.Migrate(ctx, db); err != nil {
+		panic(errors.WithMessage(err, "Error migrating event database"))
+	}
+}
+
+// BackfillJobSets records the job sets of the events already stored in Redis, and in its replica if configured,
+// in the sorted sets of their queues, which event ingesters only write to when storing new events.
+func BackfillJobSets(config *configuration.EventIngesterConfiguration) {
+	if config.Backend == configuration.EventStoreBackendPostgres {
+		log.Info("The Postgres event store doesn't need job sets to be backfilled")
+		return
+	}
+	ctx := armadacontext.Background()
+	redisConfigs := []*redis.UniversalOptions{&config.Redis}
+	names := []string{"main"}
+	if len(config.RedisReplica.Addrs) > 0 {
+		redisConfigs = append(redisConfigs, &config.RedisReplica)
+		names = append(names, "replica")
+	}
+	for i, redisConfig := range redisConfigs {
+		name := names[i]
+		db := redis.NewUniversalClient(redisConfig)
+		numStreams, err := store.BackfillQueueJobSets(ctx, db, config.EventRetentionPolicy.RetentionDuration)
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Errorf("failed to close events Redis %s client", name)
+		}
+		if err != nil {
+			panic(errors.WithMessagef(err, "Error backfilling job sets in Redis %s", name))
+		}
+		log.Infof("Backfilled the job sets of %d event streams in Redis %s", numStreams, name)
+	}
+}
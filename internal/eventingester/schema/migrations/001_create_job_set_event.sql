@@ -0,0 +1,14 @@
+-- Events of each job set, as stored by the event ingester when configured to use Postgres.
+-- Rows are partitioned by day; the event ingester creates partitions ahead of time and drops them once all their
+-- events are older than the retention period.
+CREATE TABLE job_set_event (
+    id      bigserial   NOT NULL,
+    queue   text        NOT NULL,
+    job_set text        NOT NULL,
+    created timestamptz NOT NULL DEFAULT now(),
+    -- Compressed armadaevents.EventSequence
+    event   bytea       NOT NULL,
+    PRIMARY KEY (id, created)
+) PARTITION BY RANGE (created);
+
+CREATE INDEX idx_job_set_event_queue_job_set_id ON job_set_event (queue, job_set, id);
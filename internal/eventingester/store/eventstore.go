@@ -1,25 +1,27 @@
 package store
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/eventingester/configuration"
 	"github.com/armadaproject/armada/internal/eventingester/metrics"
 	"github.com/armadaproject/armada/internal/eventingester/model"
 )
 
-const (
-	eventStreamPrefix = "Events:"
-	dataKey           = "message"
-)
+const dataKey = "message"
 
 type RedisEventStore struct {
 	dbs                []redis.UniversalClient
@@ -91,15 +93,20 @@ func (repo *RedisEventStore) doStore(ctx *armadacontext.Context, update []*model
 	return ingest.WithRetry(func() (bool, error) {
 		var data []eventData
 		uniqueJobSets := make(map[string]bool)
+		jobSetsByQueue := make(map[string]map[string]bool)
 
 		for _, e := range update {
-			key := getJobSetEventsKey(e.Queue, e.Jobset)
+			key := jobsetevents.RedisEventStreamKey(e.Queue, e.Jobset)
 			data = append(data, eventData{key: key, data: e.Event})
 			uniqueJobSets[key] = true
+			if jobSetsByQueue[e.Queue] == nil {
+				jobSetsByQueue[e.Queue] = make(map[string]bool)
+			}
+			jobSetsByQueue[e.Queue][e.Jobset] = true
 		}
 
 		for i, db := range repo.dbs {
-			r, e := repo.writeToRedis(ctx, db, data, uniqueJobSets, repo.dbNames[i])
+			r, e := repo.writeToRedis(ctx, db, data, uniqueJobSets, jobSetsByQueue, repo.dbNames[i])
 			if e != nil {
 				return r, fmt.Errorf("error with redis %s: %v", repo.dbNames[i], e)
 			}
@@ -108,7 +115,14 @@ func (repo *RedisEventStore) doStore(ctx *armadacontext.Context, update []*model
 	}, repo.intialRetryBackoff, repo.maxRetryBackoff)
 }
 
-func (repo *RedisEventStore) writeToRedis(ctx *armadacontext.Context, db redis.UniversalClient, data []eventData, uniqueJobSets map[string]bool, redisName string) (bool, error) {
+func (repo *RedisEventStore) writeToRedis(
+	ctx *armadacontext.Context,
+	db redis.UniversalClient,
+	data []eventData,
+	uniqueJobSets map[string]bool,
+	jobSetsByQueue map[string]map[string]bool,
+	redisName string,
+) (bool, error) {
 	start := time.Now()
 	pipe := db.Pipeline()
 	for _, e := range data {
@@ -125,6 +139,20 @@ func (repo *RedisEventStore) writeToRedis(ctx *armadacontext.Context, db redis.U
 		pipe.Expire(ctx, key, repo.eventRetention.RetentionDuration)
 	}
 
+	// Record the job sets of each queue, so they can be listed without scanning the keyspace. Job sets whose streams
+	// have expired are removed.
+	now := time.Now()
+	for queue, jobSets := range jobSetsByQueue {
+		key := jobsetevents.RedisQueueJobSetsKey(queue)
+		members := make([]redis.Z, 0, len(jobSets))
+		for jobSet := range jobSets {
+			members = append(members, redis.Z{Score: float64(now.Unix()), Member: jobSet})
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByScore(ctx, key, "-inf", fmt.Sprintf("(%d", now.Add(-repo.eventRetention.RetentionDuration).Unix()))
+		pipe.Expire(ctx, key, repo.eventRetention.RetentionDuration)
+	}
+
 	cmders, err := pipe.Exec(ctx)
 	if err != nil {
 		metrics.RecordWriteDuration(redisName, "failed_write", time.Since(start))
@@ -141,6 +169,82 @@ func (repo *RedisEventStore) writeToRedis(ctx *armadacontext.Context, db redis.U
 	return false, nil
 }
 
+// BackfillQueueJobSets records the job sets of all event streams in db in the sorted sets of their queues,
+// such that the job sets of streams written before the event ingester recorded them can be watched with WatchQueue.
+// Each job set is scored by when its stream was last written to, which is inferred from its expiry.
+// Job sets already recorded with a later score, e.g., by a running event ingester, are left unchanged.
+// It returns the number of streams found.
+func BackfillQueueJobSets(ctx *armadacontext.Context, db redis.UniversalClient, retention time.Duration) (int, error) {
+	if cluster, ok := db.(*redis.ClusterClient); ok {
+		var numStreams atomic.Int64
+		err := cluster.ForEachMaster(ctx, func(_ context.Context, client *redis.Client) error {
+			n, err := backfillQueueJobSets(ctx, client, retention)
+			numStreams.Add(int64(n))
+			return err
+		})
+		return int(numStreams.Load()), err
+	}
+	return backfillQueueJobSets(ctx, db, retention)
+}
+
+func backfillQueueJobSets(ctx *armadacontext.Context, db redis.UniversalClient, retention time.Duration) (int, error) {
+	numStreams := 0
+	iter := db.Scan(ctx, 0, jobsetevents.RedisEventStreamPrefix+"*", 1000).Iterator()
+	var keys []string
+	flush := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		ttls := make([]*redis.DurationCmd, len(keys))
+		if _, err := db.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, key := range keys {
+				ttls[i] = pipe.TTL(ctx, key)
+			}
+			return nil
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+		now := time.Now()
+		if _, err := db.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, key := range keys {
+				// Streams are given the retention duration as expiry whenever they're written to.
+				lastWritten := now
+				if ttl := ttls[i].Val(); ttl > 0 {
+					lastWritten = now.Add(ttl - retention)
+				} else if ttl != -1 {
+					// The stream has expired since it was found.
+					continue
+				}
+				queue, jobSetId, ok := strings.Cut(strings.TrimPrefix(key, jobsetevents.RedisEventStreamPrefix), ":")
+				if !ok {
+					continue
+				}
+				jobSetsKey := jobsetevents.RedisQueueJobSetsKey(queue)
+				pipe.ZAddGT(ctx, jobSetsKey, redis.Z{Score: float64(lastWritten.Unix()), Member: jobSetId})
+				pipe.Expire(ctx, jobSetsKey, retention)
+				numStreams++
+			}
+			return nil
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+		keys = keys[:0]
+		return nil
+	}
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == 1000 {
+			if err := flush(); err != nil {
+				return numStreams, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return numStreams, errors.WithStack(err)
+	}
+	return numStreams, flush()
+}
+
 func populateRedisSequenceIds(cmders []redis.Cmder, data []eventData) error {
 	for i := range data {
 		c := cmders[i]
@@ -173,7 +277,3 @@ func (repo *RedisEventStore) isRetryableRedisError(err error) bool {
 	}
 	return true
 }
-
-func getJobSetEventsKey(queue, jobSetId string) string {
-	return eventStreamPrefix + queue + ":" + jobSetId
-}
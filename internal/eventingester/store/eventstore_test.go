@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
 	"github.com/armadaproject/armada/internal/eventingester/configuration"
 	"github.com/armadaproject/armada/internal/eventingester/model"
 )
@@ -42,10 +43,50 @@ func TestReportEvents(t *testing.T) {
 			read2, err := ReadEvent(ctx, db, "testQueue", "testJobset2")
 			assert.NoError(t, err)
 			assert.Equal(t, update.Events[1].Event, read2)
+
+			jobSets, err := db.ZRange(ctx, jobsetevents.RedisQueueJobSetsKey("testQueue"), 0, -1).Result()
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"testJobset", "testJobset2"}, jobSets)
 		}
 	})
 }
 
+func TestBackfillQueueJobSets(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	withRedisEventStore(ctx, func(r *RedisEventStore) {
+		db := r.dbs[0]
+		// Streams written before job sets were recorded, the second one 10 minutes after the first.
+		for _, stream := range []struct {
+			queue, jobSetId string
+			ttl             time.Duration
+		}{
+			{"queueA", "jobSet1", 50 * time.Minute},
+			{"queueA", "jobSet:2", time.Hour},
+			{"queueB", "jobSet3", time.Hour},
+		} {
+			key := jobsetevents.RedisEventStreamKey(stream.queue, stream.jobSetId)
+			assert.NoError(t, db.XAdd(ctx, &redis.XAddArgs{Stream: key, Values: map[string]interface{}{dataKey: []byte{1}}}).Err())
+			assert.NoError(t, db.Expire(ctx, key, stream.ttl).Err())
+		}
+
+		numStreams, err := BackfillQueueJobSets(ctx, db, r.eventRetention.RetentionDuration)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, numStreams)
+
+		jobSets, err := db.ZRangeWithScores(ctx, jobsetevents.RedisQueueJobSetsKey("queueA"), 0, -1).Result()
+		assert.NoError(t, err)
+		if assert.Len(t, jobSets, 2) {
+			assert.Equal(t, "jobSet1", jobSets[0].Member)
+			assert.Equal(t, "jobSet:2", jobSets[1].Member)
+			assert.InDelta(t, 10*time.Minute.Seconds(), jobSets[1].Score-jobSets[0].Score, 2)
+		}
+		jobSetIds, err := db.ZRange(ctx, jobsetevents.RedisQueueJobSetsKey("queueB"), 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"jobSet3"}, jobSetIds)
+	})
+}
+
 func withRedisEventStore(ctx *armadacontext.Context, action func(es *RedisEventStore)) {
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 	defer client.FlushDB(ctx)
@@ -68,7 +109,7 @@ func withRedisEventStore(ctx *armadacontext.Context, action func(es *RedisEventS
 
 func ReadEvent(ctx *armadacontext.Context, r redis.UniversalClient, queue string, jobset string) ([]byte, error) {
 	cmd, err := r.XRead(ctx, &redis.XReadArgs{
-		Streams: []string{getJobSetEventsKey(queue, jobset), "0"},
+		Streams: []string{jobsetevents.RedisEventStreamKey(queue, jobset), "0"},
 		Count:   500,
 		Block:   1 * time.Second,
 	}).Result()
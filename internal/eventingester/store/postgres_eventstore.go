@@ -0,0 +1,179 @@
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/eventingester/configuration"
+	"github.com/armadaproject/armada/internal/eventingester/metrics"
+	"github.com/armadaproject/armada/internal/eventingester/model"
+)
+
+const (
+	eventTable = "job_set_event"
+	// Key of the advisory lock writers hold while inserting events, such that ids are committed in increasing order.
+	insertLockKey = eventTable
+	// Notification payloads must be shorter than 8000 bytes; readers of job sets with longer keys fall back to polling.
+	maxNotificationPayloadSize = 7999
+	// Partitions are created for this many days ahead.
+	partitionsAhead = 2
+	// How often partitions are created and dropped.
+	partitionMaintenanceInterval = time.Hour
+	partitionDateFormat          = "20060102"
+)
+
+// PostgresEventStore stores events in a Postgres table partitioned by day.
+// Events are assigned increasing ids in the order they're stored, and readers rely on ids being committed in that
+// order, since a reader that has read past an id never reads lower ids. Ids are allocated by a sequence, which doesn't
+// guarantee this with concurrent writers, e.g., several event ingester replicas each consuming some partitions of the
+// events topic, so writers serialise inserts with an advisory lock held until they commit.
+type PostgresEventStore struct {
+	db                 *pgxpool.Pool
+	eventRetention     configuration.EventRetentionPolicy
+	fatalErrors        []*regexp.Regexp
+	intialRetryBackoff time.Duration
+	maxRetryBackoff    time.Duration
+	// Time at which partitions were last created and dropped.
+	lastPartitionMaintenance time.Time
+}
+
+func NewPostgresEventStore(db *pgxpool.Pool, eventRetention configuration.EventRetentionPolicy, fatalErrors []*regexp.Regexp, intialRetryBackoff time.Duration, maxRetryBackoff time.Duration) ingest.Sink[*model.BatchUpdate] {
+	return &PostgresEventStore{
+		db:                 db,
+		eventRetention:     eventRetention,
+		fatalErrors:        fatalErrors,
+		intialRetryBackoff: intialRetryBackoff,
+		maxRetryBackoff:    maxRetryBackoff,
+	}
+}
+
+func (s *PostgresEventStore) Store(ctx *armadacontext.Context, update *model.BatchUpdate) error {
+	if len(update.Events) == 0 {
+		return nil
+	}
+	return ingest.WithRetry(func() (bool, error) {
+		if now := time.Now(); now.Sub(s.lastPartitionMaintenance) >= partitionMaintenanceInterval {
+			if err := s.maintainPartitions(ctx, now); err != nil {
+				return s.isRetryableError(err), err
+			}
+			s.lastPartitionMaintenance = now
+		}
+
+		start := time.Now()
+		if err := s.insert(ctx, update.Events); err != nil {
+			metrics.RecordWriteDuration("postgres", "failed_write", time.Since(start))
+			return s.isRetryableError(err), err
+		}
+		metrics.RecordWriteDuration("postgres", "success", time.Since(start))
+		return false, nil
+	}, s.intialRetryBackoff, s.maxRetryBackoff)
+}
+
+// insert stores events and notifies listeners of the job sets they're in, in a single transaction.
+func (s *PostgresEventStore) insert(ctx *armadacontext.Context, events []*model.Event) error {
+	return pgx.BeginTxFunc(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// Ids are allocated only once the lock is held, and the lock is released on commit or rollback,
+		// so no transaction can commit ids lower than those of a transaction that has already committed.
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", insertLockKey); err != nil {
+			return errors.WithStack(err)
+		}
+		_, err := tx.CopyFrom(ctx,
+			pgx.Identifier{eventTable},
+			[]string{"queue", "job_set", "event"},
+			pgx.CopyFromSlice(len(events), func(i int) ([]any, error) {
+				return []any{events[i].Queue, events[i].Jobset, events[i].Event}, nil
+			}),
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		notified := make(map[string]bool)
+		for _, e := range events {
+			payload := jobsetevents.NotificationPayload(e.Queue, e.Jobset)
+			if notified[payload] || len(payload) > maxNotificationPayloadSize {
+				continue
+			}
+			if _, err := tx.Exec(ctx, "SELECT pg_notify($1, $2)", jobsetevents.PostgresNotificationChannel, payload); err != nil {
+				return errors.WithStack(err)
+			}
+			notified[payload] = true
+		}
+		return nil
+	})
+}
+
+// maintainPartitions creates the partitions events will be stored in over the next few days, and drops those whose
+// events are all older than the retention period.
+func (s *PostgresEventStore) maintainPartitions(ctx *armadacontext.Context, now time.Time) error {
+	today := now.UTC().Truncate(24 * time.Hour)
+	for i := 0; i <= partitionsAhead; i++ {
+		from := today.AddDate(0, 0, i)
+		sql := fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			partitionName(from), eventTable, from.Format(time.RFC3339), from.AddDate(0, 0, 1).Format(time.RFC3339),
+		)
+		if _, err := s.db.Exec(ctx, sql); err != nil {
+			return errors.Wrapf(err, "error creating partition %s", partitionName(from))
+		}
+	}
+
+	rows, err := s.db.Query(ctx, `
+		SELECT child.relname
+		FROM pg_inherits
+		JOIN pg_class parent ON parent.oid = pg_inherits.inhparent
+		JOIN pg_class child ON child.oid = pg_inherits.inhrelid
+		WHERE parent.relname = $1`, eventTable)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	partitions, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	expiry := now.Add(-s.eventRetention.RetentionDuration)
+	for _, partition := range partitions {
+		from, err := time.Parse(partitionDateFormat, strings.TrimPrefix(partition, eventTable+"_"))
+		if err != nil {
+			log.Warnf("Ignoring partition %s of %s as its name doesn't contain a date", partition, eventTable)
+			continue
+		}
+		if from.AddDate(0, 0, 1).After(expiry) {
+			continue
+		}
+		log.Infof("Dropping partition %s as its events are older than %s", partition, s.eventRetention.RetentionDuration)
+		if _, err := s.db.Exec(ctx, "DROP TABLE IF EXISTS "+partition); err != nil {
+			return errors.Wrapf(err, "error dropping partition %s", partition)
+		}
+	}
+	return nil
+}
+
+func partitionName(from time.Time) string {
+	return eventTable + "_" + from.Format(partitionDateFormat)
+}
+
+// isRetryableError returns true if the error doesn't match the list of fatal errors
+func (s *PostgresEventStore) isRetryableError(err error) bool {
+	if err == nil {
+		return true
+	}
+	msg := err.Error()
+	for _, r := range s.fatalErrors {
+		if r.MatchString(msg) {
+			log.Infof("Error %s matched regex %s and so will be considered fatal", msg, r)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/eventingester/configuration"
+	"github.com/armadaproject/armada/internal/eventingester/model"
+	"github.com/armadaproject/armada/internal/eventingester/schema"
+)
+
+func TestPostgresEventStore_Store(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		s := NewPostgresEventStore(db, configuration.EventRetentionPolicy{RetentionDuration: time.Hour}, nil, time.Millisecond, time.Millisecond)
+		update := &model.BatchUpdate{
+			Events: []*model.Event{
+				{Queue: "testQueue", Jobset: "testJobset", Event: []byte{1}},
+				{Queue: "testQueue", Jobset: "testJobset2", Event: []byte{2}},
+				{Queue: "testQueue", Jobset: "testJobset", Event: []byte{3}},
+			},
+		}
+		require.NoError(t, s.Store(ctx, update))
+
+		rows, err := db.Query(ctx, "SELECT event FROM job_set_event WHERE queue = $1 AND job_set = $2 ORDER BY id", "testQueue", "testJobset")
+		require.NoError(t, err)
+		events, err := pgx.CollectRows(rows, pgx.RowTo[[]byte])
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{{1}, {3}}, events)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresEventStore_StoreWaitsForConcurrentWriters(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		s := NewPostgresEventStore(db, configuration.EventRetentionPolicy{RetentionDuration: time.Hour}, nil, time.Millisecond, time.Millisecond)
+		require.NoError(t, s.Store(ctx, &model.BatchUpdate{Events: []*model.Event{{Queue: "testQueue", Jobset: "testJobset", Event: []byte{1}}}}))
+
+		// Another writer, e.g., another event ingester replica, that has allocated ids but not yet committed.
+		tx, err := db.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+		_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", insertLockKey)
+		require.NoError(t, err)
+		_, err = tx.Exec(ctx, "INSERT INTO job_set_event (queue, job_set, event) VALUES ($1, $2, $3)", "testQueue", "testJobset", []byte{2})
+		require.NoError(t, err)
+
+		stored := make(chan error, 1)
+		go func() {
+			stored <- s.Store(ctx, &model.BatchUpdate{Events: []*model.Event{{Queue: "testQueue", Jobset: "testJobset", Event: []byte{3}}}})
+		}()
+		select {
+		case err := <-stored:
+			t.Fatalf("events were stored while another writer held the lock: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+		require.NoError(t, tx.Commit(ctx))
+		require.NoError(t, <-stored)
+
+		// Ids increase in the order writers committed, so readers never skip events committed after they've read.
+		rows, err := db.Query(ctx, "SELECT event FROM job_set_event WHERE queue = $1 AND job_set = $2 ORDER BY id", "testQueue", "testJobset")
+		require.NoError(t, err)
+		events, err := pgx.CollectRows(rows, pgx.RowTo[[]byte])
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{{1}, {2}, {3}}, events)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresEventStore_MaintainPartitions(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		s := &PostgresEventStore{db: db, eventRetention: configuration.EventRetentionPolicy{RetentionDuration: 24 * time.Hour}}
+		now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+		require.NoError(t, s.maintainPartitions(ctx, now.AddDate(0, 0, -3)))
+		require.NoError(t, s.maintainPartitions(ctx, now))
+
+		rows, err := db.Query(ctx, `
+			SELECT child.relname
+			FROM pg_inherits
+			JOIN pg_class parent ON parent.oid = pg_inherits.inhparent
+			JOIN pg_class child ON child.oid = pg_inherits.inhrelid
+			WHERE parent.relname = 'job_set_event'
+			ORDER BY child.relname`)
+		require.NoError(t, err)
+		partitions, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		require.NoError(t, err)
+		// Partitions ending more than a day ago are dropped.
+		assert.Equal(t, []string{
+			"job_set_event_20240309",
+			"job_set_event_20240310",
+			"job_set_event_20240311",
+			"job_set_event_20240312",
+		}, partitions)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPartitionName(t *testing.T) {
+	assert.Equal(t, "job_set_event_20240301", partitionName(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/cluster"
 	"github.com/armadaproject/armada/internal/common/etcdhealth"
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
+	"github.com/armadaproject/armada/internal/common/logarchive"
 	common_metrics "github.com/armadaproject/armada/internal/common/metrics"
 	"github.com/armadaproject/armada/internal/common/task"
 	"github.com/armadaproject/armada/internal/common/util"
@@ -139,14 +140,31 @@ func StartUpWithContext(
 	if err != nil {
 		ctx.Fatalf("Config error in pending pod checks: %s", err)
 	}
+	if deadline := config.Kubernetes.PendingPodChecks.DeadlineForNodeAssignment; deadline > 0 && config.Kubernetes.MaxPreemptionNoticePeriod >= deadline {
+		ctx.Fatalf(
+			"Config error: maxPreemptionNoticePeriod (%s) must be less than pendingPodChecks.deadlineForNodeAssignment (%s)",
+			config.Kubernetes.MaxPreemptionNoticePeriod, deadline,
+		)
+	}
 
 	stopExecutorApiComponents := setupExecutorApiComponents(ctx, config, clusterContext, clusterHealthMonitor, taskManager, pendingPodChecker, nodeInfoService, podUtilisationService)
 
-	resourceCleanupService, err := service.NewResourceCleanupService(clusterContext, config.Kubernetes)
+	var logArchive logarchive.Archive
+	if config.LogArchive.Enabled() {
+		logArchive, err = logarchive.New(config.LogArchive)
+		if err != nil {
+			ctx.Fatalf("Error creating log archive: %s", err)
+		}
+	}
+
+	resourceCleanupService, err := service.NewResourceCleanupService(clusterContext, config.Kubernetes, logArchive, config.LogArchive.Retention)
 	if err != nil {
 		ctx.Fatalf("Error creating resource cleanup service: %s", err)
 	}
 	taskManager.Register(resourceCleanupService.CleanupResources, config.Task.ResourceCleanupInterval, "resource_cleanup")
+	if logArchive != nil {
+		taskManager.Register(resourceCleanupService.DeleteExpiredLogs, config.Task.LogArchiveRetentionInterval, "log_archive_retention")
+	}
 
 	if config.Metric.ExposeQueueUsageMetrics {
 		taskManager.Register(podUtilisationService.RefreshUtilisationData, config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
@@ -207,7 +225,7 @@ func setupExecutorApiComponents(
 	)
 
 	leaseRequester := service.NewJobLeaseRequester(executorApiClient, clusterContext)
-	preemptRunProcessor := processors.NewRunPreemptedProcessor(clusterContext, jobRunState, eventReporter)
+	preemptRunProcessor := processors.NewRunPreemptedProcessor(clusterContext, jobRunState, eventReporter, config.Kubernetes.MaxPreemptionNoticePeriod)
 	removeRunProcessor := processors.NewRemoveRunProcessor(clusterContext, jobRunState)
 
 	jobRequester := service.NewJobRequester(
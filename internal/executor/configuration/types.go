@@ -5,6 +5,7 @@ import (
 
 	"google.golang.org/grpc/keepalive"
 
+	logarchiveconfig "github.com/armadaproject/armada/internal/common/logarchive/configuration"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/executor/configuration/podchecks"
@@ -88,6 +89,10 @@ type KubernetesConfiguration struct {
 	// MinimumResourcesMarkedAllocatedToNonArmadaPodsPerNode, those resources are marked allocated at this priority.
 	MinimumResourcesMarkedAllocatedToNonArmadaPodsPerNodePriority int32
 	PodKillTimeout                                                time.Duration
+	// Maximum notice given to preempted pods before they're killed, regardless of their priority class. Other jobs may
+	// already be scheduled onto the resources of pods given notice, so this must be less than the deadline for node
+	// assignment of PendingPodChecks, or those jobs may be failed for not starting in time.
+	MaxPreemptionNoticePeriod time.Duration
 }
 
 type EtcdConfiguration struct {
@@ -135,6 +140,7 @@ type TaskConfiguration struct {
 	UtilisationEventProcessingInterval    time.Duration
 	UtilisationEventReportingInterval     time.Duration
 	ResourceCleanupInterval               time.Duration
+	LogArchiveRetentionInterval           time.Duration
 	StateProcessorInterval                time.Duration
 }
 
@@ -178,4 +184,6 @@ type ExecutorConfiguration struct {
 
 	Kubernetes KubernetesConfiguration
 	Task       TaskConfiguration
+	// Where to archive the logs of finished runs, so they can still be viewed once their pods have been removed.
+	LogArchive logarchiveconfig.LogArchiveConfig
 }
@@ -1,8 +1,11 @@
 package context
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,7 +22,9 @@ import (
 	discovery_informer "k8s.io/client-go/informers/discovery/v1"
 	network_informer "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
@@ -51,6 +56,8 @@ type ClusterContext interface {
 	GetNode(nodeName string) (*v1.Node, error)
 	GetNodeStatsSummary(*armadacontext.Context, *v1.Node) (*v1alpha1.Summary, error)
 	GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
+	GetPodLogs(pod *v1.Pod, container string) (io.ReadCloser, error)
+	ExecInPod(ctx *armadacontext.Context, pod *v1.Pod, container string, command []string) error
 	GetServices(pod *v1.Pod) ([]*v1.Service, error)
 	GetIngresses(pod *v1.Pod) ([]*networking.Ingress, error)
 	GetEndpointSlices(namespace string, labelName string, labelValue string) ([]*discovery.EndpointSlice, error)
@@ -58,10 +65,14 @@ type ClusterContext interface {
 	SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error)
 	SubmitService(service *v1.Service) (*v1.Service, error)
 	SubmitIngress(ingress *networking.Ingress) (*networking.Ingress, error)
+	SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	SubmitSecret(secret *v1.Secret) (*v1.Secret, error)
 	DeletePodWithCondition(pod *v1.Pod, condition func(pod *v1.Pod) bool, pessimistic bool) error
 	DeletePods(pods []*v1.Pod)
 	DeleteService(service *v1.Service) error
 	DeleteIngress(ingress *networking.Ingress) error
+	DeleteConfigMap(namespace string, name string) error
+	DeleteSecret(namespace string, name string) error
 
 	AddAnnotation(pod *v1.Pod, annotations map[string]string) error
 
@@ -212,6 +223,42 @@ func (c *KubernetesClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error
 	return eventsTyped, nil
 }
 
+// GetPodLogs returns the complete log of a container of pod, with each line prefixed by its timestamp.
+func (c *KubernetesClusterContext) GetPodLogs(pod *v1.Pod, container string) (io.ReadCloser, error) {
+	return c.kubernetesClient.CoreV1().
+		Pods(pod.Namespace).
+		GetLogs(pod.Name, &v1.PodLogOptions{Container: container, Timestamps: true}).
+		Stream(armadacontext.Background())
+}
+
+// ExecInPod runs command in the given container of pod, returning an error if it couldn't be run or didn't succeed.
+func (c *KubernetesClusterContext) ExecInPod(ctx *armadacontext.Context, pod *v1.Pod, container string, command []string) error {
+	request := c.kubernetesClient.
+		CoreV1().
+		RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(c.kubernetesClientProvider.ClientConfig(), "POST", request.URL())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	var stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: io.Discard, Stderr: &stderr})
+	if err != nil {
+		return errors.Wrapf(err, "failed to exec in pod %s: %s", pod.Name, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
 func (c *KubernetesClusterContext) GetNodes() ([]*v1.Node, error) {
 	return c.nodeInformer.Lister().List(labels.Everything())
 }
@@ -265,6 +312,14 @@ func (c *KubernetesClusterContext) SubmitIngress(ingress *networking.Ingress) (*
 	return c.kubernetesClient.NetworkingV1().Ingresses(ingress.Namespace).Create(armadacontext.Background(), ingress, metav1.CreateOptions{})
 }
 
+func (c *KubernetesClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	return c.kubernetesClient.CoreV1().ConfigMaps(configMap.Namespace).Create(armadacontext.Background(), configMap, metav1.CreateOptions{})
+}
+
+func (c *KubernetesClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	return c.kubernetesClient.CoreV1().Secrets(secret.Namespace).Create(armadacontext.Background(), secret, metav1.CreateOptions{})
+}
+
 func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	patch := &domain.Patch{
 		MetaData: metav1.ObjectMeta{
@@ -362,6 +417,24 @@ func (c *KubernetesClusterContext) DeleteIngress(ingress *networking.Ingress) er
 	return err
 }
 
+func (c *KubernetesClusterContext) DeleteConfigMap(namespace string, name string) error {
+	deleteOptions := createDeleteOptions()
+	err := c.kubernetesClient.CoreV1().ConfigMaps(namespace).Delete(armadacontext.Background(), name, deleteOptions)
+	if err != nil && k8s_errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *KubernetesClusterContext) DeleteSecret(namespace string, name string) error {
+	deleteOptions := createDeleteOptions()
+	err := c.kubernetesClient.CoreV1().Secrets(namespace).Delete(armadacontext.Background(), name, deleteOptions)
+	if err != nil && k8s_errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 func (c *KubernetesClusterContext) ProcessPodsToDelete() {
 	pods := c.podsToDelete.GetAll()
 	util.ProcessItemsWithThreadPool(armadacontext.Background(), c.deleteThreadCount, pods, func(podToDelete *v1.Pod) {
@@ -3,6 +3,8 @@ package fake
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
@@ -22,6 +24,15 @@ type SyncFakeClusterContext struct {
 	AnnotationsAdded map[string]map[string]string
 	podEventHandlers []*cache.ResourceEventHandlerFuncs
 	GetPodEventsErr  error
+	// Container logs by job id and container name.
+	PodLogs map[string]map[string]string
+	// ConfigMaps and Secrets by namespace/name.
+	ConfigMaps map[string]*v1.ConfigMap
+	Secrets    map[string]*v1.Secret
+	// If non-nil, returned by SubmitSecret instead of creating the secret.
+	SubmitSecretErr error
+	// Commands run in pods by job id.
+	ExecutedCommands map[string][][]string
 }
 
 func NewSyncFakeClusterContext() *SyncFakeClusterContext {
@@ -29,6 +40,10 @@ func NewSyncFakeClusterContext() *SyncFakeClusterContext {
 		Pods:             map[string]*v1.Pod{},
 		Events:           map[string][]*v1.Event{},
 		AnnotationsAdded: map[string]map[string]string{},
+		PodLogs:          map[string]map[string]string{},
+		ExecutedCommands: map[string][][]string{},
+		ConfigMaps:       map[string]*v1.ConfigMap{},
+		Secrets:          map[string]*v1.Secret{},
 	}
 	return c
 }
@@ -72,6 +87,20 @@ func (c *SyncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
 	return c.Events[jobId], nil
 }
 
+func (c *SyncFakeClusterContext) GetPodLogs(pod *v1.Pod, container string) (io.ReadCloser, error) {
+	log, ok := c.PodLogs[util2.ExtractJobId(pod)][container]
+	if !ok {
+		return nil, fmt.Errorf("no logs for container %s of pod %s", container, pod.Name)
+	}
+	return io.NopCloser(strings.NewReader(log)), nil
+}
+
+func (c *SyncFakeClusterContext) ExecInPod(ctx *armadacontext.Context, pod *v1.Pod, container string, command []string) error {
+	jobId := util2.ExtractJobId(pod)
+	c.ExecutedCommands[jobId] = append(c.ExecutedCommands[jobId], command)
+	return nil
+}
+
 func (c *SyncFakeClusterContext) SubmitService(service *v1.Service) (*v1.Service, error) {
 	return nil, fmt.Errorf("Services not implemented in SyncFakeClusterContext")
 }
@@ -100,6 +129,29 @@ func (c *SyncFakeClusterContext) DeleteIngress(ingress *networking.Ingress) erro
 	return fmt.Errorf("Ingresses not implemented in SyncFakeClusterContext")
 }
 
+func (c *SyncFakeClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	c.ConfigMaps[configMap.Namespace+"/"+configMap.Name] = configMap
+	return configMap, nil
+}
+
+func (c *SyncFakeClusterContext) DeleteConfigMap(namespace string, name string) error {
+	delete(c.ConfigMaps, namespace+"/"+name)
+	return nil
+}
+
+func (c *SyncFakeClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	if c.SubmitSecretErr != nil {
+		return nil, c.SubmitSecretErr
+	}
+	c.Secrets[secret.Namespace+"/"+secret.Name] = secret
+	return secret, nil
+}
+
+func (c *SyncFakeClusterContext) DeleteSecret(namespace string, name string) error {
+	delete(c.Secrets, namespace+"/"+name)
+	return nil
+}
+
 func (c *SyncFakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
 	c.Pods[pod.Labels[domain.JobId]] = pod
 	return pod, nil
@@ -15,4 +15,11 @@ const (
 	MarkedForDeletion        = "deletion_requested"
 	JobDoneAnnotation        = "reported_done"
 	JobPreemptedAnnotation   = "reported_preempted"
+	LogsArchivedAnnotation   = "logs_archived"
+)
+
+// Annotations holding the comma-separated names of the ConfigMaps and Secrets created alongside a pod.
+const (
+	JobScopedConfigMaps = "job_scoped_config_maps"
+	JobScopedSecrets    = "job_scoped_secrets"
 )
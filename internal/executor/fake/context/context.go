@@ -2,6 +2,7 @@ package context
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
 	"regexp"
 	"sort"
@@ -125,6 +126,14 @@ func (c *FakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
 	return []*v1.Event{}, nil
 }
 
+func (c *FakeClusterContext) GetPodLogs(pod *v1.Pod, container string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (c *FakeClusterContext) ExecInPod(ctx *armadacontext.Context, pod *v1.Pod, container string, command []string) error {
+	return nil
+}
+
 func (c *FakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
 	saved := c.savePod(pod)
 
@@ -208,6 +217,23 @@ func (c *FakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return errors.Errorf("Ingresses not implemented in FakeClusterContext")
 }
 
+// ConfigMaps and Secrets aren't needed by fake pods, so aren't stored.
+func (c *FakeClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	return configMap, nil
+}
+
+func (c *FakeClusterContext) DeleteConfigMap(namespace string, name string) error {
+	return nil
+}
+
+func (c *FakeClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	return secret, nil
+}
+
+func (c *FakeClusterContext) DeleteSecret(namespace string, name string) error {
+	return nil
+}
+
 func (c *FakeClusterContext) updateStatus(saved *v1.Pod, phase v1.PodPhase, state v1.ContainerState) (*v1.Pod, *v1.Pod) {
 	c.rwLock.Lock()
 	oldPod := saved.DeepCopy()
@@ -14,10 +14,12 @@ type SubmitJobMeta struct {
 }
 
 type SubmitJob struct {
-	Meta      SubmitJobMeta
-	Pod       *v1.Pod
-	Ingresses []*networking.Ingress
-	Services  []*v1.Service
+	Meta       SubmitJobMeta
+	Pod        *v1.Pod
+	Ingresses  []*networking.Ingress
+	Services   []*v1.Service
+	ConfigMaps []*v1.ConfigMap
+	Secrets    []*v1.Secret
 }
 
 type RunMeta struct {
@@ -2,6 +2,11 @@ package processors
 
 import (
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -13,24 +18,41 @@ import (
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/util"
-	"github.com/armadaproject/armada/pkg/armadaevents"
+	"github.com/armadaproject/armada/internal/server/configuration"
 )
 
+// RunPreemptedProcessor kills the pods of runs the scheduler has preempted.
+// Running pods with a preemption notice period are first given notice: a JobRunPreemptionNotice event is reported,
+// the pod is annotated with the time it'll be killed and any preemption notice hooks of the pod are run.
+// The scheduler reports the run as preempted itself, so no further events are reported.
+//
+// The scheduler may already have scheduled other jobs onto the resources of a run given notice, so notice periods are
+// capped at maxNoticePeriod, which should leave those jobs' pods time to start before they're considered stuck.
 type RunPreemptedProcessor struct {
 	clusterContext   executorContext.ClusterContext
 	jobRunStateStore job.RunStateStore
 	eventReporter    reporter.EventReporter
+	maxNoticePeriod  time.Duration
+	httpClient       *http.Client
 }
 
 func NewRunPreemptedProcessor(
 	clusterContext executorContext.ClusterContext,
 	jobRunStateStore job.RunStateStore,
 	eventReporter reporter.EventReporter,
+	maxNoticePeriod time.Duration,
 ) *RunPreemptedProcessor {
 	return &RunPreemptedProcessor{
 		clusterContext:   clusterContext,
 		jobRunStateStore: jobRunStateStore,
 		eventReporter:    eventReporter,
+		maxNoticePeriod:  maxNoticePeriod,
+		httpClient: &http.Client{
+			// Hooks are only ever sent to the pod itself.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 }
 
@@ -41,28 +63,40 @@ func (j *RunPreemptedProcessor) Run() {
 		return
 	}
 
-	runsToCancel := j.jobRunStateStore.GetAllWithFilter(func(state *job.RunState) bool {
+	runsToPreempt := j.jobRunStateStore.GetAllWithFilter(func(state *job.RunState) bool {
 		return state.PreemptionRequested
 	})
-	runPodInfos := createRunPodInfos(runsToCancel, managedPods)
+	runPodInfos := createRunPodInfos(runsToPreempt, managedPods)
 
 	util.ProcessItemsWithThreadPool(armadacontext.Background(), 20, runPodInfos,
 		func(runInfo *runPodInfo) {
 			pod := runInfo.Pod
-			if pod == nil {
-				// No pod to preempt
+			if pod == nil || util.IsInTerminalState(pod) {
+				// Nothing to preempt, so clean up the run as if it were cancelled
+				j.jobRunStateStore.RequestRunCancellation(runInfo.Run.Meta.RunId)
 				return
 			}
 
-			if util.IsInTerminalState(pod) {
-				// If pod is already finished, nothing to preempt
-				return
+			noticePeriod := min(util.GetPreemptionNoticePeriod(pod), j.maxNoticePeriod)
+			if noticePeriod > 0 && pod.Status.Phase == v1.PodRunning {
+				deadline, noticeGiven := util.GetPreemptionNoticeDeadline(pod)
+				if !noticeGiven {
+					err := j.givePreemptionNotice(runInfo.Run, pod, time.Now().Add(noticePeriod))
+					if err != nil {
+						log.Errorf("failed to give run (runId = %s, jobId = %s) notice of preemption because %s ",
+							runInfo.Run.Meta.RunId, runInfo.Run.Meta.JobId, err)
+					}
+					return
+				}
+				if time.Now().Before(deadline) {
+					return
+				}
 			}
 
 			if !util.IsReportedPreempted(pod) {
-				err := j.reportPodPreempted(runInfo.Run, runInfo.Pod)
+				err := j.markPodPreempted(pod)
 				if err != nil {
-					log.Errorf("failed to report run (runId = %s, jobId = %s) preempted because %s ",
+					log.Errorf("failed to mark run (runId = %s, jobId = %s) preempted because %s ",
 						runInfo.Run.Meta.RunId, runInfo.Run.Meta.JobId, err)
 					return
 				}
@@ -73,26 +107,89 @@ func (j *RunPreemptedProcessor) Run() {
 	)
 }
 
-func (j *RunPreemptedProcessor) reportPodPreempted(run *job.RunState, pod *v1.Pod) error {
-	preemptedEvent, err := reporter.CreateSimpleJobPreemptedEvent(pod)
+func (j *RunPreemptedProcessor) givePreemptionNotice(run *job.RunState, pod *v1.Pod, deadline time.Time) error {
+	noticeEvent, err := reporter.CreateJobRunPreemptionNoticeEvent(pod, deadline)
+	if err != nil {
+		return fmt.Errorf("failed creating preemption notice event because - %s", err)
+	}
+	err = j.eventReporter.Report([]reporter.EventMessage{{Event: noticeEvent, JobRunId: run.Meta.RunId}})
 	if err != nil {
-		return fmt.Errorf("failed creating preempted event because - %s", err)
+		return fmt.Errorf("failed reporting preemption notice event because - %s", err)
 	}
-	failedEvent, err := reporter.CreateSimpleJobFailedEvent(pod, "Run preempted", "", j.clusterContext.GetClusterId(), armadaevents.KubernetesReason_AppError)
+
+	err = j.clusterContext.AddAnnotation(pod, map[string]string{
+		configuration.PreemptionNoticeDeadlineAnnotation: deadline.UTC().Format(time.RFC3339),
+	})
 	if err != nil {
-		return fmt.Errorf("failed creating failed event because - %s", err)
+		return fmt.Errorf("failed to annotate pod with preemption notice deadline - %s", err)
 	}
-	events := []reporter.EventMessage{
-		{Event: preemptedEvent, JobRunId: run.Meta.RunId},
-		{Event: failedEvent, JobRunId: run.Meta.RunId},
+
+	go j.runPreemptionNoticeHooks(pod, deadline)
+	return nil
+}
+
+// runPreemptionNoticeHooks runs the preemption notice hooks of the pod, giving up once the deadline has passed.
+func (j *RunPreemptedProcessor) runPreemptionNoticeHooks(pod *v1.Pod, deadline time.Time) {
+	ctx, cancel := armadacontext.WithDeadline(armadacontext.Background(), deadline)
+	defer cancel()
+
+	if command := pod.Annotations[configuration.PreemptionNoticeExecHookAnnotation]; command != "" && len(pod.Spec.Containers) > 0 {
+		err := j.clusterContext.ExecInPod(ctx, pod, pod.Spec.Containers[0].Name, []string{"/bin/sh", "-c", command})
+		if err != nil {
+			log.WithError(err).Warnf("Preemption notice exec hook of pod %s failed", pod.Name)
+		}
 	}
 
-	err = j.eventReporter.Report(events)
+	if portAndPath := pod.Annotations[configuration.PreemptionNoticeHttpHookAnnotation]; portAndPath != "" {
+		err := j.postPreemptionNotice(ctx, pod, portAndPath)
+		if err != nil {
+			log.WithError(err).Warnf("Preemption notice HTTP hook of pod %s failed", pod.Name)
+		}
+	}
+}
+
+func (j *RunPreemptedProcessor) postPreemptionNotice(ctx *armadacontext.Context, pod *v1.Pod, portAndPath string) error {
+	hookUrl, err := preemptionNoticeHookUrl(pod.Status.PodIP, portAndPath)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, hookUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+	response, err := j.httpClient.Do(request)
 	if err != nil {
-		return fmt.Errorf("failed reporting preempted events because - %s", err)
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", response.Status)
 	}
+	return nil
+}
 
-	err = j.clusterContext.AddAnnotation(pod, map[string]string{
+// preemptionNoticeHookUrl returns the URL on the pod's IP given by the port and path of a preemption notice HTTP hook,
+// e.g., "8080/checkpoint".
+func preemptionNoticeHookUrl(podIp string, portAndPath string) (*url.URL, error) {
+	ip := net.ParseIP(podIp)
+	if ip == nil {
+		return nil, fmt.Errorf("pod has no valid IP")
+	}
+	portString, path, _ := strings.Cut(portAndPath, "/")
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid port %q of preemption notice HTTP hook", portString)
+	}
+	return &url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(ip.String(), strconv.FormatUint(port, 10)),
+		Path:   "/" + path,
+	}, nil
+}
+
+// markPodPreempted annotates the pod so its failure isn't reported, as the scheduler has already reported the run as preempted.
+func (j *RunPreemptedProcessor) markPodPreempted(pod *v1.Pod) error {
+	err := j.clusterContext.AddAnnotation(pod, map[string]string{
 		domain.JobPreemptedAnnotation: time.Now().String(),
 		string(v1.PodFailed):          time.Now().String(),
 	})
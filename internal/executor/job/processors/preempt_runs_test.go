@@ -2,7 +2,11 @@ package processors
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -17,9 +21,12 @@ import (
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/reporter/mocks"
 	"github.com/armadaproject/armada/internal/executor/util"
+	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
+const maxNoticePeriod = 3 * time.Minute
+
 func TestRun_PreemptedRunProcessor(t *testing.T) {
 	pod := createPod()
 
@@ -30,6 +37,19 @@ func TestRun_PreemptedRunProcessor(t *testing.T) {
 	terminalPod := pod.DeepCopy()
 	terminalPod.Status.Phase = v1.PodFailed
 
+	podWithNoticePeriod := pod.DeepCopy()
+	podWithNoticePeriod.Status.Phase = v1.PodRunning
+	podWithNoticePeriod.Annotations[configuration.PreemptionNoticePeriodAnnotation] = "5m"
+
+	pendingPodWithNoticePeriod := podWithNoticePeriod.DeepCopy()
+	pendingPodWithNoticePeriod.Status.Phase = v1.PodPending
+
+	noticedPod := podWithNoticePeriod.DeepCopy()
+	noticedPod.Annotations[configuration.PreemptionNoticeDeadlineAnnotation] = time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+
+	noticeExpiredPod := podWithNoticePeriod.DeepCopy()
+	noticeExpiredPod.Annotations[configuration.PreemptionNoticeDeadlineAnnotation] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+
 	runMeta, err := job.ExtractJobRunMeta(pod)
 	require.NoError(t, err)
 
@@ -45,66 +65,101 @@ func TestRun_PreemptedRunProcessor(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		initialPod           *v1.Pod
-		initialRunState      *job.RunState
-		expectEvents         bool
-		expectAddAnnotations bool
-		expectPodDeleted     bool
+		initialPod                *v1.Pod
+		initialRunState           *job.RunState
+		expectNoticeEvent         bool
+		expectAddAnnotations      bool
+		expectNoticeAnnotation    bool
+		expectPodDeleted          bool
+		expectCancellationRequest bool
 	}{
-		"Reports preempted events and deletes pod": {
+		"Annotates and deletes pod": {
 			initialPod:           pod,
 			initialRunState:      preemptedJobRun,
 			expectAddAnnotations: true,
-			expectEvents:         true,
 			expectPodDeleted:     true,
 		},
-		"Only deletes pod if already reported": {
+		"Only deletes pod if already annotated": {
 			initialPod:       reportedPod,
 			initialRunState:  preemptedJobRun,
 			expectPodDeleted: true,
 		},
-		"Does nothing if no pod": {
-			initialPod:      nil,
-			initialRunState: preemptedJobRun,
+		"Requests cancellation if no pod": {
+			initialPod:                nil,
+			initialRunState:           preemptedJobRun,
+			expectCancellationRequest: true,
 		},
-		"Does nothing if pod is already terminal": {
-			initialPod:      terminalPod,
-			initialRunState: preemptedJobRun,
+		"Requests cancellation if pod is already terminal": {
+			initialPod:                terminalPod,
+			initialRunState:           preemptedJobRun,
+			expectCancellationRequest: true,
 		},
 		"Does nothing if run is not marked for preemption": {
 			initialPod:      pod,
 			initialRunState: activeJobRun,
 		},
+		"Gives notice of preemption": {
+			initialPod:             podWithNoticePeriod,
+			initialRunState:        preemptedJobRun,
+			expectNoticeEvent:      true,
+			expectNoticeAnnotation: true,
+		},
+		"Doesn't give pending pods notice of preemption": {
+			initialPod:           pendingPodWithNoticePeriod,
+			initialRunState:      preemptedJobRun,
+			expectAddAnnotations: true,
+			expectPodDeleted:     true,
+		},
+		"Does nothing during notice period": {
+			initialPod:      noticedPod,
+			initialRunState: preemptedJobRun,
+		},
+		"Annotates and deletes pod once notice period has passed": {
+			initialPod:           noticeExpiredPod,
+			initialRunState:      preemptedJobRun,
+			expectAddAnnotations: true,
+			expectPodDeleted:     true,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			runProcessor, executorContext, eventReporter := setupPreemptRunProcessorTest(t, tc.initialPod, tc.initialRunState)
+			runProcessor, executorContext, eventReporter, jobRunState := setupPreemptRunProcessorTest(t, tc.initialPod, tc.initialRunState)
 			runProcessor.Run()
 
 			if tc.expectPodDeleted {
 				assert.Len(t, executorContext.Pods, 0)
+			} else if tc.initialPod != nil {
+				assert.Len(t, executorContext.Pods, 1)
 			}
 
+			assert.Equal(t, tc.expectCancellationRequest, jobRunState.Get(tc.initialRunState.Meta.RunId).CancelRequested)
+
 			if tc.expectAddAnnotations {
 				addedAnnotations, exist := executorContext.AnnotationsAdded[util.ExtractJobId(tc.initialPod)]
 				assert.True(t, exist)
 				assert.Len(t, addedAnnotations, 2)
 				assert.True(t, addedAnnotations[domain.JobPreemptedAnnotation] != "")
 				assert.True(t, addedAnnotations[string(v1.PodFailed)] != "")
+			} else if tc.expectNoticeAnnotation {
+				addedAnnotations, exist := executorContext.AnnotationsAdded[util.ExtractJobId(tc.initialPod)]
+				assert.True(t, exist)
+				assert.Len(t, addedAnnotations, 1)
+				deadline, err := time.Parse(time.RFC3339, addedAnnotations[configuration.PreemptionNoticeDeadlineAnnotation])
+				require.NoError(t, err)
+				// The pod's notice period of 5m is capped at the maximum notice period.
+				assert.WithinDuration(t, time.Now().Add(maxNoticePeriod), deadline, 10*time.Second)
 			} else {
 				assert.Len(t, executorContext.AnnotationsAdded, 0)
 			}
 
-			if tc.expectEvents {
+			if tc.expectNoticeEvent {
 				events := eventReporter.ReceivedEvents
-				assert.Len(t, events, 2)
+				assert.Len(t, events, 1)
 				assert.Len(t, eventReporter.ReceivedEvents[0].Event.Events, 1)
-				_, ok := eventReporter.ReceivedEvents[0].Event.Events[0].Event.(*armadaevents.EventSequence_Event_JobRunPreempted)
-				assert.True(t, ok)
-				assert.Len(t, eventReporter.ReceivedEvents[1].Event.Events, 1)
-				_, ok = eventReporter.ReceivedEvents[1].Event.Events[0].Event.(*armadaevents.EventSequence_Event_JobRunErrors)
+				notice, ok := eventReporter.ReceivedEvents[0].Event.Events[0].Event.(*armadaevents.EventSequence_Event_JobRunPreemptionNotice)
 				assert.True(t, ok)
+				assert.Equal(t, tc.initialRunState.Meta.RunId, notice.JobRunPreemptionNotice.RunId)
 			} else {
 				assert.Len(t, eventReporter.ReceivedEvents, 0)
 			}
@@ -116,17 +171,96 @@ func setupPreemptRunProcessorTest(
 	t *testing.T,
 	existingPod *v1.Pod,
 	existingJobRuns *job.RunState,
-) (*RunPreemptedProcessor, *fakecontext.SyncFakeClusterContext, *mocks.FakeEventReporter) {
+) (*RunPreemptedProcessor, *fakecontext.SyncFakeClusterContext, *mocks.FakeEventReporter, *job.JobRunStateStore) {
 	executorContext := fakecontext.NewSyncFakeClusterContext()
-	jobRunState := job.NewJobRunStateStoreWithInitialState([]*job.RunState{existingJobRuns})
+	jobRunState := job.NewJobRunStateStoreWithInitialState([]*job.RunState{existingJobRuns.DeepCopy()})
 	if existingPod != nil {
 		_, err := executorContext.SubmitPod(existingPod, "test", []string{})
 		assert.NoError(t, err)
 	}
 
 	eventReporter := mocks.NewFakeEventReporter()
-	preemptRunProcessor := NewRunPreemptedProcessor(executorContext, jobRunState, eventReporter)
-	return preemptRunProcessor, executorContext, eventReporter
+	preemptRunProcessor := NewRunPreemptedProcessor(executorContext, jobRunState, eventReporter, maxNoticePeriod)
+	return preemptRunProcessor, executorContext, eventReporter, jobRunState
+}
+
+func TestRunPreemptionNoticeHooks(t *testing.T) {
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		requestedPaths = append(requestedPaths, r.URL.Path)
+	}))
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	pod := createPod()
+	pod.Spec.Containers = []v1.Container{{Name: "main"}}
+	pod.Status.PodIP = serverUrl.Hostname()
+	pod.Annotations[configuration.PreemptionNoticeExecHookAnnotation] = "touch /tmp/checkpoint"
+	pod.Annotations[configuration.PreemptionNoticeHttpHookAnnotation] = serverUrl.Port() + "/checkpoint"
+
+	runProcessor, executorContext, _, _ := setupPreemptRunProcessorTest(t, pod, &job.RunState{Meta: &job.RunMeta{RunId: "run"}})
+	runProcessor.runPreemptionNoticeHooks(pod, time.Now().Add(time.Minute))
+
+	assert.Equal(t, [][]string{{"/bin/sh", "-c", "touch /tmp/checkpoint"}}, executorContext.ExecutedCommands[util.ExtractJobId(pod)])
+	assert.Equal(t, []string{"/checkpoint"}, requestedPaths)
+}
+
+func TestPreemptionNoticeHookUrl(t *testing.T) {
+	tests := map[string]struct {
+		podIp       string
+		portAndPath string
+		expected    string
+	}{
+		"port and path": {
+			podIp:       "10.0.0.1",
+			portAndPath: "8080/checkpoint",
+			expected:    "http://10.0.0.1:8080/checkpoint",
+		},
+		"port only": {
+			podIp:       "10.0.0.1",
+			portAndPath: "8080",
+			expected:    "http://10.0.0.1:8080/",
+		},
+		"ipv6": {
+			podIp:       "fd00::1",
+			portAndPath: "8080/checkpoint",
+			expected:    "http://[fd00::1]:8080/checkpoint",
+		},
+		"path can't change the host": {
+			podIp:       "10.0.0.1",
+			portAndPath: "8080/@example.com/?x#y",
+			expected:    "http://10.0.0.1:8080/@example.com/%3Fx%23y",
+		},
+		"host in port": {
+			podIp:       "10.0.0.1",
+			portAndPath: "8080@example.com/checkpoint",
+		},
+		"port out of range": {
+			podIp:       "10.0.0.1",
+			portAndPath: "65536/checkpoint",
+		},
+		"no port": {
+			podIp:       "10.0.0.1",
+			portAndPath: "/checkpoint",
+		},
+		"no pod ip": {
+			podIp:       "",
+			portAndPath: "8080/checkpoint",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hookUrl, err := preemptionNoticeHookUrl(tc.podIp, tc.portAndPath)
+			if tc.expected == "" {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hookUrl.String())
+		})
+	}
 }
 
 func createPod() *v1.Pod {
@@ -3,6 +3,7 @@ package job
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	log "github.com/armadaproject/armada/internal/common/logging"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	"github.com/armadaproject/armada/internal/executor/context"
@@ -107,9 +109,10 @@ func (submitService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToSubmi
 	}
 }
 
-// submitPod submits a pod to k8s together with any services and ingresses bundled with the Armada job.
+// submitPod submits a pod to k8s together with any services, ingresses, config maps, and secrets bundled with the Armada job.
 // This function may fail partly, i.e., it may successfully create a subset of the requested objects before failing.
-// In case of failure, any already created objects are not cleaned up.
+// If creating a config map or secret fails, the pod and any config maps and secrets already created are deleted;
+// in case of any other failure, already created objects are not cleaned up.
 func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 	pod := job.Pod
 	// Ensure the K8SService and K8SIngress fields are populated
@@ -123,11 +126,43 @@ func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 		})
 	}
 
+	if len(job.ConfigMaps) > 0 {
+		pod.Annotations = util.MergeMaps(pod.Annotations, map[string]string{
+			domain.JobScopedConfigMaps: strings.Join(armadaslices.Map(job.ConfigMaps, func(c *v1.ConfigMap) string { return c.Name }), ","),
+		})
+	}
+	if len(job.Secrets) > 0 {
+		pod.Annotations = util.MergeMaps(pod.Annotations, map[string]string{
+			domain.JobScopedSecrets: strings.Join(armadaslices.Map(job.Secrets, func(s *v1.Secret) string { return s.Name }), ","),
+		})
+	}
+
 	submittedPod, err := submitService.clusterContext.SubmitPod(pod, job.Meta.Owner, job.Meta.OwnershipGroups)
 	if err != nil {
 		return pod, err
 	}
 
+	// ConfigMaps and Secrets are owned by the pod, so that they're deleted along with it, and so can only be created once the pod exists.
+	// The pod can't start until they exist, so they're created before any services or ingresses,
+	// and the pod is deleted straight away if they can't be created rather than being left pending.
+	for i, configMap := range job.ConfigMaps {
+		configMap.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = submitService.clusterContext.SubmitConfigMap(configMap)
+		if err != nil {
+			submitService.deleteJobScopedObjects(submittedPod, job.ConfigMaps[:i], nil)
+			return pod, err
+		}
+	}
+
+	for i, secret := range job.Secrets {
+		secret.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = submitService.clusterContext.SubmitSecret(secret)
+		if err != nil {
+			submitService.deleteJobScopedObjects(submittedPod, job.ConfigMaps, job.Secrets[:i])
+			return pod, err
+		}
+	}
+
 	for _, service := range job.Services {
 		service.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
 		_, err = submitService.clusterContext.SubmitService(service)
@@ -147,6 +182,22 @@ func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 	return pod, err
 }
 
+// deleteJobScopedObjects deletes pod along with the given config maps and secrets created for it.
+// The config maps and secrets would eventually be garbage collected once the pod is deleted, but are deleted here straight away.
+func (submitService *SubmitService) deleteJobScopedObjects(pod *v1.Pod, configMaps []*v1.ConfigMap, secrets []*v1.Secret) {
+	submitService.clusterContext.DeletePods([]*v1.Pod{pod})
+	for _, configMap := range configMaps {
+		if err := submitService.clusterContext.DeleteConfigMap(configMap.Namespace, configMap.Name); err != nil {
+			log.Errorf("Failed to delete config map %s/%s because %s", configMap.Namespace, configMap.Name, err)
+		}
+	}
+	for _, secret := range secrets {
+		if err := submitService.clusterContext.DeleteSecret(secret.Namespace, secret.Name); err != nil {
+			log.Errorf("Failed to delete secret %s/%s because %s", secret.Namespace, secret.Name, err)
+		}
+	}
+}
+
 // applyExecutorSpecificIngressDetails populates the executor specific details on ingresses
 // These objects are mostly created server side however there will be details that are not known until submit time
 // So the executor must fill them in before it creates the objects in kubernetes
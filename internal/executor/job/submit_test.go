@@ -5,11 +5,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/executor/configuration"
+	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
+	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/fake/context"
 )
 
@@ -78,6 +81,62 @@ func TestIsRecoverable_ArmadaErrCreateResourceIsRecoverable(t *testing.T) {
 	assert.True(t, recoverable)
 }
 
+func TestSubmitJobs_CreatesConfigMapsAndSecrets(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{})
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "armada-job-0",
+		Namespace: "ns",
+		Labels:    map[string]string{domain.JobId: "job"},
+		UID:       "pod-uid",
+	}}
+	job := &SubmitJob{
+		Meta:       SubmitJobMeta{RunMeta: &RunMeta{JobId: "job"}},
+		Pod:        pod,
+		ConfigMaps: []*v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "armada-job-0-config", Namespace: "ns"}}},
+		Secrets:    []*v1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "armada-job-0-credentials", Namespace: "ns"}}},
+	}
+
+	failed := submitter.SubmitJobs([]*SubmitJob{job})
+	assert.Empty(t, failed)
+
+	assert.Equal(t, "armada-job-0-config", pod.Annotations[domain.JobScopedConfigMaps])
+	assert.Equal(t, "armada-job-0-credentials", pod.Annotations[domain.JobScopedSecrets])
+	configMap := clusterContext.ConfigMaps["ns/armada-job-0-config"]
+	if assert.NotNil(t, configMap) {
+		assert.Equal(t, []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "armada-job-0", UID: "pod-uid"}}, configMap.OwnerReferences)
+	}
+	secret := clusterContext.Secrets["ns/armada-job-0-credentials"]
+	if assert.NotNil(t, secret) {
+		assert.Equal(t, []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "armada-job-0", UID: "pod-uid"}}, secret.OwnerReferences)
+	}
+}
+
+func TestSubmitJobs_DeletesPodIfSecretCannotBeCreated(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	clusterContext.SubmitSecretErr = errors.New("secret quota exceeded")
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{})
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "armada-job-0",
+		Namespace: "ns",
+		Labels:    map[string]string{domain.JobId: "job"},
+	}}
+	job := &SubmitJob{
+		Meta:       SubmitJobMeta{RunMeta: &RunMeta{JobId: "job"}},
+		Pod:        pod,
+		ConfigMaps: []*v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "armada-job-0-config", Namespace: "ns"}}},
+		Secrets:    []*v1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "armada-job-0-credentials", Namespace: "ns"}}},
+	}
+
+	failed := submitter.SubmitJobs([]*SubmitJob{job})
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, "job", failed[0].JobRunMeta.JobId)
+	}
+	assert.Empty(t, clusterContext.Pods)
+	assert.Empty(t, clusterContext.ConfigMaps)
+	assert.Empty(t, clusterContext.Secrets)
+}
+
 func newK8sApiError(message string, reason metav1.StatusReason) *k8s_errors.StatusError {
 	return &k8s_errors.StatusError{
 		ErrStatus: metav1.Status{
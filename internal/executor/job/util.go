@@ -31,9 +31,11 @@ func CreateSubmitJobFromExecutorApiJobRunLease(
 			Owner:           jobRunLease.User,
 			OwnershipGroups: jobRunLease.Groups,
 		},
-		Pod:       pod,
-		Ingresses: util2.ExtractIngresses(jobRunLease, pod, podDefaults.Ingress),
-		Services:  util2.ExtractServices(jobRunLease, pod),
+		Pod:        pod,
+		Ingresses:  util2.ExtractIngresses(jobRunLease, pod, podDefaults.Ingress),
+		Services:   util2.ExtractServices(jobRunLease, pod),
+		ConfigMaps: util2.ExtractConfigMaps(jobRunLease, pod),
+		Secrets:    util2.ExtractSecrets(jobRunLease, pod),
 	}, nil
 }
 
@@ -3,12 +3,14 @@ package reporter
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/util"
 	"github.com/armadaproject/armada/pkg/armadaevents"
@@ -182,19 +184,20 @@ func CreateJobIngressInfoEvent(pod *v1.Pod, clusterId string, associatedServices
 	return sequence, nil
 }
 
-func CreateSimpleJobPreemptedEvent(pod *v1.Pod) (*armadaevents.EventSequence, error) {
+func CreateJobRunPreemptionNoticeEvent(pod *v1.Pod, deadline time.Time) (*armadaevents.EventSequence, error) {
 	sequence := createEmptySequence(pod)
-	preemptedJobId, preemptedRunId, err := extractIds(pod)
+	jobId, runId, err := extractIds(pod)
 	if err != nil {
 		return nil, err
 	}
 
 	sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
 		Created: types.TimestampNow(),
-		Event: &armadaevents.EventSequence_Event_JobRunPreempted{
-			JobRunPreempted: &armadaevents.JobRunPreempted{
-				PreemptedJobId: preemptedJobId,
-				PreemptedRunId: preemptedRunId,
+		Event: &armadaevents.EventSequence_Event_JobRunPreemptionNotice{
+			JobRunPreemptionNotice: &armadaevents.JobRunPreemptionNotice{
+				JobId:    jobId,
+				RunId:    runId,
+				Deadline: protoutil.ToTimestamp(deadline),
 			},
 		},
 	})
@@ -4,27 +4,40 @@ import (
 	"sort"
 	"time"
 
+	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logarchive"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	clusterContext "github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/util"
 )
 
 type ResourceCleanupService struct {
 	clusterContext          clusterContext.ClusterContext
 	kubernetesConfiguration configuration.KubernetesConfiguration
+	// If non-nil, the container logs of finished runs are archived here before their pods are removed.
+	logArchive logarchive.Archive
+	// Archived logs older than this are deleted by DeleteExpiredLogs. Zero means archived logs are kept forever.
+	logArchiveRetention time.Duration
 }
 
 func NewResourceCleanupService(
 	clusterContext clusterContext.ClusterContext,
 	kubernetesConfiguration configuration.KubernetesConfiguration,
+	logArchive logarchive.Archive,
+	logArchiveRetention time.Duration,
 ) (*ResourceCleanupService, error) {
 	service := &ResourceCleanupService{
 		clusterContext:          clusterContext,
 		kubernetesConfiguration: kubernetesConfiguration,
+		logArchive:              logArchive,
+		logArchiveRetention:     logArchiveRetention,
 	}
 
 	/*
@@ -47,6 +60,11 @@ func NewResourceCleanupService(
 			if util.IsManagedPod(pod) && util.IsInTerminalState(pod) && util.HasIngress(pod) {
 				go service.removeAnyAssociatedIngress(pod)
 			}
+			// ConfigMaps and Secrets are deleted as soon as the pod finishes, rather than left until the pod is, so that
+			// secrets aren't kept for any longer than needed.
+			if util.IsManagedPod(pod) && util.IsInTerminalState(pod) && hasJobScopedConfig(pod) {
+				go service.removeJobScopedConfig(pod)
+			}
 		},
 	})
 	if err != nil {
@@ -85,11 +103,30 @@ func (i *ResourceCleanupService) removeAnyAssociatedIngress(pod *v1.Pod) {
 	}
 }
 
+func hasJobScopedConfig(pod *v1.Pod) bool {
+	return len(util.GetJobScopedConfigMapNames(pod)) > 0 || len(util.GetJobScopedSecretNames(pod)) > 0
+}
+
+func (i *ResourceCleanupService) removeJobScopedConfig(pod *v1.Pod) {
+	for _, name := range util.GetJobScopedConfigMapNames(pod) {
+		if err := i.clusterContext.DeleteConfigMap(pod.Namespace, name); err != nil {
+			log.Errorf("Failed to remove config map %s associated with pod %s (%s) because %s", name, pod.Name, pod.Namespace, err)
+		}
+	}
+	for _, name := range util.GetJobScopedSecretNames(pod) {
+		if err := i.clusterContext.DeleteSecret(pod.Namespace, name); err != nil {
+			log.Errorf("Failed to remove secret %s associated with pod %s (%s) because %s", name, pod.Name, pod.Namespace, err)
+		}
+	}
+}
+
 // CleanupResources
 /*
  * This function finds and delete old resources. It does this in two ways:
  *  - By deleting all expired terminated pods
  *  - Deleting non-expired terminated pods when then MaxTerminatedPods limit is exceeded
+ * If a log archive is configured, the logs of terminated pods are archived first.
+ * Expired pods whose logs couldn't be archived are kept, so archiving can be retried, unless MaxTerminatedPods is exceeded.
  */
 func (r *ResourceCleanupService) CleanupResources() {
 	pods, err := r.clusterContext.GetActiveBatchPods()
@@ -99,6 +136,9 @@ func (r *ResourceCleanupService) CleanupResources() {
 	}
 
 	allTerminatedPods := util.FilterPods(pods, util.IsPodFinishedAndReported)
+	if r.logArchive != nil {
+		r.archiveLogs(util.FilterPods(allTerminatedPods, func(pod *v1.Pod) bool { return !isLogArchived(pod) }))
+	}
 	// Expired pods are ones who are older than configured retention period
 	expiredTerminatedPods := util.FilterPods(allTerminatedPods, r.canPodBeRemoved)
 	nonExpiredTerminatedPods := util.RemovePodsFromList(allTerminatedPods, expiredTerminatedPods)
@@ -115,6 +155,61 @@ func (r *ResourceCleanupService) CleanupResources() {
 	}
 }
 
+// DeleteExpiredLogs deletes archived logs older than the configured retention period.
+func (r *ResourceCleanupService) DeleteExpiredLogs() {
+	if r.logArchive == nil || r.logArchiveRetention <= 0 {
+		return
+	}
+	deleted, err := r.logArchive.DeleteArchivedBefore(armadacontext.Background(), time.Now().Add(-r.logArchiveRetention))
+	if err != nil {
+		log.WithError(err).Errorf("Failed to delete expired logs from the log archive")
+	}
+	if deleted > 0 {
+		log.Infof("Deleted %d expired logs from the log archive", deleted)
+	}
+}
+
+func (r *ResourceCleanupService) archiveLogs(pods []*v1.Pod) {
+	for _, pod := range pods {
+		if err := r.archivePodLogs(pod); err != nil {
+			log.WithError(err).Errorf("Failed to archive logs of pod %s (%s)", pod.Name, pod.Namespace)
+			continue
+		}
+		err := r.clusterContext.AddAnnotation(pod, map[string]string{
+			domain.LogsArchivedAnnotation: time.Now().String(),
+		})
+		if err != nil {
+			log.WithError(err).Errorf("Failed to mark logs of pod %s (%s) as archived", pod.Name, pod.Namespace)
+		}
+	}
+}
+
+func (r *ResourceCleanupService) archivePodLogs(pod *v1.Pod) error {
+	runMeta, err := job.ExtractJobRunMeta(pod)
+	if err != nil {
+		return err
+	}
+	ctx := armadacontext.Background()
+	for _, container := range pod.Spec.Containers {
+		podLog, err := r.clusterContext.GetPodLogs(pod, container.Name)
+		if err != nil {
+			return errors.WithMessagef(err, "error getting logs of container %s", container.Name)
+		}
+		key := logarchive.LogKey{JobId: runMeta.JobId, RunId: runMeta.RunId, Namespace: pod.Namespace, Container: container.Name}
+		err = r.logArchive.Put(ctx, key, podLog)
+		_ = podLog.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isLogArchived(pod *v1.Pod) bool {
+	_, exists := pod.Annotations[domain.LogsArchivedAnnotation]
+	return exists
+}
+
 func getOldestPodsWithQueueFairShare(pods []*v1.Pod, numberOfPodsLimit int) []*v1.Pod {
 	if len(pods) <= numberOfPodsLimit {
 		return pods
@@ -175,6 +270,10 @@ func (r *ResourceCleanupService) canPodBeRemoved(pod *v1.Pod) bool {
 		return false
 	}
 
+	if r.logArchive != nil && !isLogArchived(pod) {
+		return false
+	}
+
 	lastChange, err := util.LastStatusChange(pod)
 	if err == nil && lastChange.Add(r.kubernetesConfiguration.MinimumPodAge).After(time.Now()) {
 		return false
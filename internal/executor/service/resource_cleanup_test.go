@@ -1,6 +1,7 @@
 package service
 
 import (
+	"io"
 	"testing"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logarchive"
 	util2 "github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	clusterContext "github.com/armadaproject/armada/internal/executor/context"
@@ -69,6 +72,56 @@ func TestCleanUpResources_RemovesNonExpiredPodsOverMaxTerminatedPodLimit(t *test
 	assert.Equal(t, remainingPods[0].Name, succeededNonExpiredPod.Name)
 }
 
+func TestCleanUpResources_ArchivesLogsBeforeRemovingPods(t *testing.T) {
+	archive, err := logarchive.NewFilesystemArchive(t.TempDir())
+	require.NoError(t, err)
+	fakeClusterContext := fake.NewSyncFakeClusterContext()
+	s, err := NewResourceCleanupService(
+		fakeClusterContext,
+		configuration.KubernetesConfiguration{MinimumPodAge: time.Second, FailedPodExpiry: time.Second, MaxTerminatedPods: 10},
+		archive,
+		time.Hour,
+	)
+	require.NoError(t, err)
+	now := time.Now()
+
+	archivablePod := makeFinishedPodWithTimestamp(v1.PodSucceeded, now.Add(-1*time.Minute))
+	unarchivablePod := makeFinishedPodWithTimestamp(v1.PodFailed, now.Add(-1*time.Minute))
+	addPods(t, fakeClusterContext, archivablePod, unarchivablePod)
+	fakeClusterContext.PodLogs[util.ExtractJobId(archivablePod)] = map[string]string{
+		"main":    "2022-02-08T11:32:21.183268868Z main\n",
+		"sidecar": "2022-02-08T11:32:21.183268868Z sidecar\n",
+	}
+
+	s.CleanupResources()
+
+	// Pods whose logs couldn't be archived are kept, so archiving can be retried.
+	remainingPods, err := fakeClusterContext.GetBatchPods()
+	require.NoError(t, err)
+	require.Len(t, remainingPods, 1)
+	assert.Equal(t, unarchivablePod.Name, remainingPods[0].Name)
+
+	for container, expected := range fakeClusterContext.PodLogs[util.ExtractJobId(archivablePod)] {
+		reader, err := archive.Get(armadacontext.Background(), logarchive.LogKey{
+			JobId:     util.ExtractJobId(archivablePod),
+			RunId:     util.ExtractJobRunId(archivablePod),
+			Namespace: archivablePod.Namespace,
+			Container: container,
+		})
+		require.NoError(t, err)
+		actual, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, expected, string(actual))
+	}
+
+	// Logs archived within the retention period are kept.
+	s.DeleteExpiredLogs()
+	logs, err := archive.List(armadacontext.Background(), util.ExtractJobId(archivablePod))
+	require.NoError(t, err)
+	assert.Len(t, logs, 2)
+}
+
 func TestCanBeRemovedConditions(t *testing.T) {
 	s, err := createResourceCleanupService(time.Second, time.Second, 1)
 	require.NoError(t, err)
@@ -220,8 +273,15 @@ func makePod(queue string) *v1.Pod {
 			Name:        util2.NewULID(),
 			UID:         types.UID(util2.NewULID()),
 			Namespace:   "default",
-			Annotations: map[string]string{},
-			Labels:      map[string]string{domain.Queue: queue, domain.JobId: util2.NewULID()},
+			Annotations: map[string]string{domain.JobSetId: "job-set"},
+			Labels: map[string]string{
+				domain.Queue:    queue,
+				domain.JobId:    util2.NewULID(),
+				domain.JobRunId: util2.NewULID(),
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "main"}, {Name: "sidecar"}},
 		},
 	}
 	return pod
@@ -244,5 +304,7 @@ func createResourceCleanupService(minimumPodAge, failedPodExpiry time.Duration,
 
 	return NewResourceCleanupService(
 		fakeClusterContext,
-		kubernetesConfig)
+		kubernetesConfig,
+		nil,
+		0)
 }
@@ -100,6 +100,132 @@ func ExtractServices(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.Service {
 	return result
 }
 
+// JobScopedObjectName returns the name of a ConfigMap or Secret of a job in Kubernetes.
+// Names are prefixed with the name of the job's pod, as jobs in the same namespace may use the same names.
+func JobScopedObjectName(podName string, name string) string {
+	return podName + "-" + name
+}
+
+func ExtractConfigMaps(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.ConfigMap {
+	var result []*v1.ConfigMap
+	for _, additionalObject := range job.Job.Objects {
+		if typed, ok := additionalObject.Object.(*armadaevents.KubernetesObject_ConfigMap); ok {
+			configMap := typed.ConfigMap.DeepCopy()
+			configMap.ObjectMeta = jobScopedObjectMeta(job, pod, additionalObject.ObjectMeta)
+			result = append(result, configMap)
+		}
+	}
+	return result
+}
+
+func ExtractSecrets(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.Secret {
+	var result []*v1.Secret
+	for _, additionalObject := range job.Job.Objects {
+		if typed, ok := additionalObject.Object.(*armadaevents.KubernetesObject_Secret); ok {
+			secret := typed.Secret.DeepCopy()
+			secret.ObjectMeta = jobScopedObjectMeta(job, pod, additionalObject.ObjectMeta)
+			result = append(result, secret)
+		}
+	}
+	return result
+}
+
+func jobScopedObjectMeta(job *executorapi.JobRunLease, pod *v1.Pod, meta *armadaevents.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name: JobScopedObjectName(pod.Name, meta.Name),
+		Labels: util.MergeMaps(meta.Labels, map[string]string{
+			domain.JobId:     pod.Labels[domain.JobId],
+			domain.JobRunId:  pod.Labels[domain.JobRunId],
+			domain.Queue:     pod.Labels[domain.Queue],
+			domain.PodNumber: pod.Labels[domain.PodNumber],
+		}),
+		Annotations: util.MergeMaps(meta.Annotations, map[string]string{
+			domain.JobSetId: job.Jobset,
+			domain.Owner:    job.User,
+		}),
+		Namespace: pod.Namespace,
+	}
+}
+
+// renameJobScopedObjectReferences changes references to the ConfigMaps and Secrets of a job in its pod spec to refer
+// to them by the names they're given in Kubernetes.
+func renameJobScopedObjectReferences(podName string, podSpec *v1.PodSpec, objects []*armadaevents.KubernetesObject) {
+	configMaps := make(map[string]bool)
+	secrets := make(map[string]bool)
+	for _, object := range objects {
+		switch object.Object.(type) {
+		case *armadaevents.KubernetesObject_ConfigMap:
+			configMaps[object.ObjectMeta.Name] = true
+		case *armadaevents.KubernetesObject_Secret:
+			secrets[object.ObjectMeta.Name] = true
+		}
+	}
+	if len(configMaps) == 0 && len(secrets) == 0 {
+		return
+	}
+	renameConfigMap := func(name *string) {
+		if configMaps[*name] {
+			*name = JobScopedObjectName(podName, *name)
+		}
+	}
+	renameSecret := func(name *string) {
+		if secrets[*name] {
+			*name = JobScopedObjectName(podName, *name)
+		}
+	}
+
+	for i := range podSpec.Volumes {
+		volume := &podSpec.Volumes[i]
+		if volume.ConfigMap != nil {
+			renameConfigMap(&volume.ConfigMap.Name)
+		}
+		if volume.Secret != nil {
+			renameSecret(&volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for j := range volume.Projected.Sources {
+				source := &volume.Projected.Sources[j]
+				if source.ConfigMap != nil {
+					renameConfigMap(&source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					renameSecret(&source.Secret.Name)
+				}
+			}
+		}
+	}
+	containers := make([]*v1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	for i := range podSpec.InitContainers {
+		containers = append(containers, &podSpec.InitContainers[i])
+	}
+	for i := range podSpec.Containers {
+		containers = append(containers, &podSpec.Containers[i])
+	}
+	for _, container := range containers {
+		for j := range container.EnvFrom {
+			envFrom := &container.EnvFrom[j]
+			if envFrom.ConfigMapRef != nil {
+				renameConfigMap(&envFrom.ConfigMapRef.Name)
+			}
+			if envFrom.SecretRef != nil {
+				renameSecret(&envFrom.SecretRef.Name)
+			}
+		}
+		for j := range container.Env {
+			valueFrom := container.Env[j].ValueFrom
+			if valueFrom == nil {
+				continue
+			}
+			if valueFrom.ConfigMapKeyRef != nil {
+				renameConfigMap(&valueFrom.ConfigMapKeyRef.Name)
+			}
+			if valueFrom.SecretKeyRef != nil {
+				renameSecret(&valueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+}
+
 func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configuration.PodDefaults) (*v1.Pod, error) {
 	podSpec, err := getPodSpec(job)
 	if err != nil {
@@ -127,12 +253,14 @@ func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configu
 		domain.Owner:    job.User,
 	})
 
+	podName := common.PodNamePrefix + job.Job.JobId + "-" + strconv.Itoa(0)
 	applyDefaults(podSpec, defaults)
 	setRestartPolicyNever(podSpec)
+	renameJobScopedObjectReferences(podName, podSpec, job.Job.Objects)
 
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        common.PodNamePrefix + job.Job.JobId + "-" + strconv.Itoa(0),
+			Name:        podName,
 			Labels:      labels,
 			Annotations: annotation,
 			Namespace:   job.Job.ObjectMeta.Namespace,
@@ -240,3 +240,55 @@ func createBasicJobRunLease() *executorapi.JobRunLease {
 		},
 	}
 }
+
+func TestCreatePodFromExecutorApiJob_JobScopedObjects(t *testing.T) {
+	lease := createBasicJobRunLease()
+	lease.Job.Objects = []*armadaevents.KubernetesObject{
+		{
+			ObjectMeta: &armadaevents.ObjectMeta{Name: "config", Labels: map[string]string{"foo": "bar"}},
+			Object:     &armadaevents.KubernetesObject_ConfigMap{ConfigMap: &v1.ConfigMap{Data: map[string]string{"a": "b"}}},
+		},
+		{
+			ObjectMeta: &armadaevents.ObjectMeta{Name: "credentials"},
+			Object:     &armadaevents.KubernetesObject_Secret{Secret: &v1.Secret{StringData: map[string]string{"token": "c"}}},
+		},
+	}
+	lease.Job.MainObject.GetPodSpec().PodSpec = &v1.PodSpec{
+		Volumes: []v1.Volume{
+			{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "config"}}}},
+			{Name: "credentials", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "credentials"}}},
+			{Name: "preexisting", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "preexisting"}}}},
+		},
+		Containers: []v1.Container{{
+			Name:    "main",
+			EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}}}},
+			Env: []v1.EnvVar{{
+				Name:      "A",
+				ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "config"}, Key: "a"}},
+			}},
+		}},
+	}
+
+	pod, err := CreatePodFromExecutorApiJob(lease, &configuration.PodDefaults{})
+	assert.NoError(t, err)
+	configMapName := pod.Name + "-config"
+	secretName := pod.Name + "-credentials"
+	assert.Equal(t, configMapName, pod.Spec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, secretName, pod.Spec.Volumes[1].Secret.SecretName)
+	assert.Equal(t, "preexisting", pod.Spec.Volumes[2].ConfigMap.Name)
+	assert.Equal(t, secretName, pod.Spec.Containers[0].EnvFrom[0].SecretRef.Name)
+	assert.Equal(t, configMapName, pod.Spec.Containers[0].Env[0].ValueFrom.ConfigMapKeyRef.Name)
+
+	configMaps := ExtractConfigMaps(lease, pod)
+	assert.Len(t, configMaps, 1)
+	assert.Equal(t, configMapName, configMaps[0].Name)
+	assert.Equal(t, pod.Namespace, configMaps[0].Namespace)
+	assert.Equal(t, "bar", configMaps[0].Labels["foo"])
+	assert.Equal(t, lease.Job.JobId, configMaps[0].Labels[domain.JobId])
+	assert.Equal(t, map[string]string{"a": "b"}, configMaps[0].Data)
+
+	secrets := ExtractSecrets(lease, pod)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, secretName, secrets[0].Name)
+	assert.Equal(t, map[string]string{"token": "c"}, secrets[0].StringData)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -53,6 +54,23 @@ func GetExpectedNumberOfAssociatedIngresses(pod *v1.Pod) int {
 	return numberOfAssociatedIngresses
 }
 
+// GetJobScopedConfigMapNames returns the names of the ConfigMaps created alongside a pod.
+func GetJobScopedConfigMapNames(pod *v1.Pod) []string {
+	return splitNames(pod.Annotations[domain.JobScopedConfigMaps])
+}
+
+// GetJobScopedSecretNames returns the names of the Secrets created alongside a pod.
+func GetJobScopedSecretNames(pod *v1.Pod) []string {
+	return splitNames(pod.Annotations[domain.JobScopedSecrets])
+}
+
+func splitNames(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
 func IsInTerminalState(pod *v1.Pod) bool {
 	podPhase := pod.Status.Phase
 	if podPhase == v1.PodSucceeded || podPhase == v1.PodFailed {
@@ -281,6 +299,36 @@ func IsReportedPreempted(pod *v1.Pod) bool {
 	return exists
 }
 
+// GetPreemptionNoticePeriod returns the notice the pod should be given before being preempted, or zero if none.
+func GetPreemptionNoticePeriod(pod *v1.Pod) time.Duration {
+	value, exists := pod.Annotations[configuration.PreemptionNoticePeriodAnnotation]
+	if !exists {
+		return 0
+	}
+	noticePeriod, err := time.ParseDuration(value)
+	if err != nil {
+		log.Warnf("Ignoring invalid preemption notice period %q of pod %s", value, pod.Name)
+		return 0
+	}
+	return noticePeriod
+}
+
+// GetPreemptionNoticeDeadline returns the time after which a pod given notice of preemption is killed,
+// and false if it hasn't been given notice.
+func GetPreemptionNoticeDeadline(pod *v1.Pod) (time.Time, bool) {
+	value, exists := pod.Annotations[configuration.PreemptionNoticeDeadlineAnnotation]
+	if !exists {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// Treat the notice period as over, rather than waiting indefinitely.
+		log.Warnf("Invalid preemption notice deadline %q of pod %s", value, pod.Name)
+		return time.Time{}, true
+	}
+	return deadline, true
+}
+
 // GetDeletionGracePeriodOrDefault returns the pod's DeletionGracePeriodSeconds seconds (if populated) or the K8s
 // default value of 30 seconds (if it isn't)
 func GetDeletionGracePeriodOrDefault(pod *v1.Pod) time.Duration {
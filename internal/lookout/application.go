@@ -4,11 +4,13 @@ package lookout
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/IBM/pgxpoolprometheus"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/armadaproject/armada/internal/common"
@@ -20,8 +22,10 @@ import (
 	"github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/lookout/configuration"
 	"github.com/armadaproject/armada/internal/lookout/conversions"
+	"github.com/armadaproject/armada/internal/lookout/export"
 	"github.com/armadaproject/armada/internal/lookout/gen/restapi"
 	"github.com/armadaproject/armada/internal/lookout/gen/restapi/operations"
+	"github.com/armadaproject/armada/internal/lookout/model"
 	"github.com/armadaproject/armada/internal/lookout/repository"
 )
 
@@ -47,6 +51,8 @@ func Serve(configuration configuration.LookoutConfig) error {
 	getJobRunErrorRepo := repository.NewSqlGetJobRunErrorRepository(db, decompressor)
 	getJobRunDebugMessageRepo := repository.NewSqlGetJobRunDebugMessageRepository(db, decompressor)
 	getJobSpecRepo := repository.NewSqlGetJobSpecRepository(db, decompressor)
+	getJobRunResourceUtilisationRepo := repository.NewSqlGetJobRunResourceUtilisationRepository(db)
+	savedQueryRepo := repository.NewSqlSavedQueryRepository(db)
 
 	// create new service API
 	api := operations.NewLookoutAPI(swaggerSpec)
@@ -93,6 +99,7 @@ func Serve(configuration configuration.LookoutConfig) error {
 				order,
 				conversions.FromSwaggerGroupedField(params.GroupJobsRequest.GroupedField),
 				params.GroupJobsRequest.Aggregates,
+				slices.Map(params.GroupJobsRequest.ResourceAggregates, conversions.FromSwaggerResourceAggregate),
 				int(params.GroupJobsRequest.Skip),
 				int(params.GroupJobsRequest.Take),
 			)
@@ -131,6 +138,19 @@ func Serve(configuration configuration.LookoutConfig) error {
 		},
 	)
 
+	api.GetJobRunResourceUtilisationHandler = operations.GetJobRunResourceUtilisationHandlerFunc(
+		func(params operations.GetJobRunResourceUtilisationParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := getJobRunResourceUtilisationRepo.GetJobRunResourceUtilisation(ctx, params.GetJobRunResourceUtilisationRequest.RunID)
+			if err != nil {
+				return operations.NewGetJobRunResourceUtilisationBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetJobRunResourceUtilisationOK().WithPayload(&operations.GetJobRunResourceUtilisationOKBody{
+				ResourceUtilisation: slices.Map(result, conversions.ToSwaggerResourceUtilisation),
+			})
+		},
+	)
+
 	api.GetJobErrorHandler = operations.GetJobErrorHandlerFunc(
 		func(params operations.GetJobErrorParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
@@ -157,6 +177,88 @@ func Serve(configuration configuration.LookoutConfig) error {
 		},
 	)
 
+	api.ExportJobsHandler = operations.ExportJobsHandlerFunc(
+		func(params operations.ExportJobsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			request := params.ExportJobsRequest
+			filters := slices.Map(request.Filters, conversions.FromSwaggerFilter)
+			var order *model.Order
+			if request.Order != nil {
+				order = conversions.FromSwaggerOrder(request.Order)
+			}
+			activeJobSets := request.ActiveJobSets
+			if request.SavedQuery != "" {
+				if len(request.Filters) > 0 || request.Order != nil {
+					return operations.NewExportJobsBadRequest().WithPayload(conversions.ToSwaggerError("filters and order must not be given with a saved query"))
+				}
+				savedQuery, err := savedQueryRepo.GetSavedQuery(ctx, request.SavedQuery)
+				if err != nil {
+					return operations.NewExportJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+				}
+				filters, order, activeJobSets = savedQuery.Filters, savedQuery.Order, savedQuery.ActiveJobSets
+			}
+			return export.NewResponder(request.Format, func(fn func(*model.Job) error) error {
+				return getJobsRepo.StreamJobs(ctx, filters, activeJobSets, order, fn)
+			})
+		},
+	)
+
+	api.ListSavedQueriesHandler = operations.ListSavedQueriesHandlerFunc(
+		func(params operations.ListSavedQueriesParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := savedQueryRepo.ListSavedQueries(ctx)
+			if err != nil {
+				return operations.NewListSavedQueriesBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewListSavedQueriesOK().WithPayload(&operations.ListSavedQueriesOKBody{
+				SavedQueries: slices.Map(result, conversions.ToSwaggerSavedQuery),
+			})
+		},
+	)
+
+	api.GetSavedQueryHandler = operations.GetSavedQueryHandlerFunc(
+		func(params operations.GetSavedQueryParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			result, err := savedQueryRepo.GetSavedQuery(ctx, params.Name)
+			if errors.Is(err, repository.ErrSavedQueryNotFound) {
+				return operations.NewGetSavedQueryNotFound().WithPayload(conversions.ToSwaggerError(err.Error()))
+			} else if err != nil {
+				return operations.NewGetSavedQueryDefault(http.StatusInternalServerError).WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetSavedQueryOK().WithPayload(conversions.ToSwaggerSavedQuery(result))
+		},
+	)
+
+	api.PutSavedQueryHandler = operations.PutSavedQueryHandlerFunc(
+		func(params operations.PutSavedQueryParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			savedQuery := conversions.FromSwaggerSavedQuery(params.SavedQuery)
+			savedQuery.Name = params.Name
+			result, err := savedQueryRepo.PutSavedQuery(ctx, savedQuery)
+			if errors.Is(err, repository.ErrSavedQueryNotOwned) {
+				return operations.NewPutSavedQueryForbidden().WithPayload(conversions.ToSwaggerError(err.Error()))
+			} else if err != nil {
+				return operations.NewPutSavedQueryBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewPutSavedQueryOK().WithPayload(conversions.ToSwaggerSavedQuery(result))
+		},
+	)
+
+	api.DeleteSavedQueryHandler = operations.DeleteSavedQueryHandlerFunc(
+		func(params operations.DeleteSavedQueryParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			err := savedQueryRepo.DeleteSavedQuery(ctx, params.Name)
+			if errors.Is(err, repository.ErrSavedQueryNotFound) {
+				return operations.NewDeleteSavedQueryNotFound().WithPayload(conversions.ToSwaggerError(err.Error()))
+			} else if errors.Is(err, repository.ErrSavedQueryNotOwned) {
+				return operations.NewDeleteSavedQueryForbidden().WithPayload(conversions.ToSwaggerError(err.Error()))
+			} else if err != nil {
+				return operations.NewDeleteSavedQueryDefault(http.StatusInternalServerError).WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewDeleteSavedQueryNoContent()
+		},
+	)
+
 	shutdownMetricServer := common.ServeMetrics(uint16(configuration.MetricsPort))
 	defer shutdownMetricServer()
 
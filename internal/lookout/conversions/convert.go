@@ -59,6 +59,15 @@ func ToSwaggerRun(run *model.Run) *models.Run {
 	}
 }
 
+func ToSwaggerResourceUtilisation(utilisation *model.ResourceUtilisation) *models.ResourceUtilisation {
+	return &models.ResourceUtilisation{
+		Resource:    utilisation.Resource,
+		Max:         utilisation.Max,
+		Average:     utilisation.Average,
+		LastUpdated: strfmt.DateTime(utilisation.LastUpdated),
+	}
+}
+
 func ToSwaggerGroup(group *model.JobGroup) *models.Group {
 	return &models.Group{
 		Aggregates: group.Aggregates,
@@ -79,13 +88,22 @@ func FromSwaggerFilter(filter *models.Filter) *model.Filter {
 		Match:        filter.Match,
 		Value:        filter.Value,
 		IsAnnotation: filter.IsAnnotation,
+		IsResource:   filter.IsResource,
 	}
 }
 
 func FromSwaggerOrder(order *models.Order) *model.Order {
 	return &model.Order{
-		Direction: order.Direction,
-		Field:     order.Field,
+		Direction:  order.Direction,
+		Field:      order.Field,
+		IsResource: order.IsResource,
+	}
+}
+
+func FromSwaggerResourceAggregate(resourceAggregate *models.ResourceAggregate) *model.ResourceAggregate {
+	return &model.ResourceAggregate{
+		Resource:  resourceAggregate.Resource,
+		Aggregate: resourceAggregate.Aggregate,
 	}
 }
 
@@ -111,3 +129,88 @@ func PostgreSQLTimeToSwaggerTime(t *model.PostgreSQLTime) *strfmt.DateTime {
 	s := strfmt.DateTime(t.Time)
 	return &s
 }
+
+func ToSwaggerSavedQuery(savedQuery *model.SavedQuery) *models.SavedQuery {
+	var groupedField *models.GroupedField
+	if savedQuery.GroupedField != nil {
+		groupedField = &models.GroupedField{
+			Field:        savedQuery.GroupedField.Field,
+			IsAnnotation: savedQuery.GroupedField.IsAnnotation,
+		}
+	}
+	var order *models.Order
+	if savedQuery.Order != nil {
+		order = &models.Order{
+			Direction:  savedQuery.Order.Direction,
+			Field:      savedQuery.Order.Field,
+			IsResource: savedQuery.Order.IsResource,
+		}
+	}
+	filters := make([]*models.Filter, len(savedQuery.Filters))
+	for i, filter := range savedQuery.Filters {
+		filters[i] = &models.Filter{
+			Field:        filter.Field,
+			Match:        filter.Match,
+			Value:        filter.Value,
+			IsAnnotation: filter.IsAnnotation,
+			IsResource:   filter.IsResource,
+		}
+	}
+	resourceAggregates := make([]*models.ResourceAggregate, len(savedQuery.ResourceAggregates))
+	for i, resourceAggregate := range savedQuery.ResourceAggregates {
+		resourceAggregates[i] = &models.ResourceAggregate{
+			Resource:  resourceAggregate.Resource,
+			Aggregate: resourceAggregate.Aggregate,
+		}
+	}
+	aggregates := savedQuery.Aggregates
+	if aggregates == nil {
+		aggregates = []string{}
+	}
+	return &models.SavedQuery{
+		Name:               savedQuery.Name,
+		Description:        savedQuery.Description,
+		Filters:            filters,
+		Order:              order,
+		ActiveJobSets:      savedQuery.ActiveJobSets,
+		GroupedField:       groupedField,
+		Aggregates:         aggregates,
+		ResourceAggregates: resourceAggregates,
+		CreatedBy:          savedQuery.CreatedBy,
+		Created:            strfmt.DateTime(savedQuery.Created),
+		Updated:            strfmt.DateTime(savedQuery.Updated),
+	}
+}
+
+// FromSwaggerSavedQuery converts a saved query as sent by a client, so the fields set by the server are ignored.
+func FromSwaggerSavedQuery(savedQuery *models.SavedQuery) *model.SavedQuery {
+	var groupedField *model.GroupedField
+	if savedQuery.GroupedField != nil {
+		groupedField = &model.GroupedField{
+			Field:        savedQuery.GroupedField.Field,
+			IsAnnotation: savedQuery.GroupedField.IsAnnotation,
+		}
+	}
+	var order *model.Order
+	if savedQuery.Order != nil {
+		order = FromSwaggerOrder(savedQuery.Order)
+	}
+	filters := make([]*model.Filter, len(savedQuery.Filters))
+	for i, filter := range savedQuery.Filters {
+		filters[i] = FromSwaggerFilter(filter)
+	}
+	resourceAggregates := make([]*model.ResourceAggregate, len(savedQuery.ResourceAggregates))
+	for i, resourceAggregate := range savedQuery.ResourceAggregates {
+		resourceAggregates[i] = FromSwaggerResourceAggregate(resourceAggregate)
+	}
+	return &model.SavedQuery{
+		Name:               savedQuery.Name,
+		Description:        savedQuery.Description,
+		Filters:            filters,
+		Order:              order,
+		ActiveJobSets:      savedQuery.ActiveJobSets,
+		GroupedField:       groupedField,
+		Aggregates:         savedQuery.Aggregates,
+		ResourceAggregates: resourceAggregates,
+	}
+}
@@ -112,6 +112,20 @@ var (
 		Name:  "queue-1",
 	}
 
+	resourceUtilisation = &model.ResourceUtilisation{
+		Resource:    "cpu",
+		Max:         1.5,
+		Average:     0.5,
+		LastUpdated: baseTime,
+	}
+
+	swaggerResourceUtilisation = &models.ResourceUtilisation{
+		Resource:    "cpu",
+		Max:         1.5,
+		Average:     0.5,
+		LastUpdated: baseTimeSwagger,
+	}
+
 	swaggerFilter = &models.Filter{
 		Field:        "jobSet",
 		Match:        "exact",
@@ -135,6 +149,70 @@ var (
 		Direction: "ASC",
 		Field:     "lastTransitionTime",
 	}
+
+	swaggerResourceOrder = &models.Order{
+		Direction:  "DESC",
+		Field:      "amd.com/gpu",
+		IsResource: true,
+	}
+
+	resourceOrder = &model.Order{
+		Direction:  "DESC",
+		Field:      "amd.com/gpu",
+		IsResource: true,
+	}
+
+	swaggerResourceFilter = &models.Filter{
+		Field:      "amd.com/gpu",
+		Match:      "greaterThan",
+		Value:      1,
+		IsResource: true,
+	}
+
+	resourceFilter = &model.Filter{
+		Field:      "amd.com/gpu",
+		Match:      "greaterThan",
+		Value:      1,
+		IsResource: true,
+	}
+
+	swaggerResourceAggregate = &models.ResourceAggregate{
+		Resource:  "amd.com/gpu",
+		Aggregate: "sum",
+	}
+
+	resourceAggregate = &model.ResourceAggregate{
+		Resource:  "amd.com/gpu",
+		Aggregate: "sum",
+	}
+
+	swaggerSavedQuery = &models.SavedQuery{
+		Name:               "gpu-jobs",
+		Description:        "GPU jobs by queue",
+		Filters:            []*models.Filter{swaggerResourceFilter},
+		Order:              swaggerResourceOrder,
+		ActiveJobSets:      true,
+		GroupedField:       &models.GroupedField{Field: "queue"},
+		Aggregates:         []string{"submitted"},
+		ResourceAggregates: []*models.ResourceAggregate{swaggerResourceAggregate},
+		CreatedBy:          "alice",
+		Created:            baseTimeSwagger,
+		Updated:            baseTimeSwagger,
+	}
+
+	savedQuery = &model.SavedQuery{
+		Name:               "gpu-jobs",
+		Description:        "GPU jobs by queue",
+		Filters:            []*model.Filter{resourceFilter},
+		Order:              resourceOrder,
+		ActiveJobSets:      true,
+		GroupedField:       &model.GroupedField{Field: "queue"},
+		Aggregates:         []string{"submitted"},
+		ResourceAggregates: []*model.ResourceAggregate{resourceAggregate},
+		CreatedBy:          "alice",
+		Created:            baseTime,
+		Updated:            baseTime,
+	}
 )
 
 func TestToSwaggerJob(t *testing.T) {
@@ -147,6 +225,11 @@ func TestToSwaggerGroup(t *testing.T) {
 	assert.Equal(t, swaggerGroup, actual)
 }
 
+func TestToSwaggerResourceUtilisation(t *testing.T) {
+	actual := ToSwaggerResourceUtilisation(resourceUtilisation)
+	assert.Equal(t, swaggerResourceUtilisation, actual)
+}
+
 func TestToSwaggerError(t *testing.T) {
 	errMsg := "some error message"
 	actual := ToSwaggerError("some error message")
@@ -156,9 +239,32 @@ func TestToSwaggerError(t *testing.T) {
 func TestFromSwaggerFilter(t *testing.T) {
 	actual := FromSwaggerFilter(swaggerFilter)
 	assert.Equal(t, filter, actual)
+	actual = FromSwaggerFilter(swaggerResourceFilter)
+	assert.Equal(t, resourceFilter, actual)
 }
 
 func TestFromSwaggerOrder(t *testing.T) {
 	actual := FromSwaggerOrder(swaggerOrder)
 	assert.Equal(t, order, actual)
+	actual = FromSwaggerOrder(swaggerResourceOrder)
+	assert.Equal(t, resourceOrder, actual)
+}
+
+func TestFromSwaggerResourceAggregate(t *testing.T) {
+	actual := FromSwaggerResourceAggregate(swaggerResourceAggregate)
+	assert.Equal(t, resourceAggregate, actual)
+}
+
+func TestToSwaggerSavedQuery(t *testing.T) {
+	actual := ToSwaggerSavedQuery(savedQuery)
+	assert.Equal(t, swaggerSavedQuery, actual)
+}
+
+func TestFromSwaggerSavedQuery(t *testing.T) {
+	actual := FromSwaggerSavedQuery(swaggerSavedQuery)
+	expected := *savedQuery
+	expected.CreatedBy = ""
+	expected.Created = time.Time{}
+	expected.Updated = time.Time{}
+	assert.Equal(t, &expected, actual)
 }
@@ -0,0 +1,250 @@
+// Package export writes Lookout jobs as CSV or Parquet, one row per job.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	parquetWriter "github.com/xitongsys/parquet-go/writer"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+// Formats in which jobs can be exported.
+const (
+	FormatCsv     = "csv"
+	FormatParquet = "parquet"
+)
+
+// Writer writes jobs to an underlying io.Writer. Close must be called once all jobs have been written.
+type Writer interface {
+	Write(job *model.Job) error
+	Close() error
+}
+
+// NewWriter returns a Writer writing jobs to w in the given format.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCsv:
+		return NewCsvWriter(w)
+	case FormatParquet:
+		return NewParquetWriter(w)
+	default:
+		return nil, errors.Errorf("unsupported export format %q; must be one of %q or %q", format, FormatCsv, FormatParquet)
+	}
+}
+
+// ContentType returns the media type of jobs exported in the given format.
+func ContentType(format string) string {
+	if format == FormatCsv {
+		return "text/csv"
+	}
+	return "application/octet-stream"
+}
+
+// FileName returns the name a file of jobs exported in the given format should be saved as.
+func FileName(format string) string {
+	return "jobs." + format
+}
+
+// JobRow is a job as exported. Resources are in the units Lookout stores them in; i.e., cpu in millicores, and memory
+// and ephemeral storage in bytes. Parquet stores times as milliseconds since the epoch.
+type JobRow struct {
+	JobId              string  `parquet:"name=job_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Queue              string  `parquet:"name=queue, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	JobSet             string  `parquet:"name=job_set, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Owner              string  `parquet:"name=owner, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Namespace          *string `parquet:"name=namespace, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL"`
+	State              string  `parquet:"name=state, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Priority           int64   `parquet:"name=priority, type=INT64"`
+	PriorityClass      *string `parquet:"name=priority_class, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL"`
+	Cpu                int64   `parquet:"name=cpu, type=INT64"`
+	Memory             int64   `parquet:"name=memory, type=INT64"`
+	EphemeralStorage   int64   `parquet:"name=ephemeral_storage, type=INT64"`
+	Gpu                int64   `parquet:"name=gpu, type=INT64"`
+	Submitted          int64   `parquet:"name=submitted, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Cancelled          *int64  `parquet:"name=cancelled, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	LastTransitionTime int64   `parquet:"name=last_transition_time, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	LatestRunId        *string `parquet:"name=latest_run_id, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Cluster            string  `parquet:"name=cluster, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Node               *string `parquet:"name=node, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL"`
+	ExitCode           *int32  `parquet:"name=exit_code, type=INT32, repetitiontype=OPTIONAL"`
+	RuntimeSeconds     int32   `parquet:"name=runtime_seconds, type=INT32"`
+	CancelReason       *string `parquet:"name=cancel_reason, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	CancelUser         *string `parquet:"name=cancel_user, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	// Annotations of the job as a JSON object.
+	Annotations string `parquet:"name=annotations, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// csvHeader holds the names of the columns of a CSV export, in the order of the fields of JobRow.
+var csvHeader = []string{
+	"job_id",
+	"queue",
+	"job_set",
+	"owner",
+	"namespace",
+	"state",
+	"priority",
+	"priority_class",
+	"cpu",
+	"memory",
+	"ephemeral_storage",
+	"gpu",
+	"submitted",
+	"cancelled",
+	"last_transition_time",
+	"latest_run_id",
+	"cluster",
+	"node",
+	"exit_code",
+	"runtime_seconds",
+	"cancel_reason",
+	"cancel_user",
+	"annotations",
+}
+
+func toJobRow(job *model.Job) (*JobRow, error) {
+	annotations, err := json.Marshal(job.Annotations)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var cancelled *int64
+	if job.Cancelled != nil {
+		millis := job.Cancelled.UnixMilli()
+		cancelled = &millis
+	}
+	return &JobRow{
+		JobId:              job.JobId,
+		Queue:              job.Queue,
+		JobSet:             job.JobSet,
+		Owner:              job.Owner,
+		Namespace:          job.Namespace,
+		State:              job.State,
+		Priority:           job.Priority,
+		PriorityClass:      job.PriorityClass,
+		Cpu:                job.Cpu,
+		Memory:             job.Memory,
+		EphemeralStorage:   job.EphemeralStorage,
+		Gpu:                job.Gpu,
+		Submitted:          job.Submitted.UnixMilli(),
+		Cancelled:          cancelled,
+		LastTransitionTime: job.LastTransitionTime.UnixMilli(),
+		LatestRunId:        job.LastActiveRunId,
+		Cluster:            job.Cluster,
+		Node:               job.Node,
+		ExitCode:           job.ExitCode,
+		RuntimeSeconds:     job.RuntimeSeconds,
+		CancelReason:       job.CancelReason,
+		CancelUser:         job.CancelUser,
+		Annotations:        string(annotations),
+	}, nil
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+// NewCsvWriter returns a Writer writing jobs as CSV, with a header row. Times are formatted as RFC 3339.
+func NewCsvWriter(w io.Writer) (Writer, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &csvWriter{writer: writer}, nil
+}
+
+func (c *csvWriter) Write(job *model.Job) error {
+	row, err := toJobRow(job)
+	if err != nil {
+		return err
+	}
+	record := []string{
+		row.JobId,
+		row.Queue,
+		row.JobSet,
+		row.Owner,
+		formatString(row.Namespace),
+		row.State,
+		strconv.FormatInt(row.Priority, 10),
+		formatString(row.PriorityClass),
+		strconv.FormatInt(row.Cpu, 10),
+		strconv.FormatInt(row.Memory, 10),
+		strconv.FormatInt(row.EphemeralStorage, 10),
+		strconv.FormatInt(row.Gpu, 10),
+		formatTime(job.Submitted),
+		formatOptionalTime(job.Cancelled),
+		formatTime(job.LastTransitionTime),
+		formatString(row.LatestRunId),
+		row.Cluster,
+		formatString(row.Node),
+		formatExitCode(row.ExitCode),
+		strconv.FormatInt(int64(row.RuntimeSeconds), 10),
+		formatString(row.CancelReason),
+		formatString(row.CancelUser),
+		row.Annotations,
+	}
+	return errors.WithStack(c.writer.Write(record))
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return errors.WithStack(c.writer.Error())
+}
+
+type parquetJobWriter struct {
+	writer *parquetWriter.ParquetWriter
+}
+
+// parquetRowGroupSize bounds the memory used by a Parquet export, as rows are buffered until a row group is full.
+const parquetRowGroupSize = 16 * 1024 * 1024
+
+// NewParquetWriter returns a Writer writing jobs as a Parquet file.
+func NewParquetWriter(w io.Writer) (Writer, error) {
+	writer, err := parquetWriter.NewParquetWriterFromWriter(w, new(JobRow), 1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	writer.RowGroupSize = parquetRowGroupSize
+	return &parquetJobWriter{writer: writer}, nil
+}
+
+func (p *parquetJobWriter) Write(job *model.Job) error {
+	row, err := toJobRow(job)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(p.writer.Write(*row))
+}
+
+func (p *parquetJobWriter) Close() error {
+	return errors.WithStack(p.writer.WriteStop())
+}
+
+func formatString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTime(*t)
+}
+
+func formatExitCode(exitCode *int32) string {
+	if exitCode == nil {
+		return ""
+	}
+	return strconv.FormatInt(int64(*exitCode), 10)
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	parquetReader "github.com/xitongsys/parquet-go/reader"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+var (
+	submitted = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	cancelled = time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC)
+	testJobs  = []*model.Job{
+		{
+			JobId:              "job-1",
+			Queue:              "queue-a",
+			JobSet:             "set-a",
+			Owner:              "alice",
+			Namespace:          pointer.String("ns"),
+			State:              "SUCCEEDED",
+			Priority:           1,
+			PriorityClass:      pointer.String("armada-default"),
+			Cpu:                1500,
+			Memory:             1073741824,
+			Gpu:                1,
+			Submitted:          submitted,
+			LastTransitionTime: submitted.Add(time.Minute),
+			LastActiveRunId:    pointer.String("run-1"),
+			Cluster:            "cluster-a",
+			Node:               pointer.String("node-1"),
+			ExitCode:           pointer.Int32(0),
+			RuntimeSeconds:     60,
+			Annotations:        map[string]string{"team": "ml, vision"},
+		},
+		{
+			JobId:              "job-2",
+			Queue:              "queue-a",
+			JobSet:             "set-a",
+			Owner:              "alice",
+			State:              "CANCELLED",
+			Cpu:                1000,
+			Memory:             1024,
+			Submitted:          submitted,
+			Cancelled:          &cancelled,
+			LastTransitionTime: cancelled,
+			CancelReason:       pointer.String("no longer needed"),
+			CancelUser:         pointer.String("bob"),
+			Annotations:        map[string]string{},
+		},
+	}
+)
+
+func TestCsvWriter(t *testing.T) {
+	var out bytes.Buffer
+	writer, err := NewWriter(FormatCsv, &out)
+	require.NoError(t, err)
+	for _, job := range testJobs {
+		require.NoError(t, writer.Write(job))
+	}
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t,
+		"job_id,queue,job_set,owner,namespace,state,priority,priority_class,cpu,memory,ephemeral_storage,gpu,submitted,cancelled,last_transition_time,latest_run_id,cluster,node,exit_code,runtime_seconds,cancel_reason,cancel_user,annotations\n"+
+			`job-1,queue-a,set-a,alice,ns,SUCCEEDED,1,armada-default,1500,1073741824,0,1,2024-03-01T12:00:00Z,,2024-03-01T12:01:00Z,run-1,cluster-a,node-1,0,60,,,"{""team"":""ml, vision""}"`+"\n"+
+			"job-2,queue-a,set-a,alice,,CANCELLED,0,,1000,1024,0,0,2024-03-01T12:00:00Z,2024-03-01T13:00:00Z,2024-03-01T13:00:00Z,,,,,0,no longer needed,bob,{}\n",
+		out.String(),
+	)
+}
+
+func TestParquetWriter(t *testing.T) {
+	var out bytes.Buffer
+	writer, err := NewWriter(FormatParquet, &out)
+	require.NoError(t, err)
+	for _, job := range testJobs {
+		require.NoError(t, writer.Write(job))
+	}
+	require.NoError(t, writer.Close())
+
+	file, err := buffer.NewBufferFile(out.Bytes())
+	require.NoError(t, err)
+	reader, err := parquetReader.NewParquetReader(file, new(JobRow), 1)
+	require.NoError(t, err)
+	defer reader.ReadStop()
+	require.Equal(t, int64(len(testJobs)), reader.GetNumRows())
+	rows := make([]JobRow, len(testJobs))
+	require.NoError(t, reader.Read(&rows))
+
+	assert.Equal(t, "job-1", rows[0].JobId)
+	assert.Equal(t, pointer.String("node-1"), rows[0].Node)
+	assert.Equal(t, submitted.UnixMilli(), rows[0].Submitted)
+	assert.Nil(t, rows[0].Cancelled)
+	assert.Equal(t, `{"team":"ml, vision"}`, rows[0].Annotations)
+	assert.Equal(t, "job-2", rows[1].JobId)
+	assert.Equal(t, pointer.Int64(cancelled.UnixMilli()), rows[1].Cancelled)
+	assert.Nil(t, rows[1].ExitCode)
+	assert.Equal(t, pointer.String("bob"), rows[1].CancelUser)
+}
+
+func TestNewWriter_UnsupportedFormat(t *testing.T) {
+	_, err := NewWriter("xlsx", &bytes.Buffer{})
+	assert.Error(t, err)
+}
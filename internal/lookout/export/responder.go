@@ -0,0 +1,69 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookout/gen/models"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+// StreamFunc calls fn with each job to export, in order.
+type StreamFunc func(fn func(*model.Job) error) error
+
+// NewResponder returns a Responder streaming the jobs produced by stream in the given format.
+// Jobs are written as they're read, so the export isn't held in memory. If stream fails before any job has been
+// written, e.g. because the query is invalid, a 400 response is returned; after that, the status has already been sent,
+// so the response is truncated and the error logged.
+func NewResponder(format string, stream StreamFunc) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		var writer Writer
+		start := func() error {
+			rw.Header().Set(runtime.HeaderContentType, ContentType(format))
+			rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", FileName(format)))
+			w, err := NewWriter(format, rw)
+			if err != nil {
+				return err
+			}
+			writer = w
+			return nil
+		}
+		err := stream(func(job *model.Job) error {
+			if writer == nil {
+				if err := start(); err != nil {
+					return err
+				}
+			}
+			return writer.Write(job)
+		})
+		if err == nil && writer == nil {
+			// No jobs matched; write an export with no rows.
+			err = start()
+		}
+		if err != nil {
+			if writer == nil {
+				writeError(rw, err)
+			} else {
+				log.WithError(err).Error("failed to export jobs; response is incomplete")
+			}
+			return
+		}
+		if err := writer.Close(); err != nil {
+			log.WithError(err).Error("failed to finish exporting jobs; response is incomplete")
+		}
+	})
+}
+
+func writeError(rw http.ResponseWriter, err error) {
+	rw.Header().Del("Content-Disposition")
+	rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+	rw.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(rw).Encode(&models.Error{Error: err.Error()}); err != nil {
+		log.WithError(err).Error("failed to write error response")
+	}
+}
@@ -0,0 +1,60 @@
+package export
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+func streamJobs(jobs []*model.Job, err error) StreamFunc {
+	return func(fn func(*model.Job) error) error {
+		for _, job := range jobs {
+			if err := fn(job); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func TestResponder(t *testing.T) {
+	rw := httptest.NewRecorder()
+	NewResponder(FormatCsv, streamJobs(testJobs[:1], nil)).WriteResponse(rw, nil)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "text/csv", rw.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="jobs.csv"`, rw.Header().Get("Content-Disposition"))
+	assert.Contains(t, rw.Body.String(), "\njob-1,queue-a,set-a,")
+}
+
+func TestResponder_NoJobs(t *testing.T) {
+	rw := httptest.NewRecorder()
+	NewResponder(FormatCsv, streamJobs(nil, nil)).WriteResponse(rw, nil)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "job_id,queue,job_set,owner,namespace,state,priority,priority_class,cpu,memory,ephemeral_storage,gpu,submitted,cancelled,last_transition_time,latest_run_id,cluster,node,exit_code,runtime_seconds,cancel_reason,cancel_user,annotations\n", rw.Body.String())
+}
+
+func TestResponder_ErrorBeforeFirstJob(t *testing.T) {
+	rw := httptest.NewRecorder()
+	NewResponder(FormatParquet, streamJobs(nil, errors.New("filters are invalid"))).WriteResponse(rw, nil)
+
+	assert.Equal(t, http.StatusBadRequest, rw.Code)
+	assert.Equal(t, "application/json", rw.Header().Get("Content-Type"))
+	assert.Empty(t, rw.Header().Get("Content-Disposition"))
+	assert.JSONEq(t, `{"error": "filters are invalid"}`, rw.Body.String())
+}
+
+func TestResponder_ErrorAfterFirstJob(t *testing.T) {
+	rw := httptest.NewRecorder()
+	NewResponder(FormatCsv, streamJobs(testJobs[:1], errors.New("connection reset"))).WriteResponse(rw, nil)
+
+	// The status has already been sent, so the response is just truncated.
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.NotContains(t, rw.Body.String(), "connection reset")
+}
@@ -28,6 +28,9 @@ type Filter struct {
 	// is annotation
 	IsAnnotation bool `json:"isAnnotation,omitempty"`
 
+	// If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu
+	IsResource bool `json:"isResource,omitempty"`
+
 	// match
 	// Required: true
 	// Enum: ["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists"]
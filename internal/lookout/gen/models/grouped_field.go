@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GroupedField grouped field
+//
+// swagger:model groupedField
+type GroupedField struct {
+
+	// Field or annotation key to group by
+	// Required: true
+	Field string `json:"field"`
+
+	// is annotation
+	IsAnnotation bool `json:"isAnnotation,omitempty"`
+}
+
+// Validate validates this grouped field
+func (m *GroupedField) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateField(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GroupedField) validateField(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("field", "body", m.Field); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this grouped field based on context it is used
+func (m *GroupedField) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GroupedField) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GroupedField) UnmarshalBinary(b []byte) error {
+	var res GroupedField
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -29,6 +29,9 @@ type Order struct {
 	// Required: true
 	// Min Length: 1
 	Field string `json:"field"`
+
+	// If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu
+	IsResource bool `json:"isResource,omitempty"`
 }
 
 // Validate validates this order
@@ -12,6 +12,8 @@
 //	  - application/json
 //
 //	Produces:
+//	  - application/octet-stream
+//	  - text/csv
 //	  - application/json
 //	  - text/plain
 //
@@ -112,7 +112,7 @@ func init() {
                   "type": "boolean"
                 },
                 "aggregates": {
-                  "description": "Additional fields to compute aggregates on",
+                  "description": "Additional fields to compute aggregates on. cpuEfficiency and memoryEfficiency compute the average ratio of usage to request over the latest run of each job.",
                   "type": "array",
                   "items": {
                     "type": "string",
@@ -150,6 +150,14 @@ func init() {
                   "x-nullable": true,
                   "$ref": "#/definitions/order"
                 },
+                "resourceAggregates": {
+                  "description": "Aggregates to compute on the amount of resources requested by jobs. Results are returned under the resources aggregate, keyed by resource and then aggregate.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/resourceAggregate"
+                  },
+                  "x-nullable": true
+                },
                 "skip": {
                   "description": "First elements to ignore from the full set of results. Used for pagination.",
                   "type": "integer"
@@ -313,6 +321,66 @@ func init() {
         }
       }
     },
+    "/api/v1/jobRunResourceUtilisation": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRunResourceUtilisation",
+        "parameters": [
+          {
+            "name": "getJobRunResourceUtilisationRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the resources used by a specific job run",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resourceUtilisation": {
+                  "description": "Usage of each resource reported for the job run",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/resourceUtilisation"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobSpec": {
       "post": {
         "consumes": [
@@ -454,6 +522,232 @@ func init() {
         }
       }
     },
+    "/api/v1/jobs/export": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json",
+          "text/csv",
+          "application/octet-stream"
+        ],
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "type": "string",
+                  "enum": [
+                    "csv",
+                    "parquet"
+                  ],
+                  "x-nullable": false
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "savedQuery": {
+                  "description": "Name of a saved query whose filters, order and activeJobSets select the jobs to export. Its grouping, if any, is ignored. Mutually exclusive with filters and order.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Streams every matching job, one row per job, as CSV or Parquet",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedQueries": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listSavedQueries",
+        "responses": {
+          "200": {
+            "description": "Returns all saved queries, ordered by name",
+            "schema": {
+              "type": "object",
+              "required": [
+                "savedQueries"
+              ],
+              "properties": {
+                "savedQueries": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedQuery"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedQueries/{name}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getSavedQuery",
+        "responses": {
+          "200": {
+            "description": "Returns the saved query",
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          },
+          "404": {
+            "description": "Saved query not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "putSavedQuery",
+        "parameters": [
+          {
+            "description": "The query to save under name, replacing any query the caller has already saved under it. The name in the body is ignored.",
+            "name": "savedQuery",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved query",
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "A query saved by another user exists under name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "deleteSavedQuery",
+        "responses": {
+          "204": {
+            "description": "Saved query deleted"
+          },
+          "403": {
+            "description": "Saved query was created by another user",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Saved query not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "maxLength": 255,
+          "minLength": 1,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/health": {
       "get": {
         "produces": [
@@ -507,6 +801,11 @@ func init() {
           "type": "boolean",
           "x-nullable": false
         },
+        "isResource": {
+          "description": "If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu",
+          "type": "boolean",
+          "x-nullable": false
+        },
         "match": {
           "type": "string",
           "enum": [
@@ -553,6 +852,23 @@ func init() {
         }
       }
     },
+    "groupedField": {
+      "type": "object",
+      "required": [
+        "field"
+      ],
+      "properties": {
+        "field": {
+          "description": "Field or annotation key to group by",
+          "type": "string",
+          "x-nullable": false
+        },
+        "isAnnotation": {
+          "type": "boolean",
+          "x-nullable": false
+        }
+      }
+    },
     "job": {
       "type": "object",
       "required": [
@@ -729,18 +1045,78 @@ func init() {
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        },
+        "isResource": {
+          "description": "If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu",
+          "type": "boolean",
+          "x-nullable": false
         }
       }
     },
-    "run": {
+    "resourceAggregate": {
       "type": "object",
       "required": [
-        "runId",
-        "cluster",
-        "jobRunState"
+        "resource",
+        "aggregate"
       ],
       "properties": {
-        "cluster": {
+        "aggregate": {
+          "type": "string",
+          "enum": [
+            "sum",
+            "avg"
+          ],
+          "x-nullable": false
+        },
+        "resource": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "resourceUtilisation": {
+      "type": "object",
+      "required": [
+        "resource",
+        "max",
+        "average",
+        "lastUpdated"
+      ],
+      "properties": {
+        "average": {
+          "description": "Average usage of the resource over the lifetime of the run",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "lastUpdated": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "max": {
+          "description": "Maximum usage of the resource over the lifetime of the run",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "resource": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "run": {
+      "type": "object",
+      "required": [
+        "runId",
+        "cluster",
+        "jobRunState"
+      ],
+      "properties": {
+        "cluster": {
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -800,6 +1176,79 @@ func init() {
           "x-nullable": true
         }
       }
+    },
+    "savedQuery": {
+      "description": "A named set of filters, order and, optionally, grouping of jobs, stored so that it can be reused.",
+      "type": "object",
+      "required": [
+        "filters",
+        "order"
+      ],
+      "properties": {
+        "activeJobSets": {
+          "description": "Only include jobs in active job sets",
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "aggregates": {
+          "description": "Aggregates to compute on each group if groupedField is set",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "x-nullable": false
+          },
+          "x-nullable": false
+        },
+        "created": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false,
+          "readOnly": true
+        },
+        "createdBy": {
+          "type": "string",
+          "x-nullable": false,
+          "readOnly": true
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "filters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          },
+          "x-nullable": false
+        },
+        "groupedField": {
+          "description": "If set, jobs are grouped by this field, as by groupJobs",
+          "$ref": "#/definitions/groupedField"
+        },
+        "name": {
+          "description": "Name of the saved query. Ignored when saving a query, as it's named by the path.",
+          "type": "string",
+          "maxLength": 255,
+          "x-nullable": false
+        },
+        "order": {
+          "$ref": "#/definitions/order"
+        },
+        "resourceAggregates": {
+          "description": "Aggregates to compute on the resources requested by each group if groupedField is set",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resourceAggregate"
+          },
+          "x-nullable": false
+        },
+        "updated": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false,
+          "readOnly": true
+        }
+      }
     }
   },
   "parameters": {
@@ -909,7 +1358,7 @@ func init() {
                   "type": "boolean"
                 },
                 "aggregates": {
-                  "description": "Additional fields to compute aggregates on",
+                  "description": "Additional fields to compute aggregates on. cpuEfficiency and memoryEfficiency compute the average ratio of usage to request over the latest run of each job.",
                   "type": "array",
                   "items": {
                     "type": "string",
@@ -947,6 +1396,14 @@ func init() {
                   "x-nullable": true,
                   "$ref": "#/definitions/order"
                 },
+                "resourceAggregates": {
+                  "description": "Aggregates to compute on the amount of resources requested by jobs. Results are returned under the resources aggregate, keyed by resource and then aggregate.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/resourceAggregate"
+                  },
+                  "x-nullable": true
+                },
                 "skip": {
                   "description": "First elements to ignore from the full set of results. Used for pagination.",
                   "type": "integer"
@@ -1116,6 +1573,66 @@ func init() {
         }
       }
     },
+    "/api/v1/jobRunResourceUtilisation": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRunResourceUtilisation",
+        "parameters": [
+          {
+            "name": "getJobRunResourceUtilisationRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the resources used by a specific job run",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "resourceUtilisation": {
+                  "description": "Usage of each resource reported for the job run",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/resourceUtilisation"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobSpec": {
       "post": {
         "consumes": [
@@ -1263,6 +1780,232 @@ func init() {
         }
       }
     },
+    "/api/v1/jobs/export": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json",
+          "text/csv",
+          "application/octet-stream"
+        ],
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "type": "string",
+                  "enum": [
+                    "csv",
+                    "parquet"
+                  ],
+                  "x-nullable": false
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "savedQuery": {
+                  "description": "Name of a saved query whose filters, order and activeJobSets select the jobs to export. Its grouping, if any, is ignored. Mutually exclusive with filters and order.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Streams every matching job, one row per job, as CSV or Parquet",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedQueries": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listSavedQueries",
+        "responses": {
+          "200": {
+            "description": "Returns all saved queries, ordered by name",
+            "schema": {
+              "type": "object",
+              "required": [
+                "savedQueries"
+              ],
+              "properties": {
+                "savedQueries": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedQuery"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedQueries/{name}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getSavedQuery",
+        "responses": {
+          "200": {
+            "description": "Returns the saved query",
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          },
+          "404": {
+            "description": "Saved query not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "putSavedQuery",
+        "parameters": [
+          {
+            "description": "The query to save under name, replacing any query the caller has already saved under it. The name in the body is ignored.",
+            "name": "savedQuery",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved query",
+            "schema": {
+              "$ref": "#/definitions/savedQuery"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "A query saved by another user exists under name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "deleteSavedQuery",
+        "responses": {
+          "204": {
+            "description": "Saved query deleted"
+          },
+          "403": {
+            "description": "Saved query was created by another user",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Saved query not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "maxLength": 255,
+          "minLength": 1,
+          "type": "string",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/health": {
       "get": {
         "produces": [
@@ -1333,6 +2076,11 @@ func init() {
           "type": "boolean",
           "x-nullable": false
         },
+        "isResource": {
+          "description": "If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu",
+          "type": "boolean",
+          "x-nullable": false
+        },
         "match": {
           "type": "string",
           "enum": [
@@ -1379,6 +2127,23 @@ func init() {
         }
       }
     },
+    "groupedField": {
+      "type": "object",
+      "required": [
+        "field"
+      ],
+      "properties": {
+        "field": {
+          "description": "Field or annotation key to group by",
+          "type": "string",
+          "x-nullable": false
+        },
+        "isAnnotation": {
+          "type": "boolean",
+          "x-nullable": false
+        }
+      }
+    },
     "job": {
       "type": "object",
       "required": [
@@ -1555,6 +2320,66 @@ func init() {
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        },
+        "isResource": {
+          "description": "If true, field is the name of a resource requested by jobs, e.g. amd.com/gpu",
+          "type": "boolean",
+          "x-nullable": false
+        }
+      }
+    },
+    "resourceAggregate": {
+      "type": "object",
+      "required": [
+        "resource",
+        "aggregate"
+      ],
+      "properties": {
+        "aggregate": {
+          "type": "string",
+          "enum": [
+            "sum",
+            "avg"
+          ],
+          "x-nullable": false
+        },
+        "resource": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "resourceUtilisation": {
+      "type": "object",
+      "required": [
+        "resource",
+        "max",
+        "average",
+        "lastUpdated"
+      ],
+      "properties": {
+        "average": {
+          "description": "Average usage of the resource over the lifetime of the run",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "lastUpdated": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        },
+        "max": {
+          "description": "Maximum usage of the resource over the lifetime of the run",
+          "type": "number",
+          "format": "double",
+          "x-nullable": false
+        },
+        "resource": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
         }
       }
     },
@@ -1626,6 +2451,79 @@ func init() {
           "x-nullable": true
         }
       }
+    },
+    "savedQuery": {
+      "description": "A named set of filters, order and, optionally, grouping of jobs, stored so that it can be reused.",
+      "type": "object",
+      "required": [
+        "filters",
+        "order"
+      ],
+      "properties": {
+        "activeJobSets": {
+          "description": "Only include jobs in active job sets",
+          "type": "boolean",
+          "x-nullable": false
+        },
+        "aggregates": {
+          "description": "Aggregates to compute on each group if groupedField is set",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "x-nullable": false
+          },
+          "x-nullable": false
+        },
+        "created": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false,
+          "readOnly": true
+        },
+        "createdBy": {
+          "type": "string",
+          "x-nullable": false,
+          "readOnly": true
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "filters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          },
+          "x-nullable": false
+        },
+        "groupedField": {
+          "description": "If set, jobs are grouped by this field, as by groupJobs",
+          "$ref": "#/definitions/groupedField"
+        },
+        "name": {
+          "description": "Name of the saved query. Ignored when saving a query, as it's named by the path.",
+          "type": "string",
+          "maxLength": 255,
+          "x-nullable": false
+        },
+        "order": {
+          "$ref": "#/definitions/order"
+        },
+        "resourceAggregates": {
+          "description": "Aggregates to compute on the resources requested by each group if groupedField is set",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resourceAggregate"
+          },
+          "x-nullable": false
+        },
+        "updated": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false,
+          "readOnly": true
+        }
+      }
     }
   },
   "parameters": {
@@ -71,7 +71,7 @@ type GroupJobsBody struct {
 	// Only include jobs in active job sets
 	ActiveJobSets bool `json:"activeJobSets,omitempty"`
 
-	// Additional fields to compute aggregates on
+	// Additional fields to compute aggregates on. cpuEfficiency and memoryEfficiency compute the average ratio of usage to request over the latest run of each job.
 	// Required: true
 	Aggregates []string `json:"aggregates"`
 
@@ -87,6 +87,9 @@ type GroupJobsBody struct {
 	// Required: true
 	Order *models.Order `json:"order"`
 
+	// Aggregates to compute on the amount of resources requested by jobs. Results are returned under the resources aggregate, keyed by resource and then aggregate.
+	ResourceAggregates []*models.ResourceAggregate `json:"resourceAggregates"`
+
 	// First elements to ignore from the full set of results. Used for pagination.
 	Skip int64 `json:"skip,omitempty"`
 
@@ -114,6 +117,10 @@ func (o *GroupJobsBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateResourceAggregates(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -196,6 +203,32 @@ func (o *GroupJobsBody) validateOrder(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *GroupJobsBody) validateResourceAggregates(formats strfmt.Registry) error {
+	if swag.IsZero(o.ResourceAggregates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.ResourceAggregates); i++ {
+		if swag.IsZero(o.ResourceAggregates[i]) { // not required
+			continue
+		}
+
+		if o.ResourceAggregates[i] != nil {
+			if err := o.ResourceAggregates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groupJobsRequest" + "." + "resourceAggregates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("groupJobsRequest" + "." + "resourceAggregates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this group jobs body based on the context it is used
 func (o *GroupJobsBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -212,6 +245,10 @@ func (o *GroupJobsBody) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateResourceAggregates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -277,6 +314,31 @@ func (o *GroupJobsBody) contextValidateOrder(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (o *GroupJobsBody) contextValidateResourceAggregates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.ResourceAggregates); i++ {
+
+		if o.ResourceAggregates[i] != nil {
+
+			if swag.IsZero(o.ResourceAggregates[i]) { // not required
+				return nil
+			}
+
+			if err := o.ResourceAggregates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groupJobsRequest" + "." + "resourceAggregates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("groupJobsRequest" + "." + "resourceAggregates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *GroupJobsBody) MarshalBinary() ([]byte, error) {
 	if o == nil {
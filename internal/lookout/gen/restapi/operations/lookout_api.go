@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -40,12 +41,22 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer: runtime.ByteStreamProducer(),
+		CsvProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("csv producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
 		TxtProducer:  runtime.TextProducer(),
 
 		GetHealthHandler: GetHealthHandlerFunc(func(params GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetHealth has not yet been implemented")
 		}),
+		DeleteSavedQueryHandler: DeleteSavedQueryHandlerFunc(func(params DeleteSavedQueryParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteSavedQuery has not yet been implemented")
+		}),
+		ExportJobsHandler: ExportJobsHandlerFunc(func(params ExportJobsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ExportJobs has not yet been implemented")
+		}),
 		GetJobErrorHandler: GetJobErrorHandlerFunc(func(params GetJobErrorParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobError has not yet been implemented")
 		}),
@@ -55,15 +66,27 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 		GetJobRunErrorHandler: GetJobRunErrorHandlerFunc(func(params GetJobRunErrorParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobRunError has not yet been implemented")
 		}),
+		GetJobRunResourceUtilisationHandler: GetJobRunResourceUtilisationHandlerFunc(func(params GetJobRunResourceUtilisationParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetJobRunResourceUtilisation has not yet been implemented")
+		}),
 		GetJobSpecHandler: GetJobSpecHandlerFunc(func(params GetJobSpecParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobSpec has not yet been implemented")
 		}),
 		GetJobsHandler: GetJobsHandlerFunc(func(params GetJobsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetJobs has not yet been implemented")
 		}),
+		GetSavedQueryHandler: GetSavedQueryHandlerFunc(func(params GetSavedQueryParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetSavedQuery has not yet been implemented")
+		}),
 		GroupJobsHandler: GroupJobsHandlerFunc(func(params GroupJobsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GroupJobs has not yet been implemented")
 		}),
+		ListSavedQueriesHandler: ListSavedQueriesHandlerFunc(func(params ListSavedQueriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListSavedQueries has not yet been implemented")
+		}),
+		PutSavedQueryHandler: PutSavedQueryHandlerFunc(func(params PutSavedQueryParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutSavedQuery has not yet been implemented")
+		}),
 	}
 }
 
@@ -96,6 +119,12 @@ type LookoutAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
+	// CsvProducer registers a producer for the following mime types:
+	//   - text/csv
+	CsvProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
@@ -105,18 +134,30 @@ type LookoutAPI struct {
 
 	// GetHealthHandler sets the operation handler for the get health operation
 	GetHealthHandler GetHealthHandler
+	// DeleteSavedQueryHandler sets the operation handler for the delete saved query operation
+	DeleteSavedQueryHandler DeleteSavedQueryHandler
+	// ExportJobsHandler sets the operation handler for the export jobs operation
+	ExportJobsHandler ExportJobsHandler
 	// GetJobErrorHandler sets the operation handler for the get job error operation
 	GetJobErrorHandler GetJobErrorHandler
 	// GetJobRunDebugMessageHandler sets the operation handler for the get job run debug message operation
 	GetJobRunDebugMessageHandler GetJobRunDebugMessageHandler
 	// GetJobRunErrorHandler sets the operation handler for the get job run error operation
 	GetJobRunErrorHandler GetJobRunErrorHandler
+	// GetJobRunResourceUtilisationHandler sets the operation handler for the get job run resource utilisation operation
+	GetJobRunResourceUtilisationHandler GetJobRunResourceUtilisationHandler
 	// GetJobSpecHandler sets the operation handler for the get job spec operation
 	GetJobSpecHandler GetJobSpecHandler
 	// GetJobsHandler sets the operation handler for the get jobs operation
 	GetJobsHandler GetJobsHandler
+	// GetSavedQueryHandler sets the operation handler for the get saved query operation
+	GetSavedQueryHandler GetSavedQueryHandler
 	// GroupJobsHandler sets the operation handler for the group jobs operation
 	GroupJobsHandler GroupJobsHandler
+	// ListSavedQueriesHandler sets the operation handler for the list saved queries operation
+	ListSavedQueriesHandler ListSavedQueriesHandler
+	// PutSavedQueryHandler sets the operation handler for the put saved query operation
+	PutSavedQueryHandler PutSavedQueryHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -190,6 +231,12 @@ func (o *LookoutAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+	if o.CsvProducer == nil {
+		unregistered = append(unregistered, "CsvProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -200,6 +247,12 @@ func (o *LookoutAPI) Validate() error {
 	if o.GetHealthHandler == nil {
 		unregistered = append(unregistered, "GetHealthHandler")
 	}
+	if o.DeleteSavedQueryHandler == nil {
+		unregistered = append(unregistered, "DeleteSavedQueryHandler")
+	}
+	if o.ExportJobsHandler == nil {
+		unregistered = append(unregistered, "ExportJobsHandler")
+	}
 	if o.GetJobErrorHandler == nil {
 		unregistered = append(unregistered, "GetJobErrorHandler")
 	}
@@ -209,15 +262,27 @@ func (o *LookoutAPI) Validate() error {
 	if o.GetJobRunErrorHandler == nil {
 		unregistered = append(unregistered, "GetJobRunErrorHandler")
 	}
+	if o.GetJobRunResourceUtilisationHandler == nil {
+		unregistered = append(unregistered, "GetJobRunResourceUtilisationHandler")
+	}
 	if o.GetJobSpecHandler == nil {
 		unregistered = append(unregistered, "GetJobSpecHandler")
 	}
 	if o.GetJobsHandler == nil {
 		unregistered = append(unregistered, "GetJobsHandler")
 	}
+	if o.GetSavedQueryHandler == nil {
+		unregistered = append(unregistered, "GetSavedQueryHandler")
+	}
 	if o.GroupJobsHandler == nil {
 		unregistered = append(unregistered, "GroupJobsHandler")
 	}
+	if o.ListSavedQueriesHandler == nil {
+		unregistered = append(unregistered, "ListSavedQueriesHandler")
+	}
+	if o.PutSavedQueryHandler == nil {
+		unregistered = append(unregistered, "PutSavedQueryHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -264,6 +329,10 @@ func (o *LookoutAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produc
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "text/csv":
+			result["text/csv"] = o.CsvProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
 		case "text/plain":
@@ -312,6 +381,14 @@ func (o *LookoutAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/health"] = NewGetHealth(o.context, o.GetHealthHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/api/v1/savedQueries/{name}"] = NewDeleteSavedQuery(o.context, o.DeleteSavedQueryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobs/export"] = NewExportJobs(o.context, o.ExportJobsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -327,15 +404,31 @@ func (o *LookoutAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/jobRunResourceUtilisation"] = NewGetJobRunResourceUtilisation(o.context, o.GetJobRunResourceUtilisationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobSpec"] = NewGetJobSpec(o.context, o.GetJobSpecHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/api/v1/jobs"] = NewGetJobs(o.context, o.GetJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/savedQueries/{name}"] = NewGetSavedQuery(o.context, o.GetSavedQueryHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/api/v1/jobGroups"] = NewGroupJobs(o.context, o.GroupJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/savedQueries"] = NewListSavedQueries(o.context, o.ListSavedQueriesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/api/v1/savedQueries/{name}"] = NewPutSavedQuery(o.context, o.PutSavedQueryHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
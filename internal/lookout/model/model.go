@@ -19,6 +19,11 @@ const (
 	DirectionDesc = "DESC"
 )
 
+const (
+	AggregateSum     = "sum"
+	AggregateAverage = "avg"
+)
+
 type Job struct {
 	Annotations        map[string]string
 	Cancelled          *time.Time
@@ -91,10 +96,19 @@ type Run struct {
 	Node        *string
 	Leased      *PostgreSQLTime
 	Pending     *PostgreSQLTime
+	Pool        string
 	RunId       string
 	Started     *PostgreSQLTime
 }
 
+// ResourceUtilisation is the usage of a single resource by a job run, as reported by the executor.
+type ResourceUtilisation struct {
+	Resource    string
+	Max         float64
+	Average     float64
+	LastUpdated time.Time
+}
+
 type JobGroup struct {
 	Aggregates map[string]interface{}
 	Count      int64
@@ -106,14 +120,44 @@ type Filter struct {
 	Match        string
 	Value        interface{}
 	IsAnnotation bool
+	// If true, Field is the key of a job label. Only exact and exists matches are supported for labels.
+	IsLabel bool
+	// If true, Field is the name of a resource requested by the job, e.g. amd.com/gpu.
+	// Jobs not requesting the resource are treated as requesting zero of it.
+	IsResource bool
 }
 
 type Order struct {
 	Direction string
 	Field     string
+	// If true, Field is the name of a resource requested by the job.
+	IsResource bool
+}
+
+// ResourceAggregate is an aggregate, one of AggregateSum or AggregateAverage, over the amount of a resource
+// requested by each job in a group.
+type ResourceAggregate struct {
+	Resource  string
+	Aggregate string
 }
 
 type GroupedField struct {
 	Field        string
 	IsAnnotation bool
 }
+
+// SavedQuery is a named set of filters, order and, optionally, grouping of jobs, stored so that it can be reused.
+type SavedQuery struct {
+	Name          string
+	Description   string
+	Filters       []*Filter
+	Order         *Order
+	ActiveJobSets bool
+	// If set, jobs are grouped by this field, and Aggregates and ResourceAggregates are computed on each group.
+	GroupedField       *GroupedField
+	Aggregates         []string
+	ResourceAggregates []*ResourceAggregate
+	CreatedBy          string
+	Created            time.Time
+	Updated            time.Time
+}
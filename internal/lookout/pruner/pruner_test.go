@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	clock "k8s.io/utils/clock/testing"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -151,7 +152,9 @@ func TestPruneDb(t *testing.T) {
 				queriedJobIdsPerTable := []map[string]bool{
 					selectStringSet(t, db, "SELECT job_id FROM job"),
 					selectStringSet(t, db, "SELECT job_id FROM job_spec"),
+					selectStringSet(t, db, "SELECT DISTINCT job_id FROM job_resource_request"),
 					selectStringSet(t, db, "SELECT DISTINCT job_id FROM job_run"),
+					selectStringSet(t, db, "SELECT DISTINCT job_id FROM job_run_resource_utilisation"),
 				}
 				for _, queriedJobs := range queriedJobIdsPerTable {
 					assert.Equal(t, len(tc.jobIdsLeft), len(queriedJobs))
@@ -169,6 +172,7 @@ func TestPruneDb(t *testing.T) {
 
 func storeJob(job testJob, db *lookoutdb.LookoutDb, converter *instructions.InstructionConverter) {
 	runId := uuid.NewString()
+	usage := map[string]resource.Quantity{"cpu": resource.MustParse("100m")}
 	simulator := repository.NewJobSimulator(converter, db).
 		Submit("queue", "jobSet", "owner", "namespace", job.ts, &repository.JobOptions{
 			JobId: job.jobId,
@@ -179,7 +183,8 @@ func storeJob(job testJob, db *lookoutdb.LookoutDb, converter *instructions.Inst
 		}).
 		Lease(runId, "cluster", "node", job.ts).
 		Pending(runId, "cluster", job.ts).
-		Running(runId, "node", job.ts)
+		Running(runId, "node", job.ts).
+		ResourceUtilisation(runId, usage, usage, job.ts)
 
 	switch job.state {
 	case lookout.JobSucceeded:
@@ -62,6 +62,36 @@ func (qa *StateCountAggregator) AggregateSql() (string, error) {
 	), nil
 }
 
+// EfficiencyAggregator computes the average ratio of resource usage to resource request over the latest run of each
+// job in a group. Jobs whose latest run hasn't reported any usage, or which don't request the resource, are ignored.
+type EfficiencyAggregator struct {
+	queryCol   *queryColumn
+	efficiency resourceEfficiency
+}
+
+func NewEfficiencyAggregator(queryCol *queryColumn) (*EfficiencyAggregator, error) {
+	efficiency, ok := efficiencyColumns[queryCol.name]
+	if !ok {
+		return nil, errors.Errorf("cannot compute efficiency for column %s", queryCol.name)
+	}
+	return &EfficiencyAggregator{
+		queryCol:   queryCol,
+		efficiency: efficiency,
+	}, nil
+}
+
+func (qa *EfficiencyAggregator) aggregateColName() string {
+	return qa.queryCol.name
+}
+
+func (qa *EfficiencyAggregator) AggregateSql() (string, error) {
+	return fmt.Sprintf(
+		"AVG((SELECT u.avg_value FROM %s AS u WHERE u.run_id = %s.%s AND u.resource = '%s') * %g / NULLIF(%s.%s, 0)) AS %s",
+		jobRunResourceUtilisationTable, qa.queryCol.abbrev, latestRunIdCol, qa.efficiency.resource,
+		qa.efficiency.usageScale, qa.queryCol.abbrev, qa.efficiency.requestCol, qa.aggregateColName(),
+	), nil
+}
+
 func GetAggregatorsForColumn(queryCol *queryColumn, aggregateType AggregateType, filters []*model.Filter) ([]QueryAggregator, error) {
 	switch aggregateType {
 	case Max:
@@ -77,6 +107,12 @@ func GetAggregatorsForColumn(queryCol *queryColumn, aggregateType AggregateType,
 		return aggregators, nil
 	case Min:
 		return []QueryAggregator{NewSqlFunctionAggregator(queryCol, "MIN")}, nil
+	case Efficiency:
+		aggregator, err := NewEfficiencyAggregator(queryCol)
+		if err != nil {
+			return nil, err
+		}
+		return []QueryAggregator{aggregator}, nil
 	default:
 		return nil, errors.Errorf("cannot determine aggregate type: %v", aggregateType)
 	}
@@ -89,6 +89,27 @@ func (fp *BasicParser[T]) ParseValue() (interface{}, error) {
 	return fp.variable, nil
 }
 
+// NullableFloatParser parses a float aggregate, which is null if there were no values to aggregate.
+type NullableFloatParser struct {
+	field    string
+	variable pgtype.Float8
+}
+
+func (fp *NullableFloatParser) GetField() string {
+	return fp.field
+}
+
+func (fp *NullableFloatParser) GetVariableRef() interface{} {
+	return &fp.variable
+}
+
+func (fp *NullableFloatParser) ParseValue() (interface{}, error) {
+	if !fp.variable.Valid {
+		return nil, nil
+	}
+	return fp.variable.Float64, nil
+}
+
 func ParserForGroup(field string) FieldParser {
 	switch field {
 	case stateField:
@@ -105,6 +126,8 @@ func ParsersForAggregate(field string, filters []*model.Filter) ([]FieldParser,
 		parsers = append(parsers, &LastTransitionTimeParser{})
 	case submittedField:
 		parsers = append(parsers, &TimeParser{field: submittedField})
+	case cpuEfficiencyField, memoryEfficiencyField:
+		parsers = append(parsers, &NullableFloatParser{field: field})
 	case stateField:
 		states := GetStatesForFilter(filters)
 		for _, state := range states {
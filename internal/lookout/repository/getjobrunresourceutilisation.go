@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+type GetJobRunResourceUtilisationRepository interface {
+	GetJobRunResourceUtilisation(ctx *armadacontext.Context, runId string) ([]*model.ResourceUtilisation, error)
+}
+
+type SqlGetJobRunResourceUtilisationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlGetJobRunResourceUtilisationRepository(db *pgxpool.Pool) *SqlGetJobRunResourceUtilisationRepository {
+	return &SqlGetJobRunResourceUtilisationRepository{
+		db: db,
+	}
+}
+
+// GetJobRunResourceUtilisation returns the usage of each resource reported for the run, ordered by resource name.
+// An empty list is returned if no usage has been reported for the run.
+func (r *SqlGetJobRunResourceUtilisationRepository) GetJobRunResourceUtilisation(ctx *armadacontext.Context, runId string) ([]*model.ResourceUtilisation, error) {
+	rows, err := r.db.Query(
+		ctx,
+		`SELECT resource, max_value, avg_value, last_updated
+		FROM job_run_resource_utilisation
+		WHERE run_id = $1
+		ORDER BY resource`,
+		runId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*model.ResourceUtilisation{}
+	for rows.Next() {
+		utilisation := &model.ResourceUtilisation{}
+		if err := rows.Scan(&utilisation.Resource, &utilisation.Max, &utilisation.Average, &utilisation.LastUpdated); err != nil {
+			return nil, err
+		}
+		result = append(result, utilisation)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookoutingester/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingester/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingester/metrics"
+)
+
+func TestGetJobRunResourceUtilisation(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, userAnnotationPrefix, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10)
+
+		_ = NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(runId, cluster, node, baseTime).
+			Pending(runId, cluster, baseTime).
+			Running(runId, node, baseTime).
+			ResourceUtilisation(
+				runId,
+				map[string]resource.Quantity{"cpu": resource.MustParse("1500m"), "memory": resource.MustParse("1Gi")},
+				map[string]resource.Quantity{"cpu": resource.MustParse("500m"), "memory": resource.MustParse("512Mi")},
+				baseTime,
+			).
+			Build()
+
+		repo := NewSqlGetJobRunResourceUtilisationRepository(db)
+		result, err := repo.GetJobRunResourceUtilisation(armadacontext.TODO(), runId)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.ResourceUtilisation{
+			{Resource: "cpu", Max: 1.5, Average: 0.5, LastUpdated: baseTime},
+			{Resource: "memory", Max: 1024 * 1024 * 1024, Average: 512 * 1024 * 1024, LastUpdated: baseTime},
+		}, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobRunResourceUtilisationNotFound(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlGetJobRunResourceUtilisationRepository(db)
+		result, err := repo.GetJobRunResourceUtilisation(armadacontext.TODO(), runId)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
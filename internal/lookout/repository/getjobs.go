@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"k8s.io/utils/clock"
 
@@ -83,55 +84,93 @@ func (r *SqlGetJobsRepository) getJobs(ctx *armadacontext.Context, filters []*mo
 
 	defer rows.Close()
 	for rows.Next() {
-		var row jobRow
-		var annotations sql.NullString
-		var runs sql.NullString
-		if err := rows.Scan(
-			&row.jobId,
-			&row.queue,
-			&row.owner,
-			&row.namespace,
-			&row.jobSet,
-			&row.cpu,
-			&row.memory,
-			&row.ephemeralStorage,
-			&row.gpu,
-			&row.priority,
-			&row.submitted,
-			&row.cancelled,
-			&row.state,
-			&row.lastTransitionTime,
-			&row.duplicate,
-			&row.priorityClass,
-			&row.latestRunId,
-			&row.cancelReason,
-			&row.cancelUser,
-			&annotations,
-			&runs,
-		); err != nil {
+		job, err := r.scanJob(rows)
+		if err != nil {
 			return nil, err
 		}
-		job := jobRowToModel(&row)
-		if annotations.Valid {
-			if err := json.Unmarshal([]byte(annotations.String), &job.Annotations); err != nil {
-				return nil, err
-			}
+		jobs = append(jobs, job)
+	}
+	return &GetJobsResult{Jobs: jobs}, nil
+}
+
+// StreamJobs calls fn with each job matching filters, in the given order, as the job is read from the database.
+// Unlike GetJobs, the number of jobs isn't limited, so it can be used to export every matching job.
+func (r *SqlGetJobsRepository) StreamJobs(ctx *armadacontext.Context, filters []*model.Filter, activeJobSets bool, order *model.Order, fn func(*model.Job) error) error {
+	user := auth.GetPrincipal(ctx).GetName()
+	query, err := NewQueryBuilder(r.lookoutTables).GetJobs(filters, activeJobSets, order, 0, 0)
+	if err != nil {
+		return err
+	}
+	logQueryDebug(user, query, "StreamJobs")
+
+	queryStart := time.Now()
+	rows, err := r.db.Query(ctx, query.Sql, query.Args...)
+	if err != nil {
+		logQueryError(user, query, "StreamJobs", time.Since(queryStart))
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		job, err := r.scanJob(rows)
+		if err != nil {
+			return err
 		}
-		if runs.Valid {
-			if err := json.Unmarshal([]byte(runs.String), &job.Runs); err != nil {
-				return nil, err
-			}
+		if err := fn(job); err != nil {
+			return err
 		}
-		if len(job.Runs) > 0 {
-			lastRun := job.Runs[len(job.Runs)-1] // Get the last run
-			job.Node = lastRun.Node
-			job.Cluster = lastRun.Cluster
-			job.ExitCode = lastRun.ExitCode
-			job.RuntimeSeconds = calculateJobRuntime(lastRun.Started, lastRun.Finished, r.clock)
+	}
+	logSlowQuery(user, query, "StreamJobs", time.Since(queryStart))
+	return rows.Err()
+}
+
+func (r *SqlGetJobsRepository) scanJob(rows pgx.Rows) (*model.Job, error) {
+	var row jobRow
+	var annotations sql.NullString
+	var runs sql.NullString
+	if err := rows.Scan(
+		&row.jobId,
+		&row.queue,
+		&row.owner,
+		&row.namespace,
+		&row.jobSet,
+		&row.cpu,
+		&row.memory,
+		&row.ephemeralStorage,
+		&row.gpu,
+		&row.priority,
+		&row.submitted,
+		&row.cancelled,
+		&row.state,
+		&row.lastTransitionTime,
+		&row.duplicate,
+		&row.priorityClass,
+		&row.latestRunId,
+		&row.cancelReason,
+		&row.cancelUser,
+		&annotations,
+		&runs,
+	); err != nil {
+		return nil, err
+	}
+	job := jobRowToModel(&row)
+	if annotations.Valid {
+		if err := json.Unmarshal([]byte(annotations.String), &job.Annotations); err != nil {
+			return nil, err
 		}
-		jobs = append(jobs, job)
 	}
-	return &GetJobsResult{Jobs: jobs}, nil
+	if runs.Valid {
+		if err := json.Unmarshal([]byte(runs.String), &job.Runs); err != nil {
+			return nil, err
+		}
+	}
+	if len(job.Runs) > 0 {
+		lastRun := job.Runs[len(job.Runs)-1] // Get the last run
+		job.Node = lastRun.Node
+		job.Cluster = lastRun.Cluster
+		job.ExitCode = lastRun.ExitCode
+		job.RuntimeSeconds = calculateJobRuntime(lastRun.Started, lastRun.Finished, r.clock)
+	}
+	return job, nil
 }
 
 func calculateJobRuntime(started, finished *model.PostgreSQLTime, clock clock.Clock) int32 {
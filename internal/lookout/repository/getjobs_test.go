@@ -11,6 +11,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -1575,6 +1576,151 @@ func TestGetJobsByGpu(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetJobsByResource(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		job1 := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{}).
+			Build().
+			Job()
+
+		job2 := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+				Resources: map[v1.ResourceName]resource.Quantity{"amd.com/gpu": resource.MustParse("2")},
+			}).
+			Build().
+			Job()
+
+		job3 := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+				Resources: map[v1.ResourceName]resource.Quantity{"amd.com/gpu": resource.MustParse("4")},
+			}).
+			Build().
+			Job()
+
+		t.Run("exact", func(t *testing.T) {
+			result, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchExact,
+					Value:      2,
+					IsResource: true,
+				}},
+				false,
+				&model.Order{},
+				0,
+				10,
+			)
+			require.NoError(t, err)
+			require.Len(t, result.Jobs, 1)
+			assert.Equal(t, job2, result.Jobs[0])
+		})
+
+		t.Run("jobs without the resource request zero", func(t *testing.T) {
+			result, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchLessThan,
+					Value:      1,
+					IsResource: true,
+				}},
+				false,
+				&model.Order{},
+				0,
+				10,
+			)
+			require.NoError(t, err)
+			require.Len(t, result.Jobs, 1)
+			assert.Equal(t, job1, result.Jobs[0])
+		})
+
+		t.Run("greaterThanOrEqualTo and order by resource", func(t *testing.T) {
+			result, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchGreaterThanOrEqualTo,
+					Value:      2,
+					IsResource: true,
+				}},
+				false,
+				&model.Order{
+					Field:      "amd.com/gpu",
+					Direction:  model.DirectionDesc,
+					IsResource: true,
+				},
+				0,
+				10,
+			)
+			require.NoError(t, err)
+			require.Len(t, result.Jobs, 2)
+			assert.Equal(t, job3, result.Jobs[0])
+			assert.Equal(t, job2, result.Jobs[1])
+		})
+
+		t.Run("lessThanOrEqualTo including jobs without the resource request", func(t *testing.T) {
+			result, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchLessThanOrEqualTo,
+					Value:      "2",
+					IsResource: true,
+				}},
+				false,
+				&model.Order{
+					Field:     "jobId",
+					Direction: model.DirectionAsc,
+				},
+				0,
+				10,
+			)
+			require.NoError(t, err)
+			require.Len(t, result.Jobs, 2)
+			assert.Equal(t, job1, result.Jobs[0])
+			assert.Equal(t, job2, result.Jobs[1])
+		})
+
+		t.Run("non-numeric value", func(t *testing.T) {
+			_, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchExact,
+					Value:      "two",
+					IsResource: true,
+				}},
+				false,
+				&model.Order{},
+				0,
+				10,
+			)
+			assert.Error(t, err)
+		})
+
+		t.Run("unsupported match", func(t *testing.T) {
+			_, err := repo.GetJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field:      "amd.com/gpu",
+					Match:      model.MatchStartsWith,
+					Value:      "2",
+					IsResource: true,
+				}},
+				false,
+				&model.Order{},
+				0,
+				10,
+			)
+			assert.Error(t, err)
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestGetJobsByPriority(t *testing.T) {
 	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
 		job1 := NewJobSimulatorWithClock(converter, store, testClock).
@@ -2167,3 +2313,61 @@ func TestJobRuntimeWhenRunFinishedWithClock(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestStreamJobs(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		var jobs []*model.Job
+		for i := 0; i < 5; i++ {
+			job := NewJobSimulatorWithClock(converter, store, testClock).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+					Priority: i,
+				}).
+				Build().
+				Job()
+			jobs = append(jobs, job)
+		}
+
+		t.Run("every matching job in order", func(t *testing.T) {
+			var streamed []*model.Job
+			err := repo.StreamJobs(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field: "priority",
+					Match: model.MatchGreaterThan,
+					Value: 0,
+				}},
+				false,
+				&model.Order{
+					Field:     "jobId",
+					Direction: model.DirectionDesc,
+				},
+				func(job *model.Job) error {
+					streamed = append(streamed, job)
+					return nil
+				},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []*model.Job{jobs[4], jobs[3], jobs[2], jobs[1]}, streamed)
+		})
+
+		t.Run("error from fn stops the stream", func(t *testing.T) {
+			count := 0
+			err := repo.StreamJobs(armadacontext.TODO(), nil, false, &model.Order{}, func(job *model.Job) error {
+				count++
+				return fmt.Errorf("client went away")
+			})
+			assert.ErrorContains(t, err, "client went away")
+			assert.Equal(t, 1, count)
+		})
+
+		t.Run("invalid order", func(t *testing.T) {
+			err := repo.StreamJobs(armadacontext.TODO(), nil, false, &model.Order{Field: "doesNotExist", Direction: model.DirectionAsc}, func(job *model.Job) error {
+				return nil
+			})
+			assert.Error(t, err)
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+}
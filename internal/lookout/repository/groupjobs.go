@@ -26,6 +26,7 @@ type GroupJobsRepository interface {
 		order *model.Order,
 		groupedField string,
 		aggregates []string,
+		resourceAggregates []*model.ResourceAggregate,
 		skip int,
 		take int,
 	) (*GroupByResult, error)
@@ -36,7 +37,11 @@ type SqlGroupJobsRepository struct {
 	lookoutTables *LookoutTables
 }
 
-const stateAggregatePrefix = "state_"
+const (
+	stateAggregatePrefix = "state_"
+	// Key under which resource aggregates are returned, as a map from resource name to aggregate to value
+	resourcesAggregate = "resources"
+)
 
 func NewSqlGroupJobsRepository(db *pgxpool.Pool) *SqlGroupJobsRepository {
 	return &SqlGroupJobsRepository{
@@ -52,12 +57,13 @@ func (r *SqlGroupJobsRepository) GroupBy(
 	order *model.Order,
 	groupedField *model.GroupedField,
 	aggregates []string,
+	resourceAggregates []*model.ResourceAggregate,
 	skip int,
 	take int,
 ) (*GroupByResult, error) {
 	user := auth.GetPrincipal(ctx).GetName()
 
-	query, err := NewQueryBuilder(r.lookoutTables).GroupBy(filters, activeJobSets, order, groupedField, aggregates, skip, take)
+	query, err := NewQueryBuilder(r.lookoutTables).GroupBy(filters, activeJobSets, order, groupedField, aggregates, resourceAggregates, skip, take)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +80,7 @@ func (r *SqlGroupJobsRepository) GroupBy(
 	}
 	logSlowQuery(user, query, "GroupBy", queryDuration)
 
-	groups, err = rowsToGroups(groupRows, groupedField, aggregates, filters)
+	groups, err = rowsToGroups(groupRows, groupedField, aggregates, resourceAggregates, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -84,10 +90,16 @@ func (r *SqlGroupJobsRepository) GroupBy(
 	}, nil
 }
 
-func rowsToGroups(rows pgx.Rows, groupedField *model.GroupedField, aggregates []string, filters []*model.Filter) ([]*model.JobGroup, error) {
+func rowsToGroups(
+	rows pgx.Rows,
+	groupedField *model.GroupedField,
+	aggregates []string,
+	resourceAggregates []*model.ResourceAggregate,
+	filters []*model.Filter,
+) ([]*model.JobGroup, error) {
 	var groups []*model.JobGroup
 	for rows.Next() {
-		jobGroup, err := scanGroup(rows, groupedField.Field, aggregates, filters)
+		jobGroup, err := scanGroup(rows, groupedField.Field, aggregates, resourceAggregates, filters)
 		if err != nil {
 			return nil, err
 		}
@@ -96,7 +108,13 @@ func rowsToGroups(rows pgx.Rows, groupedField *model.GroupedField, aggregates []
 	return groups, nil
 }
 
-func scanGroup(rows pgx.Rows, field string, aggregates []string, filters []*model.Filter) (*model.JobGroup, error) {
+func scanGroup(
+	rows pgx.Rows,
+	field string,
+	aggregates []string,
+	resourceAggregates []*model.ResourceAggregate,
+	filters []*model.Filter,
+) (*model.JobGroup, error) {
 	groupParser := ParserForGroup(field)
 	var count int64
 	var aggregateParsers []FieldParser
@@ -111,7 +129,12 @@ func scanGroup(rows pgx.Rows, field string, aggregates []string, filters []*mode
 	for i, parser := range aggregateParsers {
 		aggregateRefs[i] = parser.GetVariableRef()
 	}
-	varAddresses := slices.Concatenate([]interface{}{groupParser.GetVariableRef(), &count}, aggregateRefs)
+	resourceAggregateValues := make([]float64, len(resourceAggregates))
+	resourceAggregateRefs := make([]interface{}, len(resourceAggregates))
+	for i := range resourceAggregates {
+		resourceAggregateRefs[i] = &resourceAggregateValues[i]
+	}
+	varAddresses := slices.Concatenate([]interface{}{groupParser.GetVariableRef(), &count}, aggregateRefs, resourceAggregateRefs)
 	err := rows.Scan(varAddresses...)
 	if err != nil {
 		return nil, err
@@ -146,6 +169,16 @@ func scanGroup(rows pgx.Rows, field string, aggregates []string, filters []*mode
 			aggregatesMap[parser.GetField()] = val
 		}
 	}
+	if len(resourceAggregates) > 0 {
+		resourceAggregatesMap := make(map[string]map[string]float64)
+		for i, resourceAggregate := range resourceAggregates {
+			if _, ok := resourceAggregatesMap[resourceAggregate.Resource]; !ok {
+				resourceAggregatesMap[resourceAggregate.Resource] = make(map[string]float64)
+			}
+			resourceAggregatesMap[resourceAggregate.Resource][resourceAggregate.Aggregate] = resourceAggregateValues[i]
+		}
+		aggregatesMap[resourcesAggregate] = resourceAggregatesMap
+	}
 	return &model.JobGroup{
 		Name:       fmt.Sprintf("%s", parsedGroup),
 		Count:      count,
@@ -9,6 +9,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
@@ -56,6 +58,7 @@ func TestGroupByQueue(t *testing.T) {
 				Field: "queue",
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
@@ -110,6 +113,7 @@ func TestGroupByJobSet(t *testing.T) {
 				Field: "jobSet",
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
@@ -172,6 +176,7 @@ func TestGroupByState(t *testing.T) {
 				Field: "state",
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
@@ -355,6 +360,7 @@ func TestGroupByWithFilters(t *testing.T) {
 				Field: "state",
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
@@ -449,6 +455,7 @@ func TestGroupJobsWithMaxSubmittedTime(t *testing.T) {
 				Field: "jobSet",
 			},
 			[]string{"submitted"},
+			nil,
 			0,
 			10,
 		)
@@ -544,6 +551,7 @@ func TestGroupJobsWithAvgLastTransitionTime(t *testing.T) {
 				Field: "queue",
 			},
 			[]string{"lastTransitionTime"},
+			nil,
 			0,
 			10,
 		)
@@ -577,6 +585,176 @@ func TestGroupJobsWithAvgLastTransitionTime(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGroupJobsWithEfficiency(t *testing.T) {
+	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
+		runningJobWithUsage := func(queue string, cpuRequest, memoryRequest, avgCpu, avgMemory string) {
+			runId := uuid.NewString()
+			NewJobSimulator(converter, store).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+					Cpu:    resource.MustParse(cpuRequest),
+					Memory: resource.MustParse(memoryRequest),
+				}).
+				Lease(runId, cluster, node, baseTime).
+				Pending(runId, cluster, baseTime).
+				Running(runId, node, baseTime).
+				ResourceUtilisation(
+					runId,
+					map[string]resource.Quantity{"cpu": resource.MustParse(cpuRequest), "memory": resource.MustParse(memoryRequest)},
+					map[string]resource.Quantity{"cpu": resource.MustParse(avgCpu), "memory": resource.MustParse(avgMemory)},
+					baseTime,
+				).
+				Build()
+		}
+
+		runningJobWithUsage("queue-1", "2", "4Gi", "1", "1Gi")
+		runningJobWithUsage("queue-1", "2", "4Gi", "500m", "2Gi")
+		runningJobWithUsage("queue-2", "1", "1Gi", "1", "1Gi")
+		// Jobs without any reported usage don't contribute to efficiency
+		manyJobs(3, &createJobsOpts{
+			queue:  "queue-2",
+			jobSet: jobSet,
+			state:  lookout.JobRunning,
+		}, converter, store)
+		manyJobs(2, &createJobsOpts{
+			queue:  "queue-3",
+			jobSet: jobSet,
+			state:  lookout.JobQueued,
+		}, converter, store)
+
+		result, err := repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:     "cpuEfficiency",
+				Direction: "ASC",
+			},
+			&model.GroupedField{
+				Field: "queue",
+			},
+			[]string{"cpuEfficiency", "memoryEfficiency"},
+			nil,
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 3)
+		assert.Equal(t, []*model.JobGroup{
+			{
+				Name:  "queue-1",
+				Count: 2,
+				Aggregates: map[string]interface{}{
+					"cpuEfficiency":    0.375,
+					"memoryEfficiency": 0.375,
+				},
+			},
+			{
+				Name:  "queue-2",
+				Count: 4,
+				Aggregates: map[string]interface{}{
+					"cpuEfficiency":    1.0,
+					"memoryEfficiency": 1.0,
+				},
+			},
+			{
+				Name:  "queue-3",
+				Count: 2,
+				Aggregates: map[string]interface{}{
+					"cpuEfficiency":    nil,
+					"memoryEfficiency": nil,
+				},
+			},
+		}, result.Groups)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupJobsWithResourceAggregates(t *testing.T) {
+	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
+		jobWithGpus := func(queue string, gpus string) {
+			NewJobSimulator(converter, store).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{
+					Resources: map[v1.ResourceName]resource.Quantity{"amd.com/gpu": resource.MustParse(gpus)},
+				}).
+				Build()
+		}
+
+		jobWithGpus("queue-1", "1")
+		jobWithGpus("queue-1", "2")
+		jobWithGpus("queue-2", "8")
+		// Jobs not requesting the resource count as requesting zero of it
+		manyJobs(3, &createJobsOpts{
+			queue:  "queue-2",
+			jobSet: jobSet,
+			state:  lookout.JobQueued,
+		}, converter, store)
+		manyJobs(2, &createJobsOpts{
+			queue:  "queue-3",
+			jobSet: jobSet,
+			state:  lookout.JobQueued,
+		}, converter, store)
+
+		result, err := repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:      "amd.com/gpu",
+				Direction:  "DESC",
+				IsResource: true,
+			},
+			&model.GroupedField{
+				Field: "queue",
+			},
+			[]string{},
+			[]*model.ResourceAggregate{
+				{Resource: "amd.com/gpu", Aggregate: model.AggregateAverage},
+				{Resource: "amd.com/gpu", Aggregate: model.AggregateSum},
+				{Resource: "memory", Aggregate: model.AggregateSum},
+			},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 3)
+		assert.Equal(t, []*model.JobGroup{
+			{
+				Name:  "queue-2",
+				Count: 4,
+				Aggregates: map[string]interface{}{
+					"resources": map[string]map[string]float64{
+						"amd.com/gpu": {"avg": 2, "sum": 8},
+						"memory":      {"sum": 4 * 64 * 1024 * 1024},
+					},
+				},
+			},
+			{
+				Name:  "queue-1",
+				Count: 2,
+				Aggregates: map[string]interface{}{
+					"resources": map[string]map[string]float64{
+						"amd.com/gpu": {"avg": 1.5, "sum": 3},
+						"memory":      {"sum": 2 * 64 * 1024 * 1024},
+					},
+				},
+			},
+			{
+				Name:  "queue-3",
+				Count: 2,
+				Aggregates: map[string]interface{}{
+					"resources": map[string]map[string]float64{
+						"amd.com/gpu": {"avg": 0, "sum": 0},
+						"memory":      {"sum": 2 * 64 * 1024 * 1024},
+					},
+				},
+			},
+		}, result.Groups)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestGroupJobsWithAllStateCounts(t *testing.T) {
 	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
 		manyJobs(5, &createJobsOpts{
@@ -639,6 +817,7 @@ func TestGroupJobsWithAllStateCounts(t *testing.T) {
 				Field: "jobSet",
 			},
 			[]string{"state"},
+			nil,
 			0,
 			10,
 		)
@@ -769,6 +948,7 @@ func TestGroupJobsWithFilteredStateCounts(t *testing.T) {
 				Field: "jobSet",
 			},
 			[]string{"state"},
+			nil,
 			0,
 			10,
 		)
@@ -908,6 +1088,7 @@ func TestGroupJobsComplex(t *testing.T) {
 				"submitted",
 				"lastTransitionTime",
 			},
+			nil,
 			0,
 			10,
 		)
@@ -980,6 +1161,7 @@ func TestGroupByAnnotation(t *testing.T) {
 				IsAnnotation: true,
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
@@ -1105,6 +1287,7 @@ func TestGroupByAnnotationWithFiltersAndAggregates(t *testing.T) {
 				"submitted",
 				"lastTransitionTime",
 			},
+			nil,
 			0,
 			10,
 		)
@@ -1183,6 +1366,7 @@ func TestGroupJobsSkip(t *testing.T) {
 					Field: "queue",
 				},
 				[]string{},
+				nil,
 				skip,
 				take,
 			)
@@ -1212,6 +1396,7 @@ func TestGroupJobsSkip(t *testing.T) {
 					Field: "queue",
 				},
 				[]string{},
+				nil,
 				skip,
 				take,
 			)
@@ -1241,6 +1426,7 @@ func TestGroupJobsSkip(t *testing.T) {
 					Field: "queue",
 				},
 				[]string{},
+				nil,
 				skip,
 				take,
 			)
@@ -1272,6 +1458,7 @@ func TestGroupJobsValidation(t *testing.T) {
 					Field: "queue",
 				},
 				[]string{},
+				nil,
 				0,
 				100,
 			)
@@ -1291,6 +1478,92 @@ func TestGroupJobsValidation(t *testing.T) {
 					Field: "owner",
 				},
 				[]string{},
+				nil,
+				0,
+				100,
+			)
+			assert.Error(t, err)
+		})
+
+		t.Run("order by resource without resource aggregate", func(t *testing.T) {
+			_, err := repo.GroupBy(
+				armadacontext.TODO(),
+				[]*model.Filter{},
+				false,
+				&model.Order{
+					Field:      "amd.com/gpu",
+					Direction:  "DESC",
+					IsResource: true,
+				},
+				&model.GroupedField{
+					Field: "queue",
+				},
+				[]string{},
+				[]*model.ResourceAggregate{{Resource: "cpu", Aggregate: model.AggregateSum}},
+				0,
+				100,
+			)
+			assert.Error(t, err)
+		})
+
+		t.Run("unsupported resource aggregate", func(t *testing.T) {
+			_, err := repo.GroupBy(
+				armadacontext.TODO(),
+				[]*model.Filter{},
+				false,
+				&model.Order{
+					Field:     "count",
+					Direction: "ASC",
+				},
+				&model.GroupedField{
+					Field: "queue",
+				},
+				[]string{},
+				[]*model.ResourceAggregate{{Resource: "cpu", Aggregate: "max"}},
+				0,
+				100,
+			)
+			assert.Error(t, err)
+		})
+
+		t.Run("order by efficiency without aggregate", func(t *testing.T) {
+			_, err := repo.GroupBy(
+				armadacontext.TODO(),
+				[]*model.Filter{},
+				false,
+				&model.Order{
+					Field:     "cpuEfficiency",
+					Direction: "DESC",
+				},
+				&model.GroupedField{
+					Field: "queue",
+				},
+				[]string{},
+				nil,
+				0,
+				100,
+			)
+			assert.Error(t, err)
+		})
+
+		t.Run("filter by efficiency", func(t *testing.T) {
+			_, err := repo.GroupBy(
+				armadacontext.TODO(),
+				[]*model.Filter{{
+					Field: "cpuEfficiency",
+					Match: model.MatchGreaterThan,
+					Value: 0.5,
+				}},
+				false,
+				&model.Order{
+					Field:     "count",
+					Direction: "ASC",
+				},
+				&model.GroupedField{
+					Field: "queue",
+				},
+				[]string{"cpuEfficiency"},
+				nil,
 				0,
 				100,
 			)
@@ -1311,6 +1584,7 @@ func TestGroupJobsValidation(t *testing.T) {
 					IsAnnotation: true,
 				},
 				[]string{},
+				nil,
 				0,
 				100,
 			)
@@ -1331,6 +1605,7 @@ func TestGroupJobsValidation(t *testing.T) {
 					IsAnnotation: true,
 				},
 				[]string{},
+				nil,
 				0,
 				100,
 			)
@@ -1389,6 +1664,7 @@ func TestGroupByActiveJobSets(t *testing.T) {
 				Field: "jobSet",
 			},
 			[]string{},
+			nil,
 			0,
 			10,
 		)
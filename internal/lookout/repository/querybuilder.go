@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -128,6 +129,7 @@ CROSS JOIN LATERAL (
 						'runId', run_id,
 						'cluster', cluster,
 						'node', node,
+						'pool', pool,
 						'leased', leased AT TIME ZONE 'UTC',
 						'pending', pending AT TIME ZONE 'UTC',
 						'started', started AT TIME ZONE 'UTC',
@@ -160,6 +162,7 @@ func (qb *QueryBuilder) GroupBy(
 	order *model.Order,
 	groupedField *model.GroupedField,
 	aggregates []string,
+	resourceAggregates []*model.ResourceAggregate,
 	skip int,
 	take int,
 ) (*Query, error) {
@@ -167,7 +170,7 @@ func (qb *QueryBuilder) GroupBy(
 	if err != nil {
 		return nil, errors.Wrap(err, "filters are invalid")
 	}
-	err = qb.validateGroupOrder(order)
+	err = qb.validateGroupOrder(order, aggregates, resourceAggregates)
 	if err != nil {
 		return nil, errors.Wrap(err, "group order is invalid")
 	}
@@ -175,6 +178,10 @@ func (qb *QueryBuilder) GroupBy(
 	if err != nil {
 		return nil, errors.Wrap(err, "aggregates are invalid")
 	}
+	err = validateResourceAggregates(resourceAggregates)
+	if err != nil {
+		return nil, errors.Wrap(err, "resource aggregates are invalid")
+	}
 	err = qb.validateGroupedField(groupedField)
 	if err != nil {
 		return nil, errors.Wrap(err, "group field is invalid")
@@ -200,6 +207,9 @@ func (qb *QueryBuilder) GroupBy(
 	if err != nil {
 		return nil, err
 	}
+	if len(resourceAggregates) > 0 {
+		selectList = fmt.Sprintf("%s, %s", selectList, qb.getResourceAggregatesSql(resourceAggregates))
+	}
 
 	if groupedField.IsAnnotation {
 		// scanGroup expects values in the grouped-by field not to be null. In
@@ -222,7 +232,7 @@ func (qb *QueryBuilder) GroupBy(
 		return nil, err
 	}
 
-	orderBy, err := qb.groupByOrderSql(order)
+	orderBy, err := qb.groupByOrderSql(order, resourceAggregates)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +267,7 @@ func (qb *QueryBuilder) createGroupBySQL(order *model.Order, groupCol *queryColu
 		}
 		return false
 	}(aggregates, order.Field)
-	if orderIsNull(order) || order.Field == countCol || isInAggregators {
+	if orderIsNull(order) || order.Field == countCol || order.IsResource || isInAggregators {
 		return expr, nil
 	}
 	col, err := qb.lookoutTables.ColumnFromField(order.Field)
@@ -322,6 +332,21 @@ func (qb *QueryBuilder) makeWhere(filters []*model.Filter) (string, error) {
 }
 
 func (qb *QueryBuilder) makeWhereClause(filter *model.Filter) (string, error) {
+	if filter.IsLabel {
+		switch filter.Match {
+		case model.MatchExact:
+			placeholder := qb.recordValue(map[string]interface{}{filter.Field: filter.Value})
+			return fmt.Sprintf("%s.labels @> %s", jobTableAbbrev, placeholder), nil
+		case model.MatchExists:
+			placeholder := qb.recordValue(filter.Field)
+			return fmt.Sprintf("%s.labels ? %s", jobTableAbbrev, placeholder), nil
+		default:
+			return "", errors.Errorf("match %s is not supported for label", filter.Match)
+		}
+	}
+	if filter.IsResource {
+		return qb.resourceFilterSql(filter)
+	}
 	var column string
 	if filter.IsAnnotation {
 		switch filter.Match {
@@ -381,10 +406,88 @@ func (qb *QueryBuilder) annotationColumn(key string) string {
 	return fmt.Sprintf("annotations->>%s", placeholder)
 }
 
+// resourceFilterSql returns a condition matching jobs whose request for the filtered resource matches the filter,
+// where jobs that don't request the resource request zero of it. The condition is on the job's row in the resource
+// request table, rather than on the requested amount computed for each job, so that it can use the table's index.
+func (qb *QueryBuilder) resourceFilterSql(filter *model.Filter) (string, error) {
+	operator, err := operatorForMatch(filter.Match)
+	if err != nil {
+		return "", err
+	}
+	value, err := resourceFilterValue(filter.Value)
+	if err != nil {
+		return "", err
+	}
+	requestSql := fmt.Sprintf(
+		"SELECT 1 FROM %s AS rr WHERE rr.job_id = %s.%s AND rr.resource = %s",
+		jobResourceRequestTable, jobTableAbbrev, jobIdCol, qb.recordValue(filter.Field),
+	)
+	valuePlaceholder := qb.recordValue(value)
+	if zeroMatchesResourceFilter(filter.Match, value) {
+		// Jobs that don't request the resource match, so only jobs whose request doesn't match are excluded.
+		return fmt.Sprintf("NOT EXISTS (%s AND NOT (rr.value %s %s))", requestSql, operator, valuePlaceholder), nil
+	}
+	return fmt.Sprintf("EXISTS (%s AND rr.value %s %s)", requestSql, operator, valuePlaceholder), nil
+}
+
+// resourceFilterValue returns the amount of a resource filtered on, which may be given as a number or a string.
+func resourceFilterValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, errors.Errorf("resource filter value %q is not a number", v)
+		}
+		return f, nil
+	default:
+		return 0, errors.Errorf("resource filter value %v is not a number", value)
+	}
+}
+
+func zeroMatchesResourceFilter(match string, value float64) bool {
+	switch match {
+	case model.MatchExact:
+		return value == 0
+	case model.MatchGreaterThan:
+		return 0 > value
+	case model.MatchLessThan:
+		return 0 < value
+	case model.MatchGreaterThanOrEqualTo:
+		return 0 >= value
+	case model.MatchLessThanOrEqualTo:
+		return 0 <= value
+	default:
+		return false
+	}
+}
+
+// resourceRequestSql returns an expression evaluating to the amount of the given resource requested by the job,
+// which is zero if the job doesn't request the resource.
+func (qb *QueryBuilder) resourceRequestSql(resource string) string {
+	placeholder := qb.recordValue(resource)
+	return fmt.Sprintf(
+		"COALESCE((SELECT rr.value FROM %s AS rr WHERE rr.job_id = %s.%s AND rr.resource = %s), 0)",
+		jobResourceRequestTable, jobTableAbbrev, jobIdCol, placeholder,
+	)
+}
+
 func (qb *QueryBuilder) makeOrderBy(order *model.Order) (string, error) {
 	if orderIsNull(order) {
 		return "", nil
 	}
+	if order.IsResource {
+		return fmt.Sprintf("ORDER BY %s %s", qb.resourceRequestSql(order.Field), order.Direction), nil
+	}
 	column, err := qb.lookoutTables.ColumnFromField(order.Field)
 	if err != nil {
 		return "", err
@@ -498,13 +601,46 @@ func (qb *QueryBuilder) getAggregatesSql(aggregators []QueryAggregator) (string,
 	return strings.Join(selectList, ", "), nil
 }
 
-func (qb *QueryBuilder) groupByOrderSql(order *model.Order) (string, error) {
+func (qb *QueryBuilder) getResourceAggregatesSql(resourceAggregates []*model.ResourceAggregate) string {
+	selectList := make([]string, len(resourceAggregates))
+	for i, resourceAggregate := range resourceAggregates {
+		selectList[i] = fmt.Sprintf(
+			"%s(%s) AS %s",
+			strings.ToUpper(resourceAggregate.Aggregate),
+			qb.resourceRequestSql(resourceAggregate.Resource),
+			resourceAggregateColName(i, resourceAggregate),
+		)
+	}
+	return strings.Join(selectList, ", ")
+}
+
+func resourceAggregateColName(i int, resourceAggregate *model.ResourceAggregate) string {
+	return fmt.Sprintf("resource_%d_%s", i, resourceAggregate.Aggregate)
+}
+
+func (qb *QueryBuilder) groupByOrderSql(order *model.Order, resourceAggregates []*model.ResourceAggregate) (string, error) {
 	if orderIsNull(order) {
 		return "", nil
 	}
 	if order.Field == countCol {
 		return fmt.Sprintf("ORDER BY %s %s", countCol, order.Direction), nil
 	}
+	if order.IsResource {
+		// Order by the sum of the resource if it's computed, otherwise by its average
+		col := ""
+		for i, resourceAggregate := range resourceAggregates {
+			if resourceAggregate.Resource != order.Field {
+				continue
+			}
+			if col == "" || resourceAggregate.Aggregate == model.AggregateSum {
+				col = resourceAggregateColName(i, resourceAggregate)
+			}
+		}
+		if col == "" {
+			return "", errors.Errorf("unsupported field for order: %s, must also be included in resource aggregates", order.Field)
+		}
+		return fmt.Sprintf("ORDER BY %s %s", col, order.Direction), nil
+	}
 	col, err := qb.lookoutTables.ColumnFromField(order.Field)
 	if err != nil {
 		return "", err
@@ -535,9 +671,15 @@ func (qb *QueryBuilder) validateFilters(filters []*model.Filter) error {
 }
 
 func (qb *QueryBuilder) validateFilter(filter *model.Filter) error {
+	if filter.IsLabel {
+		return validateLabelFilter(filter)
+	}
 	if filter.IsAnnotation {
 		return validateAnnotationFilter(filter)
 	}
+	if filter.IsResource {
+		return validateResourceFilter(filter)
+	}
 	col, err := qb.lookoutTables.ColumnFromField(filter.Field)
 	if err != nil {
 		return err
@@ -563,10 +705,40 @@ func validateAnnotationFilter(filter *model.Filter) error {
 	return nil
 }
 
+func validateResourceFilter(filter *model.Filter) error {
+	if filter.Field == "" {
+		return errors.New("resource name must not be empty")
+	}
+	if !slices.Contains([]string{
+		model.MatchExact,
+		model.MatchGreaterThan,
+		model.MatchLessThan,
+		model.MatchGreaterThanOrEqualTo,
+		model.MatchLessThanOrEqualTo,
+	}, filter.Match) {
+		return errors.Errorf("match %s is not supported for resource", filter.Match)
+	}
+	_, err := resourceFilterValue(filter.Value)
+	return err
+}
+
+func validateLabelFilter(filter *model.Filter) error {
+	if !slices.Contains([]string{
+		model.MatchExact,
+		model.MatchExists,
+	}, filter.Match) {
+		return errors.Errorf("match %s is not supported for label", filter.Match)
+	}
+	return nil
+}
+
 func (qb *QueryBuilder) validateOrder(order *model.Order) error {
 	if orderIsNull(order) {
 		return nil
 	}
+	if order.IsResource {
+		return validateResourceOrder(order)
+	}
 	col, err := qb.lookoutTables.ColumnFromField(order.Field)
 	if err != nil {
 		return err
@@ -580,6 +752,16 @@ func (qb *QueryBuilder) validateOrder(order *model.Order) error {
 	return nil
 }
 
+func validateResourceOrder(order *model.Order) error {
+	if order.Field == "" {
+		return errors.New("resource name must not be empty")
+	}
+	if !isValidOrderDirection(order.Direction) {
+		return errors.Errorf("direction %s is not a valid sort direction", order.Direction)
+	}
+	return nil
+}
+
 func isValidOrderDirection(direction string) bool {
 	return slices.Contains([]string{model.DirectionAsc, model.DirectionDesc}, direction)
 }
@@ -588,17 +770,33 @@ func orderIsNull(order *model.Order) bool {
 	return order == nil || (order.Direction == "" && order.Field == "")
 }
 
-func (qb *QueryBuilder) validateGroupOrder(order *model.Order) error {
+func (qb *QueryBuilder) validateGroupOrder(order *model.Order, aggregates []string, resourceAggregates []*model.ResourceAggregate) error {
 	if order == nil {
 		return nil
 	}
 	if order.Field == countCol {
 		return nil
 	}
+	if order.IsResource {
+		if err := validateResourceOrder(order); err != nil {
+			return err
+		}
+		// Resource requests are only available to order groups by if they're aggregated
+		if !slices.ContainsFunc(resourceAggregates, func(resourceAggregate *model.ResourceAggregate) bool {
+			return resourceAggregate.Resource == order.Field
+		}) {
+			return errors.Errorf("unsupported field for order: %s, must also be included in resource aggregates", order.Field)
+		}
+		return nil
+	}
 	col, err := qb.lookoutTables.ColumnFromField(order.Field)
 	if err != nil {
 		return errors.Errorf("unsupported field for order: %s", order.Field)
 	}
+	// Efficiency isn't stored in a column of the job table, so can only be ordered by if it's computed
+	if _, isEfficiency := efficiencyColumns[col]; isEfficiency && !slices.Contains(aggregates, order.Field) {
+		return errors.Errorf("unsupported field for order: %s, must also be included in aggregates", order.Field)
+	}
 
 	_, err = qb.lookoutTables.GroupAggregateForCol(col)
 	// If it is not an aggregate and not groupable, it can't be ordered by
@@ -644,3 +842,15 @@ func (qb *QueryBuilder) validateAggregates(aggregates []string) error {
 	}
 	return nil
 }
+
+func validateResourceAggregates(resourceAggregates []*model.ResourceAggregate) error {
+	for _, resourceAggregate := range resourceAggregates {
+		if resourceAggregate.Resource == "" {
+			return errors.New("resource name must not be empty")
+		}
+		if !slices.Contains([]string{model.AggregateSum, model.AggregateAverage}, resourceAggregate.Aggregate) {
+			return errors.Errorf("unsupported aggregate %s for resource %s", resourceAggregate.Aggregate, resourceAggregate.Resource)
+		}
+	}
+	return nil
+}
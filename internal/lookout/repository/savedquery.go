@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+var (
+	// ErrSavedQueryNotFound is returned, wrapped, if there's no saved query with the given name.
+	ErrSavedQueryNotFound = errors.New("saved query not found")
+	// ErrSavedQueryNotOwned is returned, wrapped, on attempts to replace or delete a saved query created by another user.
+	ErrSavedQueryNotOwned = errors.New("saved query was created by another user")
+)
+
+type SavedQueryRepository interface {
+	ListSavedQueries(ctx *armadacontext.Context) ([]*model.SavedQuery, error)
+	GetSavedQuery(ctx *armadacontext.Context, name string) (*model.SavedQuery, error)
+	PutSavedQuery(ctx *armadacontext.Context, savedQuery *model.SavedQuery) (*model.SavedQuery, error)
+	DeleteSavedQuery(ctx *armadacontext.Context, name string) error
+}
+
+type SqlSavedQueryRepository struct {
+	db            *pgxpool.Pool
+	lookoutTables *LookoutTables
+	clock         clock.Clock
+}
+
+// savedQueryDefinition is the part of a saved query stored in the definition column.
+type savedQueryDefinition struct {
+	Filters            []*model.Filter
+	Order              *model.Order
+	ActiveJobSets      bool
+	GroupedField       *model.GroupedField
+	Aggregates         []string
+	ResourceAggregates []*model.ResourceAggregate
+}
+
+func NewSqlSavedQueryRepository(db *pgxpool.Pool) *SqlSavedQueryRepository {
+	return &SqlSavedQueryRepository{
+		db:            db,
+		lookoutTables: NewTables(),
+		clock:         clock.RealClock{},
+	}
+}
+
+func (r *SqlSavedQueryRepository) ListSavedQueries(ctx *armadacontext.Context) ([]*model.SavedQuery, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT name, description, definition, created_by, created, updated
+		FROM saved_query
+		ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	savedQueries := []*model.SavedQuery{}
+	for rows.Next() {
+		savedQuery, err := scanSavedQuery(rows)
+		if err != nil {
+			return nil, err
+		}
+		savedQueries = append(savedQueries, savedQuery)
+	}
+	return savedQueries, rows.Err()
+}
+
+func (r *SqlSavedQueryRepository) GetSavedQuery(ctx *armadacontext.Context, name string) (*model.SavedQuery, error) {
+	row := r.db.QueryRow(ctx, `
+		SELECT name, description, definition, created_by, created, updated
+		FROM saved_query
+		WHERE name = $1`, name)
+	savedQuery, err := scanSavedQuery(row)
+	if err == pgx.ErrNoRows {
+		return nil, errors.Wrapf(ErrSavedQueryNotFound, "%s", name)
+	}
+	return savedQuery, err
+}
+
+// PutSavedQuery saves savedQuery, replacing any query the caller has already saved under its name, and returns it as saved.
+// The query is rejected if it couldn't be run; e.g., if it filters on a field that doesn't exist,
+// or if another user has saved a query under the same name.
+func (r *SqlSavedQueryRepository) PutSavedQuery(ctx *armadacontext.Context, savedQuery *model.SavedQuery) (*model.SavedQuery, error) {
+	if savedQuery.Name == "" {
+		return nil, errors.New("saved query name must not be empty")
+	}
+	if err := r.validate(savedQuery); err != nil {
+		return nil, err
+	}
+	definition, err := json.Marshal(savedQueryDefinition{
+		Filters:            savedQuery.Filters,
+		Order:              savedQuery.Order,
+		ActiveJobSets:      savedQuery.ActiveJobSets,
+		GroupedField:       savedQuery.GroupedField,
+		Aggregates:         savedQuery.Aggregates,
+		ResourceAggregates: savedQuery.ResourceAggregates,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	now := r.clock.Now().UTC()
+	// Queries saved by other users aren't updated, in which case no row is returned.
+	row := r.db.QueryRow(ctx, `
+		INSERT INTO saved_query (name, description, definition, created_by, created, updated)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (name) DO UPDATE SET
+			description = EXCLUDED.description,
+			definition = EXCLUDED.definition,
+			updated = EXCLUDED.updated
+		WHERE saved_query.created_by = EXCLUDED.created_by
+		RETURNING name, description, definition, created_by, created, updated`,
+		savedQuery.Name, savedQuery.Description, definition, auth.GetPrincipal(ctx).GetName(), now)
+	saved, err := scanSavedQuery(row)
+	if err == pgx.ErrNoRows {
+		return nil, errors.Wrapf(ErrSavedQueryNotOwned, "%s", savedQuery.Name)
+	}
+	return saved, err
+}
+
+// DeleteSavedQuery deletes the query saved under name, which must have been saved by the caller.
+func (r *SqlSavedQueryRepository) DeleteSavedQuery(ctx *armadacontext.Context, name string) error {
+	result, err := r.db.Exec(ctx,
+		`DELETE FROM saved_query WHERE name = $1 AND created_by = $2`,
+		name, auth.GetPrincipal(ctx).GetName())
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+	var exists bool
+	if err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM saved_query WHERE name = $1)`, name).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return errors.Wrapf(ErrSavedQueryNotOwned, "%s", name)
+	}
+	return errors.Wrapf(ErrSavedQueryNotFound, "%s", name)
+}
+
+// validate returns an error if the jobs or groups selected by savedQuery couldn't be queried.
+func (r *SqlSavedQueryRepository) validate(savedQuery *model.SavedQuery) error {
+	if savedQuery.GroupedField == nil {
+		_, err := NewQueryBuilder(r.lookoutTables).GetJobs(savedQuery.Filters, savedQuery.ActiveJobSets, savedQuery.Order, 0, 0)
+		return err
+	}
+	_, err := NewQueryBuilder(r.lookoutTables).GroupBy(
+		savedQuery.Filters,
+		savedQuery.ActiveJobSets,
+		savedQuery.Order,
+		savedQuery.GroupedField,
+		savedQuery.Aggregates,
+		savedQuery.ResourceAggregates,
+		0,
+		0,
+	)
+	return err
+}
+
+func scanSavedQuery(row pgx.Row) (*model.SavedQuery, error) {
+	var savedQuery model.SavedQuery
+	var rawDefinition []byte
+	var created, updated time.Time
+	if err := row.Scan(&savedQuery.Name, &savedQuery.Description, &rawDefinition, &savedQuery.CreatedBy, &created, &updated); err != nil {
+		return nil, err
+	}
+	var definition savedQueryDefinition
+	if err := json.Unmarshal(rawDefinition, &definition); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	savedQuery.Filters = definition.Filters
+	savedQuery.Order = definition.Order
+	savedQuery.ActiveJobSets = definition.ActiveJobSets
+	savedQuery.GroupedField = definition.GroupedField
+	savedQuery.Aggregates = definition.Aggregates
+	savedQuery.ResourceAggregates = definition.ResourceAggregates
+	savedQuery.Created = created.UTC()
+	savedQuery.Updated = updated.UTC()
+	return &savedQuery, nil
+}
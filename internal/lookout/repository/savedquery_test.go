@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+func withSavedQuerySetup(f func(*SqlSavedQueryRepository, *clock.FakeClock) error) error {
+	testClock := clock.NewFakeClock(baseTime)
+	return lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlSavedQueryRepository(db)
+		repo.clock = testClock
+		return f(repo, testClock)
+	})
+}
+
+func TestSavedQueries(t *testing.T) {
+	err := withSavedQuerySetup(func(repo *SqlSavedQueryRepository, testClock *clock.FakeClock) error {
+		ctx := armadacontext.TODO()
+		failedJobs := &model.SavedQuery{
+			Name:        "failed-jobs",
+			Description: "Failed jobs of queue-1",
+			Filters: []*model.Filter{
+				{Field: "queue", Match: model.MatchExact, Value: queue},
+				{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"FAILED"}},
+			},
+			Order: &model.Order{Field: "submitted", Direction: model.DirectionDesc},
+		}
+		gpuByQueue := &model.SavedQuery{
+			Name:               "gpu-by-queue",
+			Filters:            []*model.Filter{},
+			Order:              &model.Order{Field: "count", Direction: model.DirectionDesc},
+			ActiveJobSets:      true,
+			GroupedField:       &model.GroupedField{Field: "queue"},
+			Aggregates:         []string{"submitted"},
+			ResourceAggregates: []*model.ResourceAggregate{{Resource: "nvidia.com/gpu", Aggregate: model.AggregateSum}},
+		}
+
+		saved, err := repo.PutSavedQuery(ctx, failedJobs)
+		require.NoError(t, err)
+		expectedFailedJobs := *failedJobs
+		expectedFailedJobs.CreatedBy = "anonymous"
+		expectedFailedJobs.Created = baseTime
+		expectedFailedJobs.Updated = baseTime
+		assert.Equal(t, &expectedFailedJobs, saved)
+
+		_, err = repo.PutSavedQuery(ctx, gpuByQueue)
+		require.NoError(t, err)
+
+		fetched, err := repo.GetSavedQuery(ctx, "failed-jobs")
+		require.NoError(t, err)
+		assert.Equal(t, &expectedFailedJobs, fetched)
+
+		// Replacing a saved query keeps its creator and creation time.
+		testClock.Step(time.Hour)
+		updatedFailedJobs := *failedJobs
+		updatedFailedJobs.Description = "Failed jobs of queue-1, oldest first"
+		updatedFailedJobs.Order = &model.Order{Field: "submitted", Direction: model.DirectionAsc}
+		saved, err = repo.PutSavedQuery(ctx, &updatedFailedJobs)
+		require.NoError(t, err)
+		assert.Equal(t, "Failed jobs of queue-1, oldest first", saved.Description)
+		assert.Equal(t, model.DirectionAsc, saved.Order.Direction)
+		assert.Equal(t, baseTime, saved.Created)
+		assert.Equal(t, baseTime.Add(time.Hour), saved.Updated)
+
+		all, err := repo.ListSavedQueries(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "failed-jobs", all[0].Name)
+		assert.Equal(t, "gpu-by-queue", all[1].Name)
+		assert.Equal(t, gpuByQueue.GroupedField, all[1].GroupedField)
+		assert.Equal(t, gpuByQueue.ResourceAggregates, all[1].ResourceAggregates)
+
+		require.NoError(t, repo.DeleteSavedQuery(ctx, "failed-jobs"))
+		_, err = repo.GetSavedQuery(ctx, "failed-jobs")
+		assert.True(t, errors.Is(err, ErrSavedQueryNotFound))
+		err = repo.DeleteSavedQuery(ctx, "failed-jobs")
+		assert.True(t, errors.Is(err, ErrSavedQueryNotFound))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestSavedQueries_OnlyCreatorCanModify(t *testing.T) {
+	err := withSavedQuerySetup(func(repo *SqlSavedQueryRepository, _ *clock.FakeClock) error {
+		aliceCtx := armadacontext.FromGrpcCtx(auth.WithPrincipal(armadacontext.TODO(), auth.NewStaticPrincipal("alice", "test", nil)))
+		bobCtx := armadacontext.FromGrpcCtx(auth.WithPrincipal(armadacontext.TODO(), auth.NewStaticPrincipal("bob", "test", nil)))
+		savedQuery := &model.SavedQuery{
+			Name:    "failed-jobs",
+			Filters: []*model.Filter{{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"FAILED"}}},
+			Order:   &model.Order{Field: "submitted", Direction: model.DirectionDesc},
+		}
+		_, err := repo.PutSavedQuery(aliceCtx, savedQuery)
+		require.NoError(t, err)
+
+		replacement := *savedQuery
+		replacement.Description = "Replaced"
+		_, err = repo.PutSavedQuery(bobCtx, &replacement)
+		assert.True(t, errors.Is(err, ErrSavedQueryNotOwned))
+		err = repo.DeleteSavedQuery(bobCtx, "failed-jobs")
+		assert.True(t, errors.Is(err, ErrSavedQueryNotOwned))
+
+		fetched, err := repo.GetSavedQuery(bobCtx, "failed-jobs")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", fetched.CreatedBy)
+		assert.Empty(t, fetched.Description)
+
+		_, err = repo.PutSavedQuery(aliceCtx, &replacement)
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteSavedQuery(aliceCtx, "failed-jobs"))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPutSavedQuery_Invalid(t *testing.T) {
+	err := withSavedQuerySetup(func(repo *SqlSavedQueryRepository, _ *clock.FakeClock) error {
+		ctx := armadacontext.TODO()
+		tests := map[string]*model.SavedQuery{
+			"empty name": {
+				Order: &model.Order{},
+			},
+			"unknown filter field": {
+				Name:    "unknown-field",
+				Filters: []*model.Filter{{Field: "doesNotExist", Match: model.MatchExact, Value: "x"}},
+				Order:   &model.Order{},
+			},
+			"group by ungroupable field": {
+				Name:         "ungroupable-field",
+				Order:        &model.Order{Field: "count", Direction: model.DirectionDesc},
+				GroupedField: &model.GroupedField{Field: "jobId"},
+			},
+		}
+		for name, savedQuery := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := repo.PutSavedQuery(ctx, savedQuery)
+				assert.Error(t, err)
+			})
+		}
+		all, err := repo.ListSavedQueries(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+		return nil
+	})
+	require.NoError(t, err)
+}
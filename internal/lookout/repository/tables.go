@@ -11,10 +11,15 @@ const (
 	stateField              = "state"
 	submittedField          = "submitted"
 	lastTransitionTimeField = "lastTransitionTime"
+	cpuEfficiencyField      = "cpuEfficiency"
+	memoryEfficiencyField   = "memoryEfficiency"
 
 	jobTable    = "job"
 	jobRunTable = "job_run"
 
+	jobRunResourceUtilisationTable = "job_run_resource_utilisation"
+	jobResourceRequestTable        = "job_resource_request"
+
 	jobTableAbbrev    = "j"
 	jobRunTableAbbrev = "jr"
 
@@ -32,6 +37,9 @@ const (
 	submittedCol          = "submitted"
 	lastTransitionTimeCol = "last_transition_time_seconds"
 	priorityClassCol      = "priority_class"
+	latestRunIdCol        = "latest_run_id"
+	cpuEfficiencyCol      = "cpu_efficiency"
+	memoryEfficiencyCol   = "memory_efficiency"
 )
 
 type AggregateType int
@@ -42,8 +50,27 @@ const (
 	Average                   = 1
 	StateCounts               = 2
 	Min                       = 3
+	Efficiency                = 4
 )
 
+// resourceEfficiency describes how to compare the usage of a resource recorded in the
+// job_run_resource_utilisation table with the amount of that resource requested by a job.
+type resourceEfficiency struct {
+	// Name of the resource in the job_run_resource_utilisation table
+	resource string
+	// Column of the job table holding the amount of the resource requested
+	requestCol string
+	// Factor by which usage is multiplied to be in the same unit as the request
+	usageScale float64
+}
+
+// efficiencyColumns maps each efficiency aggregate column to the resource it's computed for.
+var efficiencyColumns = map[string]resourceEfficiency{
+	// CPU requests are stored in millicores
+	cpuEfficiencyCol:    {resource: "cpu", requestCol: cpuCol, usageScale: 1000},
+	memoryEfficiencyCol: {resource: "memory", requestCol: memoryCol, usageScale: 1},
+}
+
 type LookoutTables struct {
 	// field name -> column name
 	fieldColumnMap map[string]string
@@ -76,6 +103,8 @@ func NewTables() *LookoutTables {
 			"submitted":          submittedCol,
 			"lastTransitionTime": lastTransitionTimeCol,
 			"priorityClass":      priorityClassCol,
+			"cpuEfficiency":      cpuEfficiencyCol,
+			"memoryEfficiency":   memoryEfficiencyCol,
 		},
 		orderableColumns: util.StringListToSet([]string{
 			jobIdCol,
@@ -84,7 +113,7 @@ func NewTables() *LookoutTables {
 			lastTransitionTimeCol,
 		}),
 		filterableColumns: map[string]map[string]bool{
-			jobIdCol:            util.StringListToSet([]string{model.MatchExact}),
+			jobIdCol:            util.StringListToSet([]string{model.MatchExact, model.MatchLessThan}),
 			queueCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchAnyOf}),
 			jobSetCol:           util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
 			ownerCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
@@ -96,6 +125,7 @@ func NewTables() *LookoutTables {
 			gpuCol:              util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			priorityCol:         util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			priorityClassCol:    util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
+			submittedCol:        util.StringListToSet([]string{model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 		},
 		tableAbbrevs: map[string]string{
 			jobTable:    jobTableAbbrev,
@@ -111,6 +141,8 @@ func NewTables() *LookoutTables {
 			submittedCol:          Min,
 			lastTransitionTimeCol: Average,
 			stateCol:              StateCounts,
+			cpuEfficiencyCol:      Efficiency,
+			memoryEfficiencyCol:   Efficiency,
 		},
 	}
 }
@@ -51,7 +51,9 @@ type JobOptions struct {
 	Memory           resource.Quantity
 	EphemeralStorage resource.Quantity
 	Gpu              resource.Quantity
-	Annotations      map[string]string
+	// Requests for resources other than those above, e.g. amd.com/gpu
+	Resources   map[v1.ResourceName]resource.Quantity
+	Annotations map[string]string
 }
 
 type runPatch struct {
@@ -115,6 +117,9 @@ func (js *JobSimulator) Submit(queue, jobSet, owner, namespace string, timestamp
 	resources[v1.ResourceMemory] = opts.Memory
 	resources[v1.ResourceEphemeralStorage] = opts.EphemeralStorage
 	resources["nvidia.com/gpu"] = opts.Gpu
+	for name, quantity := range opts.Resources {
+		resources[name] = quantity
+	}
 	ts := timestampOrNow(timestamp)
 
 	if opts.Annotations == nil {
@@ -298,6 +303,31 @@ func (js *JobSimulator) Running(runId string, node string, timestamp time.Time)
 	return js
 }
 
+func (js *JobSimulator) ResourceUtilisation(runId string, maxUsage, avgUsage map[string]resource.Quantity, timestamp time.Time) *JobSimulator {
+	ts := timestampOrNow(timestamp)
+	toProto := func(usage map[string]resource.Quantity) map[string]*resource.Quantity {
+		result := make(map[string]*resource.Quantity, len(usage))
+		for k, v := range usage {
+			q := v.DeepCopy()
+			result[k] = &q
+		}
+		return result
+	}
+	utilisationEvent := &armadaevents.EventSequence_Event{
+		Created: ts,
+		Event: &armadaevents.EventSequence_Event_ResourceUtilisation{
+			ResourceUtilisation: &armadaevents.ResourceUtilisation{
+				RunId:                 runId,
+				JobId:                 js.jobId,
+				MaxResourcesForPeriod: toProto(maxUsage),
+				AvgResourcesForPeriod: toProto(avgUsage),
+			},
+		},
+	}
+	js.events = append(js.events, utilisationEvent)
+	return js
+}
+
 func (js *JobSimulator) RunSucceeded(runId string, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	succeeded := protoutil.ToStdTime(ts)
@@ -0,0 +1,3 @@
+ALTER TABLE job ADD COLUMN labels jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_job_labels_path ON job USING GIN (labels jsonb_path_ops);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS job_run_resource_utilisation (
+  run_id       varchar(36)      NOT NULL,
+  job_id       varchar(32)      NOT NULL,
+  resource     varchar(253)     NOT NULL,
+  max_value    double precision NOT NULL,
+  avg_value    double precision NOT NULL,
+  last_updated timestamp        NOT NULL,
+  PRIMARY KEY (run_id, resource)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_resource_utilisation_job_id ON job_run_resource_utilisation (job_id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS job_resource_request (
+  job_id   varchar(32)      NOT NULL,
+  resource varchar(253)     NOT NULL,
+  value    double precision NOT NULL,
+  PRIMARY KEY (job_id, resource)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_resource_request_resource_value ON job_resource_request (resource, value);
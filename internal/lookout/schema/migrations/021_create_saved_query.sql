@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS saved_query (
+  name        varchar(255) NOT NULL PRIMARY KEY,
+  description text         NOT NULL,
+  definition  jsonb        NOT NULL,
+  created_by  varchar(512) NOT NULL,
+  created     timestamp    NOT NULL,
+  updated     timestamp    NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE job_deduplication ADD COLUMN IF NOT EXISTS array_size integer NOT NULL DEFAULT 0;
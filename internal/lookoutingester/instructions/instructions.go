@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
@@ -31,6 +33,7 @@ const (
 	maxPriorityClassLen = 63
 	maxClusterLen       = 512
 	maxNodeLen          = 512
+	maxResourceNameLen  = 253
 )
 
 type HasNodeName interface {
@@ -87,7 +90,12 @@ func (c *InstructionConverter) convertSequence(
 		ts := protoutil.ToStdTime(event.Created)
 		switch event.GetEvent().(type) {
 		case *armadaevents.EventSequence_Event_SubmitJob:
-			err = c.handleSubmitJob(queue, owner, jobset, ts, event.GetSubmitJob(), update)
+			// Each element of a job array is shown as a separate job.
+			for _, job := range eventutil.ExpandJobArray(event.GetSubmitJob()) {
+				if jobErr := c.handleSubmitJob(queue, owner, jobset, ts, job, update); jobErr != nil {
+					err = jobErr
+				}
+			}
 		case *armadaevents.EventSequence_Event_ReprioritisedJob:
 			err = c.handleReprioritiseJob(ts, event.GetReprioritisedJob(), update)
 		case *armadaevents.EventSequence_Event_CancelledJob:
@@ -112,13 +120,18 @@ func (c *InstructionConverter) convertSequence(
 			err = c.handleJobRequeued(ts, event.GetJobRequeued(), update)
 		case *armadaevents.EventSequence_Event_JobRunLeased:
 			err = c.handleJobRunLeased(ts, event.GetJobRunLeased(), update)
+		case *armadaevents.EventSequence_Event_ResourceUtilisation:
+			err = c.handleResourceUtilisation(ts, event.GetResourceUtilisation(), update)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet,
+			*armadaevents.EventSequence_Event_ReprioritiseJobArray,
 			*armadaevents.EventSequence_Event_CancelJob,
 			*armadaevents.EventSequence_Event_CancelJobSet,
-			*armadaevents.EventSequence_Event_ResourceUtilisation,
+			*armadaevents.EventSequence_Event_CancelJobArray,
 			*armadaevents.EventSequence_Event_StandaloneIngressInfo,
 			*armadaevents.EventSequence_Event_PartitionMarker,
 			*armadaevents.EventSequence_Event_JobValidated,
+			*armadaevents.EventSequence_Event_JobDeferralEnded,
+			*armadaevents.EventSequence_Event_JobRunPreemptionNotice,
 			*armadaevents.EventSequence_Event_JobRunPreemptionRequested:
 			log.Debugf("Ignoring event type %T", event.GetEvent())
 		default:
@@ -188,7 +201,9 @@ func (c *InstructionConverter) handleSubmitJob(
 		JobProto:                  jobProto,
 		PriorityClass:             priorityClass,
 		Annotations:               userAnnotations,
+		Labels:                    truncateLabels(event.GetObjectMeta().GetLabels()),
 		ExternalJobUri:            externalJobUri,
+		ResourceRequests:          getJobResourceRequests(apiJob),
 	}
 	update.JobsToCreate = append(update.JobsToCreate, &job)
 
@@ -211,6 +226,16 @@ func extractUserAnnotations(userAnnotationPrefix string, jobAnnotations map[stri
 	return result
 }
 
+// truncateLabels truncates label keys and values to their maximal lengths (as specified by maxAnnotationKeyLen and
+// maxAnnotationValLen).
+func truncateLabels(jobLabels map[string]string) map[string]string {
+	result := make(map[string]string, len(jobLabels))
+	for k, v := range jobLabels {
+		result[util.Truncate(k, maxAnnotationKeyLen)] = util.Truncate(v, maxAnnotationValLen)
+	}
+	return result
+}
+
 func (c *InstructionConverter) handleReprioritiseJob(_ time.Time, event *armadaevents.ReprioritisedJob, update *model.InstructionSet) error {
 	jobUpdate := model.UpdateJobInstruction{
 		JobId:    event.JobId,
@@ -271,6 +296,11 @@ func (c *InstructionConverter) handleJobErrors(ts time.Time, event *armadaevents
 				JobId: event.JobId,
 				Error: tryCompressError(event.JobId, reason.JobRejected.Message, c.compressor),
 			})
+		case *armadaevents.Error_JobDependencyFailed:
+			update.JobErrorsToCreate = append(update.JobErrorsToCreate, &model.CreateJobErrorInstruction{
+				JobId: event.JobId,
+				Error: tryCompressError(event.JobId, reason.JobDependencyFailed.Message, c.compressor),
+			})
 		}
 
 		jobUpdate := model.UpdateJobInstruction{
@@ -414,6 +444,9 @@ func (c *InstructionConverter) handleJobRunErrors(ts time.Time, event *armadaeve
 			// This case is already handled by the JobRunPreempted event
 			// When we formalise that as a terminal event, we'll remove this JobRunError getting produced
 			continue
+		case *armadaevents.Error_RetryDecision:
+			// Retry decisions don't change the state of the run
+			continue
 		case *armadaevents.Error_PodLeaseReturned:
 			jobRunUpdate.JobRunState = pointer.Int32(lookout.JobRunLeaseReturnedOrdinal)
 			jobRunUpdate.Error = tryCompressError(event.JobId, reason.PodLeaseReturned.GetMessage(), c.compressor)
@@ -443,6 +476,33 @@ func (c *InstructionConverter) handleJobRunPreempted(ts time.Time, event *armada
 	return nil
 }
 
+// handleResourceUtilisation records the max and average usage of each resource reported for a run.
+// The executor aggregates usage over the lifetime of the run, so each event supersedes the previous one.
+// As for resource requests, resources whose names are too long to store are skipped, since truncated names could collide.
+func (c *InstructionConverter) handleResourceUtilisation(ts time.Time, event *armadaevents.ResourceUtilisation, update *model.InstructionSet) error {
+	resourceNames := maps.Keys(event.MaxResourcesForPeriod)
+	slices.Sort(resourceNames)
+	for _, resourceName := range resourceNames {
+		maxQuantity := event.MaxResourcesForPeriod[resourceName]
+		if maxQuantity == nil || len(resourceName) > maxResourceNameLen {
+			continue
+		}
+		avgValue := maxQuantity.AsApproximateFloat64()
+		if avgQuantity, ok := event.AvgResourcesForPeriod[resourceName]; ok && avgQuantity != nil {
+			avgValue = avgQuantity.AsApproximateFloat64()
+		}
+		update.JobRunResourceUtilisationToUpsert = append(update.JobRunResourceUtilisationToUpsert, &model.UpsertJobRunResourceUtilisationInstruction{
+			RunId:       event.RunId,
+			JobId:       event.JobId,
+			Resource:    resourceName,
+			MaxValue:    maxQuantity.AsApproximateFloat64(),
+			AvgValue:    avgValue,
+			LastUpdated: ts,
+		})
+	}
+	return nil
+}
+
 func tryCompressError(jobId string, errorString string, compressor compress.Compressor) []byte {
 	compressedError, err := compressor.Compress([]byte(errorString))
 	if err != nil {
@@ -484,6 +544,21 @@ func getJobResources(job *api.Job) jobResources {
 	return resources
 }
 
+// getJobResourceRequests returns the total amount of each resource requested by the containers of the job,
+// in the base unit of each resource. Resources whose names are too long to store are skipped.
+func getJobResourceRequests(job *api.Job) map[string]float64 {
+	requests := make(map[string]float64)
+	for _, container := range job.GetMainPodSpec().Containers {
+		for resourceName, quantity := range container.Resources.Requests {
+			if len(resourceName) > maxResourceNameLen {
+				continue
+			}
+			requests[string(resourceName)] += quantity.AsApproximateFloat64()
+		}
+	}
+	return requests
+}
+
 func getResource(container v1.Container, resourceName v1.ResourceName, useMillis bool) int64 {
 	resource, ok := container.Resources.Requests[resourceName]
 	if !ok {
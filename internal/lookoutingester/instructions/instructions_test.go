@@ -166,6 +166,25 @@ var expectedCancelledRun = model.UpdateJobRunInstruction{
 	JobRunState: pointer.Int32(lookout.JobRunCancelledOrdinal),
 }
 
+var expectedResourceUtilisation = []*model.UpsertJobRunResourceUtilisationInstruction{
+	{
+		RunId:       testfixtures.RunId,
+		JobId:       testfixtures.JobId,
+		Resource:    "cpu",
+		MaxValue:    1.5,
+		AvgValue:    0.5,
+		LastUpdated: testfixtures.BaseTime,
+	},
+	{
+		RunId:       testfixtures.RunId,
+		JobId:       testfixtures.JobId,
+		Resource:    "memory",
+		MaxValue:    2 * 1024 * 1024 * 1024,
+		AvgValue:    1024 * 1024 * 1024,
+		LastUpdated: testfixtures.BaseTime,
+	},
+}
+
 func TestConvert(t *testing.T) {
 	submit, err := testfixtures.DeepCopy(testfixtures.Submit)
 	assert.NoError(t, err)
@@ -185,6 +204,7 @@ func TestConvert(t *testing.T) {
 		"b":                               "1",
 		"armadaproject.io/externalJobUri": "external-job-uri",
 	}
+	submit.GetSubmitJob().GetObjectMeta().Labels = map[string]string{"team": "ml"}
 	job, err := eventutil.ApiJobFromLogSubmitJob(testfixtures.UserId, []string{}, testfixtures.Queue, testfixtures.JobsetName, testfixtures.BaseTime, submit.GetSubmitJob())
 	assert.NoError(t, err)
 	jobProto, err := proto.Marshal(job)
@@ -211,7 +231,14 @@ func TestConvert(t *testing.T) {
 			"b":                               "1",
 			"armadaproject.io/externalJobUri": "external-job-uri",
 		},
+		Labels:         map[string]string{"team": "ml"},
 		ExternalJobUri: "external-job-uri",
+		ResourceRequests: map[string]float64{
+			"cpu":               12.5,
+			"memory":            memory,
+			"ephemeral-storage": ephemeralStorage,
+			"nvidia.com/gpu":    gpu,
+		},
 	}
 
 	cancelledWithReason, err := testfixtures.DeepCopy(testfixtures.JobCancelled)
@@ -375,6 +402,17 @@ func TestConvert(t *testing.T) {
 				MessageIds:        []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
 		},
+		"job dependency failed": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobDependencyFailed)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate:      []*model.UpdateJobInstruction{&expectedFailed},
+				JobErrorsToCreate: []*model.CreateJobErrorInstruction{&expectedRejectedJobError},
+				MessageIds:        []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
 		"job preempted": {
 			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
 				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobPreempted)},
@@ -395,6 +433,16 @@ func TestConvert(t *testing.T) {
 				MessageIds:      []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
 		},
+		"resource utilisation": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.ResourceUtilisation)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobRunResourceUtilisationToUpsert: expectedResourceUtilisation,
+				MessageIds:                        []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
 		"invalid event without created time": {
 			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
 				Events: []*armadaevents.EventSequence{
@@ -461,6 +509,7 @@ func TestConvert(t *testing.T) {
 			assert.Equal(t, tc.expected.JobsToUpdate, instructionSet.JobsToUpdate)
 			assert.Equal(t, tc.expected.JobRunsToCreate, instructionSet.JobRunsToCreate)
 			assert.Equal(t, tc.expected.JobRunsToUpdate, instructionSet.JobRunsToUpdate)
+			assert.Equal(t, tc.expected.JobRunResourceUtilisationToUpsert, instructionSet.JobRunResourceUtilisationToUpsert)
 			assert.Equal(t, tc.expected.MessageIds, instructionSet.MessageIds)
 		})
 	}
@@ -525,3 +574,36 @@ func TestExtractNodeName(t *testing.T) {
 	podError.NodeName = testfixtures.NodeName
 	assert.Equal(t, pointer.String(testfixtures.NodeName), extractNodeName(&podError))
 }
+
+func TestGetJobResourceRequests(t *testing.T) {
+	longName := strings.Repeat("a", maxResourceNameLen+1)
+	job := &api.Job{
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							"cpu":         resource.MustParse("500m"),
+							"amd.com/gpu": resource.MustParse("1"),
+						},
+					},
+				},
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							"cpu":                     resource.MustParse("1"),
+							"amd.com/gpu":             resource.MustParse("2"),
+							"nvidia.com/mig-1g.10gb":  resource.MustParse("1"),
+							v1.ResourceName(longName): resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, map[string]float64{
+		"cpu":                    1.5,
+		"amd.com/gpu":            3,
+		"nvidia.com/mig-1g.10gb": 1,
+	}, getJobResourceRequests(job))
+}
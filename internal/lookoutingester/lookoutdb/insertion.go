@@ -8,6 +8,8 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
@@ -36,9 +38,10 @@ func NewLookoutDb(db *pgxpool.Pool, fatalErrors []*regexp.Regexp, metrics *metri
 
 // Store updates the lookout database according to the supplied InstructionSet.
 // The updates are applied in the following order:
-// * New Job Creations
+// * New Job Creations, New Job Specs, New Job Resource Requests
 // * Job Updates, New Job Creations, New User Annotations
 // * Job Run Updates
+// Job run resource utilisation is upserted alongside the job updates.
 // In each case we first try to bach insert the rows using the postgres copy protocol.  If this fails then we try a
 // slower, serial insert and discard any rows that cannot be inserted.
 func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.InstructionSet) error {
@@ -46,14 +49,15 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 	// These can be conflated to help performance
 	jobsToUpdate := conflateJobUpdates(instructions.JobsToUpdate)
 	jobRunsToUpdate := conflateJobRunUpdates(instructions.JobRunsToUpdate)
+	jobRunResourceUtilisation := conflateJobRunResourceUtilisation(instructions.JobRunResourceUtilisationToUpsert)
 
 	numRowsToChange := len(instructions.JobsToCreate) + len(jobsToUpdate) + len(instructions.JobRunsToCreate) +
-		len(jobRunsToUpdate) + len(instructions.JobErrorsToCreate)
+		len(jobRunsToUpdate) + len(instructions.JobErrorsToCreate) + len(jobRunResourceUtilisation)
 
 	start := time.Now()
 	// Jobs need to be ingested first as other updates may reference these
 	wgJobIngestion := sync.WaitGroup{}
-	wgJobIngestion.Add(2)
+	wgJobIngestion.Add(3)
 	go func() {
 		defer wgJobIngestion.Done()
 		l.CreateJobs(ctx, instructions.JobsToCreate)
@@ -62,12 +66,16 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 		defer wgJobIngestion.Done()
 		l.CreateJobSpecs(ctx, instructions.JobsToCreate)
 	}()
+	go func() {
+		defer wgJobIngestion.Done()
+		l.CreateJobResourceRequests(ctx, instructions.JobsToCreate)
+	}()
 
 	wgJobIngestion.Wait()
 
 	// Now we can job updates, annotations and new job runs
 	wg := sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(4)
 	go func() {
 		defer wg.Done()
 		l.UpdateJobs(ctx, jobsToUpdate)
@@ -80,6 +88,10 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 		defer wg.Done()
 		l.CreateJobErrors(ctx, instructions.JobErrorsToCreate)
 	}()
+	go func() {
+		defer wg.Done()
+		l.UpsertJobRunResourceUtilisation(ctx, jobRunResourceUtilisation)
+	}()
 
 	wg.Wait()
 
@@ -125,6 +137,23 @@ func (l *LookoutDb) CreateJobSpecs(ctx *armadacontext.Context, instructions []*m
 	log.Infof("Inserted %d job specs in %s", len(instructions), taken)
 }
 
+func (l *LookoutDb) CreateJobResourceRequests(ctx *armadacontext.Context, instructions []*model.CreateJobInstruction) {
+	rows := jobResourceRequestRows(instructions)
+	if len(rows) == 0 {
+		return
+	}
+	start := time.Now()
+	err := l.CreateJobResourceRequestsBatch(ctx, rows)
+	if err != nil {
+		log.WithError(err).Warn("Creating job resource requests via batch failed, will attempt to insert serially (this might be slow).")
+		l.CreateJobResourceRequestsScalar(ctx, rows)
+	}
+	taken := time.Since(start)
+	l.metrics.RecordAvRowChangeTimeByOperation("job_resource_request", commonmetrics.DBOperationInsert, len(rows), taken)
+	l.metrics.RecordRowsChange("job_resource_request", commonmetrics.DBOperationInsert, len(rows))
+	log.Infof("Inserted %d job resource requests in %s", len(rows), taken)
+}
+
 func (l *LookoutDb) UpdateJobs(ctx *armadacontext.Context, instructions []*model.UpdateJobInstruction) {
 	if len(instructions) == 0 {
 		return
@@ -190,6 +219,22 @@ func (l *LookoutDb) CreateJobErrors(ctx *armadacontext.Context, instructions []*
 	log.Infof("Inserted %d job errors in %s", len(instructions), taken)
 }
 
+func (l *LookoutDb) UpsertJobRunResourceUtilisation(ctx *armadacontext.Context, instructions []*model.UpsertJobRunResourceUtilisationInstruction) {
+	if len(instructions) == 0 {
+		return
+	}
+	start := time.Now()
+	err := l.UpsertJobRunResourceUtilisationBatch(ctx, instructions)
+	if err != nil {
+		log.WithError(err).Warn("Upserting job run resource utilisation via batch failed, will attempt to insert serially (this might be slow).")
+		l.UpsertJobRunResourceUtilisationScalar(ctx, instructions)
+	}
+	taken := time.Since(start)
+	l.metrics.RecordAvRowChangeTimeByOperation("job_run_resource_utilisation", commonmetrics.DBOperationUpsert, len(instructions), taken)
+	l.metrics.RecordRowsChange("job_run_resource_utilisation", commonmetrics.DBOperationUpsert, len(instructions))
+	log.Infof("Upserted %d job run resource utilisation rows in %s", len(instructions), taken)
+}
+
 func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*model.CreateJobInstruction) error {
 	return l.withDatabaseRetryInsert(func() error {
 		tmpTable := "job_create_tmp"
@@ -214,6 +259,7 @@ func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*
 					last_transition_time_seconds bigint,
 					priority_class               varchar(63),
 					annotations                  jsonb,
+					labels                       jsonb,
 				    external_job_uri			 varchar(1024) NULL
 				) ON COMMIT DROP;`, tmpTable))
 			if err != nil {
@@ -242,6 +288,7 @@ func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*
 					"last_transition_time_seconds",
 					"priority_class",
 					"annotations",
+					"labels",
 					"external_job_uri",
 				},
 				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
@@ -262,6 +309,7 @@ func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*
 						instructions[i].LastTransitionTimeSeconds,
 						instructions[i].PriorityClass,
 						instructions[i].Annotations,
+						instructions[i].Labels,
 						instructions[i].ExternalJobUri,
 					}, nil
 				}),
@@ -290,6 +338,7 @@ func (l *LookoutDb) CreateJobsBatch(ctx *armadacontext.Context, instructions []*
 						last_transition_time_seconds,
 						priority_class,
 						annotations,
+						labels,
 					    external_job_uri
 					) SELECT * from %s
 					ON CONFLICT DO NOTHING`, tmpTable),
@@ -323,9 +372,10 @@ func (l *LookoutDb) CreateJobsScalar(ctx *armadacontext.Context, instructions []
 			last_transition_time_seconds,
 			priority_class,
 			annotations,
+			labels,
             external_job_uri
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT DO NOTHING`
 	for _, i := range instructions {
 		err := l.withDatabaseRetryInsert(func() error {
@@ -346,6 +396,7 @@ func (l *LookoutDb) CreateJobsScalar(ctx *armadacontext.Context, instructions []
 				i.LastTransitionTimeSeconds,
 				i.PriorityClass,
 				i.Annotations,
+				i.Labels,
 				i.ExternalJobUri,
 			)
 			if err != nil {
@@ -556,6 +607,113 @@ func (l *LookoutDb) CreateJobSpecsScalar(ctx *armadacontext.Context, instruction
 	}
 }
 
+// jobResourceRequest is a single row of the job_resource_request table
+type jobResourceRequest struct {
+	jobId    string
+	resource string
+	value    float64
+}
+
+// jobResourceRequestRows flattens the resource requests of each job into rows, ordered by resource name within each job.
+func jobResourceRequestRows(instructions []*model.CreateJobInstruction) []jobResourceRequest {
+	var rows []jobResourceRequest
+	for _, instruction := range instructions {
+		resources := maps.Keys(instruction.ResourceRequests)
+		slices.Sort(resources)
+		for _, resource := range resources {
+			rows = append(rows, jobResourceRequest{
+				jobId:    instruction.JobId,
+				resource: resource,
+				value:    instruction.ResourceRequests[resource],
+			})
+		}
+	}
+	return rows
+}
+
+func (l *LookoutDb) CreateJobResourceRequestsBatch(ctx *armadacontext.Context, rows []jobResourceRequest) error {
+	return l.withDatabaseRetryInsert(func() error {
+		tmpTable := "job_resource_request_create_tmp"
+
+		createTmp := func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, fmt.Sprintf(`
+				CREATE TEMPORARY TABLE %s (
+					job_id   varchar(32),
+					resource varchar(253),
+					value    double precision
+				) ON COMMIT DROP;`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationCreateTempTable)
+			}
+			return err
+		}
+
+		insertTmp := func(tx pgx.Tx) error {
+			_, err := tx.CopyFrom(ctx,
+				pgx.Identifier{tmpTable},
+				[]string{
+					"job_id",
+					"resource",
+					"value",
+				},
+				pgx.CopyFromSlice(len(rows), func(i int) ([]interface{}, error) {
+					return []interface{}{
+						rows[i].jobId,
+						rows[i].resource,
+						rows[i].value,
+					}, nil
+				}),
+			)
+			return err
+		}
+
+		copyToDest := func(tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				fmt.Sprintf(`
+					INSERT INTO job_resource_request (
+						job_id,
+						resource,
+						value
+					) SELECT * from %s
+					ON CONFLICT DO NOTHING`, tmpTable),
+			)
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationInsert)
+			}
+			return err
+		}
+
+		return batchInsert(ctx, l.db, createTmp, insertTmp, copyToDest)
+	})
+}
+
+func (l *LookoutDb) CreateJobResourceRequestsScalar(ctx *armadacontext.Context, rows []jobResourceRequest) {
+	sqlStatement := `INSERT INTO job_resource_request (
+			job_id,
+			resource,
+			value
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+	for _, row := range rows {
+		err := l.withDatabaseRetryInsert(func() error {
+			_, err := l.db.Exec(ctx, sqlStatement,
+				row.jobId,
+				row.resource,
+				row.value,
+			)
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationInsert)
+			}
+			return err
+		})
+		if err != nil {
+			log.WithError(err).Warnf("Create resource request %s for job %s failed", row.resource, row.jobId)
+		}
+	}
+}
+
 func (l *LookoutDb) CreateJobRunsBatch(ctx *armadacontext.Context, instructions []*model.CreateJobRunInstruction) error {
 	return l.withDatabaseRetryInsert(func() error {
 		tmpTable := "job_run_create_tmp"
@@ -849,6 +1007,106 @@ func (l *LookoutDb) CreateJobErrorsScalar(ctx *armadacontext.Context, instructio
 	}
 }
 
+// UpsertJobRunResourceUtilisationBatch upserts the utilisation of each resource by each run.
+// The max value only ever increases, while the average value is taken from the most recent report.
+func (l *LookoutDb) UpsertJobRunResourceUtilisationBatch(ctx *armadacontext.Context, instructions []*model.UpsertJobRunResourceUtilisationInstruction) error {
+	tmpTable := "job_run_resource_utilisation_upsert_tmp"
+	return l.withDatabaseRetryInsert(func() error {
+		createTmp := func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, fmt.Sprintf(`
+				CREATE TEMPORARY TABLE %s (
+					run_id       varchar(36),
+					job_id       varchar(32),
+					resource     varchar(253),
+					max_value    double precision,
+					avg_value    double precision,
+					last_updated timestamp
+				) ON COMMIT DROP;`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationCreateTempTable)
+			}
+			return err
+		}
+
+		insertTmp := func(tx pgx.Tx) error {
+			_, err := tx.CopyFrom(ctx,
+				pgx.Identifier{tmpTable},
+				[]string{
+					"run_id",
+					"job_id",
+					"resource",
+					"max_value",
+					"avg_value",
+					"last_updated",
+				},
+				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
+					return []interface{}{
+						instructions[i].RunId,
+						instructions[i].JobId,
+						instructions[i].Resource,
+						instructions[i].MaxValue,
+						instructions[i].AvgValue,
+						instructions[i].LastUpdated,
+					}, nil
+				}),
+			)
+			return err
+		}
+
+		copyToDest := func(tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				fmt.Sprintf(`
+					INSERT INTO job_run_resource_utilisation (
+						run_id,
+						job_id,
+						resource,
+						max_value,
+						avg_value,
+						last_updated
+					) SELECT * from %s
+					ON CONFLICT (run_id, resource) DO UPDATE SET
+						max_value = GREATEST(job_run_resource_utilisation.max_value, EXCLUDED.max_value),
+						avg_value = CASE WHEN EXCLUDED.last_updated >= job_run_resource_utilisation.last_updated
+							THEN EXCLUDED.avg_value ELSE job_run_resource_utilisation.avg_value END,
+						last_updated = GREATEST(job_run_resource_utilisation.last_updated, EXCLUDED.last_updated)`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationUpsert)
+			}
+			return err
+		}
+		return batchInsert(ctx, l.db, createTmp, insertTmp, copyToDest)
+	})
+}
+
+func (l *LookoutDb) UpsertJobRunResourceUtilisationScalar(ctx *armadacontext.Context, instructions []*model.UpsertJobRunResourceUtilisationInstruction) {
+	sqlStatement := `INSERT INTO job_run_resource_utilisation (run_id, job_id, resource, max_value, avg_value, last_updated)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (run_id, resource) DO UPDATE SET
+			max_value = GREATEST(job_run_resource_utilisation.max_value, EXCLUDED.max_value),
+			avg_value = CASE WHEN EXCLUDED.last_updated >= job_run_resource_utilisation.last_updated
+				THEN EXCLUDED.avg_value ELSE job_run_resource_utilisation.avg_value END,
+			last_updated = GREATEST(job_run_resource_utilisation.last_updated, EXCLUDED.last_updated)`
+	for _, i := range instructions {
+		err := l.withDatabaseRetryInsert(func() error {
+			_, err := l.db.Exec(ctx, sqlStatement,
+				i.RunId,
+				i.JobId,
+				i.Resource,
+				i.MaxValue,
+				i.AvgValue,
+				i.LastUpdated)
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationUpsert)
+			}
+			return err
+		})
+		if err != nil {
+			log.WithError(err).Warnf("Upserting resource utilisation of %s for job run %s failed", i.Resource, i.RunId)
+		}
+	}
+}
+
 func batchInsert(ctx *armadacontext.Context, db *pgxpool.Pool, createTmp func(pgx.Tx) error,
 	insertTmp func(pgx.Tx) error, copyToDest func(pgx.Tx) error,
 ) error {
@@ -976,6 +1234,32 @@ func conflateJobRunUpdates(updates []*model.UpdateJobRunInstruction) []*model.Up
 	return conflated
 }
 
+// conflateJobRunResourceUtilisation merges multiple reports of the utilisation of the same resource by the same run,
+// as a single INSERT ... ON CONFLICT statement can't update the same row twice.
+func conflateJobRunResourceUtilisation(updates []*model.UpsertJobRunResourceUtilisationInstruction) []*model.UpsertJobRunResourceUtilisationInstruction {
+	type key struct {
+		runId    string
+		resource string
+	}
+	updatesByKey := make(map[key]*model.UpsertJobRunResourceUtilisationInstruction)
+	conflated := make([]*model.UpsertJobRunResourceUtilisationInstruction, 0, len(updates))
+	for _, update := range updates {
+		k := key{runId: update.RunId, resource: update.Resource}
+		existing, ok := updatesByKey[k]
+		if !ok {
+			updatesByKey[k] = update
+			conflated = append(conflated, update)
+			continue
+		}
+		existing.MaxValue = max(existing.MaxValue, update.MaxValue)
+		if !update.LastUpdated.Before(existing.LastUpdated) {
+			existing.AvgValue = update.AvgValue
+			existing.LastUpdated = update.LastUpdated
+		}
+	}
+	return conflated
+}
+
 // updateInstructionsForJob is used in filterEventsForTerminalJobs, and records a list of job updates for a single job,
 // along with whether it contains an instruction corresponding to a JobPreempted event
 type updateInstructionsForJob struct {
@@ -45,6 +45,11 @@ var annotations = map[string]string{
 	"b": "1",
 }
 
+var resourceRequests = map[string]float64{
+	"cpu":         12.5,
+	"amd.com/gpu": 2,
+}
+
 var (
 	baseTime, _     = time.Parse("2006-01-02T15:04:05.000Z", "2022-03-01T15:04:05.000Z")
 	updateTime, _   = time.Parse("2006-01-02T15:04:05.000Z", "2022-03-01T15:04:06.000Z")
@@ -105,6 +110,15 @@ type JobErrorRow struct {
 	Error []byte
 }
 
+type JobRunResourceUtilisationRow struct {
+	RunId       string
+	JobId       string
+	Resource    string
+	MaxValue    float64
+	AvgValue    float64
+	LastUpdated time.Time
+}
+
 func defaultInstructionSet() *model.InstructionSet {
 	return &model.InstructionSet{
 		JobsToCreate: []*model.CreateJobInstruction{makeCreateJobInstruction(JobId)},
@@ -136,6 +150,14 @@ func defaultInstructionSet() *model.InstructionSet {
 			JobId: JobId,
 			Error: []byte(testfixtures.ErrMsg),
 		}},
+		JobRunResourceUtilisationToUpsert: []*model.UpsertJobRunResourceUtilisationInstruction{{
+			RunId:       RunId,
+			JobId:       JobId,
+			Resource:    "cpu",
+			MaxValue:    1.5,
+			AvgValue:    0.5,
+			LastUpdated: startTime,
+		}},
 		MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(3)},
 	}
 }
@@ -195,6 +217,15 @@ var expectedJobError = JobErrorRow{
 	Error: []byte(testfixtures.ErrMsg),
 }
 
+var expectedJobRunResourceUtilisation = JobRunResourceUtilisationRow{
+	RunId:       RunId,
+	JobId:       JobId,
+	Resource:    "cpu",
+	MaxValue:    1.5,
+	AvgValue:    0.5,
+	LastUpdated: startTime,
+}
+
 var expectedJobRunAfterUpdate = JobRunRow{
 	RunId:       RunId,
 	JobId:       JobId,
@@ -465,6 +496,70 @@ func TestCreateJobsScalar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateJobResourceRequestsBatch(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10)
+		rows := jobResourceRequestRows(defaultInstructionSet().JobsToCreate)
+
+		// Simple create
+		err := ldb.CreateJobResourceRequestsBatch(armadacontext.Background(), rows)
+		assert.Nil(t, err)
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, db, JobId))
+
+		// Insert again and check for idempotency
+		err = ldb.CreateJobResourceRequestsBatch(armadacontext.Background(), rows)
+		assert.Nil(t, err)
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, db, JobId))
+
+		// If a row is bad then we should return an error and no updates should happen
+		_, err = ldb.db.Exec(armadacontext.Background(), "DELETE FROM job_resource_request")
+		assert.NoError(t, err)
+		invalidRow := jobResourceRequest{jobId: invalidId, resource: "cpu", value: 1}
+		err = ldb.CreateJobResourceRequestsBatch(armadacontext.Background(), append(rows, invalidRow))
+		assert.Error(t, err)
+		assertNoRows(t, db, "job_resource_request")
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateJobResourceRequestsScalar(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10)
+		rows := jobResourceRequestRows(defaultInstructionSet().JobsToCreate)
+
+		// Simple create
+		ldb.CreateJobResourceRequestsScalar(armadacontext.Background(), rows)
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, db, JobId))
+
+		// Insert again and check for idempotency
+		ldb.CreateJobResourceRequestsScalar(armadacontext.Background(), rows)
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, db, JobId))
+
+		// If a row is bad then we should insert only the good rows
+		_, err := ldb.db.Exec(armadacontext.Background(), "DELETE FROM job_resource_request")
+		assert.NoError(t, err)
+		invalidRow := jobResourceRequest{jobId: invalidId, resource: "cpu", value: 1}
+		ldb.CreateJobResourceRequestsScalar(armadacontext.Background(), append(rows, invalidRow))
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, db, JobId))
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestJobResourceRequestRows(t *testing.T) {
+	assert.Empty(t, jobResourceRequestRows(nil))
+
+	otherJob := makeCreateJobInstruction("other-job")
+	otherJob.ResourceRequests = map[string]float64{"memory": 1024}
+	rows := jobResourceRequestRows([]*model.CreateJobInstruction{makeCreateJobInstruction(JobId), otherJob})
+	assert.Equal(t, []jobResourceRequest{
+		{jobId: JobId, resource: "amd.com/gpu", value: 2},
+		{jobId: JobId, resource: "cpu", value: 12.5},
+		{jobId: "other-job", resource: "memory", value: 1024},
+	}, rows)
+}
+
 func TestCreateJobRunsBatch(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10)
@@ -662,6 +757,88 @@ func TestCreateJobErrorsScalar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpsertJobRunResourceUtilisationBatch(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10)
+
+		// Insert
+		err := ldb.UpsertJobRunResourceUtilisationBatch(armadacontext.Background(), defaultInstructionSet().JobRunResourceUtilisationToUpsert)
+		assert.Nil(t, err)
+		utilisation := getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		assert.Equal(t, expectedJobRunResourceUtilisation, utilisation)
+
+		// Insert again and test that it's idempotent
+		err = ldb.UpsertJobRunResourceUtilisationBatch(armadacontext.Background(), defaultInstructionSet().JobRunResourceUtilisationToUpsert)
+		assert.Nil(t, err)
+		utilisation = getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		assert.Equal(t, expectedJobRunResourceUtilisation, utilisation)
+
+		// A later report updates the average but never lowers the max
+		later := defaultInstructionSet().JobRunResourceUtilisationToUpsert
+		later[0].MaxValue = 1
+		later[0].AvgValue = 0.75
+		later[0].LastUpdated = finishedTime
+		err = ldb.UpsertJobRunResourceUtilisationBatch(armadacontext.Background(), later)
+		assert.Nil(t, err)
+		utilisation = getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		expected := expectedJobRunResourceUtilisation
+		expected.AvgValue = 0.75
+		expected.LastUpdated = finishedTime
+		assert.Equal(t, expected, utilisation)
+
+		// An earlier report doesn't overwrite the average
+		earlier := defaultInstructionSet().JobRunResourceUtilisationToUpsert
+		earlier[0].MaxValue = 2
+		earlier[0].AvgValue = 0.25
+		earlier[0].LastUpdated = updateTime
+		err = ldb.UpsertJobRunResourceUtilisationBatch(armadacontext.Background(), earlier)
+		assert.Nil(t, err)
+		utilisation = getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		expected.MaxValue = 2
+		assert.Equal(t, expected, utilisation)
+
+		// If a row is bad then we should return an error and no updates should happen
+		_, err = ldb.db.Exec(armadacontext.Background(), "DELETE FROM job_run_resource_utilisation")
+		assert.NoError(t, err)
+		invalidUtilisation := &model.UpsertJobRunResourceUtilisationInstruction{
+			RunId:    invalidId,
+			Resource: "cpu",
+		}
+		err = ldb.UpsertJobRunResourceUtilisationBatch(armadacontext.Background(), append(defaultInstructionSet().JobRunResourceUtilisationToUpsert, invalidUtilisation))
+		assert.Error(t, err)
+		assertNoRows(t, db, "job_run_resource_utilisation")
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpsertJobRunResourceUtilisationScalar(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10)
+
+		// Insert
+		ldb.UpsertJobRunResourceUtilisationScalar(armadacontext.Background(), defaultInstructionSet().JobRunResourceUtilisationToUpsert)
+		utilisation := getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		assert.Equal(t, expectedJobRunResourceUtilisation, utilisation)
+
+		// Insert again and test that it's idempotent
+		ldb.UpsertJobRunResourceUtilisationScalar(armadacontext.Background(), defaultInstructionSet().JobRunResourceUtilisationToUpsert)
+		utilisation = getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		assert.Equal(t, expectedJobRunResourceUtilisation, utilisation)
+
+		// If a row is bad then it should be skipped
+		invalidUtilisation := &model.UpsertJobRunResourceUtilisationInstruction{
+			RunId:    invalidId,
+			Resource: "cpu",
+		}
+		ldb.UpsertJobRunResourceUtilisationScalar(armadacontext.Background(), append(defaultInstructionSet().JobRunResourceUtilisationToUpsert, invalidUtilisation))
+		utilisation = getJobRunResourceUtilisation(t, db, RunId, "cpu")
+		assert.Equal(t, expectedJobRunResourceUtilisation, utilisation)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
 func TestStoreWithEmptyInstructionSet(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10)
@@ -688,6 +865,8 @@ func TestStore(t *testing.T) {
 
 		assert.Equal(t, expectedJobAfterUpdate, job)
 		assert.Equal(t, expectedJobRunAfterUpdate, jobRun)
+		assert.Equal(t, resourceRequests, getJobResourceRequests(t, ldb.db, JobId))
+		assert.Equal(t, expectedJobRunResourceUtilisation, getJobRunResourceUtilisation(t, ldb.db, RunId, "cpu"))
 		return nil
 	})
 	assert.NoError(t, err)
@@ -837,6 +1016,24 @@ func TestConflateJobRunUpdates(t *testing.T) {
 	assert.Equal(t, expected, updates)
 }
 
+func TestConflateJobRunResourceUtilisation(t *testing.T) {
+	// Empty
+	updates := conflateJobRunResourceUtilisation([]*model.UpsertJobRunResourceUtilisationInstruction{})
+	assert.Equal(t, []*model.UpsertJobRunResourceUtilisationInstruction{}, updates)
+
+	// Reports for the same run and resource are merged, keeping the highest max and the latest average
+	updates = conflateJobRunResourceUtilisation([]*model.UpsertJobRunResourceUtilisationInstruction{
+		{RunId: RunId, Resource: "cpu", MaxValue: 2, AvgValue: 1, LastUpdated: updateTime},
+		{RunId: RunId, Resource: "memory", MaxValue: 100, AvgValue: 50, LastUpdated: updateTime},
+		{RunId: RunId, Resource: "cpu", MaxValue: 1, AvgValue: 0.5, LastUpdated: startTime},
+	})
+	expected := []*model.UpsertJobRunResourceUtilisationInstruction{
+		{RunId: RunId, Resource: "cpu", MaxValue: 2, AvgValue: 0.5, LastUpdated: startTime},
+		{RunId: RunId, Resource: "memory", MaxValue: 100, AvgValue: 50, LastUpdated: updateTime},
+	}
+	assert.Equal(t, expected, updates)
+}
+
 func TestStoreNullValue(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		jobProto := []byte("hello \000 world \000")
@@ -934,6 +1131,7 @@ func makeCreateJobInstruction(jobId string) *model.CreateJobInstruction {
 		PriorityClass:             pointer.String(priorityClass),
 		Annotations:               annotations,
 		ExternalJobUri:            "external-job-uri",
+		ResourceRequests:          resourceRequests,
 	}
 }
 
@@ -1069,6 +1267,48 @@ func getJobError(t *testing.T, db *pgxpool.Pool, jobId string) JobErrorRow {
 	return errorRow
 }
 
+func getJobResourceRequests(t *testing.T, db *pgxpool.Pool, jobId string) map[string]float64 {
+	rows, err := db.Query(
+		armadacontext.Background(),
+		"SELECT resource, value FROM job_resource_request WHERE job_id = $1",
+		jobId)
+	assert.NoError(t, err)
+	defer rows.Close()
+	requests := map[string]float64{}
+	for rows.Next() {
+		var resource string
+		var value float64
+		assert.NoError(t, rows.Scan(&resource, &value))
+		requests[resource] = value
+	}
+	return requests
+}
+
+func getJobRunResourceUtilisation(t *testing.T, db *pgxpool.Pool, runId string, resource string) JobRunResourceUtilisationRow {
+	row := JobRunResourceUtilisationRow{}
+	r := db.QueryRow(
+		armadacontext.Background(),
+		`SELECT
+			run_id,
+			job_id,
+			resource,
+			max_value,
+			avg_value,
+			last_updated
+		FROM job_run_resource_utilisation WHERE run_id = $1 AND resource = $2`,
+		runId, resource)
+	err := r.Scan(
+		&row.RunId,
+		&row.JobId,
+		&row.Resource,
+		&row.MaxValue,
+		&row.AvgValue,
+		&row.LastUpdated,
+	)
+	assert.NoError(t, err)
+	return row
+}
+
 func assertNoRows(t *testing.T, db *pgxpool.Pool, table string) {
 	t.Helper()
 	var count int
@@ -25,7 +25,10 @@ type CreateJobInstruction struct {
 	JobProto                  []byte
 	PriorityClass             *string
 	Annotations               map[string]string
+	Labels                    map[string]string
 	ExternalJobUri            string
+	// Amount of each resource requested by the job, in the base unit of the resource (e.g. cores for cpu)
+	ResourceRequests map[string]float64
 }
 
 // UpdateJobInstruction is an instruction to update an existing row in the jobs table
@@ -73,16 +76,28 @@ type CreateJobErrorInstruction struct {
 	Error []byte
 }
 
+// UpsertJobRunResourceUtilisationInstruction is an instruction to insert or update a row in the
+// job_run_resource_utilisation table
+type UpsertJobRunResourceUtilisationInstruction struct {
+	RunId       string
+	JobId       string
+	Resource    string
+	MaxValue    float64
+	AvgValue    float64
+	LastUpdated time.Time
+}
+
 // InstructionSet represents a set of instructions to apply to the database.  Each type of instruction is stored in its
 // own ordered list representing the order it was received.  We also store the original message ids corresponding to
 // these instructions so that when they are saved to the database, we can ACK the corresponding messages.
 type InstructionSet struct {
-	JobsToCreate      []*CreateJobInstruction
-	JobsToUpdate      []*UpdateJobInstruction
-	JobRunsToCreate   []*CreateJobRunInstruction
-	JobRunsToUpdate   []*UpdateJobRunInstruction
-	JobErrorsToCreate []*CreateJobErrorInstruction
-	MessageIds        []pulsar.MessageID
+	JobsToCreate                      []*CreateJobInstruction
+	JobsToUpdate                      []*UpdateJobInstruction
+	JobRunsToCreate                   []*CreateJobRunInstruction
+	JobRunsToUpdate                   []*UpdateJobRunInstruction
+	JobErrorsToCreate                 []*CreateJobErrorInstruction
+	JobRunResourceUtilisationToUpsert []*UpsertJobRunResourceUtilisationInstruction
+	MessageIds                        []pulsar.MessageID
 }
 
 func (i *InstructionSet) GetMessageIDs() []pulsar.MessageID {
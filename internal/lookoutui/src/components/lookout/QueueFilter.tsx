@@ -14,6 +14,7 @@ import {
 } from "@mui/material"
 
 import { useGetQueues } from "../../services/lookout/useGetQueues"
+import { orderQueuesAsTree } from "../../utils/queueTreeUtils"
 
 const ELLIPSIS = "\u2026"
 const FILTER_CHANGE_DEBOUNCE_MS = 300
@@ -35,16 +36,22 @@ const StyledAutocomplete = styled(Autocomplete<string, true>)({
 const uncheckedIcon = <CheckBoxOutlineBlank fontSize="inherit" />
 const checkedIcon = <CheckBox fontSize="inherit" />
 
-const renderOption: AutocompleteProps<string, true, false, false>["renderOption"] = (
-  { key, ...optionProps },
-  option,
-  { selected },
-) => (
-  <li key={key} {...optionProps}>
-    <Checkbox icon={uncheckedIcon} checkedIcon={checkedIcon} checked={selected} />
-    {option}
-  </li>
-)
+const QUEUE_TREE_INDENT_REM = 1.5
+
+// Nested queues are indented according to their depth in the queue hierarchy
+const makeRenderOption =
+  (depthByQueue: Map<string, number>): AutocompleteProps<string, true, false, false>["renderOption"] =>
+  ({ key, ...optionProps }, option, { selected }) => (
+    <li key={key} {...optionProps}>
+      <Checkbox
+        icon={uncheckedIcon}
+        checkedIcon={checkedIcon}
+        checked={selected}
+        sx={{ marginLeft: `${(depthByQueue.get(option) ?? 0) * QUEUE_TREE_INDENT_REM}rem` }}
+      />
+      {option}
+    </li>
+  )
 
 const areQueueValuesEqual = (a: string[] | undefined, b: string[] | undefined) =>
   JSON.stringify((a ?? []).slice().sort()) === JSON.stringify((b ?? []).slice().sort())
@@ -65,7 +72,12 @@ export const QueueFilter = ({ filterValue, parseError, onFilterChange, onSetText
   const [open, setOpen] = useState(false)
   const [enableGetQueues, setEnableGetQueues] = useState(false)
   const { refetch, status, error, data } = useGetQueues(enableGetQueues)
-  const queueNames = useMemo(() => (data ?? []).flatMap(({ name }) => (name ? [name] : [])), [data])
+  const queueTree = useMemo(() => orderQueuesAsTree(data ?? []), [data])
+  const queueNames = useMemo(() => queueTree.map(({ name }) => name), [queueTree])
+  const renderOption = useMemo(
+    () => makeRenderOption(new Map(queueTree.map(({ name, depth }) => [name, depth]))),
+    [queueTree],
+  )
 
   const [autocompleteValue, setAutocompleteValue] = useState(filterValue ?? ([] as string[]))
   useEffect(() => {
@@ -0,0 +1,32 @@
+import { orderQueuesAsTree } from "./queueTreeUtils"
+
+describe("orderQueuesAsTree", () => {
+  it("should order flat queues by name", () => {
+    expect(orderQueuesAsTree([{ name: "b" }, { name: "a" }])).toEqual([
+      { name: "a", depth: 0 },
+      { name: "b", depth: 0 },
+    ])
+  })
+
+  it("should place nested queues directly after their parent", () => {
+    expect(
+      orderQueuesAsTree([
+        { name: "user-b", parent: "project" },
+        { name: "team" },
+        { name: "project", parent: "team" },
+        { name: "other" },
+        { name: "user-a", parent: "project" },
+      ]),
+    ).toEqual([
+      { name: "other", depth: 0 },
+      { name: "team", depth: 0 },
+      { name: "project", depth: 1 },
+      { name: "user-a", depth: 2 },
+      { name: "user-b", depth: 2 },
+    ])
+  })
+
+  it("should treat queues with an unknown parent as top-level", () => {
+    expect(orderQueuesAsTree([{ name: "orphan", parent: "deleted" }])).toEqual([{ name: "orphan", depth: 0 }])
+  })
+})
@@ -0,0 +1,41 @@
+export interface QueueTreeNode {
+  name: string
+  // Number of ancestors of this queue, i.e., 0 for top-level queues
+  depth: number
+}
+
+interface NestedQueue {
+  name?: string
+  parent?: string
+}
+
+// Orders queues depth-first, such that each queue directly follows its parent, and siblings are ordered by name.
+// Queues nested under a queue that isn't in the list are considered top-level.
+export const orderQueuesAsTree = (queues: NestedQueue[]): QueueTreeNode[] => {
+  const names = new Set(queues.flatMap(({ name }) => (name ? [name] : [])))
+  const childrenByParent = new Map<string, string[]>()
+  const roots: string[] = []
+  queues.forEach(({ name, parent }) => {
+    if (!name) {
+      return
+    }
+    if (parent && names.has(parent)) {
+      childrenByParent.set(parent, [...(childrenByParent.get(parent) ?? []), name])
+    } else {
+      roots.push(name)
+    }
+  })
+
+  const result: QueueTreeNode[] = []
+  const visited = new Set<string>()
+  const visit = (name: string, depth: number) => {
+    if (visited.has(name)) {
+      return
+    }
+    visited.add(name)
+    result.push({ name, depth })
+    ;(childrenByParent.get(name) ?? []).sort().forEach((child) => visit(child, depth + 1))
+  }
+  roots.sort().forEach((name) => visit(name, 0))
+  return result
+}
@@ -0,0 +1,67 @@
+package configuration
+
+import (
+	"time"
+
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
+	"github.com/armadaproject/armada/internal/server/configuration"
+)
+
+type NotificationIngesterConfiguration struct {
+	// Metrics configuration
+	MetricsPort uint16
+	// General Pulsar configuration
+	Pulsar commonconfig.PulsarConfig
+	// Pulsar subscription name
+	SubscriptionName string `validate:"required"`
+	// Number of messages that will be batched together before notifications are sent
+	BatchSize int `validate:"gt=0"`
+	// Maximum time since the last batch before notifications will be sent
+	BatchDuration time.Duration
+	// Subscriptions to the jobs of a queue or job set
+	Subscriptions []Subscription `validate:"dive"`
+	// Prefixes of the URLs jobs may ask to be notified at via the armadaproject.io/notificationUrl annotation.
+	// A URL matches a prefix if it has the same scheme and host, and its path starts with the path of the prefix.
+	// If empty, the annotation is ignored.
+	AllowedAnnotationUrlPrefixes []string
+	// Database in which the notifications jobs ask for via annotations are stored until the jobs finish.
+	// Only used if AllowedAnnotationUrlPrefixes is non-empty.
+	Postgres configuration.PostgresConfig
+	// Configuration of webhook delivery
+	Webhook WebhookConfig
+	// Path of the file to which notifications that couldn't be delivered are appended, one JSON object per line.
+	// If empty, they're logged instead.
+	DeadLetterLogPath string
+	// If non-nil, configures pprof profiling
+	Profiling *profilingconfig.ProfilingConfig
+}
+
+// Subscription configures notifications for the jobs of a queue, or of a job set in a queue.
+type Subscription struct {
+	// Name of the subscription, used in logs and metrics
+	Name  string `validate:"required"`
+	Queue string `validate:"required"`
+	// If set, only jobs in this job set are notified of
+	JobSet string
+	// States to notify of, named as the event types of the event API, e.g., "failed" or "preempted"
+	States []string `validate:"required,min=1"`
+	// URL of the webhook
+	Url string `validate:"required,url"`
+}
+
+type WebhookConfig struct {
+	// Key used to sign the payload of each notification with HMAC-SHA256.
+	// The signature is sent, hex-encoded, in the X-Armada-Signature header as "sha256=<signature>".
+	SigningKey string
+	// Timeout of each delivery attempt
+	Timeout time.Duration `validate:"gt=0"`
+	// Number of times delivery of a notification is attempted before it's written to the dead-letter log
+	MaxAttempts int `validate:"gt=0"`
+	// Time to wait before the first retry; this doubles with each retry up to MaxRetryBackoff
+	InitialRetryBackoff time.Duration
+	MaxRetryBackoff     time.Duration
+	// Maximum time spent delivering the notifications of a batch to each webhook. Notifications not delivered by then
+	// are written to the dead-letter log, so that an unavailable webhook holds up the others for at most this long.
+	MaxDeliveryTime time.Duration `validate:"gt=0"`
+}
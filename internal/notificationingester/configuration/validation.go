@@ -0,0 +1,43 @@
+package configuration
+
+import (
+	"net/url"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func (c NotificationIngesterConfiguration) Validate() error {
+	validate := validator.New()
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	for _, sub := range c.Subscriptions {
+		for _, state := range sub.States {
+			if !api.IsValidEventType(state) {
+				return errors.Errorf("subscription %s has unknown state %q", sub.Name, state)
+			}
+		}
+	}
+	for _, prefix := range c.AllowedAnnotationUrlPrefixes {
+		if _, err := ParseUrlPrefix(prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseUrlPrefix parses one of AllowedAnnotationUrlPrefixes, which must be an absolute URL with a host but without
+// user info, query or fragment.
+func ParseUrlPrefix(prefix string) (*url.URL, error) {
+	u, err := url.Parse(prefix)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if u.Scheme == "" || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return nil, errors.Errorf("allowed annotation URL prefix %q must be a URL with only a scheme, host and path", prefix)
+	}
+	return u, nil
+}
@@ -0,0 +1,191 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+	serverconfig "github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/internal/server/event/conversion"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// AnnotationSubscription is the subscription name of notifications requested via job annotations.
+const AnnotationSubscription = "annotation"
+
+// States notified of if a job sets serverconfig.NotificationUrlAnnotation but not serverconfig.NotificationStatesAnnotation.
+var defaultAnnotationStates = []string{"succeeded", "failed", "cancelled"}
+
+// States after which a job emits no further events.
+var terminalStates = map[string]bool{"succeeded": true, "failed": true, "cancelled": true}
+
+// NotificationConverter converts event sequences into the notifications of the subscriptions matching them.
+// Jobs' own subscriptions are only known once stored by the sink, so the converter instead records the subscriptions
+// requested by submitted jobs, and the events that may be notified to them.
+type NotificationConverter struct {
+	subscriptions                []configuration.Subscription
+	allowedAnnotationUrlPrefixes []*url.URL
+	metrics                      *metrics.Metrics
+}
+
+func NewNotificationConverter(config *configuration.NotificationIngesterConfiguration, metrics *metrics.Metrics) ingest.InstructionConverter[*model.NotificationBatch, *armadaevents.EventSequence] {
+	// The prefixes have been validated with the rest of the config.
+	allowedAnnotationUrlPrefixes := make([]*url.URL, 0, len(config.AllowedAnnotationUrlPrefixes))
+	for _, prefix := range config.AllowedAnnotationUrlPrefixes {
+		if u, err := configuration.ParseUrlPrefix(prefix); err == nil {
+			allowedAnnotationUrlPrefixes = append(allowedAnnotationUrlPrefixes, u)
+		}
+	}
+	return &NotificationConverter{
+		subscriptions:                config.Subscriptions,
+		allowedAnnotationUrlPrefixes: allowedAnnotationUrlPrefixes,
+		metrics:                      metrics,
+	}
+}
+
+func (c *NotificationConverter) Convert(_ *armadacontext.Context, eventsWithIds *utils.EventsWithIds[*armadaevents.EventSequence]) *model.NotificationBatch {
+	batch := &model.NotificationBatch{
+		MessageIds:    eventsWithIds.MessageIds,
+		Notifications: make([]*model.Notification, 0),
+	}
+	for _, es := range eventsWithIds.Events {
+		apiEvents, err := conversion.FromEventSequence(es)
+		if err != nil {
+			c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+			log.WithError(err).Warnf("Could not convert event sequence %s", eventutil.ShortSequenceString(es))
+			continue
+		}
+		for _, msg := range apiEvents {
+			c.convertEvent(es.Queue, es.JobSetName, msg, batch)
+		}
+	}
+	return batch
+}
+
+func (c *NotificationConverter) convertEvent(queue string, jobSetId string, msg *api.EventMessage, batch *model.NotificationBatch) {
+	state := api.EventType(msg)
+	event, err := api.UnwrapEvent(msg)
+	if err != nil {
+		log.WithError(err).Warnf("Could not unwrap event of type %s", state)
+		return
+	}
+	jobId := event.GetJobId()
+	annotationsAllowed := len(c.allowedAnnotationUrlPrefixes) > 0
+	if submitted, ok := msg.Events.(*api.EventMessage_Submitted); ok && annotationsAllowed {
+		if jobSub := c.jobSubscriptionFromAnnotations(jobId, submitted.Submitted.GetJob().GetAnnotations()); jobSub != nil {
+			batch.JobSubscriptions = append(batch.JobSubscriptions, jobSub)
+		}
+	}
+
+	var subscriptionNames, urls []string
+	for _, sub := range c.subscriptions {
+		if sub.Queue == queue && (sub.JobSet == "" || sub.JobSet == jobSetId) && slices.Contains(sub.States, state) {
+			subscriptionNames = append(subscriptionNames, sub.Name)
+			urls = append(urls, sub.Url)
+		}
+	}
+	if len(urls) == 0 && !annotationsAllowed {
+		return
+	}
+
+	marshalledEvent, err := marshalEvent(event)
+	if err != nil {
+		c.metrics.RecordPulsarMessageError(metrics.PulsarMessageErrorProcessing)
+		log.WithError(err).Warnf("Could not marshal %s event of job %s", state, jobId)
+		return
+	}
+	created := protoutil.ToStdTime(event.GetCreated()).UTC()
+	payload := model.Payload{
+		Id:       fmt.Sprintf("%s-%s-%d", jobId, state, created.UnixNano()),
+		Queue:    queue,
+		JobSetId: jobSetId,
+		JobId:    jobId,
+		State:    state,
+		Time:     created,
+		Event:    marshalledEvent,
+	}
+	if annotationsAllowed {
+		batch.JobEvents = append(batch.JobEvents, &model.JobEvent{
+			Payload:  payload,
+			Position: len(batch.Notifications),
+			Terminal: terminalStates[state],
+		})
+	}
+	for i := range urls {
+		batch.Notifications = append(batch.Notifications, &model.Notification{Subscription: subscriptionNames[i], Url: urls[i], Payload: payload})
+	}
+}
+
+// jobSubscriptionFromAnnotations returns the subscription requested by the annotations of a job, if any.
+func (c *NotificationConverter) jobSubscriptionFromAnnotations(jobId string, annotations map[string]string) *model.JobSubscription {
+	url := annotations[serverconfig.NotificationUrlAnnotation]
+	if url == "" {
+		return nil
+	}
+	if !c.isAllowedAnnotationUrl(url) {
+		log.Warnf("Ignoring notification URL %s of job %s as it isn't allowed", url, jobId)
+		return nil
+	}
+	states := defaultAnnotationStates
+	if value, ok := annotations[serverconfig.NotificationStatesAnnotation]; ok {
+		states = strings.Split(value, ",")
+	}
+	jobSub := &model.JobSubscription{JobId: jobId, Url: url, States: make([]string, 0, len(states))}
+	for _, state := range states {
+		state = strings.TrimSpace(state)
+		if !api.IsValidEventType(state) {
+			log.Warnf("Ignoring unknown notification state %q of job %s", state, jobId)
+			continue
+		}
+		jobSub.States = append(jobSub.States, state)
+	}
+	return jobSub
+}
+
+// isAllowedAnnotationUrl returns true if rawUrl has the scheme and host of one of the allowed prefixes and its path
+// starts with the path of that prefix. URLs with user info, or with dot segments that could climb out of the path of
+// the prefix once resolved, are never allowed.
+func (c *NotificationConverter) isAllowedAnnotationUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.User != nil || u.Opaque != "" {
+		return false
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == "." || segment == ".." {
+			return false
+		}
+	}
+	for _, prefix := range c.allowedAnnotationUrlPrefixes {
+		if u.Scheme == prefix.Scheme && strings.EqualFold(u.Host, prefix.Host) && strings.HasPrefix(u.Path, prefix.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func marshalEvent(event api.Event) (json.RawMessage, error) {
+	msg, ok := event.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a proto message", event)
+	}
+	var sb strings.Builder
+	if err := (&jsonpb.Marshaler{}).Marshal(&sb, msg); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(sb.String()), nil
+}
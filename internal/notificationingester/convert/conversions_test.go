@@ -0,0 +1,160 @@
+package convert
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest/testfixtures"
+	"github.com/armadaproject/armada/internal/common/ingest/utils"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+	"github.com/armadaproject/armada/internal/notificationingester/metrics"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+	serverconfig "github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func submitWithAnnotations(t *testing.T, annotations map[string]string) *armadaevents.EventSequence_Event {
+	submit, err := testfixtures.DeepCopy(testfixtures.Submit)
+	require.NoError(t, err)
+	submit.GetSubmitJob().ObjectMeta.Annotations = annotations
+	return submit
+}
+
+func convert(converter *NotificationConverter, events ...*armadaevents.EventSequence_Event) *model.NotificationBatch {
+	return converter.Convert(armadacontext.Background(), &utils.EventsWithIds[*armadaevents.EventSequence]{
+		Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(events...)},
+		MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+	})
+}
+
+func newConverter(subscriptions ...configuration.Subscription) *NotificationConverter {
+	return NewNotificationConverter(&configuration.NotificationIngesterConfiguration{
+		Subscriptions: subscriptions,
+		// Compared as a string, the first prefix would let through other hosts, as it has no trailing slash.
+		AllowedAnnotationUrlPrefixes: []string{"https://hooks.example.com", "https://teams.example.com/a/"},
+	}, metrics.Get()).(*NotificationConverter)
+}
+
+func notifiedStates(batch *model.NotificationBatch) []string {
+	var states []string
+	for _, notification := range batch.Notifications {
+		states = append(states, notification.Subscription+" "+notification.Payload.State+" "+notification.Url)
+	}
+	return states
+}
+
+func TestConvert_QueueSubscription(t *testing.T) {
+	converter := newConverter(
+		configuration.Subscription{Name: "queue", Queue: testfixtures.Queue, States: []string{"failed", "succeeded"}, Url: "http://queue"},
+		configuration.Subscription{Name: "job-set", Queue: testfixtures.Queue, JobSet: testfixtures.JobsetName, States: []string{"running"}, Url: "http://job-set"},
+		configuration.Subscription{Name: "other-job-set", Queue: testfixtures.Queue, JobSet: "other", States: []string{"running"}, Url: "http://other"},
+		configuration.Subscription{Name: "other-queue", Queue: "other", States: []string{"failed"}, Url: "http://other"},
+	)
+
+	batch := convert(converter, testfixtures.Submit, testfixtures.Running, testfixtures.JobFailed)
+	assert.Equal(t, []pulsar.MessageID{pulsarutils.NewMessageId(1)}, batch.MessageIds)
+	assert.Equal(t, []string{"job-set running http://job-set", "queue failed http://queue"}, notifiedStates(batch))
+
+	payload := batch.Notifications[1].Payload
+	assert.Equal(t, testfixtures.Queue, payload.Queue)
+	assert.Equal(t, testfixtures.JobsetName, payload.JobSetId)
+	assert.Equal(t, testfixtures.JobId, payload.JobId)
+	assert.Equal(t, testfixtures.BaseTime, payload.Time)
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(payload.Event, &event))
+	assert.Equal(t, testfixtures.JobId, event["jobId"])
+	assert.Equal(t, testfixtures.ErrMsg, event["reason"])
+}
+
+func TestConvert_AnnotationSubscription(t *testing.T) {
+	tests := map[string]struct {
+		annotations  map[string]string
+		expectedSubs []*model.JobSubscription
+	}{
+		"default states": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://hooks.example.com/a"},
+			expectedSubs: []*model.JobSubscription{
+				{JobId: testfixtures.JobId, Url: "https://hooks.example.com/a", States: []string{"succeeded", "failed", "cancelled"}},
+			},
+		},
+		"chosen states": {
+			annotations: map[string]string{
+				serverconfig.NotificationUrlAnnotation:    "https://hooks.example.com/a",
+				serverconfig.NotificationStatesAnnotation: "running, succeeded,unknown",
+			},
+			expectedSubs: []*model.JobSubscription{
+				{JobId: testfixtures.JobId, Url: "https://hooks.example.com/a", States: []string{"running", "succeeded"}},
+			},
+		},
+		"path within prefix": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://teams.example.com/a/hook"},
+			expectedSubs: []*model.JobSubscription{
+				{JobId: testfixtures.JobId, Url: "https://teams.example.com/a/hook", States: []string{"succeeded", "failed", "cancelled"}},
+			},
+		},
+		"url not allowed": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "http://internal/a"},
+		},
+		"different scheme": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "http://hooks.example.com/a"},
+		},
+		"user info": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://hooks.example.com@attacker.net/"},
+		},
+		"host suffix": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://hooks.example.com.attacker.net/"},
+		},
+		"different port": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://hooks.example.com:8443/a"},
+		},
+		"path outside prefix": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://teams.example.com/b/"},
+		},
+		"dot segments": {
+			annotations: map[string]string{serverconfig.NotificationUrlAnnotation: "https://teams.example.com/a/../b/"},
+		},
+		"no annotation": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			batch := convert(newConverter(), submitWithAnnotations(t, tc.annotations), testfixtures.Running, testfixtures.JobSucceeded)
+			assert.Equal(t, tc.expectedSubs, batch.JobSubscriptions)
+			assert.Empty(t, batch.Notifications)
+
+			// All events are recorded, as the job may have subscribed to them before this batch.
+			var states []string
+			for _, jobEvent := range batch.JobEvents {
+				states = append(states, jobEvent.Payload.State)
+				assert.Equal(t, jobEvent.Payload.State == "succeeded", jobEvent.Terminal)
+			}
+			assert.Equal(t, []string{"submitted", "queued", "running", "succeeded"}, states)
+		})
+	}
+}
+
+func TestConvert_JobEventPositions(t *testing.T) {
+	converter := newConverter(
+		configuration.Subscription{Name: "queue", Queue: testfixtures.Queue, States: []string{"submitted", "running"}, Url: "http://queue"},
+	)
+	batch := convert(converter, testfixtures.Submit, testfixtures.Running, testfixtures.JobSucceeded)
+	// Each event's position is the number of notifications of earlier events.
+	var positions []int
+	for _, jobEvent := range batch.JobEvents {
+		positions = append(positions, jobEvent.Position)
+	}
+	assert.Equal(t, []int{0, 1, 1, 2}, positions)
+}
+
+func TestConvert_NoJobEventsIfAnnotationsNotAllowed(t *testing.T) {
+	converter := NewNotificationConverter(&configuration.NotificationIngesterConfiguration{}, metrics.Get()).(*NotificationConverter)
+	annotations := map[string]string{serverconfig.NotificationUrlAnnotation: "https://hooks.example.com/a"}
+	batch := convert(converter, submitWithAnnotations(t, annotations), testfixtures.JobSucceeded)
+	assert.Empty(t, batch.JobSubscriptions)
+	assert.Empty(t, batch.JobEvents)
+}
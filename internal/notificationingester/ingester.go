@@ -0,0 +1,110 @@
+package notificationingester
+
+import (
+	"os"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/app"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	"github.com/armadaproject/armada/internal/common/ingest/jobsetevents"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/profiling"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+	"github.com/armadaproject/armada/internal/notificationingester/convert"
+	"github.com/armadaproject/armada/internal/notificationingester/metrics"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+	"github.com/armadaproject/armada/internal/notificationingester/schema"
+	"github.com/armadaproject/armada/internal/notificationingester/subscriptiondb"
+	"github.com/armadaproject/armada/internal/notificationingester/webhook"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// Run will create a pipeline that will take Armada event messages from Pulsar and notify the webhooks subscribed to
+// the job state transitions they contain. This pipeline will run until a SIGTERM is received
+func Run(config *configuration.NotificationIngesterConfiguration) {
+	log.Info("Notification Ingester Starting")
+
+	if err := config.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Expose profiling endpoints if enabled.
+	err := profiling.SetupPprof(config.Profiling, armadacontext.Background(), nil)
+	if err != nil {
+		log.Fatalf("Pprof setup failed, exiting, %v", err)
+	}
+
+	metrics := metrics.Get()
+
+	var deadLetterLog *webhook.DeadLetterLog
+	if config.DeadLetterLogPath != "" {
+		f, err := os.OpenFile(config.DeadLetterLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatalf("Could not open dead-letter log %s: %v", config.DeadLetterLogPath, err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("failed to close dead-letter log")
+			}
+		}()
+		deadLetterLog = webhook.NewDeadLetterLog(f)
+	} else {
+		deadLetterLog = webhook.NewDeadLetterLog(nil)
+	}
+
+	// Jobs' subscriptions are only stored if jobs may request them.
+	var jobSubscriptions webhook.JobSubscriptionStore
+	if len(config.AllowedAnnotationUrlPrefixes) > 0 {
+		db, err := database.OpenPgxPool(config.Postgres)
+		if err != nil {
+			panic(errors.WithMessage(err, "Error opening connection to postgres"))
+		}
+		defer db.Close()
+		jobSubscriptions = subscriptiondb.NewSubscriptionDb(db, metrics, 100*time.Millisecond, 60*time.Second)
+	}
+
+	converter := convert.NewNotificationConverter(config, metrics)
+	sink := webhook.NewSink(config.Webhook, jobSubscriptions, deadLetterLog)
+
+	// Start metric server
+	shutdownMetricServer := common.ServeMetrics(config.MetricsPort)
+	defer shutdownMetricServer()
+
+	ingester := ingest.NewIngestionPipeline[*model.NotificationBatch, *armadaevents.EventSequence](
+		config.Pulsar,
+		config.Pulsar.JobsetEventsTopic,
+		config.SubscriptionName,
+		config.BatchSize,
+		config.BatchDuration,
+		pulsar.Failover,
+		jobsetevents.EventCounter,
+		jobsetevents.MessageUnmarshaller,
+		jobsetevents.BatchMerger,
+		jobsetevents.BatchMetricPublisher,
+		converter,
+		sink,
+		metrics,
+	)
+	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
+	}
+}
+
+// MigrateDatabase applies the migrations of the notification database.
+func MigrateDatabase(config *configuration.NotificationIngesterConfiguration) {
+	ctx := armadacontext.Background()
+	db, err := database.OpenPgxPool(config.Postgres)
+	if err != nil {
+		panic(errors.WithMessage(err, "Error opening connection to postgres"))
+	}
+	defer db.Close()
+	if err := schema.Migrate(ctx, db); err != nil {
+		panic(errors.WithMessage(err, "Error migrating notification database"))
+	}
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+)
+
+const (
+	ResultDelivered  = "delivered"
+	ResultDeadLetter = "dead_letter"
+)
+
+var m = metrics.NewMetrics(metrics.ArmadaNotificationIngesterMetricsPrefix)
+
+func Get() *metrics.Metrics {
+	return m
+}
+
+var notifications = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: metrics.ArmadaNotificationIngesterMetricsPrefix + "notifications",
+		Help: "Number of notifications sent, by subscription and whether they were delivered or dead-lettered",
+	},
+	[]string{"subscription", "result"},
+)
+
+var deliveryAttempts = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: metrics.ArmadaNotificationIngesterMetricsPrefix + "delivery_attempts",
+		Help: "Number of attempts to deliver notifications, by subscription",
+	},
+	[]string{"subscription"},
+)
+
+func RecordNotification(subscription, result string) {
+	notifications.With(map[string]string{"subscription": subscription, "result": result}).Inc()
+}
+
+func RecordDeliveryAttempt(subscription string) {
+	deliveryAttempts.With(map[string]string{"subscription": subscription}).Inc()
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+// NotificationBatch holds the notifications to send for a batch of pulsar messages
+type NotificationBatch struct {
+	MessageIds []pulsar.MessageID
+	// Notifications of the subscriptions in the config
+	Notifications []*Notification
+	// Subscriptions requested via annotations by the jobs submitted in this batch
+	JobSubscriptions []*JobSubscription
+	// Events of this batch, which are notified of if their job requested it via annotations.
+	// Only set if jobs may request notifications.
+	JobEvents []*JobEvent
+}
+
+func (b *NotificationBatch) GetMessageIDs() []pulsar.MessageID {
+	return b.MessageIds
+}
+
+// Notification is a notification that a job reached a state, to be delivered to a webhook.
+type Notification struct {
+	// Name of the subscription this notification is for, or "annotation" if the job asked for it.
+	Subscription string
+	Url          string
+	Payload      Payload
+}
+
+// Payload is the JSON body posted to the webhook.
+type Payload struct {
+	// Uniquely identifies the state transition. Notifications may be delivered more than once; receivers can use this
+	// to deduplicate them.
+	Id       string    `json:"id"`
+	Queue    string    `json:"queue"`
+	JobSetId string    `json:"jobSetId"`
+	JobId    string    `json:"jobId"`
+	State    string    `json:"state"`
+	Time     time.Time `json:"time"`
+	// The event that caused the transition, as returned by the event API.
+	Event json.RawMessage `json:"event"`
+}
+
+// JobSubscription is a subscription to the states of a job, requested via its annotations.
+type JobSubscription struct {
+	JobId  string
+	Url    string
+	States []string
+}
+
+// JobEvent is an event of a job, to be notified of if the job has a JobSubscription to its state.
+type JobEvent struct {
+	Payload Payload
+	// Number of Notifications of the batch caused by earlier events, so that the notifications of all subscriptions
+	// can be delivered in the order of their events.
+	Position int
+	// If true, the job emits no further events, so its JobSubscription is no longer needed.
+	Terminal bool
+}
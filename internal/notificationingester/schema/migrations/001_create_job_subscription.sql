@@ -0,0 +1,8 @@
+-- Notifications jobs have asked for via annotations, from when they're submitted until they finish
+CREATE TABLE IF NOT EXISTS job_subscription (
+    job_id  varchar(32) NOT NULL PRIMARY KEY,
+    url     text        NOT NULL,
+    -- States to notify of, named as the event types of the event API
+    states  text[]      NOT NULL,
+    created timestamp   NOT NULL DEFAULT now()
+);
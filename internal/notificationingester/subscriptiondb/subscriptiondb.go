@@ -0,0 +1,100 @@
+package subscriptiondb
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+)
+
+// SubscriptionDb stores the subscriptions jobs request via annotations, from when they're submitted until they
+// finish, so that they survive restarts of the ingester. Failed operations are retried until they succeed or ctx is
+// cancelled.
+type SubscriptionDb struct {
+	db                 *pgxpool.Pool
+	metrics            *commonmetrics.Metrics
+	intialRetryBackoff time.Duration
+	maxRetryBackoff    time.Duration
+}
+
+func NewSubscriptionDb(
+	db *pgxpool.Pool,
+	metrics *commonmetrics.Metrics,
+	intialRetryBackoff time.Duration,
+	maxRetryBackoff time.Duration,
+) *SubscriptionDb {
+	return &SubscriptionDb{
+		db:                 db,
+		metrics:            metrics,
+		intialRetryBackoff: intialRetryBackoff,
+		maxRetryBackoff:    maxRetryBackoff,
+	}
+}
+
+// Upsert stores subscriptions, keeping any already stored for the same jobs.
+func (s *SubscriptionDb) Upsert(ctx *armadacontext.Context, subscriptions []*model.JobSubscription) error {
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	return s.withRetry(ctx, commonmetrics.DBOperationInsert, func() error {
+		batch := &pgx.Batch{}
+		for _, subscription := range subscriptions {
+			batch.Queue(`
+				INSERT INTO job_subscription (job_id, url, states) VALUES ($1, $2, $3)
+				ON CONFLICT (job_id) DO NOTHING`,
+				subscription.JobId, subscription.Url, subscription.States)
+		}
+		return errors.WithStack(s.db.SendBatch(ctx, batch).Close())
+	})
+}
+
+// Get returns the stored subscriptions of the given jobs, by job id.
+func (s *SubscriptionDb) Get(ctx *armadacontext.Context, jobIds []string) (map[string]*model.JobSubscription, error) {
+	subscriptions := make(map[string]*model.JobSubscription)
+	if len(jobIds) == 0 {
+		return subscriptions, nil
+	}
+	err := s.withRetry(ctx, commonmetrics.DBOperationRead, func() error {
+		rows, err := s.db.Query(ctx, `SELECT job_id, url, states FROM job_subscription WHERE job_id = ANY($1)`, jobIds)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			subscription := &model.JobSubscription{}
+			if err := rows.Scan(&subscription.JobId, &subscription.Url, &subscription.States); err != nil {
+				return errors.WithStack(err)
+			}
+			subscriptions[subscription.JobId] = subscription
+		}
+		return errors.WithStack(rows.Err())
+	})
+	return subscriptions, err
+}
+
+// Delete deletes the subscriptions of the given jobs.
+func (s *SubscriptionDb) Delete(ctx *armadacontext.Context, jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	return s.withRetry(ctx, commonmetrics.DBOperationDelete, func() error {
+		_, err := s.db.Exec(ctx, `DELETE FROM job_subscription WHERE job_id = ANY($1)`, jobIds)
+		return errors.WithStack(err)
+	})
+}
+
+func (s *SubscriptionDb) withRetry(ctx *armadacontext.Context, operation commonmetrics.DBOperation, action func() error) error {
+	return ingest.WithRetry(func() (bool, error) {
+		err := action()
+		if err != nil {
+			s.metrics.RecordDBError(operation)
+		}
+		return ctx.Err() == nil, err
+	}, s.intialRetryBackoff, s.maxRetryBackoff)
+}
@@ -0,0 +1,42 @@
+package subscriptiondb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+	"github.com/armadaproject/armada/internal/notificationingester/schema"
+)
+
+func TestSubscriptionDb(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		subscriptionDb := NewSubscriptionDb(db, metrics.NewMetrics("test_subscription_db_"), time.Millisecond, time.Millisecond)
+		job1 := &model.JobSubscription{JobId: "job-1", Url: "https://hooks.example.com/1", States: []string{"failed"}}
+		job2 := &model.JobSubscription{JobId: "job-2", Url: "https://hooks.example.com/2", States: []string{"running", "succeeded"}}
+		require.NoError(t, subscriptionDb.Upsert(ctx, []*model.JobSubscription{job1, job2}))
+
+		// Subscribing a job again keeps its original subscription.
+		require.NoError(t, subscriptionDb.Upsert(ctx, []*model.JobSubscription{
+			{JobId: "job-1", Url: "https://hooks.example.com/other", States: []string{"running"}},
+		}))
+
+		subscriptions, err := subscriptionDb.Get(ctx, []string{"job-1", "job-2", "job-3"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*model.JobSubscription{"job-1": job1, "job-2": job2}, subscriptions)
+
+		require.NoError(t, subscriptionDb.Delete(ctx, []string{"job-1", "job-3"}))
+		subscriptions, err = subscriptionDb.Get(ctx, []string{"job-1", "job-2"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*model.JobSubscription{"job-2": job2}, subscriptions)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/ingest"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+	"github.com/armadaproject/armada/internal/notificationingester/convert"
+	"github.com/armadaproject/armada/internal/notificationingester/metrics"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+)
+
+const (
+	SignatureHeader = "X-Armada-Signature"
+	DeliveryHeader  = "X-Armada-Delivery"
+)
+
+// JobSubscriptionStore stores the subscriptions jobs request via annotations, from when they're submitted until they
+// finish.
+type JobSubscriptionStore interface {
+	Upsert(ctx *armadacontext.Context, subscriptions []*model.JobSubscription) error
+	Get(ctx *armadacontext.Context, jobIds []string) (map[string]*model.JobSubscription, error)
+	Delete(ctx *armadacontext.Context, jobIds []string) error
+}
+
+// Sink posts notifications to webhooks.
+// Notifications to the same URL are delivered in order, while different URLs are delivered to concurrently.
+// Notifications that can't be delivered after the configured number of attempts, or within the configured maximum
+// delivery time of a batch, are written to the dead-letter log, so that an unavailable webhook doesn't stop
+// notifications being sent to others.
+type Sink struct {
+	client           *http.Client
+	config           configuration.WebhookConfig
+	jobSubscriptions JobSubscriptionStore
+	deadLetterLog    *DeadLetterLog
+}
+
+// NewSink returns a Sink delivering the notifications of batches. jobSubscriptions may be nil if jobs can't request
+// notifications via annotations.
+func NewSink(config configuration.WebhookConfig, jobSubscriptions JobSubscriptionStore, deadLetterLog *DeadLetterLog) ingest.Sink[*model.NotificationBatch] {
+	return &Sink{
+		client: &http.Client{
+			Timeout: config.Timeout,
+			// The URLs jobs ask to be notified at are checked against the allowed prefixes, which a redirect would bypass.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		config:           config,
+		jobSubscriptions: jobSubscriptions,
+		deadLetterLog:    deadLetterLog,
+	}
+}
+
+func (s *Sink) Store(ctx *armadacontext.Context, batch *model.NotificationBatch) error {
+	notifications, err := s.withJobNotifications(ctx, batch)
+	if err != nil {
+		return err
+	}
+	s.deliverAll(ctx, notifications)
+	if s.jobSubscriptions == nil {
+		return nil
+	}
+	var finishedJobIds []string
+	for _, jobEvent := range batch.JobEvents {
+		if jobEvent.Terminal {
+			finishedJobIds = append(finishedJobIds, jobEvent.Payload.JobId)
+		}
+	}
+	return s.jobSubscriptions.Delete(ctx, finishedJobIds)
+}
+
+// withJobNotifications stores the subscriptions requested by the jobs of batch and returns the notifications of the
+// batch, together with those of the events of jobs with subscriptions to them, in the order of their events.
+func (s *Sink) withJobNotifications(ctx *armadacontext.Context, batch *model.NotificationBatch) ([]*model.Notification, error) {
+	if s.jobSubscriptions == nil || len(batch.JobEvents) == 0 {
+		return batch.Notifications, nil
+	}
+	if err := s.jobSubscriptions.Upsert(ctx, batch.JobSubscriptions); err != nil {
+		return nil, err
+	}
+	jobIds := make([]string, 0, len(batch.JobEvents))
+	for _, jobEvent := range batch.JobEvents {
+		jobIds = append(jobIds, jobEvent.Payload.JobId)
+	}
+	subscriptions, err := s.jobSubscriptions.Get(ctx, armadaslices.Unique(jobIds))
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]*model.Notification, 0, len(batch.Notifications))
+	position := 0
+	for _, jobEvent := range batch.JobEvents {
+		subscription, ok := subscriptions[jobEvent.Payload.JobId]
+		if !ok || !slices.Contains(subscription.States, jobEvent.Payload.State) {
+			continue
+		}
+		notifications = append(notifications, batch.Notifications[position:jobEvent.Position]...)
+		position = jobEvent.Position
+		notifications = append(notifications, &model.Notification{
+			Subscription: convert.AnnotationSubscription,
+			Url:          subscription.Url,
+			Payload:      jobEvent.Payload,
+		})
+	}
+	return append(notifications, batch.Notifications[position:]...), nil
+}
+
+// deliverAll delivers notifications, giving up on those to each URL not delivered within the maximum delivery time.
+func (s *Sink) deliverAll(ctx *armadacontext.Context, notifications []*model.Notification) {
+	notificationsByUrl := make(map[string][]*model.Notification)
+	for _, notification := range notifications {
+		notificationsByUrl[notification.Url] = append(notificationsByUrl[notification.Url], notification)
+	}
+	wg := sync.WaitGroup{}
+	for _, notifications := range notificationsByUrl {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deliveryCtx, cancel := armadacontext.WithTimeout(ctx, s.config.MaxDeliveryTime)
+			defer cancel()
+			for _, notification := range notifications {
+				s.deliver(deliveryCtx, notification)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deliver posts a notification to its webhook, retrying until it's delivered or the maximum number of attempts is
+// reached, in which case it's written to the dead-letter log.
+func (s *Sink) deliver(ctx *armadacontext.Context, notification *model.Notification) {
+	body, err := json.Marshal(notification.Payload)
+	if err != nil {
+		s.deadLetter(notification, 0, errors.WithStack(err))
+		return
+	}
+	if ctx.Err() != nil {
+		s.deadLetter(notification, 0, ctx.Err())
+		return
+	}
+	backoff := s.config.InitialRetryBackoff
+	for attempt := 1; ; attempt++ {
+		metrics.RecordDeliveryAttempt(notification.Subscription)
+		retryable, err := s.post(ctx, notification, body)
+		if err == nil {
+			metrics.RecordNotification(notification.Subscription, metrics.ResultDelivered)
+			return
+		}
+		if !retryable || attempt >= s.config.MaxAttempts {
+			s.deadLetter(notification, attempt, err)
+			return
+		}
+		log.WithError(err).Warnf("Failed to deliver notification %s to %s, will retry in %s", notification.Payload.Id, notification.Url, backoff)
+		select {
+		case <-ctx.Done():
+			s.deadLetter(notification, attempt, ctx.Err())
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, s.config.MaxRetryBackoff)
+	}
+}
+
+// post makes a single attempt to deliver a notification, returning whether a failed attempt should be retried.
+func (s *Sink) post(ctx *armadacontext.Context, notification *model.Notification, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, notification.Payload.Id)
+	if s.config.SigningKey != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign([]byte(s.config.SigningKey), body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	// Other client errors mean the request will never succeed.
+	retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout
+	return retryable, fmt.Errorf("webhook responded with status %s", resp.Status)
+}
+
+func (s *Sink) deadLetter(notification *model.Notification, attempts int, err error) {
+	metrics.RecordNotification(notification.Subscription, metrics.ResultDeadLetter)
+	log.WithError(err).Errorf("Failed to deliver notification %s to %s after %d attempts", notification.Payload.Id, notification.Url, attempts)
+	if err := s.deadLetterLog.Record(notification, attempts, err); err != nil {
+		log.WithError(err).Errorf("Failed to write notification %s to the dead-letter log", notification.Payload.Id)
+	}
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of body, as sent in the SignatureHeader.
+func Sign(key []byte, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeadLetterLog records notifications that couldn't be delivered, one JSON object per line.
+type DeadLetterLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+type deadLetter struct {
+	Subscription string        `json:"subscription"`
+	Url          string        `json:"url"`
+	Attempts     int           `json:"attempts"`
+	Error        string        `json:"error"`
+	Payload      model.Payload `json:"payload"`
+}
+
+// NewDeadLetterLog returns a DeadLetterLog writing to w; if w is nil, undelivered notifications are only logged.
+func NewDeadLetterLog(w io.Writer) *DeadLetterLog {
+	return &DeadLetterLog{w: w}
+}
+
+func (l *DeadLetterLog) Record(notification *model.Notification, attempts int, err error) error {
+	if l.w == nil {
+		return nil
+	}
+	line, marshalErr := json.Marshal(deadLetter{
+		Subscription: notification.Subscription,
+		Url:          notification.Url,
+		Attempts:     attempts,
+		Error:        err.Error(),
+		Payload:      notification.Payload,
+	})
+	if marshalErr != nil {
+		return errors.WithStack(marshalErr)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, writeErr := l.w.Write(append(line, '\n'))
+	return errors.WithStack(writeErr)
+}
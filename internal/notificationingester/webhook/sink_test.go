@@ -0,0 +1,267 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/notificationingester/configuration"
+	"github.com/armadaproject/armada/internal/notificationingester/model"
+)
+
+const signingKey = "secret"
+
+// webhookStandIn records the notifications it receives, failing the first few requests with the given status.
+type webhookStandIn struct {
+	mu               sync.Mutex
+	failures         int
+	failureStatus    int
+	requests         int
+	receivedIds      []string
+	validSignatures  bool
+	receivedPayloads []model.Payload
+}
+
+func (w *webhookStandIn) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.requests++
+	if w.requests <= w.failures {
+		rw.WriteHeader(w.failureStatus)
+		return
+	}
+	body, _ := io.ReadAll(req.Body)
+	w.validSignatures = req.Header.Get(SignatureHeader) == "sha256="+Sign([]byte(signingKey), body)
+	var payload model.Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.receivedIds = append(w.receivedIds, req.Header.Get(DeliveryHeader))
+	w.receivedPayloads = append(w.receivedPayloads, payload)
+}
+
+func notification(url string, id string) *model.Notification {
+	return &model.Notification{
+		Subscription: "test",
+		Url:          url,
+		Payload: model.Payload{
+			Id:    id,
+			Queue: "queue-a",
+			JobId: "job-1",
+			State: "failed",
+			Time:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Event: json.RawMessage(`{"jobId":"job-1"}`),
+		},
+	}
+}
+
+func TestSink_Store(t *testing.T) {
+	tests := map[string]struct {
+		failures            int
+		failureStatus       int
+		expectedIds         []string
+		expectedDeadLetters int
+	}{
+		"delivered": {
+			expectedIds: []string{"1", "2"},
+		},
+		"delivered after retries": {
+			failures:      2,
+			failureStatus: http.StatusServiceUnavailable,
+			expectedIds:   []string{"1", "2"},
+		},
+		"retries exhausted": {
+			failures:            3,
+			failureStatus:       http.StatusServiceUnavailable,
+			expectedIds:         []string{"2"},
+			expectedDeadLetters: 1,
+		},
+		"not retried on client error": {
+			failures:            1,
+			failureStatus:       http.StatusNotFound,
+			expectedIds:         []string{"2"},
+			expectedDeadLetters: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			standIn := &webhookStandIn{failures: tc.failures, failureStatus: tc.failureStatus}
+			server := httptest.NewServer(standIn)
+			defer server.Close()
+			var deadLetters bytes.Buffer
+			sink := NewSink(configuration.WebhookConfig{
+				SigningKey:          signingKey,
+				Timeout:             time.Second,
+				MaxAttempts:         3,
+				InitialRetryBackoff: time.Millisecond,
+				MaxRetryBackoff:     time.Millisecond,
+				MaxDeliveryTime:     time.Minute,
+			}, nil, NewDeadLetterLog(&deadLetters))
+
+			err := sink.Store(armadacontext.Background(), &model.NotificationBatch{
+				Notifications: []*model.Notification{notification(server.URL, "1"), notification(server.URL, "2")},
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedIds, standIn.receivedIds)
+			assert.True(t, standIn.validSignatures)
+			assert.Equal(t, "queue-a", standIn.receivedPayloads[0].Queue)
+			assert.JSONEq(t, `{"jobId":"job-1"}`, string(standIn.receivedPayloads[0].Event))
+
+			lines := bytes.Split(bytes.TrimSpace(deadLetters.Bytes()), []byte("\n"))
+			if tc.expectedDeadLetters == 0 {
+				assert.Empty(t, deadLetters.Bytes())
+				return
+			}
+			require.Len(t, lines, tc.expectedDeadLetters)
+			var entry deadLetter
+			require.NoError(t, json.Unmarshal(lines[0], &entry))
+			assert.Equal(t, server.URL, entry.Url)
+			assert.Equal(t, "1", entry.Payload.Id)
+			assert.Contains(t, entry.Error, http.StatusText(tc.failureStatus))
+		})
+	}
+}
+
+func TestSink_Store_UnreachableWebhook(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+	var deadLetters bytes.Buffer
+	sink := NewSink(configuration.WebhookConfig{Timeout: time.Second, MaxAttempts: 2, MaxDeliveryTime: time.Minute}, nil, NewDeadLetterLog(&deadLetters))
+
+	require.NoError(t, sink.Store(armadacontext.Background(), &model.NotificationBatch{
+		Notifications: []*model.Notification{notification(url, "1")},
+	}))
+
+	var entry deadLetter
+	require.NoError(t, json.Unmarshal(deadLetters.Bytes(), &entry))
+	assert.Equal(t, 2, entry.Attempts)
+}
+
+func TestSink_Store_RedirectNotFollowed(t *testing.T) {
+	standIn := &webhookStandIn{}
+	target := httptest.NewServer(standIn)
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+	var deadLetters bytes.Buffer
+	sink := NewSink(configuration.WebhookConfig{Timeout: time.Second, MaxAttempts: 2, MaxDeliveryTime: time.Minute}, nil, NewDeadLetterLog(&deadLetters))
+
+	require.NoError(t, sink.Store(armadacontext.Background(), &model.NotificationBatch{
+		Notifications: []*model.Notification{notification(server.URL, "1")},
+	}))
+
+	assert.Zero(t, standIn.requests)
+	var entry deadLetter
+	require.NoError(t, json.Unmarshal(deadLetters.Bytes(), &entry))
+	assert.Equal(t, 1, entry.Attempts)
+	assert.Contains(t, entry.Error, http.StatusText(http.StatusTemporaryRedirect))
+}
+
+func TestSink_Store_MaxDeliveryTime(t *testing.T) {
+	// A webhook that never responds doesn't hold up delivery to others for longer than the maximum delivery time.
+	unblock := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { <-unblock }))
+	defer hanging.Close()
+	defer close(unblock)
+	standIn := &webhookStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+	var deadLetters bytes.Buffer
+	sink := NewSink(configuration.WebhookConfig{
+		Timeout:         time.Minute,
+		MaxAttempts:     3,
+		MaxDeliveryTime: 100 * time.Millisecond,
+	}, nil, NewDeadLetterLog(&deadLetters))
+
+	start := time.Now()
+	require.NoError(t, sink.Store(armadacontext.Background(), &model.NotificationBatch{
+		Notifications: []*model.Notification{
+			notification(hanging.URL, "1"),
+			notification(hanging.URL, "2"),
+			notification(server.URL, "3"),
+		},
+	}))
+	assert.Less(t, time.Since(start), 10*time.Second)
+	assert.Equal(t, []string{"3"}, standIn.receivedIds)
+	assert.Len(t, bytes.Split(bytes.TrimSpace(deadLetters.Bytes()), []byte("\n")), 2)
+}
+
+// inMemoryJobSubscriptions is a JobSubscriptionStore holding subscriptions in memory.
+type inMemoryJobSubscriptions map[string]*model.JobSubscription
+
+func (s inMemoryJobSubscriptions) Upsert(_ *armadacontext.Context, subscriptions []*model.JobSubscription) error {
+	for _, subscription := range subscriptions {
+		if _, ok := s[subscription.JobId]; !ok {
+			s[subscription.JobId] = subscription
+		}
+	}
+	return nil
+}
+
+func (s inMemoryJobSubscriptions) Get(_ *armadacontext.Context, jobIds []string) (map[string]*model.JobSubscription, error) {
+	result := make(map[string]*model.JobSubscription)
+	for _, jobId := range jobIds {
+		if subscription, ok := s[jobId]; ok {
+			result[jobId] = subscription
+		}
+	}
+	return result, nil
+}
+
+func (s inMemoryJobSubscriptions) Delete(_ *armadacontext.Context, jobIds []string) error {
+	for _, jobId := range jobIds {
+		delete(s, jobId)
+	}
+	return nil
+}
+
+func TestSink_Store_JobSubscriptions(t *testing.T) {
+	standIn := &webhookStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+	jobEvent := func(jobId string, id string, position int, terminal bool) *model.JobEvent {
+		payload := notification(server.URL, id).Payload
+		payload.JobId = jobId
+		return &model.JobEvent{Payload: payload, Position: position, Terminal: terminal}
+	}
+	// job-1 subscribed in an earlier batch, and job-2 subscribes in this one; job-3 hasn't subscribed.
+	jobSubscriptions := inMemoryJobSubscriptions{
+		"job-1": {JobId: "job-1", Url: server.URL, States: []string{"failed"}},
+	}
+	sink := NewSink(configuration.WebhookConfig{
+		Timeout:         time.Second,
+		MaxAttempts:     1,
+		MaxDeliveryTime: time.Minute,
+	}, jobSubscriptions, NewDeadLetterLog(nil))
+
+	require.NoError(t, sink.Store(armadacontext.Background(), &model.NotificationBatch{
+		Notifications: []*model.Notification{notification(server.URL, "queue-1"), notification(server.URL, "queue-2")},
+		JobSubscriptions: []*model.JobSubscription{
+			{JobId: "job-2", Url: server.URL, States: []string{"failed"}},
+		},
+		JobEvents: []*model.JobEvent{
+			jobEvent("job-1", "job-1", 0, true),
+			jobEvent("job-3", "job-3", 1, true),
+			jobEvent("job-2", "job-2", 1, false),
+		},
+	}))
+
+	// Notifications of jobs' subscriptions are delivered in the order of their events.
+	assert.Equal(t, []string{"job-1", "queue-1", "job-2", "queue-2"}, standIn.receivedIds)
+	// Subscriptions of finished jobs are deleted.
+	assert.Equal(t, inMemoryJobSubscriptions{
+		"job-2": {JobId: "job-2", Url: server.URL, States: []string{"failed"}},
+	}, jobSubscriptions)
+}
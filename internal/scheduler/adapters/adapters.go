@@ -25,6 +25,9 @@ func PodRequirementsFromPodSpec(podSpec *v1.PodSpec) *schedulerobjects.PodRequir
 		}),
 		PreemptionPolicy:     preemptionPolicy,
 		ResourceRequirements: api.SchedulingResourceRequirementsFromPodSpec(podSpec),
+		TopologySpreadConstraints: armadaslices.Map(podSpec.TopologySpreadConstraints, func(c v1.TopologySpreadConstraint) *v1.TopologySpreadConstraint {
+			return &c
+		}),
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/maps"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	priorityTypes "github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -84,6 +85,7 @@ func NewExecutorApi(publisher pulsarutils.Publisher[*armadaevents.EventSequence]
 // LeaseJobRuns reconciles the state of the executor with that of the scheduler. Specifically it:
 // 1. Stores job and capacity information received from the executor to make it available to the scheduler.
 // 2. Notifies the executor if any of its jobs are no longer active, e.g., due to being preempted by the scheduler.
+// Preempted runs are sent separately from other inactive runs, so the executor can give them notice before killing them.
 // 3. Transfers any jobs scheduled on this executor cluster that the executor don't already have.
 func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRunsServer) error {
 	// Receive once to get info necessary to get jobs to lease.
@@ -111,13 +113,18 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 	if err != nil {
 		return err
 	}
+	runsToPreempt, err := srv.jobRepository.FindPreemptedRuns(ctx, requestRuns)
+	if err != nil {
+		return err
+	}
+	runsToCancel = armadaslices.Subtract(runsToCancel, runsToPreempt)
 	newRuns, err := srv.jobRepository.FetchJobRunLeases(ctx, req.ExecutorId, uint(req.MaxJobsToLease), requestRuns)
 	if err != nil {
 		return err
 	}
 	ctx.Infof(
-		"Executor currently has %d job runs; sending %d cancellations and %d new runs",
-		len(requestRuns), len(runsToCancel), len(newRuns),
+		"Executor currently has %d job runs; sending %d cancellations, %d preemptions and %d new runs",
+		len(requestRuns), len(runsToCancel), len(runsToPreempt), len(newRuns),
 	)
 
 	// Send any runs that should be cancelled.
@@ -133,6 +140,19 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 		}
 	}
 
+	// Send any runs that should be preempted.
+	if len(runsToPreempt) > 0 {
+		if err := stream.Send(&executorapi.LeaseStreamMessage{
+			Event: &executorapi.LeaseStreamMessage_PreemptRuns{
+				PreemptRuns: &executorapi.PreemptRuns{
+					JobRunIdsToPreempt: runsToPreempt,
+				},
+			},
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
 	// Send any scheduled jobs the executor doesn't already have.
 	decompressor := compress.NewZlibDecompressor()
 	for _, lease := range newRuns {
@@ -154,6 +174,7 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 		}
 
 		srv.addPreemptibleLabel(submitMsg)
+		srv.addPreemptionNoticePeriodAnnotation(submitMsg)
 
 		srv.dropDisallowedResources(submitMsg.MainObject.GetPodSpec().PodSpec)
 
@@ -224,6 +245,30 @@ func (srv *ExecutorApi) addPreemptibleLabel(job *armadaevents.SubmitJob) {
 	addLabels(job, labels)
 }
 
+// addPreemptionNoticePeriodAnnotation tells the executor how much notice to give the job before preempting it.
+// Preemption notice hooks are dropped from jobs that aren't given notice.
+func (srv *ExecutorApi) addPreemptionNoticePeriodAnnotation(job *armadaevents.SubmitJob) {
+	if job.ObjectMeta != nil {
+		// Only the scheduler may set the notice period, and only the executor the deadline.
+		delete(job.ObjectMeta.Annotations, configuration.PreemptionNoticePeriodAnnotation)
+		delete(job.ObjectMeta.Annotations, configuration.PreemptionNoticeDeadlineAnnotation)
+	}
+	var priorityClass priorityTypes.PriorityClass
+	if podSpec := job.GetMainObject().GetPodSpec().GetPodSpec(); podSpec != nil {
+		priorityClass = srv.priorityClasses[podSpec.PriorityClassName]
+	}
+	if priorityClass.PreemptionNoticePeriod <= 0 {
+		if job.ObjectMeta != nil {
+			delete(job.ObjectMeta.Annotations, configuration.PreemptionNoticeExecHookAnnotation)
+			delete(job.ObjectMeta.Annotations, configuration.PreemptionNoticeHttpHookAnnotation)
+		}
+		return
+	}
+	addAnnotations(job, map[string]string{
+		configuration.PreemptionNoticePeriodAnnotation: priorityClass.PreemptionNoticePeriod.String(),
+	})
+}
+
 // Drop non-supported resources. This is needed to ensure floating resources
 // are not passed to k8s.
 func (srv *ExecutorApi) dropDisallowedResources(pod *v1.PodSpec) {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
@@ -41,13 +42,15 @@ import (
 const nodeIdName = "kubernetes.io/hostname"
 
 const (
-	armadaDefaultPriorityClassName     = "armada-default"
-	armadaPreemptiblePriorityClassName = "armada-preemptible"
+	armadaDefaultPriorityClassName      = "armada-default"
+	armadaPreemptiblePriorityClassName  = "armada-preemptible"
+	armadaCheckpointedPriorityClassName = "armada-checkpointed"
 )
 
 var priorityClasses = map[string]types.PriorityClass{
-	armadaDefaultPriorityClassName:     {Preemptible: false},
-	armadaPreemptiblePriorityClassName: {Preemptible: true},
+	armadaDefaultPriorityClassName:      {Preemptible: false},
+	armadaPreemptiblePriorityClassName:  {Preemptible: true},
+	armadaCheckpointedPriorityClassName: {Preemptible: true, PreemptionNoticePeriod: 5 * time.Minute},
 }
 
 func TestExecutorApi_LeaseJobRuns(t *testing.T) {
@@ -155,6 +158,46 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 		SubmitMessage: preemptibleCompressedSubmit,
 	}
 
+	// The notice period is set by the scheduler, overriding any set by the user, and the deadline is left to the executor.
+	submitWithUserNotice, noticeCompressedSubmit := submitMsg(
+		t,
+		&armadaevents.ObjectMeta{
+			Annotations: map[string]string{
+				configuration.PreemptionNoticePeriodAnnotation:   "1h",
+				configuration.PreemptionNoticeDeadlineAnnotation: "2030-01-01T00:00:00Z",
+				configuration.PreemptionNoticeHttpHookAnnotation: "8080/checkpoint",
+			},
+		},
+		&v1.PodSpec{
+			PriorityClassName: armadaCheckpointedPriorityClassName,
+		},
+	)
+	noticeSubmit, _ := submitMsg(
+		t,
+		&armadaevents.ObjectMeta{
+			Labels: map[string]string{armadaJobPreemptibleLabel: "true"},
+			Annotations: map[string]string{
+				configuration.PoolAnnotation:                     "test-pool",
+				configuration.PreemptionNoticePeriodAnnotation:   "5m0s",
+				configuration.PreemptionNoticeHttpHookAnnotation: "8080/checkpoint",
+			},
+		},
+		&v1.PodSpec{
+			PriorityClassName: armadaCheckpointedPriorityClassName,
+			NodeSelector:      map[string]string{nodeIdName: "node-id"},
+		},
+	)
+	noticeLease := &database.JobRunLease{
+		RunID:         uuid.NewString(),
+		Queue:         "test-queue",
+		Pool:          "test-pool",
+		JobSet:        "test-jobset",
+		UserID:        "test-user",
+		Node:          "node-id",
+		Groups:        compressedGroups,
+		SubmitMessage: noticeCompressedSubmit,
+	}
+
 	tolerations := []v1.Toleration{
 		{
 			Key:    "whale",
@@ -194,10 +237,12 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 		},
 	)
 	submitWithOverlay.JobId = submit.JobId
+	noticeSubmit.JobId = submitWithUserNotice.JobId
 
 	tests := map[string]struct {
 		request          *executorapi.LeaseRequest
 		runsToCancel     []string
+		runsToPreempt    []string
 		leases           []*database.JobRunLease
 		expectedExecutor *schedulerobjects.Executor
 		expectedMsgs     []*executorapi.LeaseStreamMessage
@@ -228,6 +273,38 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				},
 			},
 		},
+		"preempt, cancel and lease with preemption notice": {
+			request:          defaultRequest,
+			runsToCancel:     []string{runId1, runId2},
+			runsToPreempt:    []string{runId2},
+			leases:           []*database.JobRunLease{noticeLease},
+			expectedExecutor: defaultExpectedExecutor,
+			expectedMsgs: []*executorapi.LeaseStreamMessage{
+				{
+					Event: &executorapi.LeaseStreamMessage_CancelRuns{CancelRuns: &executorapi.CancelRuns{
+						JobRunIdsToCancel: []string{runId1},
+					}},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_PreemptRuns{PreemptRuns: &executorapi.PreemptRuns{
+						JobRunIdsToPreempt: []string{runId2},
+					}},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_Lease{Lease: &executorapi.JobRunLease{
+						JobRunId: noticeLease.RunID,
+						Queue:    noticeLease.Queue,
+						Jobset:   noticeLease.JobSet,
+						User:     noticeLease.UserID,
+						Groups:   groups,
+						Job:      noticeSubmit,
+					}},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_End{End: &executorapi.EndMarker{}},
+				},
+			},
+		},
 		"no node selector when missing in lease": {
 			request:          defaultRequest,
 			leases:           []*database.JobRunLease{leaseWithoutNode},
@@ -319,6 +396,7 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				return nil
 			}).Times(1)
 			mockJobRepository.EXPECT().FindInactiveRuns(gomock.Any(), schedulermocks.SliceMatcher{Expected: runIds}).Return(tc.runsToCancel, nil).Times(1)
+			mockJobRepository.EXPECT().FindPreemptedRuns(gomock.Any(), schedulermocks.SliceMatcher{Expected: runIds}).Return(tc.runsToPreempt, nil).Times(1)
 			mockJobRepository.EXPECT().FetchJobRunLeases(gomock.Any(), tc.request.ExecutorId, maxJobsPerCall, runIds).Return(tc.leases, nil).Times(1)
 			mockAuthorizer.EXPECT().AuthorizeAction(gomock.Any(), permission.Permission(permissions.ExecuteJobs)).Return(nil).Times(1)
 
@@ -462,6 +540,53 @@ func TestAddNodeSelector(t *testing.T) {
 	}
 }
 
+func TestAddPreemptionNoticePeriodAnnotation(t *testing.T) {
+	userAnnotations := map[string]string{
+		configuration.PreemptionNoticePeriodAnnotation:   "1h",
+		configuration.PreemptionNoticeDeadlineAnnotation: "2030-01-01T00:00:00Z",
+		configuration.PreemptionNoticeExecHookAnnotation: "checkpoint",
+		configuration.PreemptionNoticeHttpHookAnnotation: "8080/checkpoint",
+	}
+	tests := map[string]struct {
+		priorityClassName string
+		expected          map[string]string
+	}{
+		"notice period": {
+			priorityClassName: armadaCheckpointedPriorityClassName,
+			expected: map[string]string{
+				configuration.PreemptionNoticePeriodAnnotation:   "5m0s",
+				configuration.PreemptionNoticeExecHookAnnotation: "checkpoint",
+				configuration.PreemptionNoticeHttpHookAnnotation: "8080/checkpoint",
+			},
+		},
+		"no notice period": {
+			priorityClassName: armadaPreemptiblePriorityClassName,
+			expected:          map[string]string{},
+		},
+		"unknown priority class": {
+			priorityClassName: "unknown",
+			expected:          map[string]string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			job := &armadaevents.SubmitJob{
+				ObjectMeta: &armadaevents.ObjectMeta{Annotations: maps.Clone(userAnnotations)},
+				MainObject: &armadaevents.KubernetesMainObject{
+					Object: &armadaevents.KubernetesMainObject_PodSpec{
+						PodSpec: &armadaevents.PodSpecWithAvoidList{
+							PodSpec: &v1.PodSpec{PriorityClassName: tc.priorityClassName},
+						},
+					},
+				},
+			}
+			srv := &ExecutorApi{priorityClasses: priorityClasses}
+			srv.addPreemptionNoticePeriodAnnotation(job)
+			assert.Equal(t, tc.expected, job.ObjectMeta.Annotations)
+		})
+	}
+}
+
 func TestExecutorApi_Publish(t *testing.T) {
 	tests := map[string]struct {
 		sequences []*armadaevents.EventSequence
@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
@@ -19,6 +20,8 @@ type ExecutorRepository interface {
 	GetExecutors(ctx *armadacontext.Context) ([]*schedulerobjects.Executor, error)
 	// GetExecutorSettings returns all defined executor settings
 	GetExecutorSettings(ctx *armadacontext.Context) ([]*schedulerobjects.ExecutorSettings, error)
+	// GetNodeDrains returns all node drains currently in effect
+	GetNodeDrains(ctx *armadacontext.Context) ([]*schedulerobjects.NodeDrain, error)
 	// GetLastUpdateTimes returns a map of executor name -> last heartbeat time
 	GetLastUpdateTimes(ctx *armadacontext.Context) (map[string]time.Time, error)
 	// StoreExecutor persists the latest executor state
@@ -118,6 +121,34 @@ func (r *PostgresExecutorRepository) GetExecutorSettings(ctx *armadacontext.Cont
 	return executorSettings, nil
 }
 
+func (r *PostgresExecutorRepository) GetNodeDrains(ctx *armadacontext.Context) ([]*schedulerobjects.NodeDrain, error) {
+	queries := New(r.db)
+	results, err := queries.SelectAllNodeDrains(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	drains := make([]*schedulerobjects.NodeDrain, 0, len(results))
+	for _, result := range results {
+		// The selector is stored in the canonical form produced by labels.Set.String
+		nodeSelector, err := labels.ConvertSelectorToLabelsMap(result.NodeSelector)
+		if err != nil {
+			// Skip the drain rather than failing every scheduling cycle because of it.
+			ctx.Logger().WithError(err).Errorf("Ignoring drain on executor %s with invalid node selector %q", result.ExecutorID, result.NodeSelector)
+			continue
+		}
+		drains = append(drains, &schedulerobjects.NodeDrain{
+			ExecutorId:   result.ExecutorID,
+			NodeName:     result.NodeName,
+			NodeSelector: nodeSelector,
+			Preempt:      result.Preempt,
+			Reason:       result.Reason,
+			SetByUser:    result.SetByUser,
+			SetAtTime:    protoutil.ToTimestamp(result.SetAtTime),
+		})
+	}
+	return drains, nil
+}
+
 func decompressAndMarshall(b []byte, decompressor compress.Decompressor, msg proto.Message) error {
 	decompressed, err := decompressor.Decompress(b)
 	if err != nil {
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -132,6 +133,64 @@ func TestExecutorRepository_GetLastUpdateTimes(t *testing.T) {
 	}
 }
 
+func TestExecutorRepository_GetNodeDrains(t *testing.T) {
+	t1 := time.Now().UTC().Round(1 * time.Microsecond) // postgres only stores times with micro precision
+	err := withExecutorRepository(func(repo *PostgresExecutorRepository) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+		defer cancel()
+		queries := New(repo.db)
+		require.NoError(t, queries.UpsertNodeDrain(ctx, UpsertNodeDrainParams{
+			ExecutorID: "test-executor-1",
+			NodeName:   "test-node-1",
+			Preempt:    true,
+			Reason:     "maintenance",
+			SetByUser:  "user",
+			SetAtTime:  t1,
+		}))
+		require.NoError(t, queries.UpsertNodeDrain(ctx, UpsertNodeDrainParams{
+			ExecutorID:   "test-executor-2",
+			NodeSelector: "rack=a,zone=b",
+			Reason:       "maintenance",
+			SetByUser:    "user",
+			SetAtTime:    t1,
+		}))
+		// Drains with invalid selectors are skipped.
+		require.NoError(t, queries.UpsertNodeDrain(ctx, UpsertNodeDrainParams{
+			ExecutorID:   "test-executor-3",
+			NodeSelector: "rack=a/b",
+			Reason:       "maintenance",
+			SetByUser:    "user",
+			SetAtTime:    t1,
+		}))
+
+		drains, err := repo.GetNodeDrains(ctx)
+		require.NoError(t, err)
+		slices.SortFunc(drains, func(a, b *schedulerobjects.NodeDrain) int {
+			return strings.Compare(a.ExecutorId, b.ExecutorId)
+		})
+		assert.Equal(t, []*schedulerobjects.NodeDrain{
+			{
+				ExecutorId:   "test-executor-1",
+				NodeName:     "test-node-1",
+				NodeSelector: map[string]string{},
+				Preempt:      true,
+				Reason:       "maintenance",
+				SetByUser:    "user",
+				SetAtTime:    protoutil.ToTimestamp(t1),
+			},
+			{
+				ExecutorId:   "test-executor-2",
+				NodeSelector: map[string]string{"rack": "a", "zone": "b"},
+				Reason:       "maintenance",
+				SetByUser:    "user",
+				SetAtTime:    protoutil.ToTimestamp(t1),
+			},
+		}, drains)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func withExecutorRepository(action func(repository *PostgresExecutorRepository) error) error {
 	return WithTestDb(func(_ *Queries, db *pgxpool.Pool) error {
 		repo := NewPostgresExecutorRepository(db)
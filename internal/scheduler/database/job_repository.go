@@ -48,6 +48,10 @@ type JobRepository interface {
 	// keyed by job run id. Any dbRuns which don't have errors wil be absent from the map.
 	FetchJobRunErrors(ctx *armadacontext.Context, runIds []string) (map[string]*armadaevents.Error, error)
 
+	// FetchJobOutcomes returns the terminal state of the provided jobs. The returned map is keyed by job id.
+	// Any jobs which don't exist will be absent from the map.
+	FetchJobOutcomes(ctx *armadacontext.Context, jobIds []string) (map[string]JobOutcome, error)
+
 	// CountReceivedPartitions returns a count of the number of partition messages present in the database corresponding
 	// to the provided groupId.  This is used by the scheduler to determine if the database represents the state of
 	// pulsar after a given point in time.
@@ -57,11 +61,28 @@ type JobRepository interface {
 	// Runs are inactive if they don't exist or if they have succeeded, failed or been cancelled
 	FindInactiveRuns(ctx *armadacontext.Context, runIds []string) ([]string, error)
 
+	// FindPreemptedRuns returns a slice containing all dbRuns that have been preempted
+	FindPreemptedRuns(ctx *armadacontext.Context, runIds []string) ([]string, error)
+
 	// FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 	// in excludedRunIds will be excluded
 	FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []string) ([]*JobRunLease, error)
 }
 
+// JobOutcome describes whether a job has reached a terminal state and, if so, which one.
+type JobOutcome struct {
+	// Queue the job was submitted to.
+	Queue     string
+	Succeeded bool
+	Failed    bool
+	Cancelled bool
+}
+
+// Terminal returns true if the job has succeeded, failed or been cancelled.
+func (o JobOutcome) Terminal() bool {
+	return o.Succeeded || o.Failed || o.Cancelled
+}
+
 // PostgresJobRepository is an implementation of JobRepository that stores its state in postgres
 type PostgresJobRepository struct {
 	// pool of database connections
@@ -228,6 +249,31 @@ func (r *PostgresJobRepository) FetchJobRunErrors(ctx *armadacontext.Context, ru
 	return errorsByRunId, err
 }
 
+// FetchJobOutcomes returns the terminal state of the provided jobs.  The returned map is keyed by job id.
+// Any jobs which don't exist will be absent from the map.
+func (r *PostgresJobRepository) FetchJobOutcomes(ctx *armadacontext.Context, jobIds []string) (map[string]JobOutcome, error) {
+	outcomesByJobId := make(map[string]JobOutcome, len(jobIds))
+	if len(jobIds) == 0 {
+		return outcomesByJobId, nil
+	}
+	queries := New(r.db)
+	for _, chunk := range armadaslices.PartitionToMaxLen(jobIds, int(r.batchSize)) {
+		rows, err := queries.SelectJobOutcomesById(ctx, chunk)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, row := range rows {
+			outcomesByJobId[row.JobID] = JobOutcome{
+				Queue:     row.Queue,
+				Succeeded: row.Succeeded,
+				Failed:    row.Failed,
+				Cancelled: row.Cancelled,
+			}
+		}
+	}
+	return outcomesByJobId, nil
+}
+
 // FetchJobUpdates returns all jobs and job dbRuns that have been updated after jobSerial and jobRunSerial respectively
 // These updates are guaranteed to be consistent with each other
 func (r *PostgresJobRepository) FetchJobUpdates(ctx *armadacontext.Context, jobSerial int64, jobRunSerial int64) ([]Job, []Run, error) {
@@ -339,6 +385,43 @@ func (r *PostgresJobRepository) FindInactiveRuns(ctx *armadacontext.Context, run
 	return inactiveRuns, err
 }
 
+// FindPreemptedRuns returns a slice containing all dbRuns that have been preempted
+func (r *PostgresJobRepository) FindPreemptedRuns(ctx *armadacontext.Context, runIds []string) ([]string, error) {
+	var preemptedRuns []string
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{
+		IsoLevel:       pgx.ReadCommitted,
+		AccessMode:     pgx.ReadWrite,
+		DeferrableMode: pgx.Deferrable,
+	}, func(tx pgx.Tx) error {
+		tmpTable, err := insertRunIdsToTmpTable(ctx, tx, runIds)
+		if err != nil {
+			return err
+		}
+
+		query := `
+		SELECT tmp.run_id
+		FROM %s as tmp
+		JOIN runs ON (tmp.run_id = runs.run_id)
+		WHERE runs.preempted = true;`
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(query, tmpTable))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			runId := ""
+			err = rows.Scan(&runId)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			preemptedRuns = append(preemptedRuns, runId)
+		}
+		return nil
+	})
+	return preemptedRuns, err
+}
+
 // FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 // in excludedRunIds will be excluded
 func (r *PostgresJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []string) ([]*JobRunLease, error) {
@@ -584,6 +584,51 @@ func TestFindInactiveRuns(t *testing.T) {
 	}
 }
 
+func TestFindPreemptedRuns(t *testing.T) {
+	runIds := make([]string, 3)
+	for i := 0; i < len(runIds); i++ {
+		runIds[i] = uuid.New().String()
+	}
+
+	tests := map[string]struct {
+		dbRuns            []Run
+		runsToCheck       []string
+		expectedPreempted []string
+	}{
+		"empty database": {
+			runsToCheck:       runIds,
+			expectedPreempted: nil,
+		},
+		"run preempted": {
+			runsToCheck: runIds,
+			dbRuns: []Run{
+				{RunID: runIds[0]},
+				{RunID: runIds[1], Preempted: true, Failed: true},
+				{RunID: runIds[2], Failed: true},
+			},
+			expectedPreempted: []string{runIds[1]},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := withJobRepository(func(repo *PostgresJobRepository) error {
+				ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+
+				// Set up db
+				err := database.UpsertWithTransaction(ctx, repo.db, "runs", tc.dbRuns)
+				require.NoError(t, err)
+
+				preempted, err := repo.FindPreemptedRuns(ctx, tc.runsToCheck)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedPreempted, preempted)
+				cancel()
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestFetchJobRunLeases(t *testing.T) {
 	const executorName = "testExecutor"
 	dbJobs, _ := createTestJobs(5)
@@ -0,0 +1,2 @@
+ALTER TABLE jobs ADD COLUMN array_id text NULL;
+CREATE INDEX IF NOT EXISTS idx_jobs_queue_jobset_arrayid ON jobs (queue, job_set, array_id) WHERE array_id IS NOT NULL;
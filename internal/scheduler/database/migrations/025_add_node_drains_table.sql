@@ -0,0 +1,10 @@
+CREATE TABLE node_drains (
+  executor_id text NOT NULL,
+  node_name text NOT NULL DEFAULT '',
+  node_selector text NOT NULL DEFAULT '',
+  preempt boolean NOT NULL DEFAULT false,
+  reason text NOT NULL DEFAULT '',
+  set_by_user text NOT NULL DEFAULT '',
+  set_at_time timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (executor_id, node_name, node_selector)
+);
@@ -49,6 +49,7 @@ type Job struct {
 	BidPrice                float64   `db:"bid_price"`
 	CancelUser              *string   `db:"cancel_user"`
 	PriceBand               int32     `db:"price_band"`
+	ArrayID                 *string   `db:"array_id"`
 }
 
 type JobRunError struct {
@@ -63,6 +64,16 @@ type Marker struct {
 	Created     time.Time `db:"created"`
 }
 
+type NodeDrain struct {
+	ExecutorID   string    `db:"executor_id"`
+	NodeName     string    `db:"node_name"`
+	NodeSelector string    `db:"node_selector"`
+	Preempt      bool      `db:"preempt"`
+	Reason       string    `db:"reason"`
+	SetByUser    string    `db:"set_by_user"`
+	SetAtTime    time.Time `db:"set_at_time"`
+}
+
 type Run struct {
 	RunID                  string     `db:"run_id"`
 	JobID                  string     `db:"job_id"`
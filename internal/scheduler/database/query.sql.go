@@ -32,6 +32,21 @@ func (q *Queries) DeleteExecutorSettings(ctx context.Context, executorID string)
 	return err
 }
 
+const deleteNodeDrain = `-- name: DeleteNodeDrain :exec
+DELETE FROM node_drains WHERE executor_id = $1::text AND node_name = $2::text AND node_selector = $3::text
+`
+
+type DeleteNodeDrainParams struct {
+	ExecutorID   string `db:"executor_id"`
+	NodeName     string `db:"node_name"`
+	NodeSelector string `db:"node_selector"`
+}
+
+func (q *Queries) DeleteNodeDrain(ctx context.Context, arg DeleteNodeDrainParams) error {
+	_, err := q.db.Exec(ctx, deleteNodeDrain, arg.ExecutorID, arg.NodeName, arg.NodeSelector)
+	return err
+}
+
 const deleteOldMarkers = `-- name: DeleteOldMarkers :exec
 DELETE FROM markers WHERE created < $1::timestamptz
 `
@@ -141,6 +156,27 @@ func (q *Queries) MarkJobRunsSucceededById(ctx context.Context, runIds []string)
 	return err
 }
 
+const markJobsCancelRequestedByArrayId = `-- name: MarkJobsCancelRequestedByArrayId :exec
+UPDATE jobs SET cancel_requested = true, cancel_user = $1 WHERE queue = $2 and job_set = $3 and array_id = ANY($4::text[]) and cancelled = false and succeeded = false and failed = false
+`
+
+type MarkJobsCancelRequestedByArrayIdParams struct {
+	CancelUser *string  `db:"cancel_user"`
+	Queue      string   `db:"queue"`
+	JobSet     string   `db:"job_set"`
+	ArrayIds   []string `db:"array_ids"`
+}
+
+func (q *Queries) MarkJobsCancelRequestedByArrayId(ctx context.Context, arg MarkJobsCancelRequestedByArrayIdParams) error {
+	_, err := q.db.Exec(ctx, markJobsCancelRequestedByArrayId,
+		arg.CancelUser,
+		arg.Queue,
+		arg.JobSet,
+		arg.ArrayIds,
+	)
+	return err
+}
+
 const markJobsCancelRequestedById = `-- name: MarkJobsCancelRequestedById :exec
 UPDATE jobs SET cancel_requested = true, cancel_user = $1 WHERE queue = $2 and job_set = $3 and job_id = ANY($4::text[]) and cancelled = false and succeeded = false and failed = false
 `
@@ -321,6 +357,38 @@ func (q *Queries) SelectAllMarkers(ctx context.Context) ([]Marker, error) {
 	return items, nil
 }
 
+const selectAllNodeDrains = `-- name: SelectAllNodeDrains :many
+SELECT executor_id, node_name, node_selector, preempt, reason, set_by_user, set_at_time FROM node_drains
+`
+
+func (q *Queries) SelectAllNodeDrains(ctx context.Context) ([]NodeDrain, error) {
+	rows, err := q.db.Query(ctx, selectAllNodeDrains)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeDrain
+	for rows.Next() {
+		var i NodeDrain
+		if err := rows.Scan(
+			&i.ExecutorID,
+			&i.NodeName,
+			&i.NodeSelector,
+			&i.Preempt,
+			&i.Reason,
+			&i.SetByUser,
+			&i.SetAtTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectAllRunErrors = `-- name: SelectAllRunErrors :many
 SELECT run_id, job_id, error FROM job_run_errors
 `
@@ -526,8 +594,46 @@ func (q *Queries) SelectInitialRuns(ctx context.Context, arg SelectInitialRunsPa
 	return items, nil
 }
 
+const selectJobOutcomesById = `-- name: SelectJobOutcomesById :many
+SELECT job_id, queue, succeeded, failed, cancelled FROM jobs WHERE job_id = ANY($1::text[])
+`
+
+type SelectJobOutcomesByIdRow struct {
+	JobID     string `db:"job_id"`
+	Queue     string `db:"queue"`
+	Succeeded bool   `db:"succeeded"`
+	Failed    bool   `db:"failed"`
+	Cancelled bool   `db:"cancelled"`
+}
+
+func (q *Queries) SelectJobOutcomesById(ctx context.Context, jobIds []string) ([]SelectJobOutcomesByIdRow, error) {
+	rows, err := q.db.Query(ctx, selectJobOutcomesById, jobIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectJobOutcomesByIdRow
+	for rows.Next() {
+		var i SelectJobOutcomesByIdRow
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Queue,
+			&i.Succeeded,
+			&i.Failed,
+			&i.Cancelled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectJobsByExecutorAndQueues = `-- name: SelectJobsByExecutorAndQueues :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.array_id
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -575,6 +681,7 @@ func (q *Queries) SelectJobsByExecutorAndQueues(ctx context.Context, arg SelectJ
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -660,7 +767,7 @@ func (q *Queries) SelectLatestJobSerial(ctx context.Context) (int64, error) {
 }
 
 const selectLeasedJobsByQueue = `-- name: SelectLeasedJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.array_id
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -707,6 +814,7 @@ func (q *Queries) SelectLeasedJobsByQueue(ctx context.Context, queue []string) (
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -741,7 +849,7 @@ func (q *Queries) SelectMaxRunSerial(ctx context.Context) (int64, error) {
 }
 
 const selectNewJobs = `-- name: SelectNewJobs :many
-SELECT job_id, job_set, queue, user_id, submitted, groups, priority, queued, queued_version, cancel_requested, cancelled, cancel_by_jobset_requested, succeeded, failed, submit_message, scheduling_info, scheduling_info_version, serial, last_modified, validated, pools, bid_price, cancel_user, price_band FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
+SELECT job_id, job_set, queue, user_id, submitted, groups, priority, queued, queued_version, cancel_requested, cancelled, cancel_by_jobset_requested, succeeded, failed, submit_message, scheduling_info, scheduling_info_version, serial, last_modified, validated, pools, bid_price, cancel_user, price_band, array_id FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
 `
 
 type SelectNewJobsParams struct {
@@ -783,6 +891,7 @@ func (q *Queries) SelectNewJobs(ctx context.Context, arg SelectNewJobsParams) ([
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -907,7 +1016,7 @@ func (q *Queries) SelectNewRunsForJobs(ctx context.Context, arg SelectNewRunsFor
 }
 
 const selectPendingJobsByQueue = `-- name: SelectPendingJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.array_id
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -954,6 +1063,7 @@ func (q *Queries) SelectPendingJobsByQueue(ctx context.Context, queue []string)
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -966,7 +1076,7 @@ func (q *Queries) SelectPendingJobsByQueue(ctx context.Context, queue []string)
 }
 
 const selectQueuedJobsByQueue = `-- name: SelectQueuedJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.array_id
 FROM jobs j
 WHERE j.queue = ANY($1::text[])
   AND j.queued = true
@@ -1006,6 +1116,7 @@ func (q *Queries) SelectQueuedJobsByQueue(ctx context.Context, queue []string) (
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -1043,7 +1154,7 @@ func (q *Queries) SelectRunErrorsById(ctx context.Context, runIds []string) ([]J
 }
 
 const selectRunningJobsByQueue = `-- name: SelectRunningJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.groups, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.submit_message, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.array_id
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -1090,6 +1201,7 @@ func (q *Queries) SelectRunningJobsByQueue(ctx context.Context, queue []string)
 			&i.BidPrice,
 			&i.CancelUser,
 			&i.PriceBand,
+			&i.ArrayID,
 		); err != nil {
 			return nil, err
 		}
@@ -1228,6 +1340,27 @@ func (q *Queries) SetTerminatedTime(ctx context.Context, arg SetTerminatedTimePa
 	return err
 }
 
+const updateJobPriorityByArrayId = `-- name: UpdateJobPriorityByArrayId :exec
+UPDATE jobs SET priority = $1 WHERE queue = $2 and job_set = $3 and array_id = ANY($4::text[]) and cancelled = false and succeeded = false and failed = false
+`
+
+type UpdateJobPriorityByArrayIdParams struct {
+	Priority int64    `db:"priority"`
+	Queue    string   `db:"queue"`
+	JobSet   string   `db:"job_set"`
+	ArrayIds []string `db:"array_ids"`
+}
+
+func (q *Queries) UpdateJobPriorityByArrayId(ctx context.Context, arg UpdateJobPriorityByArrayIdParams) error {
+	_, err := q.db.Exec(ctx, updateJobPriorityByArrayId,
+		arg.Priority,
+		arg.Queue,
+		arg.JobSet,
+		arg.ArrayIds,
+	)
+	return err
+}
+
 const updateJobPriorityById = `-- name: UpdateJobPriorityById :exec
 UPDATE jobs SET priority = $1 WHERE queue = $2 and job_set = $3 and job_id = ANY($4::text[]) and cancelled = false and succeeded = false and failed = false
 `
@@ -1310,3 +1443,37 @@ func (q *Queries) UpsertExecutorSettings(ctx context.Context, arg UpsertExecutor
 	)
 	return err
 }
+
+const upsertNodeDrain = `-- name: UpsertNodeDrain :exec
+INSERT INTO node_drains (executor_id, node_name, node_selector, preempt, reason, set_by_user, set_at_time)
+VALUES ($1::text, $2::text, $3::text, $4::boolean, $5::text, $6::text, $7::timestamptz)
+ON CONFLICT (executor_id, node_name, node_selector) DO UPDATE
+  SET
+    preempt = excluded.preempt,
+    reason = excluded.reason,
+    set_by_user = excluded.set_by_user,
+    set_at_time = excluded.set_at_time
+`
+
+type UpsertNodeDrainParams struct {
+	ExecutorID   string    `db:"executor_id"`
+	NodeName     string    `db:"node_name"`
+	NodeSelector string    `db:"node_selector"`
+	Preempt      bool      `db:"preempt"`
+	Reason       string    `db:"reason"`
+	SetByUser    string    `db:"set_by_user"`
+	SetAtTime    time.Time `db:"set_at_time"`
+}
+
+func (q *Queries) UpsertNodeDrain(ctx context.Context, arg UpsertNodeDrainParams) error {
+	_, err := q.db.Exec(ctx, upsertNodeDrain,
+		arg.ExecutorID,
+		arg.NodeName,
+		arg.NodeSelector,
+		arg.Preempt,
+		arg.Reason,
+		arg.SetByUser,
+		arg.SetAtTime,
+	)
+	return err
+}
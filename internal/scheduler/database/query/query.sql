@@ -28,15 +28,24 @@ UPDATE jobs SET succeeded = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 -- name: MarkJobsCancelRequestedById :exec
 UPDATE jobs SET cancel_requested = true, cancel_user = $1 WHERE queue = sqlc.arg(queue) and job_set = sqlc.arg(job_set) and job_id = ANY(sqlc.arg(job_ids)::text[]) and cancelled = false and succeeded = false and failed = false;
 
+-- name: MarkJobsCancelRequestedByArrayId :exec
+UPDATE jobs SET cancel_requested = true, cancel_user = $1 WHERE queue = sqlc.arg(queue) and job_set = sqlc.arg(job_set) and array_id = ANY(sqlc.arg(array_ids)::text[]) and cancelled = false and succeeded = false and failed = false;
+
 -- name: MarkJobsCancelledById :exec
 UPDATE jobs SET cancelled = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 
 -- name: MarkJobsFailedById :exec
 UPDATE jobs SET failed = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 
+-- name: SelectJobOutcomesById :many
+SELECT job_id, queue, succeeded, failed, cancelled FROM jobs WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
+
 -- name: UpdateJobPriorityById :exec
 UPDATE jobs SET priority = $1 WHERE queue = sqlc.arg(queue) and job_set = sqlc.arg(job_set) and job_id = ANY(sqlc.arg(job_ids)::text[]) and cancelled = false and succeeded = false and failed = false;
 
+-- name: UpdateJobPriorityByArrayId :exec
+UPDATE jobs SET priority = $1 WHERE queue = sqlc.arg(queue) and job_set = sqlc.arg(job_set) and array_id = ANY(sqlc.arg(array_ids)::text[]) and cancelled = false and succeeded = false and failed = false;
+
 -- name: SelectInitialRuns :many
 SELECT * FROM runs WHERE serial > $1 AND job_id = ANY(sqlc.arg(job_ids)::text[]) ORDER BY serial LIMIT $2;
 
@@ -141,6 +150,22 @@ DELETE FROM executor_settings WHERE executor_id = @executor_id::text;
 -- name: SelectAllExecutorSettings :many
 SELECT executor_id, cordoned, cordon_reason, set_by_user, set_at_time FROM executor_settings;
 
+-- name: UpsertNodeDrain :exec
+INSERT INTO node_drains (executor_id, node_name, node_selector, preempt, reason, set_by_user, set_at_time)
+VALUES (@executor_id::text, @node_name::text, @node_selector::text, @preempt::boolean, @reason::text, @set_by_user::text, @set_at_time::timestamptz)
+ON CONFLICT (executor_id, node_name, node_selector) DO UPDATE
+  SET
+    preempt = excluded.preempt,
+    reason = excluded.reason,
+    set_by_user = excluded.set_by_user,
+    set_at_time = excluded.set_at_time;
+
+-- name: DeleteNodeDrain :exec
+DELETE FROM node_drains WHERE executor_id = @executor_id::text AND node_name = @node_name::text AND node_selector = @node_selector::text;
+
+-- name: SelectAllNodeDrains :many
+SELECT executor_id, node_name, node_selector, preempt, reason, set_by_user, set_at_time FROM node_drains;
+
 -- name: SelectLatestJobSerial :one
 SELECT serial FROM jobs ORDER BY serial DESC LIMIT 1;
 
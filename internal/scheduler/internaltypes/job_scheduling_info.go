@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
@@ -21,16 +23,30 @@ type JobSchedulingInfo struct {
 	Priority          uint32
 	PodRequirements   *PodRequirements
 	Version           uint32
+	// Ids of the jobs that must succeed before this job may be scheduled.
+	Dependencies            []string
+	DependencyFailurePolicy schedulerobjects.DependencyFailurePolicy
+	// If non-zero, the job may not be scheduled until this time.
+	NotBefore time.Time
+	// If non-zero, the job is cancelled if it hasn't been scheduled within this many seconds of becoming eligible.
+	QueueTtlSeconds uint32
+	// Determines whether the job is retried if one of its runs fails. May be nil.
+	RetryPolicy *schedulerobjects.RetryPolicy
 }
 
 func (j *JobSchedulingInfo) DeepCopy() *JobSchedulingInfo {
 	return &JobSchedulingInfo{
-		Lifetime:          j.Lifetime,
-		PriorityClassName: j.PriorityClassName,
-		SubmitTime:        j.SubmitTime,
-		Priority:          j.Priority,
-		PodRequirements:   j.PodRequirements.DeepCopy(),
-		Version:           j.Version,
+		Lifetime:                j.Lifetime,
+		PriorityClassName:       j.PriorityClassName,
+		SubmitTime:              j.SubmitTime,
+		Priority:                j.Priority,
+		PodRequirements:         j.PodRequirements.DeepCopy(),
+		Version:                 j.Version,
+		Dependencies:            slices.Clone(j.Dependencies),
+		DependencyFailurePolicy: j.DependencyFailurePolicy,
+		NotBefore:               j.NotBefore,
+		QueueTtlSeconds:         j.QueueTtlSeconds,
+		RetryPolicy:             proto.Clone(j.RetryPolicy).(*schedulerobjects.RetryPolicy),
 	}
 }
 
@@ -41,6 +57,10 @@ type PodRequirements struct {
 	Tolerations          []v1.Toleration
 	Annotations          map[string]string
 	ResourceRequirements v1.ResourceRequirements
+	// Labels and Namespace of the pod, used to evaluate inter-pod affinity and topology spread constraints.
+	Labels                    map[string]string
+	Namespace                 string
+	TopologySpreadConstraints []v1.TopologySpreadConstraint
 }
 
 func (p *PodRequirements) GetAffinityNodeSelector() *v1.NodeSelector {
@@ -55,6 +75,28 @@ func (p *PodRequirements) GetAffinityNodeSelector() *v1.NodeSelector {
 	return nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
 }
 
+// GetPodAffinityTerms returns the required pod affinity terms of the pod.
+func (p *PodRequirements) GetPodAffinityTerms() []v1.PodAffinityTerm {
+	if p.Affinity == nil || p.Affinity.PodAffinity == nil {
+		return nil
+	}
+	return p.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+}
+
+// GetPodAntiAffinityTerms returns the required pod anti-affinity terms of the pod.
+func (p *PodRequirements) GetPodAntiAffinityTerms() []v1.PodAffinityTerm {
+	if p.Affinity == nil || p.Affinity.PodAntiAffinity == nil {
+		return nil
+	}
+	return p.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+}
+
+// HasInterPodConstraints returns true if the nodes the pod may be scheduled on depend on the pods already bound to nodes,
+// i.e., if the pod has required pod affinity or anti-affinity terms or topology spread constraints.
+func (p *PodRequirements) HasInterPodConstraints() bool {
+	return len(p.GetPodAffinityTerms()) > 0 || len(p.GetPodAntiAffinityTerms()) > 0 || len(p.TopologySpreadConstraints) > 0
+}
+
 func (p *PodRequirements) DeepCopy() *PodRequirements {
 	clonedResourceRequirements := proto.Clone(&p.ResourceRequirements).(*v1.ResourceRequirements)
 	return &PodRequirements{
@@ -66,6 +108,11 @@ func (p *PodRequirements) DeepCopy() *PodRequirements {
 			return *cloned
 		}),
 		ResourceRequirements: *clonedResourceRequirements,
+		Labels:               maps.Clone(p.Labels),
+		Namespace:            p.Namespace,
+		TopologySpreadConstraints: armadaslices.Map(p.TopologySpreadConstraints, func(c v1.TopologySpreadConstraint) v1.TopologySpreadConstraint {
+			return *c.DeepCopy()
+		}),
 	}
 }
 
@@ -92,13 +139,27 @@ func FromSchedulerObjectsJobSchedulingInfo(j *schedulerobjects.JobSchedulingInfo
 			}),
 			Annotations:          maps.Clone(podRequirements.Annotations),
 			ResourceRequirements: *rr,
+			Labels:               maps.Clone(podRequirements.Labels),
+			Namespace:            podRequirements.Namespace,
+			TopologySpreadConstraints: armadaslices.Map(podRequirements.TopologySpreadConstraints, func(c *v1.TopologySpreadConstraint) v1.TopologySpreadConstraint {
+				return *c.DeepCopy()
+			}),
 		},
-		Version: j.Version,
+		Version:                 j.Version,
+		Dependencies:            slices.Clone(j.Dependencies),
+		DependencyFailurePolicy: j.DependencyFailurePolicy,
+		NotBefore:               protoutil.ToStdTime(j.NotBefore),
+		QueueTtlSeconds:         j.QueueTtlSeconds,
+		RetryPolicy:             proto.Clone(j.RetryPolicy).(*schedulerobjects.RetryPolicy),
 	}, nil
 }
 
 func ToSchedulerObjectsJobSchedulingInfo(j *JobSchedulingInfo) *schedulerobjects.JobSchedulingInfo {
 	podRequirements := j.PodRequirements
+	var notBefore *types.Timestamp
+	if !j.NotBefore.IsZero() {
+		notBefore = protoutil.ToTimestamp(j.NotBefore)
+	}
 	return &schedulerobjects.JobSchedulingInfo{
 		Lifetime:          j.Lifetime,
 		PriorityClassName: j.PriorityClassName,
@@ -115,10 +176,20 @@ func ToSchedulerObjectsJobSchedulingInfo(j *JobSchedulingInfo) *schedulerobjects
 						}),
 						Annotations:          maps.Clone(podRequirements.Annotations),
 						ResourceRequirements: podRequirements.ResourceRequirements.DeepCopy(),
+						Labels:               maps.Clone(podRequirements.Labels),
+						Namespace:            podRequirements.Namespace,
+						TopologySpreadConstraints: armadaslices.Map(podRequirements.TopologySpreadConstraints, func(c v1.TopologySpreadConstraint) *v1.TopologySpreadConstraint {
+							return c.DeepCopy()
+						}),
 					},
 				},
 			},
 		},
-		Version: j.Version,
+		Version:                 j.Version,
+		Dependencies:            slices.Clone(j.Dependencies),
+		DependencyFailurePolicy: j.DependencyFailurePolicy,
+		NotBefore:               notBefore,
+		QueueTtlSeconds:         j.QueueTtlSeconds,
+		RetryPolicy:             proto.Clone(j.RetryPolicy).(*schedulerobjects.RetryPolicy),
 	}
 }
@@ -126,7 +126,8 @@ func (skg *PodRequirementsSerialiser) AppendNodeSelector(out []byte, nodeSelecto
 }
 
 // AppendAffinity writes a v1.Affinity into the hash.
-// Only NodeAffinity (i.e., not PodAffinity) and RequiredDuringSchedulingIgnoredDuringExecution fields are considered.
+// Only NodeAffinity (i.e., not PodAffinity) and RequiredDuringSchedulingIgnoredDuringExecution fields are considered,
+// since the scheduling key isn't used for jobs with inter-pod affinity constraints.
 func (skg *PodRequirementsSerialiser) AppendAffinity(out []byte, affinity *v1.Affinity) []byte {
 	if affinity == nil {
 		return out
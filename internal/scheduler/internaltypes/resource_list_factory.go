@@ -95,6 +95,22 @@ func (factory *ResourceListFactory) FromNodeProto(resources map[string]*k8sResou
 	return ResourceList{resources: result, factory: factory}
 }
 
+// Ignore unknown resources, round down.
+// Resources missing from the map are set to their maximum value, i.e., they're considered unlimited.
+func (factory *ResourceListFactory) FromQuotaIgnoreUnknown(resources map[string]*k8sResource.Quantity) ResourceList {
+	result := make([]int64, len(factory.indexToName))
+	for i := range result {
+		result[i] = math.MaxInt64
+	}
+	for k, v := range resources {
+		index, ok := factory.nameToIndex[k]
+		if ok && v != nil {
+			result[index] = QuantityToInt64RoundDown(*v, factory.scales[index])
+		}
+	}
+	return ResourceList{resources: result, factory: factory}
+}
+
 // Ignore unknown resources, round up.
 func (factory *ResourceListFactory) FromJobResourceListIgnoreUnknown(resources map[string]k8sResource.Quantity) ResourceList {
 	result := make([]int64, len(factory.indexToName))
@@ -93,6 +93,19 @@ func TestFromJobResourceListIgnoreUnknownDoesNotErrorIfMissing(t *testing.T) {
 	assert.Equal(t, int64(100*1024*1024), testGet(&result, "memory"))
 }
 
+func TestFromQuotaIgnoreUnknown(t *testing.T) {
+	factory := testFactory()
+	result := factory.FromQuotaIgnoreUnknown(map[string]*k8sResource.Quantity{
+		"memory":  pointer.MustParseResource("100Mi"),
+		"cpu":     pointer.MustParseResource("2000"),
+		"missing": pointer.MustParseResource("1"),
+	})
+	assert.Equal(t, int64(100*1024*1024), testGet(&result, "memory"))
+	assert.Equal(t, int64(2000*1000), testGet(&result, "cpu"))
+	assert.Equal(t, int64(math.MaxInt64), testGet(&result, "nvidia.com/gpu"))
+	assert.Equal(t, int64(math.MaxInt64), testGet(&result, "external-storage-connections"))
+}
+
 func TestMakeResourceFractionList(t *testing.T) {
 	factory := testFactory()
 	result := factory.MakeResourceFractionList(map[string]float64{
@@ -1,17 +1,19 @@
 package jobdb
 
 type GangInfo struct {
-	isGang         bool
-	id             string
-	cardinality    int
-	nodeUniformity string
+	isGang             bool
+	id                 string
+	cardinality        int
+	minimumCardinality int
+	nodeUniformity     string
 }
 
 var basicJobGangInfo = GangInfo{
-	id:             "",
-	isGang:         false,
-	cardinality:    1,
-	nodeUniformity: "",
+	id:                 "",
+	isGang:             false,
+	cardinality:        1,
+	minimumCardinality: 1,
+	nodeUniformity:     "",
 }
 
 // BasicJobGangInfo The info used for non-gang jobs
@@ -19,12 +21,13 @@ func BasicJobGangInfo() GangInfo {
 	return basicJobGangInfo
 }
 
-func CreateGangInfo(id string, cardinality int, nodeUniformity string) GangInfo {
+func CreateGangInfo(id string, cardinality int, minimumCardinality int, nodeUniformity string) GangInfo {
 	return GangInfo{
-		id:             id,
-		isGang:         true,
-		cardinality:    cardinality,
-		nodeUniformity: nodeUniformity,
+		id:                 id,
+		isGang:             true,
+		cardinality:        cardinality,
+		minimumCardinality: minimumCardinality,
+		nodeUniformity:     nodeUniformity,
 	}
 }
 
@@ -40,6 +43,17 @@ func (g GangInfo) Cardinality() int {
 	return g.cardinality
 }
 
+// MinimumCardinality returns the minimum number of jobs in the gang that must be scheduled together.
+// Equal to Cardinality unless the gang is elastic.
+func (g GangInfo) MinimumCardinality() int {
+	return g.minimumCardinality
+}
+
+// IsElastic returns true if the gang may run with fewer than Cardinality jobs.
+func (g GangInfo) IsElastic() bool {
+	return g.minimumCardinality < g.cardinality
+}
+
 func (g GangInfo) NodeUniformity() string {
 	return g.nodeUniformity
 }
@@ -13,13 +13,17 @@ func TestGangInfo_BasicJobGangInfo(t *testing.T) {
 	assert.Empty(t, info.NodeUniformity())
 	assert.Empty(t, info.Id())
 	assert.Equal(t, 1, info.Cardinality())
+	assert.Equal(t, 1, info.MinimumCardinality())
+	assert.False(t, info.IsElastic())
 }
 
 func TestGangInfo_CreateGangInfo(t *testing.T) {
-	info := CreateGangInfo("id", 4, "gang-label")
+	info := CreateGangInfo("id", 4, 2, "gang-label")
 
 	assert.True(t, info.IsGang())
 	assert.Equal(t, "gang-label", info.NodeUniformity())
 	assert.Equal(t, "id", info.Id())
 	assert.Equal(t, 4, info.Cardinality())
+	assert.Equal(t, 2, info.MinimumCardinality())
+	assert.True(t, info.IsElastic())
 }
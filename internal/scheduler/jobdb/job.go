@@ -15,6 +15,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/adapters"
 	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
 	"github.com/armadaproject/armada/internal/scheduler/pricing"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/bidstore"
 )
 
@@ -83,6 +84,15 @@ type Job struct {
 	bidPricesPool map[string]pricing.Bid
 	// Gang information for this job
 	gangInfo GangInfo
+	// True if this job has dependencies that have not yet been resolved.
+	// Such jobs may not be scheduled even if queued.
+	dependenciesPending bool
+	// Time at which the scheduler observed the dependencies of this job to have been resolved,
+	// or the zero time if the job has no dependencies or they have not yet been resolved.
+	dependenciesResolvedTime time.Time
+	// True if this job was submitted with a not-before time that the scheduler hasn't yet observed to have passed.
+	// Such jobs may not be scheduled even if queued.
+	deferred bool
 }
 
 func (job *Job) String() string {
@@ -365,6 +375,15 @@ func (job *Job) Equal(other *Job) bool {
 	if !job.gangInfo.Equal(other.gangInfo) {
 		return false
 	}
+	if job.dependenciesPending != other.dependenciesPending {
+		return false
+	}
+	if !job.dependenciesResolvedTime.Equal(other.dependenciesResolvedTime) {
+		return false
+	}
+	if job.deferred != other.deferred {
+		return false
+	}
 	if !armadamaps.DeepEqual(job.runsById, other.runsById) {
 		return false
 	}
@@ -603,6 +622,100 @@ func (job *Job) WithQueued(queued bool) *Job {
 	return j
 }
 
+// Dependencies returns the ids of the jobs that must succeed before this job may be scheduled.
+func (job *Job) Dependencies() []string {
+	return job.jobSchedulingInfo.Dependencies
+}
+
+// DependencyFailurePolicy returns what should happen to this job if any of its dependencies fails or is cancelled.
+func (job *Job) DependencyFailurePolicy() schedulerobjects.DependencyFailurePolicy {
+	return job.jobSchedulingInfo.DependencyFailurePolicy
+}
+
+// DependenciesResolved returns true if the dependencies of this job have been resolved,
+// i.e., if the job may be scheduled once queued.
+func (job *Job) DependenciesResolved() bool {
+	return !job.dependenciesPending
+}
+
+// WithDependenciesResolved returns a copy of the job with its dependencies marked as resolved or pending.
+func (job *Job) WithDependenciesResolved(dependenciesResolved bool) *Job {
+	j := shallowCopyJob(*job)
+	j.dependenciesPending = !dependenciesResolved
+	return j
+}
+
+// DependenciesResolvedTime returns the time at which the scheduler observed the dependencies of this job to have been
+// resolved, or the zero time if the job has no dependencies or they have not yet been resolved.
+func (job *Job) DependenciesResolvedTime() time.Time {
+	return job.dependenciesResolvedTime
+}
+
+// WithDependenciesResolvedTime returns a copy of the job with the time its dependencies were resolved set to the
+// provided value.
+func (job *Job) WithDependenciesResolvedTime(dependenciesResolvedTime time.Time) *Job {
+	j := shallowCopyJob(*job)
+	j.dependenciesResolvedTime = dependenciesResolvedTime
+	return j
+}
+
+// AwaitingDependencies returns true if the job is queued but may not be scheduled until its dependencies are resolved.
+func (job *Job) AwaitingDependencies() bool {
+	return job.queued && job.dependenciesPending
+}
+
+// NotBefore returns the time before which this job may not be scheduled, or the zero time if no such time was set.
+func (job *Job) NotBefore() time.Time {
+	return job.jobSchedulingInfo.NotBefore
+}
+
+// WithDeferred returns a copy of the job with deferred set to the provided value.
+func (job *Job) WithDeferred(deferred bool) *Job {
+	j := shallowCopyJob(*job)
+	j.deferred = deferred
+	return j
+}
+
+// Deferred returns true if the job is queued but may not be scheduled until its not-before time has passed.
+func (job *Job) Deferred() bool {
+	return job.queued && job.deferred
+}
+
+// QueueTtl returns how long this job may remain queued after becoming eligible for scheduling before being cancelled.
+// Zero indicates the job may remain queued indefinitely.
+func (job *Job) QueueTtl() time.Duration {
+	return time.Duration(job.jobSchedulingInfo.QueueTtlSeconds) * time.Second
+}
+
+// QueueDeadline returns the time at which this job is cancelled if it hasn't been scheduled by then, i.e., QueueTtl
+// after it was submitted, after its not-before time, or after its dependencies were resolved, whichever is later.
+// The second return value is false if the job has no queue ttl, if it's still awaiting dependencies,
+// or if it has been scheduled before, in which case the queue ttl no longer applies.
+func (job *Job) QueueDeadline() (time.Time, bool) {
+	if !job.queued || job.QueueTtl() == 0 || job.dependenciesPending || len(job.runsById) > 0 {
+		return time.Time{}, false
+	}
+	eligibleTime := job.SubmitTime()
+	if job.NotBefore().After(eligibleTime) {
+		eligibleTime = job.NotBefore()
+	}
+	if job.dependenciesResolvedTime.After(eligibleTime) {
+		eligibleTime = job.dependenciesResolvedTime
+	}
+	return eligibleTime.Add(job.QueueTtl()), true
+}
+
+// RetryPolicy returns the policy determining whether this job is retried if one of its runs fails, or nil if the job
+// has no retry policy.
+func (job *Job) RetryPolicy() *schedulerobjects.RetryPolicy {
+	return job.jobSchedulingInfo.RetryPolicy
+}
+
+// schedulable returns true if the job is queued and neither awaiting dependencies nor deferred.
+func (job *Job) schedulable() bool {
+	return job.queued && !job.dependenciesPending && !job.deferred
+}
+
 // QueuedVersion returns current queued state version.
 func (job *Job) QueuedVersion() int32 {
 	return job.queuedVersion
@@ -872,8 +985,13 @@ func (job *Job) WithJobSchedulingInfo(jobSchedulingInfo *internaltypes.JobSchedu
 	j.jobSchedulingInfo = jobSchedulingInfo
 	j.ensureJobSchedulingInfoFieldsInitialised()
 
-	// Changing the scheduling info invalidates the scheduling key stored with the job.
+	// Changing the scheduling info invalidates the scheduling key and gang info stored with the job.
 	j.schedulingKey = SchedulingKeyFromJob(j.jobDb.schedulingKeyGenerator, j)
+	gangInfo, err := createGangInfo(j.jobSchedulingInfo)
+	if err != nil {
+		return nil, err
+	}
+	j.gangInfo = *gangInfo
 
 	j.allResourceRequirements = j.jobDb.getResourceRequirements(jobSchedulingInfo)
 	j.kubernetesResourceRequirements = j.allResourceRequirements.OfType(internaltypes.Kubernetes)
@@ -2,6 +2,7 @@ package jobdb
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -422,6 +423,105 @@ func TestJob_TestWithCreated(t *testing.T) {
 	assert.Equal(t, int64(456), newJob.Created())
 }
 
+func TestJob_TestDeferred(t *testing.T) {
+	schedulingInfo := jobSchedulingInfo.DeepCopy()
+	schedulingInfo.NotBefore = time.Now().Add(time.Hour)
+	job, err := jobDb.NewJob("test-job", "test-jobSet", "test-queue", 2, schedulingInfo, true, 0, false, false, false, 3, false, []string{}, 0)
+	assert.Nil(t, err)
+	assert.True(t, job.Deferred())
+	assert.False(t, job.WithDeferred(false).Deferred())
+	assert.False(t, job.WithQueued(false).Deferred())
+
+	schedulingInfo.NotBefore = time.Now().Add(-time.Hour)
+	job, err = jobDb.NewJob("test-job", "test-jobSet", "test-queue", 2, schedulingInfo, true, 0, false, false, false, 3, false, []string{}, 0)
+	assert.Nil(t, err)
+	assert.False(t, job.Deferred())
+}
+
+func TestJob_TestQueueDeadline(t *testing.T) {
+	submitTime := time.Unix(1000, 0)
+	tests := map[string]struct {
+		notBefore                time.Time
+		queueTtlSeconds          uint32
+		queued                   bool
+		hasRun                   bool
+		dependenciesPending      bool
+		dependenciesResolvedTime time.Time
+		expectedDeadline         time.Time
+		expectedOk               bool
+	}{
+		"no queue ttl": {
+			queued: true,
+		},
+		"queue ttl": {
+			queueTtlSeconds:  10,
+			queued:           true,
+			expectedDeadline: submitTime.Add(10 * time.Second),
+			expectedOk:       true,
+		},
+		"queue ttl with not-before time": {
+			notBefore:        submitTime.Add(time.Minute),
+			queueTtlSeconds:  10,
+			queued:           true,
+			expectedDeadline: submitTime.Add(time.Minute + 10*time.Second),
+			expectedOk:       true,
+		},
+		"queue ttl with not-before time before submit time": {
+			notBefore:        submitTime.Add(-time.Minute),
+			queueTtlSeconds:  10,
+			queued:           true,
+			expectedDeadline: submitTime.Add(10 * time.Second),
+			expectedOk:       true,
+		},
+		"queue ttl while awaiting dependencies": {
+			queueTtlSeconds:     10,
+			queued:              true,
+			dependenciesPending: true,
+		},
+		"queue ttl with dependencies resolved": {
+			queueTtlSeconds:          10,
+			queued:                   true,
+			dependenciesResolvedTime: submitTime.Add(time.Hour),
+			expectedDeadline:         submitTime.Add(time.Hour + 10*time.Second),
+			expectedOk:               true,
+		},
+		"queue ttl with dependencies resolved before not-before time": {
+			notBefore:                submitTime.Add(time.Hour),
+			queueTtlSeconds:          10,
+			queued:                   true,
+			dependenciesResolvedTime: submitTime.Add(time.Minute),
+			expectedDeadline:         submitTime.Add(time.Hour + 10*time.Second),
+			expectedOk:               true,
+		},
+		"not queued": {
+			queueTtlSeconds: 10,
+		},
+		"previously scheduled": {
+			queueTtlSeconds: 10,
+			queued:          true,
+			hasRun:          true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedulingInfo := jobSchedulingInfo.DeepCopy()
+			schedulingInfo.SubmitTime = submitTime
+			schedulingInfo.NotBefore = tc.notBefore
+			schedulingInfo.QueueTtlSeconds = tc.queueTtlSeconds
+			job := JobWithJobSchedulingInfo(baseJob, schedulingInfo).
+				WithQueued(tc.queued).
+				WithDependenciesResolved(!tc.dependenciesPending).
+				WithDependenciesResolvedTime(tc.dependenciesResolvedTime)
+			if tc.hasRun {
+				job = job.WithUpdatedRun(baseJobRun)
+			}
+			deadline, ok := job.QueueDeadline()
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedDeadline, deadline)
+		})
+	}
+}
+
 func TestJob_DeepCopy(t *testing.T) {
 	original, err := jobDb.NewJob("test-job", "test-jobSet", "test-queue", 2, jobSchedulingInfo, true, 0, false, false, false, 3, false, []string{}, 0)
 	assert.Nil(t, err)
@@ -71,6 +71,12 @@ type JobDb struct {
 	jobsByQueue        map[string]immutable.SortedSet[*Job]
 	jobsByPoolAndQueue map[string]map[string]immutable.SortedSet[*Job]
 	unvalidatedJobs    *immutable.Set[*Job]
+	// Queued jobs that may not be scheduled until their dependencies have been resolved.
+	jobsAwaitingDependencies *immutable.Set[*Job]
+	// Queued jobs that may not be scheduled until their not-before time has passed.
+	deferredJobs *immutable.Set[*Job]
+	// Queued jobs that are cancelled if not scheduled before their queue deadline.
+	jobsWithQueueDeadline *immutable.Set[*Job]
 	// Configured priority classes.
 	priorityClasses map[string]types.PriorityClass
 	// Priority class assigned to jobs with a priorityClassName not in jobDb.priorityClasses.
@@ -129,20 +135,26 @@ func NewJobDbWithSchedulingKeyGenerator(
 		panic(fmt.Sprintf("unknown default priority class %s", defaultPriorityClassName))
 	}
 	unvalidatedJobs := immutable.NewSet[*Job](JobHasher{})
+	jobsAwaitingDependencies := immutable.NewSet[*Job](JobHasher{})
+	deferredJobs := immutable.NewSet[*Job](JobHasher{})
+	jobsWithQueueDeadline := immutable.NewSet[*Job](JobHasher{})
 	return &JobDb{
-		jobsById:               immutable.NewMap[string, *Job](nil),
-		jobsByRunId:            immutable.NewMap[string, string](nil),
-		jobsByGangKey:          map[gangKey]immutable.Set[string]{},
-		jobsByQueue:            map[string]immutable.SortedSet[*Job]{},
-		jobsByPoolAndQueue:     map[string]map[string]immutable.SortedSet[*Job]{},
-		unvalidatedJobs:        &unvalidatedJobs,
-		priorityClasses:        priorityClasses,
-		defaultPriorityClass:   defaultPriorityClass,
-		schedulingKeyGenerator: skg,
-		stringInterner:         stringInterner,
-		clock:                  clock.RealClock{},
-		uuidProvider:           RealUUIDProvider{},
-		resourceListFactory:    resourceListFactory,
+		jobsById:                 immutable.NewMap[string, *Job](nil),
+		jobsByRunId:              immutable.NewMap[string, string](nil),
+		jobsByGangKey:            map[gangKey]immutable.Set[string]{},
+		jobsByQueue:              map[string]immutable.SortedSet[*Job]{},
+		jobsByPoolAndQueue:       map[string]map[string]immutable.SortedSet[*Job]{},
+		unvalidatedJobs:          &unvalidatedJobs,
+		jobsAwaitingDependencies: &jobsAwaitingDependencies,
+		deferredJobs:             &deferredJobs,
+		jobsWithQueueDeadline:    &jobsWithQueueDeadline,
+		priorityClasses:          priorityClasses,
+		defaultPriorityClass:     defaultPriorityClass,
+		schedulingKeyGenerator:   skg,
+		stringInterner:           stringInterner,
+		clock:                    clock.RealClock{},
+		uuidProvider:             RealUUIDProvider{},
+		resourceListFactory:      resourceListFactory,
 	}
 }
 
@@ -157,17 +169,21 @@ func (jobDb *JobDb) SetUUIDProvider(uuidProvider IDProvider) {
 // Clone returns a copy of the jobDb.
 func (jobDb *JobDb) Clone() *JobDb {
 	return &JobDb{
-		jobsById:               jobDb.jobsById,
-		jobsByRunId:            jobDb.jobsByRunId,
-		jobsByGangKey:          maps.Clone(jobDb.jobsByGangKey),
-		jobsByQueue:            maps.Clone(jobDb.jobsByQueue),
-		jobsByPoolAndQueue:     maps.Clone(jobDb.jobsByPoolAndQueue),
-		unvalidatedJobs:        jobDb.unvalidatedJobs,
-		priorityClasses:        jobDb.priorityClasses,
-		defaultPriorityClass:   jobDb.defaultPriorityClass,
-		schedulingKeyGenerator: jobDb.schedulingKeyGenerator,
-		stringInterner:         jobDb.stringInterner,
-		resourceListFactory:    jobDb.resourceListFactory,
+		jobsById:                 jobDb.jobsById,
+		jobsByRunId:              jobDb.jobsByRunId,
+		jobsByGangKey:            maps.Clone(jobDb.jobsByGangKey),
+		jobsByQueue:              maps.Clone(jobDb.jobsByQueue),
+		jobsByPoolAndQueue:       maps.Clone(jobDb.jobsByPoolAndQueue),
+		unvalidatedJobs:          jobDb.unvalidatedJobs,
+		jobsAwaitingDependencies: jobDb.jobsAwaitingDependencies,
+		deferredJobs:             jobDb.deferredJobs,
+		jobsWithQueueDeadline:    jobDb.jobsWithQueueDeadline,
+		priorityClasses:          jobDb.priorityClasses,
+		defaultPriorityClass:     jobDb.defaultPriorityClass,
+		schedulingKeyGenerator:   jobDb.schedulingKeyGenerator,
+		stringInterner:           jobDb.stringInterner,
+		clock:                    jobDb.clock,
+		resourceListFactory:      jobDb.resourceListFactory,
 	}
 }
 
@@ -231,6 +247,8 @@ func (jobDb *JobDb) NewJob(
 		pools:                          pools,
 		priceBand:                      pb,
 		gangInfo:                       *gangInfo,
+		dependenciesPending:            len(schedulingInfo.Dependencies) > 0,
+		deferred:                       schedulingInfo.NotBefore.After(jobDb.clock.Now()),
 	}
 	job.ensureJobSchedulingInfoFieldsInitialised()
 	job.schedulingKey = SchedulingKeyFromJob(jobDb.schedulingKeyGenerator, job)
@@ -265,8 +283,19 @@ func createGangInfo(schedulingInfo *internaltypes.JobSchedulingInfo) (*GangInfo,
 		return &basicGangInfo, nil
 	}
 
+	gangMinimumCardinality := gangCardinality
+	if gangMinimumCardinalityString, ok := annotations[configuration.GangMinimumCardinalityAnnotation]; ok {
+		gangMinimumCardinality, err = strconv.Atoi(gangMinimumCardinalityString)
+		if err != nil {
+			return nil, fmt.Errorf("gang minimum cardinality is not parseable - %s", errors.WithStack(err))
+		}
+		if gangMinimumCardinality <= 0 || gangMinimumCardinality > gangCardinality {
+			return nil, errors.Errorf("gang minimum cardinality %d is not between 1 and gang cardinality %d", gangMinimumCardinality, gangCardinality)
+		}
+	}
+
 	nodeUniformityLabel := schedulingInfo.PodRequirements.Annotations[configuration.GangNodeUniformityLabelAnnotation]
-	gangInfo := CreateGangInfo(gangId, gangCardinality, nodeUniformityLabel)
+	gangInfo := CreateGangInfo(gangId, gangCardinality, gangMinimumCardinality, nodeUniformityLabel)
 	return &gangInfo, nil
 }
 
@@ -306,15 +335,18 @@ func (jobDb *JobDb) ReadTxn() *Txn {
 	jobDb.copyMutex.Lock()
 	defer jobDb.copyMutex.Unlock()
 	return &Txn{
-		readOnly:           true,
-		jobsById:           jobDb.jobsById,
-		jobsByRunId:        jobDb.jobsByRunId,
-		jobsByGangKey:      jobDb.jobsByGangKey,
-		jobsByQueue:        jobDb.jobsByQueue,
-		jobsByPoolAndQueue: jobDb.jobsByPoolAndQueue,
-		unvalidatedJobs:    jobDb.unvalidatedJobs,
-		active:             true,
-		jobDb:              jobDb,
+		readOnly:                 true,
+		jobsById:                 jobDb.jobsById,
+		jobsByRunId:              jobDb.jobsByRunId,
+		jobsByGangKey:            jobDb.jobsByGangKey,
+		jobsByQueue:              jobDb.jobsByQueue,
+		jobsByPoolAndQueue:       jobDb.jobsByPoolAndQueue,
+		unvalidatedJobs:          jobDb.unvalidatedJobs,
+		jobsAwaitingDependencies: jobDb.jobsAwaitingDependencies,
+		deferredJobs:             jobDb.deferredJobs,
+		jobsWithQueueDeadline:    jobDb.jobsWithQueueDeadline,
+		active:                   true,
+		jobDb:                    jobDb,
 	}
 }
 
@@ -326,15 +358,18 @@ func (jobDb *JobDb) WriteTxn() *Txn {
 	jobDb.copyMutex.Lock()
 	defer jobDb.copyMutex.Unlock()
 	return &Txn{
-		readOnly:           false,
-		jobsById:           jobDb.jobsById,
-		jobsByRunId:        jobDb.jobsByRunId,
-		jobsByGangKey:      maps.Clone(jobDb.jobsByGangKey),
-		jobsByQueue:        maps.Clone(jobDb.jobsByQueue),
-		jobsByPoolAndQueue: maps.Clone(jobDb.jobsByPoolAndQueue),
-		unvalidatedJobs:    jobDb.unvalidatedJobs,
-		active:             true,
-		jobDb:              jobDb,
+		readOnly:                 false,
+		jobsById:                 jobDb.jobsById,
+		jobsByRunId:              jobDb.jobsByRunId,
+		jobsByGangKey:            maps.Clone(jobDb.jobsByGangKey),
+		jobsByQueue:              maps.Clone(jobDb.jobsByQueue),
+		jobsByPoolAndQueue:       maps.Clone(jobDb.jobsByPoolAndQueue),
+		unvalidatedJobs:          jobDb.unvalidatedJobs,
+		jobsAwaitingDependencies: jobDb.jobsAwaitingDependencies,
+		deferredJobs:             jobDb.deferredJobs,
+		jobsWithQueueDeadline:    jobDb.jobsWithQueueDeadline,
+		active:                   true,
+		jobDb:                    jobDb,
 	}
 }
 
@@ -359,6 +394,14 @@ type Txn struct {
 	jobsByPoolAndQueue map[string]map[string]immutable.SortedSet[*Job]
 	// Jobs that require submit checking
 	unvalidatedJobs *immutable.Set[*Job]
+	// Queued jobs that may not be scheduled until their dependencies have been resolved.
+	// These are not included in jobsByQueue or jobsByPoolAndQueue.
+	jobsAwaitingDependencies *immutable.Set[*Job]
+	// Queued jobs that may not be scheduled until their not-before time has passed.
+	// These are not included in jobsByQueue or jobsByPoolAndQueue.
+	deferredJobs *immutable.Set[*Job]
+	// Queued jobs that are cancelled if not scheduled before their queue deadline.
+	jobsWithQueueDeadline *immutable.Set[*Job]
 	// The jobDb from which this transaction was created.
 	jobDb *JobDb
 	// Set to false when this transaction is either committed or aborted.
@@ -378,6 +421,9 @@ func (txn *Txn) Commit() {
 	txn.jobDb.jobsByQueue = txn.jobsByQueue
 	txn.jobDb.jobsByPoolAndQueue = txn.jobsByPoolAndQueue
 	txn.jobDb.unvalidatedJobs = txn.unvalidatedJobs
+	txn.jobDb.jobsAwaitingDependencies = txn.jobsAwaitingDependencies
+	txn.jobDb.deferredJobs = txn.deferredJobs
+	txn.jobDb.jobsWithQueueDeadline = txn.jobsWithQueueDeadline
 
 	txn.active = false
 }
@@ -400,7 +446,16 @@ func (txn *Txn) Assert(assertOnlyActiveJobs bool) error {
 		if assertOnlyActiveJobs && job.InTerminalState() {
 			return errors.Errorf("jobDb contains an inactive job %s", job)
 		}
-		if job.Queued() {
+		if job.AwaitingDependencies() && !txn.jobsAwaitingDependencies.Has(job) {
+			return errors.Errorf("jobDb contains job %s awaiting dependencies but this job is not in the set of jobs awaiting dependencies", job)
+		}
+		if job.Deferred() && !txn.deferredJobs.Has(job) {
+			return errors.Errorf("jobDb contains deferred job %s but this job is not in the set of deferred jobs", job)
+		}
+		if _, ok := job.QueueDeadline(); ok && !txn.jobsWithQueueDeadline.Has(job) {
+			return errors.Errorf("jobDb contains job %s with a queue deadline but this job is not in the set of jobs with a queue deadline", job)
+		}
+		if job.schedulable() {
 			if queue, ok := txn.jobsByQueue[job.queue]; !ok {
 				return errors.Errorf("jobDb contains queued job %s but there is no sorted set for this queue", job)
 			} else if !queue.Has(job) {
@@ -511,13 +566,28 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 					newUnvalidatedJobs := txn.unvalidatedJobs.Delete(existingJob)
 					txn.unvalidatedJobs = &newUnvalidatedJobs
 				}
+
+				if existingJob.AwaitingDependencies() {
+					newJobsAwaitingDependencies := txn.jobsAwaitingDependencies.Delete(existingJob)
+					txn.jobsAwaitingDependencies = &newJobsAwaitingDependencies
+				}
+
+				if existingJob.Deferred() {
+					newDeferredJobs := txn.deferredJobs.Delete(existingJob)
+					txn.deferredJobs = &newDeferredJobs
+				}
+
+				if _, ok := existingJob.QueueDeadline(); ok {
+					newJobsWithQueueDeadline := txn.jobsWithQueueDeadline.Delete(existingJob)
+					txn.jobsWithQueueDeadline = &newJobsWithQueueDeadline
+				}
 			}
 		}
 	}
 
 	// Now need to insert jobs, runs and queuedJobs. This can be done in parallel.
 	wg := sync.WaitGroup{}
-	wg.Add(5)
+	wg.Add(8)
 
 	// jobs
 	go func() {
@@ -572,10 +642,11 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 
 	// Queued jobs are additionally stored in an ordered set.
 	// To enable iterating over them in the order they should be scheduled.
+	// Jobs awaiting dependencies and deferred jobs are excluded, since they may not be scheduled yet.
 	go func() {
 		defer wg.Done()
 		for _, job := range jobs {
-			if job.Queued() {
+			if job.schedulable() {
 				newQueue, ok := txn.jobsByQueue[job.queue]
 				if !ok {
 					q := emptyList
@@ -612,6 +683,39 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 		}
 	}()
 
+	// Jobs awaiting dependencies
+	go func() {
+		defer wg.Done()
+		for _, job := range jobs {
+			if job.AwaitingDependencies() {
+				jobsAwaitingDependencies := txn.jobsAwaitingDependencies.Add(job)
+				txn.jobsAwaitingDependencies = &jobsAwaitingDependencies
+			}
+		}
+	}()
+
+	// Deferred jobs
+	go func() {
+		defer wg.Done()
+		for _, job := range jobs {
+			if job.Deferred() {
+				deferredJobs := txn.deferredJobs.Add(job)
+				txn.deferredJobs = &deferredJobs
+			}
+		}
+	}()
+
+	// Jobs with a queue deadline
+	go func() {
+		defer wg.Done()
+		for _, job := range jobs {
+			if _, ok := job.QueueDeadline(); ok {
+				jobsWithQueueDeadline := txn.jobsWithQueueDeadline.Add(job)
+				txn.jobsWithQueueDeadline = &jobsWithQueueDeadline
+			}
+		}
+	}()
+
 	wg.Wait()
 	return nil
 }
@@ -699,7 +803,8 @@ func (txn *Txn) HasQueuedJobs(queue string) bool {
 	return queuedJobs.Len() > 0
 }
 
-// QueuedJobs returns an iterator for the jobs queued for that provided queue + pool
+// QueuedJobs returns an iterator for the jobs queued for that provided queue + pool.
+// Jobs awaiting dependencies and deferred jobs are not included, since they may not yet be scheduled.
 func (txn *Txn) QueuedJobs(queue string, pool string, sortOrder JobSortOrder) JobIterator {
 	if sortOrder == PriceOrder {
 		if _, ok := txn.jobsByPoolAndQueue[pool]; !ok {
@@ -724,6 +829,24 @@ func (txn *Txn) UnvalidatedJobs() *immutable.SetIterator[*Job] {
 	return txn.unvalidatedJobs.Iterator()
 }
 
+// JobsAwaitingDependencies returns an iterator for queued jobs that may not be scheduled until their dependencies
+// have been resolved. These jobs are not returned by QueuedJobs.
+func (txn *Txn) JobsAwaitingDependencies() *immutable.SetIterator[*Job] {
+	return txn.jobsAwaitingDependencies.Iterator()
+}
+
+// DeferredJobs returns an iterator for queued jobs that may not be scheduled until their not-before time has passed.
+// These jobs are not returned by QueuedJobs.
+func (txn *Txn) DeferredJobs() *immutable.SetIterator[*Job] {
+	return txn.deferredJobs.Iterator()
+}
+
+// JobsWithQueueDeadline returns an iterator for queued jobs that are to be cancelled if not scheduled before their
+// queue deadline.
+func (txn *Txn) JobsWithQueueDeadline() *immutable.SetIterator[*Job] {
+	return txn.jobsWithQueueDeadline.Iterator()
+}
+
 // GetAll returns all jobs in the database.
 func (txn *Txn) GetAll() []*Job {
 	allJobs := make([]*Job, 0, txn.jobsById.Len())
@@ -786,6 +909,12 @@ func (txn *Txn) delete(jobId string) {
 		}
 		newUnvalidatedJobs := txn.unvalidatedJobs.Delete(job)
 		txn.unvalidatedJobs = &newUnvalidatedJobs
+		newJobsAwaitingDependencies := txn.jobsAwaitingDependencies.Delete(job)
+		txn.jobsAwaitingDependencies = &newJobsAwaitingDependencies
+		newDeferredJobs := txn.deferredJobs.Delete(job)
+		txn.deferredJobs = &newDeferredJobs
+		newJobsWithQueueDeadline := txn.jobsWithQueueDeadline.Delete(job)
+		txn.jobsWithQueueDeadline = &newJobsWithQueueDeadline
 	}
 }
 
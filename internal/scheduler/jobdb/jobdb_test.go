@@ -95,6 +95,106 @@ func TestJobDb_TestGetUnvalidated(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestJobDb_TestJobsAwaitingDependencies(t *testing.T) {
+	jobDb := NewTestJobDb()
+	job1 := newJob().WithQueued(true).WithDependenciesResolved(false)
+	job2 := newJob().WithQueued(true)
+	txn := jobDb.WriteTxn()
+
+	err := txn.Upsert([]*Job{job1, job2})
+	require.NoError(t, err)
+
+	collectAwaiting := func() []*Job {
+		var awaiting []*Job
+		it := txn.JobsAwaitingDependencies()
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			awaiting = append(awaiting, job)
+		}
+		return awaiting
+	}
+	collectQueued := func() []*Job {
+		var queued []*Job
+		it := txn.QueuedJobs(job1.Queue(), "pool", FairShareOrder)
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			queued = append(queued, job)
+		}
+		return queued
+	}
+	assert.Equal(t, []*Job{job1}, collectAwaiting())
+	assert.Equal(t, []*Job{job2}, collectQueued())
+
+	// Resolving the dependencies makes the job schedulable.
+	job1 = job1.WithDependenciesResolved(true)
+	err = txn.Upsert([]*Job{job1})
+	require.NoError(t, err)
+	assert.Empty(t, collectAwaiting())
+	assert.ElementsMatch(t, []*Job{job1, job2}, collectQueued())
+}
+
+func TestJobDb_TestDeferredJobs(t *testing.T) {
+	jobDb := NewTestJobDb()
+	job1 := newJob().WithQueued(true).WithDeferred(true)
+	job2 := newJob().WithQueued(true)
+	txn := jobDb.WriteTxn()
+
+	err := txn.Upsert([]*Job{job1, job2})
+	require.NoError(t, err)
+
+	collectDeferred := func() []*Job {
+		var deferred []*Job
+		it := txn.DeferredJobs()
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			deferred = append(deferred, job)
+		}
+		return deferred
+	}
+	collectQueued := func() []*Job {
+		var queued []*Job
+		it := txn.QueuedJobs(job1.Queue(), "pool", FairShareOrder)
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			queued = append(queued, job)
+		}
+		return queued
+	}
+	assert.Equal(t, []*Job{job1}, collectDeferred())
+	assert.Equal(t, []*Job{job2}, collectQueued())
+
+	// Ending the deferral makes the job schedulable.
+	job1 = job1.WithDeferred(false)
+	err = txn.Upsert([]*Job{job1})
+	require.NoError(t, err)
+	assert.Empty(t, collectDeferred())
+	assert.ElementsMatch(t, []*Job{job1, job2}, collectQueued())
+}
+
+func TestJobDb_TestJobsWithQueueDeadline(t *testing.T) {
+	jobDb := NewTestJobDb()
+	schedulingInfo := jobSchedulingInfo.DeepCopy()
+	schedulingInfo.QueueTtlSeconds = 60
+	job1 := JobWithJobSchedulingInfo(newJob(), schedulingInfo).WithQueued(true)
+	job2 := newJob().WithQueued(true)
+	txn := jobDb.WriteTxn()
+
+	err := txn.Upsert([]*Job{job1, job2})
+	require.NoError(t, err)
+
+	collect := func() []*Job {
+		var jobs []*Job
+		it := txn.JobsWithQueueDeadline()
+		for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+			jobs = append(jobs, job)
+		}
+		return jobs
+	}
+	assert.Equal(t, []*Job{job1}, collect())
+
+	// Once scheduled, the queue ttl no longer applies.
+	job1 = job1.WithQueued(false).WithNewRun("executor", "nodeId", "nodeName", "pool", 5)
+	err = txn.Upsert([]*Job{job1})
+	require.NoError(t, err)
+	assert.Empty(t, collect())
+}
+
 func TestJobDb_TestGetJobsByGangId(t *testing.T) {
 	jobDb := NewTestJobDb()
 	job1 := newGangJob()
@@ -336,9 +436,26 @@ func TestJobDb_GangInfoIsPopulated(t *testing.T) {
 				armadaconfiguration.GangIdAnnotation:                  "id",
 				armadaconfiguration.GangNodeUniformityLabelAnnotation: "node-uniformity",
 			},
-			expectedGangInfo: CreateGangInfo("id", 4, "node-uniformity"),
+			expectedGangInfo: CreateGangInfo("id", 4, 4, "node-uniformity"),
+			expectError:      false,
+		},
+		"elastic gang job": {
+			annotations: map[string]string{
+				armadaconfiguration.GangCardinalityAnnotation:        "4",
+				armadaconfiguration.GangMinimumCardinalityAnnotation: "2",
+				armadaconfiguration.GangIdAnnotation:                 "id",
+			},
+			expectedGangInfo: CreateGangInfo("id", 4, 2, ""),
 			expectError:      false,
 		},
+		"invalid gang job - minimum cardinality greater than cardinality": {
+			annotations: map[string]string{
+				armadaconfiguration.GangCardinalityAnnotation:        "4",
+				armadaconfiguration.GangMinimumCardinalityAnnotation: "5",
+				armadaconfiguration.GangIdAnnotation:                 "id",
+			},
+			expectError: true,
+		},
 		"invalid gang job - id empty": {
 			annotations: map[string]string{
 				armadaconfiguration.GangCardinalityAnnotation:         "4",
@@ -5,6 +5,7 @@ import "github.com/armadaproject/armada/internal/scheduler/internaltypes"
 type JobRepository interface {
 	QueuedJobs(queueName string, pool string, sortOrder JobSortOrder) JobIterator
 	GetById(id string) *Job
+	GetGangJobsByGangId(queue string, gangId string) ([]*Job, error)
 	NewJob(
 		jobId string,
 		jobSet string,
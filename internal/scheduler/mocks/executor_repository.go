@@ -87,6 +87,21 @@ func (mr *MockExecutorRepositoryMockRecorder) GetLastUpdateTimes(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUpdateTimes", reflect.TypeOf((*MockExecutorRepository)(nil).GetLastUpdateTimes), ctx)
 }
 
+// GetNodeDrains mocks base method.
+func (m *MockExecutorRepository) GetNodeDrains(ctx *armadacontext.Context) ([]*schedulerobjects.NodeDrain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeDrains", ctx)
+	ret0, _ := ret[0].([]*schedulerobjects.NodeDrain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeDrains indicates an expected call of GetNodeDrains.
+func (mr *MockExecutorRepositoryMockRecorder) GetNodeDrains(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeDrains", reflect.TypeOf((*MockExecutorRepository)(nil).GetNodeDrains), ctx)
+}
+
 // StoreExecutor mocks base method.
 func (m *MockExecutorRepository) StoreExecutor(ctx *armadacontext.Context, executor *schedulerobjects.Executor) error {
 	m.ctrl.T.Helper()
@@ -76,6 +76,21 @@ func (mr *MockJobRepositoryMockRecorder) FetchInitialJobs(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchInitialJobs", reflect.TypeOf((*MockJobRepository)(nil).FetchInitialJobs), ctx)
 }
 
+// FetchJobOutcomes mocks base method.
+func (m *MockJobRepository) FetchJobOutcomes(ctx *armadacontext.Context, jobIds []string) (map[string]database.JobOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobOutcomes", ctx, jobIds)
+	ret0, _ := ret[0].(map[string]database.JobOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobOutcomes indicates an expected call of FetchJobOutcomes.
+func (mr *MockJobRepositoryMockRecorder) FetchJobOutcomes(ctx, jobIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobOutcomes", reflect.TypeOf((*MockJobRepository)(nil).FetchJobOutcomes), ctx, jobIds)
+}
+
 // FetchJobRunErrors mocks base method.
 func (m *MockJobRepository) FetchJobRunErrors(ctx *armadacontext.Context, runIds []string) (map[string]*armadaevents.Error, error) {
 	m.ctrl.T.Helper()
@@ -136,3 +151,18 @@ func (mr *MockJobRepositoryMockRecorder) FindInactiveRuns(ctx, runIds any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInactiveRuns", reflect.TypeOf((*MockJobRepository)(nil).FindInactiveRuns), ctx, runIds)
 }
+
+// FindPreemptedRuns mocks base method.
+func (m *MockJobRepository) FindPreemptedRuns(ctx *armadacontext.Context, runIds []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPreemptedRuns", ctx, runIds)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPreemptedRuns indicates an expected call of FindPreemptedRuns.
+func (mr *MockJobRepositoryMockRecorder) FindPreemptedRuns(ctx, runIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPreemptedRuns", reflect.TypeOf((*MockJobRepository)(nil).FindPreemptedRuns), ctx, runIds)
+}
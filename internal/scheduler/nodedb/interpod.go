@@ -0,0 +1,522 @@
+package nodedb
+
+import (
+	"math"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/scheduling/context"
+)
+
+const (
+	defaultNamespace   = "default"
+	boundJobsTableName = "boundJobs"
+)
+
+// interPodConstraints evaluates the required pod affinity and anti-affinity terms and the topology spread constraints of a job,
+// as well as the required pod anti-affinity terms of the jobs already bound to nodes, against candidate nodes.
+//
+// It's computed from the jobs bound to nodes in the txn it's created with and must be recomputed once more jobs are bound.
+type interPodConstraints struct {
+	// One entry per required pod affinity term of the job.
+	affinityTerms []*podAffinityTermDomains
+	// If true, no bound job matches any of the pod affinity terms, but the job matches all of them itself.
+	// In that case, the job may be scheduled on any node with all topology keys, since it would otherwise never be scheduled.
+	affinityTermsSelfSatisfied bool
+	// One entry per required pod anti-affinity term of the job.
+	antiAffinityTerms []*podAffinityTermDomains
+	// Topology domains the job may not be scheduled in because of the anti-affinity terms of bound jobs.
+	// One entry per distinct topology key.
+	existingAntiAffinityTerms map[string]*podAffinityTermDomains
+	// One entry per topology spread constraint of the job with WhenUnsatisfiable set to DoNotSchedule.
+	spreadConstraints []*topologySpreadConstraintDomains
+}
+
+// podAffinityTermDomains is the set of topology domains containing at least one job matching a pod affinity term.
+type podAffinityTermDomains struct {
+	term       v1.PodAffinityTerm
+	namespaces namespaceSet
+	selector   labels.Selector
+	domains    map[string]bool
+}
+
+// topologySpreadConstraintDomains is the number of jobs matching a topology spread constraint in each eligible topology domain.
+type topologySpreadConstraintDomains struct {
+	constraint v1.TopologySpreadConstraint
+	selector   labels.Selector
+	// Number of matching jobs by topology domain. Contains an entry for every eligible domain.
+	numMatchingJobsByDomain map[string]int
+	// Minimum number of matching jobs across eligible domains.
+	minNumMatchingJobs int
+	// 1 if the job matches its own constraint and 0 otherwise.
+	selfMatch int
+}
+
+// namespaceSet is the set of namespaces a pod affinity term applies to. A nil set contains all namespaces.
+type namespaceSet map[string]bool
+
+func (s namespaceSet) contains(namespace string) bool {
+	return s == nil || s[namespaceOrDefault(namespace)]
+}
+
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return defaultNamespace
+	}
+	return namespace
+}
+
+// termNamespaces returns the namespaces a pod affinity term of a pod in namespace applies to.
+// Since the NodeDb doesn't know the labels of namespaces, only an empty namespace selector, which selects all namespaces, is supported;
+// jobs with other namespace selectors are rejected on submission.
+func termNamespaces(term *v1.PodAffinityTerm, namespace string) namespaceSet {
+	if term.NamespaceSelector != nil && len(term.NamespaceSelector.MatchLabels) == 0 && len(term.NamespaceSelector.MatchExpressions) == 0 {
+		return nil
+	}
+	namespaces := make(namespaceSet, len(term.Namespaces)+1)
+	for _, ns := range term.Namespaces {
+		namespaces[ns] = true
+	}
+	if len(term.Namespaces) == 0 {
+		namespaces[namespaceOrDefault(namespace)] = true
+	}
+	return namespaces
+}
+
+func newPodAffinityTermDomains(term v1.PodAffinityTerm, namespace string) (*podAffinityTermDomains, error) {
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &podAffinityTermDomains{
+		term:       term,
+		namespaces: termNamespaces(&term, namespace),
+		selector:   selector,
+		domains:    make(map[string]bool),
+	}, nil
+}
+
+func (t *podAffinityTermDomains) matches(namespace string, podLabels map[string]string) bool {
+	return t.namespaces.contains(namespace) && t.selector.Matches(labels.Set(podLabels))
+}
+
+// newInterPodConstraints returns the interPodConstraints of the job in jctx,
+// or nil if neither the job nor any bound job has inter-pod constraints.
+func (nodeDb *NodeDb) newInterPodConstraints(txn *memdb.Txn, jctx *context.JobSchedulingContext) (*interPodConstraints, error) {
+	req := jctx.PodRequirements
+	boundJobsWithPodAntiAffinity, err := getBoundJobsWithPodAntiAffinity(txn)
+	if err != nil {
+		return nil, err
+	}
+	if !req.HasInterPodConstraints() && len(boundJobsWithPodAntiAffinity) == 0 {
+		return nil, nil
+	}
+
+	constraints := &interPodConstraints{existingAntiAffinityTerms: make(map[string]*podAffinityTermDomains)}
+	for _, term := range req.GetPodAffinityTerms() {
+		termDomains, err := newPodAffinityTermDomains(term, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := termDomains.addMatchingBoundJobs(txn); err != nil {
+			return nil, err
+		}
+		constraints.affinityTerms = append(constraints.affinityTerms, termDomains)
+	}
+	for _, term := range req.GetPodAntiAffinityTerms() {
+		termDomains, err := newPodAffinityTermDomains(term, req.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := termDomains.addMatchingBoundJobs(txn); err != nil {
+			return nil, err
+		}
+		constraints.antiAffinityTerms = append(constraints.antiAffinityTerms, termDomains)
+	}
+	for _, constraint := range req.TopologySpreadConstraints {
+		if constraint.WhenUnsatisfiable != v1.DoNotSchedule {
+			// ScheduleAnyway constraints are preferences only.
+			continue
+		}
+		selector, err := topologySpreadConstraintSelector(&constraint, req.Labels)
+		if err != nil {
+			return nil, err
+		}
+		selfMatch := 0
+		if selector.Matches(labels.Set(req.Labels)) {
+			selfMatch = 1
+		}
+		constraints.spreadConstraints = append(constraints.spreadConstraints, &topologySpreadConstraintDomains{
+			constraint:              constraint,
+			selector:                selector,
+			numMatchingJobsByDomain: make(map[string]int),
+			selfMatch:               selfMatch,
+		})
+	}
+	if err := addBoundJobsToSpreadConstraints(txn, constraints.spreadConstraints, jctx); err != nil {
+		return nil, err
+	}
+
+	// Jobs may not be scheduled in violation of the anti-affinity terms of bound jobs either.
+	for _, boundJob := range boundJobsWithPodAntiAffinity {
+		boundReq := boundJob.job.PodRequirements()
+		for _, term := range boundReq.GetPodAntiAffinityTerms() {
+			domain, ok := boundJob.node.GetLabelValue(term.TopologyKey)
+			if !ok {
+				continue
+			}
+			termDomains, err := newPodAffinityTermDomains(term, boundReq.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			if !termDomains.matches(req.Namespace, req.Labels) {
+				continue
+			}
+			existing, ok := constraints.existingAntiAffinityTerms[term.TopologyKey]
+			if !ok {
+				existing = termDomains
+				constraints.existingAntiAffinityTerms[term.TopologyKey] = existing
+			}
+			existing.domains[domain] = true
+		}
+	}
+
+	if len(constraints.affinityTerms) > 0 {
+		constraints.affinityTermsSelfSatisfied = true
+		for _, term := range constraints.affinityTerms {
+			if len(term.domains) > 0 || !term.matches(req.Namespace, req.Labels) {
+				constraints.affinityTermsSelfSatisfied = false
+				break
+			}
+		}
+	}
+	return constraints, nil
+}
+
+// addMatchingBoundJobs adds the topology domains of the bound jobs matching the term.
+func (t *podAffinityTermDomains) addMatchingBoundJobs(txn *memdb.Txn) error {
+	return forEachBoundJobMatching(txn, t.selector, func(boundJob *boundJob) {
+		boundReq := boundJob.job.PodRequirements()
+		if !t.namespaces.contains(boundReq.Namespace) {
+			return
+		}
+		if domain, ok := boundJob.node.GetLabelValue(t.term.TopologyKey); ok {
+			t.domains[domain] = true
+		}
+	})
+}
+
+// addBoundJobsToSpreadConstraints counts the bound jobs matching each topology spread constraint in each eligible topology domain.
+// Finding the eligible domains requires checking every node against the node selector and affinity of the job,
+// but only the bound jobs matching a constraint are visited.
+func addBoundJobsToSpreadConstraints(txn *memdb.Txn, spreadConstraints []*topologySpreadConstraintDomains, jctx *context.JobSchedulingContext) error {
+	if len(spreadConstraints) == 0 {
+		return nil
+	}
+	req := jctx.PodRequirements
+	eligibleNodeIds := make([]map[string]bool, len(spreadConstraints))
+	for i := range spreadConstraints {
+		eligibleNodeIds[i] = make(map[string]bool)
+	}
+	it, err := NewNodesIterator(txn)
+	if err != nil {
+		return err
+	}
+	for node := it.NextNode(); node != nil; node = it.NextNode() {
+		for i, spreadConstraint := range spreadConstraints {
+			domain, ok := node.GetLabelValue(spreadConstraint.constraint.TopologyKey)
+			if !ok {
+				continue
+			}
+			eligible, err := nodeEligibleForTopologySpread(node, &spreadConstraint.constraint, jctx)
+			if err != nil {
+				return err
+			}
+			if eligible {
+				if _, ok := spreadConstraint.numMatchingJobsByDomain[domain]; !ok {
+					spreadConstraint.numMatchingJobsByDomain[domain] = 0
+				}
+				eligibleNodeIds[i][node.GetId()] = true
+			}
+		}
+	}
+
+	for i, spreadConstraint := range spreadConstraints {
+		err := forEachBoundJobMatching(txn, spreadConstraint.selector, func(boundJob *boundJob) {
+			if !eligibleNodeIds[i][boundJob.NodeId] {
+				return
+			}
+			if namespaceOrDefault(boundJob.job.PodRequirements().Namespace) != namespaceOrDefault(req.Namespace) {
+				return
+			}
+			domain, _ := boundJob.node.GetLabelValue(spreadConstraint.constraint.TopologyKey)
+			spreadConstraint.numMatchingJobsByDomain[domain]++
+		})
+		if err != nil {
+			return err
+		}
+		spreadConstraint.minNumMatchingJobs = spreadConstraint.minMatchingJobs()
+	}
+	return nil
+}
+
+// nodeEligibleForTopologySpread returns true if the jobs bound to node count towards a topology spread constraint.
+// As in Kubernetes, by default only nodes matching the node selector and node affinity of the job are considered,
+// while taints are ignored.
+func nodeEligibleForTopologySpread(node *internaltypes.Node, constraint *v1.TopologySpreadConstraint, jctx *context.JobSchedulingContext) (bool, error) {
+	if constraint.NodeAffinityPolicy == nil || *constraint.NodeAffinityPolicy == v1.NodeInclusionPolicyHonor {
+		if matches, _ := NodeSelectorRequirementsMet(node.GetLabelValue, nil, jctx.PodRequirements.NodeSelector); !matches {
+			return false, nil
+		}
+		if matches, _, err := NodeAffinityRequirementsMet(node, jctx.PodRequirements.GetAffinityNodeSelector()); !matches || err != nil {
+			return false, err
+		}
+	}
+	if constraint.NodeTaintsPolicy != nil && *constraint.NodeTaintsPolicy == v1.NodeInclusionPolicyHonor {
+		if matches, _ := NodeTolerationRequirementsMet(node, jctx.AdditionalTolerations, jctx.PodRequirements.Tolerations); !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// topologySpreadConstraintSelector returns the selector of a topology spread constraint,
+// including the labels of the pod listed in MatchLabelKeys.
+func topologySpreadConstraintSelector(constraint *v1.TopologySpreadConstraint, podLabels map[string]string) (labels.Selector, error) {
+	selector, err := metav1.LabelSelectorAsSelector(constraint.LabelSelector)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, key := range constraint.MatchLabelKeys {
+		value, ok := podLabels[key]
+		if !ok {
+			continue
+		}
+		requirement, err := labels.NewRequirement(key, "=", []string{value})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		selector = selector.Add(*requirement)
+	}
+	return selector, nil
+}
+
+// Met returns true if scheduling the job on node would satisfy all inter-pod constraints.
+// If not, it returns the reason why.
+func (constraints *interPodConstraints) Met(node *internaltypes.Node) (bool, PodRequirementsNotMetReason) {
+	for _, term := range constraints.affinityTerms {
+		domain, ok := node.GetLabelValue(term.term.TopologyKey)
+		if !ok {
+			return false, &MissingLabel{Label: term.term.TopologyKey}
+		}
+		if !constraints.affinityTermsSelfSatisfied && !term.domains[domain] {
+			return false, &UnmatchedPodAffinity{TopologyKey: term.term.TopologyKey}
+		}
+	}
+	for _, term := range constraints.antiAffinityTerms {
+		if domain, ok := node.GetLabelValue(term.term.TopologyKey); ok && term.domains[domain] {
+			return false, &MatchedPodAntiAffinity{TopologyKey: term.term.TopologyKey}
+		}
+	}
+	for topologyKey, term := range constraints.existingAntiAffinityTerms {
+		if domain, ok := node.GetLabelValue(topologyKey); ok && term.domains[domain] {
+			return false, &MatchedPodAntiAffinity{TopologyKey: topologyKey, OfBoundJob: true}
+		}
+	}
+	for _, spreadConstraint := range constraints.spreadConstraints {
+		topologyKey := spreadConstraint.constraint.TopologyKey
+		domain, ok := node.GetLabelValue(topologyKey)
+		if !ok {
+			return false, &MissingLabel{Label: topologyKey}
+		}
+		skew := spreadConstraint.numMatchingJobsByDomain[domain] + spreadConstraint.selfMatch - spreadConstraint.minNumMatchingJobs
+		if skew > int(spreadConstraint.constraint.MaxSkew) {
+			return false, &UnmetTopologySpreadConstraint{TopologyKey: topologyKey, MaxSkew: spreadConstraint.constraint.MaxSkew}
+		}
+	}
+	return true, nil
+}
+
+// antiAffinityOnly returns the pod anti-affinity terms of constraints, of both the job and bound jobs,
+// or nil if there are none.
+// Evicted jobs being rescheduled onto their node are checked against these only:
+// jobs they have affinity with or are spread across domains with may have been evicted too and not yet be rebound,
+// whereas a job bound in the domain of an anti-affinity term since the eviction conflicts with the evicted job.
+func (constraints *interPodConstraints) antiAffinityOnly() *interPodConstraints {
+	if constraints == nil || (len(constraints.antiAffinityTerms) == 0 && len(constraints.existingAntiAffinityTerms) == 0) {
+		return nil
+	}
+	return &interPodConstraints{
+		antiAffinityTerms:         constraints.antiAffinityTerms,
+		existingAntiAffinityTerms: constraints.existingAntiAffinityTerms,
+	}
+}
+
+// minMatchingJobs returns the minimum number of matching jobs across eligible domains.
+// If there are fewer eligible domains than the constraint's MinDomains, the minimum is zero.
+func (d *topologySpreadConstraintDomains) minMatchingJobs() int {
+	if d.constraint.MinDomains != nil && len(d.numMatchingJobsByDomain) < int(*d.constraint.MinDomains) {
+		return 0
+	}
+	min := math.MaxInt
+	for _, n := range d.numMatchingJobsByDomain {
+		if n < min {
+			min = n
+		}
+	}
+	if min == math.MaxInt {
+		return 0
+	}
+	return min
+}
+
+// recordBoundJob records job as bound to a node, so that it's added to the boundJobs table when the node is upserted.
+func (nodeDb *NodeDb) recordBoundJob(job *jobdb.Job) {
+	nodeDb.boundJobsById[job.Id()] = job
+}
+
+// boundJob is an entry of the boundJobs table, which indexes the jobs bound to each node, i.e., those allocated to it and
+// not evicted, by their labels. It's kept in sync with the nodes table as nodes are upserted, so it's consistent with the
+// nodes of every txn, and inter-pod constraints can be evaluated by looking up the bound jobs matching them.
+type boundJob struct {
+	NodeId string
+	JobId  string
+	// Labels of the job.
+	Labels map[string]string
+	// True if the job has required pod anti-affinity terms.
+	HasPodAntiAffinity bool
+	job                *jobdb.Job
+	// Node the job is bound to when the entry was created; only used for its labels, which don't change.
+	node *internaltypes.Node
+}
+
+func boundJobsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: boundJobsTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			"id": {
+				Name:   "id",
+				Unique: true,
+				Indexer: &memdb.CompoundIndex{Indexes: []memdb.Indexer{
+					&memdb.StringFieldIndex{Field: "NodeId"},
+					&memdb.StringFieldIndex{Field: "JobId"},
+				}},
+			},
+			"node": {
+				Name:    "node",
+				Indexer: &memdb.StringFieldIndex{Field: "NodeId"},
+			},
+			"labels": {
+				Name:         "labels",
+				AllowMissing: true,
+				Indexer:      &memdb.StringMapFieldIndex{Field: "Labels"},
+			},
+			"podAntiAffinity": {
+				Name: "podAntiAffinity",
+				Indexer: &memdb.ConditionalIndex{Conditional: func(obj interface{}) (bool, error) {
+					return obj.(*boundJob).HasPodAntiAffinity, nil
+				}},
+			},
+		},
+	}
+}
+
+// upsertBoundJobsWithTxn updates the entries of the boundJobs table for node to match the jobs bound to it.
+func (nodeDb *NodeDb) upsertBoundJobsWithTxn(txn *memdb.Txn, node *internaltypes.Node) error {
+	it, err := txn.Get(boundJobsTableName, "node", node.GetId())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	unbound := make(map[string]*boundJob)
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		entry := obj.(*boundJob)
+		unbound[entry.JobId] = entry
+	}
+	for jobId := range node.AllocatedByJobId {
+		if node.EvictedJobRunIds[jobId] {
+			continue
+		}
+		if _, ok := unbound[jobId]; ok {
+			delete(unbound, jobId)
+			continue
+		}
+		job, ok := nodeDb.boundJobsById[jobId]
+		if !ok {
+			continue
+		}
+		req := job.PodRequirements()
+		entry := &boundJob{
+			NodeId:             node.GetId(),
+			JobId:              jobId,
+			Labels:             req.Labels,
+			HasPodAntiAffinity: len(req.GetPodAntiAffinityTerms()) > 0,
+			job:                job,
+			node:               node,
+		}
+		if err := txn.Insert(boundJobsTableName, entry); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, entry := range unbound {
+		if err := txn.Delete(boundJobsTableName, entry); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func getBoundJobsWithPodAntiAffinity(txn *memdb.Txn) ([]*boundJob, error) {
+	it, err := txn.Get(boundJobsTableName, "podAntiAffinity", true)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var boundJobs []*boundJob
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		boundJobs = append(boundJobs, obj.(*boundJob))
+	}
+	return boundJobs, nil
+}
+
+// forEachBoundJobMatching calls f with each bound job whose labels match selector.
+// If the selector requires a label to have one of a set of values, only the jobs with one of those are looked up;
+// otherwise all bound jobs are checked.
+func forEachBoundJobMatching(txn *memdb.Txn, selector labels.Selector, f func(*boundJob)) error {
+	var iterators []memdb.ResultIterator
+	requirements, _ := selector.Requirements()
+	for _, requirement := range requirements {
+		operator := requirement.Operator()
+		if operator != selection.Equals && operator != selection.DoubleEquals && operator != selection.In {
+			continue
+		}
+		for _, value := range requirement.Values().List() {
+			it, err := txn.Get(boundJobsTableName, "labels", requirement.Key(), value)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			iterators = append(iterators, it)
+		}
+		break
+	}
+	if len(iterators) == 0 {
+		it, err := txn.LowerBound(boundJobsTableName, "node", "")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		iterators = append(iterators, it)
+	}
+	for _, it := range iterators {
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			if entry := obj.(*boundJob); selector.Matches(labels.Set(entry.Labels)) {
+				f(entry)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package nodedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/scheduling/context"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+)
+
+const testZoneLabel = "topology.kubernetes.io/zone"
+
+func TestInterPodConstraints(t *testing.T) {
+	webAntiAffinity := &v1.Affinity{
+		PodAntiAffinity: &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+				{LabelSelector: appSelector("web"), TopologyKey: testfixtures.TestHostnameLabel},
+			},
+		},
+	}
+	dbAffinity := &v1.Affinity{
+		PodAffinity: &v1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+				{LabelSelector: appSelector("db"), TopologyKey: testZoneLabel},
+			},
+		},
+	}
+	nodeAffinityPolicyIgnore := v1.NodeInclusionPolicyIgnore
+	zoneSpread := []v1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: testZoneLabel, WhenUnsatisfiable: v1.DoNotSchedule, LabelSelector: appSelector("web")},
+	}
+
+	tests := map[string]struct {
+		// Zone of each node.
+		Zones []string
+		// Jobs to schedule one at a time.
+		Jobs []*jobdb.Job
+		// For each job, the index of the node it's expected to be scheduled on, or -1 if it's expected to be unschedulable.
+		ExpectedNodeIndices []int
+		// Reason the last job is unschedulable, if it is.
+		ExpectedReason PodRequirementsNotMetReason
+	}{
+		"pod anti-affinity": {
+			Zones: []string{"a", "a"},
+			Jobs: testJobsWithInterPodRequirements(
+				3, map[string]string{"app": "web"}, webAntiAffinity, nil,
+			),
+			ExpectedNodeIndices: []int{0, 1, -1},
+			ExpectedReason:      &MatchedPodAntiAffinity{TopologyKey: testfixtures.TestHostnameLabel},
+		},
+		"pod anti-affinity of bound job": {
+			Zones: []string{"a"},
+			Jobs: append(
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, webAntiAffinity, nil),
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, nil)...,
+			),
+			ExpectedNodeIndices: []int{0, -1},
+			ExpectedReason:      &MatchedPodAntiAffinity{TopologyKey: testfixtures.TestHostnameLabel, OfBoundJob: true},
+		},
+		"pod anti-affinity in other namespace": {
+			Zones: []string{"a"},
+			Jobs: append(
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, webAntiAffinity, nil),
+				withNamespace("other", testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, webAntiAffinity, nil))...,
+			),
+			ExpectedNodeIndices: []int{0, 0},
+		},
+		"pod affinity": {
+			Zones: []string{"a", "b"},
+			Jobs: append(
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "db"}, &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{{
+								MatchExpressions: []v1.NodeSelectorRequirement{{Key: testZoneLabel, Operator: v1.NodeSelectorOpIn, Values: []string{"b"}}},
+							}},
+						},
+					},
+				}, nil),
+				testJobsWithInterPodRequirements(2, map[string]string{"app": "web"}, dbAffinity, nil)...,
+			),
+			ExpectedNodeIndices: []int{1, 1, 1},
+		},
+		"pod affinity without matching pod": {
+			Zones:               []string{"a", "b"},
+			Jobs:                testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, dbAffinity, nil),
+			ExpectedNodeIndices: []int{-1},
+			ExpectedReason:      &UnmatchedPodAffinity{TopologyKey: testZoneLabel},
+		},
+		"pod affinity satisfied by the pod itself": {
+			Zones:               []string{"a", "b"},
+			Jobs:                testJobsWithInterPodRequirements(2, map[string]string{"app": "db"}, dbAffinity, nil),
+			ExpectedNodeIndices: []int{0, 0},
+		},
+		"topology spread": {
+			Zones:               []string{"a", "a", "b"},
+			Jobs:                testJobsWithInterPodRequirements(4, map[string]string{"app": "web"}, nil, zoneSpread),
+			ExpectedNodeIndices: []int{0, 2, 0, 2},
+		},
+		"topology spread with max skew exceeded": {
+			Zones: []string{"a", "b"},
+			Jobs: append(
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, nil),
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{{
+								MatchExpressions: []v1.NodeSelectorRequirement{{Key: testZoneLabel, Operator: v1.NodeSelectorOpIn, Values: []string{"a"}}},
+							}},
+						},
+					},
+				}, []v1.TopologySpreadConstraint{
+					{
+						MaxSkew:            1,
+						TopologyKey:        testZoneLabel,
+						WhenUnsatisfiable:  v1.DoNotSchedule,
+						LabelSelector:      appSelector("web"),
+						NodeAffinityPolicy: &nodeAffinityPolicyIgnore,
+					},
+				})...,
+			),
+			ExpectedNodeIndices: []int{0, -1},
+			ExpectedReason:      &UnmetTopologySpreadConstraint{TopologyKey: testZoneLabel, MaxSkew: 1},
+		},
+		"topology spread ignores nodes not matching node selector": {
+			Zones: []string{"a", "b"},
+			Jobs: append(
+				testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, nil),
+				withNodeSelector(
+					map[string]string{testZoneLabel: "a"},
+					testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, zoneSpread),
+				)...,
+			),
+			ExpectedNodeIndices: []int{0, 0},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			nodes := make([]*internaltypes.Node, len(tc.Zones))
+			for i, zone := range tc.Zones {
+				nodes[i] = testZonedNode(zone)
+			}
+			nodeDb, err := newNodeDbWithNodes(nodes)
+			require.NoError(t, err)
+
+			require.Equal(t, len(tc.Jobs), len(tc.ExpectedNodeIndices))
+			for i, job := range tc.Jobs {
+				txn := nodeDb.Txn(true)
+				jctx := context.JobSchedulingContextFromJob(job)
+				ok, err := nodeDb.ScheduleManyWithTxn(txn, context.NewGangSchedulingContext([]*context.JobSchedulingContext{jctx}))
+				require.NoError(t, err)
+				if tc.ExpectedNodeIndices[i] == -1 {
+					txn.Abort()
+					assert.False(t, ok, "job %d", i)
+					if tc.ExpectedReason != nil {
+						assert.Contains(t, jctx.PodSchedulingContext.NumExcludedNodesByReason, tc.ExpectedReason.String())
+					}
+					continue
+				}
+				txn.Commit()
+				require.True(t, ok, "job %d", i)
+				assert.Equal(t, nodes[tc.ExpectedNodeIndices[i]].GetId(), jctx.PodSchedulingContext.NodeId, "job %d", i)
+			}
+		})
+	}
+}
+
+func TestInterPodConstraints_EvictedJobsIgnored(t *testing.T) {
+	webAntiAffinity := &v1.Affinity{
+		PodAntiAffinity: &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+				{LabelSelector: appSelector("web"), TopologyKey: testfixtures.TestHostnameLabel},
+			},
+		},
+	}
+	node := testZonedNode("a")
+	nodeDb, err := newNodeDbWithNodes([]*internaltypes.Node{node})
+	require.NoError(t, err)
+	boundJob := testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, webAntiAffinity, nil)[0]
+	job := testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, nil)[0]
+
+	txn := nodeDb.Txn(true)
+	defer txn.Abort()
+	node, err = nodeDb.BindJobToNode(node, boundJob, 0)
+	require.NoError(t, err)
+	require.NoError(t, nodeDb.UpsertWithTxn(txn, node))
+	constraints, err := nodeDb.newInterPodConstraints(txn, context.JobSchedulingContextFromJob(job))
+	require.NoError(t, err)
+	require.NotNil(t, constraints)
+	ok, _ := constraints.Met(node)
+	assert.False(t, ok)
+
+	// Once the job with anti-affinity is evicted, the job has no inter-pod constraints to meet.
+	node, err = nodeDb.EvictJobsFromNode([]*jobdb.Job{boundJob}, node)
+	require.NoError(t, err)
+	require.NoError(t, nodeDb.UpsertWithTxn(txn, node))
+	constraints, err = nodeDb.newInterPodConstraints(txn, context.JobSchedulingContextFromJob(job))
+	require.NoError(t, err)
+	assert.Nil(t, constraints)
+}
+
+func TestInterPodConstraints_RescheduleEvictedJob(t *testing.T) {
+	antiAffinity := func(app string) *v1.Affinity {
+		return &v1.Affinity{
+			PodAntiAffinity: &v1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+					{LabelSelector: appSelector(app), TopologyKey: testZoneLabel},
+				},
+			},
+		}
+	}
+	tests := map[string]struct {
+		// Job evicted from the first node and then rescheduled onto it.
+		EvictedJob *jobdb.Job
+		// Job scheduled in between; nil if none is.
+		Job            *jobdb.Job
+		ExpectSuccess  bool
+		ExpectedReason PodRequirementsNotMetReason
+	}{
+		"no conflicting job": {
+			EvictedJob:    testJobsWithInterPodRequirements(1, map[string]string{"app": "a"}, antiAffinity("b"), nil)[0],
+			ExpectSuccess: true,
+		},
+		"job matches anti-affinity of evicted job": {
+			EvictedJob:     testJobsWithInterPodRequirements(1, map[string]string{"app": "a"}, antiAffinity("b"), nil)[0],
+			Job:            testJobsWithInterPodRequirements(1, map[string]string{"app": "b"}, nil, nil)[0],
+			ExpectedReason: &MatchedPodAntiAffinity{TopologyKey: testZoneLabel},
+		},
+		"evicted job matches anti-affinity of job": {
+			EvictedJob:     testJobsWithInterPodRequirements(1, map[string]string{"app": "a"}, nil, nil)[0],
+			Job:            testJobsWithInterPodRequirements(1, map[string]string{"app": "b"}, antiAffinity("a"), nil)[0],
+			ExpectedReason: &MatchedPodAntiAffinity{TopologyKey: testZoneLabel, OfBoundJob: true},
+		},
+		"unrelated job": {
+			EvictedJob:    testJobsWithInterPodRequirements(1, map[string]string{"app": "a"}, antiAffinity("b"), nil)[0],
+			Job:           testJobsWithInterPodRequirements(1, map[string]string{"app": "c"}, nil, nil)[0],
+			ExpectSuccess: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			nodes := []*internaltypes.Node{testZonedNode("a"), testZonedNode("a")}
+			nodeDb, err := newNodeDbWithNodes(nodes)
+			require.NoError(t, err)
+
+			txn := nodeDb.Txn(true)
+			defer txn.Abort()
+			node, err := nodeDb.BindJobToNode(nodes[0], tc.EvictedJob, 0)
+			require.NoError(t, err)
+			node, err = nodeDb.EvictJobsFromNode([]*jobdb.Job{tc.EvictedJob}, node)
+			require.NoError(t, err)
+			require.NoError(t, nodeDb.UpsertWithTxn(txn, node))
+
+			// The job is scheduled in the zone the evicted job was bound in, since the evicted job doesn't constrain it.
+			if tc.Job != nil {
+				ok, err := nodeDb.ScheduleManyWithTxn(txn, context.NewGangSchedulingContext([]*context.JobSchedulingContext{context.JobSchedulingContextFromJob(tc.Job)}))
+				require.NoError(t, err)
+				require.True(t, ok)
+			}
+
+			jctx := context.JobSchedulingContextFromJob(tc.EvictedJob)
+			jctx.SetAssignedNode(node)
+			ok, err := nodeDb.ScheduleManyWithTxn(txn, context.NewGangSchedulingContext([]*context.JobSchedulingContext{jctx}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectSuccess, ok)
+			if tc.ExpectSuccess {
+				assert.Equal(t, node.GetId(), jctx.PodSchedulingContext.NodeId)
+			} else {
+				assert.Contains(t, jctx.PodSchedulingContext.NumExcludedNodesByReason, tc.ExpectedReason.String())
+			}
+		})
+	}
+}
+
+func TestForEachBoundJobMatching(t *testing.T) {
+	nodes := []*internaltypes.Node{testZonedNode("a"), testZonedNode("b")}
+	nodeDb, err := newNodeDbWithNodes(nodes)
+	require.NoError(t, err)
+	jobs := append(
+		testJobsWithInterPodRequirements(2, map[string]string{"app": "web"}, nil, nil),
+		testJobsWithInterPodRequirements(1, map[string]string{"app": "db"}, nil, nil)...,
+	)
+	txn := nodeDb.Txn(true)
+	defer txn.Abort()
+	for i, job := range jobs {
+		node, err := nodeDb.BindJobToNode(nodes[i%2], job, 0)
+		require.NoError(t, err)
+		nodes[i%2] = node
+		require.NoError(t, nodeDb.UpsertWithTxn(txn, node))
+	}
+
+	tests := map[string]struct {
+		selector       *metav1.LabelSelector
+		expectedJobIds []string
+	}{
+		"match labels": {
+			selector:       appSelector("web"),
+			expectedJobIds: []string{jobs[0].Id(), jobs[1].Id()},
+		},
+		"in": {
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db", "cache"}},
+			}},
+			expectedJobIds: []string{jobs[2].Id()},
+		},
+		"not in": {
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"db"}},
+			}},
+			expectedJobIds: []string{jobs[0].Id(), jobs[1].Id()},
+		},
+		"empty": {
+			selector:       &metav1.LabelSelector{},
+			expectedJobIds: []string{jobs[0].Id(), jobs[1].Id(), jobs[2].Id()},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			selector, err := metav1.LabelSelectorAsSelector(tc.selector)
+			require.NoError(t, err)
+			var jobIds []string
+			require.NoError(t, forEachBoundJobMatching(txn, selector, func(boundJob *boundJob) {
+				jobIds = append(jobIds, boundJob.JobId)
+			}))
+			assert.ElementsMatch(t, tc.expectedJobIds, jobIds)
+		})
+	}
+}
+
+func TestSchedulingKeyIgnoredForJobsWithInterPodConstraints(t *testing.T) {
+	jobs := testJobsWithInterPodRequirements(1, map[string]string{"app": "web"}, nil, []v1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: testZoneLabel, WhenUnsatisfiable: v1.DoNotSchedule, LabelSelector: appSelector("web")},
+	})
+	_, ok := context.JobSchedulingContextFromJob(jobs[0]).SchedulingKey()
+	assert.False(t, ok)
+}
+
+func appSelector(app string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}
+}
+
+func testZonedNode(zone string) *internaltypes.Node {
+	node := testfixtures.Test32CpuNode(testfixtures.TestPriorities)
+	labels := node.GetLabels()
+	labels[testZoneLabel] = zone
+	return testfixtures.TestNodeFactory.CreateNodeAndType(
+		node.GetId(),
+		node.GetExecutor(),
+		node.GetName(),
+		node.GetPool(),
+		node.GetReportingNodeType(),
+		false,
+		node.GetTaints(),
+		labels,
+		node.GetTotalResources(),
+		node.GetAllocatableResources(),
+		node.AllocatableByPriority,
+	)
+}
+
+func testJobsWithInterPodRequirements(
+	n int,
+	labels map[string]string,
+	affinity *v1.Affinity,
+	topologySpreadConstraints []v1.TopologySpreadConstraint,
+) []*jobdb.Job {
+	jobs := testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, n)
+	for i, job := range jobs {
+		schedulingInfo := job.JobSchedulingInfo().DeepCopy()
+		schedulingInfo.PodRequirements.Labels = labels
+		schedulingInfo.PodRequirements.Affinity = affinity.DeepCopy()
+		schedulingInfo.PodRequirements.TopologySpreadConstraints = topologySpreadConstraints
+		job, err := job.WithJobSchedulingInfo(schedulingInfo)
+		if err != nil {
+			panic(err)
+		}
+		jobs[i] = job
+	}
+	return jobs
+}
+
+func withNamespace(namespace string, jobs []*jobdb.Job) []*jobdb.Job {
+	for _, job := range jobs {
+		job.PodRequirements().Namespace = namespace
+	}
+	return jobs
+}
+
+func withNodeSelector(nodeSelector map[string]string, jobs []*jobdb.Job) []*jobdb.Job {
+	for _, job := range jobs {
+		job.PodRequirements().NodeSelector = nodeSelector
+	}
+	return jobs
+}
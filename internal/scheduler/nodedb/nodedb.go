@@ -144,6 +144,10 @@ type NodeDb struct {
 	// delete entries from scheduledAtPriorityByJobId in general) and every
 	// scheduling round uses a fresh NodeDb.
 	scheduledAtPriorityByJobId map[string]int32
+	// Map from job ID to jobs bound to nodes, used to add the jobs bound to a node to the boundJobs table as it's upserted.
+	// Like scheduledAtPriorityByJobId, entries are never removed;
+	// the jobs bound to a particular node are given by the node's AllocatedByJobId and EvictedJobRunIds.
+	boundJobsById map[string]*jobdb.Job
 
 	resourceListFactory *internaltypes.ResourceListFactory
 }
@@ -210,6 +214,7 @@ func NewNodeDb(
 		podRequirementsNotMetReasonStringCache: make(map[uint64]string, 128),
 
 		scheduledAtPriorityByJobId: make(map[string]int32),
+		boundJobsById:              make(map[string]*jobdb.Job),
 		resourceListFactory:        resourceListFactory,
 	}
 
@@ -345,6 +350,11 @@ func (nodeDb *NodeDb) GetNodesWithTxn(txn *memdb.Txn) ([]*internaltypes.Node, er
 }
 
 func (nodeDb *NodeDb) ScheduleManyWithTxn(txn *memdb.Txn, gctx *context.GangSchedulingContext) (bool, error) {
+	// Elastic gangs are scheduled successfully if at least their minimum cardinality is scheduled;
+	// the jobs that didn't fit are left without a node.
+	maxUnschedulable := gctx.Cardinality() - gctx.MinimumCardinality()
+	numUnschedulable := 0
+
 	// Attempt to schedule pods one by one in a transaction.
 	for _, jctx := range gctx.JobSchedulingContexts {
 		// In general, we may attempt to schedule a gang multiple times (in
@@ -372,7 +382,10 @@ func (nodeDb *NodeDb) ScheduleManyWithTxn(txn *memdb.Txn, gctx *context.GangSche
 				return false, err
 			}
 		} else {
-			return false, nil
+			numUnschedulable++
+			if numUnschedulable > maxUnschedulable {
+				return false, nil
+			}
 		}
 	}
 	return true, nil
@@ -409,27 +422,26 @@ func (nodeDb *NodeDb) SelectNodeForJobWithTxn(txn *memdb.Txn, jctx *context.JobS
 
 	// For pods that failed to schedule, add an exclusion reason for implicitly excluded nodes.
 	defer func() {
-		if pctx.NodeId != "" {
-			return
-		}
-		numExplicitlyExcludedNodes := 0
-		for _, count := range pctx.NumExcludedNodesByReason {
-			numExplicitlyExcludedNodes += count
-		}
-		numImplicitlyExcludedNodes := pctx.NumNodes - numExplicitlyExcludedNodes
-		if numImplicitlyExcludedNodes > 0 {
-			pctx.NumExcludedNodesByReason[PodRequirementsNotMetReasonInsufficientResources] += numImplicitlyExcludedNodes
+		if pctx.NodeId == "" {
+			addImplicitlyExcludedNodes(pctx.NumExcludedNodesByReason, pctx.NumNodes)
 		}
 	}()
 
 	// If the nodeIdLabel selector is set, consider only that node.
+	// Jobs bound since the job was evicted may conflict with its pod anti-affinity terms, or it with theirs;
+	// in that case the job isn't rescheduled and is preempted instead.
 	if nodeId := jctx.GetAssignedNodeId(); nodeId != "" {
+		constraints, err := nodeDb.newInterPodConstraints(txn, jctx)
+		if err != nil {
+			return nil, err
+		}
 		if it, err := txn.Get("nodes", "id", nodeId); err != nil {
 			return nil, errors.WithStack(err)
 		} else {
-			if node, err := nodeDb.selectNodeForPodWithItAtPriority(it, jctx, priority, true); err != nil {
+			if node, err := nodeDb.selectNodeForPodWithItAtPriority(it, jctx, constraints.antiAffinityOnly(), priority, true); err != nil {
 				return nil, err
 			} else {
+				recordNodeTypeAttempt(pctx, "")
 				jctx.PodSchedulingContext.SchedulingMethod = context.Rescheduled
 				return node, nil
 			}
@@ -440,6 +452,7 @@ func (nodeDb *NodeDb) SelectNodeForJobWithTxn(txn *memdb.Txn, jctx *context.JobS
 	if err != nil {
 		return nil, err
 	}
+	recordNodeTypeAttempt(pctx, "")
 	if node != nil {
 		return node, nil
 	}
@@ -455,6 +468,7 @@ func (nodeDb *NodeDb) SelectNodeForJobWithTxn(txn *memdb.Txn, jctx *context.JobS
 			if err != nil {
 				return nil, err
 			}
+			recordNodeTypeAttempt(pctx, awayNodeType.WellKnownNodeTypeName)
 			if node != nil {
 				pctx.WellKnownNodeTypeName = awayNodeType.WellKnownNodeTypeName
 				pctx.SchedulingMethod = context.ScheduledAsAwayJob
@@ -467,6 +481,34 @@ func (nodeDb *NodeDb) SelectNodeForJobWithTxn(txn *memdb.Txn, jctx *context.JobS
 	return nil, nil
 }
 
+// recordNodeTypeAttempt appends the outcome of the attempt just made to schedule the pod on nodes of the given type to pctx.
+func recordNodeTypeAttempt(pctx *context.PodSchedulingContext, wellKnownNodeTypeName string) {
+	numExcludedNodesByReason := maps.Clone(pctx.NumExcludedNodesByReason)
+	if numExcludedNodesByReason == nil {
+		numExcludedNodesByReason = make(map[string]int)
+	}
+	if pctx.NodeId == "" {
+		addImplicitlyExcludedNodes(numExcludedNodesByReason, pctx.NumNodes)
+	}
+	pctx.NodeTypeAttempts = append(pctx.NodeTypeAttempts, &context.NodeTypeSchedulingAttempt{
+		WellKnownNodeTypeName:    wellKnownNodeTypeName,
+		NodeId:                   pctx.NodeId,
+		NumExcludedNodesByReason: numExcludedNodesByReason,
+	})
+}
+
+// addImplicitlyExcludedNodes attributes any of the numNodes nodes not explicitly excluded in numExcludedNodesByReason to insufficient resources.
+func addImplicitlyExcludedNodes(numExcludedNodesByReason map[string]int, numNodes int) {
+	numExplicitlyExcludedNodes := 0
+	for _, count := range numExcludedNodesByReason {
+		numExplicitlyExcludedNodes += count
+	}
+	numImplicitlyExcludedNodes := numNodes - numExplicitlyExcludedNodes
+	if numImplicitlyExcludedNodes > 0 {
+		numExcludedNodesByReason[PodRequirementsNotMetReasonInsufficientResources] += numImplicitlyExcludedNodes
+	}
+}
+
 func (nodeDb *NodeDb) selectNodeForJobWithTxnAndAwayNodeType(
 	txn *memdb.Txn,
 	jctx *context.JobSchedulingContext,
@@ -511,9 +553,14 @@ func (nodeDb *NodeDb) selectNodeForJobWithTxnAtPriority(
 		return nil, err
 	}
 
+	constraints, err := nodeDb.newInterPodConstraints(txn, jctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Try scheduling at evictedPriority. If this succeeds, no preemption is necessary.
 	pctx.NumExcludedNodesByReason = maps.Clone(numExcludedNodesByReason)
-	if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, matchingNodeTypeIds, internaltypes.EvictedPriority); err != nil {
+	if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, constraints, matchingNodeTypeIds, internaltypes.EvictedPriority); err != nil {
 		return nil, err
 	} else if err := assertPodSchedulingContextNode(pctx, node); err != nil {
 		return nil, err
@@ -525,7 +572,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithTxnAtPriority(
 	// Try scheduling at the job priority. If this fails, scheduling is impossible and we return.
 	// This is an optimisation to avoid looking for preemption targets for unschedulable jobs.
 	pctx.NumExcludedNodesByReason = maps.Clone(numExcludedNodesByReason)
-	if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, matchingNodeTypeIds, pctx.ScheduledAtPriority); err != nil {
+	if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, constraints, matchingNodeTypeIds, pctx.ScheduledAtPriority); err != nil {
 		return nil, err
 	} else if err := assertPodSchedulingContextNode(pctx, node); err != nil {
 		return nil, err
@@ -537,7 +584,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithTxnAtPriority(
 
 	// Schedule by preventing evicted jobs from being re-scheduled.
 	// This method respect fairness by preventing from re-scheduling jobs that appear as far back in the total order as possible.
-	if node, err := nodeDb.selectNodeForJobWithFairPreemption(txn, jctx); err != nil {
+	if node, err := nodeDb.selectNodeForJobWithFairPreemption(txn, jctx, constraints); err != nil {
 		return nil, err
 	} else if err := assertPodSchedulingContextNode(pctx, node); err != nil {
 		return nil, err
@@ -551,7 +598,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithTxnAtPriority(
 
 	// Schedule by kicking off jobs currently bound to a node.
 	// This method does not respect fairness when choosing on which node to schedule the job.
-	if node, err := nodeDb.selectNodeForJobWithUrgencyPreemption(txn, jctx, matchingNodeTypeIds); err != nil {
+	if node, err := nodeDb.selectNodeForJobWithUrgencyPreemption(txn, jctx, constraints, matchingNodeTypeIds); err != nil {
 		return nil, err
 	} else if err := assertPodSchedulingContextNode(pctx, node); err != nil {
 		return nil, err
@@ -580,6 +627,7 @@ func assertPodSchedulingContextNode(pctx *context.PodSchedulingContext, node *in
 func (nodeDb *NodeDb) selectNodeForJobWithUrgencyPreemption(
 	txn *memdb.Txn,
 	jctx *context.JobSchedulingContext,
+	constraints *interPodConstraints,
 	matchingNodeTypeIds []uint64,
 ) (*internaltypes.Node, error) {
 	pctx := jctx.PodSchedulingContext
@@ -601,7 +649,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithUrgencyPreemption(
 		pctx.NumExcludedNodesByReason = maps.Clone(numExcludedNodesByReason)
 
 		// Try to find a node at this priority.
-		if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, matchingNodeTypeIds, priority); err != nil {
+		if node, err := nodeDb.selectNodeForPodAtPriority(txn, jctx, constraints, matchingNodeTypeIds, priority); err != nil {
 			return nil, err
 		} else if err := assertPodSchedulingContextNode(pctx, node); err != nil {
 			return nil, err
@@ -615,6 +663,7 @@ func (nodeDb *NodeDb) selectNodeForJobWithUrgencyPreemption(
 func (nodeDb *NodeDb) selectNodeForPodAtPriority(
 	txn *memdb.Txn,
 	jctx *context.JobSchedulingContext,
+	constraints *interPodConstraints,
 	matchingNodeTypeIds []uint64,
 	priority int32,
 ) (*internaltypes.Node, error) {
@@ -644,7 +693,7 @@ func (nodeDb *NodeDb) selectNodeForPodAtPriority(
 		return nil, err
 	}
 
-	if node, err := nodeDb.selectNodeForPodWithItAtPriority(it, jctx, priority, false); err != nil {
+	if node, err := nodeDb.selectNodeForPodWithItAtPriority(it, jctx, constraints, priority, false); err != nil {
 		return nil, err
 	} else if node != nil {
 		return node, nil
@@ -653,9 +702,12 @@ func (nodeDb *NodeDb) selectNodeForPodAtPriority(
 	return nil, nil
 }
 
+// selectNodeForPodWithItAtPriority returns the first node returned by it onto which the job can be scheduled at priority.
+// If constraints is nil, inter-pod affinity and topology spread constraints are not checked.
 func (nodeDb *NodeDb) selectNodeForPodWithItAtPriority(
 	it memdb.ResultIterator,
 	jctx *context.JobSchedulingContext,
+	constraints *interPodConstraints,
 	priority int32,
 	onlyCheckDynamicRequirements bool,
 ) (*internaltypes.Node, error) {
@@ -685,6 +737,9 @@ func (nodeDb *NodeDb) selectNodeForPodWithItAtPriority(
 		if err != nil {
 			return nil, err
 		}
+		if matches && constraints != nil {
+			matches, reason = constraints.Met(node)
+		}
 
 		if matches {
 			selectedNode = node
@@ -707,7 +762,11 @@ func (nodeDb *NodeDb) selectNodeForPodWithItAtPriority(
 //
 // It does this by considering all evicted jobs in the reverse order they would be scheduled in and preventing
 // from being re-scheduled the jobs that would be scheduled last.
-func (nodeDb *NodeDb) selectNodeForJobWithFairPreemption(txn *memdb.Txn, jctx *context.JobSchedulingContext) (*internaltypes.Node, error) {
+func (nodeDb *NodeDb) selectNodeForJobWithFairPreemption(
+	txn *memdb.Txn,
+	jctx *context.JobSchedulingContext,
+	constraints *interPodConstraints,
+) (*internaltypes.Node, error) {
 	type consideredNode struct {
 		node                     *internaltypes.Node
 		availableResource        internaltypes.ResourceList
@@ -764,6 +823,10 @@ func (nodeDb *NodeDb) selectNodeForJobWithFairPreemption(txn *memdb.Txn, jctx *c
 		if err != nil {
 			return nil, err
 		}
+		// Evicted jobs are already disregarded by constraints, so preempting more jobs on this node won't help meet them.
+		if staticRequirementsMet && constraints != nil {
+			staticRequirementsMet, reason = constraints.Met(node.node)
+		}
 		if !staticRequirementsMet {
 			node.staticRequirementsNotMet = true
 			s := nodeDb.stringFromPodRequirementsNotMetReason(reason)
@@ -842,6 +905,7 @@ func (nodeDb *NodeDb) bindJobToNodeInPlace(node *internaltypes.Node, job *jobdb.
 	}
 
 	nodeDb.scheduledAtPriorityByJobId[jobId] = priority
+	nodeDb.recordBoundJob(job)
 
 	return nil
 }
@@ -1037,7 +1101,7 @@ func (nodeDb *NodeDb) UpsertWithTxn(txn *memdb.Txn, node *internaltypes.Node) er
 	if err := txn.Insert("nodes", node); err != nil {
 		return errors.WithStack(err)
 	}
-	return nil
+	return nodeDb.upsertBoundJobsWithTxn(txn, node)
 }
 
 // ClearAllocated zeroes out allocated resources on all nodes in the NodeDb.
@@ -1087,10 +1151,12 @@ func (nodeDb *NodeDb) AddEvictedJobSchedulingContextWithTxn(txn *memdb.Txn, inde
 func nodeDbSchema(priorities []int32, resources []string) (*memdb.DBSchema, map[int32]string, map[int32]int) {
 	nodesTable, indexNameByPriority, keyIndexByPriority := nodesTableSchema(priorities)
 	evictionsTable := evictionsTableSchema()
+	boundJobsTable := boundJobsTableSchema()
 	return &memdb.DBSchema{
 		Tables: map[string]*memdb.TableSchema{
 			nodesTable.Name:     nodesTable,
 			evictionsTable.Name: evictionsTable,
+			boundJobsTable.Name: boundJobsTable,
 		},
 	}, indexNameByPriority, keyIndexByPriority
 }
@@ -649,6 +649,13 @@ func TestAwayNodeScheduling(t *testing.T) {
 					},
 					jctx.AdditionalTolerations,
 				)
+				attempts := jctx.PodSchedulingContext.NodeTypeAttempts
+				require.Len(t, attempts, 2)
+				assert.Equal(t, "", attempts[0].WellKnownNodeTypeName)
+				assert.Empty(t, attempts[0].NodeId)
+				assert.Equal(t, 1, sumCounts(attempts[0].NumExcludedNodesByReason))
+				assert.Equal(t, "gpu", attempts[1].WellKnownNodeTypeName)
+				assert.Equal(t, node.GetId(), attempts[1].NodeId)
 			} else {
 				require.False(t, ok)
 				assert.NotNil(t, jctx.PodSchedulingContext)
@@ -660,6 +667,14 @@ func TestAwayNodeScheduling(t *testing.T) {
 	}
 }
 
+func sumCounts(countsByReason map[string]int) int {
+	sum := 0
+	for _, count := range countsByReason {
+		sum += count
+	}
+	return sum
+}
+
 func TestMakeIndexedResourceResolution(t *testing.T) {
 	supportedResources := []schedulerconfig.ResourceType{
 		{
@@ -102,6 +102,61 @@ func (r *UnmatchedNodeSelector) String() string {
 	return fmt.Sprintf("node does not match pod NodeAffinity %s", r.NodeSelector)
 }
 
+type UnmatchedPodAffinity struct {
+	TopologyKey string
+}
+
+func (r *UnmatchedPodAffinity) Sum64() uint64 {
+	h := fnv1a.Init64
+	h = fnv1a.AddString64(h, "UnmatchedPodAffinity")
+	h = fnv1a.AddString64(h, r.TopologyKey)
+	return h
+}
+
+func (r *UnmatchedPodAffinity) String() string {
+	return fmt.Sprintf("node does not match pod PodAffinity: no matching pod in the same %s domain", r.TopologyKey)
+}
+
+type MatchedPodAntiAffinity struct {
+	TopologyKey string
+	// If true, the pod matches the anti-affinity of a pod already bound to a node, rather than its own anti-affinity.
+	OfBoundJob bool
+}
+
+func (r *MatchedPodAntiAffinity) Sum64() uint64 {
+	h := fnv1a.Init64
+	h = fnv1a.AddString64(h, "MatchedPodAntiAffinity")
+	h = fnv1a.AddString64(h, r.TopologyKey)
+	if r.OfBoundJob {
+		h = fnv1a.AddUint64(h, 1)
+	}
+	return h
+}
+
+func (r *MatchedPodAntiAffinity) String() string {
+	if r.OfBoundJob {
+		return fmt.Sprintf("node does not match PodAntiAffinity of a pod in the same %s domain", r.TopologyKey)
+	}
+	return fmt.Sprintf("node does not match pod PodAntiAffinity: matching pod in the same %s domain", r.TopologyKey)
+}
+
+type UnmetTopologySpreadConstraint struct {
+	TopologyKey string
+	MaxSkew     int32
+}
+
+func (r *UnmetTopologySpreadConstraint) Sum64() uint64 {
+	h := fnv1a.Init64
+	h = fnv1a.AddString64(h, "UnmetTopologySpreadConstraint")
+	h = fnv1a.AddString64(h, r.TopologyKey)
+	h = fnv1a.AddUint64(h, uint64(r.MaxSkew))
+	return h
+}
+
+func (r *UnmetTopologySpreadConstraint) String() string {
+	return fmt.Sprintf("node does not match pod TopologySpreadConstraint: skew across %s domains would exceed %d", r.TopologyKey, r.MaxSkew)
+}
+
 type InsufficientResources struct {
 	ResourceName string
 	Required     resource.Quantity
@@ -12,8 +12,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/pulsarutils/jobsetevents"
+	psutils "github.com/armadaproject/armada/internal/common/pulsarutils/utils"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -95,23 +97,7 @@ func (p *PulsarPublisher) PublishMessages(ctx *armadacontext.Context, events []*
 // of the producer's Pulsar topic.
 func (p *PulsarPublisher) PublishMarkers(ctx *armadacontext.Context, groupId uuid.UUID) (uint32, error) {
 	for i := 0; i < p.numPartitions; i++ {
-		pm := &armadaevents.PartitionMarker{
-			GroupId:   groupId.String(),
-			Partition: uint32(i),
-		}
-		es := &armadaevents.EventSequence{
-			Queue:      "armada-scheduler",
-			JobSetName: "armada-scheduler",
-			Events: []*armadaevents.EventSequence_Event{
-				{
-					Created: types.TimestampNow(),
-					Event: &armadaevents.EventSequence_Event_PartitionMarker{
-						PartitionMarker: pm,
-					},
-				},
-			},
-		}
-		bytes, err := proto.Marshal(es)
+		bytes, err := proto.Marshal(partitionMarker(groupId, i))
 		if err != nil {
 			return 0, err
 		}
@@ -131,12 +117,75 @@ func (p *PulsarPublisher) PublishMarkers(ctx *armadacontext.Context, groupId uui
 	return uint32(p.numPartitions), nil
 }
 
+// EventLogPublisher is an implementation of Publisher that publishes to the embedded event log rather than Pulsar.
+type EventLogPublisher struct {
+	publisher *eventlog.Publisher[*armadaevents.EventSequence]
+}
+
+func NewEventLogPublisher(
+	ctx *armadacontext.Context,
+	eventLog *eventlog.Log,
+	topic string,
+	maxEventsPerMessage int,
+	maxAllowedMessageSize uint,
+) (*EventLogPublisher, error) {
+	publisher, err := eventlog.NewPublisher[*armadaevents.EventSequence](
+		ctx,
+		eventLog,
+		topic,
+		jobsetevents.NewPreProcessor(maxEventsPerMessage, maxAllowedMessageSize),
+		jobsetevents.RetrieveKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &EventLogPublisher{publisher: publisher}, nil
+}
+
+// PublishMessages publishes all event sequences to the event log if shouldPublish() returns true
+func (p *EventLogPublisher) PublishMessages(ctx *armadacontext.Context, events []*armadaevents.EventSequence, shouldPublish func() bool) error {
+	if shouldPublish() {
+		return p.publisher.PublishMessages(ctx, events...)
+	} else {
+		return errors.New("Failed to publish as no longer leader")
+	}
+}
+
+// PublishMarkers appends an armadaevents.PartitionMarker to each partition of the event log topic.
+func (p *EventLogPublisher) PublishMarkers(ctx *armadacontext.Context, groupId uuid.UUID) (uint32, error) {
+	for i := 0; i < p.publisher.NumPartitions(); i++ {
+		if err := p.publisher.PublishToPartition(ctx, i, partitionMarker(groupId, i)); err != nil {
+			return 0, err
+		}
+	}
+	return uint32(p.publisher.NumPartitions()), nil
+}
+
+// partitionMarker returns the event sequence marking the given partition for groupId.
+func partitionMarker(groupId uuid.UUID, partition int) *armadaevents.EventSequence {
+	return &armadaevents.EventSequence{
+		Queue:      "armada-scheduler",
+		JobSetName: "armada-scheduler",
+		Events: []*armadaevents.EventSequence_Event{
+			{
+				Created: types.TimestampNow(),
+				Event: &armadaevents.EventSequence_Event_PartitionMarker{
+					PartitionMarker: &armadaevents.PartitionMarker{
+						GroupId:   groupId.String(),
+						Partition: uint32(partition),
+					},
+				},
+			},
+		},
+	}
+}
+
 // createMessageRouter returns a custom Pulsar message router that routes the message to the partition given by the
 // explicitPartitionKey msg property. If this property isn't present then it will fall back to the default Pulsar
 // message routing logic
 func createMessageRouter(options pulsar.ProducerOptions) func(*pulsar.ProducerMessage, pulsar.TopicMetadata) int {
 	defaultRouter := pulsar.NewDefaultRouter(
-		JavaStringHash,
+		psutils.JavaStringHash,
 		options.BatchingMaxMessages,
 		options.BatchingMaxSize,
 		options.BatchingMaxPublishDelay,
@@ -157,13 +206,3 @@ func createMessageRouter(options pulsar.ProducerOptions) func(*pulsar.ProducerMe
 		return defaultRouter(msg, md.NumPartitions())
 	}
 }
-
-// JavaStringHash is the default hashing algorithm used by Pulsar
-// copied from https://github.com/apache/pulsar-client-go/blob/master/pulsar/internal/hash.go
-func JavaStringHash(s string) uint32 {
-	var h uint32
-	for i, size := 0, len(s); i < size; i++ {
-		h = 31*h + uint32(s[i])
-	}
-	return h
-}
@@ -9,14 +9,18 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	"github.com/armadaproject/armada/internal/common/mocks"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	psutils "github.com/armadaproject/armada/internal/common/pulsarutils/utils"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -218,6 +222,35 @@ func (t TopicMetadata) NumPartitions() uint32 {
 	return 20
 }
 
+func TestEventLogPublisher_TestPublishMarkers(t *testing.T) {
+	err := eventlog.WithTestDb(func(db *pgxpool.Pool) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+		defer cancel()
+		eventLog := eventlog.New(db, commonconfig.EventLogConfig{NumPartitions: 4})
+		publisher, err := NewEventLogPublisher(ctx, eventLog, topic, 1000, 1024*1024)
+		require.NoError(t, err)
+
+		groupId := uuid.New()
+		published, err := publisher.PublishMarkers(ctx, groupId)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(4), published)
+
+		for partition := 0; partition < 4; partition++ {
+			messages, err := eventLog.Read(ctx, topic, partition, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, messages, 1)
+			es := &armadaevents.EventSequence{}
+			require.NoError(t, proto.Unmarshal(messages[0].Payload(), es))
+			marker := es.Events[0].GetPartitionMarker()
+			require.NotNil(t, marker)
+			assert.Equal(t, groupId.String(), marker.GroupId)
+			assert.Equal(t, uint32(partition), marker.Partition)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestMessageRouter(t *testing.T) {
 	options := pulsar.ProducerOptions{Topic: topic}
 	router := createMessageRouter(options)
@@ -237,20 +270,11 @@ func TestMessageRouter(t *testing.T) {
 			msg := &pulsar.ProducerMessage{
 				Key: key,
 			}
-			assert.Equal(t, int(JavaStringHash(key)%20), router(msg, TopicMetadata{}))
+			assert.Equal(t, int(psutils.JavaStringHash(key)%20), router(msg, TopicMetadata{}))
 		}
 	})
 }
 
-func TestJavaStringHash(t *testing.T) {
-	javaHashValues := map[string]uint32{"": 0x0, "hello": 0x5e918d2, "test": 0x364492}
-	for str, expectedHash := range javaHashValues {
-		t.Run(str, func(t *testing.T) {
-			assert.Equal(t, expectedHash, JavaStringHash(str))
-		})
-	}
-}
-
 func countEvents(es []*armadaevents.EventSequence) map[string]int {
 	countsById := make(map[string]int)
 	for _, sequence := range es {
@@ -0,0 +1,219 @@
+package reports
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openconfig/goyang/pkg/indent"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+// The functions in this file render structured reports in the human-readable form returned in the report field.
+// They're the only rendering of scheduling reports, so that the text and structured forms can't diverge.
+
+func renderSchedulingReport(pools []*schedulerobjects.PoolSchedulingReport, verbosity int32) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	for _, pool := range pools {
+		fmt.Fprintf(w, "%s:\n", pool.Pool)
+		fmt.Fprint(w, indent.String("\t", "Most recent scheduling round:\n"))
+		fmt.Fprint(w, indent.String("\t\t", renderPoolSchedulingReport(pool, verbosity)))
+	}
+	w.Flush()
+	return sb.String()
+}
+
+func renderQueueReport(queue string, pools []*schedulerobjects.PoolQueueReport, verbosity int32) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	for _, pool := range pools {
+		fmt.Fprintf(w, "%s:\n", pool.Pool)
+		if pool.Queue != nil {
+			fmt.Fprintf(w, "\tMost recent scheduling round that considered queue %s:\n", queue)
+			fmt.Fprint(w, indent.String("\t\t", renderQueueSchedulingReport(pool.Queue, verbosity)))
+		} else {
+			fmt.Fprintf(w, "\tMost recent scheduling round that considered queue %s: none\n", queue)
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
+
+func renderJobReport(jobId string, pools []*schedulerobjects.PoolJobReport) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	for _, pool := range pools {
+		fmt.Fprintf(w, "%s:\n", pool.Pool)
+		if pool.Job != nil {
+			fmt.Fprintf(w, "\tMost recent scheduling round that affected job %s:\n", jobId)
+			fmt.Fprint(w, indent.String("\t\t", renderJobSchedulingReport(pool.Job)))
+		} else {
+			fmt.Fprintf(w, "\tMost recent scheduling round that affected job %s: none\n", jobId)
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
+
+func renderPoolSchedulingReport(report *schedulerobjects.PoolSchedulingReport, verbosity int32) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	started := protoutil.ToStdTime(report.Started)
+	finished := protoutil.ToStdTime(report.Finished)
+	fmt.Fprintf(w, "Started:\t%s\n", started)
+	fmt.Fprintf(w, "Finished:\t%s\n", finished)
+	fmt.Fprintf(w, "Duration:\t%s\n", finished.Sub(started))
+	fmt.Fprintf(w, "Termination reason:\t%s\n", report.TerminationReason)
+	fmt.Fprintf(w, "Total capacity:\t%s\n", renderResources(report.TotalResources))
+	fmt.Fprintf(w, "Allocated resources:\t%s\n", renderResources(report.AllocatedResources))
+	fmt.Fprintf(w, "Scheduled resources:\t%s\n", renderResources(report.ScheduledResources))
+	fmt.Fprintf(w, "Preempted resources:\t%s\n", renderResources(report.PreemptedResources))
+	fmt.Fprintf(w, "Number of gangs scheduled:\t%d\n", report.NumScheduledGangs)
+	fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", report.NumScheduledJobs)
+	fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", report.NumPreemptedJobs)
+	var scheduled, preempted []*schedulerobjects.QueueSchedulingReport
+	for _, queue := range report.Queues {
+		if queue.NumScheduledJobs > 0 {
+			scheduled = append(scheduled, queue)
+		}
+		if queue.NumPreemptedJobs > 0 {
+			preempted = append(preempted, queue)
+		}
+	}
+	renderQueues := func(title string, queues []*schedulerobjects.QueueSchedulingReport) {
+		if verbosity <= 0 {
+			names := make([]string, len(queues))
+			for i, queue := range queues {
+				names[i] = queue.Queue
+			}
+			fmt.Fprintf(w, "%s:\t%v\n", title, names)
+			return
+		}
+		fmt.Fprintf(w, "%s:\n", title)
+		for _, queue := range queues {
+			fmt.Fprintf(w, "\t%s:\n", queue.Queue)
+			fmt.Fprint(w, indent.String("\t\t", renderQueueSchedulingReport(queue, verbosity-2)))
+		}
+	}
+	renderQueues("Scheduled queues", scheduled)
+	renderQueues("Preempted queues", preempted)
+	if len(report.DrainingNodes) > 0 {
+		fmt.Fprint(w, "Draining nodes:\n")
+		for _, node := range report.DrainingNodes {
+			fmt.Fprintf(w, "\t%s/%s:\t%d jobs, %d runs remaining (preempt: %t, reason: %s)\n",
+				node.Executor, node.Node, len(node.RemainingJobIds), len(node.RemainingRunIds), node.Preempt, node.Reason)
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
+
+func renderQueueSchedulingReport(report *schedulerobjects.QueueSchedulingReport, verbosity int32) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	if verbosity >= 0 {
+		fmt.Fprintf(w, "Time:\t%s\n", protoutil.ToStdTime(report.Time))
+		fmt.Fprintf(w, "Queue:\t%s\n", report.Queue)
+	}
+	fmt.Fprintf(w, "Weight:\t%f\n", report.Weight)
+	fmt.Fprintf(w, "Fair share:\t%f\n", report.FairShare)
+	fmt.Fprintf(w, "Adjusted fair share:\t%f\n", report.AdjustedFairShare)
+	fmt.Fprintf(w, "Uncapped adjusted fair share:\t%f\n", report.UncappedAdjustedFairShare)
+	fmt.Fprintf(w, "Actual share:\t%f\n", report.ActualShare)
+	fmt.Fprintf(w, "Scheduled resources:\t%s\n", renderResources(report.ScheduledResources))
+	fmt.Fprintf(w, "Preempted resources:\t%s\n", renderResources(report.PreemptedResources))
+	fmt.Fprintf(w, "Preempted by optimiser resources:\t%s\n", renderResources(report.PreemptedByOptimiserResources))
+	if verbosity >= 0 {
+		fmt.Fprintf(w, "Demand:\t%s\n", renderResources(report.Demand))
+		fmt.Fprintf(w, "Constrained demand:\t%s\n", renderResources(report.ConstrainedDemand))
+		fmt.Fprintf(w, "Total allocated resources after scheduling:\t%s\n", renderResources(report.AllocatedResources))
+		fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", report.NumScheduledJobs)
+		fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", report.NumPreemptedJobs)
+		fmt.Fprintf(w, "Number of jobs preempted by optimiser:\t%d\n", report.NumPreemptedByOptimiserJobs)
+		fmt.Fprintf(w, "Number of jobs that could not be scheduled:\t%d\n", report.NumUnschedulableJobs)
+		if len(report.NumUnschedulableJobsByReason) > 0 {
+			fmt.Fprint(w, "Unschedulable jobs:\n")
+			reasons := maps.Keys(report.NumUnschedulableJobsByReason)
+			// Most common reason first.
+			slices.SortFunc(reasons, func(a, b string) int {
+				if d := int(report.NumUnschedulableJobsByReason[b] - report.NumUnschedulableJobsByReason[a]); d != 0 {
+					return d
+				}
+				return strings.Compare(a, b)
+			})
+			for _, reason := range reasons {
+				if example, ok := report.ExampleUnschedulableJobIdByReason[reason]; ok {
+					fmt.Fprintf(w, "\t%d:\t%s (e.g., %s)\n", report.NumUnschedulableJobsByReason[reason], reason, example)
+				} else {
+					fmt.Fprintf(w, "\t%d:\t%s\n", report.NumUnschedulableJobsByReason[reason], reason)
+				}
+			}
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
+
+func renderJobSchedulingReport(report *schedulerobjects.JobSchedulingReport) string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
+	fmt.Fprintf(w, "Time:\t%s\n", protoutil.ToStdTime(report.Time))
+	fmt.Fprintf(w, "Job ID:\t%s\n", report.JobId)
+	if report.Queue != "" {
+		fmt.Fprintf(w, "Queue:\t%s\n", report.Queue)
+	}
+	if report.UnschedulableReason != "" {
+		fmt.Fprintf(w, "UnschedulableReason:\t%s\n", report.UnschedulableReason)
+	} else {
+		fmt.Fprint(w, "UnschedulableReason:\tnone\n")
+	}
+	if report.NodeId != "" {
+		fmt.Fprintf(w, "Node:\t%s\n", report.NodeId)
+	} else {
+		fmt.Fprint(w, "Node:\tnone\n")
+	}
+	fmt.Fprintf(w, "Number of nodes in cluster:\t%d\n", report.NumNodes)
+	for _, nodeType := range report.NodeTypes {
+		if nodeType.NodeType != "" {
+			fmt.Fprintf(w, "Away node type %s:\n", nodeType.NodeType)
+		} else {
+			fmt.Fprint(w, "Home nodes:\n")
+		}
+		if nodeType.UnschedulableReason != "" {
+			fmt.Fprintf(w, "\tUnschedulableReason:\t%s\n", nodeType.UnschedulableReason)
+		} else {
+			fmt.Fprint(w, "\tUnschedulableReason:\tnone\n")
+		}
+		if len(nodeType.NumExcludedNodesByReason) == 0 {
+			fmt.Fprint(w, "\tExcluded nodes:\tnone\n")
+		} else {
+			fmt.Fprint(w, "\tExcluded nodes:\n")
+			reasons := maps.Keys(nodeType.NumExcludedNodesByReason)
+			slices.Sort(reasons)
+			for _, reason := range reasons {
+				fmt.Fprintf(w, "\t\t%d:\t%s\n", nodeType.NumExcludedNodesByReason[reason], reason)
+			}
+		}
+	}
+	w.Flush()
+	return sb.String()
+}
+
+// renderResources renders resources in the same form as internaltypes.ResourceList.String, ordered by resource name.
+func renderResources(resources map[string]string) string {
+	if len(resources) == 0 {
+		return "(empty)"
+	}
+	names := maps.Keys(resources)
+	slices.Sort(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%s", name, resources[name])
+	}
+	return "(" + strings.Join(parts, ",") + ")"
+}
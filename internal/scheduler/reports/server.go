@@ -4,103 +4,121 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/gogo/status"
 	"github.com/oklog/ulid"
-	"github.com/openconfig/goyang/pkg/indent"
 	"google.golang.org/grpc/codes"
 
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
 )
 
 type Server struct {
 	repository *SchedulingContextRepository
+	// Used to report jobs that aren't considered for scheduling because they're waiting on their dependencies.
+	jobDb *jobdb.JobDb
 }
 
-func NewServer(repository *SchedulingContextRepository) *Server {
+func NewServer(repository *SchedulingContextRepository, jobDb *jobdb.JobDb) *Server {
 	return &Server{
 		repository: repository,
+		jobDb:      jobDb,
 	}
 }
 
 func (s *Server) GetSchedulingReport(_ context.Context, request *schedulerobjects.SchedulingReportRequest) (*schedulerobjects.SchedulingReport, error) {
-	var report string
 	verbosity := request.GetVerbosity()
 	switch filter := request.GetFilter().(type) {
 	case *schedulerobjects.SchedulingReportRequest_MostRecentForQueue:
 		queueName := strings.TrimSpace(filter.MostRecentForQueue.GetQueueName())
-		report = s.getQueueReportString(queueName, verbosity)
+		return &schedulerobjects.SchedulingReport{
+			Report: renderQueueReport(queueName, s.getPoolQueueReports(queueName), verbosity),
+		}, nil
 	case *schedulerobjects.SchedulingReportRequest_MostRecentForJob:
 		jobId := strings.TrimSpace(filter.MostRecentForJob.GetJobId())
-		report = s.getJobReportString(jobId)
+		return &schedulerobjects.SchedulingReport{
+			Report: s.renderJobReport(jobId, s.getPoolJobReports(jobId)),
+		}, nil
 	default:
-		report = s.getSchedulingReportString(verbosity)
+		pools := s.getPoolSchedulingReports()
+		return &schedulerobjects.SchedulingReport{
+			Report: renderSchedulingReport(pools, verbosity),
+			Pools:  pools,
+		}, nil
 	}
-	return &schedulerobjects.SchedulingReport{Report: report}, nil
 }
 
 func (s *Server) GetQueueReport(ctx context.Context, request *schedulerobjects.QueueReportRequest) (*schedulerobjects.QueueReport, error) {
 	queueName := strings.TrimSpace(request.GetQueueName())
 	verbosity := request.GetVerbosity()
+	pools := s.getPoolQueueReports(queueName)
 	return &schedulerobjects.QueueReport{
-		Report: s.getQueueReportString(queueName, verbosity),
+		Report: renderQueueReport(queueName, pools, verbosity),
+		Pools:  pools,
 	}, nil
 }
 
 func (s *Server) GetJobReport(ctx context.Context, request *schedulerobjects.JobReportRequest) (*schedulerobjects.JobReport, error) {
 	jobId := strings.TrimSpace(request.GetJobId())
-	if _, err := ulid.Parse(jobId); err != nil {
+	// Jobs making up a job array have ids of the form <arrayId>-<index>.
+	ulidPart := jobId
+	if arrayId, _, ok := eventutil.ParseJobArrayElementId(jobId); ok {
+		ulidPart = arrayId
+	}
+	if _, err := ulid.Parse(ulidPart); err != nil {
 		return nil, status.Newf(codes.InvalidArgument, "%s is not a valid jobId", request.GetJobId()).Err()
 	}
+	pools := s.getPoolJobReports(jobId)
 	return &schedulerobjects.JobReport{
-		Report: s.getJobReportString(jobId),
+		Report: s.renderJobReport(jobId, pools),
+		Pools:  pools,
 	}, nil
 }
 
-func (s *Server) getQueueReportString(queue string, verbosity int32) string {
+// renderJobReport renders the report of a job, stating first whether it's waiting on its dependencies, in which case
+// it's not considered for scheduling.
+func (s *Server) renderJobReport(jobId string, pools []*schedulerobjects.PoolJobReport) string {
+	report := renderJobReport(jobId, pools)
+	if s.jobDb == nil {
+		return report
+	}
+	job := s.jobDb.ReadTxn().GetById(jobId)
+	if job == nil || !job.AwaitingDependencies() {
+		return report
+	}
+	return fmt.Sprintf("Job %s is waiting for its dependencies to finish: %s\n", jobId, strings.Join(job.Dependencies(), ", ")) + report
+}
+
+func (s *Server) getPoolQueueReports(queue string) []*schedulerobjects.PoolQueueReport {
 	poolCtxts := s.repository.QueueSchedulingContext(queue)
-	var sb strings.Builder
-	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
-	for _, poolCtx := range poolCtxts {
-		fmt.Fprintf(w, "%s:\n", poolCtx.pool)
+	reports := make([]*schedulerobjects.PoolQueueReport, len(poolCtxts))
+	for i, poolCtx := range poolCtxts {
+		reports[i] = &schedulerobjects.PoolQueueReport{Pool: poolCtx.pool}
 		if poolCtx.schedulingCtx != nil {
-			fmt.Fprintf(w, "\tMost recent scheduling round that considered queue %s:\n", queue)
-			fmt.Fprint(w, indent.String("\t\t", poolCtx.schedulingCtx.ReportString(verbosity)))
-		} else {
-			fmt.Fprintf(w, "\tMost recent scheduling round that considered queue %s: none\n", queue)
+			reports[i].Queue = queueSchedulingReport(poolCtx.schedulingCtx)
 		}
 	}
-	w.Flush()
-	return sb.String()
+	return reports
 }
 
-func (s *Server) getJobReportString(jobId string) string {
+func (s *Server) getPoolJobReports(jobId string) []*schedulerobjects.PoolJobReport {
 	poolCtxts := s.repository.JobSchedulingContext(jobId)
-	var sb strings.Builder
-	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
-	for _, poolCtx := range poolCtxts {
-		fmt.Fprintf(w, "%s:\n", poolCtx.pool)
+	reports := make([]*schedulerobjects.PoolJobReport, len(poolCtxts))
+	for i, poolCtx := range poolCtxts {
+		reports[i] = &schedulerobjects.PoolJobReport{Pool: poolCtx.pool}
 		if poolCtx.schedulingCtx != nil {
-			fmt.Fprintf(w, "\tMost recent scheduling round that affected job %s:\n", jobId)
-			fmt.Fprint(w, indent.String("\t\t", poolCtx.schedulingCtx.String()))
-		} else {
-			fmt.Fprintf(w, "\tMost recent scheduling round that affected job %s: none\n", jobId)
+			reports[i].Job = jobSchedulingReport(poolCtx.schedulingCtx)
 		}
 	}
-	w.Flush()
-	return sb.String()
+	return reports
 }
 
-func (s *Server) getSchedulingReportString(verbosity int32) string {
+func (s *Server) getPoolSchedulingReports() []*schedulerobjects.PoolSchedulingReport {
 	poolCtxts := s.repository.RoundSchedulingContext()
-	var sb strings.Builder
-	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
-	for _, poolCtx := range poolCtxts {
-		fmt.Fprintf(w, "%s:\n", poolCtx.pool)
-		fmt.Fprint(w, indent.String("\t", "Most recent scheduling round:\n"))
-		fmt.Fprint(w, indent.String("\t\t", poolCtx.schedulingCtx.ReportString(verbosity)))
+	reports := make([]*schedulerobjects.PoolSchedulingReport, len(poolCtxts))
+	for i, poolCtx := range poolCtxts {
+		reports[i] = poolSchedulingReport(poolCtx.schedulingCtx)
 	}
-	w.Flush()
-	return sb.String()
+	return reports
 }
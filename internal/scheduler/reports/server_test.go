@@ -0,0 +1,36 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+func TestServer_GetJobReport_AwaitingDependencies(t *testing.T) {
+	job := testfixtures.Test1Cpu4GiJob("queueA", testfixtures.PriorityClass0)
+	schedulingInfo := job.JobSchedulingInfo().DeepCopy()
+	schedulingInfo.Dependencies = []string{"dependency1", "dependency2"}
+	awaitingJob, err := testfixtures.Test1Cpu4GiJob("queueA", testfixtures.PriorityClass0).WithJobSchedulingInfo(schedulingInfo)
+	require.NoError(t, err)
+	awaitingJob = awaitingJob.WithQueued(true).WithDependenciesResolved(false)
+
+	jobDb := testfixtures.NewJobDb(testfixtures.TestResourceListFactory)
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*jobdb.Job{job, awaitingJob}))
+	txn.Commit()
+	server := NewServer(NewSchedulingContextRepository(), jobDb)
+
+	report, err := server.GetJobReport(armadacontext.Background(), &schedulerobjects.JobReportRequest{JobId: awaitingJob.Id()})
+	require.NoError(t, err)
+	assert.Contains(t, report.Report, "is waiting for its dependencies to finish: dependency1, dependency2")
+
+	report, err = server.GetJobReport(armadacontext.Background(), &schedulerobjects.JobReportRequest{JobId: job.Id()})
+	require.NoError(t, err)
+	assert.NotContains(t, report.Report, "dependencies")
+}
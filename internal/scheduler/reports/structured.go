@@ -0,0 +1,161 @@
+package reports
+
+import (
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
+	schedulerconstraints "github.com/armadaproject/armada/internal/scheduler/scheduling/constraints"
+	"github.com/armadaproject/armada/internal/scheduler/scheduling/context"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+// poolSchedulingReport returns the structured form of the scheduling round captured by sctx.
+func poolSchedulingReport(sctx *context.SchedulingContext) *schedulerobjects.PoolSchedulingReport {
+	queues := maps.Keys(sctx.QueueSchedulingContexts)
+	slices.Sort(queues)
+	queueReports := make([]*schedulerobjects.QueueSchedulingReport, len(queues))
+	for i, queue := range queues {
+		queueReports[i] = queueSchedulingReport(sctx.QueueSchedulingContexts[queue])
+	}
+	var drainingNodeReports []*schedulerobjects.DrainingNodeReport
+	for _, node := range sctx.DrainingNodes {
+		drainingNodeReports = append(drainingNodeReports, &schedulerobjects.DrainingNodeReport{
+			Executor:        node.Executor,
+			Node:            node.NodeName,
+			Reason:          node.Reason,
+			Preempt:         node.Preempt,
+			RemainingJobIds: node.RemainingJobIds,
+			RemainingRunIds: node.RemainingRunIds,
+		})
+	}
+	slices.SortFunc(drainingNodeReports, func(a, b *schedulerobjects.DrainingNodeReport) int {
+		if a.Executor != b.Executor {
+			return strings.Compare(a.Executor, b.Executor)
+		}
+		return strings.Compare(a.Node, b.Node)
+	})
+	return &schedulerobjects.PoolSchedulingReport{
+		Pool:               sctx.Pool,
+		Started:            protoutil.ToTimestamp(sctx.Started),
+		Finished:           protoutil.ToTimestamp(sctx.Finished),
+		TerminationReason:  sctx.TerminationReason,
+		TotalResources:     resourceListToMap(sctx.TotalResources),
+		AllocatedResources: resourceListToMap(sctx.Allocated),
+		ScheduledResources: resourceListToMap(sctx.ScheduledResources),
+		PreemptedResources: resourceListToMap(sctx.EvictedResources),
+		NumScheduledJobs:   int32(sctx.NumScheduledJobs),
+		NumScheduledGangs:  int32(sctx.NumScheduledGangs),
+		NumPreemptedJobs:   int32(sctx.NumEvictedJobs),
+		Queues:             queueReports,
+		DrainingNodes:      drainingNodeReports,
+	}
+}
+
+// queueSchedulingReport returns the structured form of the decisions captured by qctx.
+func queueSchedulingReport(qctx *context.QueueSchedulingContext) *schedulerobjects.QueueSchedulingReport {
+	var actualShare float64
+	if sctx := qctx.SchedulingContext; sctx != nil && sctx.FairnessCostProvider != nil {
+		actualShare = sctx.FairnessCostProvider.UnweightedCostFromAllocation(qctx.Allocated)
+	}
+	var numUnschedulableJobsByReason map[string]int32
+	var exampleUnschedulableJobIdByReason map[string]string
+	if len(qctx.UnsuccessfulJobSchedulingContexts) > 0 {
+		numUnschedulableJobsByReason = make(map[string]int32)
+		exampleUnschedulableJobIdByReason = make(map[string]string)
+		for _, jctx := range qctx.UnsuccessfulJobSchedulingContexts {
+			numUnschedulableJobsByReason[jctx.UnschedulableReason]++
+			// Use the smallest job id, so that reports are stable.
+			if example, ok := exampleUnschedulableJobIdByReason[jctx.UnschedulableReason]; !ok || jctx.JobId < example {
+				exampleUnschedulableJobIdByReason[jctx.UnschedulableReason] = jctx.JobId
+			}
+		}
+	}
+	return &schedulerobjects.QueueSchedulingReport{
+		Queue:                             qctx.Queue,
+		Time:                              protoutil.ToTimestamp(qctx.Created),
+		Weight:                            qctx.Weight,
+		FairShare:                         qctx.FairShare,
+		AdjustedFairShare:                 qctx.DemandCappedAdjustedFairShare,
+		UncappedAdjustedFairShare:         qctx.UncappedAdjustedFairShare,
+		ActualShare:                       actualShare,
+		AllocatedResources:                resourceListToMap(qctx.Allocated),
+		Demand:                            resourceListToMap(qctx.Demand),
+		ConstrainedDemand:                 resourceListToMap(qctx.ConstrainedDemand),
+		ScheduledResources:                resourceListToMap(internaltypes.RlMapSumValues(qctx.ScheduledResourcesByPriorityClass)),
+		PreemptedResources:                resourceListToMap(internaltypes.RlMapSumValues(qctx.EvictedResourcesByPriorityClass)),
+		NumScheduledJobs:                  int32(len(qctx.SuccessfulJobSchedulingContexts)),
+		NumPreemptedJobs:                  int32(len(qctx.EvictedJobsById)),
+		NumUnschedulableJobs:              int32(len(qctx.UnsuccessfulJobSchedulingContexts)),
+		NumUnschedulableJobsByReason:      numUnschedulableJobsByReason,
+		ExampleUnschedulableJobIdByReason: exampleUnschedulableJobIdByReason,
+		PreemptedByOptimiserResources:     resourceListToMap(internaltypes.RlMapSumValues(qctx.PreemptedByOptimiserResourceByPriorityClass)),
+		NumPreemptedByOptimiserJobs:       int32(len(qctx.PreemptedByOptimiserJobSchedulingContexts)),
+	}
+}
+
+// jobSchedulingReport returns the structured form of the decision captured by jctx.
+func jobSchedulingReport(jctx *context.JobSchedulingContext) *schedulerobjects.JobSchedulingReport {
+	report := &schedulerobjects.JobSchedulingReport{
+		JobId:               jctx.JobId,
+		Time:                protoutil.ToTimestamp(jctx.Created),
+		UnschedulableReason: jctx.UnschedulableReason,
+	}
+	if jctx.Job != nil {
+		report.Queue = jctx.Job.Queue()
+	}
+	if pctx := jctx.PodSchedulingContext; pctx != nil {
+		report.NodeId = pctx.NodeId
+		report.NumNodes = int32(pctx.NumNodes)
+		for _, attempt := range pctx.NodeTypeAttempts {
+			report.NodeTypes = append(report.NodeTypes, nodeTypeSchedulingReport(attempt))
+		}
+	}
+	return report
+}
+
+// nodeTypeSchedulingReport returns the structured form of the attempt to schedule a job on nodes of a particular type.
+func nodeTypeSchedulingReport(attempt *context.NodeTypeSchedulingAttempt) *schedulerobjects.NodeTypeSchedulingReport {
+	report := &schedulerobjects.NodeTypeSchedulingReport{
+		NodeType: attempt.WellKnownNodeTypeName,
+	}
+	if len(attempt.NumExcludedNodesByReason) > 0 {
+		report.NumExcludedNodesByReason = make(map[string]int32, len(attempt.NumExcludedNodesByReason))
+		for reason, count := range attempt.NumExcludedNodesByReason {
+			report.NumExcludedNodesByReason[reason] = int32(count)
+		}
+	}
+	if attempt.NodeId != "" {
+		return report
+	}
+	// Report the reason that excluded the most nodes, breaking ties by name so that reports are stable.
+	maxCount := 0
+	for reason, count := range attempt.NumExcludedNodesByReason {
+		if count > maxCount || (count == maxCount && reason < report.UnschedulableReason) {
+			report.UnschedulableReason = reason
+			maxCount = count
+		}
+	}
+	if report.UnschedulableReason == "" {
+		report.UnschedulableReason = schedulerconstraints.JobDoesNotFitUnschedulableReason
+	}
+	return report
+}
+
+// resourceListToMap returns the non-zero resources of rl as a map from resource name to quantity.
+func resourceListToMap(rl internaltypes.ResourceList) map[string]string {
+	if rl.IsEmpty() {
+		return nil
+	}
+	rv := make(map[string]string)
+	for name, quantity := range rl.ToMap() {
+		if quantity.IsZero() {
+			continue
+		}
+		rv[name] = quantity.String()
+	}
+	return rv
+}
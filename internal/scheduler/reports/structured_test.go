@@ -0,0 +1,221 @@
+package reports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
+	"github.com/armadaproject/armada/internal/scheduler/scheduling/context"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+var (
+	reportTime    = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	unschedulable = &context.JobSchedulingContext{
+		Created:             reportTime,
+		JobId:               "unschedulableJob",
+		UnschedulableReason: "job does not fit on any node",
+		PodSchedulingContext: &context.PodSchedulingContext{
+			NumNodes: 3,
+			NumExcludedNodesByReason: map[string]int{
+				"insufficient cpu":       2,
+				"node selector mismatch": 1,
+			},
+			NodeTypeAttempts: []*context.NodeTypeSchedulingAttempt{
+				{
+					NumExcludedNodesByReason: map[string]int{"insufficient cpu": 1, "node selector mismatch": 2},
+				},
+				{
+					WellKnownNodeTypeName:    "gpu",
+					NumExcludedNodesByReason: map[string]int{"insufficient cpu": 2, "node selector mismatch": 1},
+				},
+			},
+		},
+	}
+	scheduled = &context.JobSchedulingContext{
+		Created: reportTime,
+		JobId:   "scheduledJob",
+		PodSchedulingContext: &context.PodSchedulingContext{
+			NodeId:                "node1",
+			WellKnownNodeTypeName: "gpu",
+			NumNodes:              3,
+			NodeTypeAttempts: []*context.NodeTypeSchedulingAttempt{
+				{
+					NumExcludedNodesByReason: map[string]int{"insufficient cpu": 3},
+				},
+				{
+					WellKnownNodeTypeName:    "gpu",
+					NodeId:                   "node1",
+					NumExcludedNodesByReason: map[string]int{},
+				},
+			},
+		},
+	}
+)
+
+func TestQueueSchedulingReport(t *testing.T) {
+	qctx := &context.QueueSchedulingContext{
+		Created:                       reportTime,
+		Queue:                         "queueA",
+		Weight:                        2,
+		FairShare:                     0.5,
+		DemandCappedAdjustedFairShare: 0.25,
+		UncappedAdjustedFairShare:     0.75,
+		Allocated:                     testfixtures.CpuMem("4", "4Gi"),
+		Demand:                        testfixtures.CpuMem("8", "8Gi"),
+		ConstrainedDemand:             testfixtures.CpuMem("6", "6Gi"),
+		ScheduledResourcesByPriorityClass: map[string]internaltypes.ResourceList{
+			testfixtures.PriorityClass0: testfixtures.CpuMem("1", "1Gi"),
+			testfixtures.PriorityClass1: testfixtures.CpuMem("1", "0"),
+		},
+		EvictedResourcesByPriorityClass: map[string]internaltypes.ResourceList{},
+		SuccessfulJobSchedulingContexts: map[string]*context.JobSchedulingContext{
+			scheduled.JobId: scheduled,
+		},
+		UnsuccessfulJobSchedulingContexts: map[string]*context.JobSchedulingContext{
+			unschedulable.JobId: unschedulable,
+		},
+		EvictedJobsById: map[string]bool{},
+	}
+
+	assert.Equal(
+		t,
+		&schedulerobjects.QueueSchedulingReport{
+			Queue:                     "queueA",
+			Time:                      protoutil.ToTimestamp(reportTime),
+			Weight:                    2,
+			FairShare:                 0.5,
+			AdjustedFairShare:         0.25,
+			UncappedAdjustedFairShare: 0.75,
+			AllocatedResources:        map[string]string{"cpu": "4", "memory": "4294967296"},
+			Demand:                    map[string]string{"cpu": "8", "memory": "8589934592"},
+			ConstrainedDemand:         map[string]string{"cpu": "6", "memory": "6442450944"},
+			ScheduledResources:        map[string]string{"cpu": "2", "memory": "1073741824"},
+			NumScheduledJobs:          1,
+			NumUnschedulableJobs:      1,
+			NumUnschedulableJobsByReason: map[string]int32{
+				"job does not fit on any node": 1,
+			},
+			ExampleUnschedulableJobIdByReason: map[string]string{
+				"job does not fit on any node": unschedulable.JobId,
+			},
+		},
+		queueSchedulingReport(qctx),
+	)
+}
+
+func TestJobSchedulingReport(t *testing.T) {
+	tests := map[string]struct {
+		jctx     *context.JobSchedulingContext
+		expected *schedulerobjects.JobSchedulingReport
+	}{
+		"unschedulable": {
+			jctx: unschedulable,
+			expected: &schedulerobjects.JobSchedulingReport{
+				JobId:               "unschedulableJob",
+				Time:                protoutil.ToTimestamp(reportTime),
+				UnschedulableReason: "job does not fit on any node",
+				NumNodes:            3,
+				NodeTypes: []*schedulerobjects.NodeTypeSchedulingReport{
+					{
+						UnschedulableReason:      "node selector mismatch",
+						NumExcludedNodesByReason: map[string]int32{"insufficient cpu": 1, "node selector mismatch": 2},
+					},
+					{
+						NodeType:                 "gpu",
+						UnschedulableReason:      "insufficient cpu",
+						NumExcludedNodesByReason: map[string]int32{"insufficient cpu": 2, "node selector mismatch": 1},
+					},
+				},
+			},
+		},
+		"scheduled on well-known node type": {
+			jctx: scheduled,
+			expected: &schedulerobjects.JobSchedulingReport{
+				JobId:    "scheduledJob",
+				Time:     protoutil.ToTimestamp(reportTime),
+				NodeId:   "node1",
+				NumNodes: 3,
+				NodeTypes: []*schedulerobjects.NodeTypeSchedulingReport{
+					{
+						UnschedulableReason:      "insufficient cpu",
+						NumExcludedNodesByReason: map[string]int32{"insufficient cpu": 3},
+					},
+					{NodeType: "gpu"},
+				},
+			},
+		},
+		"no pod scheduling context": {
+			jctx: &context.JobSchedulingContext{Created: reportTime, JobId: "job"},
+			expected: &schedulerobjects.JobSchedulingReport{
+				JobId: "job",
+				Time:  protoutil.ToTimestamp(reportTime),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, jobSchedulingReport(tc.jctx))
+		})
+	}
+}
+
+func TestRenderQueueSchedulingReport(t *testing.T) {
+	report := renderQueueSchedulingReport(&schedulerobjects.QueueSchedulingReport{
+		Queue:                        "queueA",
+		NumUnschedulableJobs:         3,
+		NumUnschedulableJobsByReason: map[string]int32{"job does not fit on any node": 2, "gang does not fit": 1},
+		ExampleUnschedulableJobIdByReason: map[string]string{
+			"job does not fit on any node": "job1",
+			"gang does not fit":            "job3",
+		},
+		NumPreemptedByOptimiserJobs: 1,
+	}, 0)
+	assert.Regexp(t, `Number of jobs preempted by optimiser: +1\n`, report)
+	// Most common reason first.
+	assert.Contains(t, report, "Unschedulable jobs:\n 2: job does not fit on any node (e.g., job1)\n 1: gang does not fit (e.g., job3)\n")
+}
+
+func TestRenderJobReport(t *testing.T) {
+	pools := []*schedulerobjects.PoolJobReport{
+		{Pool: "poolA", Job: jobSchedulingReport(unschedulable)},
+		{Pool: "poolB"},
+	}
+	report := renderJobReport("unschedulableJob", pools)
+	require.Contains(t, report, "poolA:\n")
+	assert.Contains(t, report, "job does not fit on any node")
+	assert.Contains(t, report, "Home nodes:")
+	assert.Contains(t, report, "Away node type gpu:")
+	assert.Contains(t, report, "2: insufficient cpu")
+	assert.Contains(t, report, "Most recent scheduling round that affected job unschedulableJob: none")
+}
+
+func TestPoolSchedulingReport_DrainingNodes(t *testing.T) {
+	sctx := &context.SchedulingContext{
+		Pool:    "pool",
+		Started: reportTime,
+		DrainingNodes: []*context.DrainingNodeContext{
+			{Executor: "executorB", NodeId: "id1", NodeName: "node1", Reason: "maintenance"},
+			{Executor: "executorA", NodeId: "id2", NodeName: "node2", Reason: "maintenance", Preempt: true, RemainingJobIds: []string{"job1"}, RemainingRunIds: []string{"run1", "run2"}},
+		},
+	}
+	report := poolSchedulingReport(sctx)
+	assert.Equal(t, []*schedulerobjects.DrainingNodeReport{
+		{Executor: "executorA", Node: "node2", Reason: "maintenance", Preempt: true, RemainingJobIds: []string{"job1"}, RemainingRunIds: []string{"run1", "run2"}},
+		{Executor: "executorB", Node: "node1", Reason: "maintenance"},
+	}, report.DrainingNodes)
+
+	rendered := renderPoolSchedulingReport(report, 0)
+	assert.Contains(t, rendered, "executorA/node2: 1 jobs, 2 runs remaining (preempt: true, reason: maintenance)")
+	assert.Contains(t, rendered, "executorB/node1: 0 jobs, 0 runs remaining (preempt: false, reason: maintenance)")
+}
+
+func TestRenderResources(t *testing.T) {
+	assert.Equal(t, "(empty)", renderResources(nil))
+	assert.Equal(t, "(cpu=1,memory=1Gi)", renderResources(map[string]string{"memory": "1Gi", "cpu": "1"}))
+}
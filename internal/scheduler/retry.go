@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// matchRetryRule returns the index of the first rule of the provided retry policy that matches runError,
+// or -1 if no rule matches.
+func matchRetryRule(policy *schedulerobjects.RetryPolicy, runError *armadaevents.Error) int {
+	if runError == nil {
+		return -1
+	}
+	for i, rule := range policy.GetRules() {
+		if retryRuleMatches(rule, runError) {
+			return i
+		}
+	}
+	return -1
+}
+
+// retryRuleMatches returns true if all non-empty criteria of rule match runError.
+func retryRuleMatches(rule *schedulerobjects.RetryRule, runError *armadaevents.Error) bool {
+	if len(rule.ErrorKinds) > 0 && !slices.Contains(rule.ErrorKinds, runError.GetReasonName()) {
+		return false
+	}
+	containerErrors := containerErrorsFromRunError(runError)
+	if len(rule.ExitCodes) > 0 && !slices.ContainsFunc(containerErrors, func(e *armadaevents.ContainerError) bool {
+		return slices.Contains(rule.ExitCodes, e.ExitCode)
+	}) {
+		return false
+	}
+	if len(rule.ContainerReasons) > 0 && !slices.ContainsFunc(containerErrors, func(e *armadaevents.ContainerError) bool {
+		return slices.Contains(rule.ContainerReasons, e.Reason)
+	}) {
+		return false
+	}
+	return true
+}
+
+// containerErrorsFromRunError returns the errors of the individual containers that make up runError, if any.
+func containerErrorsFromRunError(runError *armadaevents.Error) []*armadaevents.ContainerError {
+	if containerError := runError.GetContainerError(); containerError != nil {
+		return []*armadaevents.ContainerError{containerError}
+	}
+	return runError.GetPodError().GetContainerErrors()
+}
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestMatchRetryRule(t *testing.T) {
+	podError := &armadaevents.Error{
+		Reason: &armadaevents.Error_PodError{
+			PodError: &armadaevents.PodError{
+				ContainerErrors: []*armadaevents.ContainerError{
+					{ExitCode: 0, Reason: "Completed"},
+					{ExitCode: 137, Reason: "OOMKilled"},
+				},
+			},
+		},
+	}
+	containerError := &armadaevents.Error{
+		Reason: &armadaevents.Error_ContainerError{
+			ContainerError: &armadaevents.ContainerError{ExitCode: 3, Reason: "Error"},
+		},
+	}
+	leaseExpired := &armadaevents.Error{
+		Reason: &armadaevents.Error_LeaseExpired{LeaseExpired: &armadaevents.LeaseExpired{}},
+	}
+
+	tests := map[string]struct {
+		rules    []*schedulerobjects.RetryRule
+		runError *armadaevents.Error
+		expected int
+	}{
+		"no rules": {
+			runError: podError,
+			expected: -1,
+		},
+		"no run error": {
+			rules:    []*schedulerobjects.RetryRule{{}},
+			expected: -1,
+		},
+		"empty rule matches everything": {
+			rules:    []*schedulerobjects.RetryRule{{}},
+			runError: leaseExpired,
+			expected: 0,
+		},
+		"exit code of any container": {
+			rules:    []*schedulerobjects.RetryRule{{ExitCodes: []int32{1}}, {ExitCodes: []int32{1, 137}}},
+			runError: podError,
+			expected: 1,
+		},
+		"container reason": {
+			rules:    []*schedulerobjects.RetryRule{{ContainerReasons: []string{"OOMKilled"}}},
+			runError: podError,
+			expected: 0,
+		},
+		"container error": {
+			rules:    []*schedulerobjects.RetryRule{{ExitCodes: []int32{3}, ContainerReasons: []string{"Error"}}},
+			runError: containerError,
+			expected: 0,
+		},
+		"error kind": {
+			rules:    []*schedulerobjects.RetryRule{{ErrorKinds: []string{"PodError"}}, {ErrorKinds: []string{"LeaseExpired"}}},
+			runError: leaseExpired,
+			expected: 1,
+		},
+		"all criteria must match": {
+			rules:    []*schedulerobjects.RetryRule{{ErrorKinds: []string{"PodError"}, ExitCodes: []int32{1}}},
+			runError: podError,
+			expected: -1,
+		},
+		"exit codes don't match errors without containers": {
+			rules:    []*schedulerobjects.RetryRule{{ExitCodes: []int32{0}}},
+			runError: leaseExpired,
+			expected: -1,
+		},
+		"first matching rule wins": {
+			rules: []*schedulerobjects.RetryRule{
+				{Action: schedulerobjects.RetryAction_RETRY_ACTION_FAIL_FAST, ExitCodes: []int32{137}},
+				{Action: schedulerobjects.RetryAction_RETRY_ACTION_RETRY},
+			},
+			runError: podError,
+			expected: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &schedulerobjects.RetryPolicy{Rules: tc.rules}
+			assert.Equal(t, tc.expected, matchRetryRule(policy, tc.runError))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/renstrom/shortuuid"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
@@ -30,6 +31,11 @@ import (
 	"github.com/armadaproject/armada/pkg/bidstore"
 )
 
+// Jobs with a dependency the scheduler still doesn't know about this long after they were submitted are failed.
+// The submit API doesn't check that dependencies referenced by job id exist, and a dependency may be ingested after
+// the jobs depending on it, since they may be in different job sets.
+const unknownJobDependencyTimeout = 10 * time.Minute
+
 // Scheduler is the main Armada scheduler.
 // It periodically performs the following cycle:
 // 1. Update state from postgres (via the jobRepository).
@@ -313,7 +319,26 @@ func (s *Scheduler) cycle(ctx *armadacontext.Context, updateAll bool, leaderToke
 	}
 	events = append(events, validationEvents...)
 
-	// Expire any jobs running on clusters that haven't heartbeated within the configured deadline.
+	// Release any deferred jobs whose not-before time has passed.
+	ctx.Info("Releasing deferred jobs")
+	deferralEvents, err := s.releaseDeferredJobs(ctx, txn)
+	if err != nil {
+		return overallSchedulerResult, err
+	}
+	ctx.Infof("Finished releasing deferred jobs, generating %d events", len(deferralEvents))
+	events = append(events, deferralEvents...)
+
+	// Release any jobs whose dependencies have been resolved.
+	ctx.Info("Resolving job dependencies")
+	dependencyEvents, err := s.resolveJobDependencies(ctx, txn)
+	if err != nil {
+		return overallSchedulerResult, err
+	}
+	ctx.Infof("Finished resolving job dependencies, generating %d events", len(dependencyEvents))
+	events = append(events, dependencyEvents...)
+
+	// Expire any jobs running on clusters that haven't heartbeated within the configured deadline,
+	// and cancel any queued jobs that haven't been scheduled within their queue ttl.
 	ctx.Info("Looking for jobs to expire")
 	expirationEvents, err := s.expireJobsIfNecessary(ctx, txn)
 	if err != nil {
@@ -821,8 +846,26 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 			}
 			events = append(events, jobSucceeded)
 		} else if lastRun.Failed() && !job.Queued() {
+			runError := jobRunErrors[lastRun.Id()]
 			failFast := job.Annotations()[configuration.FailFastAnnotation] == "true"
-			requeueJob := !failFast && lastRun.Returned() && job.NumAttempts() < s.maxAttemptedRuns
+			retryable := lastRun.Returned()
+			maxAttemptedRuns := s.maxAttemptedRuns
+
+			// The retry policy of the job, if any, overrides the max number of attempts and decides which failures
+			// are retried based on the first of its rules to match the run error.
+			retryPolicy := job.RetryPolicy()
+			matchedRule := -1
+			if retryPolicy != nil {
+				if retryPolicy.MaxAttempts > 0 {
+					maxAttemptedRuns = uint(retryPolicy.MaxAttempts)
+				}
+				matchedRule = matchRetryRule(retryPolicy, runError)
+				if matchedRule >= 0 {
+					retryable = retryPolicy.Rules[matchedRule].Action == schedulerobjects.RetryAction_RETRY_ACTION_RETRY
+					failFast = failFast || !retryable
+				}
+			}
+			requeueJob := !failFast && retryable && job.NumAttempts() < maxAttemptedRuns
 
 			if requeueJob && lastRun.RunAttempted() {
 				jobWithAntiAffinity, schedulable, err := s.addNodeAntiAffinitiesForAttemptedRunsIfSchedulable(ctx, job)
@@ -838,6 +881,44 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 				}
 			}
 
+			if retryPolicy != nil {
+				events = append(events, &armadaevents.EventSequence_Event{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_JobRunErrors{
+						JobRunErrors: &armadaevents.JobRunErrors{
+							JobId: job.Id(),
+							RunId: lastRun.Id(),
+							Errors: []*armadaevents.Error{
+								{
+									Terminal: false,
+									Reason: &armadaevents.Error_RetryDecision{
+										RetryDecision: &armadaevents.RetryDecision{
+											Retry:       requeueJob,
+											Message:     retryDecisionMessage(job, requeueJob, failFast, retryable, matchedRule, maxAttemptedRuns),
+											Attempts:    uint32(job.NumAttempts()),
+											MaxAttempts: uint32(maxAttemptedRuns),
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			}
+
+			if requeueJob && retryPolicy.GetBackoffSeconds() > 0 {
+				// Defer the job until the back-off has elapsed. The not-before time is part of the scheduling info
+				// sent with the requeue event, such that the job remains deferred if the scheduler restarts.
+				schedulingInfo := job.JobSchedulingInfo().DeepCopy()
+				schedulingInfo.NotBefore = s.clock.Now().Add(time.Duration(retryPolicy.BackoffSeconds) * time.Second)
+				schedulingInfo.Version = job.JobSchedulingInfo().Version + 1
+				jobWithBackoff, err := job.WithJobSchedulingInfo(schedulingInfo)
+				if err != nil {
+					return nil, errors.Errorf("unable to set back-off for job %s because %s", job.Id(), err)
+				}
+				job = jobWithBackoff.WithDeferred(true)
+			}
+
 			if requeueJob {
 				job = job.WithQueued(true)
 				job = job.WithQueuedVersion(job.QueuedVersion() + 1)
@@ -855,7 +936,6 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 
 				events = append(events, requeueJobEvent)
 			} else {
-				runError := jobRunErrors[lastRun.Id()]
 				if runError == nil {
 					return nil, errors.Errorf(
 						"no run error found for run %s (job id = %s), this must mean we're out of sync with the database",
@@ -865,13 +945,16 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 
 				job = job.WithFailed(true).WithQueued(false)
 				if lastRun.Returned() {
-					errorMessage := fmt.Sprintf("Maximum number of attempts (%d) reached - this job will no longer be retried", s.maxAttemptedRuns)
-					if job.NumAttempts() < s.maxAttemptedRuns {
+					errorMessage := fmt.Sprintf("Maximum number of attempts (%d) reached - this job will no longer be retried", maxAttemptedRuns)
+					if job.NumAttempts() < maxAttemptedRuns {
 						errorMessage = fmt.Sprintf("Job was attempted %d times, and has been tried once on all nodes it can run on - this job will no longer be retried", job.NumAttempts())
 					}
 					if failFast {
 						errorMessage = fmt.Sprintf("Job has fail fast flag set - this job will no longer be retried")
 					}
+					if matchedRule >= 0 && !retryable {
+						errorMessage = fmt.Sprintf("Job matched fail fast retry rule %d - this job will no longer be retried", matchedRule)
+					}
 
 					if runError.GetPodLeaseReturned() != nil && runError.GetPodLeaseReturned().GetMessage() != "" {
 						errorMessage += "\n\n" + "Final run error:"
@@ -923,10 +1006,36 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 	return nil, nil
 }
 
+// retryDecisionMessage returns a human-readable explanation of whether a job with a retry policy is retried.
+func retryDecisionMessage(job *jobdb.Job, retry, failFast, retryable bool, matchedRule int, maxAttemptedRuns uint) string {
+	switch {
+	case retry && matchedRule >= 0:
+		return fmt.Sprintf("Job matched retry rule %d and will be retried", matchedRule)
+	case retry:
+		return "Job run was returned and the job will be retried"
+	case matchedRule >= 0 && !retryable:
+		return fmt.Sprintf("Job matched fail fast retry rule %d and will not be retried", matchedRule)
+	case failFast:
+		return "Job has fail fast flag set and will not be retried"
+	case !retryable:
+		return "Job run failed and no retry rule matched - this job will not be retried"
+	case job.NumAttempts() >= maxAttemptedRuns:
+		return fmt.Sprintf("Maximum number of attempts (%d) reached - this job will not be retried", maxAttemptedRuns)
+	default:
+		return "Job has been tried once on all nodes it can run on and will not be retried"
+	}
+}
+
 // expireJobsIfNecessary removes any jobs from the JobDb which are running on stale executors.
 // It also generates an EventSequence for each job, indicating that both the run and the job has failed
 // Note that this is different behaviour from the old scheduler which would allow expired jobs to be rerun
+// Queued jobs that have passed their queue deadline are cancelled.
 func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	events, err := s.cancelJobsPastQueueDeadline(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+
 	heartbeatTimes, err := s.executorRepository.GetLastUpdateTimes(ctx)
 	if err != nil {
 		return nil, err
@@ -949,11 +1058,9 @@ func (s *Scheduler) expireJobsIfNecessary(ctx *armadacontext.Context, txn *jobdb
 	// All clusters have had a heartbeat recently.  No need to expire any jobs
 	if len(staleExecutors) == 0 {
 		ctx.Infof("No stale executors found. No jobs need to be expired")
-		return nil, nil
+		return events, nil
 	}
 
-	events := make([]*armadaevents.EventSequence, 0)
-
 	// TODO: this is inefficient. We should create a iterator of the jobs running on the affected executors
 	jobs := txn.GetAll()
 
@@ -1087,6 +1194,205 @@ func (s *Scheduler) submitCheck(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*
 	return events, nil
 }
 
+// resolveJobDependencies releases queued jobs whose dependencies have all succeeded, such that they may be scheduled.
+// Jobs with a dependency that has failed or been cancelled are handled according to their DependencyFailurePolicy.
+// Jobs with dependencies that are still active remain waiting, as do jobs with dependencies that the scheduler doesn't
+// yet know about, e.g., because they've not yet been ingested, for up to unknownJobDependencyTimeout after submission.
+// Jobs with a dependency in another queue, or that doesn't exist, are failed.
+func (s *Scheduler) resolveJobDependencies(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	jobsToResolve := make([]*jobdb.Job, 0)
+	outcomesByJobId := make(map[string]database.JobOutcome)
+	unknownJobIds := make(map[string]bool)
+
+	it := txn.JobsAwaitingDependencies()
+	for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+		if job.InTerminalState() {
+			continue
+		}
+		jobsToResolve = append(jobsToResolve, job)
+		for _, dependencyId := range job.Dependencies() {
+			if _, ok := outcomesByJobId[dependencyId]; ok {
+				continue
+			}
+			if dependency := txn.GetById(dependencyId); dependency != nil {
+				outcomesByJobId[dependencyId] = database.JobOutcome{
+					Queue:     dependency.Queue(),
+					Succeeded: dependency.Succeeded(),
+					Failed:    dependency.Failed(),
+					Cancelled: dependency.Cancelled(),
+				}
+			} else {
+				unknownJobIds[dependencyId] = true
+			}
+		}
+	}
+	if len(jobsToResolve) == 0 {
+		return nil, nil
+	}
+
+	// Terminal jobs are removed from the jobDb, so fall back to the database for any dependencies we don't know about.
+	if len(unknownJobIds) > 0 {
+		dbOutcomesByJobId, err := s.jobRepository.FetchJobOutcomes(ctx, maps.Keys(unknownJobIds))
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(outcomesByJobId, dbOutcomesByJobId)
+	}
+
+	now := s.clock.Now()
+	events := make([]*armadaevents.EventSequence, 0)
+	jobsToUpdate := make([]*jobdb.Job, 0)
+	for _, job := range jobsToResolve {
+		resolved := true
+		failedDependencyId := ""
+		unresolvableReason := ""
+		for _, dependencyId := range job.Dependencies() {
+			outcome, ok := outcomesByJobId[dependencyId]
+			if !ok {
+				resolved = false
+				if now.Sub(job.SubmitTime()) > unknownJobDependencyTimeout {
+					unresolvableReason = fmt.Sprintf("dependency %s does not exist", dependencyId)
+					failedDependencyId = dependencyId
+					break
+				}
+			} else if outcome.Queue != job.Queue() {
+				unresolvableReason = fmt.Sprintf("dependency %s is not in queue %s", dependencyId, job.Queue())
+				failedDependencyId = dependencyId
+				break
+			} else if !outcome.Terminal() {
+				resolved = false
+			} else if !outcome.Succeeded && failedDependencyId == "" {
+				failedDependencyId = dependencyId
+			}
+		}
+
+		if unresolvableReason == "" && (failedDependencyId == "" || job.DependencyFailurePolicy() == schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY) {
+			if resolved {
+				jobsToUpdate = append(jobsToUpdate, job.WithDependenciesResolved(true).WithDependenciesResolvedTime(now))
+			}
+			continue
+		}
+
+		es := &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+				},
+			},
+		}
+		// Jobs with dependencies that can't be resolved are failed regardless of their policy, since they could never run.
+		if unresolvableReason != "" || job.DependencyFailurePolicy() == schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL {
+			message := unresolvableReason
+			if message == "" {
+				message = fmt.Sprintf("dependency %s did not succeed", failedDependencyId)
+			}
+			job = job.WithQueued(false).WithFailed(true)
+			es.Events[0].Event = &armadaevents.EventSequence_Event_JobErrors{
+				JobErrors: &armadaevents.JobErrors{
+					JobId: job.Id(),
+					Errors: []*armadaevents.Error{
+						{
+							Terminal: true,
+							Reason: &armadaevents.Error_JobDependencyFailed{
+								JobDependencyFailed: &armadaevents.JobDependencyFailed{
+									Message:         message,
+									DependencyJobId: failedDependencyId,
+								},
+							},
+						},
+					},
+				},
+			}
+		} else {
+			job = job.WithQueued(false).WithCancelRequested(true).WithCancelled(true)
+			es.Events[0].Event = &armadaevents.EventSequence_Event_CancelledJob{
+				CancelledJob: &armadaevents.CancelledJob{
+					JobId:  job.Id(),
+					Reason: fmt.Sprintf("dependency %s did not succeed", failedDependencyId),
+				},
+			}
+		}
+		jobsToUpdate = append(jobsToUpdate, job)
+		events = append(events, es)
+	}
+
+	if err := txn.Upsert(jobsToUpdate); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// cancelJobsPastQueueDeadline cancels any queued jobs that haven't been scheduled before their queue deadline,
+// i.e., within their queue ttl of becoming eligible for scheduling.
+func (s *Scheduler) cancelJobsPastQueueDeadline(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	now := s.clock.Now()
+	events := make([]*armadaevents.EventSequence, 0)
+	jobsToUpdate := make([]*jobdb.Job, 0)
+	it := txn.JobsWithQueueDeadline()
+	for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+		deadline, ok := job.QueueDeadline()
+		if !ok || job.InTerminalState() || now.Before(deadline) {
+			continue
+		}
+		ctx.Infof("Cancelling job %s as it was not scheduled before its queue deadline %s", job.Id(), deadline)
+		jobsToUpdate = append(jobsToUpdate, job.WithQueued(false).WithCancelRequested(true).WithCancelled(true))
+		events = append(events, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_CancelledJob{
+						CancelledJob: &armadaevents.CancelledJob{
+							JobId:  job.Id(),
+							Reason: fmt.Sprintf("job was not scheduled within its queue ttl of %s", job.QueueTtl()),
+						},
+					},
+				},
+			},
+		})
+	}
+	if err := txn.Upsert(jobsToUpdate); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// releaseDeferredJobs makes deferred jobs whose not-before time has passed eligible for scheduling.
+func (s *Scheduler) releaseDeferredJobs(_ *armadacontext.Context, txn *jobdb.Txn) ([]*armadaevents.EventSequence, error) {
+	now := s.clock.Now()
+	events := make([]*armadaevents.EventSequence, 0)
+	jobsToUpdate := make([]*jobdb.Job, 0)
+	it := txn.DeferredJobs()
+	for job, _ := it.Next(); job != nil; job, _ = it.Next() {
+		if job.InTerminalState() || job.NotBefore().After(now) {
+			continue
+		}
+		jobsToUpdate = append(jobsToUpdate, job.WithDeferred(false))
+		events = append(events, &armadaevents.EventSequence{
+			Queue:      job.Queue(),
+			JobSetName: job.Jobset(),
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: s.now(),
+					Event: &armadaevents.EventSequence_Event_JobDeferralEnded{
+						JobDeferralEnded: &armadaevents.JobDeferralEnded{
+							JobId:     job.Id(),
+							NotBefore: protoutil.ToTimestamp(job.NotBefore()),
+						},
+					},
+				},
+			},
+		})
+	}
+	if err := txn.Upsert(jobsToUpdate); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 // now is a convenience function for generating a proto timestamp representing the current time according to the clock
 func (s *Scheduler) now() *types.Timestamp {
 	return protoutil.ToTimestamp(s.clock.Now())
@@ -1662,6 +1662,468 @@ func TestScheduler_TestSyncState(t *testing.T) {
 	}
 }
 
+func TestScheduler_ResolveJobDependencies(t *testing.T) {
+	now := time.Now()
+	newDependentJob := func(submitted time.Time, policy schedulerobjects.DependencyFailurePolicy, dependencies ...string) *jobdb.Job {
+		jobSchedulingInfo := toInternalSchedulingInfo(schedulingInfo)
+		jobSchedulingInfo.SubmitTime = submitted
+		jobSchedulingInfo.Dependencies = dependencies
+		jobSchedulingInfo.DependencyFailurePolicy = policy
+		return testfixtures.NewJob(
+			util.NewULID(),
+			"testJobset",
+			"testQueue",
+			uint32(10),
+			jobSchedulingInfo,
+			true,
+			0,
+			false,
+			false,
+			false,
+			1,
+			true,
+		).WithPools([]string{testfixtures.TestPool})
+	}
+	const (
+		succeededJobId  = "succeeded"
+		failedJobId     = "failed"
+		cancelledJobId  = "cancelled"
+		otherQueueJobId = "otherQueue"
+		unknownJobId    = "unknown"
+	)
+	jobOutcomes := map[string]database.JobOutcome{
+		succeededJobId:  {Queue: "testQueue", Succeeded: true},
+		failedJobId:     {Queue: "testQueue", Failed: true},
+		cancelledJobId:  {Queue: "testQueue", Cancelled: true},
+		otherQueueJobId: {Queue: "otherQueue", Succeeded: true},
+	}
+
+	tests := map[string]struct {
+		job              *jobdb.Job
+		expectReleased   bool
+		expectFailed     bool
+		expectCancelled  bool
+		expectedEventLen int
+		// Id of the dependency reported in the error of failed jobs.
+		expectedFailedDependencyId string
+	}{
+		"all dependencies succeeded": {
+			job:            newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL, succeededJobId),
+			expectReleased: true,
+		},
+		"dependency still active": {
+			job: newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL, succeededJobId, queuedJob.Id()),
+		},
+		"dependency unknown": {
+			job: newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL, unknownJobId),
+		},
+		"dependency unknown for too long": {
+			job:                        newDependentJob(now.Add(-unknownJobDependencyTimeout-time.Second), schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL, unknownJobId),
+			expectFailed:               true,
+			expectedEventLen:           1,
+			expectedFailedDependencyId: unknownJobId,
+		},
+		"dependency in another queue": {
+			job:                        newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY, otherQueueJobId),
+			expectFailed:               true,
+			expectedEventLen:           1,
+			expectedFailedDependencyId: otherQueueJobId,
+		},
+		"dependency failed with cancel policy": {
+			job:              newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL, failedJobId),
+			expectCancelled:  true,
+			expectedEventLen: 1,
+		},
+		"dependency cancelled with fail policy": {
+			job:                        newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL, cancelledJobId, queuedJob.Id()),
+			expectFailed:               true,
+			expectedEventLen:           1,
+			expectedFailedDependencyId: cancelledJobId,
+		},
+		"dependency failed with run anyway policy": {
+			job:            newDependentJob(now, schedulerobjects.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY, failedJobId),
+			expectReleased: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			jobDb := testfixtures.NewJobDb(testfixtures.TestResourceListFactory)
+			sched := &Scheduler{
+				jobRepository: &testJobRepository{jobOutcomes: jobOutcomes},
+				jobDb:         jobDb,
+				clock:         clock.NewFakeClock(now),
+			}
+
+			txn := jobDb.WriteTxn()
+			require.NoError(t, txn.Upsert([]*jobdb.Job{queuedJob, tc.job}))
+			require.True(t, tc.job.AwaitingDependencies())
+
+			events, err := sched.resolveJobDependencies(ctx, txn)
+			require.NoError(t, err)
+			assert.Len(t, events, tc.expectedEventLen)
+			require.NoError(t, txn.Assert(false))
+
+			job := txn.GetById(tc.job.Id())
+			assert.Equal(t, tc.expectFailed, job.Failed())
+			assert.Equal(t, tc.expectCancelled, job.Cancelled())
+			assert.Equal(t, tc.expectReleased, job.Queued() && !job.AwaitingDependencies())
+			if tc.expectReleased {
+				assert.Equal(t, now, job.DependenciesResolvedTime())
+			}
+
+			queuedJobIds := make([]string, 0)
+			it := txn.QueuedJobs("testQueue", testfixtures.TestPool, jobdb.FairShareOrder)
+			for queued, _ := it.Next(); queued != nil; queued, _ = it.Next() {
+				queuedJobIds = append(queuedJobIds, queued.Id())
+			}
+			assert.Equal(t, tc.expectReleased, slices.Contains(queuedJobIds, tc.job.Id()))
+
+			if tc.expectFailed {
+				errs := events[0].Events[0].GetJobErrors().GetErrors()
+				require.Len(t, errs, 1)
+				assert.True(t, errs[0].Terminal)
+				assert.Equal(t, tc.expectedFailedDependencyId, errs[0].GetJobDependencyFailed().GetDependencyJobId())
+			}
+			if tc.expectCancelled {
+				assert.Equal(t, tc.job.Id(), events[0].Events[0].GetCancelledJob().GetJobId())
+			}
+		})
+	}
+}
+
+func TestScheduler_ReleaseDeferredJobs(t *testing.T) {
+	now := time.Now()
+	newDeferredJob := func(notBefore time.Time) *jobdb.Job {
+		jobSchedulingInfo := toInternalSchedulingInfo(schedulingInfo)
+		jobSchedulingInfo.NotBefore = notBefore
+		return testfixtures.NewJob(
+			util.NewULID(),
+			"testJobset",
+			"testQueue",
+			uint32(10),
+			jobSchedulingInfo,
+			true,
+			0,
+			false,
+			false,
+			false,
+			1,
+			true,
+		).WithPools([]string{testfixtures.TestPool}).WithDeferred(true)
+	}
+
+	tests := map[string]struct {
+		job            *jobdb.Job
+		expectReleased bool
+	}{
+		"not-before time in the future": {
+			job: newDeferredJob(now.Add(time.Minute)),
+		},
+		"not-before time reached": {
+			job:            newDeferredJob(now),
+			expectReleased: true,
+		},
+		"not-before time in the past": {
+			job:            newDeferredJob(now.Add(-time.Minute)),
+			expectReleased: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			jobDb := testfixtures.NewJobDb(testfixtures.TestResourceListFactory)
+			sched := &Scheduler{
+				jobDb: jobDb,
+				clock: clock.NewFakeClock(now),
+			}
+
+			txn := jobDb.WriteTxn()
+			require.NoError(t, txn.Upsert([]*jobdb.Job{tc.job}))
+			require.True(t, tc.job.Deferred())
+
+			events, err := sched.releaseDeferredJobs(ctx, txn)
+			require.NoError(t, err)
+			require.NoError(t, txn.Assert(false))
+
+			job := txn.GetById(tc.job.Id())
+			assert.True(t, job.Queued())
+			assert.Equal(t, !tc.expectReleased, job.Deferred())
+
+			queuedJobIds := make([]string, 0)
+			it := txn.QueuedJobs("testQueue", testfixtures.TestPool, jobdb.FairShareOrder)
+			for queued, _ := it.Next(); queued != nil; queued, _ = it.Next() {
+				queuedJobIds = append(queuedJobIds, queued.Id())
+			}
+			assert.Equal(t, tc.expectReleased, slices.Contains(queuedJobIds, tc.job.Id()))
+
+			if tc.expectReleased {
+				require.Len(t, events, 1)
+				assert.Equal(t, tc.job.Id(), events[0].Events[0].GetJobDeferralEnded().GetJobId())
+			} else {
+				assert.Empty(t, events)
+			}
+		})
+	}
+}
+
+func TestScheduler_ExpireJobsPastQueueDeadline(t *testing.T) {
+	now := time.Now()
+	newJobWithQueueTtl := func(submitTime time.Time, notBefore time.Time, queueTtlSeconds uint32) *jobdb.Job {
+		jobSchedulingInfo := toInternalSchedulingInfo(schedulingInfo)
+		jobSchedulingInfo.SubmitTime = submitTime
+		jobSchedulingInfo.NotBefore = notBefore
+		jobSchedulingInfo.QueueTtlSeconds = queueTtlSeconds
+		return testfixtures.NewJob(
+			util.NewULID(),
+			"testJobset",
+			"testQueue",
+			uint32(10),
+			jobSchedulingInfo,
+			true,
+			0,
+			false,
+			false,
+			false,
+			1,
+			true,
+		).WithPools([]string{testfixtures.TestPool})
+	}
+
+	tests := map[string]struct {
+		job             *jobdb.Job
+		expectCancelled bool
+	}{
+		"no queue ttl": {
+			job: newJobWithQueueTtl(now.Add(-time.Hour), time.Time{}, 0),
+		},
+		"queue ttl not yet passed": {
+			job: newJobWithQueueTtl(now.Add(-time.Minute), time.Time{}, 120),
+		},
+		"queue ttl passed": {
+			job:             newJobWithQueueTtl(now.Add(-time.Minute), time.Time{}, 30),
+			expectCancelled: true,
+		},
+		"queue ttl counted from not-before time": {
+			job: newJobWithQueueTtl(now.Add(-time.Hour), now.Add(-time.Minute), 120),
+		},
+		"queue ttl passed after not-before time": {
+			job:             newJobWithQueueTtl(now.Add(-time.Hour), now.Add(-time.Minute), 30),
+			expectCancelled: true,
+		},
+		"queue ttl passed while awaiting dependencies": {
+			job: newJobWithQueueTtl(now.Add(-time.Hour), time.Time{}, 30).WithDependenciesResolved(false),
+		},
+		"queue ttl counted from dependencies being resolved": {
+			job: newJobWithQueueTtl(now.Add(-time.Hour), time.Time{}, 120).WithDependenciesResolvedTime(now.Add(-time.Minute)),
+		},
+		"queue ttl passed after dependencies were resolved": {
+			job:             newJobWithQueueTtl(now.Add(-time.Hour), time.Time{}, 30).WithDependenciesResolvedTime(now.Add(-time.Minute)),
+			expectCancelled: true,
+		},
+		"queue ttl passed but job previously scheduled": {
+			job: newJobWithQueueTtl(now.Add(-time.Minute), time.Time{}, 30).
+				WithNewRun("testExecutor", "test-node", "node", "pool", 5).
+				WithQueued(true).
+				WithQueuedVersion(2),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			jobDb := testfixtures.NewJobDb(testfixtures.TestResourceListFactory)
+			sched := &Scheduler{
+				executorRepository: testExecutorRepository{updateTimes: map[string]time.Time{"testExecutor": now}},
+				executorTimeout:    time.Hour,
+				jobDb:              jobDb,
+				clock:              clock.NewFakeClock(now),
+			}
+
+			txn := jobDb.WriteTxn()
+			require.NoError(t, txn.Upsert([]*jobdb.Job{tc.job}))
+
+			events, err := sched.expireJobsIfNecessary(ctx, txn)
+			require.NoError(t, err)
+			require.NoError(t, txn.Assert(false))
+
+			job := txn.GetById(tc.job.Id())
+			assert.Equal(t, tc.expectCancelled, job.Cancelled())
+			assert.Equal(t, !tc.expectCancelled, job.Queued())
+			if tc.expectCancelled {
+				require.Len(t, events, 1)
+				assert.Equal(t, tc.job.Id(), events[0].Events[0].GetCancelledJob().GetJobId())
+			} else {
+				assert.Empty(t, events)
+			}
+		})
+	}
+}
+
+func TestScheduler_RetryPolicy(t *testing.T) {
+	now := time.Now()
+	newFailedJob := func(policy *schedulerobjects.RetryPolicy, returned bool) *jobdb.Job {
+		jobSchedulingInfo := toInternalSchedulingInfo(schedulingInfo)
+		jobSchedulingInfo.RetryPolicy = policy
+		job := testfixtures.NewJob(
+			util.NewULID(),
+			"testJobset",
+			"testQueue",
+			0,
+			jobSchedulingInfo,
+			false,
+			1,
+			false,
+			false,
+			false,
+			1,
+			true,
+		).WithNewRun("testExecutor", "test-node", "node", "pool", 5)
+		return job.WithUpdatedRun(job.LatestRun().WithFailed(true).WithAttempted(true).WithReturned(returned))
+	}
+	oomError := &armadaevents.Error{
+		Terminal: true,
+		Reason: &armadaevents.Error_PodError{
+			PodError: &armadaevents.PodError{
+				Message: "oom",
+				ContainerErrors: []*armadaevents.ContainerError{
+					{ExitCode: 137, Reason: "OOMKilled"},
+				},
+			},
+		},
+	}
+	leaseReturnedError := &armadaevents.Error{
+		Terminal: true,
+		Reason: &armadaevents.Error_PodLeaseReturned{
+			PodLeaseReturned: &armadaevents.PodLeaseReturned{Message: "lease returned"},
+		},
+	}
+	retryOnOom := &schedulerobjects.RetryRule{
+		Action:           schedulerobjects.RetryAction_RETRY_ACTION_RETRY,
+		ContainerReasons: []string{"OOMKilled"},
+	}
+
+	tests := map[string]struct {
+		job              *jobdb.Job
+		runError         *armadaevents.Error
+		expectRetry      bool
+		expectDeferred   bool
+		expectRunErrors  bool
+		expectedErrorMsg string
+	}{
+		"no retry policy": {
+			job:      newFailedJob(nil, false),
+			runError: oomError,
+		},
+		"no rule matches returned run": {
+			job:             newFailedJob(&schedulerobjects.RetryPolicy{Rules: []*schedulerobjects.RetryRule{retryOnOom}}, true),
+			runError:        leaseReturnedError,
+			expectRetry:     true,
+			expectRunErrors: true,
+		},
+		"no rule matches failed run": {
+			job: newFailedJob(&schedulerobjects.RetryPolicy{Rules: []*schedulerobjects.RetryRule{
+				{Action: schedulerobjects.RetryAction_RETRY_ACTION_RETRY, ExitCodes: []int32{1}},
+			}}, false),
+			runError:        oomError,
+			expectRunErrors: true,
+		},
+		"retry rule matches failed run": {
+			job:             newFailedJob(&schedulerobjects.RetryPolicy{Rules: []*schedulerobjects.RetryRule{retryOnOom}}, false),
+			runError:        oomError,
+			expectRetry:     true,
+			expectRunErrors: true,
+		},
+		"fail fast rule matches returned run": {
+			job: newFailedJob(&schedulerobjects.RetryPolicy{Rules: []*schedulerobjects.RetryRule{
+				{Action: schedulerobjects.RetryAction_RETRY_ACTION_FAIL_FAST, ErrorKinds: []string{"PodLeaseReturned"}},
+				retryOnOom,
+			}}, true),
+			runError:         leaseReturnedError,
+			expectRunErrors:  true,
+			expectedErrorMsg: "Job matched fail fast retry rule 0 - this job will no longer be retried",
+		},
+		"max attempts reached": {
+			job:              newFailedJob(&schedulerobjects.RetryPolicy{MaxAttempts: 1}, true),
+			runError:         leaseReturnedError,
+			expectRunErrors:  true,
+			expectedErrorMsg: "Maximum number of attempts (1) reached - this job will no longer be retried",
+		},
+		"retry with back-off": {
+			job: newFailedJob(&schedulerobjects.RetryPolicy{
+				BackoffSeconds: 60,
+				Rules:          []*schedulerobjects.RetryRule{retryOnOom},
+			}, false),
+			runError:        oomError,
+			expectRetry:     true,
+			expectDeferred:  true,
+			expectRunErrors: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			jobDb := testfixtures.NewJobDb(testfixtures.TestResourceListFactory)
+			sched := &Scheduler{
+				jobDb:            jobDb,
+				clock:            clock.NewFakeClock(now),
+				submitChecker:    &testSubmitChecker{checkSuccess: true},
+				nodeIdLabel:      nodeIdLabel,
+				maxAttemptedRuns: 5,
+			}
+
+			txn := jobDb.WriteTxn()
+			require.NoError(t, txn.Upsert([]*jobdb.Job{tc.job}))
+
+			runErrors := map[string]*armadaevents.Error{tc.job.LatestRun().Id(): tc.runError}
+			sequence, err := sched.generateUpdateMessagesFromJob(ctx, tc.job, runErrors, txn)
+			require.NoError(t, err)
+			require.NotNil(t, sequence)
+
+			job := txn.GetById(tc.job.Id())
+			assert.Equal(t, tc.expectRetry, job.Queued())
+			assert.Equal(t, !tc.expectRetry, job.Failed())
+			assert.Equal(t, tc.expectDeferred, job.Deferred())
+			if tc.expectDeferred {
+				assert.Equal(t, now.Add(time.Minute), job.NotBefore())
+			}
+
+			var retryDecision *armadaevents.RetryDecision
+			var jobErrors *armadaevents.JobErrors
+			requeued := false
+			for _, event := range sequence.Events {
+				if runErrors := event.GetJobRunErrors(); runErrors != nil {
+					require.Len(t, runErrors.Errors, 1)
+					assert.False(t, runErrors.Errors[0].Terminal)
+					retryDecision = runErrors.Errors[0].GetRetryDecision()
+				}
+				if event.GetJobErrors() != nil {
+					jobErrors = event.GetJobErrors()
+				}
+				if requeue := event.GetJobRequeued(); requeue != nil {
+					requeued = true
+					assert.Equal(t, tc.expectDeferred, requeue.SchedulingInfo.NotBefore != nil)
+				}
+			}
+			assert.Equal(t, tc.expectRetry, requeued)
+			if tc.expectRunErrors {
+				require.NotNil(t, retryDecision)
+				assert.Equal(t, tc.expectRetry, retryDecision.Retry)
+				assert.Equal(t, uint32(1), retryDecision.Attempts)
+			} else {
+				assert.Nil(t, retryDecision)
+			}
+			if !tc.expectRetry {
+				require.NotNil(t, jobErrors)
+				require.Len(t, jobErrors.Errors, 1)
+				assert.True(t, jobErrors.Errors[0].Terminal)
+				if tc.expectedErrorMsg != "" {
+					assert.Contains(t, jobErrors.Errors[0].GetMaxRunsExceeded().GetMessage(), tc.expectedErrorMsg)
+				}
+			}
+		})
+	}
+}
+
 type testSubmitChecker struct {
 	checkSuccess bool
 }
@@ -1685,6 +2147,7 @@ type testJobRepository struct {
 	updatedJobs           []database.Job
 	updatedRuns           []database.Run
 	errors                map[string]*armadaevents.Error
+	jobOutcomes           map[string]database.JobOutcome
 	shouldError           bool
 	numReceivedPartitions uint32
 	maxJobSerial          *int64
@@ -1696,6 +2159,11 @@ func (t *testJobRepository) FindInactiveRuns(ctx *armadacontext.Context, runIds
 	panic("implement me")
 }
 
+func (t *testJobRepository) FindPreemptedRuns(ctx *armadacontext.Context, runIds []string) ([]string, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (t *testJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []string) ([]*database.JobRunLease, error) {
 	// TODO implement me
 	panic("implement me")
@@ -1715,6 +2183,19 @@ func (t *testJobRepository) FetchJobRunErrors(ctx *armadacontext.Context, runIds
 	return t.errors, nil
 }
 
+func (t *testJobRepository) FetchJobOutcomes(ctx *armadacontext.Context, jobIds []string) (map[string]database.JobOutcome, error) {
+	if t.shouldError {
+		return nil, errors.New("error fetching job outcomes")
+	}
+	outcomes := make(map[string]database.JobOutcome, len(jobIds))
+	for _, jobId := range jobIds {
+		if outcome, ok := t.jobOutcomes[jobId]; ok {
+			outcomes[jobId] = outcome
+		}
+	}
+	return outcomes, nil
+}
+
 func (t *testJobRepository) CountReceivedPartitions(ctx *armadacontext.Context, groupId uuid.UUID) (uint32, error) {
 	if t.shouldError {
 		return 0, errors.New("error counting received partitions")
@@ -1742,6 +2223,10 @@ func (t testExecutorRepository) GetExecutorSettings(ctx *armadacontext.Context)
 	panic("not implemented")
 }
 
+func (t testExecutorRepository) GetNodeDrains(ctx *armadacontext.Context) ([]*schedulerobjects.NodeDrain, error) {
+	panic("not implemented")
+}
+
 func (t testExecutorRepository) GetLastUpdateTimes(ctx *armadacontext.Context) (map[string]time.Time, error) {
 	if t.shouldError {
 		return nil, errors.New("error getting last update time")
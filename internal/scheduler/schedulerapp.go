@@ -24,6 +24,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
 	dbcommon "github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	"github.com/armadaproject/armada/internal/common/health"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -188,48 +189,113 @@ func Run(config schedulerconfig.Configuration) error {
 	// ////////////////////////////////////////////////////////////////////////
 	// Pulsar
 	// ////////////////////////////////////////////////////////////////////////
-	ctx.Infof("Setting up Pulsar connectivity")
-	pulsarClient, err := pulsarutils.NewPulsarClient(&config.Pulsar)
-	if err != nil {
-		return errors.WithMessage(err, "Error creating pulsar client")
-	}
-	defer pulsarClient.Close()
-
-	jobsetEventPublisher, err := NewPulsarPublisher(pulsarClient, pulsar.ProducerOptions{
-		Name:             fmt.Sprintf("armada-scheduler-%s", uuid.NewString()),
-		CompressionType:  config.Pulsar.CompressionType,
-		CompressionLevel: config.Pulsar.CompressionLevel,
-		BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
-		Topic:            config.Pulsar.JobsetEventsTopic,
-	}, config.Pulsar.MaxAllowedEventsPerMessage, config.Pulsar.MaxAllowedMessageSize, config.Pulsar.SendTimeout)
-	if err != nil {
-		return errors.WithMessage(err, "error creating jobset event pulsar publisher")
-	}
-
+	var jobsetEventPublisher Publisher
 	// Publishing metrics to pulsar is experimental.  We default to a no-op publisher and only enable a pulsar publisher
 	// if the feature flag is set in config
 	var metricPublisher pulsarutils.Publisher[*metricevents.Event] = pulsarutils.NoOpPublisher[*metricevents.Event]{}
-	if config.PublishMetricsToPulsar {
-		metricPublisher, err = pulsarutils.NewPulsarPublisher[*metricevents.Event](
+	var apiPublisher pulsarutils.Publisher[*armadaevents.EventSequence]
+	// Metrics are sent to an unpartitioned pulsar topic so there is no key needed
+	metricKeyRetriever := func(event *metricevents.Event) string {
+		return ""
+	}
+	preProcessor := jobsetevents.NewPreProcessor(config.Pulsar.MaxAllowedEventsPerMessage, config.Pulsar.MaxAllowedMessageSize)
+	if config.Pulsar.EventLog.Enabled {
+		ctx.Infof("Setting up embedded event log")
+		eventLog, err := eventlog.Open(ctx, config.Pulsar.EventLog)
+		if err != nil {
+			return errors.WithMessage(err, "Error opening event log")
+		}
+		defer eventLog.Close()
+
+		jobsetEventPublisher, err = NewEventLogPublisher(
+			ctx,
+			eventLog,
+			config.Pulsar.JobsetEventsTopic,
+			config.Pulsar.MaxAllowedEventsPerMessage,
+			config.Pulsar.MaxAllowedMessageSize,
+		)
+		if err != nil {
+			return errors.WithMessage(err, "error creating jobset event log publisher")
+		}
+		if config.PublishMetricsToPulsar {
+			metricPublisher, err = eventlog.NewPublisher[*metricevents.Event](
+				ctx,
+				eventLog,
+				config.Pulsar.MetricEventsTopic,
+				utils.NoOpPreProcessor,
+				metricKeyRetriever,
+			)
+			if err != nil {
+				return errors.WithMessage(err, "error creating metric event log publisher")
+			}
+		}
+		apiPublisher, err = eventlog.NewPublisher[*armadaevents.EventSequence](
+			ctx,
+			eventLog,
+			config.Pulsar.JobsetEventsTopic,
+			preProcessor,
+			jobsetevents.RetrieveKey,
+		)
+		if err != nil {
+			return errors.WithMessage(err, "error creating event log publisher for executor api")
+		}
+	} else {
+		ctx.Infof("Setting up Pulsar connectivity")
+		pulsarClient, err := pulsarutils.NewPulsarClient(&config.Pulsar)
+		if err != nil {
+			return errors.WithMessage(err, "Error creating pulsar client")
+		}
+		defer pulsarClient.Close()
+
+		jobsetEventPublisher, err = NewPulsarPublisher(pulsarClient, pulsar.ProducerOptions{
+			Name:             fmt.Sprintf("armada-scheduler-%s", uuid.NewString()),
+			CompressionType:  config.Pulsar.CompressionType,
+			CompressionLevel: config.Pulsar.CompressionLevel,
+			BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
+			Topic:            config.Pulsar.JobsetEventsTopic,
+		}, config.Pulsar.MaxAllowedEventsPerMessage, config.Pulsar.MaxAllowedMessageSize, config.Pulsar.SendTimeout)
+		if err != nil {
+			return errors.WithMessage(err, "error creating jobset event pulsar publisher")
+		}
+
+		if config.PublishMetricsToPulsar {
+			metricPublisher, err = pulsarutils.NewPulsarPublisher[*metricevents.Event](
+				pulsarClient,
+				pulsar.ProducerOptions{
+					Name:             fmt.Sprintf("armada-scheduler-metrics-%s", uuid.NewString()),
+					CompressionType:  config.Pulsar.CompressionType,
+					CompressionLevel: config.Pulsar.CompressionLevel,
+					BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
+					Topic:            config.Pulsar.MetricEventsTopic,
+				},
+				utils.NoOpPreProcessor,
+				metricKeyRetriever,
+				config.Pulsar.SendTimeout,
+			)
+			if err != nil {
+				return errors.WithMessage(err, "error creating metric event pulsar publisher")
+			}
+		}
+
+		apiPublisher, err = pulsarutils.NewPulsarPublisher[*armadaevents.EventSequence](
 			pulsarClient,
 			pulsar.ProducerOptions{
-				Name:             fmt.Sprintf("armada-scheduler-metrics-%s", uuid.NewString()),
+				Name:             fmt.Sprintf("armada-executor-api-%s", uuid.NewString()),
 				CompressionType:  config.Pulsar.CompressionType,
 				CompressionLevel: config.Pulsar.CompressionLevel,
 				BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
-				Topic:            config.Pulsar.MetricEventsTopic,
-			},
-			utils.NoOpPreProcessor,
-			// Metrics are sent to an unpartitioned pulsar topic so there is no key needed
-			func(event *metricevents.Event) string {
-				return ""
+				Topic:            config.Pulsar.JobsetEventsTopic,
 			},
+			preProcessor,
+			jobsetevents.RetrieveKey,
 			config.Pulsar.SendTimeout,
 		)
 		if err != nil {
-			return errors.WithMessage(err, "error creating metric event pulsar publisher")
+			return errors.Wrapf(err, "error creating pulsar publisher for executor api")
 		}
 	}
+	defer apiPublisher.Close()
+
 	// ////////////////////////////////////////////////////////////////////////
 	// Leader Election
 	// ////////////////////////////////////////////////////////////////////////
@@ -243,25 +309,6 @@ func Run(config schedulerconfig.Configuration) error {
 	// Executor Api
 	// ////////////////////////////////////////////////////////////////////////
 	ctx.Infof("Setting up executor api")
-	preProcessor := jobsetevents.NewPreProcessor(config.Pulsar.MaxAllowedEventsPerMessage, config.Pulsar.MaxAllowedMessageSize)
-	apiPublisher, err := pulsarutils.NewPulsarPublisher[*armadaevents.EventSequence](
-		pulsarClient,
-		pulsar.ProducerOptions{
-			Name:             fmt.Sprintf("armada-executor-api-%s", uuid.NewString()),
-			CompressionType:  config.Pulsar.CompressionType,
-			CompressionLevel: config.Pulsar.CompressionLevel,
-			BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
-			Topic:            config.Pulsar.JobsetEventsTopic,
-		},
-		preProcessor,
-		jobsetevents.RetrieveKey,
-		config.Pulsar.SendTimeout,
-	)
-	if err != nil {
-		return errors.Wrapf(err, "error creating pulsar publisher for executor api")
-	}
-	defer apiPublisher.Close()
-
 	authServices, err := auth.ConfigureAuth(config.Auth)
 	if err != nil {
 		return errors.WithMessage(err, "error creating auth services")
@@ -305,8 +352,15 @@ func Run(config schedulerconfig.Configuration) error {
 	// ////////////////////////////////////////////////////////////////////////
 	// Scheduler Reports
 	// ////////////////////////////////////////////////////////////////////////
+	stringInterner := stringinterner.New(config.InternedStringsCacheSize)
+	jobDb := jobdb.NewJobDb(
+		config.Scheduling.PriorityClasses,
+		config.Scheduling.DefaultPriorityClassName,
+		stringInterner,
+		resourceListFactory,
+	)
 	schedulingContextRepository := reports.NewSchedulingContextRepository()
-	reportServer := reports.NewServer(schedulingContextRepository)
+	reportServer := reports.NewServer(schedulingContextRepository, jobDb)
 
 	clientMetrics := grpc_prometheus.NewClientMetrics(
 		grpc_prometheus.WithClientHandlingTimeHistogram(),
@@ -340,7 +394,6 @@ func Run(config schedulerconfig.Configuration) error {
 	})
 
 	shortJobPenalty := scheduling.NewShortJobPenalty(config.Scheduling.GetShortJobPenaltyCutoffs())
-	stringInterner := stringinterner.New(config.InternedStringsCacheSize)
 	schedulingAlgo, err := scheduling.NewFairSchedulingAlgo(
 		config.Scheduling,
 		config.MaxSchedulingDuration,
@@ -355,12 +408,6 @@ func Run(config schedulerconfig.Configuration) error {
 	if err != nil {
 		return errors.WithMessage(err, "error creating scheduling algo")
 	}
-	jobDb := jobdb.NewJobDb(
-		config.Scheduling.PriorityClasses,
-		config.Scheduling.DefaultPriorityClassName,
-		stringInterner,
-		resourceListFactory,
-	)
 
 	schedulerMetrics, err := metrics.New(
 		config.Metrics.TrackedErrorRegexes,
@@ -60,6 +60,62 @@ func (JobRunState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_97dadc5fbd620721, []int{0}
 }
 
+// Determines what happens to a job when one of the jobs it depends on fails or is cancelled.
+type DependencyFailurePolicy int32
+
+const (
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL     DependencyFailurePolicy = 0
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL       DependencyFailurePolicy = 1
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY DependencyFailurePolicy = 2
+)
+
+var DependencyFailurePolicy_name = map[int32]string{
+	0: "DEPENDENCY_FAILURE_POLICY_CANCEL",
+	1: "DEPENDENCY_FAILURE_POLICY_FAIL",
+	2: "DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY",
+}
+
+var DependencyFailurePolicy_value = map[string]int32{
+	"DEPENDENCY_FAILURE_POLICY_CANCEL":     0,
+	"DEPENDENCY_FAILURE_POLICY_FAIL":       1,
+	"DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY": 2,
+}
+
+func (x DependencyFailurePolicy) String() string {
+	return proto.EnumName(DependencyFailurePolicy_name, int32(x))
+}
+
+func (DependencyFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{1}
+}
+
+type RetryAction int32
+
+const (
+	// The job is retried, provided it has not reached its maximum number of attempts.
+	RetryAction_RETRY_ACTION_RETRY RetryAction = 0
+	// The job is failed immediately.
+	RetryAction_RETRY_ACTION_FAIL_FAST RetryAction = 1
+)
+
+var RetryAction_name = map[int32]string{
+	0: "RETRY_ACTION_RETRY",
+	1: "RETRY_ACTION_FAIL_FAST",
+}
+
+var RetryAction_value = map[string]int32{
+	"RETRY_ACTION_RETRY":     0,
+	"RETRY_ACTION_FAIL_FAST": 1,
+}
+
+func (x RetryAction) String() string {
+	return proto.EnumName(RetryAction_name, int32(x))
+}
+
+func (RetryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{2}
+}
+
 // Executor represents an executor running on a worker cluster
 type Executor struct {
 	// Name of the executor.
@@ -414,6 +470,16 @@ type JobSchedulingInfo struct {
 	// Kubernetes objects that make up this job and their respective scheduling requirements.
 	ObjectRequirements []*ObjectRequirements `protobuf:"bytes,5,rep,name=object_requirements,json=objectRequirements,proto3" json:"objectRequirements,omitempty"`
 	Version            uint32                `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Ids of the jobs that must succeed before this job may be scheduled.
+	Dependencies            []string                `protobuf:"bytes,11,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	DependencyFailurePolicy DependencyFailurePolicy `protobuf:"varint,12,opt,name=dependency_failure_policy,json=dependencyFailurePolicy,proto3,enum=schedulerobjects.DependencyFailurePolicy" json:"dependencyFailurePolicy,omitempty"`
+	// If set, the job may not be scheduled until this time.
+	NotBefore *types.Timestamp `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3" json:"notBefore,omitempty"`
+	// If non-zero, the job is cancelled if it hasn't been scheduled within this many seconds of becoming eligible for
+	// scheduling.
+	QueueTtlSeconds uint32 `protobuf:"varint,14,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// Determines whether the job is retried if one of its runs fails. If not set, scheduler-wide defaults apply.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,15,opt,name=retry_policy,json=retryPolicy,proto3" json:"retryPolicy,omitempty"`
 }
 
 func (m *JobSchedulingInfo) Reset()         { *m = JobSchedulingInfo{} }
@@ -512,6 +578,179 @@ func (m *JobSchedulingInfo) GetVersion() uint32 {
 	return 0
 }
 
+func (m *JobSchedulingInfo) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *JobSchedulingInfo) GetDependencyFailurePolicy() DependencyFailurePolicy {
+	if m != nil {
+		return m.DependencyFailurePolicy
+	}
+	return DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL
+}
+
+func (m *JobSchedulingInfo) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *JobSchedulingInfo) GetQueueTtlSeconds() uint32 {
+	if m != nil {
+		return m.QueueTtlSeconds
+	}
+	return 0
+}
+
+func (m *JobSchedulingInfo) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+// Determines whether a job is retried if one of its runs fails.
+type RetryPolicy struct {
+	// Maximum number of runs of the job. If zero, the maximum number of attempts configured for the scheduler applies.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// Minimum number of seconds between a run failing and the job being scheduled again.
+	BackoffSeconds uint32 `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoffSeconds,omitempty"`
+	// Rules matched, in order, against the error of a failed run. The first matching rule determines whether the job
+	// is retried. If no rule matches, the job is retried only if the executor returned the run because its pod never
+	// started, e.g., because it couldn't be scheduled onto the node it was assigned to.
+	Rules []*RetryRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{5}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffSeconds() uint32 {
+	if m != nil {
+		return m.BackoffSeconds
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRules() []*RetryRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// A rule matching the error of a failed run. A rule matches if all of its non-empty criteria match.
+type RetryRule struct {
+	Action RetryAction `protobuf:"varint,1,opt,name=action,proto3,enum=schedulerobjects.RetryAction" json:"action,omitempty"`
+	// Matches if any container exited with one of these exit codes.
+	ExitCodes []int32 `protobuf:"varint,2,rep,packed,name=exit_codes,json=exitCodes,proto3" json:"exitCodes,omitempty"`
+	// Matches if any container terminated with one of these reasons, e.g., OOMKilled.
+	ContainerReasons []string `protobuf:"bytes,3,rep,name=container_reasons,json=containerReasons,proto3" json:"containerReasons,omitempty"`
+	// Matches if the kind of the error is one of these, e.g., PodError or PodLeaseReturned.
+	ErrorKinds []string `protobuf:"bytes,4,rep,name=error_kinds,json=errorKinds,proto3" json:"errorKinds,omitempty"`
+}
+
+func (m *RetryRule) Reset()         { *m = RetryRule{} }
+func (m *RetryRule) String() string { return proto.CompactTextString(m) }
+func (*RetryRule) ProtoMessage()    {}
+func (*RetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{6}
+}
+func (m *RetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryRule.Merge(m, src)
+}
+func (m *RetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryRule proto.InternalMessageInfo
+
+func (m *RetryRule) GetAction() RetryAction {
+	if m != nil {
+		return m.Action
+	}
+	return RetryAction_RETRY_ACTION_RETRY
+}
+
+func (m *RetryRule) GetExitCodes() []int32 {
+	if m != nil {
+		return m.ExitCodes
+	}
+	return nil
+}
+
+func (m *RetryRule) GetContainerReasons() []string {
+	if m != nil {
+		return m.ContainerReasons
+	}
+	return nil
+}
+
+func (m *RetryRule) GetErrorKinds() []string {
+	if m != nil {
+		return m.ErrorKinds
+	}
+	return nil
+}
+
 // Message capturing the scheduling requirements of a particular Kubernetes object.
 type ObjectRequirements struct {
 	// Types that are valid to be assigned to Requirements:
@@ -523,7 +762,7 @@ func (m *ObjectRequirements) Reset()         { *m = ObjectRequirements{} }
 func (m *ObjectRequirements) String() string { return proto.CompactTextString(m) }
 func (*ObjectRequirements) ProtoMessage()    {}
 func (*ObjectRequirements) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97dadc5fbd620721, []int{5}
+	return fileDescriptor_97dadc5fbd620721, []int{7}
 }
 func (m *ObjectRequirements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,13 +839,18 @@ type PodRequirements struct {
 	PreemptionPolicy string `protobuf:"bytes,5,opt,name=preemptionPolicy,proto3" json:"preemptionPolicy,omitempty"`
 	// Sum of the resource requirements for all containers that make up this pod.
 	ResourceRequirements *v1.ResourceRequirements `protobuf:"bytes,6,opt,name=resourceRequirements,proto3" json:"resourceRequirements,omitempty"`
+	// Kubernetes labels and namespace of the pod. Used to evaluate inter-pod affinity and topology spread constraints.
+	Labels    map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace string            `protobuf:"bytes,10,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Kubernetes topology spread constraints.
+	TopologySpreadConstraints []*v1.TopologySpreadConstraint `protobuf:"bytes,11,rep,name=topologySpreadConstraints,proto3" json:"topologySpreadConstraints,omitempty"`
 }
 
 func (m *PodRequirements) Reset()         { *m = PodRequirements{} }
 func (m *PodRequirements) String() string { return proto.CompactTextString(m) }
 func (*PodRequirements) ProtoMessage()    {}
 func (*PodRequirements) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97dadc5fbd620721, []int{6}
+	return fileDescriptor_97dadc5fbd620721, []int{8}
 }
 func (m *PodRequirements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,6 +921,27 @@ func (m *PodRequirements) GetResourceRequirements() *v1.ResourceRequirements {
 	return nil
 }
 
+func (m *PodRequirements) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PodRequirements) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PodRequirements) GetTopologySpreadConstraints() []*v1.TopologySpreadConstraint {
+	if m != nil {
+		return m.TopologySpreadConstraints
+	}
+	return nil
+}
+
 type ExecutorSettings struct {
 	ExecutorId   string           `protobuf:"bytes,1,opt,name=executorId,proto3" json:"executorId,omitempty"`
 	Cordoned     bool             `protobuf:"varint,2,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
@@ -689,7 +954,7 @@ func (m *ExecutorSettings) Reset()         { *m = ExecutorSettings{} }
 func (m *ExecutorSettings) String() string { return proto.CompactTextString(m) }
 func (*ExecutorSettings) ProtoMessage()    {}
 func (*ExecutorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97dadc5fbd620721, []int{7}
+	return fileDescriptor_97dadc5fbd620721, []int{9}
 }
 func (m *ExecutorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,8 +1018,105 @@ func (m *ExecutorSettings) GetSetAtTime() *types.Timestamp {
 	return nil
 }
 
+// A request to drain either a single node or all nodes matching a label selector on an executor.
+type NodeDrain struct {
+	ExecutorId string `protobuf:"bytes,1,opt,name=executorId,proto3" json:"executorId,omitempty"`
+	// Empty if the drain applies to nodes matching nodeSelector.
+	NodeName     string            `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, preemptible jobs running on the drained nodes are preempted.
+	Preempt   bool             `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	Reason    string           `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SetByUser string           `protobuf:"bytes,6,opt,name=setByUser,proto3" json:"setByUser,omitempty"`
+	SetAtTime *types.Timestamp `protobuf:"bytes,7,opt,name=setAtTime,proto3" json:"setAtTime,omitempty"`
+}
+
+func (m *NodeDrain) Reset()         { *m = NodeDrain{} }
+func (m *NodeDrain) String() string { return proto.CompactTextString(m) }
+func (*NodeDrain) ProtoMessage()    {}
+func (*NodeDrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dadc5fbd620721, []int{10}
+}
+func (m *NodeDrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDrain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeDrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDrain.Merge(m, src)
+}
+func (m *NodeDrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDrain proto.InternalMessageInfo
+
+func (m *NodeDrain) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return ""
+}
+
+func (m *NodeDrain) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *NodeDrain) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *NodeDrain) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
+func (m *NodeDrain) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *NodeDrain) GetSetByUser() string {
+	if m != nil {
+		return m.SetByUser
+	}
+	return ""
+}
+
+func (m *NodeDrain) GetSetAtTime() *types.Timestamp {
+	if m != nil {
+		return m.SetAtTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("schedulerobjects.JobRunState", JobRunState_name, JobRunState_value)
+	proto.RegisterEnum("schedulerobjects.DependencyFailurePolicy", DependencyFailurePolicy_name, DependencyFailurePolicy_value)
+	proto.RegisterEnum("schedulerobjects.RetryAction", RetryAction_name, RetryAction_value)
 	proto.RegisterType((*Executor)(nil), "schedulerobjects.Executor")
 	proto.RegisterType((*Node)(nil), "schedulerobjects.Node")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.Node.LabelsEntry")
@@ -764,11 +1126,16 @@ func init() {
 	proto.RegisterType((*ResourceList)(nil), "schedulerobjects.ResourceList")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "schedulerobjects.ResourceList.ResourcesEntry")
 	proto.RegisterType((*JobSchedulingInfo)(nil), "schedulerobjects.JobSchedulingInfo")
+	proto.RegisterType((*RetryPolicy)(nil), "schedulerobjects.RetryPolicy")
+	proto.RegisterType((*RetryRule)(nil), "schedulerobjects.RetryRule")
 	proto.RegisterType((*ObjectRequirements)(nil), "schedulerobjects.ObjectRequirements")
 	proto.RegisterType((*PodRequirements)(nil), "schedulerobjects.PodRequirements")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.NodeSelectorEntry")
 	proto.RegisterType((*ExecutorSettings)(nil), "schedulerobjects.ExecutorSettings")
+	proto.RegisterType((*NodeDrain)(nil), "schedulerobjects.NodeDrain")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.NodeDrain.NodeSelectorEntry")
 }
 
 func init() {
@@ -776,113 +1143,151 @@ func init() {
 }
 
 var fileDescriptor_97dadc5fbd620721 = []byte{
-	// 1696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x6d, 0x59, 0xa6, 0x46, 0xfe, 0xa0, 0xc6, 0xde, 0x84, 0x51, 0xb2, 0xa2, 0xaa, 0xdd,
-	0x16, 0x4e, 0x3f, 0x28, 0xac, 0xb7, 0x05, 0x82, 0x14, 0x28, 0x60, 0x26, 0xee, 0xc6, 0x6a, 0x2a,
-	0x3b, 0x76, 0x84, 0xa2, 0x2d, 0x0a, 0x76, 0x44, 0x8e, 0x15, 0xae, 0xa9, 0x19, 0x2e, 0x39, 0x4c,
-	0x57, 0xb7, 0x5e, 0x8b, 0x5e, 0xba, 0x45, 0xdb, 0xff, 0xa6, 0xf7, 0xa2, 0xe8, 0x61, 0x8f, 0x3d,
-	0x11, 0x45, 0x02, 0xf4, 0xc0, 0xbf, 0x62, 0x31, 0x43, 0x52, 0x1c, 0x7d, 0xd8, 0xf2, 0x65, 0x4f,
-	0x16, 0x7f, 0xef, 0xbd, 0xdf, 0x7b, 0xf3, 0xe6, 0xcd, 0x9b, 0x37, 0x06, 0x4f, 0x3d, 0xc2, 0x70,
-	0x48, 0x90, 0xdf, 0x8d, 0x9c, 0x37, 0xd8, 0x8d, 0x7d, 0x1c, 0x96, 0xbf, 0xe8, 0xf0, 0x73, 0xec,
-	0xb0, 0x68, 0x01, 0x30, 0x83, 0x90, 0x32, 0x0a, 0xb5, 0x79, 0xbc, 0x69, 0x8c, 0x28, 0x1d, 0xf9,
-	0xb8, 0x2b, 0xe4, 0xc3, 0xf8, 0xaa, 0xcb, 0xbc, 0x31, 0x8e, 0x18, 0x1a, 0x07, 0x99, 0x49, 0xb3,
-	0x73, 0xfd, 0x24, 0x32, 0x3d, 0xda, 0x45, 0x81, 0xd7, 0x75, 0x68, 0x88, 0xbb, 0x6f, 0x3f, 0xe9,
-	0x8e, 0x30, 0xc1, 0x21, 0x62, 0xd8, 0xcd, 0x75, 0x7e, 0x5c, 0xea, 0x8c, 0x91, 0xf3, 0xc6, 0x23,
-	0x38, 0x9c, 0x74, 0x83, 0xeb, 0x91, 0x30, 0x0a, 0x71, 0x44, 0xe3, 0xd0, 0xc1, 0xf3, 0x56, 0x9d,
-	0x77, 0xeb, 0x40, 0x3d, 0xf9, 0x12, 0x3b, 0x31, 0xa3, 0x21, 0x6c, 0x83, 0x75, 0xcf, 0xd5, 0x95,
-	0xb6, 0x72, 0x58, 0xb3, 0xb4, 0x34, 0x31, 0xb6, 0x3d, 0xf7, 0x87, 0x74, 0xec, 0x31, 0x3c, 0x0e,
-	0xd8, 0xe4, 0x62, 0xdd, 0x73, 0xe1, 0xf7, 0x40, 0x25, 0xa0, 0xd4, 0xd7, 0xd7, 0x85, 0x0e, 0x4c,
-	0x13, 0x63, 0x97, 0x7f, 0x4b, 0x5a, 0x42, 0x0e, 0x8f, 0xc1, 0x26, 0xa1, 0x2e, 0x8e, 0xf4, 0x8d,
-	0xf6, 0xc6, 0x61, 0xfd, 0xe8, 0x9e, 0xb9, 0x90, 0x8b, 0x3e, 0x75, 0xb1, 0xb5, 0x9f, 0x26, 0xc6,
-	0x9e, 0x50, 0x94, 0x18, 0x32, 0x4b, 0xf8, 0x7b, 0xb0, 0xeb, 0xa3, 0x88, 0x0d, 0x02, 0x17, 0x31,
-	0xfc, 0xda, 0x1b, 0x63, 0x7d, 0xb3, 0xad, 0x1c, 0xd6, 0x8f, 0x9a, 0x66, 0x96, 0x2d, 0xb3, 0xc8,
-	0x96, 0xf9, 0xba, 0xc8, 0x96, 0xf5, 0x28, 0x4d, 0x0c, 0x7d, 0xd6, 0x4a, 0x22, 0x9e, 0xe3, 0x83,
-	0x67, 0x60, 0x3f, 0x26, 0x28, 0x8a, 0xbc, 0x11, 0xc1, 0xae, 0xfd, 0x39, 0x1d, 0xda, 0x61, 0x4c,
-	0x22, 0xbd, 0xd6, 0xde, 0x38, 0xac, 0x59, 0x46, 0x9a, 0x18, 0x0f, 0x4b, 0x71, 0x8f, 0x0e, 0x2f,
-	0x62, 0x22, 0x87, 0xd9, 0x58, 0x10, 0xf6, 0x2a, 0x6a, 0x45, 0xdb, 0xec, 0x55, 0xd4, 0xaa, 0xb6,
-	0xd5, 0xab, 0xa8, 0x5b, 0x9a, 0xda, 0xab, 0xa8, 0xaa, 0x56, 0xeb, 0xfc, 0xbf, 0x0e, 0x2a, 0x7c,
-	0xbd, 0x77, 0x4b, 0x30, 0x41, 0x63, 0xac, 0x6f, 0x97, 0x09, 0xe6, 0xdf, 0x72, 0x82, 0xf9, 0x37,
-	0x3c, 0x02, 0x2a, 0xce, 0xb7, 0x4d, 0xdf, 0x17, 0xba, 0xf7, 0xd2, 0xc4, 0x80, 0x05, 0x26, 0xe9,
-	0x4f, 0xf5, 0xe0, 0x19, 0xa8, 0xf1, 0x0c, 0xd8, 0x11, 0xc6, 0x44, 0xec, 0xe0, 0xed, 0xc9, 0x14,
-	0x84, 0xdc, 0xe0, 0x12, 0x63, 0x22, 0x13, 0x16, 0x18, 0xfc, 0x0c, 0x54, 0x19, 0xf2, 0x08, 0x8b,
-	0xf4, 0x4d, 0xb1, 0xcd, 0x0f, 0xcc, 0xac, 0x06, 0x4d, 0x14, 0x78, 0x26, 0xaf, 0x53, 0xf3, 0xed,
-	0x27, 0xe6, 0x6b, 0xae, 0x61, 0x1d, 0xa4, 0x89, 0xa1, 0x65, 0xca, 0x12, 0x55, 0x6e, 0x0e, 0xcf,
-	0x41, 0xd5, 0x47, 0x43, 0xec, 0x47, 0x7a, 0x55, 0x10, 0x75, 0x96, 0xd7, 0x8b, 0xf9, 0x52, 0x28,
-	0x9d, 0x10, 0x16, 0x4e, 0x32, 0xc6, 0xcc, 0x4a, 0x66, 0xcc, 0x10, 0x88, 0xc1, 0x1e, 0xa3, 0x0c,
-	0xf9, 0x76, 0x51, 0xf9, 0x91, 0xbe, 0x25, 0x56, 0xdc, 0x5a, 0xa4, 0xbe, 0xc8, 0x55, 0x5e, 0x7a,
-	0x11, 0xcb, 0x4a, 0x48, 0x98, 0x16, 0xb0, 0x4c, 0xbf, 0x3b, 0x2b, 0x81, 0x5f, 0x82, 0xfd, 0x88,
-	0x21, 0x86, 0xed, 0xe1, 0xa4, 0x28, 0x20, 0xdb, 0x73, 0x45, 0x09, 0xd5, 0x8f, 0x7e, 0x70, 0xc3,
-	0x2a, 0x2e, 0xb9, 0x85, 0x35, 0xc9, 0xaa, 0xe6, 0xd4, 0xcd, 0x96, 0xf3, 0x61, 0x9a, 0x18, 0x0f,
-	0xa2, 0x59, 0x89, 0xe4, 0x78, 0x6f, 0x4e, 0x04, 0xbf, 0x52, 0xc0, 0xfd, 0x98, 0x20, 0xdf, 0xa7,
-	0x0e, 0x62, 0x68, 0xe8, 0x63, 0x69, 0xa5, 0x3b, 0xc2, 0xfd, 0xd1, 0x0d, 0xee, 0x07, 0xb2, 0xd5,
-	0x74, 0x29, 0x59, 0x14, 0x1f, 0xa7, 0x89, 0xd1, 0x8e, 0x97, 0x2a, 0x48, 0xc1, 0xdc, 0x5b, 0xae,
-	0x01, 0x8f, 0xc1, 0x4e, 0x4c, 0x72, 0xa7, 0x5c, 0xa2, 0xef, 0xb5, 0x95, 0x43, 0xd5, 0x7a, 0x98,
-	0x26, 0xc6, 0xfd, 0x19, 0x81, 0xc4, 0x35, 0x6b, 0xc1, 0xcf, 0x64, 0x88, 0x03, 0x1a, 0x32, 0x8f,
-	0x8c, 0x6c, 0xde, 0x08, 0x6c, 0x36, 0x09, 0xb0, 0xde, 0x10, 0x25, 0x2e, 0xce, 0xe4, 0x54, 0xcc,
-	0x17, 0xf3, 0x7a, 0x12, 0xc8, 0x64, 0x8d, 0x05, 0xe1, 0xb4, 0x63, 0xc1, 0x15, 0x1d, 0xeb, 0xef,
-	0x0a, 0x68, 0x17, 0x19, 0xb4, 0xe3, 0x08, 0x8d, 0xc4, 0x9e, 0x7e, 0x11, 0xe3, 0x18, 0xdb, 0x88,
-	0xb8, 0xb6, 0x20, 0x39, 0x10, 0x89, 0xfd, 0x68, 0x31, 0xb1, 0xe7, 0x94, 0xfa, 0xaf, 0xb8, 0x6e,
-	0x91, 0x0c, 0xeb, 0x71, 0x9a, 0x18, 0xdf, 0x2d, 0x08, 0x07, 0x9c, 0xcf, 0x9a, 0x08, 0x8d, 0x63,
-	0xe2, 0x9e, 0xcf, 0x06, 0xf0, 0xf0, 0x16, 0xb5, 0x26, 0x02, 0x75, 0xa9, 0xea, 0xe1, 0x47, 0x60,
-	0xe3, 0x1a, 0x4f, 0xf2, 0x16, 0xd2, 0x48, 0x13, 0x63, 0xe7, 0x1a, 0x4f, 0x24, 0x2e, 0x2e, 0x85,
-	0x8f, 0xc1, 0xe6, 0x5b, 0xe4, 0xc7, 0x38, 0x6f, 0xd3, 0xa2, 0xcb, 0x0a, 0x40, 0xee, 0xb2, 0x02,
-	0x78, 0xba, 0xfe, 0x44, 0x69, 0xfe, 0x49, 0x01, 0x07, 0xcb, 0x6a, 0xf2, 0x6e, 0xce, 0x5e, 0xc8,
-	0xce, 0x76, 0x8f, 0x3e, 0x5c, 0x4c, 0x4e, 0x46, 0x9a, 0x79, 0x58, 0x15, 0xcb, 0x57, 0x0a, 0x78,
-	0x78, 0x4b, 0x81, 0xca, 0x21, 0x6d, 0xde, 0x18, 0xd2, 0xa9, 0x1c, 0xd2, 0xea, 0x23, 0xbf, 0x22,
-	0xa6, 0x5e, 0x45, 0xdd, 0xd0, 0x2a, 0xd3, 0xe6, 0xae, 0x6a, 0xb5, 0x5e, 0x45, 0x05, 0x5a, 0xbd,
-	0x57, 0x51, 0xeb, 0xda, 0x76, 0xaf, 0xa2, 0xee, 0x6a, 0x7b, 0xbd, 0x8a, 0xaa, 0x69, 0x8d, 0xce,
-	0x3f, 0x15, 0xd0, 0x58, 0x28, 0x85, 0x69, 0x09, 0x2a, 0x2b, 0x4a, 0xf0, 0x31, 0xd8, 0x14, 0xf5,
-	0x26, 0x6f, 0x9b, 0x00, 0xe4, 0xb0, 0x04, 0x00, 0x07, 0xa0, 0x56, 0x1e, 0xf7, 0x8d, 0x3b, 0xad,
-	0xf2, 0x7e, 0x9a, 0x18, 0xfb, 0xe1, 0x92, 0xd3, 0x5c, 0x32, 0x75, 0xfe, 0xbc, 0x0e, 0xb6, 0x65,
-	0x23, 0xe8, 0xca, 0x7e, 0x14, 0x51, 0xfd, 0x3f, 0xba, 0xdd, 0x8f, 0x39, 0xd7, 0x51, 0xee, 0xe0,
-	0xb6, 0xf9, 0x37, 0x05, 0xec, 0xde, 0xbc, 0xcf, 0x37, 0x97, 0xde, 0xaf, 0x67, 0xf7, 0xd9, 0x94,
-	0xae, 0x9f, 0xe9, 0x08, 0x64, 0x06, 0xd7, 0x23, 0x71, 0x1f, 0x15, 0xee, 0xcc, 0x57, 0x31, 0x22,
-	0xcc, 0x63, 0x93, 0x55, 0xfb, 0xde, 0xf9, 0xc7, 0x26, 0x68, 0xf4, 0xe8, 0xf0, 0x32, 0x5b, 0xae,
-	0x47, 0x46, 0xa7, 0xe4, 0x8a, 0xf2, 0x9b, 0xd7, 0xf7, 0xae, 0x30, 0x1f, 0xd1, 0x44, 0x78, 0x3b,
-	0xf9, 0x45, 0x99, 0x63, 0x33, 0x17, 0x65, 0x8e, 0xc1, 0xa7, 0x60, 0x1b, 0x31, 0x7b, 0x4c, 0x23,
-	0x66, 0x53, 0xe2, 0x64, 0xf1, 0xaa, 0x96, 0x9e, 0x26, 0xc6, 0x01, 0x62, 0xbf, 0xa4, 0x11, 0x3b,
-	0x23, 0x8e, 0x6c, 0x09, 0x4a, 0x14, 0xfe, 0x14, 0xd4, 0x83, 0x10, 0x73, 0xdc, 0xe3, 0x2d, 0x75,
-	0x43, 0x98, 0x3e, 0x48, 0x13, 0xe3, 0x03, 0x09, 0x96, 0x6c, 0x65, 0x6d, 0xf8, 0x02, 0x68, 0x0e,
-	0x25, 0x4e, 0x1c, 0x86, 0x98, 0x38, 0x13, 0x3b, 0x42, 0x57, 0x58, 0xaf, 0x08, 0x06, 0x71, 0xdf,
-	0x48, 0xb2, 0x4b, 0x74, 0x25, 0xb3, 0xec, 0xcd, 0x89, 0x78, 0x63, 0x0e, 0x42, 0x8f, 0x86, 0x1e,
-	0x9b, 0xd8, 0x8e, 0x8f, 0xa2, 0xc8, 0x16, 0x73, 0x4a, 0xb5, 0x6c, 0xcc, 0x85, 0xf8, 0x19, 0x97,
-	0xf6, 0x67, 0x87, 0x96, 0xc6, 0x82, 0x10, 0x0e, 0x40, 0x3d, 0x8a, 0x87, 0x63, 0x8f, 0xd9, 0x22,
-	0x95, 0x5b, 0x2b, 0xe7, 0x11, 0x91, 0xae, 0xcc, 0x64, 0x6e, 0xb0, 0x03, 0x25, 0xca, 0xb7, 0xa7,
-	0xf0, 0xa5, 0xab, 0xe5, 0xf6, 0x14, 0x98, 0xbc, 0x3d, 0x05, 0x06, 0xff, 0x00, 0xf6, 0xb3, 0x52,
-	0xb6, 0x43, 0xfc, 0x45, 0xec, 0x85, 0x78, 0x8c, 0xcb, 0xa1, 0xe6, 0xe3, 0xc5, 0x7a, 0x3f, 0x13,
-	0x7f, 0x2f, 0x24, 0x5d, 0xab, 0x9d, 0x26, 0xc6, 0x23, 0xba, 0x80, 0x4b, 0xee, 0xe0, 0xa2, 0x14,
-	0x76, 0xc1, 0xd6, 0x5b, 0x1c, 0x46, 0x1e, 0x25, 0x7a, 0x4d, 0xc4, 0xfa, 0x41, 0x9a, 0x18, 0x8d,
-	0x1c, 0x92, 0x6c, 0x0b, 0xad, 0xac, 0xf1, 0x74, 0xfe, 0xaa, 0x00, 0xb8, 0x18, 0x03, 0xf4, 0xc1,
-	0x5e, 0x40, 0x5d, 0x19, 0x12, 0x05, 0x5a, 0x3f, 0xfa, 0xce, 0xb2, 0x0b, 0x6b, 0x46, 0x31, 0x2b,
-	0x87, 0x39, 0xeb, 0x32, 0x80, 0x17, 0x6b, 0x17, 0xf3, 0xd4, 0xd6, 0x2e, 0xd8, 0x96, 0xb3, 0xd5,
-	0xf9, 0x4f, 0x15, 0xec, 0xcd, 0xb1, 0xc2, 0x08, 0x6c, 0xf3, 0x3b, 0xfc, 0x12, 0xfb, 0xd8, 0xe1,
-	0x93, 0x6a, 0xd6, 0x41, 0x3e, 0x5d, 0x19, 0x8e, 0x18, 0x54, 0x0a, 0xab, 0xac, 0x8f, 0x34, 0xd3,
-	0xc4, 0xb8, 0x27, 0x93, 0x49, 0xe9, 0x99, 0x71, 0x02, 0xcf, 0x81, 0x8a, 0xae, 0xae, 0x3c, 0xc2,
-	0x2b, 0x20, 0x6b, 0x0c, 0x8f, 0x96, 0xcd, 0xa5, 0xc7, 0xb9, 0x4e, 0x56, 0x1f, 0x85, 0x85, 0x5c,
-	0x1f, 0x05, 0x06, 0x7f, 0x0b, 0xea, 0x8c, 0xfa, 0xfc, 0xdd, 0xe4, 0x51, 0x52, 0xbc, 0x69, 0x5a,
-	0x4b, 0x87, 0xdd, 0xa9, 0x5a, 0x76, 0x44, 0x25, 0x33, 0xf9, 0x88, 0x4a, 0x30, 0xa4, 0xa0, 0x8e,
-	0x08, 0xa1, 0x2c, 0x27, 0xdf, 0xba, 0x69, 0x76, 0x9b, 0x4f, 0xd1, 0x71, 0x69, 0x94, 0x65, 0x48,
-	0x38, 0x94, 0xa8, 0x64, 0x87, 0x12, 0x0c, 0x7b, 0x40, 0x2b, 0x5a, 0x04, 0x25, 0xe7, 0xd4, 0xf7,
-	0x9c, 0x89, 0x78, 0x5a, 0xd5, 0xac, 0x56, 0x9a, 0x18, 0xcd, 0x79, 0x99, 0x44, 0xb3, 0x60, 0x07,
-	0xff, 0xa8, 0x80, 0x83, 0xa2, 0xb1, 0xce, 0x14, 0x5e, 0x55, 0x24, 0xfe, 0x70, 0x59, 0x8e, 0x2e,
-	0x96, 0xe8, 0x5b, 0x9d, 0x34, 0x31, 0x5a, 0xcb, 0x98, 0x24, 0xf7, 0x4b, 0x3d, 0x35, 0x47, 0xa0,
-	0xb1, 0x50, 0x2d, 0xdf, 0xca, 0x98, 0x74, 0x05, 0xb4, 0xf9, 0x9c, 0x7f, 0x1b, 0x7e, 0xf2, 0x37,
-	0xe3, 0xbf, 0xd7, 0x81, 0x56, 0x3c, 0xcc, 0x2f, 0x31, 0xe3, 0x33, 0x6d, 0x04, 0x9f, 0x00, 0x50,
-	0xbc, 0xe6, 0x4e, 0x8b, 0x77, 0xa4, 0x68, 0x8b, 0x25, 0x2a, 0xb7, 0xc5, 0x12, 0xe5, 0x6d, 0xd1,
-	0xa1, 0xa1, 0x4b, 0x09, 0x76, 0xf3, 0xdb, 0x47, 0x94, 0x7d, 0x81, 0xc9, 0x65, 0x5f, 0x60, 0xf0,
-	0x67, 0x60, 0x3b, 0xfb, 0x7d, 0x81, 0x51, 0x44, 0x89, 0xb8, 0x7a, 0x6a, 0xd9, 0x41, 0x94, 0x71,
-	0xf9, 0x20, 0xca, 0x38, 0xfc, 0x09, 0xa8, 0x45, 0x98, 0x59, 0x93, 0x41, 0x84, 0x43, 0x71, 0xeb,
-	0xd4, 0xb2, 0x69, 0x60, 0x0a, 0xca, 0xd3, 0xc0, 0x14, 0x84, 0xaf, 0x84, 0xd9, 0x31, 0xbb, 0xe3,
-	0x9b, 0xbf, 0xa0, 0x3c, 0x9e, 0xbf, 0x15, 0x4a, 0x96, 0xef, 0x9f, 0x81, 0xba, 0x34, 0x84, 0xc2,
-	0x3a, 0xd8, 0x1a, 0xf4, 0x7f, 0xd1, 0x3f, 0xfb, 0x55, 0x5f, 0x5b, 0xe3, 0x1f, 0xe7, 0x27, 0xfd,
-	0xe7, 0xa7, 0xfd, 0xcf, 0x34, 0x85, 0x7f, 0x5c, 0x0c, 0xfa, 0x7d, 0xfe, 0xb1, 0x0e, 0x77, 0x40,
-	0xed, 0x72, 0xf0, 0xec, 0xd9, 0xc9, 0xc9, 0xf3, 0x93, 0xe7, 0xda, 0x06, 0x04, 0xa0, 0xfa, 0xf3,
-	0xe3, 0xd3, 0x97, 0x27, 0xcf, 0xb5, 0x8a, 0xf5, 0xbb, 0x7f, 0xbd, 0x6b, 0x29, 0x5f, 0xbf, 0x6b,
-	0x29, 0xff, 0x7b, 0xd7, 0x52, 0xfe, 0xf2, 0xbe, 0xb5, 0xf6, 0xf5, 0xfb, 0xd6, 0xda, 0x7f, 0xdf,
-	0xb7, 0xd6, 0x7e, 0xf3, 0x6c, 0xe4, 0xb1, 0x37, 0xf1, 0xd0, 0x74, 0xe8, 0xb8, 0x8b, 0xc2, 0x31,
-	0x72, 0x51, 0x10, 0x52, 0x7e, 0x82, 0xf3, 0xaf, 0xee, 0x1d, 0xfe, 0x73, 0x34, 0xac, 0x8a, 0x75,
-	0x7e, 0xfa, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xce, 0x72, 0x4f, 0x67, 0x12, 0x00, 0x00,
+	// 2302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x37, 0x65, 0x59, 0x96, 0x46, 0x7e, 0xa1, 0xc6, 0x59, 0x47, 0x71, 0x12, 0x51, 0xab, 0x64,
+	0xff, 0x7f, 0x27, 0x4d, 0x25, 0xac, 0xb7, 0x2d, 0xd2, 0xb4, 0x28, 0x20, 0xc9, 0xca, 0xc6, 0x4a,
+	0x2a, 0x3b, 0xb2, 0x85, 0x85, 0x1b, 0x14, 0x2c, 0x45, 0x8e, 0x14, 0xae, 0x29, 0x8e, 0x96, 0x1c,
+	0xa6, 0xd1, 0xad, 0xd7, 0xa2, 0x2d, 0xd0, 0xed, 0x0b, 0xd0, 0xcf, 0x52, 0xa0, 0xf7, 0xa2, 0xa7,
+	0x3d, 0xb6, 0x3d, 0x10, 0x6d, 0x02, 0xf4, 0xc0, 0x4f, 0x51, 0xcc, 0x0c, 0x49, 0x8d, 0x28, 0x29,
+	0x36, 0xba, 0x4d, 0x4f, 0xd6, 0xfc, 0x9e, 0xb7, 0x99, 0x67, 0x7e, 0xf3, 0xcc, 0x33, 0x34, 0x78,
+	0x64, 0xda, 0x04, 0x39, 0xb6, 0x66, 0xd5, 0x5c, 0xfd, 0x25, 0x32, 0x3c, 0x0b, 0x39, 0xd3, 0x5f,
+	0xb8, 0xff, 0x39, 0xd2, 0x89, 0x3b, 0x07, 0x54, 0xc7, 0x0e, 0x26, 0x18, 0xca, 0x49, 0x7c, 0x4f,
+	0x19, 0x62, 0x3c, 0xb4, 0x50, 0x8d, 0xc9, 0xfb, 0xde, 0xa0, 0x46, 0xcc, 0x11, 0x72, 0x89, 0x36,
+	0x1a, 0x73, 0x93, 0xbd, 0xca, 0xc5, 0x43, 0xb7, 0x6a, 0xe2, 0x9a, 0x36, 0x36, 0x6b, 0x3a, 0x76,
+	0x50, 0xed, 0xd5, 0xc7, 0xb5, 0x21, 0xb2, 0x91, 0xa3, 0x11, 0x64, 0x84, 0x3a, 0xdf, 0x9a, 0xea,
+	0x8c, 0x34, 0xfd, 0xa5, 0x69, 0x23, 0x67, 0x52, 0x1b, 0x5f, 0x0c, 0x99, 0x91, 0x83, 0x5c, 0xec,
+	0x39, 0x3a, 0x4a, 0x5a, 0x55, 0xde, 0xa4, 0x40, 0xb6, 0xf5, 0x1a, 0xe9, 0x1e, 0xc1, 0x0e, 0x2c,
+	0x83, 0x94, 0x69, 0x14, 0xa5, 0xb2, 0xb4, 0x9f, 0x6b, 0xc8, 0x81, 0xaf, 0x6c, 0x98, 0xc6, 0x03,
+	0x3c, 0x32, 0x09, 0x1a, 0x8d, 0xc9, 0xa4, 0x9b, 0x32, 0x0d, 0xf8, 0x7f, 0x20, 0x3d, 0xc6, 0xd8,
+	0x2a, 0xa6, 0x98, 0x0e, 0x0c, 0x7c, 0x65, 0x8b, 0x8e, 0x05, 0x2d, 0x26, 0x87, 0x75, 0xb0, 0x66,
+	0x63, 0x03, 0xb9, 0xc5, 0xd5, 0xf2, 0xea, 0x7e, 0xfe, 0x60, 0xb7, 0x3a, 0x97, 0x8b, 0x0e, 0x36,
+	0x50, 0x63, 0x27, 0xf0, 0x95, 0x6d, 0xa6, 0x28, 0x78, 0xe0, 0x96, 0xf0, 0x27, 0x60, 0xcb, 0xd2,
+	0x5c, 0xd2, 0x1b, 0x1b, 0x1a, 0x41, 0x67, 0xe6, 0x08, 0x15, 0xd7, 0xca, 0xd2, 0x7e, 0xfe, 0x60,
+	0xaf, 0xca, 0xb3, 0x55, 0x8d, 0xb2, 0x55, 0x3d, 0x8b, 0xb2, 0xd5, 0xb8, 0x15, 0xf8, 0x4a, 0x71,
+	0xd6, 0x4a, 0x70, 0x9c, 0xf0, 0x07, 0x8f, 0xc1, 0x8e, 0x67, 0x6b, 0xae, 0x6b, 0x0e, 0x6d, 0x64,
+	0xa8, 0x9f, 0xe3, 0xbe, 0xea, 0x78, 0xb6, 0x5b, 0xcc, 0x95, 0x57, 0xf7, 0x73, 0x0d, 0x25, 0xf0,
+	0x95, 0x9b, 0x53, 0x71, 0x1b, 0xf7, 0xbb, 0x9e, 0x2d, 0x4e, 0xb3, 0x30, 0x27, 0x6c, 0xa7, 0xb3,
+	0x69, 0x79, 0xad, 0x9d, 0xce, 0x66, 0xe4, 0xf5, 0x76, 0x3a, 0xbb, 0x2e, 0x67, 0xdb, 0xe9, 0x6c,
+	0x56, 0xce, 0x55, 0xfe, 0x95, 0x07, 0x69, 0xba, 0xde, 0xab, 0x25, 0xd8, 0xd6, 0x46, 0xa8, 0xb8,
+	0x31, 0x4d, 0x30, 0x1d, 0x8b, 0x09, 0xa6, 0x63, 0x78, 0x00, 0xb2, 0x28, 0xdc, 0xb6, 0xe2, 0x0e,
+	0xd3, 0xdd, 0x0d, 0x7c, 0x05, 0x46, 0x98, 0xa0, 0x1f, 0xeb, 0xc1, 0x63, 0x90, 0xa3, 0x19, 0x50,
+	0x5d, 0x84, 0x6c, 0xb6, 0x83, 0xef, 0x4e, 0x26, 0x73, 0x48, 0x0d, 0x4e, 0x11, 0xb2, 0x45, 0x87,
+	0x11, 0x06, 0x3f, 0x05, 0x19, 0xa2, 0x99, 0x36, 0x71, 0x8b, 0x6b, 0x6c, 0x9b, 0x6f, 0x54, 0x39,
+	0x07, 0xab, 0xda, 0xd8, 0xac, 0x52, 0x9e, 0x56, 0x5f, 0x7d, 0x5c, 0x3d, 0xa3, 0x1a, 0x8d, 0x6b,
+	0x81, 0xaf, 0xc8, 0x5c, 0x59, 0x70, 0x15, 0x9a, 0xc3, 0x13, 0x90, 0xb1, 0xb4, 0x3e, 0xb2, 0xdc,
+	0x62, 0x86, 0x39, 0xaa, 0x2c, 0xe6, 0x4b, 0xf5, 0x19, 0x53, 0x6a, 0xd9, 0xc4, 0x99, 0x70, 0x8f,
+	0xdc, 0x4a, 0xf4, 0xc8, 0x11, 0x88, 0xc0, 0x36, 0xc1, 0x44, 0xb3, 0xd4, 0x88, 0xf9, 0x6e, 0x71,
+	0x9d, 0xad, 0xb8, 0x34, 0xef, 0xba, 0x1b, 0xaa, 0x3c, 0x33, 0x5d, 0xc2, 0x29, 0xc4, 0x4c, 0x23,
+	0x58, 0x74, 0xbf, 0x35, 0x2b, 0x81, 0xaf, 0xc1, 0x8e, 0x4b, 0x34, 0x82, 0xd4, 0xfe, 0x24, 0x22,
+	0x90, 0x6a, 0x1a, 0x8c, 0x42, 0xf9, 0x83, 0x6f, 0x2c, 0x59, 0xc5, 0x29, 0xb5, 0x68, 0x4c, 0x38,
+	0x6b, 0x8e, 0x0c, 0xbe, 0x9c, 0xdb, 0x81, 0xaf, 0xdc, 0x70, 0x67, 0x25, 0x42, 0xe0, 0xed, 0x84,
+	0x08, 0x7e, 0x29, 0x81, 0xeb, 0x9e, 0xad, 0x59, 0x16, 0xd6, 0x35, 0xa2, 0xf5, 0x2d, 0x24, 0xac,
+	0x74, 0x93, 0x85, 0x3f, 0x58, 0x12, 0xbe, 0x27, 0x5a, 0xc5, 0x4b, 0xe1, 0xb3, 0xb8, 0x1b, 0xf8,
+	0x4a, 0xd9, 0x5b, 0xa8, 0x20, 0x4c, 0x66, 0x77, 0xb1, 0x06, 0xac, 0x83, 0x4d, 0xcf, 0x0e, 0x83,
+	0x52, 0x49, 0x71, 0xbb, 0x2c, 0xed, 0x67, 0x1b, 0x37, 0x03, 0x5f, 0xb9, 0x3e, 0x23, 0x10, 0x7c,
+	0xcd, 0x5a, 0xd0, 0x33, 0xe9, 0xa0, 0x31, 0x76, 0x88, 0x69, 0x0f, 0x55, 0x5a, 0x08, 0x54, 0x32,
+	0x19, 0xa3, 0x62, 0x81, 0x51, 0x9c, 0x9d, 0xc9, 0x58, 0x4c, 0x17, 0x73, 0x36, 0x19, 0x8b, 0xce,
+	0x0a, 0x73, 0xc2, 0xb8, 0x62, 0xc1, 0x4b, 0x2a, 0xd6, 0xef, 0x25, 0x50, 0x8e, 0x32, 0xa8, 0x7a,
+	0xae, 0x36, 0x64, 0x7b, 0xfa, 0x85, 0x87, 0x3c, 0xa4, 0x6a, 0xb6, 0xa1, 0x32, 0x27, 0xd7, 0x58,
+	0x62, 0xef, 0xcc, 0x27, 0xf6, 0x04, 0x63, 0xeb, 0x39, 0xd5, 0x8d, 0x92, 0xd1, 0xb8, 0x17, 0xf8,
+	0xca, 0x47, 0x91, 0xc3, 0x1e, 0xf5, 0xd7, 0x98, 0x30, 0x8d, 0xba, 0x6d, 0x9c, 0xcc, 0x4e, 0xe0,
+	0xe6, 0x3b, 0xd4, 0xf6, 0x34, 0x90, 0x17, 0x58, 0x0f, 0xef, 0x80, 0xd5, 0x0b, 0x34, 0x09, 0x4b,
+	0x48, 0x21, 0xf0, 0x95, 0xcd, 0x0b, 0x34, 0x11, 0x7c, 0x51, 0x29, 0xbc, 0x07, 0xd6, 0x5e, 0x69,
+	0x96, 0x87, 0xc2, 0x32, 0xcd, 0xaa, 0x2c, 0x03, 0xc4, 0x2a, 0xcb, 0x80, 0x47, 0xa9, 0x87, 0xd2,
+	0xde, 0xcf, 0x25, 0x70, 0x6d, 0x11, 0x27, 0xaf, 0x16, 0xec, 0x89, 0x18, 0x6c, 0xeb, 0xe0, 0xf6,
+	0x7c, 0x72, 0xb8, 0x53, 0x1e, 0xe1, 0xb2, 0xb9, 0x7c, 0x29, 0x81, 0x9b, 0xef, 0x20, 0xa8, 0x38,
+	0xa5, 0xb5, 0xa5, 0x53, 0x3a, 0x12, 0xa7, 0x74, 0xf9, 0x91, 0xbf, 0x64, 0x4e, 0xed, 0x74, 0x76,
+	0x55, 0x4e, 0xc7, 0xc5, 0x3d, 0x2b, 0xe7, 0xda, 0xe9, 0x2c, 0x90, 0xf3, 0xed, 0x74, 0x36, 0x2f,
+	0x6f, 0xb4, 0xd3, 0xd9, 0x2d, 0x79, 0xbb, 0x9d, 0xce, 0xca, 0x72, 0xa1, 0xf2, 0x27, 0x09, 0x14,
+	0xe6, 0xa8, 0x10, 0x53, 0x50, 0xba, 0x84, 0x82, 0xf7, 0xc0, 0x1a, 0xe3, 0x9b, 0xb8, 0x6d, 0x0c,
+	0x10, 0xa7, 0xc5, 0x00, 0xd8, 0x03, 0xb9, 0xe9, 0x71, 0x5f, 0xbd, 0xd2, 0x2a, 0xaf, 0x07, 0xbe,
+	0xb2, 0xe3, 0x2c, 0x38, 0xcd, 0x53, 0x4f, 0x95, 0x5f, 0xa4, 0xc0, 0x86, 0x68, 0x04, 0x0d, 0x31,
+	0x8e, 0xc4, 0xd8, 0xff, 0xcd, 0x77, 0xc7, 0xa9, 0x26, 0x2a, 0xca, 0x15, 0xc2, 0xee, 0xfd, 0x4e,
+	0x02, 0x5b, 0xcb, 0xf7, 0x79, 0x39, 0xf5, 0xce, 0x67, 0xf7, 0xb9, 0x2a, 0x5c, 0x3f, 0x71, 0x0b,
+	0x54, 0x1d, 0x5f, 0x0c, 0xd9, 0x7d, 0x14, 0x85, 0xab, 0x3e, 0xf7, 0x34, 0x9b, 0x98, 0x64, 0x72,
+	0xd9, 0xbe, 0x57, 0xfe, 0x99, 0x05, 0x85, 0x36, 0xee, 0x9f, 0xf2, 0xe5, 0x9a, 0xf6, 0xf0, 0xc8,
+	0x1e, 0x60, 0x7a, 0xf3, 0x5a, 0xe6, 0x00, 0xd1, 0x16, 0x8d, 0x4d, 0x6f, 0x33, 0xbc, 0x28, 0x43,
+	0x6c, 0xe6, 0xa2, 0x0c, 0x31, 0xf8, 0x08, 0x6c, 0x68, 0x44, 0x1d, 0x61, 0x97, 0xa8, 0xd8, 0xd6,
+	0xf9, 0x7c, 0xb3, 0x8d, 0x62, 0xe0, 0x2b, 0xd7, 0x34, 0xf2, 0x43, 0xec, 0x92, 0x63, 0x5b, 0x17,
+	0x2d, 0xc1, 0x14, 0x85, 0xdf, 0x03, 0xf9, 0xb1, 0x83, 0x28, 0x6e, 0xd2, 0x92, 0xba, 0xca, 0x4c,
+	0x6f, 0x04, 0xbe, 0xf2, 0x81, 0x00, 0x0b, 0xb6, 0xa2, 0x36, 0x7c, 0x02, 0x64, 0x1d, 0xdb, 0xba,
+	0xe7, 0x38, 0xc8, 0xd6, 0x27, 0xaa, 0xab, 0x0d, 0x50, 0x31, 0xcd, 0x3c, 0xb0, 0xfb, 0x46, 0x90,
+	0x9d, 0x6a, 0x03, 0xd1, 0xcb, 0x76, 0x42, 0x44, 0x0b, 0xf3, 0xd8, 0x31, 0xb1, 0x63, 0x92, 0x89,
+	0xaa, 0x5b, 0x9a, 0xeb, 0xaa, 0xac, 0x4f, 0xc9, 0x4c, 0x0b, 0x73, 0x24, 0x6e, 0x52, 0x69, 0x67,
+	0xb6, 0x69, 0x29, 0xcc, 0x09, 0x61, 0x0f, 0xe4, 0x5d, 0xaf, 0x3f, 0x32, 0x89, 0xca, 0x52, 0xb9,
+	0x7e, 0x69, 0x3f, 0xc2, 0xd2, 0xc5, 0x4d, 0x12, 0x8d, 0x1d, 0x98, 0xa2, 0x74, 0x7b, 0xa2, 0x58,
+	0xc5, 0xec, 0x74, 0x7b, 0x22, 0x4c, 0xdc, 0x9e, 0x08, 0x83, 0x3f, 0x05, 0x3b, 0x9c, 0xca, 0xaa,
+	0x83, 0xbe, 0xf0, 0x4c, 0x07, 0x8d, 0xd0, 0xb4, 0xa9, 0xb9, 0x3b, 0xcf, 0xf7, 0x63, 0xf6, 0xb7,
+	0x2b, 0xe8, 0x36, 0xca, 0x81, 0xaf, 0xdc, 0xc2, 0x73, 0xb8, 0x10, 0x0e, 0xce, 0x4b, 0x61, 0x0d,
+	0xac, 0xbf, 0x42, 0x8e, 0x6b, 0x62, 0xbb, 0x98, 0x63, 0x73, 0xfd, 0x20, 0xf0, 0x95, 0x42, 0x08,
+	0x09, 0xb6, 0x91, 0x16, 0xfc, 0x01, 0xd8, 0x30, 0xd0, 0x18, 0xd9, 0x06, 0xb2, 0x75, 0x13, 0xb9,
+	0xc5, 0x3c, 0xeb, 0x55, 0xf7, 0x02, 0x5f, 0xd9, 0x15, 0x71, 0xc1, 0x74, 0x46, 0x1f, 0xfe, 0x52,
+	0x02, 0x37, 0x62, 0x60, 0xa2, 0x0e, 0x34, 0xd3, 0xf2, 0x1c, 0xa4, 0x8e, 0xb1, 0x65, 0xea, 0x13,
+	0xd6, 0x74, 0x6e, 0x1d, 0xdc, 0x9b, 0x5f, 0xf0, 0x61, 0x6c, 0xf2, 0x98, 0x5b, 0x9c, 0x30, 0x83,
+	0xc6, 0x47, 0x81, 0xaf, 0x7c, 0x68, 0x2c, 0x16, 0x0a, 0x73, 0xb8, 0xbe, 0x44, 0x05, 0x76, 0x01,
+	0xb0, 0x31, 0x51, 0xfb, 0x68, 0x80, 0x1d, 0x54, 0xdc, 0xbc, 0x94, 0x02, 0xac, 0x98, 0xd8, 0x98,
+	0x34, 0x98, 0x81, 0x58, 0x4c, 0x62, 0x10, 0x1e, 0x81, 0x02, 0xbf, 0xb5, 0x09, 0xb1, 0x54, 0x17,
+	0xe9, 0xd8, 0x36, 0xdc, 0xe2, 0x16, 0xcb, 0x2e, 0xe3, 0x3c, 0x13, 0x9e, 0x11, 0xeb, 0x94, 0x8b,
+	0x44, 0xce, 0x27, 0x44, 0xf0, 0x05, 0xd8, 0x70, 0x10, 0x71, 0x26, 0x51, 0x7e, 0xb6, 0xd9, 0x04,
+	0x6f, 0x2f, 0x2a, 0x80, 0xc4, 0x99, 0x84, 0x39, 0x61, 0x47, 0xd3, 0x99, 0x02, 0xe2, 0xd1, 0x14,
+	0x60, 0x7e, 0x87, 0x54, 0xfe, 0x2e, 0x81, 0xbc, 0x60, 0x0d, 0xbf, 0x0f, 0x36, 0x46, 0xda, 0x6b,
+	0x55, 0x23, 0xcc, 0xc4, 0x0d, 0x2b, 0x0c, 0xf3, 0x39, 0xd2, 0x5e, 0xd7, 0x43, 0x58, 0xf4, 0x29,
+	0xc0, 0xb0, 0x05, 0xb6, 0xfb, 0x9a, 0x7e, 0x81, 0x07, 0x83, 0x78, 0xe5, 0x29, 0xe6, 0x80, 0x75,
+	0xb5, 0xa1, 0x68, 0x7e, 0xe1, 0x5b, 0xb3, 0x12, 0xf8, 0x18, 0xac, 0x39, 0x9e, 0x15, 0xbf, 0xde,
+	0x6e, 0x2e, 0x59, 0x70, 0xd7, 0xb3, 0xc2, 0x0b, 0x9d, 0x69, 0x8b, 0x45, 0x94, 0x01, 0x95, 0x3f,
+	0xa4, 0x40, 0x2e, 0xd6, 0x84, 0x4f, 0x41, 0x46, 0xd3, 0x09, 0xe5, 0xba, 0xb4, 0xac, 0x53, 0x60,
+	0xca, 0x75, 0xa6, 0xc4, 0xfb, 0x7b, 0x6e, 0x20, 0xf6, 0xf7, 0x1c, 0x81, 0xdf, 0x01, 0x00, 0xbd,
+	0x36, 0x89, 0xaa, 0xb3, 0x57, 0x66, 0xaa, 0xbc, 0xba, 0xbf, 0xc6, 0xd9, 0x41, 0xd1, 0x66, 0xe2,
+	0x45, 0x99, 0x8b, 0x41, 0xf8, 0x14, 0x14, 0x74, 0x6c, 0xd3, 0x67, 0x07, 0x72, 0x54, 0x07, 0x69,
+	0x2e, 0xb6, 0xf9, 0x32, 0x73, 0x8d, 0x52, 0xe0, 0x2b, 0x7b, 0xb1, 0xb0, 0xcb, 0x65, 0x82, 0x17,
+	0x39, 0x29, 0x83, 0xdf, 0x05, 0x79, 0xe4, 0x38, 0xd8, 0x51, 0x2f, 0x4c, 0x9a, 0xea, 0x34, 0x73,
+	0xc3, 0xca, 0x14, 0x83, 0x9f, 0x9a, 0xb3, 0x69, 0x06, 0x53, 0xb4, 0xf2, 0x1b, 0x09, 0xc0, 0xf9,
+	0x32, 0x02, 0x2d, 0xb0, 0x3d, 0xc6, 0x86, 0x08, 0xb1, 0x64, 0xe5, 0x0f, 0x3e, 0x5c, 0xd4, 0x73,
+	0xce, 0x28, 0x72, 0x76, 0x27, 0xac, 0xa7, 0xd1, 0x9f, 0xac, 0x74, 0x93, 0xae, 0x1b, 0x5b, 0x94,
+	0xdf, 0xd3, 0x71, 0xe5, 0x6f, 0x39, 0xb0, 0x9d, 0xf0, 0x0a, 0x5d, 0xb0, 0x41, 0xdb, 0xf0, 0x53,
+	0x64, 0x21, 0x9d, 0x3e, 0x36, 0x79, 0x13, 0xf0, 0xc9, 0xa5, 0xd3, 0x61, 0x6f, 0x8d, 0xc8, 0x8a,
+	0xb7, 0x02, 0xac, 0x4c, 0x89, 0xce, 0xc4, 0x32, 0x25, 0xe2, 0xf0, 0x04, 0x64, 0xb5, 0xc1, 0xc0,
+	0xb4, 0x69, 0x11, 0xe7, 0x77, 0xfb, 0xad, 0x45, 0x4f, 0xcb, 0x7a, 0xa8, 0xc3, 0x4b, 0x7c, 0x64,
+	0x21, 0x96, 0xf8, 0x08, 0x83, 0x2f, 0x40, 0x9e, 0x60, 0x0b, 0x39, 0x1a, 0x65, 0x4f, 0x44, 0xec,
+	0xd2, 0xc2, 0xf7, 0x6a, 0xac, 0xc6, 0x8f, 0x9d, 0x60, 0x26, 0x1e, 0x3b, 0x01, 0x86, 0x18, 0xe4,
+	0x35, 0xdb, 0xc6, 0x24, 0x74, 0xbe, 0xbe, 0xec, 0xf9, 0x95, 0x4c, 0x51, 0x7d, 0x6a, 0xc4, 0x33,
+	0xc4, 0x02, 0x0a, 0xae, 0xc4, 0x80, 0x02, 0x0c, 0xdb, 0x40, 0x8e, 0x6e, 0x79, 0x6c, 0xf3, 0xca,
+	0xc1, 0xbe, 0x8e, 0x84, 0x24, 0x4e, 0xca, 0x44, 0x12, 0x27, 0x65, 0xf0, 0x67, 0x12, 0xb8, 0x16,
+	0xf5, 0x46, 0x33, 0xc4, 0xcb, 0xb0, 0xc4, 0xef, 0x2f, 0xca, 0x51, 0x77, 0x81, 0x7e, 0xa3, 0x12,
+	0xf8, 0x4a, 0x69, 0x91, 0x27, 0x21, 0xfc, 0xc2, 0x48, 0xf0, 0x45, 0xfc, 0xfc, 0xcf, 0x2d, 0x6b,
+	0x31, 0x93, 0xa9, 0xbb, 0xfa, 0x97, 0x80, 0x6f, 0x83, 0x1c, 0xed, 0x54, 0xdc, 0xb1, 0xa6, 0xa3,
+	0x22, 0x60, 0x49, 0xe2, 0xd7, 0x48, 0x04, 0xce, 0x5c, 0x23, 0x11, 0x08, 0x7f, 0x2b, 0x81, 0x1b,
+	0x04, 0x8f, 0xb1, 0x85, 0x87, 0x93, 0xd3, 0xb1, 0x83, 0x34, 0xa3, 0x89, 0x6d, 0x97, 0x38, 0xfc,
+	0x7b, 0x47, 0x9e, 0xcd, 0xf3, 0xc1, 0x62, 0xfe, 0x2c, 0x36, 0x6a, 0xfc, 0x7f, 0xe0, 0x2b, 0x77,
+	0x96, 0xba, 0x14, 0x66, 0xb1, 0x3c, 0xee, 0xde, 0x10, 0x14, 0xe6, 0xce, 0xd5, 0x7b, 0x79, 0x13,
+	0x0e, 0x80, 0x9c, 0x64, 0xe7, 0x7b, 0x89, 0xf3, 0xfe, 0x9f, 0xb7, 0xe1, 0x37, 0xb8, 0xbf, 0xa4,
+	0x80, 0x1c, 0x7d, 0xe8, 0x3c, 0x45, 0x84, 0x98, 0xf6, 0xd0, 0x85, 0x0f, 0xe9, 0x2d, 0xc2, 0xb1,
+	0xa3, 0xe8, 0xbb, 0x1c, 0xaf, 0xdf, 0x31, 0x3a, 0x53, 0xbf, 0x63, 0x94, 0xb6, 0x99, 0x3a, 0x76,
+	0x0c, 0x6c, 0x23, 0x23, 0xec, 0xe6, 0x59, 0x0d, 0x8a, 0x30, 0xb1, 0x06, 0x45, 0x18, 0x6d, 0xde,
+	0xf8, 0x6f, 0x7e, 0x7f, 0xb0, 0x56, 0x3e, 0x6c, 0xde, 0x44, 0x5c, 0xac, 0x8a, 0x22, 0x4e, 0x99,
+	0xec, 0x22, 0xd2, 0x98, 0xf4, 0x5c, 0xe4, 0xb0, 0x2e, 0x3e, 0x64, 0x72, 0x0c, 0x8a, 0x4c, 0x8e,
+	0x41, 0xf8, 0x9c, 0x99, 0xd5, 0xc9, 0x15, 0xbf, 0xa1, 0x46, 0x2e, 0xeb, 0xc9, 0x2e, 0x7b, 0xea,
+	0xa5, 0xf2, 0xc7, 0x34, 0xc8, 0x51, 0x1e, 0x1e, 0x52, 0x5a, 0x7e, 0xbd, 0x2c, 0xd2, 0xba, 0x4f,
+	0xdf, 0x03, 0xe1, 0x66, 0xb2, 0x2c, 0x46, 0x98, 0x98, 0xc5, 0x08, 0x83, 0xa3, 0xc4, 0x85, 0xb4,
+	0xba, 0xac, 0x64, 0xc4, 0x13, 0xfc, 0x5a, 0x57, 0x51, 0x0d, 0xac, 0x87, 0x25, 0x33, 0x7c, 0x38,
+	0xb1, 0x16, 0x3d, 0x84, 0xc4, 0x16, 0x3d, 0x84, 0xe0, 0x03, 0x90, 0xe1, 0x7d, 0x45, 0x58, 0x91,
+	0x59, 0x75, 0x72, 0x92, 0x3b, 0x1b, 0xea, 0xcc, 0xee, 0x69, 0xe6, 0x3f, 0xdb, 0xd3, 0xf5, 0xff,
+	0xc6, 0x9e, 0xfe, 0xcf, 0x4a, 0xcb, 0xfd, 0x63, 0x90, 0x17, 0xbe, 0x08, 0xc1, 0x3c, 0x58, 0xef,
+	0x75, 0x9e, 0x76, 0x8e, 0x3f, 0xeb, 0xc8, 0x2b, 0x74, 0x70, 0xd2, 0xea, 0x1c, 0x1e, 0x75, 0x3e,
+	0x95, 0x25, 0x3a, 0xe8, 0xf6, 0x3a, 0x1d, 0x3a, 0x48, 0xc1, 0x4d, 0x90, 0x3b, 0xed, 0x35, 0x9b,
+	0xad, 0xd6, 0x61, 0xeb, 0x50, 0x5e, 0x85, 0x00, 0x64, 0x1e, 0xd7, 0x8f, 0x9e, 0xb5, 0x0e, 0xe5,
+	0xf4, 0xfd, 0x5f, 0x49, 0xe0, 0xfa, 0x92, 0x17, 0x0a, 0xbc, 0x0b, 0xca, 0x87, 0x2d, 0xea, 0xb2,
+	0xd5, 0x69, 0x9e, 0xab, 0xd4, 0xa4, 0xd7, 0x6d, 0xa9, 0x27, 0xc7, 0xcf, 0x8e, 0x9a, 0xe7, 0x6a,
+	0xb3, 0xde, 0x69, 0xb6, 0x9e, 0xc9, 0x2b, 0xb0, 0x02, 0x4a, 0xcb, 0xb5, 0xe8, 0x50, 0x96, 0xe0,
+	0x3e, 0xb8, 0xbb, 0x5c, 0xa7, 0xdb, 0xeb, 0xa8, 0xf5, 0xce, 0xf9, 0x67, 0xf5, 0x73, 0x39, 0x75,
+	0xbf, 0x1e, 0xb6, 0xf4, 0xbc, 0x91, 0x85, 0xbb, 0x00, 0x76, 0x5b, 0x67, 0xdd, 0x73, 0xb5, 0xde,
+	0x3c, 0x3b, 0x3a, 0xee, 0xa8, 0x6c, 0x20, 0xaf, 0xc0, 0x3d, 0xb0, 0x3b, 0x83, 0x53, 0x97, 0xea,
+	0xe3, 0xfa, 0xe9, 0x99, 0x2c, 0x35, 0x7e, 0xfc, 0xe7, 0x37, 0x25, 0xe9, 0xab, 0x37, 0x25, 0xe9,
+	0x1f, 0x6f, 0x4a, 0xd2, 0xaf, 0xdf, 0x96, 0x56, 0xbe, 0x7a, 0x5b, 0x5a, 0xf9, 0xeb, 0xdb, 0xd2,
+	0xca, 0x8f, 0x9a, 0x43, 0x93, 0xbc, 0xf4, 0xfa, 0x55, 0x1d, 0x8f, 0x6a, 0x9a, 0x33, 0xd2, 0x0c,
+	0x6d, 0xec, 0x60, 0x4a, 0xf8, 0x70, 0x54, 0xbb, 0xc2, 0x7f, 0xa6, 0xfa, 0x19, 0xc6, 0x91, 0x4f,
+	0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x2d, 0x4f, 0x4f, 0xc7, 0x1a, 0x00, 0x00,
 }
 
 func (m *Executor) Marshal() (dAtA []byte, err error) {
@@ -1263,6 +1668,49 @@ func (m *JobSchedulingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.QueueTtlSeconds != 0 {
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.QueueTtlSeconds))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DependencyFailurePolicy != 0 {
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.DependencyFailurePolicy))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.Version != 0 {
 		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.Version))
 		i--
@@ -1344,7 +1792,7 @@ func (m *JobSchedulingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectRequirements) Marshal() (dAtA []byte, err error) {
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1354,22 +1802,134 @@ func (m *ObjectRequirements) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectRequirements) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Requirements != nil {
-		{
-			size := m.Requirements.Size()
-			i -= size
-			if _, err := m.Requirements.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BackoffSeconds != 0 {
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.BackoffSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorKinds) > 0 {
+		for iNdEx := len(m.ErrorKinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ErrorKinds[iNdEx])
+			copy(dAtA[i:], m.ErrorKinds[iNdEx])
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.ErrorKinds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContainerReasons) > 0 {
+		for iNdEx := len(m.ContainerReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContainerReasons[iNdEx])
+			copy(dAtA[i:], m.ContainerReasons[iNdEx])
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.ContainerReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExitCodes) > 0 {
+		dAtA11 := make([]byte, len(m.ExitCodes)*10)
+		var j10 int
+		for _, num1 := range m.ExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Requirements != nil {
+		{
+			size := m.Requirements.Size()
+			i -= size
+			if _, err := m.Requirements.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
 		}
 	}
@@ -1417,6 +1977,46 @@ func (m *PodRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TopologySpreadConstraints) > 0 {
+		for iNdEx := len(m.TopologySpreadConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopologySpreadConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Annotations) > 0 {
 		for k := range m.Annotations {
 			v := m.Annotations[k]
@@ -1569,6 +2169,98 @@ func (m *ExecutorSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeDrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetAtTime != nil {
+		{
+			size, err := m.SetAtTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SetByUser) > 0 {
+		i -= len(m.SetByUser)
+		copy(dAtA[i:], m.SetByUser)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.SetByUser)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSchedulerobjects(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSchedulerobjects(v)
 	base := offset
@@ -1775,6 +2467,78 @@ func (m *JobSchedulingInfo) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovSchedulerobjects(uint64(m.Version))
 	}
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
+	if m.DependencyFailurePolicy != 0 {
+		n += 1 + sovSchedulerobjects(uint64(m.DependencyFailurePolicy))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	if m.QueueTtlSeconds != 0 {
+		n += 1 + sovSchedulerobjects(uint64(m.QueueTtlSeconds))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovSchedulerobjects(uint64(m.MaxAttempts))
+	}
+	if m.BackoffSeconds != 0 {
+		n += 1 + sovSchedulerobjects(uint64(m.BackoffSeconds))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovSchedulerobjects(uint64(m.Action))
+	}
+	if len(m.ExitCodes) > 0 {
+		l = 0
+		for _, e := range m.ExitCodes {
+			l += sovSchedulerobjects(uint64(e))
+		}
+		n += 1 + sovSchedulerobjects(uint64(l)) + l
+	}
+	if len(m.ContainerReasons) > 0 {
+		for _, s := range m.ContainerReasons {
+			l = len(s)
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
+	if len(m.ErrorKinds) > 0 {
+		for _, s := range m.ErrorKinds {
+			l = len(s)
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1842,6 +2606,24 @@ func (m *PodRequirements) Size() (n int) {
 			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerobjects(uint64(len(k))) + 1 + len(v) + sovSchedulerobjects(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	if len(m.TopologySpreadConstraints) > 0 {
+		for _, e := range m.TopologySpreadConstraints {
+			l = e.Size()
+			n += 1 + l + sovSchedulerobjects(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1873,28 +2655,68 @@ func (m *ExecutorSettings) Size() (n int) {
 	return n
 }
 
-func sovSchedulerobjects(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSchedulerobjects(x uint64) (n int) {
-	return sovSchedulerobjects(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Executor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerobjects
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
+func (m *NodeDrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerobjects(uint64(len(k))) + 1 + len(v) + sovSchedulerobjects(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
+		}
+	}
+	if m.Preempt {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	l = len(m.SetByUser)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	if m.SetAtTime != nil {
+		l = m.SetAtTime.Size()
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	return n
+}
+
+func sovSchedulerobjects(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedulerobjects(x uint64) (n int) {
+	return sovSchedulerobjects(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Executor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3397,6 +4219,148 @@ func (m *JobSchedulingInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyFailurePolicy", wireType)
+			}
+			m.DependencyFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependencyFailurePolicy |= DependencyFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			}
+			m.QueueTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueTtlSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
@@ -3418,7 +4382,7 @@ func (m *JobSchedulingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRequirements) Unmarshal(dAtA []byte) error {
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3441,15 +4405,53 @@ func (m *ObjectRequirements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRequirements: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			m.BackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3476,11 +4478,10 @@ func (m *ObjectRequirements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PodRequirements{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, &RetryRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Requirements = &ObjectRequirements_PodRequirements{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3503,7 +4504,7 @@ func (m *ObjectRequirements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodRequirements) Unmarshal(dAtA []byte) error {
+func (m *RetryRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,17 +4527,17 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodRequirements: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerobjects
@@ -3546,29 +4547,14 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Action |= RetryAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerobjects
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSchedulerobjects
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+		case 2:
+			if wireType == 0 {
+				var v int32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSchedulerobjects
@@ -3578,18 +4564,719 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSchedulerobjects
-						}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSchedulerobjects
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSchedulerobjects
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerReasons = append(m.ContainerReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorKinds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorKinds = append(m.ErrorKinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PodRequirements{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Requirements = &ObjectRequirements_PodRequirements{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, &v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptionPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreemptionPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRequirements == nil {
+				m.ResourceRequirements = &v1.ResourceRequirements{}
+			}
+			if err := m.ResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
 						if iNdEx >= l {
 							return io.ErrUnexpectedEOF
 						}
@@ -3657,13 +5344,181 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopologySpreadConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopologySpreadConstraints = append(m.TopologySpreadConstraints, &v1.TopologySpreadConstraint{})
+			if err := m.TopologySpreadConstraints[len(m.TopologySpreadConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerobjects
@@ -3673,31 +5528,59 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerobjects
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerobjects
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			m.CordonReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetByUser", wireType)
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SetByUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAtTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3724,14 +5607,66 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, &v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SetAtTime == nil {
+				m.SetAtTime = &types.Timestamp{}
+			}
+			if err := m.SetAtTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeDrain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerobjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDrain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDrain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3759,13 +5694,13 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptionPolicy = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerobjects
@@ -3775,31 +5710,27 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerobjects
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerobjects
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequirements == nil {
-				m.ResourceRequirements = &v1.ResourceRequirements{}
-			}
-			if err := m.ResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3826,8 +5757,8 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -3922,93 +5853,11 @@ func (m *PodRequirements) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSchedulerobjects
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerobjects
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerobjects
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSchedulerobjects
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSchedulerobjects
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4025,10 +5874,10 @@ func (m *ExecutorSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Cordoned = bool(v != 0)
-		case 3:
+			m.Preempt = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CordonReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4056,9 +5905,9 @@ func (m *ExecutorSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CordonReason = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SetByUser", wireType)
 			}
@@ -4090,7 +5939,7 @@ func (m *ExecutorSettings) Unmarshal(dAtA []byte) error {
 			}
 			m.SetByUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SetAtTime", wireType)
 			}
@@ -20,6 +20,16 @@ type SchedulingConstraints interface {
 	CheckJobConstraints(sctx *context.SchedulingContext, gctx *context.GangSchedulingContext) (bool, string, error)
 	GetQueueResourceLimit(queueName string, priorityClassName string) internaltypes.ResourceList
 	CapResources(queue string, resourcesByPc map[string]internaltypes.ResourceList) map[string]internaltypes.ResourceList
+	GetQueueQuota(queueName string) (QueueQuota, bool)
+}
+
+// QueueQuota is the absolute amount of resources guaranteed to a queue in a pool.
+type QueueQuota struct {
+	// Resources guaranteed to the queue. Resources not part of the quota are set to their maximum value.
+	Guaranteed internaltypes.ResourceList
+	// If true, the queue may borrow idle resources beyond Guaranteed.
+	// Borrowed resources may be preempted to make room for queues below their guaranteed quota.
+	BorrowingEnabled bool
 }
 
 const (
@@ -29,6 +39,9 @@ const (
 	// Indicates that a queue has been assigned more than its allowed amount of resources.
 	MaximumResourcesPerQueueExceededUnschedulableReason = "maximum total resources for this queue exceeded"
 
+	// Indicates that a queue not allowed to borrow resources has been assigned more than its guaranteed quota.
+	QueueQuotaExceededUnschedulableReason = "queue quota exceeded"
+
 	// Indicates that the scheduling rate limit has been exceeded.
 	GlobalRateLimitExceededUnschedulableReason = "global scheduling rate limit exceeded"
 	QueueRateLimitExceededUnschedulableReason  = "queue scheduling rate limit exceeded"
@@ -75,6 +88,8 @@ type schedulingConstraints struct {
 	cordonedQueues map[string]bool
 	// Resource limits by queue and priority class. E.g. "Queue A is limited to 100 cpu at priority class armada-default"
 	resourceLimitsPerQueuePerPriorityClass map[string]map[string]internaltypes.ResourceList
+	// Absolute quotas by queue for this pool. Queues without a quota for this pool are omitted.
+	quotasByQueue map[string]QueueQuota
 }
 
 func NewSchedulingConstraints(
@@ -91,6 +106,7 @@ func NewSchedulingConstraints(
 		cordonedQueues:                         cordonedQueues,
 		maximumResourcesToSchedule:             calculatePerRoundLimits(totalResources, pool, config),
 		resourceLimitsPerQueuePerPriorityClass: calculatePerQueueLimits(totalResources, pool, config.PriorityClasses, queues),
+		quotasByQueue:                          calculateQueueQuotas(totalResources, pool, queues),
 	}
 }
 
@@ -146,6 +162,10 @@ func (constraints *schedulingConstraints) CheckJobConstraints(
 		return false, UnschedulableReasonMaximumResourcesExceeded, nil
 	}
 
+	if quota, ok := constraints.quotasByQueue[qctx.Queue]; ok && !quota.BorrowingEnabled && qctx.Allocated.Exceeds(quota.Guaranteed) {
+		return false, QueueQuotaExceededUnschedulableReason, nil
+	}
+
 	return true, "", nil
 }
 
@@ -168,6 +188,11 @@ func (c *schedulingConstraints) CapResources(queue string, resourcesByPc map[str
 	return cappedResourcesByPc
 }
 
+func (c *schedulingConstraints) GetQueueQuota(queueName string) (QueueQuota, bool) {
+	quota, ok := c.quotasByQueue[queueName]
+	return quota, ok
+}
+
 func calculatePerRoundLimits(
 	totalResources internaltypes.ResourceList,
 	pool string,
@@ -226,3 +251,26 @@ func calculatePerQueueLimits(
 
 	return limitsPerQueuePerPc
 }
+
+func calculateQueueQuotas(
+	totalResources internaltypes.ResourceList,
+	pool string,
+	queues []*api.Queue,
+) map[string]QueueQuota {
+	quotasByQueue := make(map[string]QueueQuota)
+	if totalResources.IsEmpty() {
+		return quotasByQueue
+	}
+	rlFactory := totalResources.Factory()
+	for _, queue := range queues {
+		quota, ok := queue.QuotaByPool[pool]
+		if !ok || quota == nil {
+			continue
+		}
+		quotasByQueue[queue.Name] = QueueQuota{
+			Guaranteed:       rlFactory.FromQuotaIgnoreUnknown(quota.Guaranteed),
+			BorrowingEnabled: quota.BorrowingEnabled,
+		}
+	}
+	return quotasByQueue
+}
@@ -108,6 +108,29 @@ func TestConstraints(t *testing.T) {
 				[]*api.Queue{{Name: "queue-1", ResourceLimitsByPriorityClassName: map[string]*api.PriorityClassResourceLimits{"priority-class-1": {MaximumResourceFraction: map[string]float64{"cpu": 0.9, "memory": 0.9}}}}}),
 			rlFactory,
 		).withExpectedQueueResourceLimit(makeResourceList(rlFactory, "900", "900Gi")),
+		"within-queue-quota": makeConstraintsTest(
+			NewSchedulingConstraints("pool-1", makeResourceList(rlFactory, "1000", "1000Gi"), makeSchedulingConfig(),
+				[]*api.Queue{{Name: "queue-1", QuotaByPool: map[string]*api.QueueQuota{"pool-1": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("30")}}}}}),
+			rlFactory,
+		).withExpectedQueueResourceLimit(rlFactory.MakeAllMax()),
+		"exceeds-queue-quota": func() *constraintTest {
+			t := makeConstraintsTest(NewSchedulingConstraints("pool-1", makeResourceList(rlFactory, "1000", "1000Gi"), makeSchedulingConfig(),
+				[]*api.Queue{{Name: "queue-1", QuotaByPool: map[string]*api.QueueQuota{"pool-1": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("20")}}}}}),
+				rlFactory)
+			t.expectedCheckConstraintsReason = QueueQuotaExceededUnschedulableReason
+			t.expectedQueueResourceLimit = rlFactory.MakeAllMax()
+			return t
+		}(),
+		"exceeds-queue-quota-with-borrowing": makeConstraintsTest(
+			NewSchedulingConstraints("pool-1", makeResourceList(rlFactory, "1000", "1000Gi"), makeSchedulingConfig(),
+				[]*api.Queue{{Name: "queue-1", QuotaByPool: map[string]*api.QueueQuota{"pool-1": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("20")}, BorrowingEnabled: true}}}}),
+			rlFactory,
+		).withExpectedQueueResourceLimit(rlFactory.MakeAllMax()),
+		"exceeds-queue-quota-of-other-pool": makeConstraintsTest(
+			NewSchedulingConstraints("pool-1", makeResourceList(rlFactory, "1000", "1000Gi"), makeSchedulingConfig(),
+				[]*api.Queue{{Name: "queue-1", QuotaByPool: map[string]*api.QueueQuota{"pool-2": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("20")}}}}}),
+			rlFactory,
+		).withExpectedQueueResourceLimit(rlFactory.MakeAllMax()),
 		"one-constraint-per-level-falls-back-as-expected--within-limits": makeMultiLevelConstraintsTest(
 			map[string]resource.Quantity{"a": resource.MustParse("99"), "b": resource.MustParse("19"), "c": resource.MustParse("2.9"), "d": resource.MustParse("0.39")},
 			"",
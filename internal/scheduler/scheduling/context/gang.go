@@ -55,6 +55,15 @@ func (gctx *GangSchedulingContext) Cardinality() int {
 	return len(gctx.JobSchedulingContexts)
 }
 
+// MinimumCardinality returns the number of jobs in the gang that must be scheduled for the gang to be scheduled.
+// Equal to Cardinality unless the gang is elastic.
+func (gctx *GangSchedulingContext) MinimumCardinality() int {
+	if !gctx.IsGang || gctx.GangInfo.MinimumCardinality <= 0 || gctx.GangInfo.MinimumCardinality > gctx.Cardinality() {
+		return gctx.Cardinality()
+	}
+	return gctx.GangInfo.MinimumCardinality
+}
+
 type GangSchedulingFit struct {
 	// The number of jobs in the gang that were successfully scheduled.
 	NumScheduled int
@@ -93,11 +93,16 @@ func (jctx *JobSchedulingContext) String() string {
 
 // SchedulingKey returns the scheduling key of the embedded job.
 // If the jctx contains additional node selectors or tolerations,
+// or the job has inter-pod affinity or topology spread constraints,
 // the key is invalid and the second return value is false.
 func (jctx *JobSchedulingContext) SchedulingKey() (internaltypes.SchedulingKey, bool) {
 	if len(jctx.AdditionalNodeSelectors) != 0 || len(jctx.AdditionalTolerations) != 0 {
 		return internaltypes.EmptySchedulingKey, false
 	}
+	// Whether such jobs can be scheduled depends on the jobs already scheduled, not just on the requirements hashed into the key.
+	if jctx.PodRequirements.HasInterPodConstraints() {
+		return internaltypes.EmptySchedulingKey, false
+	}
 	return jctx.Job.SchedulingKey(), true
 }
 
@@ -140,11 +145,14 @@ func (jctx *JobSchedulingContext) AddNodeSelector(key, value string) {
 }
 
 type GangInfo struct {
-	Id                string
-	IsGang            bool
-	Cardinality       int
-	PriorityClassName string
-	NodeUniformity    string
+	Id          string
+	IsGang      bool
+	Cardinality int
+	// Minimum number of jobs in the gang that must be scheduled together.
+	// Less than Cardinality for elastic gangs.
+	MinimumCardinality int
+	PriorityClassName  string
+	NodeUniformity     string
 }
 
 // EmptyGangInfo returns a GangInfo for a job that is not in a gang.
@@ -153,14 +161,29 @@ func EmptyGangInfo(job interfaces.MinimalJob) GangInfo {
 		// An Id of "" indicates that this job is not in a gang; we set
 		// Cardinality (as well as the other fields,
 		// which all make sense in this context) accordingly.
-		Id:                "",
-		IsGang:            false,
-		Cardinality:       1,
-		PriorityClassName: job.PriorityClassName(),
-		NodeUniformity:    job.Annotations()[configuration.GangNodeUniformityLabelAnnotation],
+		Id:                 "",
+		IsGang:             false,
+		Cardinality:        1,
+		MinimumCardinality: 1,
+		PriorityClassName:  job.PriorityClassName(),
+		NodeUniformity:     job.Annotations()[configuration.GangNodeUniformityLabelAnnotation],
 	}
 }
 
+// IsElastic returns true if the gang may run with fewer than Cardinality jobs.
+func (g GangInfo) IsElastic() bool {
+	return g.IsGang && g.MinimumCardinality < g.Cardinality
+}
+
+// ForSubset returns the GangInfo of a subset of numJobs jobs of the gang that are scheduled together,
+// given that numRunningJobs other jobs of the gang are already running.
+// The minimum cardinality is reduced by the number of running jobs, but each subset must schedule at least one job.
+func (g GangInfo) ForSubset(numJobs int, numRunningJobs int) GangInfo {
+	g.MinimumCardinality = max(1, min(numJobs, g.MinimumCardinality-numRunningJobs))
+	g.Cardinality = numJobs
+	return g
+}
+
 func GangInfoFromLegacySchedulerJob(job interfaces.MinimalJob) (GangInfo, error) {
 	gangInfo := EmptyGangInfo(job)
 
@@ -189,8 +212,23 @@ func GangInfoFromLegacySchedulerJob(job interfaces.MinimalJob) (GangInfo, error)
 		gangInfo.IsGang = true
 	}
 
+	gangMinimumCardinality := gangCardinality
+	if gangMinimumCardinalityString, ok := annotations[configuration.GangMinimumCardinalityAnnotation]; ok {
+		gangMinimumCardinality, err = strconv.Atoi(gangMinimumCardinalityString)
+		if err != nil {
+			return gangInfo, errors.WithStack(err)
+		}
+		if gangMinimumCardinality <= 0 || gangMinimumCardinality > gangCardinality {
+			return gangInfo, errors.Errorf(
+				"gang minimum cardinality %d is not between 1 and gang cardinality %d",
+				gangMinimumCardinality, gangCardinality,
+			)
+		}
+	}
+
 	gangInfo.Id = gangId
 	gangInfo.Cardinality = gangCardinality
+	gangInfo.MinimumCardinality = gangMinimumCardinality
 	return gangInfo, nil
 }
 
@@ -30,6 +30,8 @@ const (
 	PreemptedViaApi                  PreemptionType = "api"
 )
 
+const PreemptedViaNodeDrain PreemptionType = "node-drain"
+
 // PodSchedulingContext is returned by SelectAndBindNodeToPod and
 // contains detailed information on the scheduling decision made for this pod.
 type PodSchedulingContext struct {
@@ -48,12 +50,24 @@ type PodSchedulingContext struct {
 	NumNodes int
 	// Number of nodes excluded by reason.
 	NumExcludedNodesByReason map[string]int
+	// Outcome of trying each type of node, in the order tried: the pod's home nodes, followed by its away node types.
+	NodeTypeAttempts []*NodeTypeSchedulingAttempt
 	// If this pod was scheduled as an away job
 	ScheduledAway bool
 	// The method of scheduling that was used to schedule this job
 	SchedulingMethod SchedulingType
 }
 
+// NodeTypeSchedulingAttempt is the outcome of trying to schedule a pod on nodes of a particular type.
+type NodeTypeSchedulingAttempt struct {
+	// Well-known node type tried when scheduling the pod as an away job; empty for the pod's home nodes.
+	WellKnownNodeTypeName string
+	// ID of the node that the pod was assigned to, or empty.
+	NodeId string
+	// Number of nodes excluded by reason.
+	NumExcludedNodesByReason map[string]int
+}
+
 func (pctx *PodSchedulingContext) IsSuccessful() bool {
 	return pctx != nil && pctx.NodeId != ""
 }
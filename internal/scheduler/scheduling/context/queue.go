@@ -1,17 +1,11 @@
 package context
 
 import (
-	"fmt"
-	"strings"
-	"text/tabwriter"
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/exp/maps"
-	"golang.org/x/exp/slices"
 	"golang.org/x/time/rate"
 
-	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 )
@@ -27,6 +21,9 @@ type QueueSchedulingContext struct {
 	Queue string
 	// Determines the fair share of this queue relative to other queues.
 	Weight float64
+	// Weight of this queue after accounting for the queue hierarchy, such that the weights of queues are proportional
+	// to their fair shares. Zero if no queues are nested, in which case Weight applies.
+	FlattenedWeight float64
 	// Raw Weight of the queue before any priority boosts.
 	// This is purely informational as all scheduling decisions are made using Weight
 	RawWeight float64
@@ -47,6 +44,9 @@ type QueueSchedulingContext struct {
 	// Constrained demand for this queue. This differs from Demand in that it takes into account any constraints that we have
 	// placed on the queue
 	ConstrainedDemand internaltypes.ResourceList
+	// Resources guaranteed to this queue by its quota for this pool. Empty if the queue has no quota.
+	// Jobs of queues whose allocation is within their guaranteed resources are scheduled first and aren't preempted.
+	GuaranteedResources internaltypes.ResourceList
 	// Fair share is the weight of this queue over the sum of the weights of all queues
 	FairShare float64
 	// UncappedAdjustedFairShare includes not only this queue's fairshare, but also this queue's share of any unused fairshare from other queues. It's
@@ -83,10 +83,6 @@ type QueueSchedulingContext struct {
 	EvictedJobsById map[string]bool
 }
 
-func (qctx *QueueSchedulingContext) String() string {
-	return qctx.ReportString(0)
-}
-
 // GetAllocation is necessary to implement the fairness.Queue interface.
 func (qctx *QueueSchedulingContext) GetAllocation() internaltypes.ResourceList {
 	return qctx.Allocated
@@ -96,6 +92,11 @@ func (qctx *QueueSchedulingContext) GetAllocationInclShortJobPenalty() internalt
 	return qctx.Allocated.Add(qctx.ShortJobPenalty)
 }
 
+// GetGuaranteedResources is necessary to implement the fairness.Queue interface.
+func (qctx *QueueSchedulingContext) GetGuaranteedResources() internaltypes.ResourceList {
+	return qctx.GuaranteedResources
+}
+
 func (qctx *QueueSchedulingContext) SetBillableResource() {
 	billable := qctx.SchedulingContext.TotalResources.Factory().MakeAllZero()
 	for _, jctx := range qctx.SuccessfulJobSchedulingContexts {
@@ -115,102 +116,10 @@ func (qctx *QueueSchedulingContext) GetBillableResource() internaltypes.Resource
 
 // GetWeight is necessary to implement the fairness.Queue interface.
 func (qctx *QueueSchedulingContext) GetWeight() float64 {
-	return qctx.Weight
-}
-
-const maxJobIdsToPrint = 1
-
-func (qctx *QueueSchedulingContext) ReportString(verbosity int32) string {
-	var sb strings.Builder
-	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
-	if verbosity >= 0 {
-		fmt.Fprintf(w, "Time:\t%s\n", qctx.Created)
-		fmt.Fprintf(w, "Queue:\t%s\n", qctx.Queue)
-	}
-	fmt.Fprintf(w, "Scheduled resources:\t%s\n", internaltypes.RlMapSumValues(qctx.ScheduledResourcesByPriorityClass).String())
-	fmt.Fprintf(w, "Scheduled resources (by priority):\t%s\n", internaltypes.RlMapToString(qctx.ScheduledResourcesByPriorityClass))
-	fmt.Fprintf(w, "Preempted resources:\t%s\n", internaltypes.RlMapSumValues(qctx.EvictedResourcesByPriorityClass).String())
-	fmt.Fprintf(w, "Preempted resources (by priority):\t%s\n", internaltypes.RlMapToString(qctx.EvictedResourcesByPriorityClass))
-	fmt.Fprintf(w, "Preempted by optimiser resources:\t%s\n", internaltypes.RlMapSumValues(qctx.PreemptedByOptimiserResourceByPriorityClass).String())
-	fmt.Fprintf(w, "Preempted by optimiser resources (by priority):\t%s\n", internaltypes.RlMapToString(qctx.PreemptedByOptimiserResourceByPriorityClass))
-	if verbosity >= 0 {
-		fmt.Fprintf(w, "Total allocated resources after scheduling:\t%s\n", qctx.Allocated.String())
-		for pc, res := range qctx.AllocatedByPriorityClass {
-			fmt.Fprintf(w, "Total allocated resources after scheduling by for priority class %s:\t%s\n", pc, res.String())
-		}
-		fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", len(qctx.SuccessfulJobSchedulingContexts))
-		fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", len(qctx.EvictedJobsById))
-		fmt.Fprintf(w, "Number of jobs preempted by optimiser:\t%d\n", len(qctx.PreemptedByOptimiserJobSchedulingContexts))
-		fmt.Fprintf(w, "Number of jobs that could not be scheduled:\t%d\n", len(qctx.UnsuccessfulJobSchedulingContexts))
-		if len(qctx.SuccessfulJobSchedulingContexts) > 0 {
-			jobIdsToPrint := maps.Keys(qctx.SuccessfulJobSchedulingContexts)
-			if len(jobIdsToPrint) > maxJobIdsToPrint {
-				jobIdsToPrint = jobIdsToPrint[0:maxJobIdsToPrint]
-			}
-			fmt.Fprintf(w, "Scheduled jobs:\t%v", jobIdsToPrint)
-			if len(jobIdsToPrint) != len(qctx.SuccessfulJobSchedulingContexts) {
-				fmt.Fprintf(w, " (and %d others not shown)\n", len(qctx.SuccessfulJobSchedulingContexts)-len(jobIdsToPrint))
-			} else {
-				fmt.Fprint(w, "\n")
-			}
-		}
-		if len(qctx.EvictedJobsById) > 0 {
-			jobIdsToPrint := maps.Keys(qctx.EvictedJobsById)
-			if len(jobIdsToPrint) > maxJobIdsToPrint {
-				jobIdsToPrint = jobIdsToPrint[0:maxJobIdsToPrint]
-			}
-			fmt.Fprintf(w, "Preempted jobs:\t%v", jobIdsToPrint)
-			if len(jobIdsToPrint) != len(qctx.EvictedJobsById) {
-				fmt.Fprintf(w, " (and %d others not shown)\n", len(qctx.EvictedJobsById)-len(jobIdsToPrint))
-			} else {
-				fmt.Fprint(w, "\n")
-			}
-		}
-		if len(qctx.PreemptedByOptimiserJobSchedulingContexts) > 0 {
-			jobIdsToPrint := maps.Keys(qctx.PreemptedByOptimiserJobSchedulingContexts)
-			if len(jobIdsToPrint) > maxJobIdsToPrint {
-				jobIdsToPrint = jobIdsToPrint[0:maxJobIdsToPrint]
-			}
-			fmt.Fprintf(w, "Preempted jobs (by optimiser):\t%v", jobIdsToPrint)
-			if len(jobIdsToPrint) != len(qctx.PreemptedByOptimiserJobSchedulingContexts) {
-				fmt.Fprintf(w, " (and %d others not shown)\n", len(qctx.PreemptedByOptimiserJobSchedulingContexts)-len(jobIdsToPrint))
-			} else {
-				fmt.Fprint(w, "\n")
-			}
-		}
-		if len(qctx.UnsuccessfulJobSchedulingContexts) > 0 {
-			fmt.Fprint(w, "Unschedulable jobs:\n")
-			jobIdsByReason := armadaslices.MapAndGroupByFuncs(
-				maps.Values(qctx.UnsuccessfulJobSchedulingContexts),
-				func(jctx *JobSchedulingContext) string {
-					return jctx.UnschedulableReason
-				},
-				func(jctx *JobSchedulingContext) string {
-					return jctx.JobId
-				},
-			)
-			reasons := maps.Keys(jobIdsByReason)
-			slices.SortFunc(reasons, func(a, b string) int {
-				if len(jobIdsByReason[a]) < len(jobIdsByReason[b]) {
-					return -1
-				} else if len(jobIdsByReason[a]) > len(jobIdsByReason[b]) {
-					return 1
-				} else {
-					return 0
-				}
-			})
-			for i := len(reasons) - 1; i >= 0; i-- {
-				reason := reasons[i]
-				jobIds := jobIdsByReason[reason]
-				if len(jobIds) <= 0 {
-					continue
-				}
-				fmt.Fprintf(w, "\t%d:\t%s (e.g., %s)\n", len(jobIds), reason, jobIds[0])
-			}
-		}
+	if qctx.FlattenedWeight > 0 {
+		return qctx.FlattenedWeight
 	}
-	w.Flush()
-	return sb.String()
+	return qctx.Weight
 }
 
 // addJobSchedulingContext adds a job scheduling context.
@@ -4,17 +4,14 @@ import (
 	"fmt"
 	"math"
 	"strings"
-	"text/tabwriter"
 	"time"
 
-	"github.com/openconfig/goyang/pkg/indent"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/time/rate"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
-	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
@@ -36,6 +33,8 @@ type SchedulingContext struct {
 	Limiter *rate.Limiter
 	// Sum of queue weights across all queues.
 	WeightSum float64
+	// Describes how queues are nested. If nil, or if no queue is nested, all queues are siblings.
+	QueueHierarchy *fairness.QueueHierarchy
 	// Per-queue scheduling contexts.
 	QueueSchedulingContexts map[string]*QueueSchedulingContext
 	// Total resources across all clusters in this pool available at the start of the scheduling cycle.
@@ -63,6 +62,23 @@ type SchedulingContext struct {
 	UnfeasibleSchedulingKeys     map[internaltypes.SchedulingKey]*JobSchedulingContext
 	ExperimentalIndicativeShares map[int]float64
 	SpotPrice                    *float64
+	// Progress of draining each draining node in this pool, ordered by node id.
+	DrainingNodes []*DrainingNodeContext
+}
+
+// DrainingNodeContext captures the state of a draining node at the end of a scheduling cycle.
+type DrainingNodeContext struct {
+	Executor string
+	NodeId   string
+	NodeName string
+	Reason   string
+	// If true, preemptible jobs on the node are preempted rather than left to finish.
+	Preempt bool
+	// Jobs still allocated to the node.
+	RemainingJobIds []string
+	// Runs the executor still reports on the node, including those of preempted jobs whose pods are yet to exit.
+	// The node is empty once there are no remaining jobs or runs.
+	RemainingRunIds []string
 }
 
 func NewSchedulingContext(
@@ -151,10 +167,6 @@ func (sctx *SchedulingContext) AddQueueSchedulingContext(
 	return nil
 }
 
-func (sctx *SchedulingContext) String() string {
-	return sctx.ReportString(0)
-}
-
 // GetQueue is necessary to implement the fairness.QueueRepository interface.
 func (sctx *SchedulingContext) GetQueue(queue string) (fairness.Queue, bool) {
 	qctx, ok := sctx.QueueSchedulingContexts[queue]
@@ -185,13 +197,21 @@ type queueInfo struct {
 // QueueSchedulingContext associated with this SchedulingContext. This works by calculating a FairShare as
 // queue_weight/sum_of_all_queue_weights then DemandCappedAdjustedFairShare/UncappedAdjustedFairShare
 // by resharing any unused capacity (as determined by a queue's demand).
+//
+// If queues are nested, shares are first divided among top-level queues and then, recursively, among the children
+// of each queue; FlattenedWeight is set such that queues' weights are proportional to their fair shares.
 func (sctx *SchedulingContext) UpdateFairShares() {
 	queueInfos := sctx.updateFairShares(sctx.QueueSchedulingContexts)
+	nested := sctx.QueueHierarchy.IsNested()
 	for _, q := range queueInfos {
 		qtx := sctx.QueueSchedulingContexts[q.queueName]
 		qtx.FairShare = q.fairShare
 		qtx.DemandCappedAdjustedFairShare = q.demandCappedAdjustedFairShare
 		qtx.UncappedAdjustedFairShare = q.uncappedAdjustedFairShare
+		qtx.FlattenedWeight = 0
+		if nested {
+			qtx.FlattenedWeight = q.fairShare * sctx.WeightSum
+		}
 	}
 }
 
@@ -218,21 +238,19 @@ func (sctx *SchedulingContext) CalculateTheoreticalShare(priority float64) float
 // as queue_weight/sum_of_all_queue_weights and a DemandCappedAdjustedFairShare/UncappedAdjustedFairShare
 // by re-sharing any unused capacity (as determined by a queue's demand).
 func (sctx *SchedulingContext) updateFairShares(qctxs map[string]*QueueSchedulingContext) []*queueInfo {
-	const maxIterations = 10
+	if sctx.QueueHierarchy.IsNested() {
+		return sctx.updateHierarchicalFairShares(qctxs)
+	}
 
 	queueInfos := make([]*queueInfo, 0, len(qctxs))
 	for queueName, qctx := range qctxs {
-		constrainedDemandShare := 1.0
-		if !sctx.TotalResources.AllZero() {
-			constrainedDemandShare = sctx.FairnessCostProvider.UnweightedCostFromAllocation(qctx.ConstrainedDemand)
-		}
 		queueInfos = append(queueInfos, &queueInfo{
 			queueName:                     queueName,
 			demandCappedAdjustedFairShare: 0,
 			uncappedAdjustedFairShare:     0,
 			fairShare:                     qctx.Weight / sctx.WeightSum,
 			weight:                        qctx.Weight,
-			constrainedDemandShare:        constrainedDemandShare,
+			constrainedDemandShare:        sctx.constrainedDemandShare(qctx),
 			achievedDemand:                false,
 			spareShare:                    0,
 		})
@@ -243,10 +261,26 @@ func (sctx *SchedulingContext) updateFairShares(qctxs map[string]*QueueSchedulin
 		return strings.Compare(a.queueName, b.queueName)
 	})
 
-	unallocated := 1.0 // this is the proportion of the cluster that we can share each time
+	shareAmongSiblings(queueInfos, 1.0)
+	return queueInfos
+}
+
+func (sctx *SchedulingContext) constrainedDemandShare(qctx *QueueSchedulingContext) float64 {
+	if sctx.TotalResources.AllZero() {
+		return 1.0
+	}
+	return sctx.FairnessCostProvider.UnweightedCostFromAllocation(qctx.ConstrainedDemand)
+}
+
+// shareAmongSiblings computes demandCappedAdjustedFairShare and uncappedAdjustedFairShare for each of queueInfos
+// by dividing total among them in proportion to their weight and re-sharing any share in excess of their demand.
+func shareAmongSiblings(queueInfos []*queueInfo, total float64) {
+	const maxIterations = 10
+
+	unallocated := total // this is the proportion of the cluster that we can share each time
 
 	// We will reshare unused capacity until we've reshared 99% of all capacity or we've completed 5 iteration
-	for i := 0; i < maxIterations && unallocated > 0.01; i++ {
+	for i := 0; i < maxIterations && unallocated > 0.01*total; i++ {
 		totalWeight := 0.0
 		for _, q := range queueInfos {
 			if q.achievedDemand {
@@ -296,54 +330,117 @@ func (sctx *SchedulingContext) updateFairShares(qctxs map[string]*QueueSchedulin
 			}
 		}
 	}
-	return queueInfos
 }
 
-func (sctx *SchedulingContext) ReportString(verbosity int32) string {
-	var sb strings.Builder
-	w := tabwriter.NewWriter(&sb, 1, 1, 1, ' ', 0)
-	fmt.Fprintf(w, "Started:\t%s\n", sctx.Started)
-	fmt.Fprintf(w, "Finished:\t%s\n", sctx.Finished)
-	fmt.Fprintf(w, "Duration:\t%s\n", sctx.Finished.Sub(sctx.Started))
-	fmt.Fprintf(w, "Termination reason:\t%s\n", sctx.TerminationReason)
-	fmt.Fprintf(w, "Total capacity:\t%s\n", sctx.TotalResources.String())
-	fmt.Fprintf(w, "Scheduled resources:\t%s\n", sctx.ScheduledResources.String())
-	fmt.Fprintf(w, "Preempted resources:\t%s\n", sctx.EvictedResources.String())
-	fmt.Fprintf(w, "Number of gangs scheduled:\t%d\n", sctx.NumScheduledGangs)
-	fmt.Fprintf(w, "Number of jobs scheduled:\t%d\n", sctx.NumScheduledJobs)
-	fmt.Fprintf(w, "Number of jobs preempted:\t%d\n", sctx.NumEvictedJobs)
-	scheduled := armadamaps.Filter(
-		sctx.QueueSchedulingContexts,
-		func(_ string, qctx *QueueSchedulingContext) bool {
-			return len(qctx.SuccessfulJobSchedulingContexts) > 0
-		},
-	)
-	if verbosity <= 0 {
-		fmt.Fprintf(w, "Scheduled queues:\t%v\n", maps.Keys(scheduled))
-	} else {
-		fmt.Fprint(w, "Scheduled queues:\n")
-		for queueName, qctx := range scheduled {
-			fmt.Fprintf(w, "\t%s:\n", queueName)
-			fmt.Fprint(w, indent.String("\t\t", qctx.ReportString(verbosity-2)))
+// queueTreeNode is a queue in the hierarchy formed by nested queues.
+type queueTreeNode struct {
+	name     string
+	weight   float64
+	children map[string]*queueTreeNode
+	// Set if this node represents the jobs of an active queue, rather than a queue with active descendants.
+	qctx *QueueSchedulingContext
+	// Demand of this node and all its descendants, capped by constraints, as a fraction of total available resource.
+	constrainedDemandShare float64
+}
+
+// updateHierarchicalFairShares computes fair shares for nested queues.
+// Shares are divided among top-level queues as if they were the only queues, with the demand of each being that
+// of all queues nested under it, and then, recursively, among the children of each queue.
+// Hence, share unused by a queue is re-shared among its siblings before being re-shared with other parts of the tree.
+//
+// Queues not part of the hierarchy are considered top-level.
+// The jobs of a queue that also has children compete with its children as if they were submitted to a child
+// with the same weight as the queue itself.
+func (sctx *SchedulingContext) updateHierarchicalFairShares(qctxs map[string]*QueueSchedulingContext) []*queueInfo {
+	root := &queueTreeNode{children: make(map[string]*queueTreeNode)}
+	for queueName, qctx := range qctxs {
+		path := []string{queueName}
+		if sctx.QueueHierarchy.Contains(queueName) {
+			path = sctx.QueueHierarchy.Path(queueName)
+		}
+		node := root
+		for _, name := range path {
+			child, ok := node.children[name]
+			if !ok {
+				weight := qctx.Weight
+				if sctx.QueueHierarchy.Contains(name) {
+					weight = sctx.QueueHierarchy.Weight(name)
+				}
+				child = &queueTreeNode{name: name, weight: weight, children: make(map[string]*queueTreeNode)}
+				node.children[name] = child
+			}
+			node = child
 		}
+		// The jobs of a queue are represented by a child with the same name,
+		// which is flattened into the queue itself if it has no other children.
+		node.children[queueName] = &queueTreeNode{name: queueName, weight: node.weight, qctx: qctx}
 	}
-	preempted := armadamaps.Filter(
-		sctx.QueueSchedulingContexts,
-		func(_ string, qctx *QueueSchedulingContext) bool {
-			return len(qctx.EvictedJobsById) > 0
-		},
-	)
-	if verbosity <= 0 {
-		fmt.Fprintf(w, "Preempted queues:\t%v\n", maps.Keys(preempted))
+
+	queueInfos := make([]*queueInfo, 0, len(qctxs))
+	var divide func(node *queueTreeNode, fairShare, demandCappedShare, uncappedShare float64)
+	divide = func(node *queueTreeNode, fairShare, demandCappedShare, uncappedShare float64) {
+		if node.qctx != nil {
+			queueInfos = append(queueInfos, &queueInfo{
+				queueName:                     node.name,
+				weight:                        node.weight,
+				fairShare:                     fairShare,
+				demandCappedAdjustedFairShare: demandCappedShare,
+				uncappedAdjustedFairShare:     uncappedShare,
+				constrainedDemandShare:        node.constrainedDemandShare,
+			})
+			return
+		}
+		children := maps.Values(node.children)
+		slices.SortFunc(children, func(a, b *queueTreeNode) int {
+			return strings.Compare(a.name, b.name)
+		})
+		if len(children) == 1 && children[0].qctx != nil {
+			divide(children[0], fairShare, demandCappedShare, uncappedShare)
+			return
+		}
+		weightSum := 0.0
+		for _, child := range children {
+			weightSum += child.weight
+		}
+		// Demand-capped and uncapped shares are computed separately, since the uncapped share of a child is
+		// computed assuming the parent, too, has infinite demand.
+		demandCapped := make([]*queueInfo, len(children))
+		uncapped := make([]*queueInfo, len(children))
+		for i, child := range children {
+			demandShare := sctx.subtreeConstrainedDemandShare(child)
+			demandCapped[i] = &queueInfo{weight: child.weight, constrainedDemandShare: demandShare}
+			uncapped[i] = &queueInfo{weight: child.weight, constrainedDemandShare: demandShare}
+		}
+		shareAmongSiblings(demandCapped, demandCappedShare)
+		shareAmongSiblings(uncapped, uncappedShare)
+		for i, child := range children {
+			childFairShare := 0.0
+			if weightSum > 0 {
+				childFairShare = fairShare * child.weight / weightSum
+			}
+			divide(child, childFairShare, demandCapped[i].demandCappedAdjustedFairShare, uncapped[i].uncappedAdjustedFairShare)
+		}
+	}
+	divide(root, 1.0, 1.0, 1.0)
+
+	// We do this so that we get deterministic output
+	slices.SortFunc(queueInfos, func(a, b *queueInfo) int {
+		return strings.Compare(a.queueName, b.queueName)
+	})
+	return queueInfos
+}
+
+// subtreeConstrainedDemandShare computes and stores the constrained demand share of node and all its descendants.
+func (sctx *SchedulingContext) subtreeConstrainedDemandShare(node *queueTreeNode) float64 {
+	if node.qctx != nil {
+		node.constrainedDemandShare = sctx.constrainedDemandShare(node.qctx)
 	} else {
-		fmt.Fprint(w, "Preempted queues:\n")
-		for queueName, qctx := range preempted {
-			fmt.Fprintf(w, "\t%s:\n", queueName)
-			fmt.Fprint(w, indent.String("\t\t", qctx.ReportString(verbosity-2)))
+		node.constrainedDemandShare = 0
+		for _, child := range node.children {
+			node.constrainedDemandShare += sctx.subtreeConstrainedDemandShare(child)
 		}
 	}
-	w.Flush()
-	return sb.String()
+	return node.constrainedDemandShare
 }
 
 func (sctx *SchedulingContext) AddGangSchedulingContext(gctx *GangSchedulingContext) (bool, error) {
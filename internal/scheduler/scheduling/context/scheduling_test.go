@@ -225,6 +225,97 @@ func TestCalculateFairShares(t *testing.T) {
 	}
 }
 
+func TestUpdateHierarchicalFairShares(t *testing.T) {
+	zeroCpu := cpu(0)
+	oneCpu := cpu(1)
+	oneHundredCpu := cpu(100)
+	oneThousandCpu := cpu(1000)
+
+	// teamA and teamB are top-level queues; alice and bob are nested under teamA and carol under teamB.
+	queueHierarchy := fairness.NewQueueHierarchy()
+	queueHierarchy.AddQueue("teamA", "", 1)
+	queueHierarchy.AddQueue("teamB", "", 1)
+	queueHierarchy.AddQueue("alice", "teamA", 1)
+	queueHierarchy.AddQueue("bob", "teamA", 1)
+	queueHierarchy.AddQueue("carol", "teamB", 1)
+
+	tests := map[string]struct {
+		demand                                 map[string]internaltypes.ResourceList
+		expectedFairShares                     map[string]float64
+		expectedDemandCappedAdjustedFairShares map[string]float64
+		expectedUncappedAdjustedFairShares     map[string]float64
+	}{
+		"demand exceeds capacity for all queues": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": oneThousandCpu, "bob": oneThousandCpu, "carol": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.25, "bob": 0.25, "carol": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.25, "bob": 0.25, "carol": 0.5},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 0.25, "bob": 0.25, "carol": 0.5},
+		},
+		"unused share goes to siblings first": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": oneThousandCpu, "bob": oneCpu, "carol": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.25, "bob": 0.25, "carol": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.49, "bob": 0.01, "carol": 0.5},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 0.49, "bob": 0.25, "carol": 0.5},
+		},
+		"share unused by a subtree goes to other subtrees": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": oneCpu, "bob": oneCpu, "carol": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.25, "bob": 0.25, "carol": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.01, "bob": 0.01, "carol": 0.98},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 0.49, "bob": 0.49, "carol": 0.98},
+		},
+		"only active queues are considered": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": oneThousandCpu, "bob": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.5, "bob": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.5, "bob": 0.5},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 0.5, "bob": 0.5},
+		},
+		"jobs of a parent queue compete with its children": {
+			demand:                                 map[string]internaltypes.ResourceList{"teamA": oneThousandCpu, "alice": oneThousandCpu, "carol": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"teamA": 0.25, "alice": 0.25, "carol": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"teamA": 0.25, "alice": 0.25, "carol": 0.5},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"teamA": 0.25, "alice": 0.25, "carol": 0.5},
+		},
+		"queues not part of the hierarchy are top-level": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": oneThousandCpu, "bob": oneThousandCpu, "other": oneThousandCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.25, "bob": 0.25, "other": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.25, "bob": 0.25, "other": 0.5},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 0.25, "bob": 0.25, "other": 0.5},
+		},
+		"no demand": {
+			demand:                                 map[string]internaltypes.ResourceList{"alice": zeroCpu, "carol": zeroCpu},
+			expectedFairShares:                     map[string]float64{"alice": 0.5, "carol": 0.5},
+			expectedDemandCappedAdjustedFairShares: map[string]float64{"alice": 0.0, "carol": 0.0},
+			expectedUncappedAdjustedFairShares:     map[string]float64{"alice": 1.0, "carol": 1.0},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fairnessCostProvider, err := fairness.NewDominantResourceFairness(oneHundredCpu, "pool", configuration.SchedulingConfig{DominantResourceFairnessResourcesToConsider: []string{"cpu"}})
+			require.NoError(t, err)
+			sctx := NewSchedulingContext(
+				"pool",
+				fairnessCostProvider,
+				nil,
+				oneHundredCpu,
+			)
+			sctx.QueueHierarchy = queueHierarchy
+			for qName, demand := range tc.demand {
+				err = sctx.AddQueueSchedulingContext(
+					qName, 1.0, 1.0, map[string]internaltypes.ResourceList{}, demand, demand, internaltypes.ResourceList{}, nil)
+				require.NoError(t, err)
+			}
+			sctx.UpdateFairShares()
+			for qName, qctx := range sctx.QueueSchedulingContexts {
+				assert.InDelta(t, tc.expectedFairShares[qName], qctx.FairShare, 1e-9, "Fair share for queue %s", qName)
+				assert.InDelta(t, tc.expectedDemandCappedAdjustedFairShares[qName], qctx.DemandCappedAdjustedFairShare, 1e-9, "Demand capped adjusted fair share for queue %s", qName)
+				assert.InDelta(t, tc.expectedUncappedAdjustedFairShares[qName], qctx.UncappedAdjustedFairShare, 1e-9, "Uncapped adjusted fair share for queue %s", qName)
+				// Queues are ordered by weight, which must hence reflect the hierarchy.
+				assert.InDelta(t, qctx.FairShare*sctx.WeightSum, qctx.GetWeight(), 1e-9, "Weight of queue %s", qName)
+			}
+		})
+	}
+}
+
 func TestCalculateTheoreticalShare(t *testing.T) {
 	oneCpu := cpu(1)
 	oneHundredCpu := cpu(100)
@@ -179,6 +179,20 @@ func NewOversubscribedEvictor(
 	}
 }
 
+// quotaEvictionDecision decides whether jobs of a queue with a guaranteed quota may be evicted to balance resources.
+// Jobs of queues within their guaranteed quota are never evicted, whereas jobs of queues that have borrowed resources
+// beyond their guaranteed quota are always eligible for eviction, such that the borrowed resources can be reclaimed.
+// The last return value is false if the queue has no quota, in which case the decision is left to the caller.
+func quotaEvictionDecision(qctx *schedulercontext.QueueSchedulingContext) (bool, string, bool) {
+	if qctx.GuaranteedResources.IsEmpty() {
+		return false, "", false
+	}
+	if !qctx.GetAllocation().Exceeds(qctx.GuaranteedResources) {
+		return false, "within_guaranteed_quota", true
+	}
+	return true, "", true
+}
+
 // Evict removes jobs from nodes, returning all affected jobs and nodes.
 // Any node for which nodeFilter returns false is skipped.
 // Any job for which jobFilter returns true is evicted (if the node was not skipped).
@@ -23,6 +23,8 @@ type Queue interface {
 	GetAllocationInclShortJobPenalty() internaltypes.ResourceList
 	// Determines the fair share of this queue relative to other queues.
 	GetWeight() float64
+	// GetGuaranteedResources returns the resources guaranteed to this queue by its quota, or an empty list if it has none.
+	GetGuaranteedResources() internaltypes.ResourceList
 }
 
 // FairnessCostProvider captures algorithms to compute the cost of an allocation.
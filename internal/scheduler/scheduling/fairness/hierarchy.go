@@ -0,0 +1,72 @@
+package fairness
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// QueueHierarchy describes how queues are nested.
+// Fair share is divided first among top-level queues and then, recursively, among the children of each queue.
+type QueueHierarchy struct {
+	parentByQueue map[string]string
+	weightByQueue map[string]float64
+	nested        bool
+}
+
+func NewQueueHierarchy() *QueueHierarchy {
+	return &QueueHierarchy{
+		parentByQueue: make(map[string]string),
+		weightByQueue: make(map[string]float64),
+	}
+}
+
+// AddQueue adds a queue with the given weight to the hierarchy, nested under parent.
+// Parent is empty for top-level queues.
+func (h *QueueHierarchy) AddQueue(queue string, parent string, weight float64) {
+	h.parentByQueue[queue] = parent
+	h.weightByQueue[queue] = weight
+	if parent != "" {
+		h.nested = true
+	}
+}
+
+// Contains returns true if the queue is part of the hierarchy.
+func (h *QueueHierarchy) Contains(queue string) bool {
+	if h == nil {
+		return false
+	}
+	_, ok := h.parentByQueue[queue]
+	return ok
+}
+
+// IsNested returns true if at least one queue in the hierarchy has a parent.
+func (h *QueueHierarchy) IsNested() bool {
+	return h != nil && h.nested
+}
+
+// Weight returns the weight of a queue relative to its siblings.
+func (h *QueueHierarchy) Weight(queue string) float64 {
+	return h.weightByQueue[queue]
+}
+
+// Parent returns the parent of a queue, or the empty string for top-level queues.
+// Queues nested under a queue not part of the hierarchy are considered top-level.
+func (h *QueueHierarchy) Parent(queue string) string {
+	parent := h.parentByQueue[queue]
+	if !h.Contains(parent) {
+		return ""
+	}
+	return parent
+}
+
+// Path returns the ancestors of a queue, starting with the top-level queue and ending with the queue itself.
+// Should the parents of a queue form a cycle, the path is cut short such that no queue appears twice.
+func (h *QueueHierarchy) Path(queue string) []string {
+	path := []string{queue}
+	seen := map[string]bool{queue: true}
+	for parent := h.Parent(queue); parent != "" && !seen[parent]; parent = h.Parent(parent) {
+		path = append(path, parent)
+		seen[parent] = true
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,34 @@
+package fairness
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueHierarchy(t *testing.T) {
+	h := NewQueueHierarchy()
+	assert.False(t, h.IsNested())
+
+	h.AddQueue("team", "", 1)
+	h.AddQueue("project", "team", 2)
+	h.AddQueue("user", "project", 3)
+	h.AddQueue("orphan", "deleted", 1)
+	h.AddQueue("cycleA", "cycleB", 1)
+	h.AddQueue("cycleB", "cycleA", 1)
+
+	assert.True(t, h.IsNested())
+	assert.True(t, h.Contains("user"))
+	assert.False(t, h.Contains("deleted"))
+	assert.Equal(t, 2.0, h.Weight("project"))
+	assert.Equal(t, "project", h.Parent("user"))
+	assert.Equal(t, "", h.Parent("team"))
+	assert.Equal(t, "", h.Parent("orphan"))
+	assert.Equal(t, []string{"team", "project", "user"}, h.Path("user"))
+	assert.Equal(t, []string{"orphan"}, h.Path("orphan"))
+	assert.Equal(t, []string{"cycleB", "cycleA"}, h.Path("cycleA"))
+
+	var nilHierarchy *QueueHierarchy
+	assert.False(t, nilHierarchy.IsNested())
+	assert.False(t, nilHierarchy.Contains("team"))
+}
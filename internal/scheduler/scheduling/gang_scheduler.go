@@ -46,15 +46,19 @@ func (sch *GangScheduler) updateGangSchedulingContextOnSuccess(gctx *context.Gan
 		return nil
 	}
 
-	// Evict any jobs added to the context marked as unsuccessful.
-	// This is necessary to support min-max gang-scheduling,
-	// where the gang is scheduled successfully if at least min of its members scheduled successfully.
-	// Here, we evict the memebers of the gang that were not scheduled successfully.
+	// Elastic gangs are scheduled successfully if at least their minimum cardinality was scheduled.
+	// Here, we remove the members of the gang that were not scheduled from the context
+	// and add them back as unsuccessful, such that queued jobs remain queued and evicted jobs are preempted.
 	for _, jctx := range gctx.JobSchedulingContexts {
-		if !jctx.IsSuccessful() {
-			if _, err := sch.schedulingContext.EvictJob(jctx); err != nil {
-				return err
-			}
+		if jctx.PodSchedulingContext.IsSuccessful() {
+			continue
+		}
+		if _, err := sch.schedulingContext.EvictJob(jctx); err != nil {
+			return err
+		}
+		jctx.Fail(schedulerconstraints.JobDoesNotFitUnschedulableReason)
+		if _, err := sch.schedulingContext.AddJobSchedulingContext(jctx); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -116,9 +120,10 @@ func (sch *GangScheduler) Schedule(ctx *armadacontext.Context, gctx *context.Gan
 
 		// Update rate-limiters to account for new successfully scheduled jobs.
 		if ok && !gctx.AllJobsEvicted {
-			sch.schedulingContext.Limiter.ReserveN(sch.schedulingContext.Started, gctx.Cardinality())
+			numScheduled := gctx.Fit().NumScheduled
+			sch.schedulingContext.Limiter.ReserveN(sch.schedulingContext.Started, numScheduled)
 			if qctx := sch.schedulingContext.QueueSchedulingContexts[gctx.Queue]; qctx != nil {
-				qctx.Limiter.ReserveN(sch.schedulingContext.Started, gctx.Cardinality())
+				qctx.Limiter.ReserveN(sch.schedulingContext.Started, numScheduled)
 			}
 		}
 
@@ -87,6 +87,28 @@ func TestGangScheduler(t *testing.T) {
 			ExpectedRuntimeGangCardinality:         []int{32, 0},
 			ExpectedUnfeasibleSchedulingKeyIndices: []int{1},
 		},
+		"elastic gang partial success": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Gangs: [][]*jobdb.Job{
+				testfixtures.WithElasticGangAnnotationsJobs(testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 40), 16),
+			},
+			ExpectedScheduledIndices:               testfixtures.IntRange(0, 0),
+			ExpectedCumulativeScheduledJobs:        []int{32},
+			ExpectedRuntimeGangCardinality:         []int{32},
+			ExpectedUnfeasibleSchedulingKeyIndices: []int{},
+		},
+		"elastic gang below minimum cardinality": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Gangs: [][]*jobdb.Job{
+				testfixtures.WithElasticGangAnnotationsJobs(testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 40), 36),
+			},
+			ExpectedScheduledIndices:               nil,
+			ExpectedCumulativeScheduledJobs:        []int{0},
+			ExpectedRuntimeGangCardinality:         []int{0},
+			ExpectedUnfeasibleSchedulingKeyIndices: []int{},
+		},
 		"multiple nodes": {
 			SchedulingConfig: testfixtures.TestSchedulingConfig(),
 			Nodes:            testfixtures.N32CpuNodes(2, testfixtures.TestPriorities),
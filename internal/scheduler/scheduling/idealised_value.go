@@ -48,6 +48,7 @@ func CalculateIdealisedValue(
 		sctx.FairnessCostProvider,
 		noOpRateLimiter,
 		sctx.TotalResources)
+	dummySchedulingContext.QueueHierarchy = sctx.QueueHierarchy
 
 	for _, qctx := range sctx.QueueSchedulingContexts {
 		err := dummySchedulingContext.AddQueueSchedulingContext(
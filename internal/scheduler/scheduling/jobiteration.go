@@ -109,15 +109,22 @@ func (repo *InMemoryJobRepository) GetJobIterator(queue string) JobContextIterat
 // QueuedJobsIterator is an iterator over all jobs in a queue.
 type QueuedJobsIterator struct {
 	jobIter jobdb.JobIterator
+	repo    jobdb.JobRepository
+	queue   string
 	pool    string
 	ctx     *armadacontext.Context
+	// Gang info of the queued jobs of each elastic gang seen so far.
+	elasticGangInfoById map[string]schedulercontext.GangInfo
 }
 
 func NewQueuedJobsIterator(ctx *armadacontext.Context, queue string, pool string, sortOrder jobdb.JobSortOrder, repo jobdb.JobRepository) *QueuedJobsIterator {
 	return &QueuedJobsIterator{
-		jobIter: repo.QueuedJobs(queue, pool, sortOrder),
-		pool:    pool,
-		ctx:     ctx,
+		jobIter:             repo.QueuedJobs(queue, pool, sortOrder),
+		repo:                repo,
+		queue:               queue,
+		pool:                pool,
+		ctx:                 ctx,
+		elasticGangInfoById: make(map[string]schedulercontext.GangInfo),
 	}
 }
 
@@ -131,9 +138,42 @@ func (it *QueuedJobsIterator) Next() (*schedulercontext.JobSchedulingContext, er
 			if job == nil {
 				return nil, nil
 			}
-			return schedulercontext.JobSchedulingContextFromJob(job), nil
+			jctx := schedulercontext.JobSchedulingContextFromJob(job)
+			if jctx.GangInfo.IsElastic() {
+				gangInfo, err := it.elasticGangInfo(jctx)
+				if err != nil {
+					return nil, err
+				}
+				jctx.GangInfo = gangInfo
+			}
+			return jctx, nil
+		}
+	}
+}
+
+// elasticGangInfo returns the gang info of the queued jobs of the elastic gang jctx is part of.
+// Some jobs of an elastic gang may already be running, in which case the queued jobs are yielded as a smaller gang
+// that only has to make up for the difference between the running jobs and the minimum cardinality.
+func (it *QueuedJobsIterator) elasticGangInfo(jctx *schedulercontext.JobSchedulingContext) (schedulercontext.GangInfo, error) {
+	if gangInfo, ok := it.elasticGangInfoById[jctx.GangInfo.Id]; ok {
+		return gangInfo, nil
+	}
+	gangJobs, err := it.repo.GetGangJobsByGangId(it.queue, jctx.GangInfo.Id)
+	if err != nil {
+		return schedulercontext.GangInfo{}, err
+	}
+	numQueued := 0
+	numRunning := 0
+	for _, gangJob := range gangJobs {
+		if gangJob.Queued() {
+			numQueued++
+		} else if !gangJob.InTerminalState() {
+			numRunning++
 		}
 	}
+	gangInfo := jctx.GangInfo.ForSubset(numQueued, numRunning)
+	it.elasticGangInfoById[jctx.GangInfo.Id] = gangInfo
+	return gangInfo, nil
 }
 
 // MultiJobsIterator chains several JobIterators together in the order provided.
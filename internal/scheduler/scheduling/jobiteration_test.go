@@ -14,6 +14,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/jobdb"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/scheduling/context"
 	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	armadaconfiguration "github.com/armadaproject/armada/internal/server/configuration"
 )
 
 func TestInMemoryJobRepository(t *testing.T) {
@@ -107,6 +108,53 @@ func TestQueuedJobsIterator_OneQueue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestQueuedJobsIterator_ElasticGang(t *testing.T) {
+	tests := map[string]struct {
+		numRunning                 int
+		expectedCardinality        int
+		expectedMinimumCardinality int
+	}{
+		"no running jobs": {
+			numRunning:                 0,
+			expectedCardinality:        6,
+			expectedMinimumCardinality: 4,
+		},
+		"fewer running jobs than the minimum": {
+			numRunning:                 2,
+			expectedCardinality:        4,
+			expectedMinimumCardinality: 2,
+		},
+		"more running jobs than the minimum": {
+			numRunning:                 5,
+			expectedCardinality:        1,
+			expectedMinimumCardinality: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := newMockJobRepository()
+			gang := testfixtures.WithQueued(testfixtures.WithElasticGangAnnotationsJobs(testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 6), 4))
+			for i, job := range gang {
+				if i < tc.numRunning {
+					repo.jobsById[job.Id()] = job.WithQueued(false).WithNewRun("executor", "node", "node", testfixtures.TestPool, job.PriorityClass().Priority)
+				} else {
+					repo.Enqueue(job)
+				}
+			}
+
+			it := NewQueuedJobsIterator(armadacontext.Background(), "A", testfixtures.TestPool, jobdb.FairShareOrder, repo)
+			numQueued := 0
+			for jctx, err := it.Next(); jctx != nil; jctx, err = it.Next() {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedCardinality, jctx.GangInfo.Cardinality)
+				assert.Equal(t, tc.expectedMinimumCardinality, jctx.GangInfo.MinimumCardinality)
+				numQueued++
+			}
+			assert.Equal(t, 6-tc.numRunning, numQueued)
+		})
+	}
+}
+
 func TestQueuedJobsIterator_ExceedsBufferSize(t *testing.T) {
 	repo := newMockJobRepository()
 	expected := make([]string, 0)
@@ -248,6 +296,16 @@ func (repo *mockJobRepository) GetById(id string) *jobdb.Job {
 	return j
 }
 
+func (repo *mockJobRepository) GetGangJobsByGangId(queue string, gangId string) ([]*jobdb.Job, error) {
+	var jobs []*jobdb.Job
+	for _, job := range repo.jobsById {
+		if job.Queue() == queue && job.Annotations()[armadaconfiguration.GangIdAnnotation] == gangId {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
 func (repo *mockJobRepository) NewJob(
 	jobId string,
 	jobSet string,
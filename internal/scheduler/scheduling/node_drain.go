@@ -0,0 +1,173 @@
+package scheduling
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/armadaproject/armada/internal/scheduler/internaltypes"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/scheduling/context"
+)
+
+// drainingNode is a node matched by at least one node drain.
+type drainingNode struct {
+	node  *internaltypes.Node
+	drain *schedulerobjects.NodeDrain
+	// Runs the executor reports on the node that haven't yet finished.
+	activeRunIds []string
+}
+
+// markDrainingNodes marks every node matched by one of the provided drains as unschedulable,
+// such that no new jobs are scheduled onto it, and returns the draining nodes indexed by node id.
+// activeRunIdsByNodeId holds the runs reported on each node that haven't finished.
+func markDrainingNodes(
+	nodes []*internaltypes.Node,
+	drains []*schedulerobjects.NodeDrain,
+	activeRunIdsByNodeId map[string][]string,
+) ([]*internaltypes.Node, map[string]*drainingNode) {
+	if len(drains) == 0 {
+		return nodes, nil
+	}
+	result := make([]*internaltypes.Node, len(nodes))
+	drainingNodesById := make(map[string]*drainingNode)
+	for i, node := range nodes {
+		drain := drainForNode(node, drains)
+		if drain != nil {
+			node = node.WithSchedulable(false)
+			drainingNodesById[node.GetId()] = &drainingNode{node: node, drain: drain, activeRunIds: activeRunIdsByNodeId[node.GetId()]}
+		}
+		result[i] = node
+	}
+	return result, drainingNodesById
+}
+
+// activeRunIdsByNodeId returns the runs reported by executors on each of their nodes that haven't finished, sorted.
+// Unlike the jobs allocated to a node, these include runs of preempted jobs whose pods haven't yet exited.
+func activeRunIdsByNodeId(executors []*schedulerobjects.Executor) map[string][]string {
+	result := make(map[string][]string)
+	for _, executor := range executors {
+		for _, node := range executor.Nodes {
+			for runId, state := range node.StateByJobRunId {
+				if state != schedulerobjects.JobRunState_SUCCEEDED && state != schedulerobjects.JobRunState_FAILED {
+					result[node.Id] = append(result[node.Id], runId)
+				}
+			}
+			slices.Sort(result[node.Id])
+		}
+	}
+	return result
+}
+
+// drainForNode returns the drain applying to node, or nil if the node isn't being drained.
+// If several drains apply, one that requests preemption takes precedence.
+func drainForNode(node *internaltypes.Node, drains []*schedulerobjects.NodeDrain) *schedulerobjects.NodeDrain {
+	var match *schedulerobjects.NodeDrain
+	for _, drain := range drains {
+		if drain.ExecutorId != node.GetExecutor() {
+			continue
+		}
+		if drain.NodeName != "" {
+			if drain.NodeName != node.GetName() {
+				continue
+			}
+		} else if len(drain.NodeSelector) == 0 || !labels.SelectorFromSet(drain.NodeSelector).Matches(labels.Set(node.GetLabels())) {
+			continue
+		}
+		if match == nil || (drain.Preempt && !match.Preempt) {
+			match = drain
+		}
+	}
+	return match
+}
+
+// preemptJobsOnDrainingNodes adds to result all preemptible jobs running on nodes drained with preemption requested,
+// together with the other members of their gangs, and records the progress of each draining node in the scheduling context.
+func preemptJobsOnDrainingNodes(fsctx *FairSchedulingAlgoContext, result *SchedulerResult) error {
+	if len(fsctx.drainingNodesById) == 0 {
+		return nil
+	}
+	preemptedJobIds := make(map[string]bool, len(result.PreemptedJobs))
+	for _, jctx := range result.PreemptedJobs {
+		preemptedJobIds[jctx.JobId] = true
+	}
+
+	var drainPreemptedJobs []*schedulercontext.JobSchedulingContext
+	preempt := func(jobId string, description string) error {
+		if preemptedJobIds[jobId] {
+			return nil
+		}
+		job := fsctx.Txn.GetById(jobId)
+		if job == nil || job.InTerminalState() || !job.PriorityClass().Preemptible {
+			return nil
+		}
+		jctx := schedulercontext.JobSchedulingContextFromJob(job)
+		jctx.PreemptionType = schedulercontext.PreemptedViaNodeDrain
+		jctx.PreemptionDescription = description
+		if _, err := fsctx.schedulingContext.EvictJob(jctx); err != nil {
+			return err
+		}
+		preemptedJobIds[jobId] = true
+		drainPreemptedJobs = append(drainPreemptedJobs, jctx)
+		return nil
+	}
+
+	nodeIds := maps.Keys(fsctx.drainingNodesById)
+	slices.Sort(nodeIds)
+	for _, nodeId := range nodeIds {
+		dn := fsctx.drainingNodesById[nodeId]
+		// Draining nodes with no jobs allocated to them aren't added to the nodeDb.
+		node, err := fsctx.nodeDb.GetNode(nodeId)
+		if err != nil {
+			return err
+		}
+		var jobIds []string
+		if node != nil {
+			jobIds = maps.Keys(node.AllocatedByJobId)
+			slices.Sort(jobIds)
+		}
+		if dn.drain.Preempt {
+			description := fmt.Sprintf("Preempted - node %s is being drained: %s", dn.node.GetName(), dn.drain.Reason)
+			for _, jobId := range jobIds {
+				if err := preempt(jobId, description); err != nil {
+					return err
+				}
+				if gangId, ok := fsctx.gangIdByJobId[jobId]; ok {
+					for gangJobId := range fsctx.jobIdsByGangId[gangId] {
+						if err := preempt(gangJobId, description); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		remainingJobIds := make([]string, 0, len(jobIds))
+		for _, jobId := range jobIds {
+			if !preemptedJobIds[jobId] {
+				remainingJobIds = append(remainingJobIds, jobId)
+			}
+		}
+		fsctx.schedulingContext.DrainingNodes = append(fsctx.schedulingContext.DrainingNodes, &schedulercontext.DrainingNodeContext{
+			Executor:        dn.node.GetExecutor(),
+			NodeId:          nodeId,
+			NodeName:        dn.node.GetName(),
+			Reason:          dn.drain.Reason,
+			Preempt:         dn.drain.Preempt,
+			RemainingJobIds: remainingJobIds,
+			RemainingRunIds: dn.activeRunIds,
+		})
+	}
+
+	if len(drainPreemptedJobs) > 0 {
+		result.PreemptedJobs = append(result.PreemptedJobs, drainPreemptedJobs...)
+		stats := result.PerPoolSchedulingStats[fsctx.pool]
+		stats.PreemptedJobs = append(stats.PreemptedJobs, drainPreemptedJobs...)
+		if result.PerPoolSchedulingStats == nil {
+			result.PerPoolSchedulingStats = make(map[string]PerPoolSchedulingStats)
+		}
+		result.PerPoolSchedulingStats[fsctx.pool] = stats
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+)
+
+func TestActiveRunIdsByNodeId(t *testing.T) {
+	executors := []*schedulerobjects.Executor{
+		{
+			Id: "executor1",
+			Nodes: []*schedulerobjects.Node{
+				{
+					Id: "node1",
+					StateByJobRunId: map[string]schedulerobjects.JobRunState{
+						"run3": schedulerobjects.JobRunState_RUNNING,
+						"run1": schedulerobjects.JobRunState_PENDING,
+						"run2": schedulerobjects.JobRunState_SUCCEEDED,
+						"run4": schedulerobjects.JobRunState_FAILED,
+					},
+				},
+				{Id: "node2"},
+			},
+		},
+	}
+	assert.Equal(t, map[string][]string{"node1": {"run1", "run3"}}, activeRunIdsByNodeId(executors))
+}
@@ -18,7 +18,7 @@ func FromSchedulingContext(sctx *context.SchedulingContext) *SchedulingContext {
 			Name:        qctx.Queue,
 			CurrentCost: sctx.FairnessCostProvider.UnweightedCostFromAllocation(qctx.GetAllocationInclShortJobPenalty()),
 			Fairshare:   qctx.DemandCappedAdjustedFairShare,
-			Weight:      qctx.Weight,
+			Weight:      qctx.GetWeight(),
 		}
 		schedulingContext.Queues[queueContext.Name] = queueContext
 	}
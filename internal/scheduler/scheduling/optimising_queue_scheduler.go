@@ -103,7 +103,7 @@ loop:
 			return nil, fmt.Errorf("unable to find queue scheduling context for queue %s", gctx.Queue)
 		}
 
-		if queueCostInclGang > qctx.DemandCappedAdjustedFairShare/qctx.Weight {
+		if queueCostInclGang > qctx.DemandCappedAdjustedFairShare/qctx.GetWeight() {
 			continue
 		}
 
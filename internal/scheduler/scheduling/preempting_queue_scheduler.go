@@ -144,6 +144,9 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 				}
 
 				if qctx, ok := sch.schedulingContext.QueueSchedulingContexts[job.Queue()]; ok {
+					if evict, reason, hasQuota := quotaEvictionDecision(qctx); hasQuota {
+						return evict, reason
+					}
 					actualShare := sch.schedulingContext.FairnessCostProvider.UnweightedCostFromAllocation(qctx.GetAllocation())
 					fairShare := math.Max(qctx.DemandCappedAdjustedFairShare, qctx.FairShare)
 					if sch.protectUncappedAdjustedFairShare {
@@ -408,11 +411,13 @@ func (sch *PreemptingQueueScheduler) evictGangs(ctx *armadacontext.Context, txn
 }
 
 // Collect job ids for any gangs that were partially evicted and the ids of nodes those jobs are on.
+// Elastic gangs are only evicted in full if fewer than their minimum cardinality of jobs would otherwise keep running.
 func (sch *PreemptingQueueScheduler) collectIdsForGangEviction(evictorResult *EvictorResult) (map[string]bool, map[string]bool, error) {
 	allGangJobIds := make(map[string]bool)
 	gangNodeIds := make(map[string]bool)
 	seenGangs := make(map[string]bool)
-	for jobId := range evictorResult.EvictedJctxsByJobId {
+	numEvictedByGangId := sch.numEvictedJobsByGangId(evictorResult)
+	for jobId, jctx := range evictorResult.EvictedJctxsByJobId {
 		gangId, ok := sch.gangIdByJobId[jobId]
 		if !ok {
 			// Not a gang job.
@@ -431,6 +436,12 @@ func (sch *PreemptingQueueScheduler) collectIdsForGangEviction(evictorResult *Ev
 		if len(gangJobIds) == 0 {
 			return nil, nil, errors.Errorf("no jobs found for gang %s", gangId)
 		}
+		seenGangs[gangId] = true
+
+		// Shrink elastic gangs rather than evicting them if enough of their jobs keep running.
+		if len(gangJobIds)-numEvictedByGangId[gangId] >= jctx.GangInfo.MinimumCardinality {
+			continue
+		}
 
 		// Collect all job ids part of that gang.
 		for gangJobId := range gangJobIds {
@@ -443,15 +454,25 @@ func (sch *PreemptingQueueScheduler) collectIdsForGangEviction(evictorResult *Ev
 				gangNodeIds[nodeId] = true
 			}
 		}
-		seenGangs[gangId] = true
 	}
 	return allGangJobIds, gangNodeIds, nil
 }
 
-// Some jobs in a gang may have terminated since the gang was scheduled.
-// For these gangs, we need to set the gang cardinality to the number of jobs in the gang yet to terminate.
+func (sch *PreemptingQueueScheduler) numEvictedJobsByGangId(evictorResult *EvictorResult) map[string]int {
+	numEvictedByGangId := make(map[string]int)
+	for jobId := range evictorResult.EvictedJctxsByJobId {
+		if gangId, ok := sch.gangIdByJobId[jobId]; ok {
+			numEvictedByGangId[gangId]++
+		}
+	}
+	return numEvictedByGangId
+}
+
+// Some jobs in a gang may have terminated since the gang was scheduled and elastic gangs may be partially evicted.
+// For these gangs, we need to set the gang cardinality to the number of evicted jobs in the gang.
 // Otherwise, the evicted gang jobs will not be schedulable, since some gang jobs will be considered missing.
 func (sch *PreemptingQueueScheduler) setEvictedGangCardinality(evictorResult *EvictorResult) {
+	numEvictedByGangId := sch.numEvictedJobsByGangId(evictorResult)
 	for _, jctx := range evictorResult.EvictedJctxsByJobId {
 		gangId, ok := sch.gangIdByJobId[jctx.Job.Id()]
 		if !ok {
@@ -459,8 +480,10 @@ func (sch *PreemptingQueueScheduler) setEvictedGangCardinality(evictorResult *Ev
 			continue
 		}
 
-		// Override cardinality with the number of evicted jobs in this gang.
-		jctx.GangInfo.Cardinality = len(sch.jobIdsByGangId[gangId])
+		// Override cardinality with the number of evicted jobs in this gang,
+		// and the minimum cardinality with the number of evicted jobs needed to make up for those still running.
+		numEvicted := numEvictedByGangId[gangId]
+		jctx.GangInfo = jctx.GangInfo.ForSubset(numEvicted, len(sch.jobIdsByGangId[gangId])-numEvicted)
 	}
 	return
 }
@@ -517,7 +540,7 @@ func (qr *MinimalQueueRepository) GetQueue(name string) (fairness.Queue, bool) {
 func NewMinimalQueueRepositoryFromSchedulingContext(sctx *schedulercontext.SchedulingContext) *MinimalQueueRepository {
 	queues := make(map[string]MinimalQueue, len(sctx.QueueSchedulingContexts))
 	for name, qctx := range sctx.QueueSchedulingContexts {
-		queues[name] = MinimalQueue{allocation: qctx.Allocated, shortJobPenalty: qctx.ShortJobPenalty, weight: qctx.Weight}
+		queues[name] = MinimalQueue{allocation: qctx.Allocated, shortJobPenalty: qctx.ShortJobPenalty, weight: qctx.GetWeight(), guaranteed: qctx.GuaranteedResources}
 	}
 	return &MinimalQueueRepository{queues: queues}
 }
@@ -526,6 +549,7 @@ type MinimalQueue struct {
 	allocation      internaltypes.ResourceList
 	shortJobPenalty internaltypes.ResourceList
 	weight          float64
+	guaranteed      internaltypes.ResourceList
 }
 
 func (q MinimalQueue) GetAllocation() internaltypes.ResourceList {
@@ -540,6 +564,10 @@ func (q MinimalQueue) GetWeight() float64 {
 	return q.weight
 }
 
+func (q MinimalQueue) GetGuaranteedResources() internaltypes.ResourceList {
+	return q.guaranteed
+}
+
 // addEvictedJobsToNodeDb adds evicted jobs to the NodeDb.
 // Needed to enable the nodeDb accounting for these when preempting.
 func (sch *PreemptingQueueScheduler) addEvictedJobsToNodeDb(_ *armadacontext.Context, inMemoryJobRepo *InMemoryJobRepository) error {
@@ -757,7 +785,11 @@ func (sch *PreemptingQueueScheduler) updateGangAccounting(preempted []*scheduler
 	for _, jctx := range preempted {
 		if gangId, ok := sch.gangIdByJobId[jctx.Job.Id()]; ok {
 			delete(sch.gangIdByJobId, jctx.Job.Id())
-			delete(sch.jobIdsByGangId, gangId)
+			// Elastic gangs may be preempted one job at a time.
+			delete(sch.jobIdsByGangId[gangId], jctx.Job.Id())
+			if len(sch.jobIdsByGangId[gangId]) == 0 {
+				delete(sch.jobIdsByGangId, gangId)
+			}
 		}
 	}
 	for _, jctx := range scheduled {
@@ -13,10 +13,12 @@ import (
 	"golang.org/x/exp/slices"
 	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/logging"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
+	"github.com/armadaproject/armada/internal/common/pointer"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/stringinterner"
 	"github.com/armadaproject/armada/internal/common/types"
@@ -100,6 +102,7 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 		// If this round should run with the optimiser enabled
 		OptimiserEnabled bool
 	}
+	elasticGang := testfixtures.WithElasticGangAnnotationsJobs(testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 64), 16)
 	tests := map[string]struct {
 		SchedulingConfig configuration.SchedulingConfig
 		// Nodes to be considered by the scheduler.
@@ -108,9 +111,111 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 		Rounds []SchedulingRound
 		// Map from queue to the priority factor associated with that queue.
 		PriorityFactorByQueue map[string]float64
+		// Map from queue to the queue it's nested under, if any. Parents without jobs have a priority factor of 1.
+		ParentByQueue map[string]string
+		// Map from queue to the quota of that queue in the pool being scheduled.
+		QuotaByQueue map[string]*api.QueueQuota
 		// Map of nodeId to jobs running on those nodes
 		InitialRunningJobs map[int][]*jobdb.Job
 	}{
+		"balancing nested queues": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A1": testfixtures.N1Cpu4GiJobs("A1", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A1": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A2": testfixtures.N1Cpu4GiJobs("A2", testfixtures.PriorityClass0, 32),
+						"B1": testfixtures.N1Cpu4GiJobs("B1", testfixtures.PriorityClass0, 32),
+					},
+					// Team A and team B get half the node each; the share of team A is split between A1 and A2.
+					ExpectedScheduledIndices: map[string][]int{
+						"A2": testfixtures.IntRange(0, 7),
+						"B1": testfixtures.IntRange(0, 15),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A1": {
+							0: testfixtures.IntRange(8, 31),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A1": 1,
+				"A2": 1,
+				"B1": 1,
+			},
+			ParentByQueue: map[string]string{
+				"A1": "teamA",
+				"A2": "teamA",
+				"B1": "teamB",
+			},
+		},
+		"queue quota without borrowing": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 7),
+					},
+				},
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+			},
+			QuotaByQueue: map[string]*api.QueueQuota{
+				"A": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("8")}},
+			},
+		},
+		"reclaiming resources borrowed beyond queue quota": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 32),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N1Cpu4GiJobs("B", testfixtures.PriorityClass0, 32),
+					},
+					// B gets its guaranteed quota even though that exceeds its fair share.
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 23),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(8, 31),
+						},
+					},
+				},
+				{}, // Empty round to make sure nothing changes.
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+			QuotaByQueue: map[string]*api.QueueQuota{
+				"A": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("8")}, BorrowingEnabled: true},
+				"B": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("24")}},
+			},
+		},
 		"balancing three queues": {
 			SchedulingConfig: testfixtures.TestSchedulingConfig(),
 			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
@@ -687,6 +792,86 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 				"B": 1,
 			},
 		},
+		"elastic gang preemption shrinks the gang": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(2, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					// Schedule an elastic gang spanning nodes 1 and 2.
+					// Make the one job landing on node 2 have priority 0, so it will be urgency-preempted next.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.WithElasticGangAnnotationsJobs(
+							append(testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass1, 32), testfixtures.N1Cpu4GiJobs("A", testfixtures.PriorityClass0, 1)...),
+							16,
+						),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 32),
+					},
+				},
+				{
+					// Schedule a job that requires preempting the one job on node 2.
+					// Assert that only that job is preempted, since the rest of the gang meets its minimum cardinality.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": testfixtures.N32Cpu256GiJobsWithLargeJobToleration("B", testfixtures.PriorityClass1, 1),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 0),
+					},
+					ExpectedPreemptedIndices: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(32, 32),
+						},
+					},
+				},
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
+		"elastic gang grows in later rounds": {
+			SchedulingConfig: testfixtures.TestSchedulingConfig(),
+			Nodes:            testfixtures.N32CpuNodes(2, testfixtures.TestPriorities),
+			Rounds: []SchedulingRound{
+				{
+					// Fill node 1.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"A": testfixtures.N32Cpu256GiJobs("A", testfixtures.PriorityClass0, 1),
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"A": testfixtures.IntRange(0, 0),
+					},
+				},
+				{
+					// Only half of the gang fits.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": elasticGang,
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 31),
+					},
+				},
+				{
+					// Free up node 1; the jobs of the gang still queued are scheduled alongside those running.
+					JobsByQueue: map[string][]*jobdb.Job{
+						"B": elasticGang[32:],
+					},
+					IndicesToUnbind: map[string]map[int][]int{
+						"A": {
+							0: testfixtures.IntRange(0, 0),
+						},
+					},
+					ExpectedScheduledIndices: map[string][]int{
+						"B": testfixtures.IntRange(0, 31),
+					},
+				},
+			},
+			PriorityFactorByQueue: map[string]float64{
+				"A": 1,
+				"B": 1,
+			},
+		},
 		"rescheduled jobs don't count towards global scheduling rate limit": {
 			SchedulingConfig: testfixtures.WithGlobalSchedulingRateLimiterConfig(2, 5, testfixtures.TestSchedulingConfig()),
 			Nodes:            testfixtures.N32CpuNodes(1, testfixtures.TestPriorities),
@@ -2074,6 +2259,15 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 					totalResources,
 				)
 				sctx.Started = schedulingStarted.Add(time.Duration(i) * schedulingInterval)
+				if tc.ParentByQueue != nil {
+					sctx.QueueHierarchy = fairness.NewQueueHierarchy()
+					for _, parent := range tc.ParentByQueue {
+						sctx.QueueHierarchy.AddQueue(parent, "", 1)
+					}
+					for queue, priorityFactor := range tc.PriorityFactorByQueue {
+						sctx.QueueHierarchy.AddQueue(queue, tc.ParentByQueue[queue], 1/priorityFactor)
+					}
+				}
 
 				for queue, priorityFactor := range tc.PriorityFactorByQueue {
 					weight := 1 / priorityFactor
@@ -2096,8 +2290,15 @@ func TestPreemptingQueueScheduler(t *testing.T) {
 					tc.SchedulingConfig,
 					armadaslices.Map(
 						maps.Keys(tc.PriorityFactorByQueue),
-						func(qn string) *api.Queue { return &api.Queue{Name: qn} },
+						func(qn string) *api.Queue {
+							return &api.Queue{Name: qn, QuotaByPool: map[string]*api.QueueQuota{"pool": tc.QuotaByQueue[qn]}}
+						},
 					))
+				for queue := range tc.QuotaByQueue {
+					quota, ok := constraints.GetQueueQuota(queue)
+					require.True(t, ok)
+					sctx.QueueSchedulingContexts[queue].GuaranteedResources = quota.Guaranteed
+				}
 				sctx.UpdateFairShares()
 
 				tc.SchedulingConfig.EnablePreferLargeJobOrdering = true
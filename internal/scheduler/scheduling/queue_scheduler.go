@@ -128,10 +128,10 @@ func (sch *QueueScheduler) Schedule(ctx *armadacontext.Context) (*SchedulerResul
 				if pctx := jctx.PodSchedulingContext; pctx.IsSuccessful() {
 					scheduledJobs = append(scheduledJobs, jctx)
 					nodeIdByJobId[jctx.JobId] = pctx.NodeId
+					scheduledResource = scheduledResource.Add(jctx.Job.AllResourceRequirements())
 				}
 			}
 
-			scheduledResource = scheduledResource.Add(gctx.TotalResourceRequests)
 			if sch.marketDriven && sctx.SpotPrice == nil {
 				totalAllocation := sctx.FairnessCostProvider.UnweightedCostFromAllocation(scheduledResource)
 				if totalAllocation > sch.spotPriceCutoff {
@@ -260,7 +260,7 @@ type QueuedGangIterator struct {
 	schedulingContext  *schedulercontext.SchedulingContext
 	queuedJobsIterator JobContextIterator
 	// Groups jctxs by the gang they belong to.
-	jctxsByGangId map[string][]*schedulercontext.JobSchedulingContext
+	jctxsByGangId map[queuedGangKey][]*schedulercontext.JobSchedulingContext
 	// Maximum number of jobs to look at before giving up.
 	maxLookback uint
 	// If true, do not yield jobs known to be unschedulable.
@@ -276,10 +276,18 @@ func NewQueuedGangIterator(sctx *schedulercontext.SchedulingContext, it JobConte
 		queuedJobsIterator:         it,
 		maxLookback:                maxLookback,
 		skipKnownUnschedulableJobs: skipKnownUnschedulableJobs,
-		jctxsByGangId:              make(map[string][]*schedulercontext.JobSchedulingContext),
+		jctxsByGangId:              make(map[queuedGangKey][]*schedulercontext.JobSchedulingContext),
 	}
 }
 
+// queuedGangKey identifies the jobs of a gang that are yielded together.
+// Evicted and queued jobs of the same gang are yielded separately,
+// since the queued jobs of an elastic gang may be scheduled while some of its jobs are running.
+type queuedGangKey struct {
+	gangId  string
+	evicted bool
+}
+
 func (it *QueuedGangIterator) Next() (*schedulercontext.GangSchedulingContext, error) {
 	if gctx, err := it.Peek(); err != nil {
 		return nil, err
@@ -340,11 +348,12 @@ func (it *QueuedGangIterator) Peek() (*schedulercontext.GangSchedulingContext, e
 			}
 		}
 		if gangId := jctx.GangInfo.Id; gangId != "" {
-			gang := it.jctxsByGangId[gangId]
+			key := queuedGangKey{gangId: gangId, evicted: jctx.IsEvicted}
+			gang := it.jctxsByGangId[key]
 			gang = append(gang, jctx)
-			it.jctxsByGangId[gangId] = gang
+			it.jctxsByGangId[key] = gang
 			if len(gang) == jctx.GangInfo.Cardinality {
-				delete(it.jctxsByGangId, gangId)
+				delete(it.jctxsByGangId, key)
 				it.next = schedulercontext.NewGangSchedulingContext(gang)
 				return it.next, nil
 			}
@@ -414,7 +423,7 @@ func NewCostBasedCandidateGangIterator(
 		if !present {
 			return nil, fmt.Errorf("unable to find queue context for queue %s", queue)
 		}
-		queueBudget := qctx.DemandCappedAdjustedFairShare / qctx.Weight
+		queueBudget := qctx.DemandCappedAdjustedFairShare / qctx.GetWeight()
 		if _, err := it.updateAndPushPQItem(it.newPQItem(queue, queueBudget, queueIt)); err != nil {
 			return nil, err
 		}
@@ -499,6 +508,7 @@ func (it *CostBasedCandidateGangIterator) updatePQItem(item *QueueCandidateGangI
 	item.proposedQueueCost = 0
 	item.currentQueueCost = 0
 	item.itemSize = 0
+	item.withinGuaranteedQuota = false
 	gctx, err := item.it.Peek()
 	if err != nil {
 		return err
@@ -516,6 +526,9 @@ func (it *CostBasedCandidateGangIterator) updatePQItem(item *QueueCandidateGangI
 	// We multiply here, as queue weights are a fraction
 	// So for the same job size, highly weighted queues jobs will look larger
 	item.itemSize = it.fairnessCostProvider.UnweightedCostFromAllocation(gctx.TotalResourceRequests) * queue.GetWeight()
+	if guaranteed := queue.GetGuaranteedResources(); !guaranteed.IsEmpty() {
+		item.withinGuaranteedQuota = !queue.GetAllocation().Add(gctx.TotalResourceRequests).Exceeds(guaranteed)
+	}
 
 	// The PQItem needs to have a priority class priority for the whole gang.  This may not be uniform as different
 	// Gang members may have been scheduled at different priorities due to home/away preemption. We therefore take the
@@ -579,6 +592,8 @@ type QueueCandidateGangIteratorItem struct {
 	// Used to determine which job is larger
 	itemSize              float64
 	priorityClassPriority int32
+	// True if scheduling the top most gang keeps the queue within its guaranteed quota.
+	withinGuaranteedQuota bool
 	// The index of the item in the heap.
 	// maintained by the heap.Interface methods.
 	index int
@@ -595,6 +610,11 @@ func (pq *QueueCandidateGangIteratorPQ) Less(i, j int) bool {
 		return item1.priorityClassPriority > item2.priorityClassPriority
 	}
 
+	// Then let queues within their guaranteed quota go first, such that resources borrowed by other queues are reclaimed.
+	if item1.withinGuaranteedQuota != item2.withinGuaranteedQuota {
+		return item1.withinGuaranteedQuota
+	}
+
 	if pq.prioritiseLargerJobs {
 		if item1.proposedQueueCost <= item1.queueBudget && item2.proposedQueueCost <= item2.queueBudget {
 			// If adding the items results in neither queue exceeding its fairshare
@@ -195,6 +195,7 @@ type FairSchedulingAlgoContext struct {
 	nodeIdByJobId     map[string]string
 	jobIdsByGangId    map[string]map[string]bool
 	gangIdByJobId     map[string]string
+	drainingNodesById map[string]*drainingNode
 	Txn               *jobdb.Txn
 }
 
@@ -252,6 +253,10 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 		return nil, err
 	}
 	healthyExecutors = l.filterCordonedExecutors(ctx, healthyExecutors, executorSettings)
+	nodeDrains, err := l.executorRepository.GetNodeDrains(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	nodes := nodeFactory.FromSchedulerObjectsExecutors(healthyExecutors, func(errMes string) {
 		ctx.Error(errMes)
@@ -281,8 +286,14 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 
 	nodePools := append(currentPool.AwayPools, currentPool.Name)
 
-	nodeDb, err := l.constructNodeDb(currentPoolJobs, otherPoolsJobs,
-		armadaslices.Filter(nodes, func(node *internaltypes.Node) bool { return slices.Contains(nodePools, node.GetPool()) }))
+	poolNodes := armadaslices.Filter(nodes, func(node *internaltypes.Node) bool { return slices.Contains(nodePools, node.GetPool()) })
+	var activeRunIds map[string][]string
+	if len(nodeDrains) > 0 {
+		activeRunIds = activeRunIdsByNodeId(healthyExecutors)
+	}
+	poolNodes, drainingNodesById := markDrainingNodes(poolNodes, nodeDrains, activeRunIds)
+
+	nodeDb, err := l.constructNodeDb(currentPoolJobs, otherPoolsJobs, poolNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +322,7 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 		nodeIdByJobId:     jobSchedulingInfo.nodeIdByJobId,
 		jobIdsByGangId:    jobSchedulingInfo.jobIdsByGangId,
 		gangIdByJobId:     jobSchedulingInfo.gangIdByJobId,
+		drainingNodesById: drainingNodesById,
 		Txn:               txn,
 	}, nil
 }
@@ -500,31 +512,37 @@ func (l *FairSchedulingAlgo) constructSchedulingContext(
 	sctx := schedulercontext.NewSchedulingContext(pool, fairnessCostProvider, l.limiter, totalCapacity)
 	constraints := schedulerconstraints.NewSchedulingConstraints(pool, totalCapacity, l.schedulingConfig, maps.Values(queues))
 
+	// Inactive queues are part of the hierarchy too, since their active descendants inherit their share.
+	queueHierarchy := fairness.NewQueueHierarchy()
+	for _, queue := range queues {
+		weight, _, err := l.queueWeights(pool, queue)
+		if err != nil {
+			return nil, err
+		}
+		queueHierarchy.AddQueue(queue.Name, queue.Parent, weight)
+	}
+	sctx.QueueHierarchy = queueHierarchy
+
 	for _, queue := range queues {
 		demand, hasDemand := demandByQueueAndPriorityClass[queue.Name]
 		if !hasDemand {
 			// To ensure fair share is computed only from active queues, i.e., queues with jobs queued or running.
 			continue
 		}
-		constrainedDemand := constraints.CapResources(queue.Name, demand)
+		constrainedDemand := internaltypes.RlMapSumValues(constraints.CapResources(queue.Name, demand))
+		quota, hasQuota := constraints.GetQueueQuota(queue.Name)
+		if hasQuota && !quota.BorrowingEnabled {
+			constrainedDemand = constrainedDemand.Cap(quota.Guaranteed)
+		}
 
 		var allocatedByPriorityClass map[string]internaltypes.ResourceList
 		if allocationByQueueAndPriorityClass != nil {
 			allocatedByPriorityClass = allocationByQueueAndPriorityClass[queue.Name]
 		}
-		var rawWeight float64 = 1
-		if queue.PriorityFactor > 0 {
-			rawWeight = 1 / queue.PriorityFactor
-		}
-
-		weight := rawWeight
-		overridePriority, ok, err := l.queueOverrideProvider.Override(pool, queue.Name)
+		weight, rawWeight, err := l.queueWeights(pool, queue)
 		if err != nil {
 			return nil, err
 		}
-		if ok && overridePriority > 0 {
-			weight = 1 / overridePriority
-		}
 
 		queueLimiter, ok := l.limiterByQueue[queue.Name]
 		if !ok {
@@ -535,9 +553,12 @@ func (l *FairSchedulingAlgo) constructSchedulingContext(
 			l.limiterByQueue[queue.Name] = queueLimiter
 		}
 
-		if err := sctx.AddQueueSchedulingContext(queue.Name, weight, rawWeight, allocatedByPriorityClass, internaltypes.RlMapSumValues(demand), internaltypes.RlMapSumValues(constrainedDemand), shortJobPenaltyByQueue[queue.Name], queueLimiter); err != nil {
+		if err := sctx.AddQueueSchedulingContext(queue.Name, weight, rawWeight, allocatedByPriorityClass, internaltypes.RlMapSumValues(demand), constrainedDemand, shortJobPenaltyByQueue[queue.Name], queueLimiter); err != nil {
 			return nil, err
 		}
+		if hasQuota {
+			sctx.QueueSchedulingContexts[queue.Name].GuaranteedResources = quota.Guaranteed
+		}
 	}
 
 	for _, queue := range queues {
@@ -547,18 +568,10 @@ func (l *FairSchedulingAlgo) constructSchedulingContext(
 			continue
 		}
 
-		var rawWeight float64 = 1
-		if queue.PriorityFactor > 0 {
-			rawWeight = 1 / queue.PriorityFactor
-		}
-		weight := rawWeight
-		overridePriority, ok, err := l.queueOverrideProvider.Override(pool, queue.Name)
+		weight, rawWeight, err := l.queueWeights(pool, queue)
 		if err != nil {
 			return nil, err
 		}
-		if ok && overridePriority > 0 {
-			weight = 1 / overridePriority
-		}
 
 		if err := sctx.AddQueueSchedulingContext(schedulercontext.CalculateAwayQueueName(queue.Name), weight, rawWeight, allocation, internaltypes.ResourceList{}, internaltypes.ResourceList{}, awayShortJobPenaltyByQueue[queue.Name], nil); err != nil {
 			return nil, err
@@ -570,6 +583,24 @@ func (l *FairSchedulingAlgo) constructSchedulingContext(
 	return sctx, nil
 }
 
+// queueWeights returns the weight of a queue in the given pool, taking into account any priority override,
+// and its raw weight, which doesn't.
+func (l *FairSchedulingAlgo) queueWeights(pool string, queue *api.Queue) (float64, float64, error) {
+	var rawWeight float64 = 1
+	if queue.PriorityFactor > 0 {
+		rawWeight = 1 / queue.PriorityFactor
+	}
+	weight := rawWeight
+	overridePriority, ok, err := l.queueOverrideProvider.Override(pool, queue.Name)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ok && overridePriority > 0 {
+		weight = 1 / overridePriority
+	}
+	return weight, rawWeight, nil
+}
+
 // SchedulePool schedules jobs on nodes that belong to a given pool.
 func (l *FairSchedulingAlgo) SchedulePool(
 	ctx *armadacontext.Context,
@@ -631,6 +662,9 @@ func (l *FairSchedulingAlgo) SchedulePool(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := preemptJobsOnDrainingNodes(fsctx, result); err != nil {
+		return nil, nil, err
+	}
 	for i, jctx := range result.PreemptedJobs {
 		jobDbJob := jctx.Job
 		now := l.clock.Now()
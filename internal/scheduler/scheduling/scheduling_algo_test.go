@@ -50,6 +50,7 @@ func TestSchedule(t *testing.T) {
 		schedulingConfig configuration.SchedulingConfig
 
 		executors  []*schedulerobjects.Executor
+		nodeDrains []*schedulerobjects.NodeDrain
 		queues     []*api.Queue
 		queuedJobs []*jobdb.Job
 
@@ -446,6 +447,67 @@ func TestSchedule(t *testing.T) {
 			queuedJobs:               testfixtures.WithGangAnnotationsJobs(testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 4)),
 			expectedScheduledIndices: []int{0, 1, 2, 3},
 		},
+		"no scheduling onto drained node": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				test1Node32CoreExecutor("executor1"),
+				test1Node32CoreExecutor("executor2"),
+			},
+			nodeDrains: []*schedulerobjects.NodeDrain{
+				{ExecutorId: "executor1", NodeName: "executor1-node", Reason: "maintenance"},
+			},
+			queues:                   []*api.Queue{testfixtures.MakeTestQueue()},
+			queuedJobs:               testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10),
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"drained node with preemption preempts preemptible jobs": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				test1Node32CoreExecutor("executor1"),
+			},
+			nodeDrains: []*schedulerobjects.NodeDrain{
+				{ExecutorId: "executor1", NodeName: "executor1-node", Preempt: true, Reason: "maintenance"},
+			},
+			queues: []*api.Queue{testfixtures.MakeTestQueue()},
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs: append(
+							testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 1),
+							testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 1)...,
+						),
+						acknowledged: true,
+					},
+				},
+			},
+			expectedPreemptedJobIndicesByExecutorIndexAndNodeIndex: map[int]map[int][]int{
+				0: {
+					0: {0},
+				},
+			},
+			expectedScheduledIndices: []int{},
+		},
+		"drained node without preemption leaves jobs running": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				test1Node32CoreExecutor("executor1"),
+				test1Node32CoreExecutor("executor2"),
+			},
+			nodeDrains: []*schedulerobjects.NodeDrain{
+				{ExecutorId: "executor1", NodeSelector: map[string]string{testfixtures.ClusterNameLabel: "executor1"}, Reason: "maintenance"},
+			},
+			queues: []*api.Queue{testfixtures.MakeTestQueue()},
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs:         testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass0, 1),
+						acknowledged: true,
+					},
+				},
+			},
+			queuedJobs:               testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10),
+			expectedScheduledIndices: []int{0, 1},
+		},
 		"scheduling from paused queue": {
 			schedulingConfig: testfixtures.TestSchedulingConfig(),
 			executors: []*schedulerobjects.Executor{
@@ -506,6 +568,7 @@ func TestSchedule(t *testing.T) {
 			mockExecutorRepo := schedulermocks.NewMockExecutorRepository(ctrl)
 			mockExecutorRepo.EXPECT().GetExecutors(ctx).Return(tc.executors, nil).AnyTimes()
 			mockExecutorRepo.EXPECT().GetExecutorSettings(ctx).Return(defaultExecutorSettings, nil).AnyTimes()
+			mockExecutorRepo.EXPECT().GetNodeDrains(ctx).Return(tc.nodeDrains, nil).AnyTimes()
 			mockQueueCache := schedulermocks.NewMockQueueCache(ctrl)
 			mockQueueCache.EXPECT().GetAll(ctx).Return(tc.queues, nil).AnyTimes()
 
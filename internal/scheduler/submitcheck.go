@@ -327,7 +327,7 @@ poolStart:
 				sb.WriteString(
 					fmt.Sprintf(
 						": %d out of %d pods schedulable (minCardinality %d)\n",
-						numSuccessfullyScheduled, len(gctx.JobSchedulingContexts), gctx.GangInfo.Cardinality,
+						numSuccessfullyScheduled, len(gctx.JobSchedulingContexts), gctx.MinimumCardinality(),
 					),
 				)
 			}
@@ -467,6 +467,22 @@ func WithGangAnnotationsJobs(jobs []*jobdb.Job) []*jobdb.Job {
 	)
 }
 
+func WithElasticGangAnnotationsJobs(jobs []*jobdb.Job, minimumCardinality int) []*jobdb.Job {
+	jobs = WithAnnotationsJobs(
+		map[string]string{configuration.GangMinimumCardinalityAnnotation: fmt.Sprintf("%d", minimumCardinality)},
+		WithGangAnnotationsJobs(jobs),
+	)
+	// Re-create the gang info stored with each job, since elastic gangs are looked up by gang id in the jobDb.
+	for i, job := range jobs {
+		job, err := job.WithJobSchedulingInfo(job.JobSchedulingInfo())
+		if err != nil {
+			panic(err)
+		}
+		jobs[i] = job
+	}
+	return jobs
+}
+
 func WithPools(jobs []*jobdb.Job, pools []string) []*jobdb.Job {
 	result := make([]*jobdb.Job, 0, len(jobs))
 	for _, job := range jobs {
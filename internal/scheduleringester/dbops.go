@@ -76,6 +76,22 @@ type ExecutorSettingsDelete struct {
 	ExecutorID string
 }
 
+// NodeDrainKey identifies a node drain. Exactly one of NodeName and NodeSelector is non-empty;
+// NodeSelector is stored in its canonical form as produced by labels.Set.String.
+type NodeDrainKey struct {
+	ExecutorID   string
+	NodeName     string
+	NodeSelector string
+}
+
+type NodeDrainUpsert struct {
+	NodeDrainKey
+	Preempt   bool
+	Reason    string
+	SetByUser string
+	SetAtTime time.Time
+}
+
 type PreemptOnExecutor struct {
 	Name            string
 	Queues          []string
@@ -120,8 +136,8 @@ type CancelOnQueue struct {
 // - Job run operations: if not affecting a run defined in a prior op.
 // - Control Plane Operations: if not conflicting with prior op.
 //
-// In addition, UpdateJobPriorities can never be applied beforee UpdateJobSetPriorities
-// and vice versa, since one may overwrite values set by the other.
+// In addition, UpdateJobPriorities, UpdateJobArrayPriorities and UpdateJobSetPriorities can never be applied
+// before one another, since one may overwrite values set by the other.
 type DbOperation interface {
 	// a.Merge(b) attempts to merge b into a, creating a single combined op.
 	// Returns true if merging was successful.
@@ -178,6 +194,10 @@ type (
 		cancelUser string
 		jobIds     map[JobSetKey][]string
 	}
+	MarkJobArraysCancelRequested struct {
+		cancelUser string
+		arrayIds   map[JobSetKey][]string
+	}
 	MarkJobsCancelled              map[string]time.Time
 	MarkJobsSucceeded              map[string]bool
 	MarkJobsFailed                 map[string]bool
@@ -194,6 +214,10 @@ type (
 		key    JobReprioritiseKey
 		jobIds []string
 	}
+	UpdateJobArrayPriorities struct {
+		key      JobReprioritiseKey
+		arrayIds []string
+	}
 	MarkJobsValidated     map[string][]string
 	InsertPartitionMarker struct {
 		markers []*schedulerdb.Marker
@@ -202,6 +226,8 @@ type (
 	UpsertExecutorSettings map[string]*ExecutorSettingsUpsert
 	DeleteExecutorSettings map[string]*ExecutorSettingsDelete
 	PreemptExecutor        map[string]*PreemptOnExecutor
+	UpsertNodeDrains       map[NodeDrainKey]*NodeDrainUpsert
+	DeleteNodeDrains       map[NodeDrainKey]bool
 	CancelExecutor         map[string]*CancelOnExecutor
 	PreemptQueue           map[string]*PreemptOnQueue
 	CancelQueue            map[string]*CancelOnQueue
@@ -263,6 +289,20 @@ func (a MarkJobsCancelRequested) Merge(b DbOperation) bool {
 	return false
 }
 
+func (a MarkJobArraysCancelRequested) Merge(b DbOperation) bool {
+	switch op := b.(type) {
+	case MarkJobArraysCancelRequested:
+		if a.cancelUser != op.cancelUser {
+			return false
+		}
+		for k, v := range op.arrayIds {
+			a.arrayIds[k] = append(a.arrayIds[k], v...)
+		}
+		return true
+	}
+	return false
+}
+
 func (a MarkRunsForJobPreemptRequested) Merge(b DbOperation) bool {
 	return mergeListMaps(a, b)
 }
@@ -326,6 +366,17 @@ func (a *UpdateJobPriorities) Merge(b DbOperation) bool {
 	return false
 }
 
+func (a *UpdateJobArrayPriorities) Merge(b DbOperation) bool {
+	switch op := b.(type) {
+	case *UpdateJobArrayPriorities:
+		if a.key == op.key {
+			a.arrayIds = append(a.arrayIds, op.arrayIds...)
+			return true
+		}
+	}
+	return false
+}
+
 func (a MarkRunsSucceeded) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
@@ -371,6 +422,14 @@ func (a DeleteExecutorSettings) Merge(_ DbOperation) bool {
 	return false
 }
 
+func (a UpsertNodeDrains) Merge(_ DbOperation) bool {
+	return false
+}
+
+func (a DeleteNodeDrains) Merge(_ DbOperation) bool {
+	return false
+}
+
 func (pe PreemptExecutor) Merge(_ DbOperation) bool {
 	return false
 }
@@ -458,7 +517,8 @@ func (a InsertRuns) CanBeAppliedBefore(b DbOperation) bool {
 
 func (a UpdateJobSetPriorities) CanBeAppliedBefore(b DbOperation) bool {
 	_, isUpdateJobPriorities := b.(*UpdateJobPriorities)
-	return !isUpdateJobPriorities && !definesJobInSet(a, b)
+	_, isUpdateJobArrayPriorities := b.(*UpdateJobArrayPriorities)
+	return !isUpdateJobPriorities && !isUpdateJobArrayPriorities && !definesJobInSet(a, b)
 }
 
 func (a MarkJobSetsCancelRequested) CanBeAppliedBefore(b DbOperation) bool {
@@ -469,6 +529,10 @@ func (a MarkJobsCancelRequested) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJobInSet(a.jobIds, b) && !definesRunInSet(a.jobIds, b)
 }
 
+func (a MarkJobArraysCancelRequested) CanBeAppliedBefore(b DbOperation) bool {
+	return !definesJobInSet(a.arrayIds, b) && !definesRunInSet(a.arrayIds, b)
+}
+
 func (a MarkRunsForJobPreemptRequested) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJobInSet(a, b) && !definesRunInSet(a, b)
 }
@@ -496,7 +560,17 @@ func (a UpdateJobQueuedState) CanBeAppliedBefore(b DbOperation) bool {
 func (a *UpdateJobPriorities) CanBeAppliedBefore(b DbOperation) bool {
 	_, isUpdateJobSetPriorities := b.(UpdateJobSetPriorities)
 	_, isUpdateJobPriorities := b.(*UpdateJobPriorities)
-	return !isUpdateJobPriorities && !isUpdateJobSetPriorities &&
+	_, isUpdateJobArrayPriorities := b.(*UpdateJobArrayPriorities)
+	return !isUpdateJobPriorities && !isUpdateJobSetPriorities && !isUpdateJobArrayPriorities &&
+		!definesJobInSet(map[JobSetKey]bool{a.key.JobSetKey: true}, b) &&
+		!definesRunInSet(map[JobSetKey]bool{a.key.JobSetKey: true}, b)
+}
+
+func (a *UpdateJobArrayPriorities) CanBeAppliedBefore(b DbOperation) bool {
+	_, isUpdateJobSetPriorities := b.(UpdateJobSetPriorities)
+	_, isUpdateJobPriorities := b.(*UpdateJobPriorities)
+	_, isUpdateJobArrayPriorities := b.(*UpdateJobArrayPriorities)
+	return !isUpdateJobPriorities && !isUpdateJobSetPriorities && !isUpdateJobArrayPriorities &&
 		!definesJobInSet(map[JobSetKey]bool{a.key.JobSetKey: true}, b) &&
 		!definesRunInSet(map[JobSetKey]bool{a.key.JobSetKey: true}, b)
 }
@@ -562,6 +636,32 @@ func (a DeleteExecutorSettings) CanBeAppliedBefore(b DbOperation) bool {
 	return true
 }
 
+// Can be applied before another operation only if it relates to a different executor
+func (a UpsertNodeDrains) CanBeAppliedBefore(b DbOperation) bool {
+	switch op := b.(type) {
+	case executorOperation:
+		for key := range a {
+			if affectsExecutor := op.affectsExecutor(key.ExecutorID); affectsExecutor {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Can be applied before another operation only if it relates to a different executor
+func (a DeleteNodeDrains) CanBeAppliedBefore(b DbOperation) bool {
+	switch op := b.(type) {
+	case executorOperation:
+		for key := range a {
+			if affectsExecutor := op.affectsExecutor(key.ExecutorID); affectsExecutor {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (pe PreemptExecutor) CanBeAppliedBefore(b DbOperation) bool {
 	switch op := b.(type) {
 	case executorOperation:
@@ -698,6 +798,10 @@ func (a MarkJobsCancelled) GetOperation() Operation {
 	return JobSetOperation
 }
 
+func (a MarkJobArraysCancelRequested) GetOperation() Operation {
+	return JobSetOperation
+}
+
 func (a MarkJobsSucceeded) GetOperation() Operation {
 	return JobSetOperation
 }
@@ -710,6 +814,10 @@ func (a *UpdateJobPriorities) GetOperation() Operation {
 	return JobSetOperation
 }
 
+func (a *UpdateJobArrayPriorities) GetOperation() Operation {
+	return JobSetOperation
+}
+
 func (a MarkRunsSucceeded) GetOperation() Operation {
 	return JobSetOperation
 }
@@ -750,6 +858,14 @@ func (a DeleteExecutorSettings) GetOperation() Operation {
 	return ControlPlaneOperation
 }
 
+func (a UpsertNodeDrains) GetOperation() Operation {
+	return ControlPlaneOperation
+}
+
+func (a DeleteNodeDrains) GetOperation() Operation {
+	return ControlPlaneOperation
+}
+
 func (pe PreemptExecutor) GetOperation() Operation {
 	return ControlPlaneOperation
 }
@@ -780,6 +896,24 @@ func (a DeleteExecutorSettings) affectsExecutor(executor string) bool {
 	return ok
 }
 
+func (a UpsertNodeDrains) affectsExecutor(executor string) bool {
+	for key := range a {
+		if key.ExecutorID == executor {
+			return true
+		}
+	}
+	return false
+}
+
+func (a DeleteNodeDrains) affectsExecutor(executor string) bool {
+	for key := range a {
+		if key.ExecutorID == executor {
+			return true
+		}
+	}
+	return false
+}
+
 func (pe PreemptExecutor) affectsExecutor(executor string) bool {
 	_, ok := pe[executor]
 	return ok
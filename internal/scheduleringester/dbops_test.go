@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
 
 	f "github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	"github.com/armadaproject/armada/internal/common/util"
@@ -143,6 +144,10 @@ func TestDbOperationOptimisation(t *testing.T) {
 	for i := range runIds {
 		runIds[i] = uuid.NewString()
 	}
+	arrayIds := make([]string, 2)
+	for i := range arrayIds {
+		arrayIds[i] = util.NewULID()
+	}
 	tests := map[string]struct {
 		N   int           // Expected number of ops after optimisation.
 		Ops []DbOperation // Ops sequence to optimise.
@@ -178,6 +183,14 @@ func TestDbOperationOptimisation(t *testing.T) {
 			&UpdateJobPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 4}, []string{jobIds[1]}}, // 5
 			&UpdateJobPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 4}, []string{jobIds[2]}}, // 5
 		}},
+		"UpdateJobPriorities, UpdateJobArrayPriorities": {N: 4, Ops: []DbOperation{
+			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]}},   // 1
+			InsertJobs{jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[1]}},   // 1
+			&UpdateJobArrayPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 1}, []string{arrayIds[0]}}, // 2
+			&UpdateJobPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 2}, []string{jobIds[0]}},        // 3
+			&UpdateJobArrayPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 3}, []string{arrayIds[1]}}, // 4
+			&UpdateJobArrayPriorities{JobReprioritiseKey{JobSetKey{queue: testQueueName, jobSet: "set1"}, 3}, []string{arrayIds[0]}}, // 4
+		}},
 		"MarkJobSetsCancelRequested": {N: 3, Ops: []DbOperation{
 			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1"}}, // 1
 			MarkJobSetsCancelRequested{
@@ -224,6 +237,22 @@ func TestDbOperationOptimisation(t *testing.T) {
 				},
 			}, // 4
 		}},
+		"MarkJobArraysCancelRequested": {N: 2, Ops: []DbOperation{
+			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]}}, // 1
+			MarkJobArraysCancelRequested{
+				cancelUser: f.CancelUser,
+				arrayIds: map[JobSetKey][]string{
+					{queue: testQueueName, jobSet: "set1"}: {arrayIds[0]},
+				},
+			}, // 2
+			InsertJobs{jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[1]}}, // 1
+			MarkJobArraysCancelRequested{
+				cancelUser: f.CancelUser,
+				arrayIds: map[JobSetKey][]string{
+					{queue: testQueueName, jobSet: "set1"}: {arrayIds[1]},
+				},
+			}, // 2
+		}},
 		"MarkRunsForJobPreemptRequested": {N: 2, Ops: []DbOperation{
 			InsertJobs{jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1"}},      // 1
 			InsertJobs{jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1"}},      // 1
@@ -498,6 +527,16 @@ func (db *mockDb) apply(op DbOperation) error {
 				}
 			}
 		}
+	case MarkJobArraysCancelRequested:
+		for jobSetKey, arrayIds := range o.arrayIds {
+			for _, job := range db.Jobs {
+				if job.JobSet == jobSetKey.jobSet && job.Queue == jobSetKey.queue &&
+					job.ArrayID != nil && slices.Contains(arrayIds, *job.ArrayID) {
+					job.CancelRequested = true
+					job.CancelUser = &o.cancelUser
+				}
+			}
+		}
 	case MarkJobsSucceeded:
 		for jobId := range o {
 			if job, ok := db.Jobs[jobId]; ok {
@@ -524,6 +563,13 @@ func (db *mockDb) apply(op DbOperation) error {
 				job.Priority = o.key.Priority
 			}
 		}
+	case *UpdateJobArrayPriorities:
+		for _, job := range db.Jobs {
+			if job.JobSet == o.key.jobSet && job.Queue == o.key.queue &&
+				job.ArrayID != nil && slices.Contains(o.arrayIds, *job.ArrayID) {
+				job.Priority = o.key.Priority
+			}
+		}
 	case MarkRunsSucceeded:
 		for runId := range o {
 			if run, ok := db.Runs[runId]; ok {
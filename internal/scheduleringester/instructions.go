@@ -9,9 +9,11 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
 	"github.com/armadaproject/armada/internal/common/ingest/utils"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -101,10 +103,14 @@ func (c *JobSetEventsInstructionConverter) dbOperationsFromEventSequence(es *arm
 			operationsFromEvent, err = c.handleReprioritiseJob(event.GetReprioritiseJob(), meta)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet:
 			operationsFromEvent, err = c.handleReprioritiseJobSet(event.GetReprioritiseJobSet(), meta)
+		case *armadaevents.EventSequence_Event_ReprioritiseJobArray:
+			operationsFromEvent, err = c.handleReprioritiseJobArray(event.GetReprioritiseJobArray(), meta)
 		case *armadaevents.EventSequence_Event_CancelJob:
 			operationsFromEvent, err = c.handleCancelJob(event.GetCancelJob(), meta)
 		case *armadaevents.EventSequence_Event_CancelJobSet:
 			operationsFromEvent, err = c.handleCancelJobSet(event.GetCancelJobSet(), meta)
+		case *armadaevents.EventSequence_Event_CancelJobArray:
+			operationsFromEvent, err = c.handleCancelJobArray(event.GetCancelJobArray(), meta)
 		case *armadaevents.EventSequence_Event_CancelledJob:
 			operationsFromEvent, err = c.handleCancelledJob(event.GetCancelledJob(), eventTime)
 		case *armadaevents.EventSequence_Event_JobRequeued:
@@ -120,6 +126,8 @@ func (c *JobSetEventsInstructionConverter) dbOperationsFromEventSequence(es *arm
 		case *armadaevents.EventSequence_Event_ReprioritisedJob,
 			*armadaevents.EventSequence_Event_ResourceUtilisation,
 			*armadaevents.EventSequence_Event_JobRunCancelled,
+			*armadaevents.EventSequence_Event_JobDeferralEnded,
+			*armadaevents.EventSequence_Event_JobRunPreemptionNotice,
 			*armadaevents.EventSequence_Event_StandaloneIngressInfo:
 			// These events can all be safely ignored
 			log.Debugf("Ignoring event type %T", event)
@@ -138,6 +146,29 @@ func (c *JobSetEventsInstructionConverter) dbOperationsFromEventSequence(es *arm
 }
 
 func (c *JobSetEventsInstructionConverter) handleSubmitJob(job *armadaevents.SubmitJob, submitTime time.Time, meta eventSequenceCommon) ([]DbOperation, error) {
+	compressedGroups, err := compress.CompressStringArray(meta.groups, c.compressor)
+	if err != nil {
+		return nil, err
+	}
+
+	// A job array is stored as one row per element, each of which is scheduled independently.
+	var arrayId *string
+	if job.ArraySize > 0 {
+		arrayId = &job.JobId
+	}
+	jobs := InsertJobs{}
+	for _, element := range eventutil.ExpandJobArray(job) {
+		dbJob, err := c.dbJobFromSubmitJob(element, submitTime, meta, compressedGroups)
+		if err != nil {
+			return nil, err
+		}
+		dbJob.ArrayID = arrayId
+		jobs[dbJob.JobID] = dbJob
+	}
+	return []DbOperation{jobs}, nil
+}
+
+func (c *JobSetEventsInstructionConverter) dbJobFromSubmitJob(job *armadaevents.SubmitJob, submitTime time.Time, meta eventSequenceCommon, compressedGroups []byte) (*schedulerdb.Job, error) {
 	jobId := job.JobId
 
 	// Store the job submit message so that it can be sent to an executor.
@@ -161,10 +192,6 @@ func (c *JobSetEventsInstructionConverter) handleSubmitJob(job *armadaevents.Sub
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	compressedGroups, err := compress.CompressStringArray(meta.groups, c.compressor)
-	if err != nil {
-		return nil, err
-	}
 
 	pricingBand := int32(0)
 	if job.ObjectMeta != nil {
@@ -178,7 +205,7 @@ func (c *JobSetEventsInstructionConverter) handleSubmitJob(job *armadaevents.Sub
 		}
 	}
 
-	return []DbOperation{InsertJobs{jobId: &schedulerdb.Job{
+	return &schedulerdb.Job{
 		JobID:                 jobId,
 		JobSet:                meta.jobset,
 		UserID:                meta.user,
@@ -192,7 +219,7 @@ func (c *JobSetEventsInstructionConverter) handleSubmitJob(job *armadaevents.Sub
 		SchedulingInfo:        schedulingInfoBytes,
 		SchedulingInfoVersion: int32(schedulingInfo.Version),
 		PriceBand:             pricingBand,
-	}}}, nil
+	}, nil
 }
 
 func (c *JobSetEventsInstructionConverter) handleJobRunLeased(jobRunLeased *armadaevents.JobRunLeased, eventTime time.Time, meta eventSequenceCommon) ([]DbOperation, error) {
@@ -338,6 +365,19 @@ func (c *JobSetEventsInstructionConverter) handleReprioritiseJob(reprioritiseJob
 	}}, nil
 }
 
+func (c *JobSetEventsInstructionConverter) handleReprioritiseJobArray(reprioritiseJobArray *armadaevents.ReprioritiseJobArray, meta eventSequenceCommon) ([]DbOperation, error) {
+	return []DbOperation{&UpdateJobArrayPriorities{
+		key: JobReprioritiseKey{
+			JobSetKey: JobSetKey{
+				queue:  meta.queue,
+				jobSet: meta.jobset,
+			},
+			Priority: int64(reprioritiseJobArray.Priority),
+		},
+		arrayIds: []string{reprioritiseJobArray.ArrayId},
+	}}, nil
+}
+
 func (c *JobSetEventsInstructionConverter) handleReprioritiseJobSet(reprioritiseJobSet *armadaevents.ReprioritiseJobSet, meta eventSequenceCommon) ([]DbOperation, error) {
 	return []DbOperation{UpdateJobSetPriorities{
 		JobSetKey{queue: meta.queue, jobSet: meta.jobset}: int64(reprioritiseJobSet.Priority),
@@ -356,6 +396,18 @@ func (c *JobSetEventsInstructionConverter) handleCancelJob(cancelJob *armadaeven
 	}}, nil
 }
 
+func (c *JobSetEventsInstructionConverter) handleCancelJobArray(cancelJobArray *armadaevents.CancelJobArray, meta eventSequenceCommon) ([]DbOperation, error) {
+	return []DbOperation{MarkJobArraysCancelRequested{
+		cancelUser: meta.user,
+		arrayIds: map[JobSetKey][]string{
+			{
+				queue:  meta.queue,
+				jobSet: meta.jobset,
+			}: {cancelJobArray.ArrayId},
+		},
+	}}, nil
+}
+
 func (c *JobSetEventsInstructionConverter) handleCancelJobSet(cancelJobSet *armadaevents.CancelJobSet, meta eventSequenceCommon) ([]DbOperation, error) {
 	cancelQueued := len(cancelJobSet.States) == 0 || slices.Contains(cancelJobSet.States, armadaevents.JobState_QUEUED)
 	cancelLeased := len(cancelJobSet.States) == 0 || slices.Contains(cancelJobSet.States, armadaevents.JobState_PENDING) || slices.Contains(cancelJobSet.States, armadaevents.JobState_RUNNING)
@@ -431,6 +483,11 @@ func (c *ControlPlaneEventsInstructionConverter) dbOperationFromControlPlaneEven
 		operations, err = c.handleExecutorSettingsUpsert(event.GetExecutorSettingsUpsert(), eventTime)
 	case *controlplaneevents.Event_ExecutorSettingsDelete:
 		operations, err = c.handleExecutorSettingsDelete(event.GetExecutorSettingsDelete())
+	case *controlplaneevents.Event_NodeDrainUpsert:
+		eventTime := protoutil.ToStdTime(event.Created)
+		operations, err = c.handleNodeDrainUpsert(event.GetNodeDrainUpsert(), eventTime)
+	case *controlplaneevents.Event_NodeDrainDelete:
+		operations, err = c.handleNodeDrainDelete(event.GetNodeDrainDelete())
 	case *controlplaneevents.Event_PreemptOnExecutor:
 		operations, err = c.handlePreemptOnExecutor(event.GetPreemptOnExecutor())
 	case *controlplaneevents.Event_CancelOnExecutor:
@@ -474,6 +531,34 @@ func (c *ControlPlaneEventsInstructionConverter) handleExecutorSettingsDelete(de
 	}, nil
 }
 
+func (c *ControlPlaneEventsInstructionConverter) handleNodeDrainUpsert(upsert *controlplaneevents.NodeDrainUpsert, setAtTime time.Time) ([]DbOperation, error) {
+	key := NodeDrainKey{
+		ExecutorID:   upsert.Name,
+		NodeName:     upsert.NodeName,
+		NodeSelector: labels.Set(upsert.NodeSelector).String(),
+	}
+	return []DbOperation{
+		UpsertNodeDrains{
+			key: &NodeDrainUpsert{
+				NodeDrainKey: key,
+				Preempt:      upsert.Preempt,
+				Reason:       upsert.Reason,
+				SetByUser:    upsert.SetByUser,
+				SetAtTime:    setAtTime,
+			},
+		},
+	}, nil
+}
+
+func (c *ControlPlaneEventsInstructionConverter) handleNodeDrainDelete(delete *controlplaneevents.NodeDrainDelete) ([]DbOperation, error) {
+	key := NodeDrainKey{
+		ExecutorID:   delete.Name,
+		NodeName:     delete.NodeName,
+		NodeSelector: labels.Set(delete.NodeSelector).String(),
+	}
+	return []DbOperation{DeleteNodeDrains{key: true}}, nil
+}
+
 func (c *ControlPlaneEventsInstructionConverter) handlePreemptOnExecutor(preempt *controlplaneevents.PreemptOnExecutor) ([]DbOperation, error) {
 	return []DbOperation{
 		PreemptExecutor{
@@ -530,13 +615,18 @@ func (c *JobSetEventsInstructionConverter) schedulingInfoFromSubmitJob(submitJob
 func SchedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime time.Time) (*schedulerobjects.JobSchedulingInfo, error) {
 	// Component common to all jobs.
 	schedulingInfo := &schedulerobjects.JobSchedulingInfo{
-		Lifetime:        submitJob.Lifetime,
-		AtMostOnce:      submitJob.AtMostOnce,
-		Preemptible:     submitJob.Preemptible,
-		ConcurrencySafe: submitJob.ConcurrencySafe,
-		SubmitTime:      protoutil.ToTimestamp(submitTime),
-		Priority:        submitJob.Priority,
-		Version:         0,
+		Lifetime:                submitJob.Lifetime,
+		AtMostOnce:              submitJob.AtMostOnce,
+		Preemptible:             submitJob.Preemptible,
+		ConcurrencySafe:         submitJob.ConcurrencySafe,
+		SubmitTime:              protoutil.ToTimestamp(submitTime),
+		Priority:                submitJob.Priority,
+		Version:                 0,
+		Dependencies:            submitJob.Dependencies,
+		DependencyFailurePolicy: schedulerobjects.DependencyFailurePolicy(submitJob.DependencyFailurePolicy),
+		NotBefore:               submitJob.NotBefore,
+		QueueTtlSeconds:         submitJob.QueueTtlSeconds,
+		RetryPolicy:             submitJob.RetryPolicy,
 	}
 
 	// Scheduling requirements specific to the objects that make up this job.
@@ -547,6 +637,8 @@ func SchedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime t
 		podRequirements := adapters.PodRequirementsFromPodSpec(podSpec)
 		if submitJob.ObjectMeta != nil {
 			podRequirements.Annotations = maps.Clone(submitJob.ObjectMeta.Annotations)
+			podRequirements.Labels = maps.Clone(submitJob.ObjectMeta.Labels)
+			podRequirements.Namespace = submitJob.ObjectMeta.Namespace
 		}
 		if submitJob.MainObject.ObjectMeta != nil {
 			if podRequirements.Annotations == nil {
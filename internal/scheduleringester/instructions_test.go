@@ -5,12 +5,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
 	f "github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
@@ -137,6 +139,18 @@ func TestConvertEventSequence(t *testing.T) {
 				},
 			},
 		},
+		"reprioritise job array": {
+			events: []*armadaevents.EventSequence_Event{f.JobArrayReprioritiseRequested},
+			expected: []DbOperation{
+				&UpdateJobArrayPriorities{
+					key: JobReprioritiseKey{
+						JobSetKey: JobSetKey{queue: f.Queue, jobSet: f.JobsetName},
+						Priority:  f.NewPriority,
+					},
+					arrayIds: []string{f.JobId},
+				},
+			},
+		},
 		"reprioritise jobset": {
 			events: []*armadaevents.EventSequence_Event{f.JobSetReprioritiseRequested},
 			expected: []DbOperation{
@@ -154,6 +168,17 @@ func TestConvertEventSequence(t *testing.T) {
 				},
 			},
 		},
+		"JobArrayCancelRequested": {
+			events: []*armadaevents.EventSequence_Event{f.JobArrayCancelRequested},
+			expected: []DbOperation{
+				MarkJobArraysCancelRequested{
+					cancelUser: f.UserId,
+					arrayIds: map[JobSetKey][]string{
+						{queue: f.Queue, jobSet: f.JobsetName}: {f.JobId},
+					},
+				},
+			},
+		},
 		"JobSetCancelRequested": {
 			events: []*armadaevents.EventSequence_Event{f.JobSetCancelRequested},
 			expected: []DbOperation{
@@ -268,6 +293,35 @@ func TestConvertEventSequence(t *testing.T) {
 	}
 }
 
+func TestConvertEventSequence_SubmitJobArray(t *testing.T) {
+	submit := proto.Clone(f.Submit).(*armadaevents.EventSequence_Event)
+	submit.GetSubmitJob().ArraySize = 2
+
+	converter := JobSetEventsInstructionConverter{m, compressor}
+	results := converter.dbOperationsFromEventSequence(f.NewEventSequence(submit))
+	require.Len(t, results, 1)
+	insertJobs, ok := results[0].(InsertJobs)
+	require.True(t, ok)
+	require.Len(t, insertJobs, 2)
+
+	for i, element := range eventutil.ExpandJobArray(submit.GetSubmitJob()) {
+		jobId := fmt.Sprintf("%s-%d", f.JobId, i)
+		job, ok := insertJobs[jobId]
+		require.True(t, ok, "missing job %s", jobId)
+		assert.Equal(t, jobId, job.JobID)
+		require.NotNil(t, job.ArrayID)
+		assert.Equal(t, f.JobId, *job.ArrayID)
+		assert.Equal(t, int64(f.Priority), job.Priority)
+		assert.True(t, job.Queued)
+
+		submitMessage, err := decompressor.Decompress(job.SubmitMessage)
+		require.NoError(t, err)
+		actual := &armadaevents.SubmitJob{}
+		require.NoError(t, proto.Unmarshal(submitMessage, actual))
+		assert.Equal(t, element, actual)
+	}
+}
+
 func TestConvertControlPlaneEvent(t *testing.T) {
 	tests := map[string]struct {
 		event    *controlplaneevents.Event
@@ -301,6 +355,31 @@ func TestConvertControlPlaneEvent(t *testing.T) {
 				},
 			}},
 		},
+		"upsert node drain": {
+			event: f.UpsertNodeDrain,
+			expected: []DbOperation{UpsertNodeDrains{
+				{ExecutorID: f.ExecutorId, NodeName: f.DrainNodeName}: &NodeDrainUpsert{
+					NodeDrainKey: NodeDrainKey{ExecutorID: f.ExecutorId, NodeName: f.DrainNodeName},
+					Preempt:      true,
+					Reason:       f.NodeDrainReason,
+				},
+			}},
+		},
+		"upsert node drain by selector": {
+			event: f.UpsertNodeDrainBySelector,
+			expected: []DbOperation{UpsertNodeDrains{
+				{ExecutorID: f.ExecutorId, NodeSelector: "rack=a,zone=b"}: &NodeDrainUpsert{
+					NodeDrainKey: NodeDrainKey{ExecutorID: f.ExecutorId, NodeSelector: "rack=a,zone=b"},
+					Reason:       f.NodeDrainReason,
+				},
+			}},
+		},
+		"delete node drain": {
+			event: f.DeleteNodeDrain,
+			expected: []DbOperation{DeleteNodeDrains{
+				{ExecutorID: f.ExecutorId, NodeName: f.DrainNodeName}: true,
+			}},
+		},
 		"preempt on executor": {
 			event: f.PreemptOnExecutor,
 			expected: []DbOperation{PreemptExecutor{
@@ -439,6 +518,7 @@ func getExpectedSubmitMessageSchedulingInfo(t *testing.T) *schedulerobjects.JobS
 				Requirements: &schedulerobjects.ObjectRequirements_PodRequirements{
 					PodRequirements: &schedulerobjects.PodRequirements{
 						NodeSelector: f.NodeSelector,
+						Namespace:    f.Namespace,
 						Tolerations: armadaslices.Map(f.Tolerations, func(t v1.Toleration) *v1.Toleration {
 							return &t
 						}),
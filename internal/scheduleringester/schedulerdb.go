@@ -196,6 +196,19 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 				return errors.WithStack(err)
 			}
 		}
+	case MarkJobArraysCancelRequested:
+		for key, value := range o.arrayIds {
+			params := schedulerdb.MarkJobsCancelRequestedByArrayIdParams{
+				Queue:      key.queue,
+				JobSet:     key.jobSet,
+				ArrayIds:   value,
+				CancelUser: &o.cancelUser,
+			}
+			err := queries.MarkJobsCancelRequestedByArrayId(ctx, params)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
 	case MarkJobsCancelled:
 		jobIds := maps.Keys(o)
 		if err := queries.MarkJobsCancelledById(ctx, jobIds); err != nil {
@@ -246,6 +259,16 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 		if err != nil {
 			return errors.WithStack(err)
 		}
+	case *UpdateJobArrayPriorities:
+		err := queries.UpdateJobPriorityByArrayId(ctx, schedulerdb.UpdateJobPriorityByArrayIdParams{
+			Queue:    o.key.queue,
+			JobSet:   o.key.jobSet,
+			Priority: o.key.Priority,
+			ArrayIds: slices.Unique(o.arrayIds),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
 	case MarkRunsSucceeded:
 		successTimes := make([]interface{}, 0, len(o))
 		succeeded := make([]bool, 0, len(o))
@@ -381,6 +404,34 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 			}
 		}
 		return nil
+	case UpsertNodeDrains:
+		for _, drain := range o {
+			err := queries.UpsertNodeDrain(ctx, schedulerdb.UpsertNodeDrainParams{
+				ExecutorID:   drain.ExecutorID,
+				NodeName:     drain.NodeName,
+				NodeSelector: drain.NodeSelector,
+				Preempt:      drain.Preempt,
+				Reason:       drain.Reason,
+				SetByUser:    drain.SetByUser,
+				SetAtTime:    drain.SetAtTime,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "error upserting node drain for executor %s", drain.ExecutorID)
+			}
+		}
+		return nil
+	case DeleteNodeDrains:
+		for key := range o {
+			err := queries.DeleteNodeDrain(ctx, schedulerdb.DeleteNodeDrainParams{
+				ExecutorID:   key.ExecutorID,
+				NodeName:     key.NodeName,
+				NodeSelector: key.NodeSelector,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "error deleting node drain for executor %s", key.ExecutorID)
+			}
+		}
+		return nil
 	case CancelExecutor:
 		for executor, cancelRequest := range o {
 			jobs, err := queries.SelectJobsByExecutorAndQueues(ctx, schedulerdb.SelectJobsByExecutorAndQueuesParams{
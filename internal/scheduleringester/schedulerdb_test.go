@@ -33,6 +33,7 @@ func TestWriteOps(t *testing.T) {
 	for i := range runIds {
 		runIds[i] = uuid.NewString()
 	}
+	arrayIds := []string{util.NewULID(), util.NewULID()}
 	scheduledAtPriorities := []int32{5, 10}
 	tests := map[string]struct {
 		Ops             []DbOperation
@@ -108,6 +109,24 @@ func TestWriteOps(t *testing.T) {
 				jobIds: []string{jobIds[0], jobIds[2]},
 			},
 		}},
+		"UpdateJobArrayPriorities": {Ops: []DbOperation{
+			InsertJobs{
+				jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]},
+				jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]},
+				jobIds[2]: &schedulerdb.Job{JobID: jobIds[2], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[1]},
+				jobIds[3]: &schedulerdb.Job{JobID: jobIds[3], Queue: testQueueName, JobSet: "set1"},
+			},
+			&UpdateJobArrayPriorities{
+				key: JobReprioritiseKey{
+					JobSetKey: JobSetKey{
+						queue:  testQueueName,
+						jobSet: "set1",
+					},
+					Priority: 3,
+				},
+				arrayIds: []string{arrayIds[0]},
+			},
+		}},
 		"MarkRunsForJobPreemptRequested": {Ops: []DbOperation{
 			InsertJobs{
 				jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1"},
@@ -183,6 +202,20 @@ func TestWriteOps(t *testing.T) {
 				},
 			},
 		}},
+		"MarkJobArraysCancelRequested": {Ops: []DbOperation{
+			InsertJobs{
+				jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]},
+				jobIds[1]: &schedulerdb.Job{JobID: jobIds[1], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[0]},
+				jobIds[2]: &schedulerdb.Job{JobID: jobIds[2], Queue: testQueueName, JobSet: "set1", ArrayID: &arrayIds[1]},
+				jobIds[3]: &schedulerdb.Job{JobID: jobIds[3], Queue: testQueueName, JobSet: "set1"},
+			},
+			MarkJobArraysCancelRequested{
+				cancelUser: testfixtures.CancelUser,
+				arrayIds: map[JobSetKey][]string{
+					{queue: testQueueName, jobSet: "set1"}: {arrayIds[0]},
+				},
+			},
+		}},
 		"MarkJobsCancelled": {Ops: []DbOperation{
 			InsertJobs{
 				jobIds[0]: &schedulerdb.Job{JobID: jobIds[0], JobSet: "set1"},
@@ -383,6 +416,30 @@ func TestWriteOps(t *testing.T) {
 				},
 			},
 		}},
+		"Upsert Node Drains": {Ops: []DbOperation{
+			UpsertNodeDrains{
+				{ExecutorID: "executor-1", NodeName: "node-1"}: &NodeDrainUpsert{
+					NodeDrainKey: NodeDrainKey{ExecutorID: "executor-1", NodeName: "node-1"},
+					Preempt:      true,
+					Reason:       "Maintenance",
+				},
+				{ExecutorID: "executor-1", NodeSelector: "rack=a"}: &NodeDrainUpsert{
+					NodeDrainKey: NodeDrainKey{ExecutorID: "executor-1", NodeSelector: "rack=a"},
+					Reason:       "Maintenance",
+				},
+			},
+		}},
+		"Delete Node Drains": {Ops: []DbOperation{
+			UpsertNodeDrains{
+				{ExecutorID: "executor-1", NodeName: "node-1"}: &NodeDrainUpsert{
+					NodeDrainKey: NodeDrainKey{ExecutorID: "executor-1", NodeName: "node-1"},
+					Reason:       "Maintenance",
+				},
+			},
+			DeleteNodeDrains{
+				{ExecutorID: "executor-1", NodeName: "node-1"}: true,
+			},
+		}},
 		"Delete Single Executor Setting": {Ops: []DbOperation{
 			DeleteExecutorSettings{
 				"executor-1": &ExecutorSettingsDelete{
@@ -543,9 +600,11 @@ func addDefaultValues(op DbOperation) DbOperation {
 	case UpdateJobSetPriorities:
 	case MarkJobSetsCancelRequested:
 	case MarkJobsCancelRequested:
+	case MarkJobArraysCancelRequested:
 	case MarkJobsSucceeded:
 	case MarkJobsFailed:
 	case *UpdateJobPriorities:
+	case *UpdateJobArrayPriorities:
 	case MarkRunsSucceeded:
 	case MarkRunsFailed:
 	case MarkRunsRunning:
@@ -692,6 +751,23 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 			}
 		}
 		assert.Equal(t, len(expected.jobIds), numChanged)
+	case MarkJobArraysCancelRequested:
+		jobs, err := selectNewJobs(ctx, serials["jobs"])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		numChanged := 0
+		for _, job := range jobs {
+			arrayIds := expected.arrayIds[JobSetKey{queue: job.Queue, jobSet: job.JobSet}]
+			if job.ArrayID != nil && slices.Contains(arrayIds, *job.ArrayID) {
+				assert.True(t, job.CancelRequested)
+				assert.Equal(t, expected.cancelUser, *job.CancelUser)
+				numChanged++
+			} else {
+				assert.False(t, job.CancelRequested)
+			}
+		}
+		assert.Greater(t, numChanged, 0)
 	case MarkRunsForJobPreemptRequested:
 		jobs, err := selectNewJobs(ctx, 0)
 		if err != nil {
@@ -790,6 +866,19 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 			}
 		}
 		assert.Equal(t, len(expected.jobIds), numChanged)
+	case *UpdateJobArrayPriorities:
+		jobs, err := selectNewJobs(ctx, serials["jobs"])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		numChanged := 0
+		for _, job := range jobs {
+			if job.ArrayID != nil && slices.Contains(expected.arrayIds, *job.ArrayID) {
+				assert.Equal(t, expected.key.Priority, job.Priority)
+				numChanged++
+			}
+		}
+		assert.Greater(t, numChanged, 0)
 	case MarkRunsSucceeded:
 		jobs, err := selectNewJobs(ctx, 0)
 		if err != nil {
@@ -991,6 +1080,32 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 			return ok
 		})
 		assert.Equal(t, 0, len(filtered))
+	case UpsertNodeDrains:
+		allDrains, err := queries.SelectAllNodeDrains(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		actual := UpsertNodeDrains{}
+		for _, d := range allDrains {
+			key := NodeDrainKey{ExecutorID: d.ExecutorID, NodeName: d.NodeName, NodeSelector: d.NodeSelector}
+			if _, ok := expected[key]; ok {
+				actual[key] = &NodeDrainUpsert{
+					NodeDrainKey: key,
+					Preempt:      d.Preempt,
+					Reason:       d.Reason,
+				}
+			}
+		}
+		assert.Equal(t, expected, actual)
+	case DeleteNodeDrains:
+		allDrains, err := queries.SelectAllNodeDrains(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		filtered := armadaslices.Filter(allDrains, func(d schedulerdb.NodeDrain) bool {
+			return expected[NodeDrainKey{ExecutorID: d.ExecutorID, NodeName: d.NodeName, NodeSelector: d.NodeSelector}]
+		})
+		assert.Equal(t, 0, len(filtered))
 	default:
 		return errors.Errorf("received unexpected op %+v", op)
 	}
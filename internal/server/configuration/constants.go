@@ -7,6 +7,11 @@ const (
 	// GangCardinalityAnnotation All jobs in a gang must specify the total number of jobs in the gang via this annotation.
 	// The cardinality should be expressed as a positive integer, e.g., "3".
 	GangCardinalityAnnotation = "armadaproject.io/gangCardinality"
+	// GangMinimumCardinalityAnnotation optionally makes a gang elastic by specifying the minimum number of its jobs
+	// that must be scheduled together; the remaining jobs stay queued and may be scheduled in later rounds.
+	// Jobs beyond the minimum may also be preempted without preempting the rest of the gang.
+	// Must be a positive integer no greater than the gang cardinality; defaults to the gang cardinality.
+	GangMinimumCardinalityAnnotation = "armadaproject.io/gangMinimumCardinality"
 	// The jobs that make up a gang may be constrained to be scheduled across a set of uniform nodes.
 	// Specifically, if provided, all gang jobs are scheduled onto nodes for which the value of the provided label is equal.
 	// Used to ensure, e.g., that all gang jobs are scheduled onto the same cluster or rack.
@@ -17,11 +22,38 @@ const (
 	JobPriceBand       = "armadaproject.io/priceBand"
 	FailFastAnnotation = "armadaproject.io/failFast"
 	PoolAnnotation     = "armadaproject.io/pool"
+	// JobArrayIdAnnotation and JobArrayIndexAnnotation are set on each job that is part of a job array,
+	// and hold the id of the array and the index of the job within it, respectively.
+	JobArrayIdAnnotation    = "armadaproject.io/jobArrayId"
+	JobArrayIndexAnnotation = "armadaproject.io/jobArrayIndex"
+	// NotificationUrlAnnotation, if set, is the URL of an HTTP webhook the notification ingester notifies when the job
+	// reaches one of the states in NotificationStatesAnnotation. Only URLs allowed by the notification ingester are used.
+	NotificationUrlAnnotation = "armadaproject.io/notificationUrl"
+	// NotificationStatesAnnotation is a comma-separated list of the states to notify NotificationUrlAnnotation of,
+	// named as the event types of the event API, e.g., "failed,preempted". Defaults to "succeeded,failed,cancelled".
+	NotificationStatesAnnotation = "armadaproject.io/notificationStates"
+	// PreemptionNoticePeriodAnnotation is set by the scheduler to the preemption notice period of the job's priority
+	// class, e.g., "5m0s", if it has one; the executor waits this long between giving notice of preemption and
+	// killing the pod.
+	PreemptionNoticePeriodAnnotation = "armadaproject.io/preemptionNoticePeriod"
+	// PreemptionNoticeDeadlineAnnotation is added to the pod by the executor when it gives notice of preemption,
+	// and holds the RFC 3339 time after which the pod is killed.
+	PreemptionNoticeDeadlineAnnotation = "armadaproject.io/preemptionNoticeDeadline"
+	// PreemptionNoticeExecHookAnnotation, if set, is a command the executor runs with "/bin/sh -c" in the first
+	// container of the pod when it gives notice of preemption. The executor needs permission to create pods/exec.
+	PreemptionNoticeExecHookAnnotation = "armadaproject.io/preemptionNoticeExecHook"
+	// PreemptionNoticeHttpHookAnnotation, if set, is the port and path, e.g., "8080/checkpoint", the executor sends
+	// an HTTP POST request to on the pod's IP when it gives notice of preemption.
+	PreemptionNoticeHttpHookAnnotation = "armadaproject.io/preemptionNoticeHttpHook"
+	// JobArrayIndexEnvVar is the environment variable through which each container of a job in a job array is
+	// told the index of its job within the array.
+	JobArrayIndexEnvVar = "ARMADA_JOB_ARRAY_INDEX"
 )
 
 var schedulingAnnotations = map[string]bool{
 	GangIdAnnotation:                  true,
 	GangCardinalityAnnotation:         true,
+	GangMinimumCardinalityAnnotation:  true,
 	GangNodeUniformityLabelAnnotation: true,
 	FailFastAnnotation:                true,
 }
@@ -14,6 +14,12 @@ import (
 	"github.com/armadaproject/armada/pkg/client"
 )
 
+// Backends the events API can read events from.
+const (
+	EventsApiBackendRedis    = "redis"
+	EventsApiBackendPostgres = "postgres"
+)
+
 type ArmadaConfig struct {
 	Auth authconfig.AuthConfig
 
@@ -29,6 +35,15 @@ type ArmadaConfig struct {
 
 	SchedulerApiConnection client.ApiConnectionDetails
 
+	// Backend the events API reads events from; one of "redis" (the default) or "postgres".
+	// Must match the backend the event ingester writes to.
+	EventsApiBackend string `validate:"omitempty,oneof=redis postgres"`
+	// Database the events API reads from if EventsApiBackend is "postgres".
+	EventsApiPostgres PostgresConfig
+	// Database the usage API reads from, written by the accounting ingester.
+	// The usage API isn't served if no connection is configured.
+	UsageApiPostgres PostgresConfig
+
 	EventsApiRedis redis.UniversalOptions
 	Pulsar         commonconfig.PulsarConfig
 	Postgres       PostgresConfig // Needs to point to the lookout db
@@ -61,6 +76,12 @@ type SubmissionConfig struct {
 	RestrictedTolerationKeys []string
 	// Pods of size greater than this are rejected at submission.
 	MaxPodSpecSizeBytes uint
+	// Jobs whose ConfigMaps and Secrets have a combined size greater than this are rejected at submission.
+	MaxConfigMapAndSecretSizeBytes uint
+	// Job arrays with more elements than this are rejected at submission.
+	MaxJobArraySize uint32
+	// Jobs with a retry policy allowing more attempts than this are rejected at submission.
+	MaxRetryAttempts uint32
 	// Jobs requesting less than this amount of resources are rejected at submission.
 	MinJobResources v1.ResourceList
 	// Default value of GangNodeUniformityLabelAnnotation if not set on submitted jobs.
@@ -52,13 +52,18 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 			convertedEvents, err = FromInternalStandaloneIngressInfo(es.Queue, es.JobSetName, eventTs, esEvent.StandaloneIngressInfo)
 		case *armadaevents.EventSequence_Event_JobRunPreempted:
 			convertedEvents, err = FromInternalJobRunPreempted(es.Queue, es.JobSetName, eventTs, esEvent.JobRunPreempted)
+		case *armadaevents.EventSequence_Event_JobRunPreemptionNotice:
+			convertedEvents, err = FromInternalJobRunPreemptionNotice(es.Queue, es.JobSetName, eventTs, esEvent.JobRunPreemptionNotice)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet,
+			*armadaevents.EventSequence_Event_ReprioritiseJobArray,
 			*armadaevents.EventSequence_Event_JobRunPreemptionRequested,
 			*armadaevents.EventSequence_Event_JobRunCancelled,
 			*armadaevents.EventSequence_Event_CancelJobSet,
+			*armadaevents.EventSequence_Event_CancelJobArray,
 			*armadaevents.EventSequence_Event_JobRunSucceeded,
 			*armadaevents.EventSequence_Event_JobRequeued,
 			*armadaevents.EventSequence_Event_JobValidated,
+			*armadaevents.EventSequence_Event_JobDeferralEnded,
 			*armadaevents.EventSequence_Event_PartitionMarker:
 			// These events have no api analog right now, so we ignore
 			log.Debugf("ignoring event type %T", esEvent)
@@ -76,38 +81,45 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 }
 
 func FromInternalSubmit(owner string, groups []string, queue string, jobSet string, time time.Time, e *armadaevents.SubmitJob) ([]*api.EventMessage, error) {
-	job, err := eventutil.ApiJobFromLogSubmitJob(owner, groups, queue, jobSet, time, e)
-	if err != nil {
-		return nil, err
-	}
+	// Each element of a job array is reported as a separate job.
+	jobs := eventutil.ExpandJobArray(e)
+	events := make([]*api.EventMessage, 0, 2*len(jobs))
+	for _, element := range jobs {
+		job, err := eventutil.ApiJobFromLogSubmitJob(owner, groups, queue, jobSet, time, element)
+		if err != nil {
+			return nil, err
+		}
 
-	submitEvent := &api.JobSubmittedEvent{
-		JobId:    e.JobId,
-		JobSetId: jobSet,
-		Queue:    queue,
-		Created:  protoutil.ToTimestamp(time),
-		Job:      job,
-	}
+		submitEvent := &api.JobSubmittedEvent{
+			JobId:    element.JobId,
+			JobSetId: jobSet,
+			Queue:    queue,
+			Created:  protoutil.ToTimestamp(time),
+			Job:      job,
+		}
 
-	queuedEvent := &api.JobQueuedEvent{
-		JobId:    e.JobId,
-		JobSetId: jobSet,
-		Queue:    queue,
-		Created:  protoutil.ToTimestamp(time),
-	}
+		queuedEvent := &api.JobQueuedEvent{
+			JobId:    element.JobId,
+			JobSetId: jobSet,
+			Queue:    queue,
+			Created:  protoutil.ToTimestamp(time),
+		}
 
-	return []*api.EventMessage{
-		{
-			Events: &api.EventMessage_Submitted{
-				Submitted: submitEvent,
+		events = append(
+			events,
+			&api.EventMessage{
+				Events: &api.EventMessage_Submitted{
+					Submitted: submitEvent,
+				},
 			},
-		},
-		{
-			Events: &api.EventMessage_Queued{
-				Queued: queuedEvent,
+			&api.EventMessage{
+				Events: &api.EventMessage_Queued{
+					Queued: queuedEvent,
+				},
 			},
-		},
-	}, nil
+		)
+	}
+	return events, nil
 }
 
 func FromInternalPreemptionRequested(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.JobPreemptionRequested) ([]*api.EventMessage, error) {
@@ -344,6 +356,19 @@ func FromInternalJobErrors(queueName string, jobSetName string, time time.Time,
 				},
 			}
 			events = append(events, event)
+		case *armadaevents.Error_JobDependencyFailed:
+			event := &api.EventMessage{
+				Events: &api.EventMessage_Failed{
+					Failed: &api.JobFailedEvent{
+						JobId:    e.JobId,
+						JobSetId: jobSetName,
+						Queue:    queueName,
+						Created:  protoutil.ToTimestamp(time),
+						Reason:   reason.JobDependencyFailed.Message,
+					},
+				},
+			}
+			events = append(events, event)
 		default:
 			log.Warnf("unknown error %T for job %s", reason, e.JobId)
 			event := &api.EventMessage{
@@ -438,6 +463,25 @@ func FromInternalJobRunPreempted(queueName string, jobSetName string, time time.
 	}, nil
 }
 
+func FromInternalJobRunPreemptionNotice(queueName string, jobSetName string, time time.Time, e *armadaevents.JobRunPreemptionNotice) ([]*api.EventMessage, error) {
+	apiEvent := &api.JobPreemptionNoticeEvent{
+		JobId:    e.JobId,
+		JobSetId: jobSetName,
+		Queue:    queueName,
+		Created:  protoutil.ToTimestamp(time),
+		RunId:    e.RunId,
+		Deadline: e.Deadline,
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_PreemptionNotice{
+				PreemptionNotice: apiEvent,
+			},
+		},
+	}, nil
+}
+
 func FromInternalResourceUtilisation(queueName string, jobSetName string, time time.Time, e *armadaevents.ResourceUtilisation) ([]*api.EventMessage, error) {
 	apiEvent := &api.JobUtilisationEvent{
 		JobId:                 e.JobId,
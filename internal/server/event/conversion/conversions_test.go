@@ -11,6 +11,7 @@ import (
 
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
@@ -110,6 +111,47 @@ func TestConvertSubmitted(t *testing.T) {
 	assert.Equal(t, expected, apiEvents)
 }
 
+func TestConvertSubmitted_JobArray(t *testing.T) {
+	submit := &armadaevents.EventSequence_Event{
+		Created: baseTimeProto,
+		Event: &armadaevents.EventSequence_Event_SubmitJob{
+			SubmitJob: &armadaevents.SubmitJob{
+				JobId:     jobId,
+				Priority:  priority,
+				ArraySize: 2,
+				ObjectMeta: &armadaevents.ObjectMeta{
+					Namespace: namespace,
+				},
+				MainObject: &armadaevents.KubernetesMainObject{
+					Object: &armadaevents.KubernetesMainObject_PodSpec{
+						PodSpec: &armadaevents.PodSpecWithAvoidList{
+							PodSpec: &v1.PodSpec{
+								Containers: []v1.Container{{Name: "container1"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(submit))
+	assert.NoError(t, err)
+	assert.Len(t, apiEvents, 4)
+	for i, elementId := range []string{jobId + "-0", jobId + "-1"} {
+		submitted := apiEvents[2*i].GetSubmitted()
+		if assert.NotNil(t, submitted) {
+			assert.Equal(t, elementId, submitted.JobId)
+			assert.Equal(t, elementId, submitted.Job.Id)
+			assert.Equal(t, jobId, submitted.Job.Annotations[configuration.JobArrayIdAnnotation])
+		}
+		queued := apiEvents[2*i+1].GetQueued()
+		if assert.NotNil(t, queued) {
+			assert.Equal(t, elementId, queued.JobId)
+		}
+	}
+}
+
 func TestConvertCancel(t *testing.T) {
 	cancel := &armadaevents.EventSequence_Event{
 		Created: baseTimeProto,
@@ -843,6 +885,39 @@ func TestConvertJobRunPreempted(t *testing.T) {
 	assert.Equal(t, expected, apiEvents)
 }
 
+func TestConvertJobRunPreemptionNotice(t *testing.T) {
+	deadline := protoutil.ToTimestamp(baseTime.Add(5 * time.Minute))
+	notice := &armadaevents.EventSequence_Event{
+		Created: baseTimeProto,
+		Event: &armadaevents.EventSequence_Event_JobRunPreemptionNotice{
+			JobRunPreemptionNotice: &armadaevents.JobRunPreemptionNotice{
+				JobId:    jobId,
+				RunId:    runId,
+				Deadline: deadline,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_PreemptionNotice{
+				PreemptionNotice: &api.JobPreemptionNoticeEvent{
+					JobId:    jobId,
+					JobSetId: jobSetName,
+					Queue:    queue,
+					Created:  protoutil.ToTimestamp(baseTime),
+					RunId:    runId,
+					Deadline: deadline,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(notice))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
 func toEventSeq(event ...*armadaevents.EventSequence_Event) *armadaevents.EventSequence {
 	return &armadaevents.EventSequence{
 		Queue:      queue,
@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -10,7 +11,6 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
-	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/server/event/sequence"
@@ -147,13 +147,5 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 }
 
 func validateUserHasWatchPermissions(ctx *armadacontext.Context, authorizer auth.ActionAuthorizer, q queue.Queue, jobSetId string) error {
-	err := authorizer.AuthorizeQueueAction(ctx, q, permissions.WatchAllEvents, queue.PermissionVerbWatch)
-	var permErr *armadaerrors.ErrUnauthorized
-	if errors.As(err, &permErr) {
-		return status.Errorf(codes.PermissionDenied, "error getting events for queue: %s, job set: %s: %s",
-			q.Name, jobSetId, permErr)
-	} else if err != nil {
-		return status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
-	}
-	return nil
+	return permissions.AuthorizeWatchQueue(ctx, authorizer, q, fmt.Sprintf("getting events for queue: %s, job set: %s", q.Name, jobSetId))
 }
@@ -2,17 +2,20 @@ package event
 
 import (
 	"context"
-	"fmt"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/exp/maps"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/server/event/conversion"
 	"github.com/armadaproject/armada/internal/server/event/sequence"
@@ -21,14 +24,36 @@ import (
 )
 
 const (
-	eventStreamPrefix = "Events:"
-	dataKey           = "message"
+	dataKey = "message"
+	// How often reads of several job sets on a Redis cluster check for new events, as they can't block.
+	clusterReadPollInterval = time.Second
 )
 
 type EventRepository interface {
 	CheckStreamExists(ctx *armadacontext.Context, queue string, jobSetId string) (bool, error)
 	ReadEvents(ctx *armadacontext.Context, queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error)
 	GetLastMessageId(ctx *armadacontext.Context, queue, jobSetId string) (string, error)
+	GetJobSetIds(ctx *armadacontext.Context, queue string, jobSetPrefix string) ([]string, error)
+	// ReadQueueEvents reads the events after lastIds[jobSetId] of each of the given job sets of queue, returning those
+	// job sets with events to read. Like ReadEvents, if there are none it waits up to block, or indefinitely if block
+	// is zero, and doesn't wait if block is negative.
+	ReadQueueEvents(ctx *armadacontext.Context, queue string, lastIds map[string]string, limit int64, block time.Duration) (map[string]*JobSetEvents, error)
+}
+
+// JobSetEvents are the events read from a job set by ReadQueueEvents.
+type JobSetEvents struct {
+	Messages []*api.EventStreamMessage
+	// Id of the last event read; this may not be in Messages if it wasn't after the id read from.
+	LastMessageId *sequence.ExternalSeqNo
+}
+
+// unwrap returns the messages and last message id of events read by ReadEvents, which returns an empty list of
+// messages and no id if there were no events to read.
+func (e *JobSetEvents) unwrap() ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	if e == nil {
+		return make([]*api.EventStreamMessage, 0), nil, nil
+	}
+	return e.Messages, e.LastMessageId, nil
 }
 
 type RedisEventRepository struct {
@@ -37,6 +62,10 @@ type RedisEventRepository struct {
 }
 
 func NewEventRepository(db redis.UniversalClient) *RedisEventRepository {
+	return &RedisEventRepository{db: db, decompressorPool: newDecompressorPool()}
+}
+
+func newDecompressorPool() *pool.ObjectPool {
 	// This is basically the default config but with a max of 100 rather than 8 and a min of 10 rather than 0.
 	poolConfig := pool.ObjectPoolConfig{
 		MaxTotal:                 100,
@@ -49,16 +78,14 @@ func NewEventRepository(db redis.UniversalClient) *RedisEventRepository {
 		NumTestsPerEvictionRun:   10,
 	}
 
-	decompressorPool := pool.NewObjectPool(armadacontext.Background(), pool.NewPooledObjectFactorySimple(
+	return pool.NewObjectPool(armadacontext.Background(), pool.NewPooledObjectFactorySimple(
 		func(context.Context) (interface{}, error) {
 			return compress.NewZlibDecompressor(), nil
 		}), &poolConfig)
-
-	return &RedisEventRepository{db: db, decompressorPool: decompressorPool}
 }
 
 func (repo *RedisEventRepository) CheckStreamExists(ctx *armadacontext.Context, queue string, jobSetId string) (bool, error) {
-	result, err := repo.db.Exists(ctx, getJobSetEventsKey(queue, jobSetId)).Result()
+	result, err := repo.db.Exists(ctx, jobsetevents.RedisEventStreamKey(queue, jobSetId)).Result()
 	if err != nil {
 		return false, err
 	}
@@ -67,42 +94,134 @@ func (repo *RedisEventRepository) CheckStreamExists(ctx *armadacontext.Context,
 }
 
 func (repo *RedisEventRepository) ReadEvents(ctx *armadacontext.Context, queue string, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
-	from, err := sequence.Parse(lastId)
+	events, err := repo.ReadQueueEvents(ctx, queue, map[string]string{jobSetId: lastId}, limit, block)
 	if err != nil {
 		return nil, nil, err
 	}
-	seqId := from.PrevRedisId()
-	cmd, err := repo.db.XRead(ctx, &redis.XReadArgs{
-		Streams: []string{getJobSetEventsKey(queue, jobSetId), seqId},
-		Count:   limit,
-		Block:   block,
-	}).Result()
+	return events[jobSetId].unwrap()
+}
 
-	// redis signals empty list by Nil
-	if err == redis.Nil {
-		return make([]*api.EventStreamMessage, 0), nil, nil
-	} else if err != nil {
-		return nil, nil, errors.WithStack(fmt.Errorf("%s (fromId: %s, seqId: %s)", err, from, seqId))
+// ReadQueueEvents reads the streams of all the given job sets with a single XREAD, so that watching many job sets
+// doesn't need a read of each per poll.
+func (repo *RedisEventRepository) ReadQueueEvents(ctx *armadacontext.Context, queue string, lastIds map[string]string, limit int64, block time.Duration) (map[string]*JobSetEvents, error) {
+	jobSetIds := maps.Keys(lastIds)
+	slices.Sort(jobSetIds)
+	froms := make(map[string]*sequence.ExternalSeqNo, len(jobSetIds))
+	keys := make([]string, 0, len(jobSetIds))
+	seqIds := make([]string, 0, len(jobSetIds))
+	for _, jobSetId := range jobSetIds {
+		from, err := sequence.Parse(lastIds[jobSetId])
+		if err != nil {
+			return nil, err
+		}
+		froms[jobSetId] = from
+		keys = append(keys, jobsetevents.RedisEventStreamKey(queue, jobSetId))
+		seqIds = append(seqIds, from.PrevRedisId())
+	}
+
+	streams, err := repo.readStreams(ctx, keys, seqIds, limit, block)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading events of %d job sets of queue %s", len(jobSetIds), queue)
+	}
+
+	keyPrefix := jobsetevents.RedisEventStreamKey(queue, "")
+	result := make(map[string]*JobSetEvents, len(streams))
+	for _, stream := range streams {
+		if len(stream.Messages) == 0 {
+			continue
+		}
+		jobSetId := strings.TrimPrefix(stream.Stream, keyPrefix)
+		events, err := repo.toJobSetEvents(ctx, stream.Messages, queue, jobSetId, froms[jobSetId])
+		if err != nil {
+			return nil, err
+		}
+		result[jobSetId] = events
+	}
+	return result, nil
+}
+
+// readStreams reads the given streams from after the corresponding ids.
+// Redis can only read streams with one command if they're on the same server, so on a cluster several streams are
+// read with a pipeline of non-blocking reads, repeated until there are messages or block has elapsed.
+func (repo *RedisEventRepository) readStreams(ctx *armadacontext.Context, keys []string, ids []string, limit int64, block time.Duration) ([]redis.XStream, error) {
+	if _, ok := repo.db.(*redis.Client); ok || len(keys) == 1 {
+		streams, err := repo.db.XRead(ctx, &redis.XReadArgs{
+			Streams: append(slices.Clone(keys), ids...),
+			Count:   limit,
+			Block:   block,
+		}).Result()
+		// redis signals empty list by Nil
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return streams, err
+	}
+
+	var deadline time.Time
+	if block > 0 {
+		deadline = time.Now().Add(block)
 	}
+	for {
+		cmds := make([]*redis.XStreamSliceCmd, len(keys))
+		_, err := repo.db.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i := range keys {
+				cmds[i] = pipe.XRead(ctx, &redis.XReadArgs{Streams: []string{keys[i], ids[i]}, Count: limit, Block: -1})
+			}
+			return nil
+		})
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		var streams []redis.XStream
+		for _, cmd := range cmds {
+			result, err := cmd.Result()
+			if err == redis.Nil {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			streams = append(streams, result...)
+		}
+		if len(streams) > 0 || block < 0 {
+			return streams, nil
+		}
 
+		wait := clusterReadPollInterval
+		if block > 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, nil
+			}
+			wait = min(wait, remaining)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// toJobSetEvents converts the messages read from the stream of a job set, dropping any events not after from.
+func (repo *RedisEventRepository) toJobSetEvents(ctx *armadacontext.Context, redisMessages []redis.XMessage, queue, jobSetId string, from *sequence.ExternalSeqNo) (*JobSetEvents, error) {
 	var lastMessageId *sequence.ExternalSeqNo = nil
-	messages := make([]*api.EventStreamMessage, 0, len(cmd[0].Messages))
-	for _, m := range cmd[0].Messages {
+	messages := make([]*api.EventStreamMessage, 0, len(redisMessages))
+	for _, m := range redisMessages {
 		// TODO: here we decompress all the events we fetched from the db- it would be much better
 		// If we could decompress lazily, but the interface confines us somewhat here
 		apiEvents, err := repo.extractEvents(ctx, m, queue, jobSetId)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		// Set a default id for the message, if there are apiEvents produced by this message then they'll overwrite this value
 		lastMessageId, err = sequence.FromRedisId(m.ID, 0, true)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		for i, msg := range apiEvents {
 			msgId, err := sequence.FromRedisId(m.ID, i, i == len(apiEvents)-1)
 			if err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 			lastMessageId = msgId
 			if msgId.IsAfter(from) {
@@ -110,11 +229,11 @@ func (repo *RedisEventRepository) ReadEvents(ctx *armadacontext.Context, queue s
 			}
 		}
 	}
-	return messages, lastMessageId, nil
+	return &JobSetEvents{Messages: messages, LastMessageId: lastMessageId}, nil
 }
 
 func (repo *RedisEventRepository) GetLastMessageId(ctx *armadacontext.Context, queue, jobSetId string) (string, error) {
-	msg, err := repo.db.XRevRangeN(ctx, getJobSetEventsKey(queue, jobSetId), "+", "-", 1).Result()
+	msg, err := repo.db.XRevRangeN(ctx, jobsetevents.RedisEventStreamKey(queue, jobSetId), "+", "-", 1).Result()
 	if err != nil {
 		return "", errors.Wrap(err, "Error retrieving the last message id from Redis")
 	}
@@ -132,10 +251,26 @@ func (repo *RedisEventRepository) GetLastMessageId(ctx *armadacontext.Context, q
 	return "0", nil
 }
 
+// GetJobSetIds returns the ids of the job sets in queue with events stored, and whose id starts with jobSetPrefix.
+// These are read from the set of job sets of the queue, which the event ingester adds to as it stores events.
+func (repo *RedisEventRepository) GetJobSetIds(ctx *armadacontext.Context, queue string, jobSetPrefix string) ([]string, error) {
+	jobSetIds, err := repo.db.ZRange(ctx, jobsetevents.RedisQueueJobSetsKey(queue), 0, -1).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing job sets of queue %s", queue)
+	}
+	return slices.DeleteFunc(jobSetIds, func(jobSetId string) bool {
+		return !strings.HasPrefix(jobSetId, jobSetPrefix)
+	}), nil
+}
+
 func (repo *RedisEventRepository) extractEvents(ctx *armadacontext.Context, msg redis.XMessage, queue, jobSetId string) ([]*api.EventMessage, error) {
 	data := msg.Values[dataKey]
-	bytes := []byte(data.(string))
-	decompressor, err := repo.decompressorPool.BorrowObject(armadacontext.Background())
+	return extractEvents(ctx, repo.decompressorPool, []byte(data.(string)), queue, jobSetId)
+}
+
+// extractEvents decompresses a stored event sequence and converts it to api events.
+func extractEvents(ctx *armadacontext.Context, decompressorPool *pool.ObjectPool, bytes []byte, queue, jobSetId string) ([]*api.EventMessage, error) {
+	decompressor, err := decompressorPool.BorrowObject(armadacontext.Background())
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -144,7 +279,7 @@ func (repo *RedisEventRepository) extractEvents(ctx *armadacontext.Context, msg
 		if err != nil {
 			log.WithError(err).Errorf("Error returning decompressor to pool")
 		}
-	}(repo.decompressorPool, ctx, decompressor)
+	}(decompressorPool, ctx, decompressor)
 	decompressedData, err := decompressor.(compress.Decompressor).Decompress(bytes)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -159,7 +294,3 @@ func (repo *RedisEventRepository) extractEvents(ctx *armadacontext.Context, msg
 	es.JobSetName = jobSetId
 	return conversion.FromEventSequence(es)
 }
-
-func getJobSetEventsKey(queue, jobSetId string) string {
-	return eventStreamPrefix + queue + ":" + jobSetId
-}
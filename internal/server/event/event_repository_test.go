@@ -7,9 +7,11 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/server/event/sequence"
 	"github.com/armadaproject/armada/pkg/api"
@@ -214,6 +216,48 @@ func TestStreamExists(t *testing.T) {
 	})
 }
 
+func TestReadQueueEvents(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	withRedisEventRepository(ctx, func(r *RedisEventRepository) {
+		require.NoError(t, storeJobSetEvents(ctx, r, "set-a", assigned, running))
+
+		// Only job sets with events are returned.
+		events, err := r.ReadQueueEvents(ctx, testQueue, map[string]string{"set-a": "", "set-b": ""}, 500, time.Second)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assertExpected(t, events["set-a"].Messages, events["set-a"].LastMessageId, &expectedPending, &expectedRunning)
+
+		// Blocked reads return once any of the job sets has events.
+		lastIds := map[string]string{"set-a": events["set-a"].LastMessageId.String(), "set-b": ""}
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			assert.NoError(t, storeJobSetEvents(ctx, r, "set-b", assigned))
+		}()
+		events, err = r.ReadQueueEvents(ctx, testQueue, lastIds, 500, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assertExpected(t, events["set-b"].Messages, events["set-b"].LastMessageId, &expectedPending)
+	})
+}
+
+func TestGetJobSetIds(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	withRedisEventRepository(ctx, func(r *RedisEventRepository) {
+		jobSetIds, err := r.GetJobSetIds(ctx, testQueue, "")
+		require.NoError(t, err)
+		assert.Empty(t, jobSetIds)
+
+		r.db.ZAdd(ctx, jobsetevents.RedisQueueJobSetsKey(testQueue), redis.Z{Score: 1, Member: "set-a"}, redis.Z{Score: 2, Member: "other"})
+		r.db.ZAdd(ctx, jobsetevents.RedisQueueJobSetsKey(testQueue+":set"), redis.Z{Score: 1, Member: "x"})
+
+		jobSetIds, err = r.GetJobSetIds(ctx, testQueue, "set")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"set-a"}, jobSetIds)
+	})
+}
+
 func withRedisEventRepository(ctx *armadacontext.Context, action func(r *RedisEventRepository)) {
 	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
 	defer client.FlushDB(ctx)
@@ -235,6 +279,10 @@ func assertExpected(t *testing.T, actual []*api.EventStreamMessage, lastMessageI
 }
 
 func storeEvents(ctx *armadacontext.Context, r *RedisEventRepository, events ...*armadaevents.EventSequence_Event) error {
+	return storeJobSetEvents(ctx, r, jobSetName, events...)
+}
+
+func storeJobSetEvents(ctx *armadacontext.Context, r *RedisEventRepository, jobSetId string, events ...*armadaevents.EventSequence_Event) error {
 	// create an eventSequence
 	es := &armadaevents.EventSequence{Events: events}
 
@@ -252,7 +300,7 @@ func storeEvents(ctx *armadacontext.Context, r *RedisEventRepository, events ...
 	}
 
 	r.db.XAdd(ctx, &redis.XAddArgs{
-		Stream: eventStreamPrefix + testQueue + ":" + jobSetName,
+		Stream: jobsetevents.RedisEventStreamKey(testQueue, jobSetId),
 		Values: map[string]interface{}{
 			dataKey: compressed,
 		},
@@ -0,0 +1,305 @@
+package event
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	pool "github.com/jolestar/go-commons-pool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/jobsetevents"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/server/event/sequence"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	// How often blocked readers check for new events, in case a notification is missed.
+	postgresEventPollInterval = time.Second
+	// How long to wait before listening again after losing the listening connection.
+	postgresListenRetryInterval = 5 * time.Second
+)
+
+// PostgresEventRepository reads events stored by the event ingester's Postgres event store.
+// Each row holds one event sequence; the id of its i-th event is ExternalSeqNo{Seq: <row id>, SubSeq: i}.
+// Blocked reads are woken by notifications sent as events are stored, which are received by Run.
+type PostgresEventRepository struct {
+	db               *pgxpool.Pool
+	decompressorPool *pool.ObjectPool
+	listener         *eventListener
+}
+
+func NewPostgresEventRepository(db *pgxpool.Pool) *PostgresEventRepository {
+	return &PostgresEventRepository{
+		db:               db,
+		decompressorPool: newDecompressorPool(),
+		listener:         newEventListener(),
+	}
+}
+
+// Run listens for notifications of stored events until ctx is cancelled.
+func (repo *PostgresEventRepository) Run(ctx *armadacontext.Context) error {
+	for {
+		err := repo.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.WithError(err).Warnf("Stopped listening for event notifications; blocked reads will poll until listening resumes")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(postgresListenRetryInterval):
+		}
+	}
+}
+
+func (repo *PostgresEventRepository) listen(ctx *armadacontext.Context) error {
+	conn, err := repo.db.Acquire(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// The connection stays subscribed to the channel, so it mustn't be returned to the pool.
+	pgConn := conn.Hijack()
+	defer func() {
+		if err := pgConn.Close(armadacontext.Background()); err != nil {
+			log.WithError(err).Warn("Error closing event notification connection")
+		}
+	}()
+	if _, err := pgConn.Exec(ctx, "LISTEN "+jobsetevents.PostgresNotificationChannel); err != nil {
+		return errors.WithStack(err)
+	}
+	for {
+		notification, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		repo.listener.notify(notification.Payload)
+	}
+}
+
+func (repo *PostgresEventRepository) CheckStreamExists(ctx *armadacontext.Context, queue string, jobSetId string) (bool, error) {
+	var exists bool
+	err := repo.db.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM job_set_event WHERE queue = $1 AND job_set = $2)",
+		queue, jobSetId,
+	).Scan(&exists)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return exists, nil
+}
+
+// ReadEvents has the same semantics as RedisEventRepository.ReadEvents: if block is non-negative and there are no
+// events after lastId, it waits up to block, or indefinitely if block is zero, for events to be stored.
+func (repo *PostgresEventRepository) ReadEvents(ctx *armadacontext.Context, queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	events, err := repo.ReadQueueEvents(ctx, queue, map[string]string{jobSetId: lastId}, limit, block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events[jobSetId].unwrap()
+}
+
+// ReadQueueEvents reads the events of all the given job sets with one query, waiting for a notification that events
+// of any of them have been stored if there are none.
+func (repo *PostgresEventRepository) ReadQueueEvents(ctx *armadacontext.Context, queue string, lastIds map[string]string, limit int64, block time.Duration) (map[string]*JobSetEvents, error) {
+	froms := make(map[string]*sequence.ExternalSeqNo, len(lastIds))
+	keys := make([]string, 0, len(lastIds))
+	for jobSetId, lastId := range lastIds {
+		from, err := sequence.Parse(lastId)
+		if err != nil {
+			return nil, err
+		}
+		froms[jobSetId] = from
+		keys = append(keys, jobsetevents.NotificationPayload(queue, jobSetId))
+	}
+	var deadline time.Time
+	if block > 0 {
+		deadline = time.Now().Add(block)
+	}
+	for {
+		var notified <-chan struct{}
+		var unsubscribe func()
+		if block >= 0 {
+			// Subscribe before reading, so events stored between reading and waiting aren't missed.
+			notified, unsubscribe = repo.listener.subscribe(keys...)
+		}
+		events, err := repo.readQueueEvents(ctx, queue, froms, limit)
+		if err != nil || len(events) > 0 || block < 0 {
+			if unsubscribe != nil {
+				unsubscribe()
+			}
+			return events, err
+		}
+
+		wait := postgresEventPollInterval
+		if block > 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				unsubscribe()
+				return events, nil
+			}
+			wait = min(wait, remaining)
+		}
+		select {
+		case <-ctx.Done():
+			unsubscribe()
+			return nil, errors.WithStack(ctx.Err())
+		case <-notified:
+		case <-time.After(wait):
+		}
+		unsubscribe()
+	}
+}
+
+// readQueueEvents returns up to limit rows of events after froms[jobSetId] of each job set, without blocking.
+// Job sets with no rows to read are omitted.
+func (repo *PostgresEventRepository) readQueueEvents(ctx *armadacontext.Context, queue string, froms map[string]*sequence.ExternalSeqNo, limit int64) (map[string]*JobSetEvents, error) {
+	jobSetIds := make([]string, 0, len(froms))
+	fromRowIds := make([]int64, 0, len(froms))
+	for jobSetId, from := range froms {
+		// Events after a partially-read row include the rest of that row.
+		fromRowId := from.Seq
+		if from.Last {
+			fromRowId++
+		}
+		jobSetIds = append(jobSetIds, jobSetId)
+		fromRowIds = append(fromRowIds, fromRowId)
+	}
+	rows, err := repo.db.Query(ctx, `
+		SELECT f.job_set, e.id, e.event
+		FROM unnest($2::text[], $3::bigint[]) AS f(job_set, from_id)
+		CROSS JOIN LATERAL (
+			SELECT id, event FROM job_set_event
+			WHERE queue = $1 AND job_set = f.job_set AND id >= f.from_id
+			ORDER BY id LIMIT $4
+		) e
+		ORDER BY f.job_set, e.id`,
+		queue, jobSetIds, fromRowIds, limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	type eventRow struct {
+		JobSet string
+		Id     int64
+		Event  []byte
+	}
+	eventRows, err := pgx.CollectRows(rows, pgx.RowToStructByPos[eventRow])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make(map[string]*JobSetEvents)
+	for _, row := range eventRows {
+		jobSetEvents, ok := result[row.JobSet]
+		if !ok {
+			jobSetEvents = &JobSetEvents{Messages: make([]*api.EventStreamMessage, 0)}
+			result[row.JobSet] = jobSetEvents
+		}
+		from := froms[row.JobSet]
+		events, err := extractEvents(ctx, repo.decompressorPool, row.Event, queue, row.JobSet)
+		if err != nil {
+			return nil, err
+		}
+		jobSetEvents.LastMessageId = &sequence.ExternalSeqNo{Seq: row.Id, Last: true}
+		for i, event := range events {
+			msgId := &sequence.ExternalSeqNo{Seq: row.Id, SubSeq: i, Last: i == len(events)-1}
+			if msgId.IsAfter(from) {
+				jobSetEvents.Messages = append(jobSetEvents.Messages, &api.EventStreamMessage{Id: msgId.String(), Message: event})
+			}
+			jobSetEvents.LastMessageId = msgId
+		}
+	}
+	return result, nil
+}
+
+func (repo *PostgresEventRepository) GetLastMessageId(ctx *armadacontext.Context, queue, jobSetId string) (string, error) {
+	var id int64
+	var event []byte
+	err := repo.db.QueryRow(ctx,
+		"SELECT id, event FROM job_set_event WHERE queue = $1 AND job_set = $2 ORDER BY id DESC LIMIT 1",
+		queue, jobSetId,
+	).Scan(&id, &event)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "0", nil
+	}
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	events, err := extractEvents(ctx, repo.decompressorPool, event, queue, jobSetId)
+	if err != nil {
+		return "", err
+	}
+	lastMessageId := &sequence.ExternalSeqNo{Seq: id, SubSeq: max(len(events)-1, 0), Last: true}
+	return lastMessageId.String(), nil
+}
+
+func (repo *PostgresEventRepository) GetJobSetIds(ctx *armadacontext.Context, queue string, jobSetPrefix string) ([]string, error) {
+	rows, err := repo.db.Query(ctx,
+		`SELECT DISTINCT job_set FROM job_set_event WHERE queue = $1 AND job_set LIKE $2 ESCAPE '\'`,
+		queue, escapeLikePattern(jobSetPrefix)+"%",
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobSetIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return jobSetIds, nil
+}
+
+// escapeLikePattern escapes the characters that have a special meaning in a LIKE pattern.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// eventListener wakes readers waiting for events of a job set when it's notified that they've been stored.
+type eventListener struct {
+	mu sync.Mutex
+	// Channels of the readers waiting for each "<queue>:<jobSetId>".
+	waiting map[string]map[chan struct{}]bool
+}
+
+func newEventListener() *eventListener {
+	return &eventListener{waiting: make(map[string]map[chan struct{}]bool)}
+}
+
+// subscribe returns a channel that receives a value when any of keys is notified, and a function that must be called
+// once the channel is no longer needed.
+func (l *eventListener) subscribe(keys ...string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if l.waiting[key] == nil {
+			l.waiting[key] = make(map[chan struct{}]bool)
+		}
+		l.waiting[key][ch] = true
+	}
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, key := range keys {
+			delete(l.waiting[key], ch)
+			if len(l.waiting[key]) == 0 {
+				delete(l.waiting, key)
+			}
+		}
+	}
+}
+
+func (l *eventListener) notify(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.waiting[key] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
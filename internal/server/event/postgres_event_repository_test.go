@@ -0,0 +1,215 @@
+package event
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/eventingester/configuration"
+	"github.com/armadaproject/armada/internal/eventingester/model"
+	"github.com/armadaproject/armada/internal/eventingester/schema"
+	"github.com/armadaproject/armada/internal/eventingester/store"
+	"github.com/armadaproject/armada/internal/server/event/sequence"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestPostgresRead(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := withPostgresEventRepository(ctx, func(r *PostgresEventRepository, s *postgresTestStore) {
+		require.NoError(t, s.storeEvents(ctx, assigned, running))
+
+		// Fetch from beginning
+		events, lastMessageId, err := r.ReadEvents(ctx, testQueue, jobSetName, "", 500, 1*time.Second)
+		assert.NoError(t, err)
+		assertExpected(t, events, lastMessageId, &expectedPending, &expectedRunning)
+
+		// Fetch from offset in the middle of a row
+		offset := events[0].Id
+		events, lastMessageId, err = r.ReadEvents(ctx, testQueue, jobSetName, offset, 500, 1*time.Second)
+		assert.NoError(t, err)
+		assertExpected(t, events, lastMessageId, &expectedRunning)
+
+		// Fetch from offset after
+		offset = events[0].Id
+		events, lastMessageId, err = r.ReadEvents(ctx, testQueue, jobSetName, offset, 500, 100*time.Millisecond)
+		assert.NoError(t, err)
+		assert.Nil(t, lastMessageId)
+		assert.Equal(t, 0, len(events))
+
+		// JobRunSucceeded doesn't result in an api event, so expect no events but a non-nil last message id.
+		require.NoError(t, s.storeEvents(ctx, runSucceeded))
+		offSetId, err := sequence.Parse(offset)
+		assert.NoError(t, err)
+		events, lastMessageId, err = r.ReadEvents(ctx, testQueue, jobSetName, offset, 500, 1*time.Second)
+		assert.NoError(t, err)
+		assert.NotNil(t, lastMessageId)
+		assert.True(t, lastMessageId.IsAfter(offSetId))
+		assert.Equal(t, 0, len(events))
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresRead_Blocking(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := withPostgresEventRepository(ctx, func(r *PostgresEventRepository, s *postgresTestStore) {
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			assert.NoError(t, s.storeEvents(ctx, assigned))
+		}()
+		events, lastMessageId, err := r.ReadEvents(ctx, testQueue, jobSetName, "", 500, 0)
+		assert.NoError(t, err)
+		assertExpected(t, events, lastMessageId, &expectedPending)
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresReadQueueEvents(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := withPostgresEventRepository(ctx, func(r *PostgresEventRepository, s *postgresTestStore) {
+		require.NoError(t, s.storeEvents(ctx, assigned, running))
+
+		// Only job sets with events are returned.
+		events, err := r.ReadQueueEvents(ctx, testQueue, map[string]string{jobSetName: "", "other": ""}, 500, time.Second)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assertExpected(t, events[jobSetName].Messages, events[jobSetName].LastMessageId, &expectedPending, &expectedRunning)
+
+		// Blocked reads return once any of the job sets has events.
+		lastIds := map[string]string{jobSetName: events[jobSetName].LastMessageId.String(), "other": ""}
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			assert.NoError(t, s.storeEvents(ctx, assigned))
+		}()
+		events, err = r.ReadQueueEvents(ctx, testQueue, lastIds, 500, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assertExpected(t, events[jobSetName].Messages, events[jobSetName].LastMessageId, &expectedPending)
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresGetLastId(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := withPostgresEventRepository(ctx, func(r *PostgresEventRepository, s *postgresTestStore) {
+		retrievedLastId, err := r.GetLastMessageId(ctx, testQueue, jobSetName)
+		assert.NoError(t, err)
+		assert.Equal(t, "0", retrievedLastId)
+
+		require.NoError(t, s.storeEvents(ctx, assigned, running))
+		events, _, err := r.ReadEvents(ctx, testQueue, jobSetName, "", 500, -1)
+		assert.NoError(t, err)
+		actualLastId := events[1].Id
+
+		retrievedLastId, err = r.GetLastMessageId(ctx, testQueue, jobSetName)
+		assert.NoError(t, err)
+		assert.Equal(t, actualLastId, retrievedLastId)
+	})
+	require.NoError(t, err)
+}
+
+func TestPostgresStreamExistsAndJobSetIds(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := withPostgresEventRepository(ctx, func(r *PostgresEventRepository, s *postgresTestStore) {
+		exists, err := r.CheckStreamExists(ctx, testQueue, jobSetName)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		require.NoError(t, s.storeEvents(ctx, assigned, running))
+
+		exists, err = r.CheckStreamExists(ctx, testQueue, jobSetName)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		jobSetIds, err := r.GetJobSetIds(ctx, testQueue, "test")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{jobSetName}, jobSetIds)
+
+		jobSetIds, err = r.GetJobSetIds(ctx, testQueue, "test_")
+		assert.NoError(t, err)
+		assert.Empty(t, jobSetIds)
+	})
+	require.NoError(t, err)
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	assert.Equal(t, `set\%\_x\\`, escapeLikePattern(`set%_x\`))
+}
+
+func TestEventListener(t *testing.T) {
+	l := newEventListener()
+	notifiedA, unsubscribeA := l.subscribe("queue:a")
+	notifiedB, unsubscribeB := l.subscribe("queue:b")
+	notifiedAOrC, unsubscribeAOrC := l.subscribe("queue:a", "queue:c")
+
+	l.notify("queue:a")
+	l.notify("queue:a")
+	assert.Len(t, notifiedA, 1)
+	assert.Len(t, notifiedB, 0)
+	assert.Len(t, notifiedAOrC, 1)
+
+	<-notifiedAOrC
+	l.notify("queue:c")
+	assert.Len(t, notifiedAOrC, 1)
+
+	unsubscribeA()
+	unsubscribeB()
+	unsubscribeAOrC()
+	assert.Empty(t, l.waiting)
+}
+
+// postgresTestStore stores events in the test database as the event ingester would.
+type postgresTestStore struct {
+	sink interface {
+		Store(ctx *armadacontext.Context, update *model.BatchUpdate) error
+	}
+}
+
+func (s *postgresTestStore) storeEvents(ctx *armadacontext.Context, events ...*armadaevents.EventSequence_Event) error {
+	bytes, err := proto.Marshal(&armadaevents.EventSequence{Events: events})
+	if err != nil {
+		return err
+	}
+	compressor, err := compress.NewZlibCompressor(0)
+	if err != nil {
+		return err
+	}
+	compressed, err := compressor.Compress(bytes)
+	if err != nil {
+		return err
+	}
+	return s.sink.Store(ctx, &model.BatchUpdate{
+		Events: []*model.Event{{Queue: testQueue, Jobset: jobSetName, Event: compressed}},
+	})
+}
+
+func withPostgresEventRepository(ctx *armadacontext.Context, action func(r *PostgresEventRepository, s *postgresTestStore)) error {
+	return schema.WithTestDb(func(db *pgxpool.Pool) error {
+		repo := NewPostgresEventRepository(db)
+		listenCtx, cancel := armadacontext.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			_ = repo.Run(listenCtx)
+		}()
+		sink := store.NewPostgresEventStore(
+			db,
+			configuration.EventRetentionPolicy{RetentionDuration: time.Hour},
+			[]*regexp.Regexp{regexp.MustCompile(".*")},
+			time.Millisecond,
+			time.Millisecond,
+		)
+		action(repo, &postgresTestStore{sink: sink})
+		return nil
+	})
+}
@@ -0,0 +1,263 @@
+package event
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/server/event/sequence"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	// Maximum number of messages read from a job set at a time.
+	watchQueueReadLimit = 500
+	// How often to list the job sets of the queue to find new ones.
+	watchQueueRescanInterval = 10 * time.Second
+)
+
+// WatchQueue streams back the events of all job sets in a queue, optionally filtered by job set prefix, event type
+// and job labels. All job sets are read together, a batch of messages of each at a time, and when watching reads block
+// until any of them has new events.
+func (s *EventServer) WatchQueue(request *api.WatchQueueRequest, stream api.Event_WatchQueueServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	q, err := s.queueRepository.GetQueue(ctx, request.Queue)
+	var expected *armadaqueue.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return status.Errorf(codes.NotFound, "[WatchQueue] Queue %s does not exist", request.Queue)
+	} else if err != nil {
+		return err
+	}
+
+	err = validateUserHasWatchPermissions(ctx, s.authorizer, q, request.JobSetPrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	filter, err := newQueueEventFilter(request)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "[WatchQueue] %s", err)
+	}
+	for jobSetId, fromId := range request.FromMessageIds {
+		if !sequence.IsValid(fromId) {
+			return status.Errorf(codes.InvalidArgument, "[WatchQueue] invalid message id %q for job set %s", fromId, jobSetId)
+		}
+	}
+
+	w := &queueWatcher{
+		eventRepository: s.eventRepository,
+		rescanInterval:  watchQueueRescanInterval,
+	}
+	return w.run(ctx, request, filter, stream)
+}
+
+// queueWatcher multiplexes the event streams of the job sets in a queue.
+type queueWatcher struct {
+	eventRepository EventRepository
+	rescanInterval  time.Duration
+}
+
+// jobSetCursor records how far a job set has been read and sent.
+type jobSetCursor struct {
+	// Id of the last message read from the job set.
+	readFrom string
+	// Messages up to and including this one have already been received by the client; nil if none have.
+	// Messages may be read before this point to learn the labels of jobs.
+	sentUpTo *sequence.ExternalSeqNo
+	// Id of the last message to send if not watching.
+	stopAfter string
+	// True once there's nothing more to send if not watching.
+	done bool
+}
+
+func (w *queueWatcher) run(ctx *armadacontext.Context, request *api.WatchQueueRequest, filter *queueEventFilter, stream api.Event_WatchQueueServer) error {
+	cursors := make(map[string]*jobSetCursor)
+	var lastScan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if lastScan.IsZero() || (request.Watch && time.Since(lastScan) >= w.rescanInterval) {
+			jobSetIds, err := w.eventRepository.GetJobSetIds(ctx, request.Queue, request.JobSetPrefix)
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "[WatchQueue] error listing job sets: %s", err)
+			}
+			for _, jobSetId := range jobSetIds {
+				if _, ok := cursors[jobSetId]; ok {
+					continue
+				}
+				cursor, err := w.newJobSetCursor(ctx, request, filter, jobSetId)
+				if err != nil {
+					return err
+				}
+				cursors[jobSetId] = cursor
+			}
+			lastScan = time.Now()
+		}
+
+		lastIds := make(map[string]string, len(cursors))
+		for jobSetId, cursor := range cursors {
+			if !cursor.done {
+				lastIds[jobSetId] = cursor.readFrom
+			}
+		}
+		// When watching, wait for new events until it's time to look for new job sets.
+		block := time.Duration(-1)
+		if request.Watch {
+			block = max(w.rescanInterval-time.Since(lastScan), time.Millisecond)
+		}
+		if len(lastIds) == 0 {
+			if !request.Watch {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(block):
+			}
+			continue
+		}
+
+		events, err := w.eventRepository.ReadQueueEvents(ctx, request.Queue, lastIds, watchQueueReadLimit, block)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return status.Errorf(codes.Unavailable, "[WatchQueue] error reading events: %s", err)
+		}
+		jobSetIds := maps.Keys(lastIds)
+		slices.Sort(jobSetIds)
+		for _, jobSetId := range jobSetIds {
+			cursor := cursors[jobSetId]
+			jobSetEvents := events[jobSetId]
+			if jobSetEvents == nil || len(jobSetEvents.Messages) == 0 {
+				if jobSetEvents != nil && jobSetEvents.LastMessageId != nil {
+					cursor.readFrom = jobSetEvents.LastMessageId.String()
+				}
+				cursor.done = !request.Watch
+				continue
+			}
+			for _, msg := range jobSetEvents.Messages {
+				cursor.readFrom = msg.Id
+				send, err := cursor.isUnsent(msg.Id)
+				if err != nil {
+					return status.Errorf(codes.Internal, "[WatchQueue] %s", err)
+				}
+				// The filter has to see every message read, as it learns the labels of jobs from them.
+				if filter.matches(msg.Message) && send {
+					err := stream.Send(&api.QueueEventStreamMessage{JobSetId: jobSetId, Id: msg.Id, Message: msg.Message})
+					if err != nil {
+						return status.Errorf(codes.Unavailable, "[WatchQueue] error sending event: %s", err)
+					}
+				}
+				if !request.Watch && msg.Id == cursor.stopAfter {
+					cursor.done = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (w *queueWatcher) newJobSetCursor(ctx *armadacontext.Context, request *api.WatchQueueRequest, filter *queueEventFilter, jobSetId string) (*jobSetCursor, error) {
+	cursor := &jobSetCursor{readFrom: request.FromMessageIds[jobSetId]}
+	if cursor.readFrom != "" {
+		sentUpTo, err := sequence.Parse(cursor.readFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[WatchQueue] %s", err)
+		}
+		cursor.sentUpTo = sentUpTo
+		// Labels are only known for jobs whose submitted event is read, so read the job set from the start.
+		if filter.filtersOnLabels() {
+			cursor.readFrom = ""
+		}
+	}
+	if !request.Watch {
+		stopAfter, err := w.eventRepository.GetLastMessageId(ctx, request.Queue, jobSetId)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[WatchQueue] error getting ID of last message of job set %s: %s", jobSetId, err)
+		}
+		cursor.stopAfter = stopAfter
+	}
+	return cursor, nil
+}
+
+// isUnsent returns true if the message with the given id hasn't yet been received by the client.
+func (c *jobSetCursor) isUnsent(messageId string) (bool, error) {
+	if c.sentUpTo == nil {
+		return true, nil
+	}
+	seqNo, err := sequence.Parse(messageId)
+	if err != nil {
+		return false, err
+	}
+	return seqNo.IsAfter(c.sentUpTo), nil
+}
+
+// queueEventFilter selects the events matching the event type and label filters of a WatchQueueRequest.
+type queueEventFilter struct {
+	eventTypes map[string]bool
+	labels     map[string]string
+	// Whether each job whose submitted event has been seen has all the labels filtered on.
+	// Jobs are removed once they reach a terminal state.
+	jobsMatchingLabels map[string]bool
+}
+
+func newQueueEventFilter(request *api.WatchQueueRequest) (*queueEventFilter, error) {
+	filter := &queueEventFilter{
+		eventTypes:         make(map[string]bool, len(request.EventTypes)),
+		labels:             request.Labels,
+		jobsMatchingLabels: make(map[string]bool),
+	}
+	for _, eventType := range request.EventTypes {
+		if !api.IsValidEventType(eventType) {
+			return nil, errors.Errorf("unknown event type %q", eventType)
+		}
+		filter.eventTypes[eventType] = true
+	}
+	return filter, nil
+}
+
+func (f *queueEventFilter) filtersOnLabels() bool {
+	return len(f.labels) > 0
+}
+
+// matches returns true if message passes the filter.
+// Messages must be passed in the order they were published, as the labels of jobs are learnt from their submitted events.
+func (f *queueEventFilter) matches(message *api.EventMessage) bool {
+	if f.filtersOnLabels() {
+		event, err := api.UnwrapEvent(message)
+		if err != nil {
+			return false
+		}
+		jobId := event.GetJobId()
+		switch e := message.Events.(type) {
+		case *api.EventMessage_Submitted:
+			f.jobsMatchingLabels[jobId] = hasLabels(e.Submitted.GetJob().GetLabels(), f.labels)
+		case *api.EventMessage_Succeeded, *api.EventMessage_Failed, *api.EventMessage_Cancelled:
+			defer delete(f.jobsMatchingLabels, jobId)
+		}
+		if !f.jobsMatchingLabels[jobId] {
+			return false
+		}
+	}
+	return len(f.eventTypes) == 0 || f.eventTypes[api.EventType(message)]
+}
+
+func hasLabels(labels map[string]string, required map[string]string) bool {
+	for key, value := range required {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
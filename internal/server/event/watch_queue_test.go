@@ -0,0 +1,294 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/server/event/sequence"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// fakeEventRepository stores the events of each job set in memory, each in its own message.
+type fakeEventRepository struct {
+	// Events by queue and job set.
+	events map[string]map[string][]*api.EventMessage
+}
+
+func (r *fakeEventRepository) CheckStreamExists(_ *armadacontext.Context, queue string, jobSetId string) (bool, error) {
+	_, ok := r.events[queue][jobSetId]
+	return ok, nil
+}
+
+func (r *fakeEventRepository) ReadEvents(_ *armadacontext.Context, queue, jobSetId string, lastId string, limit int64, _ time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	from, err := sequence.Parse(lastId)
+	if err != nil {
+		return nil, nil, err
+	}
+	messages := make([]*api.EventStreamMessage, 0)
+	for i, event := range r.events[queue][jobSetId] {
+		id := messageId(i)
+		if id.IsAfter(from) && int64(len(messages)) < limit {
+			messages = append(messages, &api.EventStreamMessage{Id: id.String(), Message: event})
+		}
+	}
+	return messages, nil, nil
+}
+
+func (r *fakeEventRepository) GetLastMessageId(_ *armadacontext.Context, queue, jobSetId string) (string, error) {
+	events := r.events[queue][jobSetId]
+	if len(events) == 0 {
+		return "0", nil
+	}
+	return messageId(len(events) - 1).String(), nil
+}
+
+func (r *fakeEventRepository) GetJobSetIds(_ *armadacontext.Context, queue string, jobSetPrefix string) ([]string, error) {
+	var jobSetIds []string
+	for jobSetId := range r.events[queue] {
+		if strings.HasPrefix(jobSetId, jobSetPrefix) {
+			jobSetIds = append(jobSetIds, jobSetId)
+		}
+	}
+	return jobSetIds, nil
+}
+
+func (r *fakeEventRepository) ReadQueueEvents(ctx *armadacontext.Context, queue string, lastIds map[string]string, limit int64, _ time.Duration) (map[string]*JobSetEvents, error) {
+	result := make(map[string]*JobSetEvents)
+	for jobSetId, lastId := range lastIds {
+		messages, lastMessageId, err := r.ReadEvents(ctx, queue, jobSetId, lastId, limit, -1)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) > 0 {
+			result[jobSetId] = &JobSetEvents{Messages: messages, LastMessageId: lastMessageId}
+		}
+	}
+	return result, nil
+}
+
+func messageId(i int) *sequence.ExternalSeqNo {
+	return &sequence.ExternalSeqNo{Time: 1000, Seq: int64(i + 1), Last: true}
+}
+
+type fakeQueueRepository struct {
+	queues []queue.Queue
+}
+
+func (r *fakeQueueRepository) GetAllQueues(_ *armadacontext.Context) ([]queue.Queue, error) {
+	return r.queues, nil
+}
+
+func (r *fakeQueueRepository) GetQueue(_ *armadacontext.Context, name string) (queue.Queue, error) {
+	for _, q := range r.queues {
+		if q.Name == name {
+			return q, nil
+		}
+	}
+	return queue.Queue{}, &armadaqueue.ErrQueueNotFound{QueueName: name}
+}
+
+type queueEventStreamMock struct {
+	grpc.ServerStream
+	ctx context.Context
+	// Called after each message is sent.
+	onSend       func()
+	sentMessages []*api.QueueEventStreamMessage
+}
+
+func (s *queueEventStreamMock) Send(m *api.QueueEventStreamMessage) error {
+	s.sentMessages = append(s.sentMessages, m)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
+
+func (s *queueEventStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func submittedMessage(jobId string, labels map[string]string) *api.EventMessage {
+	return &api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{
+		JobId: jobId,
+		Job:   &api.Job{Id: jobId, Labels: labels},
+	}}}
+}
+
+func runningMessage(jobId string) *api.EventMessage {
+	return &api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: jobId}}}
+}
+
+func succeededMessage(jobId string) *api.EventMessage {
+	return &api.EventMessage{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{JobId: jobId}}}
+}
+
+func TestEventServer_WatchQueue(t *testing.T) {
+	events := map[string]map[string][]*api.EventMessage{
+		"queue-a": {
+			"set-a": {submittedMessage("job-1", map[string]string{"team": "ml"}), runningMessage("job-1"), succeededMessage("job-1")},
+			"set-b": {submittedMessage("job-2", nil), succeededMessage("job-2")},
+			"other": {submittedMessage("job-3", map[string]string{"team": "ml"})},
+		},
+		"queue-a:set": {
+			"x": {submittedMessage("job-4", nil)},
+		},
+		"queue-b": {
+			"set-a": {submittedMessage("job-5", nil)},
+		},
+	}
+
+	tests := map[string]struct {
+		request          *api.WatchQueueRequest
+		expectedMessages []string
+		expectedCode     codes.Code
+	}{
+		"all events": {
+			request: &api.WatchQueueRequest{Queue: "queue-a"},
+			expectedMessages: []string{
+				"other/1 submitted job-3",
+				"set-a/1 submitted job-1",
+				"set-a/2 running job-1",
+				"set-a/3 succeeded job-1",
+				"set-b/1 submitted job-2",
+				"set-b/2 succeeded job-2",
+			},
+		},
+		"job set prefix": {
+			request: &api.WatchQueueRequest{Queue: "queue-a", JobSetPrefix: "set-"},
+			expectedMessages: []string{
+				"set-a/1 submitted job-1",
+				"set-a/2 running job-1",
+				"set-a/3 succeeded job-1",
+				"set-b/1 submitted job-2",
+				"set-b/2 succeeded job-2",
+			},
+		},
+		"event types": {
+			request: &api.WatchQueueRequest{Queue: "queue-a", EventTypes: []string{"succeeded"}},
+			expectedMessages: []string{
+				"set-a/3 succeeded job-1",
+				"set-b/2 succeeded job-2",
+			},
+		},
+		"labels": {
+			request: &api.WatchQueueRequest{Queue: "queue-a", Labels: map[string]string{"team": "ml"}},
+			expectedMessages: []string{
+				"other/1 submitted job-3",
+				"set-a/1 submitted job-1",
+				"set-a/2 running job-1",
+				"set-a/3 succeeded job-1",
+			},
+		},
+		"resume": {
+			request: &api.WatchQueueRequest{
+				Queue:          "queue-a",
+				FromMessageIds: map[string]string{"set-a": messageId(1).String(), "set-b": messageId(1).String()},
+			},
+			expectedMessages: []string{
+				"other/1 submitted job-3",
+				"set-a/3 succeeded job-1",
+			},
+		},
+		"resume with labels": {
+			request: &api.WatchQueueRequest{
+				Queue:          "queue-a",
+				Labels:         map[string]string{"team": "ml"},
+				EventTypes:     []string{"running", "succeeded"},
+				FromMessageIds: map[string]string{"set-a": messageId(0).String(), "set-b": messageId(0).String()},
+			},
+			expectedMessages: []string{
+				"set-a/2 running job-1",
+				"set-a/3 succeeded job-1",
+			},
+		},
+		"queue does not exist": {
+			request:      &api.WatchQueueRequest{Queue: "queue-c"},
+			expectedCode: codes.NotFound,
+		},
+		"unknown event type": {
+			request:      &api.WatchQueueRequest{Queue: "queue-a", EventTypes: []string{"finished"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		"invalid message id": {
+			request:      &api.WatchQueueRequest{Queue: "queue-a", FromMessageIds: map[string]string{"set-a": "1-0"}},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			defer cancel()
+			s := NewEventServer(
+				&FakeActionAuthorizer{},
+				&fakeEventRepository{events: events},
+				&fakeQueueRepository{queues: []queue.Queue{{Name: "queue-a"}, {Name: "queue-a:set"}, {Name: "queue-b"}}},
+			)
+			stream := &queueEventStreamMock{ctx: ctx}
+
+			err := s.WatchQueue(tc.request, stream)
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMessages, formatQueueEventStreamMessages(t, stream.sentMessages))
+		})
+	}
+}
+
+func TestEventServer_WatchQueue_Watch(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	repository := &fakeEventRepository{events: map[string]map[string][]*api.EventMessage{
+		"queue-a": {"set-a": {submittedMessage("job-1", nil)}},
+	}}
+	w := &queueWatcher{
+		eventRepository: repository,
+		rescanInterval:  time.Millisecond,
+	}
+	request := &api.WatchQueueRequest{Queue: "queue-a", Watch: true}
+	filter, err := newQueueEventFilter(request)
+	require.NoError(t, err)
+
+	stream := &queueEventStreamMock{ctx: ctx}
+	stream.onSend = func() {
+		switch len(stream.sentMessages) {
+		case 1:
+			// Publish an event to an existing job set and create a new job set.
+			repository.events["queue-a"]["set-a"] = append(repository.events["queue-a"]["set-a"], succeededMessage("job-1"))
+			repository.events["queue-a"]["set-b"] = []*api.EventMessage{submittedMessage("job-2", nil)}
+		case 3:
+			cancel()
+		}
+	}
+
+	require.NoError(t, w.run(ctx, request, filter, stream))
+	assert.ElementsMatch(t,
+		[]string{"set-a/1 submitted job-1", "set-a/2 succeeded job-1", "set-b/1 submitted job-2"},
+		formatQueueEventStreamMessages(t, stream.sentMessages),
+	)
+}
+
+func formatQueueEventStreamMessages(t *testing.T, messages []*api.QueueEventStreamMessage) []string {
+	var formatted []string
+	for _, msg := range messages {
+		seqNo, err := sequence.Parse(msg.Id)
+		require.NoError(t, err)
+		event, err := api.UnwrapEvent(msg.Message)
+		require.NoError(t, err)
+		formatted = append(formatted, fmt.Sprintf("%s/%d %s %s", msg.JobSetId, seqNo.Seq, api.EventType(msg.Message), event.GetJobId()))
+	}
+	return formatted
+}
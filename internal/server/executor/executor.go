@@ -3,11 +3,13 @@ package executor
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -171,3 +173,99 @@ func (s *Server) CancelOnExecutor(grpcCtx context.Context, req *api.ExecutorCanc
 
 	return &types.Empty{}, nil
 }
+
+func (s *Server) DrainNodes(grpcCtx context.Context, req *api.ExecutorNodeDrainRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := s.authorizer.AuthorizeAction(ctx, permissions.UpdateExecutorSettings)
+	if err == nil && req.Preempt {
+		err = s.authorizer.AuthorizeAction(ctx, permissions.PreemptAnyJobs)
+	}
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "error draining nodes on executor %s: %s", req.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+
+	if err := validateNodeDrainTarget(req.Name, req.NodeName, req.NodeSelector); err != nil {
+		return nil, err
+	} else if req.Reason == "" {
+		return nil, fmt.Errorf("drain reason must be specified when draining nodes")
+	}
+
+	requestingPrincipal := auth.GetPrincipal(ctx)
+
+	es := &controlplaneevents.Event{
+		Created: protoutil.ToTimestamp(s.clock.Now().UTC()),
+		Event: &controlplaneevents.Event_NodeDrainUpsert{
+			NodeDrainUpsert: &controlplaneevents.NodeDrainUpsert{
+				Name:         req.Name,
+				NodeName:     req.NodeName,
+				NodeSelector: req.NodeSelector,
+				Preempt:      req.Preempt,
+				Reason:       req.Reason,
+				SetByUser:    requestingPrincipal.GetName(),
+			},
+		},
+	}
+
+	err = s.publisher.PublishMessages(ctx, es)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to send events to Pulsar")
+	}
+
+	return &types.Empty{}, nil
+}
+
+func (s *Server) UndrainNodes(grpcCtx context.Context, req *api.ExecutorNodeUndrainRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := s.authorizer.AuthorizeAction(ctx, permissions.UpdateExecutorSettings)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "error undraining nodes on executor %s: %s", req.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+
+	if err := validateNodeDrainTarget(req.Name, req.NodeName, req.NodeSelector); err != nil {
+		return nil, err
+	}
+
+	es := &controlplaneevents.Event{
+		Created: protoutil.ToTimestamp(s.clock.Now().UTC()),
+		Event: &controlplaneevents.Event_NodeDrainDelete{
+			NodeDrainDelete: &controlplaneevents.NodeDrainDelete{
+				Name:         req.Name,
+				NodeName:     req.NodeName,
+				NodeSelector: req.NodeSelector,
+			},
+		},
+	}
+
+	err = s.publisher.PublishMessages(ctx, es)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to send events to Pulsar")
+	}
+
+	return &types.Empty{}, nil
+}
+
+// validateNodeDrainTarget checks that a drain targets exactly one of a node name or a non-empty, valid node selector.
+func validateNodeDrainTarget(executor string, nodeName string, nodeSelector map[string]string) error {
+	if executor == "" {
+		return fmt.Errorf("must provide non-empty executor name when draining nodes")
+	} else if nodeName == "" && len(nodeSelector) == 0 {
+		return fmt.Errorf("must provide either a node name or a node selector when draining nodes")
+	} else if nodeName != "" && len(nodeSelector) > 0 {
+		return fmt.Errorf("must not provide both a node name and a node selector when draining nodes")
+	}
+	for key, value := range nodeSelector {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector value %q for key %q: %s", value, key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
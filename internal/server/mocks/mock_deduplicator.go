@@ -41,6 +41,21 @@ func (m *MockDeduplicator) EXPECT() *MockDeduplicatorMockRecorder {
 	return m.recorder
 }
 
+// GetDependencyJobIds mocks base method.
+func (m *MockDeduplicator) GetDependencyJobIds(ctx *armadacontext.Context, queue string, clientIds []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyJobIds", ctx, queue, clientIds)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyJobIds indicates an expected call of GetDependencyJobIds.
+func (mr *MockDeduplicatorMockRecorder) GetDependencyJobIds(ctx, queue, clientIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyJobIds", reflect.TypeOf((*MockDeduplicator)(nil).GetDependencyJobIds), ctx, queue, clientIds)
+}
+
 // GetOriginalJobIds mocks base method.
 func (m *MockDeduplicator) GetOriginalJobIds(ctx *armadacontext.Context, queue string, jobRequests []*api.JobSubmitRequestItem) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -57,15 +72,15 @@ func (mr *MockDeduplicatorMockRecorder) GetOriginalJobIds(ctx, queue, jobRequest
 }
 
 // StoreOriginalJobIds mocks base method.
-func (m *MockDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string) error {
+func (m *MockDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string, arraySizes map[string]uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreOriginalJobIds", ctx, queue, mappings)
+	ret := m.ctrl.Call(m, "StoreOriginalJobIds", ctx, queue, mappings, arraySizes)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // StoreOriginalJobIds indicates an expected call of StoreOriginalJobIds.
-func (mr *MockDeduplicatorMockRecorder) StoreOriginalJobIds(ctx, queue, mappings any) *gomock.Call {
+func (mr *MockDeduplicatorMockRecorder) StoreOriginalJobIds(ctx, queue, mappings, arraySizes any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOriginalJobIds", reflect.TypeOf((*MockDeduplicator)(nil).StoreOriginalJobIds), ctx, queue, mappings)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOriginalJobIds", reflect.TypeOf((*MockDeduplicator)(nil).StoreOriginalJobIds), ctx, queue, mappings, arraySizes)
 }
@@ -0,0 +1,50 @@
+package permissions
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// QueueActionAuthorizer checks whether the caller may perform an action on a queue. It's implemented by auth.ActionAuthorizer.
+type QueueActionAuthorizer interface {
+	AuthorizeQueueAction(ctx *armadacontext.Context, queue queue.Queue, anyPerm permission.Permission, perm queue.PermissionVerb) error
+}
+
+// CanWatchQueue returns true if the caller may watch the events and jobs of queue q,
+// i.e., if they have the WatchAllEvents permission or the watch permission of q.
+func CanWatchQueue(ctx *armadacontext.Context, authorizer QueueActionAuthorizer, q queue.Queue) (bool, error) {
+	permErr, err := authorizeWatchQueue(ctx, authorizer, q)
+	if err != nil {
+		return false, err
+	}
+	return permErr == nil, nil
+}
+
+// AuthorizeWatchQueue returns a PermissionDenied error if the caller may not watch the events and jobs of queue q.
+// action describes what the caller is doing, e.g., "listing jobs in queue a", and is included in the error.
+func AuthorizeWatchQueue(ctx *armadacontext.Context, authorizer QueueActionAuthorizer, q queue.Queue, action string) error {
+	permErr, err := authorizeWatchQueue(ctx, authorizer, q)
+	if err != nil {
+		return err
+	} else if permErr != nil {
+		return status.Errorf(codes.PermissionDenied, "error %s: %s", action, permErr)
+	}
+	return nil
+}
+
+func authorizeWatchQueue(ctx *armadacontext.Context, authorizer QueueActionAuthorizer, q queue.Queue) (*armadaerrors.ErrUnauthorized, error) {
+	err := authorizer.AuthorizeQueueAction(ctx, q, WatchAllEvents, queue.PermissionVerbWatch)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return permErr, nil
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	return nil, nil
+}
@@ -32,6 +32,7 @@ type Job struct {
 	Annotations               []byte           `db:"annotations"`
 	ExternalJobUri            *string          `db:"external_job_uri"`
 	CancelUser                *string          `db:"cancel_user"`
+	Labels                    []byte           `db:"labels"`
 }
 
 type JobDeduplication struct {
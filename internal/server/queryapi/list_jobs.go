@@ -0,0 +1,192 @@
+package queryapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookout/repository"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Jobs are listed by descending job id. Job ids are ULIDs, so this lists the most recently submitted jobs first.
+var listJobsOrder = &model.Order{Field: "jobId", Direction: model.DirectionDesc}
+
+// getJobsRepository is the subset of *repository.SqlGetJobsRepository used to list jobs.
+type getJobsRepository interface {
+	GetJobs(ctx *armadacontext.Context, filters []*model.Filter, activeJobSets bool, order *model.Order, skip int, take int) (*repository.GetJobsResult, error)
+}
+
+// ListJobs returns a page of the jobs matching the filters in req.
+// Only jobs in queues the caller has permission to watch are returned.
+func (q *QueryApi) ListJobs(grpcCtx context.Context, req *api.ListJobsRequest) (*api.ListJobsResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	filters, err := listJobsFilters(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[ListJobs] %s", err)
+	}
+	pageSize := q.maxQueryItems
+	if req.PageSize > 0 && int(req.PageSize) < pageSize {
+		pageSize = int(req.PageSize)
+	}
+
+	queueFilter, ok, err := q.watchableQueuesFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return &api.ListJobsResponse{}, nil
+	}
+	if queueFilter != nil {
+		filters = append(filters, queueFilter)
+	}
+	// The page token is the id of the last job of the previous page; since jobs are listed by descending job id,
+	// the next page starts with the jobs with lower ids. Unlike an offset, this doesn't require the database to skip
+	// over the jobs of previous pages, and jobs aren't skipped or repeated when jobs are submitted between requests.
+	if req.PageToken != "" {
+		filters = append(filters, &model.Filter{Field: "jobId", Match: model.MatchLessThan, Value: req.PageToken})
+	}
+
+	// Fetch one more job than requested to find out whether there's another page.
+	result, err := q.jobsRepository.GetJobs(ctx, filters, false, listJobsOrder, 0, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	response := &api.ListJobsResponse{}
+	for i, job := range result.Jobs {
+		if i == pageSize {
+			response.NextPageToken = result.Jobs[i-1].JobId
+			break
+		}
+		response.Jobs = append(response.Jobs, jobModelToJobDetails(job))
+	}
+	return response, nil
+}
+
+// watchableQueuesFilter returns a filter restricting jobs to those in queues the caller has permission to watch,
+// following the same rules as for watching job set events.
+// The returned filter is nil if the caller may watch jobs in any queue, and ok is false if there are no such queues.
+func (q *QueryApi) watchableQueuesFilter(ctx *armadacontext.Context, req *api.ListJobsRequest) (*model.Filter, bool, error) {
+	if req.Queue != "" {
+		apiQueue, err := q.queueRepository.GetQueue(ctx, req.Queue)
+		var notFoundErr *armadaqueue.ErrQueueNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, false, status.Errorf(codes.NotFound, "[ListJobs] queue %s does not exist", req.Queue)
+		} else if err != nil {
+			return nil, false, err
+		}
+		if err := permissions.AuthorizeWatchQueue(ctx, q.authorizer, apiQueue, "listing jobs in queue "+apiQueue.Name); err != nil {
+			return nil, false, err
+		}
+		return &model.Filter{Field: "queue", Match: model.MatchExact, Value: req.Queue}, true, nil
+	}
+
+	if err := q.authorizer.AuthorizeAction(ctx, permissions.WatchAllEvents); err == nil {
+		return nil, true, nil
+	}
+	queues, err := q.queueRepository.GetAllQueues(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	var queueNames []interface{}
+	for _, apiQueue := range queues {
+		if ok, err := permissions.CanWatchQueue(ctx, q.authorizer, apiQueue); err != nil {
+			return nil, false, err
+		} else if ok {
+			queueNames = append(queueNames, apiQueue.Name)
+		}
+	}
+	if len(queueNames) == 0 {
+		return nil, false, nil
+	}
+	return &model.Filter{Field: "queue", Match: model.MatchAnyOf, Value: queueNames}, true, nil
+}
+
+// listJobsFilters converts the filters in req, other than the queue, to Lookout filters.
+func listJobsFilters(req *api.ListJobsRequest) ([]*model.Filter, error) {
+	var filters []*model.Filter
+	if req.Jobset != "" {
+		if req.Queue == "" {
+			return nil, errors.New("queue must be provided to filter by job set")
+		}
+		filters = append(filters, &model.Filter{Field: "jobSet", Match: model.MatchExact, Value: req.Jobset})
+	}
+	if len(req.States) > 0 {
+		states := make([]interface{}, len(req.States))
+		for i, state := range req.States {
+			if _, ok := lookout.JobStateOrdinalMap[lookout.JobState(state.String())]; !ok {
+				return nil, errors.Errorf("can't filter by state %s", state)
+			}
+			states[i] = state.String()
+		}
+		filters = append(filters, &model.Filter{Field: "state", Match: model.MatchAnyOf, Value: states})
+	}
+	for key, value := range req.Labels {
+		filters = append(filters, &model.Filter{Field: key, Match: model.MatchExact, Value: value, IsLabel: true})
+	}
+	for key, value := range req.Annotations {
+		filters = append(filters, &model.Filter{Field: key, Match: model.MatchExact, Value: value, IsAnnotation: true})
+	}
+	if req.SubmittedAfter != nil {
+		filters = append(filters, &model.Filter{
+			Field: "submitted", Match: model.MatchGreaterThanOrEqualTo, Value: protoutil.ToStdTime(req.SubmittedAfter).UTC(),
+		})
+	}
+	if req.SubmittedBefore != nil {
+		filters = append(filters, &model.Filter{
+			Field: "submitted", Match: model.MatchLessThan, Value: protoutil.ToStdTime(req.SubmittedBefore).UTC(),
+		})
+	}
+	return filters, nil
+}
+
+func jobModelToJobDetails(job *model.Job) *api.JobDetails {
+	details := &api.JobDetails{
+		JobId:            job.JobId,
+		Queue:            job.Queue,
+		Jobset:           job.JobSet,
+		Namespace:        NilStringToString(job.Namespace),
+		State:            api.JobState(api.JobState_value[job.State]),
+		SubmittedTs:      protoutil.ToTimestamp(job.Submitted),
+		CancelReason:     NilStringToString(job.CancelReason),
+		CancelUser:       NilStringToString(job.CancelUser),
+		LastTransitionTs: protoutil.ToTimestamp(job.LastTransitionTime),
+		LatestRunId:      NilStringToString(job.LastActiveRunId),
+	}
+	if _, ok := api.JobState_value[job.State]; !ok {
+		details.State = api.JobState_UNKNOWN
+	}
+	if job.Cancelled != nil {
+		details.CancelTs = protoutil.ToTimestamp(*job.Cancelled)
+	}
+	// Runs are ordered oldest first, whereas GetJobDetails returns the latest run first.
+	for i := len(job.Runs) - 1; i >= 0; i-- {
+		run := job.Runs[i]
+		runState, ok := JobRunStateMap[int16(run.JobRunState)]
+		if !ok {
+			runState = api.JobRunState_RUN_STATE_UNKNOWN
+		}
+		details.JobRuns = append(details.JobRuns, &api.JobRunDetails{
+			RunId:      run.RunId,
+			JobId:      job.JobId,
+			State:      runState,
+			Cluster:    run.Cluster,
+			Node:       NilStringToString(run.Node),
+			Pool:       run.Pool,
+			LeasedTs:   postgreSQLTimeToTimestamp(run.Leased),
+			PendingTs:  postgreSQLTimeToTimestamp(run.Pending),
+			StartedTs:  postgreSQLTimeToTimestamp(run.Started),
+			FinishedTs: postgreSQLTimeToTimestamp(run.Finished),
+			ExitCode:   NilInt32ToInt32(run.ExitCode),
+		})
+	}
+	return details
+}
@@ -0,0 +1,253 @@
+package queryapi
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookout/repository"
+	"github.com/armadaproject/armada/internal/server/mocks"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+type fakeGetJobsRepository struct {
+	jobs []*model.Job
+	// Arguments of the last call to GetJobs.
+	filters []*model.Filter
+	take    int
+}
+
+// GetJobs returns the jobs, which must be ordered by descending job id, after applying any job id filter.
+func (r *fakeGetJobsRepository) GetJobs(_ *armadacontext.Context, filters []*model.Filter, _ bool, _ *model.Order, _ int, take int) (*repository.GetJobsResult, error) {
+	r.filters = filters
+	r.take = take
+	var jobs []*model.Job
+	for _, job := range r.jobs {
+		if !slices.ContainsFunc(filters, func(filter *model.Filter) bool {
+			return filter.Field == "jobId" && filter.Match == model.MatchLessThan && job.JobId >= filter.Value.(string)
+		}) {
+			jobs = append(jobs, job)
+		}
+	}
+	return &repository.GetJobsResult{Jobs: jobs[:min(take, len(jobs))]}, nil
+}
+
+func TestListJobs(t *testing.T) {
+	queueA := queue.Queue{Name: "queue-a"}
+	queueB := queue.Queue{Name: "queue-b"}
+	unauthorized := &armadaerrors.ErrUnauthorized{Principal: "alice", Permission: permissions.WatchAllEvents}
+	submittedAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		request *api.ListJobsRequest
+		// Whether the caller has the global permission to watch all queues.
+		watchAll bool
+		// Queues the caller may watch.
+		watchableQueues  map[string]bool
+		expectedFilters  []*model.Filter
+		expectedJobIds   []string
+		expectedNextPage string
+		expectedCode     codes.Code
+	}{
+		"queue and filters": {
+			request: &api.ListJobsRequest{
+				Queue:          "queue-a",
+				Jobset:         "set-a",
+				States:         []api.JobState{api.JobState_RUNNING, api.JobState_PENDING},
+				Labels:         map[string]string{"team": "ml"},
+				Annotations:    map[string]string{"owner": "alice"},
+				SubmittedAfter: protoutil.ToTimestamp(submittedAfter),
+			},
+			watchableQueues: map[string]bool{"queue-a": true},
+			expectedFilters: []*model.Filter{
+				{Field: "jobSet", Match: model.MatchExact, Value: "set-a"},
+				{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"RUNNING", "PENDING"}},
+				{Field: "team", Match: model.MatchExact, Value: "ml", IsLabel: true},
+				{Field: "owner", Match: model.MatchExact, Value: "alice", IsAnnotation: true},
+				{Field: "submitted", Match: model.MatchGreaterThanOrEqualTo, Value: submittedAfter},
+				{Field: "queue", Match: model.MatchExact, Value: "queue-a"},
+			},
+			expectedJobIds: []string{"job-3", "job-2", "job-1"},
+		},
+		"paging": {
+			request:          &api.ListJobsRequest{Queue: "queue-a", PageSize: 2},
+			watchableQueues:  map[string]bool{"queue-a": true},
+			expectedFilters:  []*model.Filter{{Field: "queue", Match: model.MatchExact, Value: "queue-a"}},
+			expectedJobIds:   []string{"job-3", "job-2"},
+			expectedNextPage: "job-2",
+		},
+		"last page": {
+			request:         &api.ListJobsRequest{Queue: "queue-a", PageSize: 2, PageToken: "job-2"},
+			watchableQueues: map[string]bool{"queue-a": true},
+			expectedFilters: []*model.Filter{
+				{Field: "queue", Match: model.MatchExact, Value: "queue-a"},
+				{Field: "jobId", Match: model.MatchLessThan, Value: "job-2"},
+			},
+			expectedJobIds: []string{"job-1"},
+		},
+		"all queues": {
+			request:        &api.ListJobsRequest{},
+			watchAll:       true,
+			expectedJobIds: []string{"job-3", "job-2", "job-1"},
+		},
+		"only watchable queues": {
+			request:         &api.ListJobsRequest{},
+			watchableQueues: map[string]bool{"queue-b": true},
+			expectedFilters: []*model.Filter{{Field: "queue", Match: model.MatchAnyOf, Value: []interface{}{"queue-b"}}},
+			expectedJobIds:  []string{"job-3", "job-2", "job-1"},
+		},
+		"no watchable queues": {
+			request: &api.ListJobsRequest{},
+		},
+		"queue not watchable": {
+			request:      &api.ListJobsRequest{Queue: "queue-a"},
+			expectedCode: codes.PermissionDenied,
+		},
+		"queue does not exist": {
+			request:      &api.ListJobsRequest{Queue: "queue-c"},
+			expectedCode: codes.NotFound,
+		},
+		"job set without queue": {
+			request:      &api.ListJobsRequest{Jobset: "set-a"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"unsupported state": {
+			request:      &api.ListJobsRequest{Queue: "queue-a", States: []api.JobState{api.JobState_SUBMITTED}},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			ctrl := gomock.NewController(t)
+			queueRepository := mocks.NewMockQueueRepository(ctrl)
+			queueRepository.EXPECT().GetAllQueues(gomock.Any()).Return([]queue.Queue{queueA, queueB}, nil).AnyTimes()
+			queueRepository.EXPECT().GetQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *armadacontext.Context, name string) (queue.Queue, error) {
+					for _, q := range []queue.Queue{queueA, queueB} {
+						if q.Name == name {
+							return q, nil
+						}
+					}
+					return queue.Queue{}, &armadaqueue.ErrQueueNotFound{QueueName: name}
+				},
+			).AnyTimes()
+			authorizer := mocks.NewMockActionAuthorizer(ctrl)
+			authorizer.EXPECT().AuthorizeAction(gomock.Any(), permission.Permission(permissions.WatchAllEvents)).DoAndReturn(
+				func(_ *armadacontext.Context, _ any) error {
+					if tc.watchAll {
+						return nil
+					}
+					return unauthorized
+				},
+			).AnyTimes()
+			authorizer.EXPECT().AuthorizeQueueAction(gomock.Any(), gomock.Any(), permission.Permission(permissions.WatchAllEvents), queue.PermissionVerbWatch).DoAndReturn(
+				func(_ *armadacontext.Context, q queue.Queue, _ any, _ any) error {
+					if tc.watchAll || tc.watchableQueues[q.Name] {
+						return nil
+					}
+					return unauthorized
+				},
+			).AnyTimes()
+			jobsRepository := &fakeGetJobsRepository{
+				jobs: []*model.Job{
+					{JobId: "job-3", Queue: "queue-a", State: "RUNNING", Namespace: pointer.String("ns")},
+					{JobId: "job-2", Queue: "queue-a", State: "PENDING"},
+					{JobId: "job-1", Queue: "queue-b", State: "SUCCEEDED"},
+				},
+			}
+			queryApi := &QueryApi{
+				jobsRepository:  jobsRepository,
+				queueRepository: queueRepository,
+				authorizer:      authorizer,
+				maxQueryItems:   defaultMaxQueryItems,
+			}
+
+			response, err := queryApi.ListJobs(ctx, tc.request)
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			var jobIds []string
+			for _, job := range response.Jobs {
+				jobIds = append(jobIds, job.JobId)
+			}
+			assert.Equal(t, tc.expectedJobIds, jobIds)
+			assert.Equal(t, tc.expectedNextPage, response.NextPageToken)
+			if tc.expectedJobIds != nil {
+				assert.Equal(t, tc.expectedFilters, jobsRepository.filters)
+			}
+		})
+	}
+}
+
+func TestJobModelToJobDetails(t *testing.T) {
+	submitted := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	started := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	details := jobModelToJobDetails(&model.Job{
+		JobId:              "job-1",
+		Queue:              "queue-a",
+		JobSet:             "set-a",
+		State:              "FAILED",
+		Submitted:          submitted,
+		LastTransitionTime: started,
+		LastActiveRunId:    pointer.String("run-2"),
+		Runs: []*model.Run{
+			{RunId: "run-1", Cluster: "cluster-a", JobRunState: 6},
+			{
+				RunId:       "run-2",
+				Cluster:     "cluster-a",
+				Pool:        "cpu",
+				Node:        pointer.String("node-1"),
+				Started:     model.NewPostgreSQLTime(&started),
+				ExitCode:    pointer.Int32(1),
+				JobRunState: 4,
+			},
+		},
+	})
+
+	assert.Equal(t, &api.JobDetails{
+		JobId:            "job-1",
+		Queue:            "queue-a",
+		Jobset:           "set-a",
+		State:            api.JobState_FAILED,
+		SubmittedTs:      protoutil.ToTimestamp(submitted),
+		LastTransitionTs: protoutil.ToTimestamp(started),
+		LatestRunId:      "run-2",
+		JobRuns: []*api.JobRunDetails{
+			{
+				RunId:     "run-2",
+				JobId:     "job-1",
+				State:     api.JobRunState_RUN_STATE_FAILED,
+				Cluster:   "cluster-a",
+				Pool:      "cpu",
+				Node:      "node-1",
+				StartedTs: protoutil.ToTimestamp(started),
+				ExitCode:  1,
+			},
+			{
+				RunId:   "run-1",
+				JobId:   "job-1",
+				State:   api.JobRunState_RUN_STATE_PREEMPTED,
+				Cluster: "cluster-a",
+			},
+		},
+	}, details)
+}
@@ -7,10 +7,13 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/lookout/repository"
 	"github.com/armadaproject/armada/internal/server/queryapi/database"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -43,13 +46,25 @@ var JobRunStateMap = map[int16]api.JobRunState{
 
 type QueryApi struct {
 	db                  *pgxpool.Pool
+	jobsRepository      getJobsRepository
+	queueRepository     armadaqueue.ReadOnlyQueueRepository
+	authorizer          auth.ActionAuthorizer
 	decompressorFactory func() compress.Decompressor
 	maxQueryItems       int
 }
 
-func New(db *pgxpool.Pool, maxQueryItems int, decompressorFactory func() compress.Decompressor) *QueryApi {
+func New(
+	db *pgxpool.Pool,
+	queueRepository armadaqueue.ReadOnlyQueueRepository,
+	authorizer auth.ActionAuthorizer,
+	maxQueryItems int,
+	decompressorFactory func() compress.Decompressor,
+) *QueryApi {
 	return &QueryApi{
 		db:                  db,
+		jobsRepository:      repository.NewSqlGetJobsRepository(db),
+		queueRepository:     queueRepository,
+		authorizer:          authorizer,
 		maxQueryItems:       maxQueryItems,
 		decompressorFactory: decompressorFactory,
 	}
@@ -287,6 +302,8 @@ func parseJobDetails(row database.JobRun) *api.JobRunDetails {
 		State:      runState,
 		Cluster:    row.Cluster,
 		Node:       NilStringToString(row.Node),
+		Pool:       NilStringToString(row.Pool),
+		ExitCode:   NilInt32ToInt32(row.ExitCode),
 		LeasedTs:   DbTimeToTimestamp(row.Leased),
 		PendingTs:  DbTimeToTimestamp(row.Pending),
 		StartedTs:  DbTimeToTimestamp(row.Started),
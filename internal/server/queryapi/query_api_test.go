@@ -122,7 +122,7 @@ func TestGetJobDetails(t *testing.T) {
 				require.NoError(t, err)
 				err = dbcommon.UpsertWithTransaction(ctx, db, "job_run", testJobRuns)
 				require.NoError(t, err)
-				queryApi := New(db, defaultMaxQueryItems, testDecompressor)
+				queryApi := New(db, nil, nil, defaultMaxQueryItems, testDecompressor)
 				resp, err := queryApi.GetJobDetails(ctx, tc.request)
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedResponse, resp)
@@ -196,7 +196,7 @@ func TestGetJobRunDetails(t *testing.T) {
 				require.NoError(t, err)
 				err = dbcommon.UpsertWithTransaction(ctx, db, "job_run", testJobRuns)
 				require.NoError(t, err)
-				queryApi := New(db, defaultMaxQueryItems, testDecompressor)
+				queryApi := New(db, nil, nil, defaultMaxQueryItems, testDecompressor)
 				resp, err := queryApi.GetJobRunDetails(ctx, tc.request)
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedResponse, resp)
@@ -274,7 +274,7 @@ func TestGetJobStatus(t *testing.T) {
 			err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 				err := dbcommon.UpsertWithTransaction(ctx, db, "job", testdata)
 				require.NoError(t, err)
-				queryApi := New(db, defaultMaxQueryItems, testDecompressor)
+				queryApi := New(db, nil, nil, defaultMaxQueryItems, testDecompressor)
 				resp, err := queryApi.GetJobStatus(ctx, &api.JobStatusRequest{JobIds: tc.jobIds})
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedResponse, resp)
@@ -317,7 +317,7 @@ func TestGetJobStatusUsingExternalJobUri(t *testing.T) {
 			err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 				err := dbcommon.UpsertWithTransaction(ctx, db, "job", testdata)
 				require.NoError(t, err)
-				queryApi := New(db, defaultMaxQueryItems, testDecompressor)
+				queryApi := New(db, nil, nil, defaultMaxQueryItems, testDecompressor)
 				resp, err := queryApi.GetJobStatusUsingExternalJobUri(ctx, &api.JobStatusUsingExternalJobUriRequest{
 					Queue:          "testQueue",
 					Jobset:         "testJobset",
@@ -397,7 +397,7 @@ func TestGetJobErrors(t *testing.T) {
 				require.NoError(t, err)
 				err = dbcommon.UpsertWithTransaction(ctx, db, "job_error", testJobErrors)
 				require.NoError(t, err)
-				queryApi := New(db, defaultMaxQueryItems, testDecompressor)
+				queryApi := New(db, nil, nil, defaultMaxQueryItems, testDecompressor)
 				resp, err := queryApi.GetJobErrors(ctx, tc.request)
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedResponse, resp)
@@ -454,6 +454,7 @@ func newJobRun(jobId, runId string, state int16, leased time.Time, error string)
 		JobID:   jobId,
 		Cluster: "testCluster",
 		Node:    pointer.String("testNode"),
+		Pool:    pointer.String("testPool"),
 		Pending: pgtype.Timestamp{
 			Time:  baseTime,
 			Valid: true,
@@ -498,6 +499,7 @@ func newJobRunDetails(jobId string, runId string, state api.JobRunState, leased
 		State:      state,
 		Cluster:    "testCluster",
 		Node:       "testNode",
+		Pool:       "testPool",
 		LeasedTs:   protoutil.ToTimestamp(leased),
 		PendingTs:  baseTimestamp,
 		StartedTs:  baseTimestamp,
@@ -5,6 +5,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/lookout/model"
 )
 
 func NilStringToString(s *string) string {
@@ -14,9 +15,23 @@ func NilStringToString(s *string) string {
 	return *s
 }
 
+func NilInt32ToInt32(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
 func DbTimeToTimestamp(t pgtype.Timestamp) *types.Timestamp {
 	if !t.Valid {
 		return nil
 	}
 	return protoutil.ToTimestamp(t.Time.UTC())
 }
+
+func postgreSQLTimeToTimestamp(t *model.PostgreSQLTime) *types.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return protoutil.ToTimestamp(t.Time.UTC())
+}
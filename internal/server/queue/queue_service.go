@@ -18,6 +18,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/server/permissions"
+	"github.com/armadaproject/armada/internal/server/submit/validation"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client/queue"
 	"github.com/armadaproject/armada/pkg/controlplaneevents"
@@ -62,6 +63,12 @@ func (s *Server) CreateQueue(grpcCtx context.Context, req *api.Queue) (*types.Em
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error validating queue: %s", err)
 	}
+	if err := validation.ValidateRetryPolicy(queue.DefaultRetryPolicy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error validating queue: %s", err)
+	}
+	if err := s.validateParent(ctx, queue); err != nil {
+		return nil, err
+	}
 
 	err = s.queueRepository.CreateQueue(ctx, queue)
 	var eq *ErrQueueAlreadyExists
@@ -107,6 +114,12 @@ func (s *Server) UpdateQueue(grpcCtx context.Context, req *api.Queue) (*types.Em
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error: %s", err)
 	}
+	if err := validation.ValidateRetryPolicy(queue.DefaultRetryPolicy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error: %s", err)
+	}
+	if err := s.validateParent(ctx, queue); err != nil {
+		return nil, err
+	}
 
 	err = s.queueRepository.UpdateQueue(ctx, queue)
 	var e *ErrQueueNotFound
@@ -148,6 +161,15 @@ func (s *Server) DeleteQueue(grpcCtx context.Context, req *api.QueueDeleteReques
 	} else if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
 	}
+	queues, err := s.queueRepository.GetAllQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error getting queues: %s", err)
+	}
+	for _, q := range queues {
+		if q.Parent == req.Name {
+			return nil, status.Errorf(codes.FailedPrecondition, "error deleting queue %s: queue %s is nested under it", req.Name, q.Name)
+		}
+	}
 	err = s.queueRepository.DeleteQueue(ctx, req.Name)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "error deleting queue %s: %s", req.Name, err)
@@ -155,6 +177,26 @@ func (s *Server) DeleteQueue(grpcCtx context.Context, req *api.QueueDeleteReques
 	return &types.Empty{}, nil
 }
 
+// validateParent returns an error if the parent of q doesn't exist or if nesting q under it would create a cycle.
+func (s *Server) validateParent(ctx *armadacontext.Context, q queue.Queue) error {
+	seen := map[string]bool{}
+	for parent := q.Parent; parent != ""; {
+		if parent == q.Name || seen[parent] {
+			return status.Errorf(codes.InvalidArgument, "error validating queue: nesting queue %s under %s would create a cycle", q.Name, q.Parent)
+		}
+		p, err := s.queueRepository.GetQueue(ctx, parent)
+		var e *ErrQueueNotFound
+		if errors.As(err, &e) {
+			return status.Errorf(codes.InvalidArgument, "error validating queue: parent queue %s does not exist", parent)
+		} else if err != nil {
+			return status.Errorf(codes.Unavailable, "error getting queue %q: %s", parent, err)
+		}
+		seen[parent] = true
+		parent = p.Parent
+	}
+	return nil
+}
+
 func (s *Server) GetQueue(grpcCtx context.Context, req *api.QueueGetRequest) (*api.Queue, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 	queue, err := s.queueRepository.GetQueue(ctx, req.Name)
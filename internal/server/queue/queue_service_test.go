@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/server/mocks"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestQueueServer_NestedQueues(t *testing.T) {
+	existing := map[string]queue.Queue{
+		"team":    {Name: "team", PriorityFactor: 1},
+		"project": {Name: "project", PriorityFactor: 1, Parent: "team"},
+		"user":    {Name: "user", PriorityFactor: 1, Parent: "project"},
+	}
+	tests := map[string]struct {
+		create       *api.Queue
+		update       *api.Queue
+		delete       string
+		expectedCode codes.Code
+	}{
+		"create nested queue": {
+			create:       &api.Queue{Name: "other", PriorityFactor: 1, Parent: "project"},
+			expectedCode: codes.OK,
+		},
+		"create queue with missing parent": {
+			create:       &api.Queue{Name: "other", PriorityFactor: 1, Parent: "missing"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"create queue nested under itself": {
+			create:       &api.Queue{Name: "other", PriorityFactor: 1, Parent: "other"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"move queue": {
+			update:       &api.Queue{Name: "user", PriorityFactor: 1, Parent: "team"},
+			expectedCode: codes.OK,
+		},
+		"move queue under its descendant": {
+			update:       &api.Queue{Name: "team", PriorityFactor: 1, Parent: "user"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"delete leaf queue": {
+			delete:       "user",
+			expectedCode: codes.OK,
+		},
+		"delete queue with children": {
+			delete:       "project",
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			ctrl := gomock.NewController(t)
+			authorizer := mocks.NewMockActionAuthorizer(ctrl)
+			authorizer.EXPECT().AuthorizeAction(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			repo := mocks.NewMockQueueRepository(ctrl)
+			repo.EXPECT().GetQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *armadacontext.Context, name string) (queue.Queue, error) {
+					q, ok := existing[name]
+					if !ok {
+						return queue.Queue{}, &ErrQueueNotFound{QueueName: name}
+					}
+					return q, nil
+				},
+			).AnyTimes()
+			repo.EXPECT().GetAllQueues(gomock.Any()).DoAndReturn(
+				func(_ *armadacontext.Context) ([]queue.Queue, error) {
+					return []queue.Queue{existing["team"], existing["project"], existing["user"]}, nil
+				},
+			).AnyTimes()
+			repo.EXPECT().CreateQueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			repo.EXPECT().UpdateQueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			repo.EXPECT().DeleteQueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			server := NewServer(nil, repo, authorizer)
+
+			var err error
+			switch {
+			case tc.create != nil:
+				_, err = server.CreateQueue(ctx, tc.create)
+			case tc.update != nil:
+				_, err = server.UpdateQueue(ctx, tc.update)
+			default:
+				_, err = server.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: tc.delete})
+			}
+			if tc.expectedCode == codes.OK {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, tc.expectedCode, status.Code(err), "unexpected error %v", err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/eventlog"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	"github.com/armadaproject/armada/internal/common/health"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -31,6 +32,7 @@ import (
 	"github.com/armadaproject/armada/internal/server/queryapi"
 	"github.com/armadaproject/armada/internal/server/queue"
 	"github.com/armadaproject/armada/internal/server/submit"
+	"github.com/armadaproject/armada/internal/server/usage"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/armadaevents"
@@ -60,6 +62,9 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	if err := validateSubmissionConfig(config.Submission); err != nil {
 		return err
 	}
+	if err := validateEventsApiBackend(config.EventsApiBackend); err != nil {
+		return err
+	}
 
 	// We support multiple simultaneous authentication services (e.g., username/password  OpenId).
 	// For each gRPC request, we try them all until one succeeds, at which point the process is
@@ -88,27 +93,36 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return errors.WithMessage(err, "error creating postgres pool")
 	}
 	defer dbPool.Close()
-	queryapiServer := queryapi.New(
-		dbPool,
-		config.QueryApi.MaxQueryItems,
-		func() compress.Decompressor { return compress.NewZlibDecompressor() })
-	api.RegisterJobsServer(grpcServer, queryapiServer)
-
-	eventDb := createRedisClient(&config.EventsApiRedis)
-	defer func() {
-		if err := eventDb.Close(); err != nil {
-			log.WithError(err).Error("failed to close events api Redis client")
-		}
-	}()
-	prometheus.MustRegister(
-		redisprometheus.NewCollector("armada", "events_redis", eventDb))
 
 	queueRepository := queue.NewPostgresQueueRepository(dbPool)
 	queueCache := queue.NewCachedQueueRepository(queueRepository, config.QueueCacheRefreshPeriod)
 	services = append(services, func() error {
 		return queueCache.Run(ctx)
 	})
-	eventRepository := event.NewEventRepository(eventDb)
+
+	var eventRepository event.EventRepository
+	if config.EventsApiBackend == configuration.EventsApiBackendPostgres {
+		eventDbPool, err := database.OpenPgxPool(config.EventsApiPostgres)
+		if err != nil {
+			return errors.WithMessage(err, "error creating events api postgres pool")
+		}
+		defer eventDbPool.Close()
+		postgresEventRepository := event.NewPostgresEventRepository(eventDbPool)
+		services = append(services, func() error {
+			return postgresEventRepository.Run(ctx)
+		})
+		eventRepository = postgresEventRepository
+	} else {
+		eventDb := createRedisClient(&config.EventsApiRedis)
+		defer func() {
+			if err := eventDb.Close(); err != nil {
+				log.WithError(err).Error("failed to close events api Redis client")
+			}
+		}()
+		prometheus.MustRegister(
+			redisprometheus.NewCollector("armada", "events_redis", eventDb))
+		eventRepository = event.NewEventRepository(eventDb)
+	}
 
 	authorizer := auth.NewAuthorizer(
 		auth.NewPrincipalPermissionChecker(
@@ -118,49 +132,99 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		),
 	)
 
+	queryapiServer := queryapi.New(
+		dbPool,
+		queueCache,
+		authorizer,
+		config.QueryApi.MaxQueryItems,
+		func() compress.Decompressor { return compress.NewZlibDecompressor() })
+	api.RegisterJobsServer(grpcServer, queryapiServer)
+
+	if len(config.UsageApiPostgres.Connection) > 0 {
+		usageDbPool, err := database.OpenPgxPool(config.UsageApiPostgres)
+		if err != nil {
+			return errors.WithMessage(err, "error creating usage api postgres pool")
+		}
+		defer usageDbPool.Close()
+		usageServer := usage.New(usage.NewPostgresRepository(usageDbPool), queueCache, authorizer)
+		api.RegisterUsageServer(grpcServer, usageServer)
+	}
+
 	serverId := uuid.New()
-	var pulsarClient pulsar.Client
 	// API endpoints that generate Pulsar messages.
-	pulsarClient, err = pulsarutils.NewPulsarClient(&config.Pulsar)
-	if err != nil {
-		return err
-	}
-	defer pulsarClient.Close()
+	var jobSetEventsPublisher pulsarutils.Publisher[*armadaevents.EventSequence]
+	var controlPlaneEventsPublisher pulsarutils.Publisher[*controlplaneevents.Event]
 	preProcessor := jobsetevents.NewPreProcessor(config.Pulsar.MaxAllowedEventsPerMessage, config.Pulsar.MaxAllowedMessageSize)
-	jobSetEventsPublisher, err := pulsarutils.NewPulsarPublisher[*armadaevents.EventSequence](
-		pulsarClient,
-		pulsar.ProducerOptions{
-			Name:             fmt.Sprintf("armada-server-%s", serverId),
-			CompressionType:  config.Pulsar.CompressionType,
-			CompressionLevel: config.Pulsar.CompressionLevel,
-			BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
-			Topic:            config.Pulsar.JobsetEventsTopic,
-		},
-		preProcessor,
-		jobsetevents.RetrieveKey,
-		config.Pulsar.SendTimeout,
-	)
-	if err != nil {
-		return errors.Wrapf(err, "error creating pulsar producer for jobset events")
-	}
-	defer jobSetEventsPublisher.Close()
+	if config.Pulsar.EventLog.Enabled {
+		eventLog, err := eventlog.Open(ctx, config.Pulsar.EventLog)
+		if err != nil {
+			return errors.WithMessage(err, "error opening event log")
+		}
+		defer eventLog.Close()
 
-	controlPlaneEventsPublisher, err := pulsarutils.NewPulsarPublisher[*controlplaneevents.Event](
-		pulsarClient,
-		pulsar.ProducerOptions{
-			Name:             fmt.Sprintf("armada-server-%s", serverId),
-			CompressionType:  config.Pulsar.CompressionType,
-			CompressionLevel: config.Pulsar.CompressionLevel,
-			BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
-			Topic:            config.Pulsar.ControlPlaneEventsTopic,
-		},
-		controlplaneeventspulsarutils.PreProcess[*controlplaneevents.Event],
-		controlplaneeventspulsarutils.RetrieveKey[*controlplaneevents.Event],
-		config.Pulsar.SendTimeout,
-	)
-	if err != nil {
-		return errors.Wrapf(err, "error creating pulsar producer for control plane events")
+		jobSetEventsPublisher, err = eventlog.NewPublisher[*armadaevents.EventSequence](
+			ctx,
+			eventLog,
+			config.Pulsar.JobsetEventsTopic,
+			preProcessor,
+			jobsetevents.RetrieveKey,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error creating event log publisher for jobset events")
+		}
+
+		controlPlaneEventsPublisher, err = eventlog.NewPublisher[*controlplaneevents.Event](
+			ctx,
+			eventLog,
+			config.Pulsar.ControlPlaneEventsTopic,
+			controlplaneeventspulsarutils.PreProcess[*controlplaneevents.Event],
+			controlplaneeventspulsarutils.RetrieveKey[*controlplaneevents.Event],
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error creating event log publisher for control plane events")
+		}
+	} else {
+		pulsarClient, err := pulsarutils.NewPulsarClient(&config.Pulsar)
+		if err != nil {
+			return err
+		}
+		defer pulsarClient.Close()
+
+		jobSetEventsPublisher, err = pulsarutils.NewPulsarPublisher[*armadaevents.EventSequence](
+			pulsarClient,
+			pulsar.ProducerOptions{
+				Name:             fmt.Sprintf("armada-server-%s", serverId),
+				CompressionType:  config.Pulsar.CompressionType,
+				CompressionLevel: config.Pulsar.CompressionLevel,
+				BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
+				Topic:            config.Pulsar.JobsetEventsTopic,
+			},
+			preProcessor,
+			jobsetevents.RetrieveKey,
+			config.Pulsar.SendTimeout,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error creating pulsar producer for jobset events")
+		}
+
+		controlPlaneEventsPublisher, err = pulsarutils.NewPulsarPublisher[*controlplaneevents.Event](
+			pulsarClient,
+			pulsar.ProducerOptions{
+				Name:             fmt.Sprintf("armada-server-%s", serverId),
+				CompressionType:  config.Pulsar.CompressionType,
+				CompressionLevel: config.Pulsar.CompressionLevel,
+				BatchingMaxSize:  config.Pulsar.MaxAllowedMessageSize,
+				Topic:            config.Pulsar.ControlPlaneEventsTopic,
+			},
+			controlplaneeventspulsarutils.PreProcess[*controlplaneevents.Event],
+			controlplaneeventspulsarutils.RetrieveKey[*controlplaneevents.Event],
+			config.Pulsar.SendTimeout,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "error creating pulsar producer for control plane events")
+		}
 	}
+	defer jobSetEventsPublisher.Close()
 	defer controlPlaneEventsPublisher.Close()
 
 	queueServer := queue.NewServer(controlPlaneEventsPublisher, queueRepository, authorizer)
@@ -233,6 +297,20 @@ func validateSubmissionConfig(config configuration.SubmissionConfig) error {
 	return nil
 }
 
+// validateEventsApiBackend returns an error if backend isn't one the events API can read from.
+// An empty backend selects Redis.
+func validateEventsApiBackend(backend string) error {
+	switch backend {
+	case "", configuration.EventsApiBackendRedis, configuration.EventsApiBackendPostgres:
+		return nil
+	default:
+		return errors.Errorf(
+			"eventsApiBackend %s is not supported; must be one of %s or %s",
+			backend, configuration.EventsApiBackendRedis, configuration.EventsApiBackendPostgres,
+		)
+	}
+}
+
 func createApiConnection(connectionDetails client.ApiConnectionDetails) (*grpc.ClientConn, error) {
 	clientMetrics := grpc_prometheus.NewClientMetrics(
 		grpc_prometheus.WithClientHandlingTimeHistogram(),
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"math"
 
+	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
@@ -27,7 +30,8 @@ func SubmitJobFromApiRequest(
 ) *armadaevents.SubmitJob {
 	jobId := idGen()
 	priority := PriorityAsInt32(jobReq.GetPriority())
-	ingressesAndServices := convertIngressesAndServices(config, jobReq, jobId, jobSetId, queue, owner)
+	objects := convertIngressesAndServices(config, jobReq, jobId, jobSetId, queue, owner)
+	objects = append(objects, convertConfigMapsAndSecrets(jobReq, jobId, jobSetId, queue, owner)...)
 
 	msg := &armadaevents.SubmitJob{
 		JobId:           jobId,
@@ -45,14 +49,38 @@ func SubmitJobFromApiRequest(
 				},
 			},
 		},
-		Objects:   ingressesAndServices,
-		Scheduler: jobReq.Scheduler,
+		Objects:                 objects,
+		Scheduler:               jobReq.Scheduler,
+		DependencyFailurePolicy: armadaevents.DependencyFailurePolicy(jobReq.DependencyFailurePolicy),
+		ArraySize:               jobReq.ArraySize,
+		NotBefore:               jobReq.NotBefore,
+		QueueTtlSeconds:         jobReq.QueueTtlSeconds,
+		RetryPolicy:             RetryPolicyFromApi(jobReq.RetryPolicy),
 	}
 
 	postProcess(msg, config)
 	return msg
 }
 
+// RetryPolicyFromApi converts an api.RetryPolicy into the equivalent schedulerobjects.RetryPolicy.
+func RetryPolicyFromApi(policy *api.RetryPolicy) *schedulerobjects.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &schedulerobjects.RetryPolicy{
+		MaxAttempts:    policy.MaxAttempts,
+		BackoffSeconds: policy.BackoffSeconds,
+		Rules: armadaslices.Map(policy.Rules, func(rule *api.RetryRule) *schedulerobjects.RetryRule {
+			return &schedulerobjects.RetryRule{
+				Action:           schedulerobjects.RetryAction(rule.Action),
+				ExitCodes:        slices.Clone(rule.ExitCodes),
+				ContainerReasons: slices.Clone(rule.ContainerReasons),
+				ErrorKinds:       slices.Clone(rule.ErrorKinds),
+			}
+		}),
+	}
+}
+
 // Creates KubernetesObjects representing ingresses and services from the *api.JobSubmitRequestItem.
 // An ingress will have  a corresponding service created for it.
 func convertIngressesAndServices(
@@ -131,10 +159,59 @@ func convertIngressesAndServices(
 		}
 	}
 
-	// Add standard annotations and labels to all objects
+	addStandardMetadata(objects, jobReq.Namespace, jobId, jobsetId, queue, owner)
+	return objects
+}
+
+// Creates KubernetesObjects representing the ConfigMaps and Secrets of the *api.JobSubmitRequestItem.
+// Their metadata is moved to the ObjectMeta of the KubernetesObject, as for other objects.
+func convertConfigMapsAndSecrets(
+	jobReq *api.JobSubmitRequestItem,
+	jobId, jobsetId, queue, owner string,
+) []*armadaevents.KubernetesObject {
+	objects := make([]*armadaevents.KubernetesObject, 0, len(jobReq.ConfigMaps)+len(jobReq.Secrets))
+	for _, configMap := range jobReq.ConfigMaps {
+		objects = append(objects, &armadaevents.KubernetesObject{
+			ObjectMeta: objectMetaFromK8s(configMap.ObjectMeta),
+			Object: &armadaevents.KubernetesObject_ConfigMap{
+				ConfigMap: &v1.ConfigMap{
+					Immutable:  configMap.Immutable,
+					Data:       configMap.Data,
+					BinaryData: configMap.BinaryData,
+				},
+			},
+		})
+	}
+	for _, secret := range jobReq.Secrets {
+		objects = append(objects, &armadaevents.KubernetesObject{
+			ObjectMeta: objectMetaFromK8s(secret.ObjectMeta),
+			Object: &armadaevents.KubernetesObject_Secret{
+				Secret: &v1.Secret{
+					Immutable:  secret.Immutable,
+					Data:       secret.Data,
+					StringData: secret.StringData,
+					Type:       secret.Type,
+				},
+			},
+		})
+	}
+	addStandardMetadata(objects, jobReq.Namespace, jobId, jobsetId, queue, owner)
+	return objects
+}
+
+func objectMetaFromK8s(meta metav1.ObjectMeta) *armadaevents.ObjectMeta {
+	return &armadaevents.ObjectMeta{
+		Name:        meta.Name,
+		Annotations: util.MergeMaps(map[string]string{}, meta.Annotations),
+		Labels:      util.MergeMaps(map[string]string{}, meta.Labels),
+	}
+}
+
+// Adds standard annotations and labels to all objects
+func addStandardMetadata(objects []*armadaevents.KubernetesObject, namespace, jobId, jobsetId, queue, owner string) {
 	for _, object := range objects {
 		md := object.GetObjectMeta()
-		md.Namespace = jobReq.Namespace
+		md.Namespace = namespace
 
 		annotations := md.GetAnnotations()
 		annotations[domain.JobSetId] = jobsetId
@@ -144,8 +221,6 @@ func convertIngressesAndServices(
 		labels[domain.JobId] = jobId
 		labels[domain.Queue] = queue
 	}
-
-	return objects
 }
 
 func createService(
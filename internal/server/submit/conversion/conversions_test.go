@@ -4,10 +4,13 @@ import (
 	"math"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/internal/server/submit/testfixtures"
 	"github.com/armadaproject/armada/pkg/api"
@@ -27,6 +30,100 @@ func TestSubmitJobFromApiRequest(t *testing.T) {
 			expectedSubmitJob: testfixtures.SubmitJob(1),
 			submissionConfig:  testfixtures.DefaultSubmissionConfig(),
 		},
+		"Not before and queue ttl": {
+			jobReq: func() *api.JobSubmitRequestItem {
+				req := testfixtures.JobSubmitRequestItem(1)
+				req.NotBefore = &types.Timestamp{Seconds: 1767225600}
+				req.QueueTtlSeconds = 3600
+				return req
+			}(),
+			expectedSubmitJob: func() *armadaevents.SubmitJob {
+				submitMsg := testfixtures.SubmitJob(1)
+				submitMsg.NotBefore = &types.Timestamp{Seconds: 1767225600}
+				submitMsg.QueueTtlSeconds = 3600
+				return submitMsg
+			}(),
+			submissionConfig: testfixtures.DefaultSubmissionConfig(),
+		},
+		"Retry policy": {
+			jobReq: func() *api.JobSubmitRequestItem {
+				req := testfixtures.JobSubmitRequestItem(1)
+				req.RetryPolicy = &api.RetryPolicy{
+					MaxAttempts:    3,
+					BackoffSeconds: 60,
+					Rules: []*api.RetryRule{
+						{Action: api.RetryAction_RETRY_ACTION_FAIL_FAST, ExitCodes: []int32{1}, ErrorKinds: []string{"PodError"}},
+						{Action: api.RetryAction_RETRY_ACTION_RETRY, ContainerReasons: []string{"OOMKilled"}},
+					},
+				}
+				return req
+			}(),
+			expectedSubmitJob: func() *armadaevents.SubmitJob {
+				submitMsg := testfixtures.SubmitJob(1)
+				submitMsg.RetryPolicy = &schedulerobjects.RetryPolicy{
+					MaxAttempts:    3,
+					BackoffSeconds: 60,
+					Rules: []*schedulerobjects.RetryRule{
+						{Action: schedulerobjects.RetryAction_RETRY_ACTION_FAIL_FAST, ExitCodes: []int32{1}, ErrorKinds: []string{"PodError"}},
+						{Action: schedulerobjects.RetryAction_RETRY_ACTION_RETRY, ContainerReasons: []string{"OOMKilled"}},
+					},
+				}
+				return submitMsg
+			}(),
+			submissionConfig: testfixtures.DefaultSubmissionConfig(),
+		},
+		"ConfigMaps and secrets": {
+			jobReq: func() *api.JobSubmitRequestItem {
+				req := testfixtures.JobSubmitRequestItem(1)
+				req.ConfigMaps = []*v1.ConfigMap{{
+					ObjectMeta: metav1.ObjectMeta{Name: "config", Labels: map[string]string{"foo": "bar"}},
+					Data:       map[string]string{"config.yaml": "a: b"},
+				}}
+				req.Secrets = []*v1.Secret{{
+					ObjectMeta: metav1.ObjectMeta{Name: "credentials"},
+					StringData: map[string]string{"token": "secret"},
+				}}
+				return req
+			}(),
+			expectedSubmitJob: SubmitJobMsgWithK8sObjects([]*armadaevents.KubernetesObject{
+				{
+					ObjectMeta: &armadaevents.ObjectMeta{
+						Namespace: testfixtures.DefaultNamespace,
+						Name:      "config",
+						Annotations: map[string]string{
+							"armada_jobset_id": testfixtures.DefaultJobset,
+							"armada_owner":     testfixtures.DefaultOwner,
+						},
+						Labels: map[string]string{
+							"foo":             "bar",
+							"armada_job_id":   "00000000000000000000000001",
+							"armada_queue_id": testfixtures.DefaultQueue.Name,
+						},
+					},
+					Object: &armadaevents.KubernetesObject_ConfigMap{
+						ConfigMap: &v1.ConfigMap{Data: map[string]string{"config.yaml": "a: b"}},
+					},
+				},
+				{
+					ObjectMeta: &armadaevents.ObjectMeta{
+						Namespace: testfixtures.DefaultNamespace,
+						Name:      "credentials",
+						Annotations: map[string]string{
+							"armada_jobset_id": testfixtures.DefaultJobset,
+							"armada_owner":     testfixtures.DefaultOwner,
+						},
+						Labels: map[string]string{
+							"armada_job_id":   "00000000000000000000000001",
+							"armada_queue_id": testfixtures.DefaultQueue.Name,
+						},
+					},
+					Object: &armadaevents.KubernetesObject_Secret{
+						Secret: &v1.Secret{StringData: map[string]string{"token": "secret"}},
+					},
+				},
+			}),
+			submissionConfig: testfixtures.DefaultSubmissionConfig(),
+		},
 		"NodePort Service": {
 			jobReq: jobSubmitRequestItemWithServices([]*api.ServiceConfig{
 				{
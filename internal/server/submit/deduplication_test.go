@@ -96,7 +96,7 @@ func TestDeduplicator(t *testing.T) {
 
 				// Store
 				for _, keys := range tc.initialKeys {
-					err := deduplicator.StoreOriginalJobIds(ctx, keys.queue, keys.kvs)
+					err := deduplicator.StoreOriginalJobIds(ctx, keys.queue, keys.kvs, nil)
 					require.NoError(t, err)
 				}
 
@@ -113,3 +113,27 @@ func TestDeduplicator(t *testing.T) {
 		})
 	}
 }
+
+func TestDeduplicator_GetDependencyJobIds(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		deduplicator := NewDeduplicator(db)
+		err := deduplicator.StoreOriginalJobIds(
+			ctx,
+			"testQueue",
+			map[string]string{"job": "jobId", "array": "arrayId"},
+			map[string]uint32{"array": 2},
+		)
+		require.NoError(t, err)
+
+		jobIds, err := deduplicator.GetDependencyJobIds(ctx, "testQueue", []string{"job", "array", "unknown"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			"job":   {"jobId"},
+			"array": {"arrayId-0", "arrayId-1"},
+		}, jobIds)
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -13,7 +13,17 @@ import (
 // Deduplicator deduplicates jobs submitted ot armada in order to prevent double submission
 type Deduplicator interface {
 	GetOriginalJobIds(ctx *armadacontext.Context, queue string, jobRequests []*api.JobSubmitRequestItem) (map[string]string, error)
-	StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string) error
+	// GetDependencyJobIds returns the ids of the jobs previously submitted to queue with the given client ids, keyed by
+	// client id. The client id of a job array maps to the ids of the array's elements.
+	GetDependencyJobIds(ctx *armadacontext.Context, queue string, clientIds []string) (map[string][]string, error)
+	// StoreOriginalJobIds stores the job id submitted for each client id, and the size of those that are job arrays.
+	StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string, arraySizes map[string]uint32) error
+}
+
+// originalJob is a job previously submitted with a given client id.
+type originalJob struct {
+	jobId     string
+	arraySize uint32
 }
 
 // PostgresDeduplicator is an implementation of a Deduplicator that uses a pgkeyvalue.KeyValueStore as its state store
@@ -43,22 +53,51 @@ func (s *PostgresDeduplicator) GetOriginalJobIds(ctx *armadacontext.Context, que
 			return nil, err
 		}
 		for k, v := range kvs {
-			originalJobId, ok := existingKvs[k]
+			originalJob, ok := existingKvs[k]
 			if ok {
-				duplicates[v] = originalJobId
+				duplicates[v] = originalJob.jobId
 			}
 		}
 	}
 	return duplicates, nil
 }
 
-func (s *PostgresDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string) error {
+func (s *PostgresDeduplicator) GetDependencyJobIds(ctx *armadacontext.Context, queue string, clientIds []string) (map[string][]string, error) {
+	kvs := make(map[string]string, len(clientIds))
+	for _, clientId := range clientIds {
+		if clientId != "" {
+			kvs[s.jobKey(queue, clientId)] = clientId
+		}
+	}
+
+	jobIds := make(map[string][]string)
+	if len(kvs) > 0 {
+		existingKvs, err := s.loadMappings(ctx, maps.Keys(kvs))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range kvs {
+			originalJob, ok := existingKvs[k]
+			if !ok {
+				continue
+			}
+			if originalJob.arraySize > 0 {
+				jobIds[v] = jobArrayElementIds(originalJob.jobId, originalJob.arraySize)
+			} else {
+				jobIds[v] = []string{originalJob.jobId}
+			}
+		}
+	}
+	return jobIds, nil
+}
+
+func (s *PostgresDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string, arraySizes map[string]uint32) error {
 	if len(mappings) == 0 {
 		return nil
 	}
-	kvs := make(map[string]string, len(mappings))
+	kvs := make(map[string]originalJob, len(mappings))
 	for k, v := range mappings {
-		kvs[s.jobKey(queue, k)] = v
+		kvs[s.jobKey(queue, k)] = originalJob{jobId: v, arraySize: arraySizes[k]}
 	}
 	return s.storeMappings(ctx, kvs)
 }
@@ -67,21 +106,23 @@ func (s *PostgresDeduplicator) jobKey(queue, clientId string) string {
 	return fmt.Sprintf("%s:%s", queue, clientId)
 }
 
-func (s *PostgresDeduplicator) storeMappings(ctx *armadacontext.Context, mappings map[string]string) error {
+func (s *PostgresDeduplicator) storeMappings(ctx *armadacontext.Context, mappings map[string]originalJob) error {
 	deduplicationIDs := make([]string, 0, len(mappings))
 	jobIDs := make([]string, 0, len(mappings))
+	arraySizes := make([]int64, 0, len(mappings))
 
-	for deduplicationID, jobID := range mappings {
+	for deduplicationID, job := range mappings {
 		deduplicationIDs = append(deduplicationIDs, deduplicationID)
-		jobIDs = append(jobIDs, jobID)
+		jobIDs = append(jobIDs, job.jobId)
+		arraySizes = append(arraySizes, int64(job.arraySize))
 	}
 
 	sql := `
-        INSERT INTO job_deduplication (deduplication_id, job_id)
-        SELECT unnest($1::text[]), unnest($2::text[])
+        INSERT INTO job_deduplication (deduplication_id, job_id, array_size)
+        SELECT unnest($1::text[]), unnest($2::text[]), unnest($3::integer[])
         ON CONFLICT (deduplication_id) DO NOTHING
     `
-	_, err := s.db.Exec(ctx, sql, deduplicationIDs, jobIDs)
+	_, err := s.db.Exec(ctx, sql, deduplicationIDs, jobIDs, arraySizes)
 	if err != nil {
 		return err
 	}
@@ -89,12 +130,12 @@ func (s *PostgresDeduplicator) storeMappings(ctx *armadacontext.Context, mapping
 	return nil
 }
 
-func (s *PostgresDeduplicator) loadMappings(ctx *armadacontext.Context, keys []string) (map[string]string, error) {
+func (s *PostgresDeduplicator) loadMappings(ctx *armadacontext.Context, keys []string) (map[string]originalJob, error) {
 	// Prepare the output map
-	result := make(map[string]string)
+	result := make(map[string]originalJob)
 
 	sql := `
-        SELECT deduplication_id, job_id
+        SELECT deduplication_id, job_id, array_size
         FROM job_deduplication
         WHERE deduplication_id = ANY($1)
     `
@@ -108,10 +149,11 @@ func (s *PostgresDeduplicator) loadMappings(ctx *armadacontext.Context, keys []s
 	// Iterate through the result rows
 	for rows.Next() {
 		var deduplicationID, jobID string
-		if err := rows.Scan(&deduplicationID, &jobID); err != nil {
+		var arraySize int32
+		if err := rows.Scan(&deduplicationID, &jobID, &arraySize); err != nil {
 			return nil, err
 		}
-		result[deduplicationID] = jobID
+		result[deduplicationID] = originalJob{jobId: jobID, arraySize: uint32(arraySize)}
 	}
 
 	if err := rows.Err(); err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
@@ -73,11 +74,20 @@ func (s *Server) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest)
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 
 	// Check that the user is actually allowed to submit jobs
-	userId, groups, err := s.authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	q, userId, groups, err := s.authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
 	if err != nil {
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
+	// Jobs that don't specify a retry policy get the default retry policy of the queue.
+	if q.DefaultRetryPolicy != nil {
+		for _, jobRequest := range req.JobRequestItems {
+			if jobRequest.RetryPolicy == nil {
+				jobRequest.RetryPolicy = q.DefaultRetryPolicy
+			}
+		}
+	}
+
 	// Validate the request is well-formed
 	if err = validation.ValidateSubmitRequest(req, s.submissionConfig); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -91,9 +101,21 @@ func (s *Server) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest)
 		log.WithError(err).Warn("Error fetching original job ids, deduplication will not occur.")
 	}
 
+	// Jobs may depend on jobs submitted in earlier requests by referencing their client id, so look up the
+	// corresponding job ids. Unlike deduplication, this is required for the request to succeed.
+	var dependencyJobIds map[string][]string
+	if dependencyClientIds := clientIdDependencies(req); len(dependencyClientIds) > 0 {
+		dependencyJobIds, err = s.deduplicator.GetDependencyJobIds(ctx, req.Queue, dependencyClientIds)
+		if err != nil {
+			log.WithError(err).Error("failed to resolve job dependencies")
+			return nil, status.Error(codes.Internal, "Failed to resolve job dependencies")
+		}
+	}
+
 	submitMsgs := make([]*armadaevents.EventSequence_Event, 0, len(req.JobRequestItems))
 	jobResponses := make([]*api.JobSubmitResponseItem, 0, len(req.JobRequestItems))
 	idMappings := make(map[string]string, len(req.JobRequestItems))
+	arraySizes := make(map[string]uint32)
 
 	for _, jobRequest := range req.JobRequestItems {
 
@@ -101,12 +123,21 @@ func (s *Server) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest)
 		originalId, isDuplicate := originalIds[jobRequest.ClientId]
 		if isDuplicate {
 			ctx.Infof("Job with client id %s is a duplicate of %s", jobRequest.ClientId, originalId)
-			jobResponses = append(jobResponses, &api.JobSubmitResponseItem{JobId: originalId})
+			jobResponses = append(jobResponses, &api.JobSubmitResponseItem{
+				JobId:       originalId,
+				ArrayJobIds: jobArrayElementIds(originalId, jobRequest.ArraySize),
+			})
 			continue
 		}
 
 		// If we get to here then it isn't a duplicate. Create a Job submission and a job response
+		dependencies, err := resolveDependencies(jobRequest, idMappings, originalIds, dependencyJobIds)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		submitMsg := conversion.SubmitJobFromApiRequest(jobRequest, s.submissionConfig, req.JobSetId, req.Queue, userId, s.idGenerator)
+		submitMsg.Dependencies = dependencies
 		eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
 		submitMsgs = append(submitMsgs, &armadaevents.EventSequence_Event{
 			Created: eventTime,
@@ -116,11 +147,15 @@ func (s *Server) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest)
 		})
 
 		jobResponses = append(jobResponses, &api.JobSubmitResponseItem{
-			JobId: submitMsg.JobId,
+			JobId:       submitMsg.JobId,
+			ArrayJobIds: jobArrayElementIds(submitMsg.JobId, submitMsg.ArraySize),
 		})
 
 		if jobRequest.ClientId != "" {
 			idMappings[jobRequest.ClientId] = submitMsg.JobId
+			if submitMsg.ArraySize > 0 {
+				arraySizes[jobRequest.ClientId] = submitMsg.ArraySize
+			}
 		}
 	}
 
@@ -146,14 +181,74 @@ func (s *Server) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest)
 
 	// Store the deduplication ids. Note that this will not be called if pulsar submission has failed, hence
 	// a partial pulsar submission can result in duplicate jobs.
-	if err = s.deduplicator.StoreOriginalJobIds(ctx, req.Queue, idMappings); err != nil {
+	if err = s.deduplicator.StoreOriginalJobIds(ctx, req.Queue, idMappings, arraySizes); err != nil {
 		log.WithError(err).Warn("failed to store deduplication ids")
 	}
 	return &api.JobSubmitResponse{JobResponseItems: jobResponses}, nil
 }
 
+// clientIdDependencies returns the client ids of the dependencies referenced by client id, in order to find the ids
+// of the corresponding jobs.
+func clientIdDependencies(req *api.JobSubmitRequest) []string {
+	var clientIds []string
+	for _, jobRequest := range req.JobRequestItems {
+		for _, dependency := range jobRequest.Dependencies {
+			if dependency.ClientId != "" {
+				clientIds = append(clientIds, dependency.ClientId)
+			}
+		}
+	}
+	return clientIds
+}
+
+// resolveDependencies returns the ids of the jobs the provided job depends on. Dependencies referenced by client id
+// are resolved against jobs submitted earlier in the request, followed by jobs submitted in earlier requests; a job
+// array submitted in an earlier request resolves to all of its elements. Validation ensures jobs don't depend on job
+// arrays submitted in the same request.
+func resolveDependencies(
+	jobRequest *api.JobSubmitRequestItem,
+	requestIdMappings map[string]string,
+	originalIds map[string]string,
+	dependencyJobIds map[string][]string,
+) ([]string, error) {
+	if len(jobRequest.Dependencies) == 0 {
+		return nil, nil
+	}
+	jobIds := make([]string, 0, len(jobRequest.Dependencies))
+	for _, dependency := range jobRequest.Dependencies {
+		if dependency.JobId != "" {
+			jobIds = append(jobIds, dependency.JobId)
+		} else if jobId, ok := requestIdMappings[dependency.ClientId]; ok {
+			jobIds = append(jobIds, jobId)
+		} else if jobId, ok := originalIds[dependency.ClientId]; ok {
+			jobIds = append(jobIds, jobId)
+		} else if ids, ok := dependencyJobIds[dependency.ClientId]; ok {
+			jobIds = append(jobIds, ids...)
+		} else {
+			return nil, fmt.Errorf("dependency with client id %s does not match any submitted job", dependency.ClientId)
+		}
+	}
+	return slices.Unique(jobIds), nil
+}
+
+// jobArrayElementIds returns the ids of the jobs making up the job array with the given id and size,
+// or nil if size is zero, i.e., if the job isn't a job array.
+func jobArrayElementIds(arrayId string, size uint32) []string {
+	if size == 0 {
+		return nil
+	}
+	jobIds := make([]string, size)
+	for i := range jobIds {
+		jobIds[i] = eventutil.JobArrayElementId(arrayId, uint32(i))
+	}
+	return jobIds
+}
+
 func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest) (*api.CancellationResult, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.ArrayId != "" {
+		return s.cancelJobArray(ctx, req)
+	}
 	jobIds := []string{}
 	jobIds = append(jobIds, req.JobIds...)
 	if req.JobId != "" {
@@ -181,7 +276,7 @@ func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest)
 		return nil, err
 	}
 
-	userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	_, userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +294,48 @@ func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest)
 	}, nil
 }
 
+// cancelJobArray cancels all jobs making up the job array identified by req.ArrayId.
+func (s *Server) cancelJobArray(ctx *armadacontext.Context, req *api.JobCancelRequest) (*api.CancellationResult, error) {
+	if req.JobId != "" || len(req.JobIds) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "arrayId cannot be combined with jobId or jobIds")
+	}
+	err := validation.ValidateQueueAndJobSet(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	if err != nil {
+		return nil, err
+	}
+
+	es := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events: []*armadaevents.EventSequence_Event{
+			{
+				Created: protoutil.ToTimestamp(s.clock.Now().UTC()),
+				Event: &armadaevents.EventSequence_Event_CancelJobArray{
+					CancelJobArray: &armadaevents.CancelJobArray{
+						ArrayId: req.ArrayId,
+						Reason:  util.Truncate(req.Reason, 512),
+					},
+				},
+			},
+		},
+	}
+	err = s.publisher.PublishMessages(ctx, es)
+	if err != nil {
+		log.WithError(err).Error("failed send to Pulsar")
+		return nil, status.Error(codes.Internal, "Failed to send message")
+	}
+	return &api.CancellationResult{
+		CancelledIds: []string{req.ArrayId},
+	}, nil
+}
+
 func (s *Server) PreemptJobs(grpcCtx context.Context, req *api.JobPreemptRequest) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 	err := validation.ValidateQueueAndJobSet(req)
@@ -206,7 +343,7 @@ func (s *Server) PreemptJobs(grpcCtx context.Context, req *api.JobPreemptRequest
 		return nil, err
 	}
 
-	userId, groups, err := s.authorize(ctx, req.Queue, permissions.PreemptAnyJobs, queue.PermissionVerbPreempt)
+	_, userId, groups, err := s.authorize(ctx, req.Queue, permissions.PreemptAnyJobs, queue.PermissionVerbPreempt)
 	if err != nil {
 		return nil, err
 	}
@@ -254,8 +391,11 @@ func (s *Server) ReprioritizeJobs(grpcCtx context.Context, req *api.JobRepriorit
 	if err != nil {
 		return nil, err
 	}
+	if req.ArrayId != "" && len(req.JobIds) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "arrayId cannot be combined with jobIds")
+	}
 
-	userId, groups, err := s.authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	_, userId, groups, err := s.authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
 	if err != nil {
 		return nil, err
 	}
@@ -273,8 +413,21 @@ func (s *Server) ReprioritizeJobs(grpcCtx context.Context, req *api.JobRepriorit
 	}
 
 	eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
-	// No job ids implicitly indicates that all jobs in the job set should be re-prioritised.
-	if len(req.JobIds) == 0 {
+	if req.ArrayId != "" {
+		// All jobs making up the job array should be re-prioritised.
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: eventTime,
+			Event: &armadaevents.EventSequence_Event_ReprioritiseJobArray{
+				ReprioritiseJobArray: &armadaevents.ReprioritiseJobArray{
+					ArrayId:  req.ArrayId,
+					Priority: priority,
+				},
+			},
+		})
+
+		results[req.ArrayId] = ""
+	} else if len(req.JobIds) == 0 {
+		// No job ids implicitly indicates that all jobs in the job set should be re-prioritised.
 		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
 			Created: eventTime,
 			Event: &armadaevents.EventSequence_Event_ReprioritiseJobSet{
@@ -325,7 +478,7 @@ func (s *Server) CancelJobSet(grpcCtx context.Context, req *api.JobSetCancelRequ
 		return nil, err
 	}
 
-	userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	_, userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
 	if err != nil {
 		return nil, err
 	}
@@ -398,22 +551,22 @@ func eventSequenceForJobIds(clock clock.Clock, jobIds []string, queue, jobSet, u
 // authorize authorizes a user request to submit a state transition message to the log.
 // User information used for authorization is extracted from the provided context.
 // Checks that the user has either anyPerm (e.g., permissions.SubmitAnyJobs) or perm (e.g., PermissionVerbSubmit) for this queue.
-// Returns the userId and groups extracted from the context.
+// Returns the queue, along with the userId and groups extracted from the context.
 func (s *Server) authorize(
 	ctx *armadacontext.Context,
 	queueName string,
 	anyPerm permission.Permission,
 	perm queue.PermissionVerb,
-) (string, []string, error) {
+) (queue.Queue, string, []string, error) {
 	principal := auth.GetPrincipal(ctx)
 	userId := principal.GetName()
 	groups := principal.GetGroupNames()
 	q, err := s.queueCache.GetQueue(ctx, queueName)
 	if err != nil {
-		return userId, groups, err
+		return q, userId, groups, err
 	}
 	err = s.authorizer.AuthorizeQueueAction(ctx, q, anyPerm, perm)
-	return userId, groups, err
+	return q, userId, groups, err
 }
 
 func (s *Server) GetUser(ctx *armadacontext.Context) string {
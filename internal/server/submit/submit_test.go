@@ -4,8 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	clock "k8s.io/utils/clock/testing"
@@ -15,6 +18,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 	commonMocks "github.com/armadaproject/armada/internal/common/mocks"
 	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/server/mocks"
 	"github.com/armadaproject/armada/internal/server/permissions"
 	"github.com/armadaproject/armada/internal/server/submit/testfixtures"
@@ -99,7 +103,7 @@ func TestSubmit_Success(t *testing.T) {
 
 			mockedObjects.deduplicator.
 				EXPECT().
-				StoreOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, gomock.Any()).
+				StoreOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, gomock.Any(), gomock.Any()).
 				Times(1)
 
 			expectedEventSequence := &armadaevents.EventSequence{
@@ -127,6 +131,230 @@ func TestSubmit_Success(t *testing.T) {
 	}
 }
 
+func TestSubmit_Dependencies(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+
+	// Job 2 depends on job 1 in the same request, a job referenced by id and a job submitted in an earlier request.
+	req := testfixtures.SubmitRequestWithNItems(2)
+	req.JobRequestItems[1].Dependencies = []*api.JobDependency{
+		{ClientId: "1"},
+		{JobId: "01f3j0g1md4qx7z5qb148qnh4r"},
+		{ClientId: "earlier"},
+		{ClientId: "earlierArray"},
+	}
+	req.JobRequestItems[1].DependencyFailurePolicy = api.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL
+
+	server, mockedObjects := createTestServer(t)
+
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, req.Queue).
+		Return(testfixtures.DefaultQueue, nil).
+		Times(1)
+
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit).
+		Return(nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, req.JobRequestItems).
+		Return(nil, nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetDependencyJobIds(ctx, testfixtures.DefaultQueue.Name, []string{"1", "earlier", "earlierArray"}).
+		Return(map[string][]string{
+			"earlier":      {"01f3j0g1md4qx7z5qb148qnh4s"},
+			"earlierArray": {"01f3j0g1md4qx7z5qb148qnh4t-0", "01f3j0g1md4qx7z5qb148qnh4t-1"},
+		}, nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		StoreOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, gomock.Any(), gomock.Any()).
+		Times(1)
+
+	var capturedEventSequence *armadaevents.EventSequence
+	mockedObjects.publisher.EXPECT().
+		PublishMessages(ctx, gomock.Any()).
+		Times(1).
+		Do(func(_ interface{}, es *armadaevents.EventSequence) {
+			capturedEventSequence = es
+		})
+
+	_, err := server.SubmitJobs(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, capturedEventSequence.Events, 2)
+
+	assert.Empty(t, capturedEventSequence.Events[0].GetSubmitJob().Dependencies)
+	submitJob := capturedEventSequence.Events[1].GetSubmitJob()
+	// Dependencies on job arrays submitted in earlier requests are dependencies on each of their elements.
+	assert.Equal(t, []string{
+		testfixtures.TestUlid(1),
+		"01f3j0g1md4qx7z5qb148qnh4r",
+		"01f3j0g1md4qx7z5qb148qnh4s",
+		"01f3j0g1md4qx7z5qb148qnh4t-0",
+		"01f3j0g1md4qx7z5qb148qnh4t-1",
+	}, submitJob.Dependencies)
+	assert.Equal(t, armadaevents.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL, submitJob.DependencyFailurePolicy)
+}
+
+func TestSubmit_QueueDefaultRetryPolicy(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+
+	// Job 1 uses the default retry policy of the queue, whereas job 2 specifies its own.
+	req := testfixtures.SubmitRequestWithNItems(2)
+	req.JobRequestItems[1].RetryPolicy = &api.RetryPolicy{MaxAttempts: 2}
+	q := testfixtures.DefaultQueue
+	q.DefaultRetryPolicy = &api.RetryPolicy{BackoffSeconds: 30}
+
+	server, mockedObjects := createTestServer(t)
+
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, req.Queue).
+		Return(q, nil).
+		Times(1)
+
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, q, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit).
+		Return(nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetOriginalJobIds(ctx, q.Name, req.JobRequestItems).
+		Return(nil, nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		StoreOriginalJobIds(ctx, q.Name, gomock.Any(), gomock.Any()).
+		Times(1)
+
+	var capturedEventSequence *armadaevents.EventSequence
+	mockedObjects.publisher.EXPECT().
+		PublishMessages(ctx, gomock.Any()).
+		Times(1).
+		Do(func(_ interface{}, es *armadaevents.EventSequence) {
+			capturedEventSequence = es
+		})
+
+	_, err := server.SubmitJobs(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, capturedEventSequence.Events, 2)
+
+	assert.Equal(t, &schedulerobjects.RetryPolicy{BackoffSeconds: 30}, capturedEventSequence.Events[0].GetSubmitJob().RetryPolicy)
+	assert.Equal(t, &schedulerobjects.RetryPolicy{MaxAttempts: 2}, capturedEventSequence.Events[1].GetSubmitJob().RetryPolicy)
+}
+
+func TestSubmit_UnknownDependency(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+
+	req := testfixtures.SubmitRequestWithNItems(1)
+	req.JobRequestItems[0].Dependencies = []*api.JobDependency{{ClientId: "unknown"}}
+
+	server, mockedObjects := createTestServer(t)
+
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, req.Queue).
+		Return(testfixtures.DefaultQueue, nil).
+		Times(1)
+
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit).
+		Return(nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, req.JobRequestItems).
+		Return(nil, nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetDependencyJobIds(ctx, testfixtures.DefaultQueue.Name, []string{"unknown"}).
+		Return(nil, nil).
+		Times(1)
+
+	_, err := server.SubmitJobs(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSubmit_JobArray(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+
+	req := testfixtures.SubmitRequestWithNItems(1)
+	req.JobRequestItems[0].ArraySize = 3
+
+	server, mockedObjects := createTestServer(t)
+
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, req.Queue).
+		Return(testfixtures.DefaultQueue, nil).
+		Times(1)
+
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit).
+		Return(nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		GetOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, req.JobRequestItems).
+		Return(nil, nil).
+		Times(1)
+
+	mockedObjects.deduplicator.
+		EXPECT().
+		StoreOriginalJobIds(ctx, testfixtures.DefaultQueue.Name, map[string]string{"1": testfixtures.TestUlid(1)}, map[string]uint32{"1": 3}).
+		Times(1)
+
+	var capturedEventSequence *armadaevents.EventSequence
+	mockedObjects.publisher.EXPECT().
+		PublishMessages(ctx, gomock.Any()).
+		Times(1).
+		Do(func(_ interface{}, es *armadaevents.EventSequence) {
+			capturedEventSequence = es
+		})
+
+	resp, err := server.SubmitJobs(ctx, req)
+	require.NoError(t, err)
+
+	// The array is submitted as a single event and expanded downstream.
+	require.Len(t, capturedEventSequence.Events, 1)
+	submitJob := capturedEventSequence.Events[0].GetSubmitJob()
+	assert.Equal(t, uint32(3), submitJob.ArraySize)
+
+	arrayId := testfixtures.TestUlid(1)
+	assert.Equal(
+		t,
+		[]*api.JobSubmitResponseItem{{
+			JobId:       arrayId,
+			ArrayJobIds: []string{arrayId + "-0", arrayId + "-1", arrayId + "-2"},
+		}},
+		resp.JobResponseItems,
+	)
+}
+
 func TestSubmit_FailedValidation(t *testing.T) {
 	tests := map[string]struct {
 		req *api.JobSubmitRequest
@@ -217,6 +445,10 @@ func TestCancelJobs(t *testing.T) {
 			req:            &api.JobCancelRequest{Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset},
 			expectedEvents: []*armadaevents.EventSequence_Event{testfixtures.CreateCancelJobSetSequenceEvent()},
 		},
+		"Cancel job array": {
+			req:            &api.JobCancelRequest{ArrayId: jobId1, Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset},
+			expectedEvents: []*armadaevents.EventSequence_Event{testfixtures.CreateCancelJobArraySequenceEvent(jobId1)},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -272,6 +504,9 @@ func TestCancelJobs_FailedValidation(t *testing.T) {
 		"Job set is empty": {
 			req: &api.JobCancelRequest{JobId: jobId1, Queue: testfixtures.DefaultQueue.Name},
 		},
+		"Array id combined with job id": {
+			req: &api.JobCancelRequest{ArrayId: jobId1, JobId: jobId1, Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -382,6 +617,10 @@ func TestReprioritizeJobs(t *testing.T) {
 			req:            &api.JobReprioritizeRequest{Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset, NewPriority: newPriority},
 			expectedEvents: []*armadaevents.EventSequence_Event{testfixtures.CreateReprioritizedJobSetSequenceEvent(newPriority)},
 		},
+		"Reprioritize job array": {
+			req:            &api.JobReprioritizeRequest{ArrayId: jobId1, Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset, NewPriority: newPriority},
+			expectedEvents: []*armadaevents.EventSequence_Event{testfixtures.CreateReprioritizedJobArraySequenceEvent(jobId1, newPriority)},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -437,6 +676,9 @@ func TestReprioritizeJobs_FailedValidation(t *testing.T) {
 		"Job set is empty": {
 			req: &api.JobReprioritizeRequest{JobIds: []string{jobId1}, Queue: testfixtures.DefaultQueue.Name},
 		},
+		"Array id combined with job ids": {
+			req: &api.JobReprioritizeRequest{ArrayId: jobId1, JobIds: []string{jobId1}, Queue: testfixtures.DefaultQueue.Name, JobSetId: testfixtures.DefaultJobset},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
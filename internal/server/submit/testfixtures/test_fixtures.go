@@ -67,30 +67,36 @@ var (
 
 func DefaultSubmissionConfig() configuration.SubmissionConfig {
 	return configuration.SubmissionConfig{
-		AllowedPriorityClassNames: map[string]bool{DefaultPriorityClass: true},
-		DefaultPriorityClassName:  DefaultPriorityClass,
-		DefaultJobLimits:          armadaresource.ComputeResources{"cpu": resource.MustParse("1")},
-		DefaultJobTolerations:     DefaultTolerations,
-		MaxPodSpecSizeBytes:       1000,
-		MinJobResources:           map[v1.ResourceName]resource.Quantity{},
-		MinTerminationGracePeriod: 30 * time.Second,
-		MaxTerminationGracePeriod: 300 * time.Second,
-		DefaultActiveDeadline:     1 * time.Hour,
+		AllowedPriorityClassNames:      map[string]bool{DefaultPriorityClass: true},
+		DefaultPriorityClassName:       DefaultPriorityClass,
+		DefaultJobLimits:               armadaresource.ComputeResources{"cpu": resource.MustParse("1")},
+		DefaultJobTolerations:          DefaultTolerations,
+		MaxPodSpecSizeBytes:            1000,
+		MaxConfigMapAndSecretSizeBytes: 1000,
+		MaxJobArraySize:                100,
+		MaxRetryAttempts:               10,
+		MinJobResources:                map[v1.ResourceName]resource.Quantity{},
+		MinTerminationGracePeriod:      30 * time.Second,
+		MaxTerminationGracePeriod:      300 * time.Second,
+		DefaultActiveDeadline:          1 * time.Hour,
 	}
 }
 
 func SubmissionConfigWithCustomServiceNamesEnabled() configuration.SubmissionConfig {
 	return configuration.SubmissionConfig{
-		AllowedPriorityClassNames: map[string]bool{DefaultPriorityClass: true},
-		DefaultPriorityClassName:  DefaultPriorityClass,
-		DefaultJobLimits:          armadaresource.ComputeResources{"cpu": resource.MustParse("1")},
-		DefaultJobTolerations:     DefaultTolerations,
-		MaxPodSpecSizeBytes:       1000,
-		MinJobResources:           map[v1.ResourceName]resource.Quantity{},
-		MinTerminationGracePeriod: 30 * time.Second,
-		MaxTerminationGracePeriod: 300 * time.Second,
-		DefaultActiveDeadline:     1 * time.Hour,
-		AllowCustomServiceNames:   true,
+		AllowedPriorityClassNames:      map[string]bool{DefaultPriorityClass: true},
+		DefaultPriorityClassName:       DefaultPriorityClass,
+		DefaultJobLimits:               armadaresource.ComputeResources{"cpu": resource.MustParse("1")},
+		DefaultJobTolerations:          DefaultTolerations,
+		MaxPodSpecSizeBytes:            1000,
+		MaxConfigMapAndSecretSizeBytes: 1000,
+		MaxJobArraySize:                100,
+		MaxRetryAttempts:               10,
+		MinJobResources:                map[v1.ResourceName]resource.Quantity{},
+		MinTerminationGracePeriod:      30 * time.Second,
+		MaxTerminationGracePeriod:      300 * time.Second,
+		DefaultActiveDeadline:          1 * time.Hour,
+		AllowCustomServiceNames:        true,
 	}
 }
 
@@ -136,6 +142,17 @@ func CreateCancelJobSetSequenceEvent() *armadaevents.EventSequence_Event {
 	}
 }
 
+func CreateCancelJobArraySequenceEvent(arrayId string) *armadaevents.EventSequence_Event {
+	return &armadaevents.EventSequence_Event{
+		Created: DefaultTimeProto,
+		Event: &armadaevents.EventSequence_Event_CancelJobArray{
+			CancelJobArray: &armadaevents.CancelJobArray{
+				ArrayId: arrayId,
+			},
+		},
+	}
+}
+
 func CreateReprioritizeJobSequenceEvents(jobIds []string, newPriority float64) []*armadaevents.EventSequence_Event {
 	events := make([]*armadaevents.EventSequence_Event, len(jobIds))
 	for i, jobId := range jobIds {
@@ -163,6 +180,18 @@ func CreateReprioritizedJobSetSequenceEvent(newPriority float64) *armadaevents.E
 	}
 }
 
+func CreateReprioritizedJobArraySequenceEvent(arrayId string, newPriority float64) *armadaevents.EventSequence_Event {
+	return &armadaevents.EventSequence_Event{
+		Created: DefaultTimeProto,
+		Event: &armadaevents.EventSequence_Event_ReprioritiseJobArray{
+			ReprioritiseJobArray: &armadaevents.ReprioritiseJobArray{
+				ArrayId:  arrayId,
+				Priority: uint32(newPriority),
+			},
+		},
+	}
+}
+
 func NEventSequenceEvents(n int) []*armadaevents.EventSequence_Event {
 	events := make([]*armadaevents.EventSequence_Event, n)
 	for i := 0; i < n; i++ {
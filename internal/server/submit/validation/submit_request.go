@@ -5,11 +5,15 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/scheduling/context"
 	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/bidstore"
 )
 
@@ -25,6 +29,7 @@ var (
 		validateGangs,
 		validateHasJobSetId,
 		validateJobSetIdLength,
+		validateDependencyOrder,
 	}
 	itemValidators = []itemValidator{
 		validateHasNamespace,
@@ -40,6 +45,10 @@ var (
 		validateClientId,
 		validateTolerations,
 		validatePriceBand,
+		validateDependencies,
+		validateJobArray,
+		validateRetryPolicy,
+		validateConfigMapsAndSecrets,
 	}
 )
 
@@ -182,6 +191,20 @@ func validateAffinity(j *api.JobSubmitRequestItem, _ configuration.SubmissionCon
 		return nil // No affinity to check
 	}
 
+	// The scheduler doesn't know the labels of namespaces, so can't evaluate pod affinity terms selecting namespaces by label
+	var podAffinityTerms []v1.PodAffinityTerm
+	if affinity.PodAffinity != nil {
+		podAffinityTerms = append(podAffinityTerms, affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if affinity.PodAntiAffinity != nil {
+		podAffinityTerms = append(podAffinityTerms, affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+	}
+	for _, term := range podAffinityTerms {
+		if selector := term.NamespaceSelector; selector != nil && (len(selector.MatchLabels) > 0 || len(selector.MatchExpressions) > 0) {
+			return fmt.Errorf("pod affinity terms with a non-empty namespaceSelector are not supported by Armada")
+		}
+	}
+
 	nodeAffinity := affinity.NodeAffinity
 	if nodeAffinity == nil {
 		return nil // No affinity to check
@@ -211,6 +234,135 @@ func validateClientId(j *api.JobSubmitRequestItem, _ configuration.SubmissionCon
 	return nil
 }
 
+// Ensures that each dependency references exactly one job, either by job id or by client id, and that a job
+// doesn't depend on itself.
+func validateDependencies(j *api.JobSubmitRequestItem, _ configuration.SubmissionConfig) error {
+	for i, dependency := range j.Dependencies {
+		if dependency == nil || (dependency.JobId == "") == (dependency.ClientId == "") {
+			return fmt.Errorf("dependency %d must specify exactly one of jobId or clientId", i)
+		}
+		if dependency.ClientId != "" && dependency.ClientId == j.ClientId {
+			return fmt.Errorf("job with client id %s cannot depend on itself", j.ClientId)
+		}
+	}
+	if _, ok := api.DependencyFailurePolicy_name[int32(j.DependencyFailurePolicy)]; !ok {
+		return fmt.Errorf("dependency failure policy %d is not supported", j.DependencyFailurePolicy)
+	}
+	return nil
+}
+
+// Ensures that job arrays don't exceed the maximum size allowed in config and don't use features that can't be
+// shared between array elements, i.e., gang scheduling, ingresses, and services.
+func validateJobArray(j *api.JobSubmitRequestItem, config configuration.SubmissionConfig) error {
+	if j.ArraySize == 0 {
+		return nil
+	}
+	if j.ArraySize > config.MaxJobArraySize {
+		return fmt.Errorf("job array size %d is greater than the maximum allowed size of %d", j.ArraySize, config.MaxJobArraySize)
+	}
+	if _, ok := j.Annotations[configuration.GangIdAnnotation]; ok {
+		return fmt.Errorf("job arrays cannot be gang scheduled")
+	}
+	if len(j.Ingress) > 0 || len(j.Services) > 0 {
+		return fmt.Errorf("job arrays cannot define ingresses or services")
+	}
+	return nil
+}
+
+// Ensures that the ConfigMaps and Secrets of a job have distinct, valid names, are in the job's namespace, and have
+// a combined size no greater than the maximum allowed in config.
+// The executor prefixes their names with the name of the job's pod, so names are limited to leave room for it.
+func validateConfigMapsAndSecrets(j *api.JobSubmitRequestItem, config configuration.SubmissionConfig) error {
+	const maxNameChars = 200
+	size := 0
+	validateMeta := func(kind string, meta metav1.ObjectMeta, names map[string]bool) error {
+		if meta.Name == "" {
+			return fmt.Errorf("%s name is a required field", kind)
+		}
+		if len(meta.Name) > maxNameChars {
+			return fmt.Errorf("%s name %s is longer than the maximum allowed length of %d", kind, meta.Name, maxNameChars)
+		}
+		if errs := k8svalidation.IsDNS1123Subdomain(meta.Name); len(errs) > 0 {
+			return fmt.Errorf("%s name %s is invalid: %s", kind, meta.Name, strings.Join(errs, "; "))
+		}
+		if names[meta.Name] {
+			return fmt.Errorf("%s name %s is used more than once", kind, meta.Name)
+		}
+		names[meta.Name] = true
+		if meta.Namespace != "" && meta.Namespace != j.Namespace {
+			return fmt.Errorf("%s %s must be in the job's namespace %s", kind, meta.Name, j.Namespace)
+		}
+		for key, value := range meta.Labels {
+			if errs := k8svalidation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("%s %s has invalid label key %q: %s", kind, meta.Name, key, strings.Join(errs, "; "))
+			}
+			if errs := k8svalidation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("%s %s has invalid value %q for label %q: %s", kind, meta.Name, value, key, strings.Join(errs, "; "))
+			}
+		}
+		for key := range meta.Annotations {
+			if errs := k8svalidation.IsQualifiedName(strings.ToLower(key)); len(errs) > 0 {
+				return fmt.Errorf("%s %s has invalid annotation key %q: %s", kind, meta.Name, key, strings.Join(errs, "; "))
+			}
+		}
+		return nil
+	}
+	configMapNames := make(map[string]bool, len(j.ConfigMaps))
+	for _, configMap := range j.ConfigMaps {
+		if err := validateMeta("config map", configMap.ObjectMeta, configMapNames); err != nil {
+			return err
+		}
+		size += configMap.Size()
+	}
+	secretNames := make(map[string]bool, len(j.Secrets))
+	for _, secret := range j.Secrets {
+		if err := validateMeta("secret", secret.ObjectMeta, secretNames); err != nil {
+			return err
+		}
+		size += secret.Size()
+	}
+	if uint(size) > config.MaxConfigMapAndSecretSizeBytes {
+		return fmt.Errorf(
+			"config maps and secrets have a combined size of %d bytes which is greater than the maximum allowed size of %d",
+			size, config.MaxConfigMapAndSecretSizeBytes,
+		)
+	}
+	return nil
+}
+
+// Ensures that the retry policy, if any, is well-formed and doesn't allow more attempts than the maximum allowed in config.
+func validateRetryPolicy(j *api.JobSubmitRequestItem, config configuration.SubmissionConfig) error {
+	if j.RetryPolicy == nil {
+		return nil
+	}
+	if j.RetryPolicy.MaxAttempts > config.MaxRetryAttempts {
+		return fmt.Errorf(
+			"retry policy max attempts %d is greater than the maximum allowed of %d",
+			j.RetryPolicy.MaxAttempts, config.MaxRetryAttempts,
+		)
+	}
+	return ValidateRetryPolicy(j.RetryPolicy)
+}
+
+// ValidateRetryPolicy returns an error if any rule of the provided retry policy has an unsupported action or matches
+// an unknown kind of error.
+func ValidateRetryPolicy(policy *api.RetryPolicy) error {
+	for i, rule := range policy.GetRules() {
+		if rule == nil {
+			return fmt.Errorf("retry rule %d must not be empty", i)
+		}
+		if _, ok := api.RetryAction_name[int32(rule.Action)]; !ok {
+			return fmt.Errorf("retry rule %d has unsupported action %d", i, rule.Action)
+		}
+		for _, errorKind := range rule.ErrorKinds {
+			if !armadaevents.IsErrorReasonName(errorKind) {
+				return fmt.Errorf("retry rule %d matches unknown error kind %s", i, errorKind)
+			}
+		}
+	}
+	return nil
+}
+
 func validatePriceBand(j *api.JobSubmitRequestItem, _ configuration.SubmissionConfig) error {
 	priceBand, present := j.Annotations[configuration.JobPriceBand]
 	if present {
@@ -312,6 +464,37 @@ func (j jobAdapter) Annotations() map[string]string {
 	return j.GetAnnotations()
 }
 
+// Ensures that jobs only depend on jobs submitted earlier in the same request, which rules out dependency cycles,
+// and that jobs don't depend on a job array as a whole. Dependencies on client ids not present in the request refer to
+// previously submitted jobs.
+func validateDependencyOrder(request *api.JobSubmitRequest, _ configuration.SubmissionConfig) error {
+	indexByClientId := make(map[string]int, len(request.JobRequestItems))
+	for i, job := range request.JobRequestItems {
+		if job.ClientId != "" {
+			if _, ok := indexByClientId[job.ClientId]; !ok {
+				indexByClientId[job.ClientId] = i
+			}
+		}
+	}
+	for i, job := range request.JobRequestItems {
+		for _, dependency := range job.Dependencies {
+			if dependencyIndex, ok := indexByClientId[dependency.GetClientId()]; ok && dependencyIndex >= i {
+				return fmt.Errorf(
+					"job %d depends on job with client id %s, which must appear earlier in the request",
+					i, dependency.GetClientId(),
+				)
+			}
+			if dependencyIndex, ok := indexByClientId[dependency.GetClientId()]; ok && request.JobRequestItems[dependencyIndex].ArraySize > 0 {
+				return fmt.Errorf(
+					"job %d depends on job array with client id %s; depend on individual array elements by job id instead",
+					i, dependency.GetClientId(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // Ensures that any gang jobs defined in the request are consistent.  This checks that all jobs in the same gang have
 // the same:
 //   - Cardinality
@@ -335,6 +518,12 @@ func validateGangs(request *api.JobSubmitRequest, _ configuration.SubmissionConf
 					actual.Id, expected.Cardinality, actual.Cardinality,
 				)
 			}
+			if expected.MinimumCardinality != actual.MinimumCardinality {
+				return errors.Errorf(
+					"inconsistent gang minimum cardinality in gang %s: expected %d but got %d",
+					actual.Id, expected.MinimumCardinality, actual.MinimumCardinality,
+				)
+			}
 			if expected.PriorityClassName != actual.PriorityClassName {
 				return errors.Errorf(
 					"inconsistent PriorityClassName in gang %s: expected %s but got %s",
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
@@ -108,6 +109,37 @@ func TestValidateAffinity(t *testing.T) {
 			},
 			expectSuccess: false,
 		},
+		"empty namespaceSelector": {
+			req: &api.JobSubmitRequestItem{
+				PodSpec: &v1.PodSpec{
+					Affinity: &v1.Affinity{
+						PodAffinity: &v1.PodAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+								{TopologyKey: "zone", NamespaceSelector: &metav1.LabelSelector{}},
+							},
+						},
+					},
+				},
+			},
+			expectSuccess: true,
+		},
+		"non-empty namespaceSelector not allowed": {
+			req: &api.JobSubmitRequestItem{
+				PodSpec: &v1.PodSpec{
+					Affinity: &v1.Affinity{
+						PodAntiAffinity: &v1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+								{
+									TopologyKey:       "zone",
+									NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ml"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectSuccess: false,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -175,6 +207,77 @@ func TestValidateGangs(t *testing.T) {
 			},
 			expectSuccess: true,
 		},
+		"elastic gang": {
+			jobRequests: []*api.JobSubmitRequestItem{
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(2),
+					},
+				},
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(2),
+					},
+				},
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(2),
+					},
+				},
+			},
+			expectSuccess: true,
+		},
+		"inconsistent gang minimum cardinality": {
+			jobRequests: []*api.JobSubmitRequestItem{
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(2),
+					},
+				},
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(1),
+					},
+				},
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(3),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(2),
+					},
+				},
+			},
+			expectSuccess: false,
+		},
+		"gang minimum cardinality greater than cardinality": {
+			jobRequests: []*api.JobSubmitRequestItem{
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(2),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(3),
+					},
+				},
+				{
+					Annotations: map[string]string{
+						configuration.GangIdAnnotation:                 "foo",
+						configuration.GangCardinalityAnnotation:        strconv.Itoa(2),
+						configuration.GangMinimumCardinalityAnnotation: strconv.Itoa(3),
+					},
+				},
+			},
+			expectSuccess: false,
+		},
 		"two complete gangs": {
 			jobRequests: []*api.JobSubmitRequestItem{
 				{
@@ -848,6 +951,319 @@ func TestValidateClientId(t *testing.T) {
 	}
 }
 
+func TestValidateDependencies(t *testing.T) {
+	tests := map[string]struct {
+		req           *api.JobSubmitRequestItem
+		expectSuccess bool
+	}{
+		"no dependencies": {
+			req:           &api.JobSubmitRequestItem{},
+			expectSuccess: true,
+		},
+		"dependencies by job id and client id": {
+			req: &api.JobSubmitRequestItem{
+				ClientId: "b",
+				Dependencies: []*api.JobDependency{
+					{JobId: "01f3j0g1md4qx7z5qb148qnh4r"},
+					{ClientId: "a"},
+				},
+				DependencyFailurePolicy: api.DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY,
+			},
+			expectSuccess: true,
+		},
+		"dependency with neither job id nor client id": {
+			req: &api.JobSubmitRequestItem{
+				Dependencies: []*api.JobDependency{{}},
+			},
+			expectSuccess: false,
+		},
+		"dependency with both job id and client id": {
+			req: &api.JobSubmitRequestItem{
+				Dependencies: []*api.JobDependency{{JobId: "01f3j0g1md4qx7z5qb148qnh4r", ClientId: "a"}},
+			},
+			expectSuccess: false,
+		},
+		"dependency on self": {
+			req: &api.JobSubmitRequestItem{
+				ClientId:     "a",
+				Dependencies: []*api.JobDependency{{ClientId: "a"}},
+			},
+			expectSuccess: false,
+		},
+		"unknown dependency failure policy": {
+			req: &api.JobSubmitRequestItem{
+				DependencyFailurePolicy: api.DependencyFailurePolicy(100),
+			},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateDependencies(tc.req, configuration.SubmissionConfig{})
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDependencyOrder(t *testing.T) {
+	tests := map[string]struct {
+		req           *api.JobSubmitRequest
+		expectSuccess bool
+	}{
+		"dependency on earlier job": {
+			req: &api.JobSubmitRequest{
+				JobRequestItems: []*api.JobSubmitRequestItem{
+					{ClientId: "a"},
+					{ClientId: "b", Dependencies: []*api.JobDependency{{ClientId: "a"}}},
+				},
+			},
+			expectSuccess: true,
+		},
+		"dependency on previously submitted job": {
+			req: &api.JobSubmitRequest{
+				JobRequestItems: []*api.JobSubmitRequestItem{
+					{ClientId: "b", Dependencies: []*api.JobDependency{{ClientId: "a"}}},
+				},
+			},
+			expectSuccess: true,
+		},
+		"dependency on later job": {
+			req: &api.JobSubmitRequest{
+				JobRequestItems: []*api.JobSubmitRequestItem{
+					{ClientId: "b", Dependencies: []*api.JobDependency{{ClientId: "a"}}},
+					{ClientId: "a"},
+				},
+			},
+			expectSuccess: false,
+		},
+		"dependency cycle": {
+			req: &api.JobSubmitRequest{
+				JobRequestItems: []*api.JobSubmitRequestItem{
+					{ClientId: "a", Dependencies: []*api.JobDependency{{ClientId: "b"}}},
+					{ClientId: "b", Dependencies: []*api.JobDependency{{ClientId: "a"}}},
+				},
+			},
+			expectSuccess: false,
+		},
+		"dependency on job array": {
+			req: &api.JobSubmitRequest{
+				JobRequestItems: []*api.JobSubmitRequestItem{
+					{ClientId: "a", ArraySize: 2},
+					{ClientId: "b", Dependencies: []*api.JobDependency{{ClientId: "a"}}},
+				},
+			},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateDependencyOrder(tc.req, configuration.SubmissionConfig{})
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateJobArray(t *testing.T) {
+	config := configuration.SubmissionConfig{MaxJobArraySize: 10}
+	tests := map[string]struct {
+		req           *api.JobSubmitRequestItem
+		expectSuccess bool
+	}{
+		"not an array": {
+			req:           &api.JobSubmitRequestItem{Services: []*api.ServiceConfig{{}}},
+			expectSuccess: true,
+		},
+		"valid array": {
+			req:           &api.JobSubmitRequestItem{ArraySize: 10},
+			expectSuccess: true,
+		},
+		"array too large": {
+			req:           &api.JobSubmitRequestItem{ArraySize: 11},
+			expectSuccess: false,
+		},
+		"array with gang": {
+			req: &api.JobSubmitRequestItem{
+				ArraySize:   2,
+				Annotations: map[string]string{configuration.GangIdAnnotation: "foo"},
+			},
+			expectSuccess: false,
+		},
+		"array with ingress": {
+			req:           &api.JobSubmitRequestItem{ArraySize: 2, Ingress: []*api.IngressConfig{{Ports: []uint32{8080}}}},
+			expectSuccess: false,
+		},
+		"array with service": {
+			req:           &api.JobSubmitRequestItem{ArraySize: 2, Services: []*api.ServiceConfig{{}}},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateJobArray(tc.req, config)
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRetryPolicy(t *testing.T) {
+	config := configuration.SubmissionConfig{MaxRetryAttempts: 5}
+	tests := map[string]struct {
+		req           *api.JobSubmitRequestItem
+		expectSuccess bool
+	}{
+		"no retry policy": {
+			req:           &api.JobSubmitRequestItem{},
+			expectSuccess: true,
+		},
+		"valid retry policy": {
+			req: &api.JobSubmitRequestItem{
+				RetryPolicy: &api.RetryPolicy{
+					MaxAttempts:    5,
+					BackoffSeconds: 60,
+					Rules: []*api.RetryRule{
+						{Action: api.RetryAction_RETRY_ACTION_FAIL_FAST, ExitCodes: []int32{1}},
+						{Action: api.RetryAction_RETRY_ACTION_RETRY, ContainerReasons: []string{"OOMKilled"}, ErrorKinds: []string{"PodError"}},
+					},
+				},
+			},
+			expectSuccess: true,
+		},
+		"too many attempts": {
+			req:           &api.JobSubmitRequestItem{RetryPolicy: &api.RetryPolicy{MaxAttempts: 6}},
+			expectSuccess: false,
+		},
+		"empty rule": {
+			req:           &api.JobSubmitRequestItem{RetryPolicy: &api.RetryPolicy{Rules: []*api.RetryRule{nil}}},
+			expectSuccess: false,
+		},
+		"unknown action": {
+			req:           &api.JobSubmitRequestItem{RetryPolicy: &api.RetryPolicy{Rules: []*api.RetryRule{{Action: 7}}}},
+			expectSuccess: false,
+		},
+		"unknown error kind": {
+			req:           &api.JobSubmitRequestItem{RetryPolicy: &api.RetryPolicy{Rules: []*api.RetryRule{{ErrorKinds: []string{"podError"}}}}},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateRetryPolicy(tc.req, config)
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateConfigMapsAndSecrets(t *testing.T) {
+	config := configuration.SubmissionConfig{MaxConfigMapAndSecretSizeBytes: 200}
+	configMap := func(name string, data string) *v1.ConfigMap {
+		return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name}, Data: map[string]string{"key": data}}
+	}
+	secret := func(name string, data string) *v1.Secret {
+		return &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}, StringData: map[string]string{"key": data}}
+	}
+	tests := map[string]struct {
+		req           *api.JobSubmitRequestItem
+		expectSuccess bool
+	}{
+		"none": {
+			req:           &api.JobSubmitRequestItem{},
+			expectSuccess: true,
+		},
+		"valid": {
+			req: &api.JobSubmitRequestItem{
+				Namespace:  "ns",
+				ConfigMaps: []*v1.ConfigMap{configMap("config", "a"), configMap("other-config", "b")},
+				Secrets:    []*v1.Secret{secret("config", "c")},
+			},
+			expectSuccess: true,
+		},
+		"missing name": {
+			req:           &api.JobSubmitRequestItem{ConfigMaps: []*v1.ConfigMap{configMap("", "a")}},
+			expectSuccess: false,
+		},
+		"invalid name": {
+			req:           &api.JobSubmitRequestItem{Secrets: []*v1.Secret{secret("Config", "a")}},
+			expectSuccess: false,
+		},
+		"name too long": {
+			req:           &api.JobSubmitRequestItem{Secrets: []*v1.Secret{secret(strings.Repeat("a", 201), "a")}},
+			expectSuccess: false,
+		},
+		"duplicate name": {
+			req:           &api.JobSubmitRequestItem{ConfigMaps: []*v1.ConfigMap{configMap("config", "a"), configMap("config", "b")}},
+			expectSuccess: false,
+		},
+		"other namespace": {
+			req: &api.JobSubmitRequestItem{
+				Namespace:  "ns",
+				ConfigMaps: []*v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "other"}}},
+			},
+			expectSuccess: false,
+		},
+		"valid labels and annotations": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{
+					Name:        "config",
+					Labels:      map[string]string{"app.kubernetes.io/name": "trainer"},
+					Annotations: map[string]string{"example.com/Owner": "Team A, ML"},
+				}}},
+			},
+			expectSuccess: true,
+		},
+		"invalid label key": {
+			req: &api.JobSubmitRequestItem{
+				Secrets: []*v1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "secret", Labels: map[string]string{"not a key": "a"}}}},
+			},
+			expectSuccess: false,
+		},
+		"invalid label value": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "config", Labels: map[string]string{"app": "not a value"}}}},
+			},
+			expectSuccess: false,
+		},
+		"invalid annotation key": {
+			req: &api.JobSubmitRequestItem{
+				Secrets: []*v1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "secret", Annotations: map[string]string{"/owner": "a"}}}},
+			},
+			expectSuccess: false,
+		},
+		"too large": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{configMap("config", strings.Repeat("a", 100))},
+				Secrets:    []*v1.Secret{secret("secret", strings.Repeat("a", 100))},
+			},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateConfigMapsAndSecrets(tc.req, config)
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateQueue(t *testing.T) {
 	tests := map[string]struct {
 		req           *api.JobSubmitRequest
@@ -0,0 +1,81 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Query selects the usage to return.
+type Query struct {
+	// First and last day, inclusive, of which usage is returned, as midnight in UTC
+	StartDate time.Time
+	EndDate   time.Time
+	// If non-empty, only usage by these queues is returned
+	Queues []string
+	// If non-empty, only usage in these pools is returned
+	Pools []string
+}
+
+type Repository interface {
+	// GetUsage returns the usage matching the query, ordered by date, queue and pool.
+	GetUsage(ctx *armadacontext.Context, query *Query) ([]*api.QueueUsage, error)
+}
+
+// PostgresRepository reads usage from the database written to by the accounting ingester.
+type PostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresRepository(db *pgxpool.Pool) *PostgresRepository {
+	return &PostgresRepository{db: db}
+}
+
+func (r *PostgresRepository) GetUsage(ctx *armadacontext.Context, query *Query) ([]*api.QueueUsage, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT day, queue, pool, resource, resource_hours
+		FROM queue_usage
+		WHERE day >= $1 AND day <= $2
+		AND (cardinality($3::text[]) = 0 OR queue = ANY($3::text[]))
+		AND (cardinality($4::text[]) = 0 OR pool = ANY($4::text[]))
+		ORDER BY day, queue, pool, resource`,
+		query.StartDate, query.EndDate, nonNil(query.Queues), nonNil(query.Pools),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	var result []*api.QueueUsage
+	var current *api.QueueUsage
+	for rows.Next() {
+		var day time.Time
+		var queue, pool, resource string
+		var resourceHours float64
+		if err := rows.Scan(&day, &queue, &pool, &resource, &resourceHours); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		date := day.Format(DateFormat)
+		if current == nil || current.Date != date || current.Queue != queue || current.Pool != pool {
+			current = &api.QueueUsage{Queue: queue, Pool: pool, Date: date, ResourceHours: make(map[string]float64)}
+			result = append(result, current)
+		}
+		current.ResourceHours[resource] = resourceHours
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return result, nil
+}
+
+// nonNil returns s, or an empty slice if s is nil, as pgx encodes a nil slice as NULL.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
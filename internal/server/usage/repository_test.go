@@ -0,0 +1,52 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/accountingingester/schema"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestPostgresRepository_GetUsage(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schema.WithTestDb(func(db *pgxpool.Pool) error {
+		_, err := db.Exec(ctx, `
+			INSERT INTO queue_usage (day, queue, pool, resource, resource_hours) VALUES
+			('2024-02-29', 'queue-a', 'cpu', 'cpu', 1),
+			('2024-03-01', 'queue-a', 'cpu', 'cpu', 24),
+			('2024-03-01', 'queue-a', 'cpu', 'memory', 1024),
+			('2024-03-01', 'queue-a', 'gpu', 'nvidia.com/gpu', 8),
+			('2024-03-01', 'queue-b', 'cpu', 'cpu', 12),
+			('2024-03-02', 'queue-a', 'cpu', 'cpu', 6),
+			('2024-03-03', 'queue-a', 'cpu', 'cpu', 1)`)
+		require.NoError(t, err)
+		repository := NewPostgresRepository(db)
+		startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+		usage, err := repository.GetUsage(ctx, &Query{StartDate: startDate, EndDate: endDate})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.QueueUsage{
+			{Queue: "queue-a", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"cpu": 24, "memory": 1024}},
+			{Queue: "queue-a", Pool: "gpu", Date: "2024-03-01", ResourceHours: map[string]float64{"nvidia.com/gpu": 8}},
+			{Queue: "queue-b", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"cpu": 12}},
+			{Queue: "queue-a", Pool: "cpu", Date: "2024-03-02", ResourceHours: map[string]float64{"cpu": 6}},
+		}, usage)
+
+		usage, err = repository.GetUsage(ctx, &Query{StartDate: startDate, EndDate: endDate, Queues: []string{"queue-a"}, Pools: []string{"cpu"}})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.QueueUsage{
+			{Queue: "queue-a", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"cpu": 24, "memory": 1024}},
+			{Queue: "queue-a", Pool: "cpu", Date: "2024-03-02", ResourceHours: map[string]float64{"cpu": 6}},
+		}, usage)
+		return nil
+	})
+	require.NoError(t, err)
+}
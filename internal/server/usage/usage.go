@@ -0,0 +1,127 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Format of the dates of usage requests and responses.
+const DateFormat = "2006-01-02"
+
+// Server serves the resource usage of queues, as accounted for by the accounting ingester.
+type Server struct {
+	repository      Repository
+	queueRepository armadaqueue.ReadOnlyQueueRepository
+	authorizer      auth.ActionAuthorizer
+}
+
+func New(
+	repository Repository,
+	queueRepository armadaqueue.ReadOnlyQueueRepository,
+	authorizer auth.ActionAuthorizer,
+) *Server {
+	return &Server{
+		repository:      repository,
+		queueRepository: queueRepository,
+		authorizer:      authorizer,
+	}
+}
+
+// GetUsage returns the usage of each queue in each pool on each day of the requested date range.
+// Only usage by queues the caller has permission to watch is returned.
+func (s *Server) GetUsage(grpcCtx context.Context, req *api.UsageRequest) (*api.UsageResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	query, err := queryFromRequest(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[GetUsage] %s", err)
+	}
+	queues, ok, err := s.watchableQueues(ctx, req.Queues)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return &api.UsageResponse{}, nil
+	}
+	query.Queues = queues
+
+	usage, err := s.repository.GetUsage(ctx, query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "[GetUsage] error getting usage: %s", err)
+	}
+	return &api.UsageResponse{Usage: usage}, nil
+}
+
+func queryFromRequest(req *api.UsageRequest) (*Query, error) {
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, errors.New("start and end dates must be provided")
+	}
+	startDate, err := parseDate(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDate(req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, errors.Errorf("end date %s is before start date %s", req.EndDate, req.StartDate)
+	}
+	return &Query{StartDate: startDate, EndDate: endDate, Pools: req.Pools}, nil
+}
+
+func parseDate(date string) (time.Time, error) {
+	t, err := time.Parse(DateFormat, date)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid date %q; must be formatted as YYYY-MM-DD", date)
+	}
+	return t, nil
+}
+
+// watchableQueues returns the queues of those requested the caller has permission to watch, following the same rules
+// as for watching job set events. If no queues are requested, the queues the caller may watch are returned, or nil if
+// they may watch all queues. ok is false if there are no such queues.
+func (s *Server) watchableQueues(ctx *armadacontext.Context, requested []string) ([]string, bool, error) {
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.WatchAllEvents); err == nil {
+		return requested, true, nil
+	}
+
+	if len(requested) > 0 {
+		for _, queueName := range requested {
+			apiQueue, err := s.queueRepository.GetQueue(ctx, queueName)
+			var notFoundErr *armadaqueue.ErrQueueNotFound
+			if errors.As(err, &notFoundErr) {
+				return nil, false, status.Errorf(codes.NotFound, "[GetUsage] queue %s does not exist", queueName)
+			} else if err != nil {
+				return nil, false, err
+			}
+			if err := permissions.AuthorizeWatchQueue(ctx, s.authorizer, apiQueue, "getting usage of queue "+apiQueue.Name); err != nil {
+				return nil, false, err
+			}
+		}
+		return requested, true, nil
+	}
+
+	queues, err := s.queueRepository.GetAllQueues(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	var queueNames []string
+	for _, apiQueue := range queues {
+		if ok, err := permissions.CanWatchQueue(ctx, s.authorizer, apiQueue); err != nil {
+			return nil, false, err
+		} else if ok {
+			queueNames = append(queueNames, apiQueue.Name)
+		}
+	}
+	return queueNames, len(queueNames) > 0, nil
+}
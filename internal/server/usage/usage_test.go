@@ -0,0 +1,145 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/server/mocks"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	armadaqueue "github.com/armadaproject/armada/internal/server/queue"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+type fakeRepository struct {
+	usage []*api.QueueUsage
+	// Query of the last call to GetUsage.
+	query *Query
+}
+
+func (r *fakeRepository) GetUsage(_ *armadacontext.Context, query *Query) ([]*api.QueueUsage, error) {
+	r.query = query
+	return r.usage, nil
+}
+
+func TestGetUsage(t *testing.T) {
+	queueA := queue.Queue{Name: "queue-a"}
+	queueB := queue.Queue{Name: "queue-b"}
+	unauthorized := &armadaerrors.ErrUnauthorized{Principal: "alice", Permission: permissions.WatchAllEvents}
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	usage := []*api.QueueUsage{{Queue: "queue-a", Pool: "cpu", Date: "2024-03-01", ResourceHours: map[string]float64{"cpu": 24}}}
+
+	tests := map[string]struct {
+		request *api.UsageRequest
+		// Whether the caller has the global permission to watch all queues.
+		watchAll bool
+		// Queues the caller may watch.
+		watchableQueues map[string]bool
+		expectedQuery   *Query
+		expectedCode    codes.Code
+	}{
+		"queues and pools": {
+			request:         &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31", Queues: []string{"queue-a"}, Pools: []string{"cpu"}},
+			watchableQueues: map[string]bool{"queue-a": true},
+			expectedQuery:   &Query{StartDate: startDate, EndDate: endDate, Queues: []string{"queue-a"}, Pools: []string{"cpu"}},
+		},
+		"all queues": {
+			request:       &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31"},
+			watchAll:      true,
+			expectedQuery: &Query{StartDate: startDate, EndDate: endDate},
+		},
+		"deleted queue with global permission": {
+			request:       &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31", Queues: []string{"queue-c"}},
+			watchAll:      true,
+			expectedQuery: &Query{StartDate: startDate, EndDate: endDate, Queues: []string{"queue-c"}},
+		},
+		"only watchable queues": {
+			request:         &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31"},
+			watchableQueues: map[string]bool{"queue-b": true},
+			expectedQuery:   &Query{StartDate: startDate, EndDate: endDate, Queues: []string{"queue-b"}},
+		},
+		"no watchable queues": {
+			request: &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31"},
+		},
+		"queue not watchable": {
+			request:         &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31", Queues: []string{"queue-a", "queue-b"}},
+			watchableQueues: map[string]bool{"queue-a": true},
+			expectedCode:    codes.PermissionDenied,
+		},
+		"queue does not exist": {
+			request:      &api.UsageRequest{StartDate: "2024-03-01", EndDate: "2024-03-31", Queues: []string{"queue-c"}},
+			expectedCode: codes.NotFound,
+		},
+		"missing date": {
+			request:      &api.UsageRequest{StartDate: "2024-03-01"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"invalid date": {
+			request:      &api.UsageRequest{StartDate: "2024-03-01", EndDate: "31/03/2024"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"end before start": {
+			request:      &api.UsageRequest{StartDate: "2024-03-31", EndDate: "2024-03-01"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := armadacontext.Background()
+			ctrl := gomock.NewController(t)
+			queueRepository := mocks.NewMockQueueRepository(ctrl)
+			queueRepository.EXPECT().GetAllQueues(gomock.Any()).Return([]queue.Queue{queueA, queueB}, nil).AnyTimes()
+			queueRepository.EXPECT().GetQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *armadacontext.Context, name string) (queue.Queue, error) {
+					for _, q := range []queue.Queue{queueA, queueB} {
+						if q.Name == name {
+							return q, nil
+						}
+					}
+					return queue.Queue{}, &armadaqueue.ErrQueueNotFound{QueueName: name}
+				},
+			).AnyTimes()
+			authorizer := mocks.NewMockActionAuthorizer(ctrl)
+			authorizer.EXPECT().AuthorizeAction(gomock.Any(), permission.Permission(permissions.WatchAllEvents)).DoAndReturn(
+				func(_ *armadacontext.Context, _ any) error {
+					if tc.watchAll {
+						return nil
+					}
+					return unauthorized
+				},
+			).AnyTimes()
+			authorizer.EXPECT().AuthorizeQueueAction(gomock.Any(), gomock.Any(), permission.Permission(permissions.WatchAllEvents), queue.PermissionVerbWatch).DoAndReturn(
+				func(_ *armadacontext.Context, q queue.Queue, _ any, _ any) error {
+					if tc.watchAll || tc.watchableQueues[q.Name] {
+						return nil
+					}
+					return unauthorized
+				},
+			).AnyTimes()
+			repository := &fakeRepository{usage: usage}
+
+			response, err := New(repository, queueRepository, authorizer).GetUsage(ctx, tc.request)
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, repository.query)
+			if tc.expectedQuery != nil {
+				assert.Equal(t, usage, response.Usage)
+			} else {
+				assert.Empty(t, response.Usage)
+			}
+		})
+	}
+}
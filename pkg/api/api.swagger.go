@@ -277,6 +277,38 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/list\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Jobs\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ListJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiListJobsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiListJobsResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/preempt\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -585,6 +617,54 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/queue/{queue}/events\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Event\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"WatchQueue streams the events of all job sets in a queue.\",\n" +
+		"        \"operationId\": \"WatchQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiWatchQueueRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiQueueEventStreamMessage\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiQueueEventStreamMessage\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/queues/active\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -648,6 +728,38 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
+		"    },\n" +
+		"    \"/v1/usage\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Usage\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetUsage\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiUsageRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiUsageResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
 		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
@@ -756,6 +868,16 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiDependencyFailurePolicy\": {\n" +
+		"      \"description\": \"Determines what happens to a job when one of the jobs it depends on fails or is cancelled.\\n\\n - DEPENDENCY_FAILURE_POLICY_CANCEL: The job is cancelled.\\n - DEPENDENCY_FAILURE_POLICY_FAIL: The job is failed.\\n - DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY: The job is scheduled regardless.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"DEPENDENCY_FAILURE_POLICY_CANCEL\",\n" +
+		"      \"enum\": [\n" +
+		"        \"DEPENDENCY_FAILURE_POLICY_CANCEL\",\n" +
+		"        \"DEPENDENCY_FAILURE_POLICY_FAIL\",\n" +
+		"        \"DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY\"\n" +
+		"      ]\n" +
+		"    },\n" +
 		"    \"apiEndMarker\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"Indicates the end of streams\"\n" +
@@ -793,6 +915,9 @@ func SwaggerJsonTemplate() string {
 		"        \"preempting\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobPreemptingEvent\"\n" +
 		"        },\n" +
+		"        \"preemptionNotice\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobPreemptionNoticeEvent\"\n" +
+		"        },\n" +
 		"        \"queued\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobQueuedEvent\"\n" +
 		"        },\n" +
@@ -900,6 +1025,16 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"dependencies\": {\n" +
+		"          \"description\": \"Ids of the jobs that must succeed before this job may be scheduled.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"dependencyFailurePolicy\": {\n" +
+		"          \"$ref\": \"#/definitions/apiDependencyFailurePolicy\"\n" +
+		"        },\n" +
 		"        \"id\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -913,6 +1048,13 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"k8sConfigMap\": {\n" +
+		"          \"description\": \"ConfigMaps created alongside the job's pod. Secrets are deliberately not included, so that their contents aren't\\nexposed by APIs returning jobs.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ConfigMap\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"k8sIngress\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -986,6 +1128,10 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"arrayId\": {\n" +
+		"          \"description\": \"If set, all jobs in the job array with this id are cancelled.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1054,6 +1200,20 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobDependency\": {\n" +
+		"      \"description\": \"Identifies a job that another job depends on. Exactly one of job_id and client_id must be set.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clientId\": {\n" +
+		"          \"description\": \"Client id of a job in the same queue, submitted either previously or as part of the same request.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"description\": \"Id of a previously submitted job.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobDetails\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1438,6 +1598,32 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobPreemptionNoticeEvent\": {\n" +
+		"      \"description\": \"Indicates that the job's run is about to be preempted; it's killed once the deadline has passed.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"deadline\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobQueuedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1460,6 +1646,10 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"arrayId\": {\n" +
+		"          \"description\": \"If set, all jobs in the job array with this id are re-prioritised.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobIds\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1546,6 +1736,11 @@ func SwaggerJsonTemplate() string {
 		"        \"cluster\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"exitCode\": {\n" +
+		"          \"description\": \"Exit code of the run's main container. Only meaningful if the run finished after its container exited.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
 		"        \"finishedTs\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
@@ -1564,6 +1759,9 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"runId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1783,9 +1981,32 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"arraySize\": {\n" +
+		"          \"description\": \"If non-zero, this item is expanded into a job array of array_size jobs, which share the same spec.\\nEach job is given its index in the array via the ARMADA_JOB_ARRAY_INDEX environment variable\\nand the armadaproject.io/jobArrayIndex annotation.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
 		"        \"clientId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"configMaps\": {\n" +
+		"          \"description\": \"ConfigMaps and Secrets created alongside the job's pod and deleted with it.\\nEach must be named; the pod refers to them by that name, e.g., in volumes or envFrom,\\nand the executor gives them a name unique to the job when creating them.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ConfigMap\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"dependencies\": {\n" +
+		"          \"description\": \"Jobs that must succeed before this job may be scheduled.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobDependency\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"dependencyFailurePolicy\": {\n" +
+		"          \"description\": \"What to do with this job if any of its dependencies fails or is cancelled.\",\n" +
+		"          \"$ref\": \"#/definitions/apiDependencyFailurePolicy\"\n" +
+		"        },\n" +
 		"        \"ingress\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1801,6 +2022,11 @@ func SwaggerJsonTemplate() string {
 		"        \"namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"notBefore\": {\n" +
+		"          \"description\": \"If set, the job remains queued but may not be scheduled until this time.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
 		"        \"podSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
@@ -1814,16 +2040,31 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
+		"        \"queueTtlSeconds\": {\n" +
+		"          \"description\": \"If non-zero, the job is cancelled if it hasn't been scheduled within this many seconds of becoming eligible for\\nscheduling, i.e., of being submitted, of not_before, or of its dependencies having succeeded, whichever is later.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
 		"        \"requiredNodeLabels\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"retryPolicy\": {\n" +
+		"          \"description\": \"Determines whether the job is retried if one of its runs fails. If not set, the queue's default retry policy applies.\",\n" +
+		"          \"$ref\": \"#/definitions/apiRetryPolicy\"\n" +
+		"        },\n" +
 		"        \"scheduler\": {\n" +
 		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"secrets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Secret\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"services\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1847,10 +2088,18 @@ func SwaggerJsonTemplate() string {
 		"    \"apiJobSubmitResponseItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"arrayJobIds\": {\n" +
+		"          \"description\": \"For job arrays, the ids of the jobs that make up the array, ordered by index.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"error\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobId\": {\n" +
+		"          \"description\": \"Id of the submitted job. For job arrays, this is the id of the array.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
@@ -1968,6 +2217,75 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiListJobsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"description\": \"Only jobs with all of these annotations are returned.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobset\": {\n" +
+		"          \"description\": \"Only jobs in this job set are returned. Requires queue to be set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"description\": \"Only jobs with all of these labels are returned.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"pageSize\": {\n" +
+		"          \"description\": \"Maximum number of jobs to return. Defaults to, and is capped at, the server's maximum number of query items.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"pageToken\": {\n" +
+		"          \"description\": \"Token returned as next_page_token by a previous request with the same filters; used to get the next page of jobs.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"description\": \"Only jobs in this queue are returned. If empty, jobs in all queues the caller may watch are returned.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"states\": {\n" +
+		"          \"description\": \"Only jobs in one of these states are returned. If empty, jobs in any state are returned.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"submittedAfter\": {\n" +
+		"          \"description\": \"Only jobs submitted at or after this time are returned.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"submittedBefore\": {\n" +
+		"          \"description\": \"Only jobs submitted before this time are returned.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiListJobsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobs\": {\n" +
+		"          \"description\": \"Jobs matching the request, most recently submitted first. Job specs aren't included.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobDetails\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nextPageToken\": {\n" +
+		"          \"description\": \"Token to pass as page_token to get the next page of jobs. Empty if there are no more jobs.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiPriorityClassPoolResourceLimits\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -2008,6 +2326,10 @@ func SwaggerJsonTemplate() string {
 		"          \"description\": \"Determines whether scheduling is enabled for this queue.\",\n" +
 		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
+		"        \"defaultRetryPolicy\": {\n" +
+		"          \"description\": \"Retry policy of jobs submitted to this queue that don't specify one.\",\n" +
+		"          \"$ref\": \"#/definitions/apiRetryPolicy\"\n" +
+		"        },\n" +
 		"        \"groupOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -2030,6 +2352,10 @@ func SwaggerJsonTemplate() string {
 		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"parent\": {\n" +
+		"          \"description\": \"Name of the queue this queue is nested under, if any.\\nFair share is first divided among top-level queues and then, recursively, among the children of each queue.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"permissions\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -2040,6 +2366,13 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
+		"        \"quotaByPool\": {\n" +
+		"          \"description\": \"Map from pool name to the absolute quota of this queue in that pool.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueQuota\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"resourceLimits\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"title\": \"These are ignored and should be removed\",\n" +
@@ -2074,6 +2407,22 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueEventStreamMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"id\": {\n" +
+		"          \"description\": \"Id of the message within its job set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiQueueList\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -2086,6 +2435,23 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueQuota\": {\n" +
+		"      \"description\": \"Absolute amount of resources guaranteed to a queue in a particular pool.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"borrowingEnabled\": {\n" +
+		"          \"description\": \"If true, the queue may use idle resources beyond its guaranteed quota.\\nBorrowed resources may be preempted to make room for jobs of queues below their guaranteed quota.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"guaranteed\": {\n" +
+		"          \"description\": \"Resources guaranteed to the queue, e.g., {\\\"cpu\\\": \\\"2000\\\", \\\"nvidia.com/gpu\\\": \\\"64\\\"}.\\nThe queue is not limited with respect to resources not listed here.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiQueueUpdateResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -2097,6 +2463,93 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueueUsage\": {\n" +
+		"      \"description\": \"Resources allocated to a queue in a pool over a day.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"date\": {\n" +
+		"          \"description\": \"Day, formatted as YYYY-MM-DD, in UTC.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"resourceHours\": {\n" +
+		"          \"description\": \"Amount of each resource allocated to the queue integrated over time, in the base unit of the resource multiplied\\nby hours; e.g., cpu in core-hours and memory in byte-hours.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiRetryAction\": {\n" +
+		"      \"description\": \" - RETRY_ACTION_RETRY: The job is retried, provided it has not reached its maximum number of attempts.\\n - RETRY_ACTION_FAIL_FAST: The job is failed immediately.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"RETRY_ACTION_RETRY\",\n" +
+		"      \"enum\": [\n" +
+		"        \"RETRY_ACTION_RETRY\",\n" +
+		"        \"RETRY_ACTION_FAIL_FAST\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiRetryPolicy\": {\n" +
+		"      \"description\": \"Determines whether a job is retried if one of its runs fails.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"backoffSeconds\": {\n" +
+		"          \"description\": \"Minimum number of seconds between a run failing and the job being scheduled again.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"maxAttempts\": {\n" +
+		"          \"description\": \"Maximum number of runs of the job. If zero, the maximum number of attempts configured for the scheduler applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"rules\": {\n" +
+		"          \"description\": \"Rules matched, in order, against the error of a failed run. The first matching rule determines whether the job\\nis retried. If no rule matches, the job is retried only if the executor returned the run because its pod never\\nstarted, e.g., because it couldn't be scheduled onto the node it was assigned to.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiRetryRule\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiRetryRule\": {\n" +
+		"      \"description\": \"A rule matching the error of a failed run. A rule matches if all of its non-empty criteria match.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"action\": {\n" +
+		"          \"$ref\": \"#/definitions/apiRetryAction\"\n" +
+		"        },\n" +
+		"        \"containerReasons\": {\n" +
+		"          \"description\": \"Matches if any container terminated with one of these reasons, e.g., OOMKilled.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"errorKinds\": {\n" +
+		"          \"description\": \"Matches if the kind of the error is one of these, e.g., PodError or PodLeaseReturned.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"exitCodes\": {\n" +
+		"          \"description\": \"Matches if any container exited with one of these exit codes.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiServiceConfig\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -2130,7 +2583,84 @@ func SwaggerJsonTemplate() string {
 		"          \"$ref\": \"#/definitions/apiEndMarker\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
-		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiUsageRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"endDate\": {\n" +
+		"          \"description\": \"Last day, formatted as YYYY-MM-DD, of which usage is returned, inclusive.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pools\": {\n" +
+		"          \"description\": \"Only usage in these pools is returned. If empty, usage in all pools is returned.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queues\": {\n" +
+		"          \"description\": \"Only usage by these queues is returned. If empty, usage by all queues the caller may watch is returned.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"startDate\": {\n" +
+		"          \"description\": \"First day, formatted as YYYY-MM-DD, of which usage is returned. Days are in UTC.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiUsageResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"usage\": {\n" +
+		"          \"description\": \"Usage ordered by date, queue and pool.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueUsage\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiWatchQueueRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"eventTypes\": {\n" +
+		"          \"description\": \"If non-empty, only events of these types are streamed.\\nTypes are named as in the EventMessage oneof, e.g., \\\"submitted\\\" or \\\"lease_returned\\\".\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"fromMessageIds\": {\n" +
+		"          \"description\": \"Id of the last message received from each job set, as returned in QueueEventStreamMessage.\\nEvents of these job sets are streamed from after that message; other job sets are streamed from the start.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetPrefix\": {\n" +
+		"          \"description\": \"If set, only events of job sets whose name starts with this prefix are streamed.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"description\": \"If non-empty, only events of jobs with all of these labels are streamed.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"watch\": {\n" +
+		"          \"description\": \"If true, new events are streamed as they're published, including those of job sets created after the call.\\nOtherwise, the stream ends once all events stored when the call was made have been sent.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -2523,6 +3053,138 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"v1ConfigMap\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"ConfigMap holds configuration data for pods to consume.\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"description\": \"Annotations is an unstructured key value map stored with a resource that may be\\nset by external tools to store and retrieve arbitrary metadata. They are not\\nqueryable and should be preserved when modifying objects.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Annotations\"\n" +
+		"        },\n" +
+		"        \"apiVersion\": {\n" +
+		"          \"description\": \"APIVersion defines the versioned schema of this representation of an object.\\nServers should convert recognized schemas to the latest internal value, and\\nmay reject unrecognized values.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"APIVersion\"\n" +
+		"        },\n" +
+		"        \"binaryData\": {\n" +
+		"          \"description\": \"BinaryData contains the binary data.\\nEach key must consist of alphanumeric characters, '-', '_' or '.'.\\nBinaryData can contain byte sequences that are not in the UTF-8 range.\\nThe keys stored in BinaryData must not overlap with the ones in\\nthe Data field, this is enforced during validation process.\\nUsing this field will require 1.10+ apiserver and\\nkubelet.\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"array\",\n" +
+		"            \"items\": {\n" +
+		"              \"type\": \"integer\",\n" +
+		"              \"format\": \"uint8\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"BinaryData\"\n" +
+		"        },\n" +
+		"        \"creationTimestamp\": {\n" +
+		"          \"description\": \"CreationTimestamp is a timestamp representing the server time when this object was\\ncreated. It is not guaranteed to be set in happens-before order across separate operations.\\nClients may not set this value. It is represented in RFC3339 form and is in UTC.\\n\\nPopulated by the system.\\nRead-only.\\nNull for lists.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"CreationTimestamp\"\n" +
+		"        },\n" +
+		"        \"data\": {\n" +
+		"          \"description\": \"Data contains the configuration data.\\nEach key must consist of alphanumeric characters, '-', '_' or '.'.\\nValues with non-UTF-8 byte sequences must use the BinaryData field.\\nThe keys stored in Data must not overlap with the keys in\\nthe BinaryData field, this is enforced during validation process.\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Data\"\n" +
+		"        },\n" +
+		"        \"deletionGracePeriodSeconds\": {\n" +
+		"          \"description\": \"Number of seconds allowed for this object to gracefully terminate before\\nit will be removed from the system. Only set when deletionTimestamp is also set.\\nMay only be shortened.\\nRead-only.\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"x-go-name\": \"DeletionGracePeriodSeconds\"\n" +
+		"        },\n" +
+		"        \"deletionTimestamp\": {\n" +
+		"          \"description\": \"DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This\\nfield is set by the server when a graceful deletion is requested by the user, and is not\\ndirectly settable by a client. The resource is expected to be deleted (no longer visible\\nfrom resource lists, and not reachable by name) after the time in this field, once the\\nfinalizers list is empty. As long as the finalizers list contains items, deletion is blocked.\\nOnce the deletionTimestamp is set, this value may not be unset or be set further into the\\nfuture, although it may be shortened or the resource may be deleted prior to this time.\\nFor example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react\\nby sending a graceful termination signal to the containers in the pod. After that 30 seconds,\\nthe Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup,\\nremove the pod from the API. In the presence of network partitions, this object may still\\nexist after this timestamp, until an administrator or automated process can determine the\\nresource is fully terminated.\\nIf not set, graceful deletion of the object has not been requested.\\n\\nPopulated by the system when a graceful deletion is requested.\\nRead-only.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"DeletionTimestamp\"\n" +
+		"        },\n" +
+		"        \"finalizers\": {\n" +
+		"          \"description\": \"Must be empty before the object is deleted from the registry. Each entry\\nis an identifier for the responsible component that will remove the entry\\nfrom the list. If the deletionTimestamp of the object is non-nil, entries\\nin this list can only be removed.\\nFinalizers may be processed and removed in any order.  Order is NOT enforced\\nbecause it introduces significant risk of stuck finalizers.\\nfinalizers is a shared field, any actor with permission can reorder it.\\nIf the finalizer list is processed in order, then this can lead to a situation\\nin which the component responsible for the first finalizer in the list is\\nwaiting for a signal (field value, external system, or other) produced by a\\ncomponent responsible for a finalizer later in the list, resulting in a deadlock.\\nWithout enforced ordering finalizers are free to order amongst themselves and\\nare not vulnerable to ordering changes in the list.\\n+optional\\n+patchStrategy=merge\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Finalizers\"\n" +
+		"        },\n" +
+		"        \"generateName\": {\n" +
+		"          \"description\": \"GenerateName is an optional prefix, used by the server, to generate a unique\\nname ONLY IF the Name field has not been provided.\\nIf this field is used, the name returned to the client will be different\\nthan the name passed. This value will also be combined with a unique suffix.\\nThe provided value has the same validation rules as the Name field,\\nand may be truncated by the length of the suffix required to make the value\\nunique on the server.\\n\\nIf this field is specified and the generated name exists, the server will return a 409.\\n\\nApplied only if Name is not specified.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"GenerateName\"\n" +
+		"        },\n" +
+		"        \"generation\": {\n" +
+		"          \"description\": \"A sequence number representing a specific generation of the desired state.\\nPopulated by the system. Read-only.\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"x-go-name\": \"Generation\"\n" +
+		"        },\n" +
+		"        \"immutable\": {\n" +
+		"          \"description\": \"Immutable, if set to true, ensures that data stored in the ConfigMap cannot\\nbe updated (only object metadata can be modified).\\nIf not set to true, the field can be modified at any time.\\nDefaulted to nil.\\n+optional\",\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"x-go-name\": \"Immutable\"\n" +
+		"        },\n" +
+		"        \"kind\": {\n" +
+		"          \"description\": \"Kind is a string value representing the REST resource this object represents.\\nServers may infer this from the endpoint the client submits requests to.\\nCannot be updated.\\nIn CamelCase.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Kind\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"description\": \"Map of string keys and values that can be used to organize and categorize\\n(scope and select) objects. May match selectors of replication controllers\\nand services.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Labels\"\n" +
+		"        },\n" +
+		"        \"managedFields\": {\n" +
+		"          \"description\": \"ManagedFields maps workflow-id and version to the set of fields\\nthat are managed by that workflow. This is mostly for internal\\nhousekeeping, and users typically shouldn't need to set or\\nunderstand this field. A workflow can be the user's name, a\\ncontroller's name, or the name of a specific apply path like\\n\\\"ci-cd\\\". The set of fields is always in the version that the\\nworkflow used when modifying the object.\\n\\n+optional\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ManagedFieldsEntry\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"ManagedFields\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"Name must be unique within a namespace. Is required when creating resources, although\\nsome resources may allow a client to request the generation of an appropriate name\\nautomatically. Name is primarily intended for creation idempotence and configuration\\ndefinition.\\nCannot be updated.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Name\"\n" +
+		"        },\n" +
+		"        \"namespace\": {\n" +
+		"          \"description\": \"Namespace defines the space within which each name must be unique. An empty namespace is\\nequivalent to the \\\"default\\\" namespace, but \\\"default\\\" is the canonical representation.\\nNot all objects are required to be scoped to a namespace - the value of this field for\\nthose objects will be empty.\\n\\nMust be a DNS_LABEL.\\nCannot be updated.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Namespace\"\n" +
+		"        },\n" +
+		"        \"ownerReferences\": {\n" +
+		"          \"description\": \"List of objects depended by this object. If ALL objects in the list have\\nbeen deleted, this object will be garbage collected. If this object is managed by a controller,\\nthen an entry in this list will point to this controller, with the controller field set to true.\\nThere cannot be more than one managing controller.\\n+optional\\n+patchMergeKey=uid\\n+patchStrategy=merge\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1OwnerReference\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"OwnerReferences\"\n" +
+		"        },\n" +
+		"        \"resourceVersion\": {\n" +
+		"          \"description\": \"An opaque value that represents the internal version of this object that can\\nbe used by clients to determine when objects have changed. May be used for optimistic\\nconcurrency, change detection, and the watch operation on a resource or set of resources.\\nClients must treat these values as opaque and passed unmodified back to the server.\\nThey may only be valid for a particular resource or set of resources.\\n\\nPopulated by the system.\\nRead-only.\\nValue must be treated as opaque by clients and .\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"ResourceVersion\"\n" +
+		"        },\n" +
+		"        \"selfLink\": {\n" +
+		"          \"description\": \"Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"SelfLink\"\n" +
+		"        },\n" +
+		"        \"uid\": {\n" +
+		"          \"$ref\": \"#/definitions/typesUID\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
+		"    },\n" +
 		"    \"v1ConfigMapEnvSource\": {\n" +
 		"      \"description\": \"The contents of the target ConfigMap's Data field will represent the\\nkey-value pairs as environment variables.\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -5197,6 +5859,141 @@ func SwaggerJsonTemplate() string {
 		"      \"title\": \"SeccompProfileType defines the supported seccomp profile types.\",\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1Secret\": {\n" +
+		"      \"description\": \"Secret holds secret data of a certain type. The total bytes of the values in\\nthe Data field must be less than MaxSecretSize bytes.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"description\": \"Annotations is an unstructured key value map stored with a resource that may be\\nset by external tools to store and retrieve arbitrary metadata. They are not\\nqueryable and should be preserved when modifying objects.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Annotations\"\n" +
+		"        },\n" +
+		"        \"apiVersion\": {\n" +
+		"          \"description\": \"APIVersion defines the versioned schema of this representation of an object.\\nServers should convert recognized schemas to the latest internal value, and\\nmay reject unrecognized values.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"APIVersion\"\n" +
+		"        },\n" +
+		"        \"creationTimestamp\": {\n" +
+		"          \"description\": \"CreationTimestamp is a timestamp representing the server time when this object was\\ncreated. It is not guaranteed to be set in happens-before order across separate operations.\\nClients may not set this value. It is represented in RFC3339 form and is in UTC.\\n\\nPopulated by the system.\\nRead-only.\\nNull for lists.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"CreationTimestamp\"\n" +
+		"        },\n" +
+		"        \"data\": {\n" +
+		"          \"description\": \"Data contains the secret data. Each key must consist of alphanumeric\\ncharacters, '-', '_' or '.'. The serialized form of the secret data is a\\nbase64 encoded string, representing the arbitrary (possibly non-string)\\ndata value here. Described in https://tools.ietf.org/html/rfc4648#section-4\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"array\",\n" +
+		"            \"items\": {\n" +
+		"              \"type\": \"integer\",\n" +
+		"              \"format\": \"uint8\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Data\"\n" +
+		"        },\n" +
+		"        \"deletionGracePeriodSeconds\": {\n" +
+		"          \"description\": \"Number of seconds allowed for this object to gracefully terminate before\\nit will be removed from the system. Only set when deletionTimestamp is also set.\\nMay only be shortened.\\nRead-only.\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"x-go-name\": \"DeletionGracePeriodSeconds\"\n" +
+		"        },\n" +
+		"        \"deletionTimestamp\": {\n" +
+		"          \"description\": \"DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This\\nfield is set by the server when a graceful deletion is requested by the user, and is not\\ndirectly settable by a client. The resource is expected to be deleted (no longer visible\\nfrom resource lists, and not reachable by name) after the time in this field, once the\\nfinalizers list is empty. As long as the finalizers list contains items, deletion is blocked.\\nOnce the deletionTimestamp is set, this value may not be unset or be set further into the\\nfuture, although it may be shortened or the resource may be deleted prior to this time.\\nFor example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react\\nby sending a graceful termination signal to the containers in the pod. After that 30 seconds,\\nthe Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup,\\nremove the pod from the API. In the presence of network partitions, this object may still\\nexist after this timestamp, until an administrator or automated process can determine the\\nresource is fully terminated.\\nIf not set, graceful deletion of the object has not been requested.\\n\\nPopulated by the system when a graceful deletion is requested.\\nRead-only.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"DeletionTimestamp\"\n" +
+		"        },\n" +
+		"        \"finalizers\": {\n" +
+		"          \"description\": \"Must be empty before the object is deleted from the registry. Each entry\\nis an identifier for the responsible component that will remove the entry\\nfrom the list. If the deletionTimestamp of the object is non-nil, entries\\nin this list can only be removed.\\nFinalizers may be processed and removed in any order.  Order is NOT enforced\\nbecause it introduces significant risk of stuck finalizers.\\nfinalizers is a shared field, any actor with permission can reorder it.\\nIf the finalizer list is processed in order, then this can lead to a situation\\nin which the component responsible for the first finalizer in the list is\\nwaiting for a signal (field value, external system, or other) produced by a\\ncomponent responsible for a finalizer later in the list, resulting in a deadlock.\\nWithout enforced ordering finalizers are free to order amongst themselves and\\nare not vulnerable to ordering changes in the list.\\n+optional\\n+patchStrategy=merge\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Finalizers\"\n" +
+		"        },\n" +
+		"        \"generateName\": {\n" +
+		"          \"description\": \"GenerateName is an optional prefix, used by the server, to generate a unique\\nname ONLY IF the Name field has not been provided.\\nIf this field is used, the name returned to the client will be different\\nthan the name passed. This value will also be combined with a unique suffix.\\nThe provided value has the same validation rules as the Name field,\\nand may be truncated by the length of the suffix required to make the value\\nunique on the server.\\n\\nIf this field is specified and the generated name exists, the server will return a 409.\\n\\nApplied only if Name is not specified.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"GenerateName\"\n" +
+		"        },\n" +
+		"        \"generation\": {\n" +
+		"          \"description\": \"A sequence number representing a specific generation of the desired state.\\nPopulated by the system. Read-only.\\n+optional\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\",\n" +
+		"          \"x-go-name\": \"Generation\"\n" +
+		"        },\n" +
+		"        \"immutable\": {\n" +
+		"          \"description\": \"Immutable, if set to true, ensures that data stored in the Secret cannot\\nbe updated (only object metadata can be modified).\\nIf not set to true, the field can be modified at any time.\\nDefaulted to nil.\\n+optional\",\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"x-go-name\": \"Immutable\"\n" +
+		"        },\n" +
+		"        \"kind\": {\n" +
+		"          \"description\": \"Kind is a string value representing the REST resource this object represents.\\nServers may infer this from the endpoint the client submits requests to.\\nCannot be updated.\\nIn CamelCase.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Kind\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"description\": \"Map of string keys and values that can be used to organize and categorize\\n(scope and select) objects. May match selectors of replication controllers\\nand services.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"Labels\"\n" +
+		"        },\n" +
+		"        \"managedFields\": {\n" +
+		"          \"description\": \"ManagedFields maps workflow-id and version to the set of fields\\nthat are managed by that workflow. This is mostly for internal\\nhousekeeping, and users typically shouldn't need to set or\\nunderstand this field. A workflow can be the user's name, a\\ncontroller's name, or the name of a specific apply path like\\n\\\"ci-cd\\\". The set of fields is always in the version that the\\nworkflow used when modifying the object.\\n\\n+optional\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ManagedFieldsEntry\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"ManagedFields\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"Name must be unique within a namespace. Is required when creating resources, although\\nsome resources may allow a client to request the generation of an appropriate name\\nautomatically. Name is primarily intended for creation idempotence and configuration\\ndefinition.\\nCannot be updated.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Name\"\n" +
+		"        },\n" +
+		"        \"namespace\": {\n" +
+		"          \"description\": \"Namespace defines the space within which each name must be unique. An empty namespace is\\nequivalent to the \\\"default\\\" namespace, but \\\"default\\\" is the canonical representation.\\nNot all objects are required to be scoped to a namespace - the value of this field for\\nthose objects will be empty.\\n\\nMust be a DNS_LABEL.\\nCannot be updated.\\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"Namespace\"\n" +
+		"        },\n" +
+		"        \"ownerReferences\": {\n" +
+		"          \"description\": \"List of objects depended by this object. If ALL objects in the list have\\nbeen deleted, this object will be garbage collected. If this object is managed by a controller,\\nthen an entry in this list will point to this controller, with the controller field set to true.\\nThere cannot be more than one managing controller.\\n+optional\\n+patchMergeKey=uid\\n+patchStrategy=merge\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1OwnerReference\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"OwnerReferences\"\n" +
+		"        },\n" +
+		"        \"resourceVersion\": {\n" +
+		"          \"description\": \"An opaque value that represents the internal version of this object that can\\nbe used by clients to determine when objects have changed. May be used for optimistic\\nconcurrency, change detection, and the watch operation on a resource or set of resources.\\nClients must treat these values as opaque and passed unmodified back to the server.\\nThey may only be valid for a particular resource or set of resources.\\n\\nPopulated by the system.\\nRead-only.\\nValue must be treated as opaque by clients and .\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"ResourceVersion\"\n" +
+		"        },\n" +
+		"        \"selfLink\": {\n" +
+		"          \"description\": \"Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.\\n+optional\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"x-go-name\": \"SelfLink\"\n" +
+		"        },\n" +
+		"        \"stringData\": {\n" +
+		"          \"description\": \"stringData allows specifying non-binary secret data in string form.\\nIt is provided as a write-only input field for convenience.\\nAll keys and values are merged into the data field on write, overwriting any existing values.\\nThe stringData field is never output when reading from the API.\\n+k8s:conversion-gen=false\\n+optional\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          },\n" +
+		"          \"x-go-name\": \"StringData\"\n" +
+		"        },\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/v1SecretType\"\n" +
+		"        },\n" +
+		"        \"uid\": {\n" +
+		"          \"$ref\": \"#/definitions/typesUID\"\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
+		"    },\n" +
 		"    \"v1SecretEnvSource\": {\n" +
 		"      \"description\": \"The contents of the target Secret's Data field will represent the\\nkey-value pairs as environment variables.\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -5264,6 +6061,10 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1SecretType\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
+		"    },\n" +
 		"    \"v1SecretVolumeSource\": {\n" +
 		"      \"description\": \"The contents of the target Secret's Data field will be presented in a volume\\nas files using the keys in the Data field as the file names.\\nSecret volumes support ownership management and SELinux relabeling.\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -83,6 +83,47 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
+		"    },\n" +
+		"    \"/v1/binoculars/log/stream\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Binoculars\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"StreamLogs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/binocularsStreamLogsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of binocularsStreamLogsResponse\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/binocularsStreamLogsResponse\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
 		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
@@ -124,6 +165,10 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
+		"        \"runId\": {\n" +
+		"          \"description\": \"Run to return archived logs for once the pod has been deleted. If empty, the latest archived run is used.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"sinceTime\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
@@ -141,6 +186,64 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"binocularsStreamLogsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"container\": {\n" +
+		"          \"description\": \"Container to return logs for. May be omitted if the pod has a single container.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"follow\": {\n" +
+		"          \"description\": \"If true, new log lines are streamed as they are written until the container exits or the request is cancelled.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"limitBytes\": {\n" +
+		"          \"description\": \"Maximum number of bytes of log to return. If zero, the log is returned up to the server-side limit, or without limit if following.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"offset\": {\n" +
+		"          \"description\": \"Position in the log, in bytes, to start from. Lines starting before this position are skipped.\\nUse next_offset from a previous response to continue where it left off.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"runId\": {\n" +
+		"          \"description\": \"Run to return archived logs for once the pod has been deleted. If empty, the latest archived run is used.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"sinceTime\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"binocularsStreamLogsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"log\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/binocularsLogLine\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nextOffset\": {\n" +
+		"          \"description\": \"Position in the log directly after the last line of this response.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"protobufAny\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -174,6 +277,31 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"runtimeStreamError\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"details\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/protobufAny\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"grpcCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"httpCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"httpStatus\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"v1PodLogOptions\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"PodLogOptions is the query options for a Pod's logs REST call.\",\n" +
@@ -37,6 +37,8 @@ type LogRequest struct {
 	PodNamespace string            `protobuf:"bytes,3,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 	SinceTime    string            `protobuf:"bytes,4,opt,name=since_time,json=sinceTime,proto3" json:"sinceTime,omitempty"`
 	LogOptions   *v1.PodLogOptions `protobuf:"bytes,5,opt,name=log_options,json=logOptions,proto3" json:"logOptions,omitempty"`
+	// Run to return archived logs for once the pod has been deleted. If empty, the latest archived run is used.
+	RunId string `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
 }
 
 func (m *LogRequest) Reset()         { *m = LogRequest{} }
@@ -107,6 +109,13 @@ func (m *LogRequest) GetLogOptions() *v1.PodLogOptions {
 	return nil
 }
 
+func (m *LogRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
 // swagger:model
 type LogResponse struct {
 	Log []*LogLine `protobuf:"bytes,1,rep,name=log,proto3" json:"log,omitempty"`
@@ -205,6 +214,175 @@ func (m *LogLine) GetLine() string {
 	return ""
 }
 
+// swagger:model
+type StreamLogsRequest struct {
+	JobId        string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	PodNumber    int32  `protobuf:"varint,2,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	PodNamespace string `protobuf:"bytes,3,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	// Container to return logs for. May be omitted if the pod has a single container.
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// If true, new log lines are streamed as they are written until the container exits or the request is cancelled.
+	Follow bool `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Position in the log, in bytes, to start from. Lines starting before this position are skipped.
+	// Use next_offset from a previous response to continue where it left off.
+	Offset int64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of bytes of log to return. If zero, the log is returned up to the server-side limit, or without limit if following.
+	LimitBytes int64  `protobuf:"varint,7,opt,name=limit_bytes,json=limitBytes,proto3" json:"limitBytes,omitempty"`
+	SinceTime  string `protobuf:"bytes,8,opt,name=since_time,json=sinceTime,proto3" json:"sinceTime,omitempty"`
+	// Run to return archived logs for once the pod has been deleted. If empty, the latest archived run is used.
+	RunId string `protobuf:"bytes,9,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+}
+
+func (m *StreamLogsRequest) Reset()         { *m = StreamLogsRequest{} }
+func (m *StreamLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsRequest) ProtoMessage()    {}
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f2fc8093f6f091f, []int{3}
+}
+func (m *StreamLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsRequest.Merge(m, src)
+}
+func (m *StreamLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsRequest proto.InternalMessageInfo
+
+func (m *StreamLogsRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StreamLogsRequest) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func (m *StreamLogsRequest) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
+	}
+	return ""
+}
+
+func (m *StreamLogsRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *StreamLogsRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
+func (m *StreamLogsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *StreamLogsRequest) GetLimitBytes() int64 {
+	if m != nil {
+		return m.LimitBytes
+	}
+	return 0
+}
+
+func (m *StreamLogsRequest) GetSinceTime() string {
+	if m != nil {
+		return m.SinceTime
+	}
+	return ""
+}
+
+func (m *StreamLogsRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+// swagger:model
+type StreamLogsResponse struct {
+	Log []*LogLine `protobuf:"bytes,1,rep,name=log,proto3" json:"log,omitempty"`
+	// Position in the log directly after the last line of this response.
+	NextOffset int64 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"nextOffset,omitempty"`
+}
+
+func (m *StreamLogsResponse) Reset()         { *m = StreamLogsResponse{} }
+func (m *StreamLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsResponse) ProtoMessage()    {}
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f2fc8093f6f091f, []int{4}
+}
+func (m *StreamLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsResponse.Merge(m, src)
+}
+func (m *StreamLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsResponse proto.InternalMessageInfo
+
+func (m *StreamLogsResponse) GetLog() []*LogLine {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *StreamLogsResponse) GetNextOffset() int64 {
+	if m != nil {
+		return m.NextOffset
+	}
+	return 0
+}
+
 // swagger:model
 type CordonRequest struct {
 	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
@@ -214,7 +392,7 @@ func (m *CordonRequest) Reset()         { *m = CordonRequest{} }
 func (m *CordonRequest) String() string { return proto.CompactTextString(m) }
 func (*CordonRequest) ProtoMessage()    {}
 func (*CordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f2fc8093f6f091f, []int{3}
+	return fileDescriptor_3f2fc8093f6f091f, []int{5}
 }
 func (m *CordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,6 +432,8 @@ func init() {
 	proto.RegisterType((*LogRequest)(nil), "binoculars.LogRequest")
 	proto.RegisterType((*LogResponse)(nil), "binoculars.LogResponse")
 	proto.RegisterType((*LogLine)(nil), "binoculars.LogLine")
+	proto.RegisterType((*StreamLogsRequest)(nil), "binoculars.StreamLogsRequest")
+	proto.RegisterType((*StreamLogsResponse)(nil), "binoculars.StreamLogsResponse")
 	proto.RegisterType((*CordonRequest)(nil), "binoculars.CordonRequest")
 }
 
@@ -262,45 +442,57 @@ func init() {
 }
 
 var fileDescriptor_3f2fc8093f6f091f = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xdd, 0x6a, 0xd4, 0x40,
-	0x14, 0xc7, 0x9b, 0xed, 0x87, 0xee, 0xac, 0x55, 0x9c, 0xea, 0x36, 0x46, 0xdd, 0xac, 0x11, 0x64,
-	0x29, 0x32, 0xa1, 0x5b, 0x94, 0xd2, 0x1b, 0x69, 0x54, 0xa4, 0xb0, 0x68, 0x29, 0xe2, 0x85, 0x28,
-	0x4b, 0x3e, 0xc6, 0xe9, 0xb4, 0xc9, 0x9c, 0x31, 0xc9, 0x16, 0xbc, 0xf5, 0x09, 0x04, 0xdf, 0xc2,
-	0xd7, 0xf0, 0x42, 0x2f, 0x0b, 0xde, 0x78, 0x15, 0xa4, 0xf5, 0x2a, 0x4f, 0x21, 0x99, 0x64, 0xb7,
-	0x63, 0x7b, 0x97, 0xf9, 0x9d, 0xf3, 0x3f, 0x67, 0xce, 0xff, 0x4c, 0xd0, 0x7d, 0x79, 0xc8, 0x5c,
-	0x5f, 0x72, 0x37, 0xe0, 0x02, 0xc2, 0x49, 0xec, 0xa7, 0x99, 0xf6, 0x49, 0x64, 0x0a, 0x39, 0x60,
-	0x74, 0x46, 0x2c, 0xe7, 0x70, 0x33, 0x23, 0x1c, 0x94, 0x26, 0x84, 0x94, 0xba, 0x47, 0xeb, 0x2e,
-	0xa3, 0x82, 0xa6, 0x7e, 0x4e, 0xa3, 0x3a, 0xdf, 0xba, 0xc3, 0x00, 0x58, 0x4c, 0x55, 0x8e, 0x2f,
-	0x04, 0xe4, 0x7e, 0xce, 0x41, 0x34, 0xd5, 0xac, 0xdb, 0x4d, 0x54, 0x9d, 0x82, 0xc9, 0x07, 0x97,
-	0x26, 0x32, 0xff, 0x54, 0x07, 0x9d, 0x1f, 0x2d, 0x84, 0x46, 0xc0, 0xf6, 0xe8, 0xc7, 0x09, 0xcd,
-	0x72, 0xbc, 0x86, 0x96, 0x0e, 0x20, 0x18, 0xf3, 0xc8, 0x34, 0xfa, 0xc6, 0xa0, 0xed, 0xad, 0x94,
-	0x85, 0x7d, 0xed, 0x00, 0x82, 0x9d, 0xe8, 0x21, 0x24, 0x3c, 0x57, 0xca, 0xbd, 0x45, 0x05, 0xf0,
-	0x63, 0x84, 0x24, 0x44, 0x63, 0x31, 0x49, 0x02, 0x9a, 0x9a, 0xad, 0xbe, 0x31, 0x58, 0xf4, 0x56,
-	0xcb, 0xc2, 0x5e, 0x91, 0x10, 0xbd, 0x54, 0x50, 0xd3, 0xb4, 0x67, 0x10, 0x3f, 0x41, 0xcb, 0x4a,
-	0xe7, 0x27, 0x34, 0x93, 0x7e, 0x48, 0xcd, 0x79, 0xd5, 0xca, 0x2a, 0x0b, 0xbb, 0x5b, 0x65, 0x4d,
-	0xb9, 0xa6, 0xbe, 0xa2, 0xf3, 0xaa, 0x71, 0xc6, 0x45, 0x48, 0xc7, 0x39, 0x4f, 0xa8, 0xb9, 0xa0,
-	0xd4, 0xaa, 0xb1, 0xa2, 0xaf, 0x79, 0xa2, 0x4b, 0xdb, 0x33, 0x88, 0xdf, 0xa1, 0x4e, 0x0c, 0x6c,
-	0x0c, 0x52, 0xb9, 0x63, 0x2e, 0xf6, 0x8d, 0x41, 0x67, 0x78, 0x8f, 0xd4, 0x06, 0x13, 0x5f, 0x72,
-	0x52, 0x19, 0x4c, 0x8e, 0xd6, 0xc9, 0x2e, 0x44, 0x23, 0x60, 0xaf, 0xea, 0x44, 0xcf, 0x2c, 0x0b,
-	0xfb, 0x46, 0x3c, 0x3b, 0x6b, 0xc5, 0xd1, 0x19, 0x75, 0x5e, 0xa0, 0x8e, 0x32, 0x32, 0x93, 0x20,
-	0x32, 0x8a, 0x37, 0xd1, 0x7c, 0x0c, 0xcc, 0x34, 0xfa, 0xf3, 0x83, 0xce, 0x70, 0x85, 0x68, 0x3b,
-	0x1e, 0x01, 0x1b, 0x71, 0x41, 0xbd, 0xeb, 0x65, 0x61, 0x2f, 0xc7, 0xc0, 0xb4, 0x7a, 0x95, 0xc4,
-	0xd9, 0x47, 0x97, 0x9a, 0x14, 0xfc, 0x08, 0xb5, 0xab, 0x19, 0xb3, 0xdc, 0x4f, 0x64, 0xb3, 0x11,
-	0x35, 0xe8, 0x0c, 0xea, 0x83, 0xce, 0x20, 0x7e, 0x80, 0x16, 0x62, 0x2e, 0xa8, 0xda, 0x49, 0xdb,
-	0xc3, 0x65, 0x61, 0x5f, 0xad, 0xce, 0x5a, 0xb2, 0x8a, 0x3b, 0xcf, 0xd0, 0xf2, 0x53, 0x48, 0x23,
-	0x10, 0xd3, 0xf5, 0x6f, 0xa0, 0xb6, 0x80, 0x88, 0xaa, 0xdd, 0x34, 0xfd, 0xba, 0x65, 0x61, 0xe3,
-	0x0a, 0x56, 0xfe, 0x6b, 0x15, 0x2e, 0x4f, 0xd9, 0xf0, 0xbb, 0x81, 0x90, 0x37, 0x1b, 0x0f, 0xbf,
-	0x41, 0x0b, 0x23, 0x60, 0x19, 0xee, 0x9e, 0x9b, 0xb9, 0xe9, 0x61, 0xad, 0x5e, 0xe0, 0xb5, 0x63,
-	0xce, 0xdd, 0xcf, 0xbf, 0xfe, 0x7e, 0x6d, 0xad, 0x3a, 0xb8, 0x7a, 0xe2, 0xda, 0xef, 0x11, 0x03,
-	0xdb, 0x32, 0xd6, 0xf0, 0x7b, 0xb4, 0x54, 0x5f, 0x16, 0xdf, 0xd2, 0x2b, 0xfc, 0x37, 0x80, 0xd5,
-	0x25, 0xf5, 0x63, 0x27, 0xd3, 0xc7, 0x4e, 0x9e, 0x57, 0xb7, 0x75, 0xfa, 0xaa, 0xb6, 0xe5, 0xdc,
-	0x3c, 0x57, 0x3b, 0x54, 0xea, 0x2d, 0x63, 0xcd, 0x13, 0x3f, 0x4f, 0x7a, 0xc6, 0xf1, 0x49, 0xcf,
-	0xf8, 0x73, 0xd2, 0x33, 0xbe, 0x9c, 0xf6, 0xe6, 0x8e, 0x4f, 0x7b, 0x73, 0xbf, 0x4f, 0x7b, 0x73,
-	0x6f, 0x87, 0x8c, 0xe7, 0xfb, 0x93, 0x80, 0x84, 0x90, 0xb8, 0x7e, 0x9a, 0xf8, 0x91, 0x2f, 0x53,
-	0x38, 0xa0, 0x61, 0xde, 0x9c, 0xdc, 0x8b, 0xbf, 0xf4, 0xb7, 0x96, 0xbd, 0xad, 0x62, 0xbb, 0x75,
-	0x26, 0xd9, 0x01, 0xb2, 0x2d, 0x39, 0x39, 0xb3, 0x29, 0x58, 0x52, 0x37, 0xdc, 0xf8, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x60, 0x12, 0x4c, 0xad, 0x11, 0x04, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x4f, 0x8f, 0xdb, 0x44,
+	0x18, 0xc6, 0xd7, 0xc9, 0x6e, 0xba, 0x9e, 0xb0, 0x40, 0x67, 0xcb, 0xae, 0x6b, 0xa8, 0x1d, 0x0c,
+	0x42, 0xab, 0xa8, 0xb2, 0x69, 0xaa, 0xa2, 0xb2, 0x17, 0x54, 0x03, 0x42, 0x95, 0x22, 0x5a, 0x15,
+	0xc4, 0x01, 0x81, 0x22, 0xff, 0x99, 0xb8, 0xb3, 0xb5, 0xe7, 0x35, 0xf6, 0xa4, 0xd0, 0x2b, 0x37,
+	0x6e, 0x48, 0x7c, 0x0b, 0x3e, 0x01, 0x1f, 0x81, 0x63, 0x25, 0x2e, 0x9c, 0x2c, 0xb4, 0xcb, 0xc9,
+	0x57, 0xbe, 0x00, 0x9a, 0xb1, 0x63, 0xcf, 0x26, 0x42, 0x20, 0x71, 0xea, 0x2d, 0xf3, 0x9b, 0xe7,
+	0x9d, 0xd7, 0xf3, 0xbc, 0x4f, 0x6c, 0xf4, 0x56, 0xfe, 0x24, 0xf1, 0x82, 0x9c, 0x7a, 0x21, 0x65,
+	0x10, 0xad, 0xd2, 0xa0, 0x28, 0x95, 0x9f, 0x6e, 0x5e, 0x00, 0x07, 0x8c, 0x7a, 0x62, 0x3a, 0x4f,
+	0xee, 0x96, 0x2e, 0x05, 0x59, 0x13, 0x41, 0x41, 0xbc, 0xa7, 0xb7, 0xbc, 0x84, 0x30, 0x52, 0x04,
+	0x9c, 0xc4, 0x8d, 0xde, 0x7c, 0x23, 0x01, 0x48, 0x52, 0x22, 0x35, 0x01, 0x63, 0xc0, 0x03, 0x4e,
+	0x81, 0xb5, 0xa7, 0x99, 0xaf, 0xb7, 0xbb, 0x72, 0x15, 0xae, 0x96, 0x1e, 0xc9, 0x72, 0xfe, 0xac,
+	0xd9, 0x74, 0xfe, 0x1a, 0x20, 0x34, 0x87, 0xe4, 0x11, 0xf9, 0x66, 0x45, 0x4a, 0x8e, 0xa7, 0x68,
+	0x74, 0x06, 0xe1, 0x82, 0xc6, 0x86, 0x36, 0xd1, 0x4e, 0x74, 0xff, 0xb0, 0xae, 0xec, 0x57, 0xce,
+	0x20, 0xbc, 0x1f, 0xdf, 0x84, 0x8c, 0x72, 0x59, 0xf9, 0x68, 0x4f, 0x02, 0xfc, 0x1e, 0x42, 0x39,
+	0xc4, 0x0b, 0xb6, 0xca, 0x42, 0x52, 0x18, 0x83, 0x89, 0x76, 0xb2, 0xe7, 0x1f, 0xd7, 0x95, 0x7d,
+	0x98, 0x43, 0xfc, 0xa9, 0x84, 0x4a, 0x8d, 0xde, 0x41, 0xfc, 0x01, 0x3a, 0x90, 0x75, 0x41, 0x46,
+	0xca, 0x3c, 0x88, 0x88, 0x31, 0x94, 0xad, 0xcc, 0xba, 0xb2, 0x8f, 0x84, 0x6a, 0xcd, 0x95, 0xea,
+	0x97, 0x54, 0x2e, 0x1a, 0x97, 0x94, 0x45, 0x64, 0xc1, 0x69, 0x46, 0x8c, 0x5d, 0x59, 0x2d, 0x1b,
+	0x4b, 0xfa, 0x39, 0xcd, 0xd4, 0x52, 0xbd, 0x83, 0xf8, 0x2b, 0x34, 0x4e, 0x21, 0x59, 0x40, 0x2e,
+	0xdd, 0x31, 0xf6, 0x26, 0xda, 0xc9, 0x78, 0xf6, 0xa6, 0xdb, 0x18, 0xec, 0x06, 0x39, 0x75, 0x85,
+	0xc1, 0xee, 0xd3, 0x5b, 0xee, 0x43, 0x88, 0xe7, 0x90, 0x3c, 0x68, 0x84, 0xbe, 0x51, 0x57, 0xf6,
+	0xb5, 0xb4, 0x5b, 0x2b, 0x87, 0xa3, 0x9e, 0x0a, 0xeb, 0x8a, 0x15, 0x13, 0xd6, 0x8d, 0x7a, 0xeb,
+	0x8a, 0x15, 0xbb, 0x6c, 0x9d, 0x04, 0xce, 0x27, 0x68, 0x2c, 0x4d, 0x2f, 0x73, 0x60, 0x25, 0xc1,
+	0x77, 0xd1, 0x30, 0x85, 0xc4, 0xd0, 0x26, 0xc3, 0x93, 0xf1, 0xec, 0xd0, 0x55, 0xf2, 0x30, 0x87,
+	0x64, 0x4e, 0x19, 0xf1, 0xaf, 0xd6, 0x95, 0x7d, 0x90, 0x42, 0xa2, 0x1c, 0x25, 0x4a, 0x9c, 0xc7,
+	0xe8, 0x4a, 0x2b, 0xc1, 0x77, 0x90, 0x2e, 0xfc, 0x28, 0x79, 0x90, 0xe5, 0xed, 0xf4, 0xa4, 0x29,
+	0x1d, 0x54, 0x4d, 0xe9, 0x20, 0x7e, 0x07, 0xed, 0xa6, 0x94, 0x11, 0x39, 0x3f, 0xdd, 0xc7, 0x75,
+	0x65, 0xbf, 0x2c, 0xd6, 0x8a, 0x58, 0xee, 0x3b, 0xf5, 0x10, 0x5d, 0xfd, 0x8c, 0x17, 0x24, 0xc8,
+	0xe6, 0x90, 0x94, 0x2f, 0x54, 0x5e, 0xee, 0x20, 0x3d, 0x02, 0xc6, 0x03, 0xca, 0x48, 0xa1, 0xc6,
+	0xa5, 0x83, 0x6a, 0xdf, 0x0e, 0xe2, 0x9b, 0x68, 0xb4, 0x84, 0x34, 0x85, 0x6f, 0x65, 0x52, 0xf6,
+	0xfd, 0x6b, 0x75, 0x65, 0xbf, 0xda, 0x10, 0xa5, 0xa0, 0xd5, 0x08, 0x35, 0x2c, 0x97, 0x25, 0xe1,
+	0x72, 0xfc, 0xc3, 0x46, 0xdd, 0x10, 0x55, 0xdd, 0x10, 0xfc, 0x3e, 0x1a, 0xa7, 0x34, 0xa3, 0x7c,
+	0x11, 0x3e, 0xe3, 0xa4, 0x34, 0xae, 0xc8, 0x92, 0x26, 0x67, 0x02, 0xfb, 0x82, 0x5e, 0xca, 0x59,
+	0x47, 0x37, 0xd2, 0xbf, 0xff, 0x9f, 0xd3, 0xdf, 0xe7, 0x53, 0xff, 0xd7, 0x7c, 0xfe, 0xa0, 0x21,
+	0xac, 0x0e, 0xfb, 0xff, 0xe6, 0x54, 0xdc, 0x97, 0x91, 0xef, 0xf8, 0xa2, 0xb5, 0x68, 0xd0, 0xdf,
+	0x57, 0xe0, 0x07, 0x9b, 0x36, 0xa1, 0x9e, 0x3a, 0x1f, 0xa1, 0x83, 0x0f, 0xa1, 0x88, 0x81, 0xad,
+	0x33, 0x77, 0x1b, 0xe9, 0x0c, 0x62, 0x22, 0x03, 0xd1, 0xc6, 0xee, 0xa8, 0xae, 0x6c, 0x2c, 0xa0,
+	0x18, 0xba, 0x72, 0xce, 0xfe, 0x9a, 0xcd, 0x7e, 0x19, 0x20, 0xe4, 0x77, 0xcf, 0x8b, 0xbf, 0x40,
+	0xbb, 0xe2, 0x66, 0xf8, 0x68, 0xe3, 0x12, 0x6d, 0x0f, 0xf3, 0x78, 0x8b, 0x37, 0x16, 0x38, 0x37,
+	0xbe, 0xff, 0xed, 0xcf, 0x9f, 0x06, 0xc7, 0x0e, 0x16, 0xef, 0x61, 0xe5, 0x1d, 0x9e, 0x42, 0x72,
+	0xaa, 0x4d, 0x71, 0x89, 0x50, 0xef, 0x1b, 0xbe, 0xa1, 0x9e, 0xb2, 0xf5, 0xe7, 0x31, 0xad, 0x7f,
+	0xda, 0x6e, 0x7b, 0xbd, 0x2d, 0x7b, 0x59, 0xce, 0xf5, 0xed, 0x5e, 0x5e, 0x29, 0xe5, 0xa7, 0xda,
+	0xf4, 0x5d, 0x0d, 0x7f, 0x8d, 0x46, 0x8d, 0x43, 0xf8, 0xba, 0x7a, 0xe2, 0x25, 0xd7, 0xcc, 0x23,
+	0xb7, 0xf9, 0x0c, 0xb8, 0xeb, 0xcf, 0x80, 0xfb, 0xb1, 0xb0, 0xc8, 0x99, 0xc8, 0x26, 0xa6, 0xf3,
+	0xda, 0x46, 0x93, 0x48, 0x56, 0x9f, 0x6a, 0x53, 0x9f, 0xfd, 0x7a, 0x6e, 0x69, 0xcf, 0xcf, 0x2d,
+	0xed, 0x8f, 0x73, 0x4b, 0xfb, 0xf1, 0xc2, 0xda, 0x79, 0x7e, 0x61, 0xed, 0xfc, 0x7e, 0x61, 0xed,
+	0x7c, 0x39, 0x4b, 0x28, 0x7f, 0xbc, 0x0a, 0xdd, 0x08, 0x32, 0x2f, 0x28, 0xb2, 0x20, 0x0e, 0xf2,
+	0x02, 0xce, 0x48, 0xc4, 0xdb, 0x95, 0xb7, 0xfd, 0xb1, 0xfb, 0x79, 0x60, 0xdf, 0x93, 0x7b, 0x0f,
+	0x1b, 0xa5, 0x7b, 0x1f, 0xdc, 0x7b, 0x39, 0x75, 0xfb, 0xd9, 0x84, 0x23, 0xf9, 0x84, 0xb7, 0xff,
+	0x0e, 0x00, 0x00, 0xff, 0xff, 0x58, 0xb1, 0x43, 0xb1, 0x2b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -316,6 +508,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BinocularsClient interface {
 	Logs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*LogResponse, error)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Binoculars_StreamLogsClient, error)
 	Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
@@ -336,6 +529,38 @@ func (c *binocularsClient) Logs(ctx context.Context, in *LogRequest, opts ...grp
 	return out, nil
 }
 
+func (c *binocularsClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Binoculars_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Binoculars_serviceDesc.Streams[0], "/binoculars.Binoculars/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &binocularsStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Binoculars_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type binocularsStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *binocularsStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *binocularsClient) Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/binoculars.Binoculars/Cordon", in, out, opts...)
@@ -348,6 +573,7 @@ func (c *binocularsClient) Cordon(ctx context.Context, in *CordonRequest, opts .
 // BinocularsServer is the server API for Binoculars service.
 type BinocularsServer interface {
 	Logs(context.Context, *LogRequest) (*LogResponse, error)
+	StreamLogs(*StreamLogsRequest, Binoculars_StreamLogsServer) error
 	Cordon(context.Context, *CordonRequest) (*types.Empty, error)
 }
 
@@ -358,6 +584,9 @@ type UnimplementedBinocularsServer struct {
 func (*UnimplementedBinocularsServer) Logs(ctx context.Context, req *LogRequest) (*LogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logs not implemented")
 }
+func (*UnimplementedBinocularsServer) StreamLogs(req *StreamLogsRequest, srv Binoculars_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
 func (*UnimplementedBinocularsServer) Cordon(ctx context.Context, req *CordonRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cordon not implemented")
 }
@@ -384,6 +613,27 @@ func _Binoculars_Logs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Binoculars_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BinocularsServer).StreamLogs(m, &binocularsStreamLogsServer{stream})
+}
+
+type Binoculars_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type binocularsStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *binocularsStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Binoculars_Cordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CordonRequest)
 	if err := dec(in); err != nil {
@@ -415,7 +665,13 @@ var _Binoculars_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Binoculars_Cordon_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _Binoculars_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pkg/api/binoculars/binoculars.proto",
 }
 
@@ -439,6 +695,13 @@ func (m *LogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LogOptions != nil {
 		{
 			size, err := m.LogOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -554,6 +817,131 @@ func (m *LogLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SinceTime) > 0 {
+		i -= len(m.SinceTime)
+		copy(dAtA[i:], m.SinceTime)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.SinceTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LimitBytes != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.LimitBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBinoculars(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextOffset != 0 {
+		i = encodeVarintBinoculars(dAtA, i, uint64(m.NextOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Log) > 0 {
+		for iNdEx := len(m.Log) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Log[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBinoculars(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CordonRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -620,6 +1008,10 @@ func (m *LogRequest) Size() (n int) {
 		l = m.LogOptions.Size()
 		n += 1 + l + sovBinoculars(uint64(l))
 	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
 	return n
 }
 
@@ -655,30 +1047,89 @@ func (m *LogLine) Size() (n int) {
 	return n
 }
 
-func (m *CordonRequest) Size() (n int) {
+func (m *StreamLogsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NodeName)
+	l = len(m.JobId)
 	if l > 0 {
 		n += 1 + l + sovBinoculars(uint64(l))
 	}
-	return n
-}
-
-func sovBinoculars(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBinoculars(x uint64) (n int) {
-	return sovBinoculars(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *LogRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+	if m.PodNumber != 0 {
+		n += 1 + sovBinoculars(uint64(m.PodNumber))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBinoculars(uint64(m.Offset))
+	}
+	if m.LimitBytes != 0 {
+		n += 1 + sovBinoculars(uint64(m.LimitBytes))
+	}
+	l = len(m.SinceTime)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		for _, e := range m.Log {
+			l = e.Size()
+			n += 1 + l + sovBinoculars(uint64(l))
+		}
+	}
+	if m.NextOffset != 0 {
+		n += 1 + sovBinoculars(uint64(m.NextOffset))
+	}
+	return n
+}
+
+func (m *CordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovBinoculars(uint64(l))
+	}
+	return n
+}
+
+func sovBinoculars(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBinoculars(x uint64) (n int) {
+	return sovBinoculars(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -854,6 +1305,38 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinoculars(dAtA[iNdEx:])
@@ -1073,6 +1556,396 @@ func (m *LogLine) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinoculars
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitBytes", wireType)
+			}
+			m.LimitBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SinceTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinoculars(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinoculars
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = append(m.Log, &LogLine{})
+			if err := m.Log[len(m.Log)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOffset", wireType)
+			}
+			m.NextOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinoculars
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinoculars(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBinoculars
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CordonRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
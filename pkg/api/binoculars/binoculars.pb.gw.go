@@ -67,6 +67,31 @@ func local_request_Binoculars_Logs_0(ctx context.Context, marshaler runtime.Mars
 
 }
 
+func request_Binoculars_StreamLogs_0(ctx context.Context, marshaler runtime.Marshaler, client BinocularsClient, req *http.Request, pathParams map[string]string) (Binoculars_StreamLogsClient, runtime.ServerMetadata, error) {
+	var protoReq StreamLogsRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamLogs(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 func request_Binoculars_Cordon_0(ctx context.Context, marshaler runtime.Marshaler, client BinocularsClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq CordonRequest
 	var metadata runtime.ServerMetadata
@@ -130,6 +155,13 @@ func RegisterBinocularsHandlerServer(ctx context.Context, mux *runtime.ServeMux,
 
 	})
 
+	mux.Handle("POST", pattern_Binoculars_StreamLogs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	mux.Handle("POST", pattern_Binoculars_Cordon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -214,6 +246,26 @@ func RegisterBinocularsHandlerClient(ctx context.Context, mux *runtime.ServeMux,
 
 	})
 
+	mux.Handle("POST", pattern_Binoculars_StreamLogs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Binoculars_StreamLogs_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_Binoculars_StreamLogs_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_Binoculars_Cordon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -240,11 +292,15 @@ func RegisterBinocularsHandlerClient(ctx context.Context, mux *runtime.ServeMux,
 var (
 	pattern_Binoculars_Logs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "binoculars", "log"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_Binoculars_StreamLogs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "binoculars", "log", "stream"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_Binoculars_Cordon_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "binoculars", "cordon"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
 	forward_Binoculars_Logs_0 = runtime.ForwardResponseMessage
 
+	forward_Binoculars_StreamLogs_0 = runtime.ForwardResponseStream
+
 	forward_Binoculars_Cordon_0 = runtime.ForwardResponseMessage
 )
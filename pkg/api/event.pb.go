@@ -1150,6 +1150,91 @@ func (m *JobPreemptedEvent) GetReason() string {
 	return ""
 }
 
+// Indicates that the job's run is about to be preempted; it's killed once the deadline has passed.
+type JobPreemptionNoticeEvent struct {
+	JobId    string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created  *types.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	RunId    string           `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	Deadline *types.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *JobPreemptionNoticeEvent) Reset()         { *m = JobPreemptionNoticeEvent{} }
+func (m *JobPreemptionNoticeEvent) String() string { return proto.CompactTextString(m) }
+func (*JobPreemptionNoticeEvent) ProtoMessage()    {}
+func (*JobPreemptionNoticeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{11}
+}
+func (m *JobPreemptionNoticeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobPreemptionNoticeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobPreemptionNoticeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobPreemptionNoticeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobPreemptionNoticeEvent.Merge(m, src)
+}
+func (m *JobPreemptionNoticeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobPreemptionNoticeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobPreemptionNoticeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobPreemptionNoticeEvent proto.InternalMessageInfo
+
+func (m *JobPreemptionNoticeEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobPreemptionNoticeEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobPreemptionNoticeEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobPreemptionNoticeEvent) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *JobPreemptionNoticeEvent) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *JobPreemptionNoticeEvent) GetDeadline() *types.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type JobSucceededEvent struct {
 	JobId        string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1167,7 +1252,7 @@ func (m *JobSucceededEvent) Reset()         { *m = JobSucceededEvent{} }
 func (m *JobSucceededEvent) String() string { return proto.CompactTextString(m) }
 func (*JobSucceededEvent) ProtoMessage()    {}
 func (*JobSucceededEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{11}
+	return fileDescriptor_7758595c3bb8cf56, []int{12}
 }
 func (m *JobSucceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1286,7 +1371,7 @@ func (m *JobUtilisationEvent) Reset()         { *m = JobUtilisationEvent{} }
 func (m *JobUtilisationEvent) String() string { return proto.CompactTextString(m) }
 func (*JobUtilisationEvent) ProtoMessage()    {}
 func (*JobUtilisationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{12}
+	return fileDescriptor_7758595c3bb8cf56, []int{13}
 }
 func (m *JobUtilisationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,7 +1504,7 @@ func (m *JobReprioritizingEvent) Reset()         { *m = JobReprioritizingEvent{}
 func (m *JobReprioritizingEvent) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizingEvent) ProtoMessage()    {}
 func (*JobReprioritizingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{13}
+	return fileDescriptor_7758595c3bb8cf56, []int{14}
 }
 func (m *JobReprioritizingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1503,7 +1588,7 @@ func (m *JobReprioritizedEvent) Reset()         { *m = JobReprioritizedEvent{} }
 func (m *JobReprioritizedEvent) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizedEvent) ProtoMessage()    {}
 func (*JobReprioritizedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{14}
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
 }
 func (m *JobReprioritizedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,7 +1672,7 @@ func (m *JobCancellingEvent) Reset()         { *m = JobCancellingEvent{} }
 func (m *JobCancellingEvent) String() string { return proto.CompactTextString(m) }
 func (*JobCancellingEvent) ProtoMessage()    {}
 func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
 }
 func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +1756,7 @@ func (m *JobCancelledEvent) Reset()         { *m = JobCancelledEvent{} }
 func (m *JobCancelledEvent) String() string { return proto.CompactTextString(m) }
 func (*JobCancelledEvent) ProtoMessage()    {}
 func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
 }
 func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,7 +1844,7 @@ func (m *JobTerminatedEvent) Reset()         { *m = JobTerminatedEvent{} }
 func (m *JobTerminatedEvent) String() string { return proto.CompactTextString(m) }
 func (*JobTerminatedEvent) ProtoMessage()    {}
 func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
 func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,6 +1962,7 @@ type EventMessage struct {
 	//	*EventMessage_Reprioritizing
 	//	*EventMessage_Preempted
 	//	*EventMessage_Preempting
+	//	*EventMessage_PreemptionNotice
 	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
@@ -1884,7 +1970,7 @@ func (m *EventMessage) Reset()         { *m = EventMessage{} }
 func (m *EventMessage) String() string { return proto.CompactTextString(m) }
 func (*EventMessage) ProtoMessage()    {}
 func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
 func (m *EventMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1970,24 +2056,28 @@ type EventMessage_Preempted struct {
 type EventMessage_Preempting struct {
 	Preempting *JobPreemptingEvent `protobuf:"bytes,22,opt,name=preempting,proto3,oneof" json:"preempting,omitempty"`
 }
-
-func (*EventMessage_Submitted) isEventMessage_Events()      {}
-func (*EventMessage_Queued) isEventMessage_Events()         {}
-func (*EventMessage_Leased) isEventMessage_Events()         {}
-func (*EventMessage_LeaseReturned) isEventMessage_Events()  {}
-func (*EventMessage_LeaseExpired) isEventMessage_Events()   {}
-func (*EventMessage_Pending) isEventMessage_Events()        {}
-func (*EventMessage_Running) isEventMessage_Events()        {}
-func (*EventMessage_Failed) isEventMessage_Events()         {}
-func (*EventMessage_Succeeded) isEventMessage_Events()      {}
-func (*EventMessage_Reprioritized) isEventMessage_Events()  {}
-func (*EventMessage_Cancelling) isEventMessage_Events()     {}
-func (*EventMessage_Cancelled) isEventMessage_Events()      {}
-func (*EventMessage_Utilisation) isEventMessage_Events()    {}
-func (*EventMessage_IngressInfo) isEventMessage_Events()    {}
-func (*EventMessage_Reprioritizing) isEventMessage_Events() {}
-func (*EventMessage_Preempted) isEventMessage_Events()      {}
-func (*EventMessage_Preempting) isEventMessage_Events()     {}
+type EventMessage_PreemptionNotice struct {
+	PreemptionNotice *JobPreemptionNoticeEvent `protobuf:"bytes,23,opt,name=preemption_notice,json=preemptionNotice,proto3,oneof" json:"preemptionNotice,omitempty"`
+}
+
+func (*EventMessage_Submitted) isEventMessage_Events()        {}
+func (*EventMessage_Queued) isEventMessage_Events()           {}
+func (*EventMessage_Leased) isEventMessage_Events()           {}
+func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
+func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
+func (*EventMessage_Pending) isEventMessage_Events()          {}
+func (*EventMessage_Running) isEventMessage_Events()          {}
+func (*EventMessage_Failed) isEventMessage_Events()           {}
+func (*EventMessage_Succeeded) isEventMessage_Events()        {}
+func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
+func (*EventMessage_Cancelling) isEventMessage_Events()       {}
+func (*EventMessage_Cancelled) isEventMessage_Events()        {}
+func (*EventMessage_Utilisation) isEventMessage_Events()      {}
+func (*EventMessage_IngressInfo) isEventMessage_Events()      {}
+func (*EventMessage_Reprioritizing) isEventMessage_Events()   {}
+func (*EventMessage_Preempted) isEventMessage_Events()        {}
+func (*EventMessage_Preempting) isEventMessage_Events()       {}
+func (*EventMessage_PreemptionNotice) isEventMessage_Events() {}
 
 func (m *EventMessage) GetEvents() isEventMessage_Events {
 	if m != nil {
@@ -2115,6 +2205,13 @@ func (m *EventMessage) GetPreempting() *JobPreemptingEvent {
 	return nil
 }
 
+func (m *EventMessage) GetPreemptionNotice() *JobPreemptionNoticeEvent {
+	if x, ok := m.GetEvents().(*EventMessage_PreemptionNotice); ok {
+		return x.PreemptionNotice
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2135,6 +2232,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessage_Reprioritizing)(nil),
 		(*EventMessage_Preempted)(nil),
 		(*EventMessage_Preempting)(nil),
+		(*EventMessage_PreemptionNotice)(nil),
 	}
 }
 
@@ -2150,7 +2248,7 @@ func (m *ContainerStatus) Reset()         { *m = ContainerStatus{} }
 func (m *ContainerStatus) String() string { return proto.CompactTextString(m) }
 func (*ContainerStatus) ProtoMessage()    {}
 func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
 }
 func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2224,7 +2322,7 @@ func (m *EventStreamMessage) Reset()         { *m = EventStreamMessage{} }
 func (m *EventStreamMessage) String() string { return proto.CompactTextString(m) }
 func (*EventStreamMessage) ProtoMessage()    {}
 func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
 }
 func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2280,7 +2378,7 @@ func (m *JobSetRequest) Reset()         { *m = JobSetRequest{} }
 func (m *JobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSetRequest) ProtoMessage()    {}
 func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{21}
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
 func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2354,7 +2452,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{22}
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2404,6 +2502,161 @@ func (m *WatchRequest) GetFromId() string {
 	return ""
 }
 
+// swagger:model
+type WatchQueueRequest struct {
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// If set, only events of job sets whose name starts with this prefix are streamed.
+	JobSetPrefix string `protobuf:"bytes,2,opt,name=job_set_prefix,json=jobSetPrefix,proto3" json:"jobSetPrefix,omitempty"`
+	// If non-empty, only events of these types are streamed.
+	// Types are named as in the EventMessage oneof, e.g., "submitted" or "lease_returned".
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"eventTypes,omitempty"`
+	// If non-empty, only events of jobs with all of these labels are streamed.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Id of the last message received from each job set, as returned in QueueEventStreamMessage.
+	// Events of these job sets are streamed from after that message; other job sets are streamed from the start.
+	FromMessageIds map[string]string `protobuf:"bytes,5,rep,name=from_message_ids,json=fromMessageIds,proto3" json:"fromMessageIds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, new events are streamed as they're published, including those of job sets created after the call.
+	// Otherwise, the stream ends once all events stored when the call was made have been sent.
+	Watch bool `protobuf:"varint,6,opt,name=watch,proto3" json:"watch,omitempty"`
+}
+
+func (m *WatchQueueRequest) Reset()         { *m = WatchQueueRequest{} }
+func (m *WatchQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchQueueRequest) ProtoMessage()    {}
+func (*WatchQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
+}
+func (m *WatchQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchQueueRequest.Merge(m, src)
+}
+func (m *WatchQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchQueueRequest proto.InternalMessageInfo
+
+func (m *WatchQueueRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *WatchQueueRequest) GetJobSetPrefix() string {
+	if m != nil {
+		return m.JobSetPrefix
+	}
+	return ""
+}
+
+func (m *WatchQueueRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *WatchQueueRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *WatchQueueRequest) GetFromMessageIds() map[string]string {
+	if m != nil {
+		return m.FromMessageIds
+	}
+	return nil
+}
+
+func (m *WatchQueueRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
+	}
+	return false
+}
+
+// swagger:model
+type QueueEventStreamMessage struct {
+	JobSetId string `protobuf:"bytes,1,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// Id of the message within its job set.
+	Id      string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Message *EventMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *QueueEventStreamMessage) Reset()         { *m = QueueEventStreamMessage{} }
+func (m *QueueEventStreamMessage) String() string { return proto.CompactTextString(m) }
+func (*QueueEventStreamMessage) ProtoMessage()    {}
+func (*QueueEventStreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
+}
+func (m *QueueEventStreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEventStreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueEventStreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEventStreamMessage.Merge(m, src)
+}
+func (m *QueueEventStreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEventStreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEventStreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueEventStreamMessage proto.InternalMessageInfo
+
+func (m *QueueEventStreamMessage) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *QueueEventStreamMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueueEventStreamMessage) GetMessage() *EventMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
 	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
@@ -2419,6 +2672,7 @@ func init() {
 	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
 	proto.RegisterType((*JobPreemptingEvent)(nil), "api.JobPreemptingEvent")
 	proto.RegisterType((*JobPreemptedEvent)(nil), "api.JobPreemptedEvent")
+	proto.RegisterType((*JobPreemptionNoticeEvent)(nil), "api.JobPreemptionNoticeEvent")
 	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
 	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "api.JobUtilisationEvent.AvgResourcesForPeriodEntry")
@@ -2434,155 +2688,176 @@ func init() {
 	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
 	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
 	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
+	proto.RegisterType((*WatchQueueRequest)(nil), "api.WatchQueueRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.WatchQueueRequest.FromMessageIdsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.WatchQueueRequest.LabelsEntry")
+	proto.RegisterType((*QueueEventStreamMessage)(nil), "api.QueueEventStreamMessage")
 }
 
 func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
 
 var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 2284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x52, 0xfc, 0x58, 0x0e, 0xf5, 0x41, 0x8d, 0x3e, 0xbc, 0xa6, 0x13, 0xad, 0xc0, 0x00,
-	0xad, 0x62, 0xc4, 0x64, 0x2a, 0x27, 0x45, 0x60, 0x14, 0x28, 0x2c, 0x45, 0x49, 0xc4, 0xc6, 0xb5,
-	0x43, 0x3b, 0x48, 0x5a, 0x04, 0x60, 0x97, 0xbb, 0x23, 0x69, 0x25, 0x72, 0x67, 0xb3, 0x1f, 0xb2,
-	0x15, 0x23, 0x97, 0xf6, 0xd2, 0x43, 0xd1, 0x4f, 0xf4, 0x56, 0xb4, 0x45, 0x8f, 0x3d, 0xf6, 0x0f,
-	0x28, 0x7a, 0x28, 0x8a, 0x1e, 0x83, 0xe4, 0xd2, 0x13, 0xd1, 0xda, 0x45, 0x0a, 0xf0, 0xd4, 0x3f,
-	0xa1, 0x98, 0x37, 0xb3, 0xcb, 0x99, 0x35, 0x05, 0xc9, 0xca, 0x47, 0x0d, 0x85, 0x27, 0x89, 0xbf,
-	0x37, 0xef, 0xcd, 0xdb, 0xf7, 0x7e, 0xb3, 0xf3, 0x66, 0xdf, 0xa0, 0x05, 0xff, 0x60, 0xb7, 0x69,
-	0xf9, 0x6e, 0x93, 0x1c, 0x12, 0x2f, 0x6a, 0xf8, 0x01, 0x8d, 0x28, 0x9e, 0xb2, 0x7c, 0xb7, 0x66,
-	0xee, 0x52, 0xba, 0xdb, 0x23, 0x4d, 0x80, 0xba, 0xf1, 0x4e, 0x33, 0x72, 0xfb, 0x24, 0x8c, 0xac,
-	0xbe, 0xcf, 0x47, 0xd5, 0x16, 0x13, 0xd5, 0x30, 0xee, 0xf6, 0xdd, 0x28, 0x8b, 0xee, 0x11, 0xab,
-	0x17, 0xed, 0x09, 0xf4, 0x72, 0xd6, 0x18, 0xe9, 0xfb, 0xd1, 0x91, 0x10, 0x3e, 0x23, 0x84, 0x4c,
-	0xcb, 0xf2, 0x3c, 0x1a, 0x59, 0x91, 0x4b, 0xbd, 0x50, 0x48, 0x5f, 0x3a, 0x78, 0x25, 0x6c, 0xb8,
-	0x94, 0x49, 0xfb, 0x96, 0xbd, 0xe7, 0x7a, 0x24, 0x38, 0x6a, 0x26, 0x93, 0x04, 0x24, 0xa4, 0x71,
-	0x60, 0x93, 0xe6, 0x2e, 0xf1, 0x48, 0x60, 0x45, 0xc4, 0xe1, 0x5a, 0xf5, 0xdf, 0xe4, 0xd0, 0x7c,
-	0x8b, 0x76, 0xef, 0x80, 0x6b, 0x11, 0x71, 0xb6, 0xd8, 0xe3, 0xe1, 0x2b, 0xa8, 0xb8, 0x4f, 0xbb,
-	0x1d, 0xd7, 0x31, 0xb4, 0x55, 0x6d, 0xad, 0xbc, 0xb1, 0x30, 0x1c, 0x98, 0x73, 0xfb, 0xb4, 0xbb,
-	0xed, 0xbc, 0x40, 0xfb, 0x6e, 0x04, 0x4e, 0xb5, 0x0b, 0x00, 0xe0, 0x97, 0x10, 0x62, 0x63, 0x43,
-	0x12, 0xb1, 0xf1, 0x39, 0x18, 0xbf, 0x3c, 0x1c, 0x98, 0x78, 0x9f, 0x76, 0xef, 0x90, 0x48, 0x51,
-	0xd1, 0x13, 0x0c, 0x3f, 0x8f, 0x0a, 0xef, 0xc7, 0x24, 0x26, 0xc6, 0xd4, 0x68, 0x02, 0x00, 0xe4,
-	0x09, 0x00, 0xc0, 0xdf, 0x41, 0x25, 0x3b, 0x20, 0xcc, 0x67, 0x23, 0xbf, 0xaa, 0xad, 0x55, 0xd6,
-	0x6b, 0x0d, 0x1e, 0x88, 0x46, 0x12, 0xa5, 0xc6, 0xdd, 0x24, 0xe4, 0x1b, 0x4b, 0xc3, 0x81, 0x39,
-	0x2f, 0x86, 0x4b, 0xa6, 0x12, 0x0b, 0xf8, 0x2a, 0x9a, 0xda, 0xa7, 0x5d, 0xa3, 0x00, 0x86, 0xf4,
-	0x86, 0xe5, 0xbb, 0x8d, 0x16, 0xed, 0x6e, 0xcc, 0x0f, 0x07, 0xe6, 0xcc, 0x3e, 0xed, 0x4a, 0x2a,
-	0x6c, 0x5c, 0x7d, 0xa8, 0xa1, 0xd9, 0x16, 0xed, 0xbe, 0xc5, 0x1c, 0x39, 0xef, 0xb1, 0xa9, 0xff,
-	0x21, 0x07, 0x0f, 0xfb, 0x26, 0xb1, 0xc2, 0xf3, 0x4f, 0x84, 0x6f, 0x22, 0x64, 0xf7, 0xe2, 0x30,
-	0x22, 0x01, 0xf3, 0xb6, 0x00, 0x93, 0x5f, 0x1c, 0x0e, 0xcc, 0x05, 0x81, 0x2a, 0xee, 0x96, 0x53,
-	0xb0, 0xfe, 0x8b, 0x3c, 0x5a, 0x4a, 0x82, 0xd4, 0x26, 0x51, 0x1c, 0x78, 0x93, 0x58, 0x1d, 0x13,
-	0x2b, 0xfc, 0x02, 0x2a, 0x06, 0xc4, 0x0a, 0xa9, 0x67, 0x14, 0x41, 0x67, 0x71, 0x38, 0x30, 0xab,
-	0x1c, 0x91, 0x14, 0xc4, 0x18, 0xfc, 0x6d, 0x34, 0x73, 0x10, 0x77, 0x49, 0xe0, 0x91, 0x88, 0x84,
-	0x6c, 0xa2, 0x12, 0x28, 0xd5, 0x86, 0x03, 0x73, 0x79, 0x24, 0x50, 0xe6, 0x9a, 0x96, 0x71, 0xe6,
-	0xa6, 0x4f, 0x9d, 0x8e, 0x17, 0xf7, 0xbb, 0x24, 0x30, 0xf4, 0x55, 0x6d, 0xad, 0xc0, 0xdd, 0xf4,
-	0xa9, 0xf3, 0x5d, 0x00, 0x65, 0x37, 0x53, 0x90, 0x4d, 0x1c, 0xc4, 0x5e, 0xc7, 0x8a, 0x40, 0x44,
-	0x1c, 0xa3, 0xbc, 0xaa, 0xad, 0xe9, 0x7c, 0xe2, 0x20, 0xf6, 0x6e, 0x24, 0xb8, 0x3c, 0xb1, 0x8c,
-	0xd7, 0xff, 0xab, 0xa1, 0xc5, 0x84, 0x13, 0x5b, 0xf7, 0x7d, 0x37, 0x38, 0xff, 0xef, 0x8a, 0x9f,
-	0xe5, 0xd1, 0x5c, 0x8b, 0x76, 0x6f, 0x13, 0xcf, 0x71, 0xbd, 0xdd, 0xc9, 0x02, 0x18, 0xbf, 0x00,
-	0x1e, 0xa3, 0x74, 0xf1, 0x33, 0x51, 0xba, 0x74, 0x6a, 0x4a, 0xbf, 0x88, 0x74, 0xd0, 0xb3, 0xfa,
-	0x04, 0x16, 0x42, 0x99, 0x3f, 0x22, 0x1b, 0x60, 0xf5, 0xe5, 0x68, 0x95, 0x04, 0xc4, 0x5c, 0x4d,
-	0x34, 0x42, 0xdf, 0xb2, 0x09, 0x2c, 0x02, 0xe1, 0xaa, 0x18, 0x03, 0xb8, 0xec, 0xaa, 0x8c, 0xd7,
-	0xff, 0xca, 0x19, 0xd1, 0x8e, 0x3d, 0x6f, 0xc2, 0x88, 0x2f, 0x8e, 0x11, 0xd7, 0x50, 0xd9, 0xa3,
-	0x0e, 0xe1, 0xa9, 0x2d, 0x8d, 0xa2, 0xc4, 0xc0, 0x4c, 0x6e, 0xf5, 0x04, 0x3b, 0xf3, 0x9b, 0x51,
-	0xa6, 0x51, 0xf9, 0x6c, 0x34, 0x42, 0x4f, 0x48, 0xa3, 0x3f, 0x15, 0xd1, 0x42, 0x8b, 0x76, 0xb7,
-	0xbd, 0xdd, 0x80, 0x84, 0xe1, 0xb6, 0xb7, 0x43, 0x27, 0x54, 0x3a, 0x6f, 0x54, 0x42, 0x67, 0xa3,
-	0x52, 0xe5, 0xc9, 0xa8, 0x84, 0x1f, 0xa0, 0x79, 0x97, 0xd3, 0xa8, 0x63, 0x39, 0x0e, 0xfb, 0x4b,
-	0x42, 0xa3, 0xbc, 0x3a, 0xb5, 0x56, 0x59, 0x6f, 0x24, 0x95, 0x7f, 0x96, 0x67, 0x0d, 0x01, 0xdc,
-	0x48, 0x14, 0xb6, 0xbc, 0x28, 0x38, 0xda, 0x58, 0x19, 0x0e, 0xcc, 0x9a, 0x9b, 0x11, 0x49, 0x13,
-	0x57, 0xb3, 0xb2, 0xda, 0x01, 0x5a, 0x1a, 0x6b, 0x0a, 0x3f, 0x87, 0xa6, 0x0e, 0xc8, 0x11, 0xb0,
-	0xb8, 0xc0, 0xcf, 0x1d, 0x07, 0xe4, 0x48, 0x3e, 0x77, 0x1c, 0x90, 0x23, 0xc6, 0xc5, 0x43, 0xab,
-	0x17, 0x13, 0x41, 0x5e, 0xe0, 0x22, 0x00, 0x32, 0x17, 0x01, 0xb8, 0x9e, 0x7b, 0x45, 0xab, 0x7f,
-	0x5c, 0x82, 0xca, 0xfd, 0x35, 0xcb, 0xed, 0x4d, 0xaa, 0xd1, 0xcf, 0xa7, 0x1a, 0x7d, 0x0f, 0x21,
-	0x72, 0xdf, 0x8d, 0x3a, 0x36, 0x75, 0x48, 0x68, 0x94, 0x80, 0x35, 0xf5, 0x84, 0x35, 0x52, 0xa0,
-	0x1b, 0x5b, 0xf7, 0xdd, 0x68, 0x93, 0x0d, 0xe2, 0x4c, 0xb9, 0xc4, 0x3c, 0x21, 0x09, 0x36, 0x32,
-	0x6c, 0x68, 0xed, 0x72, 0x0a, 0x3f, 0xbe, 0x76, 0xf5, 0xcf, 0xb2, 0x76, 0xcb, 0x67, 0x5a, 0xbb,
-	0xe8, 0x4c, 0x6b, 0x77, 0xe6, 0x6c, 0x6b, 0x77, 0xf6, 0x09, 0xd7, 0xae, 0x83, 0xb0, 0x4d, 0xbd,
-	0xc8, 0x72, 0x3d, 0x12, 0x74, 0xc2, 0xc8, 0x8a, 0x62, 0xb6, 0x78, 0x2b, 0x90, 0x86, 0x45, 0x48,
-	0xc3, 0x66, 0x22, 0xbe, 0x03, 0xd2, 0x0d, 0x73, 0x38, 0x30, 0x2f, 0xdb, 0x2a, 0xa8, 0xac, 0xd1,
-	0xf9, 0xc7, 0x84, 0xf8, 0x65, 0x54, 0xb0, 0xad, 0x38, 0x24, 0xc6, 0xf4, 0xaa, 0xb6, 0x36, 0xbb,
-	0x8e, 0xb8, 0x61, 0x86, 0x70, 0x3a, 0x83, 0x50, 0xa6, 0x33, 0x00, 0x35, 0x07, 0xcd, 0xaa, 0x59,
-	0x97, 0x17, 0x75, 0xf9, 0x74, 0x8b, 0xba, 0x70, 0xe2, 0xa2, 0xfe, 0x24, 0x87, 0x30, 0x2b, 0xb1,
-	0x03, 0xc2, 0x44, 0x5f, 0x81, 0x9a, 0xea, 0x65, 0x54, 0x0e, 0xc8, 0xfb, 0x31, 0x09, 0x23, 0x1a,
-	0xc8, 0xeb, 0x3a, 0x05, 0x65, 0x76, 0xa6, 0xe0, 0x93, 0xad, 0xeb, 0xfa, 0xaf, 0xf3, 0xf0, 0xc1,
-	0x4b, 0x44, 0x75, 0xf2, 0xb6, 0x3c, 0xee, 0x6d, 0x79, 0x05, 0x15, 0xd9, 0xa1, 0x38, 0x2d, 0x2b,
-	0xc0, 0xe1, 0x20, 0xf6, 0xd4, 0x88, 0x00, 0x80, 0xb7, 0xd1, 0xbc, 0x2f, 0x58, 0x7a, 0x48, 0x3a,
-	0x22, 0x90, 0xbc, 0xa0, 0x78, 0x76, 0x38, 0x30, 0x2f, 0x8d, 0x84, 0xad, 0x4c, 0x48, 0xe7, 0x32,
-	0xa2, 0x8c, 0x29, 0xe1, 0x81, 0x3e, 0xce, 0x54, 0x3b, 0xe3, 0xcb, 0x5c, 0x46, 0x24, 0xf1, 0xa2,
-	0x7c, 0x0a, 0x5e, 0xfc, 0x2d, 0x2f, 0x3e, 0x84, 0xda, 0x36, 0x21, 0xce, 0x84, 0x17, 0x93, 0x03,
-	0xcc, 0x19, 0x0f, 0x30, 0xff, 0xaa, 0xc0, 0x01, 0xe6, 0xed, 0xc8, 0xed, 0xb9, 0x21, 0x7c, 0xa1,
-	0x9f, 0x50, 0xe9, 0x0b, 0xa2, 0xd2, 0x4f, 0x35, 0xb4, 0x74, 0xd3, 0xba, 0xdf, 0x16, 0xcd, 0x8d,
-	0xf0, 0x35, 0x1a, 0xdc, 0x26, 0x81, 0x4b, 0x1d, 0x51, 0xaf, 0x5d, 0x4b, 0xea, 0xb5, 0x6c, 0x32,
-	0x1a, 0x63, 0xb5, 0x78, 0x01, 0xf7, 0xdc, 0x70, 0x60, 0x9a, 0x63, 0xe5, 0x92, 0x1f, 0xe3, 0xa7,
-	0x55, 0xb9, 0xad, 0x9f, 0x89, 0xdb, 0xe5, 0xa7, 0xf9, 0x44, 0xf5, 0x13, 0x0d, 0x2d, 0x47, 0x34,
-	0xb2, 0x7a, 0x1d, 0x3b, 0xee, 0xc7, 0x3d, 0x0b, 0x5e, 0xd2, 0x71, 0x68, 0xed, 0xb2, 0x0a, 0x8a,
-	0x45, 0x7c, 0xfd, 0xd8, 0x88, 0xdf, 0x65, 0x6a, 0x9b, 0xa9, 0xd6, 0xdb, 0x4c, 0x89, 0x07, 0xbc,
-	0x3e, 0x1c, 0x98, 0x2b, 0xd1, 0x18, 0xb1, 0xe4, 0xc6, 0xe2, 0x38, 0x39, 0xe4, 0xff, 0xc6, 0xe1,
-	0xee, 0x98, 0xfc, 0xcf, 0x9c, 0x90, 0xff, 0xb1, 0x5a, 0x52, 0xfe, 0xc7, 0xca, 0xe5, 0xfc, 0x8f,
-	0x1d, 0x50, 0xfb, 0xbd, 0x86, 0x6a, 0xc7, 0x53, 0xeb, 0x74, 0x55, 0xe2, 0xf7, 0xe4, 0x2a, 0x91,
-	0x9d, 0x54, 0x79, 0x5f, 0xaf, 0x21, 0xf7, 0xf5, 0x1a, 0xfe, 0xc1, 0x2e, 0x3c, 0x5b, 0xd2, 0xd7,
-	0x6b, 0xbc, 0x15, 0x5b, 0x5e, 0xe4, 0x46, 0x47, 0x27, 0x55, 0x95, 0xb5, 0xdf, 0x69, 0xe8, 0xd2,
-	0xb1, 0xb9, 0x78, 0x2a, 0x3c, 0x64, 0x41, 0x3c, 0x3e, 0x3f, 0x4f, 0x83, 0x8b, 0xf5, 0xff, 0xe4,
-	0xd0, 0x72, 0x8b, 0x76, 0xdb, 0xc4, 0x0f, 0x5c, 0x1a, 0xb8, 0x91, 0xfb, 0xc1, 0x57, 0xa0, 0x3c,
-	0xff, 0x16, 0x9a, 0xf6, 0xc8, 0xbd, 0x8e, 0x78, 0xe4, 0x23, 0x78, 0xd1, 0x6b, 0x70, 0xde, 0x5d,
-	0xf2, 0xc8, 0xbd, 0xdb, 0x02, 0x96, 0x34, 0x2b, 0x12, 0xac, 0x16, 0xf7, 0xc5, 0xd3, 0x16, 0xf7,
-	0xf5, 0x4f, 0x73, 0xd0, 0x6e, 0x93, 0x22, 0x7d, 0xfe, 0x4b, 0xb3, 0xff, 0x4b, 0xa0, 0xc5, 0x69,
-	0x73, 0xd3, 0xf2, 0x6c, 0xd2, 0xeb, 0x4d, 0x4e, 0x9b, 0x9f, 0xcf, 0x69, 0xf3, 0x63, 0x7e, 0xbd,
-	0x42, 0x44, 0xf5, 0xfc, 0x53, 0xf7, 0x4b, 0x09, 0xea, 0x5f, 0xf2, 0x40, 0xd5, 0xbb, 0x24, 0xe8,
-	0xbb, 0x9e, 0x35, 0x39, 0xc3, 0x3f, 0xdd, 0xed, 0xc7, 0x2f, 0xe7, 0xd8, 0x25, 0x51, 0x48, 0x3f,
-	0x05, 0x85, 0xfe, 0x5c, 0x41, 0xd3, 0xc0, 0x9a, 0x9b, 0x24, 0x84, 0x52, 0xf2, 0x16, 0x2a, 0x87,
-	0xc9, 0x1d, 0x28, 0xe0, 0x4f, 0x65, 0x7d, 0x39, 0xa9, 0x1e, 0xd5, 0xcb, 0x51, 0x3c, 0x00, 0xe9,
-	0xe0, 0x91, 0xf1, 0x37, 0x2e, 0xb4, 0x47, 0x36, 0xf0, 0x26, 0x2a, 0x02, 0x13, 0x1c, 0x51, 0x82,
-	0x2c, 0x24, 0xd6, 0xa4, 0xbb, 0x44, 0xdc, 0x49, 0x3e, 0x4c, 0xb1, 0x23, 0x54, 0x99, 0x91, 0x1e,
-	0xdc, 0xc6, 0x01, 0xc6, 0x49, 0x46, 0xa4, 0x3b, 0x3a, 0xdc, 0x08, 0x1f, 0xa6, 0x1a, 0xe1, 0x18,
-	0xfe, 0x01, 0x9a, 0x85, 0xff, 0x3a, 0x81, 0xb8, 0xae, 0x92, 0x32, 0x52, 0x36, 0xa6, 0xdc, 0x65,
-	0xd9, 0xb8, 0x3c, 0x1c, 0x98, 0x17, 0x7b, 0x32, 0xae, 0x98, 0x9e, 0x51, 0x44, 0xf8, 0x3d, 0xc4,
-	0x81, 0x0e, 0xe1, 0x97, 0x1f, 0xc4, 0xf5, 0xaa, 0x4b, 0xca, 0x04, 0xf2, 0xc5, 0x08, 0x9e, 0xd7,
-	0x9e, 0x04, 0x2b, 0xe6, 0xa7, 0x65, 0x09, 0x7e, 0x1d, 0x95, 0x7c, 0x7e, 0xcd, 0x00, 0xf8, 0x9b,
-	0x7c, 0xff, 0xcd, 0xdc, 0x3e, 0x10, 0x0c, 0xe3, 0x88, 0x62, 0x2d, 0xd1, 0x66, 0x86, 0x02, 0xde,
-	0x9d, 0x06, 0x2a, 0x4b, 0x86, 0xe4, 0xa6, 0x35, 0x37, 0x24, 0x06, 0xaa, 0x86, 0x04, 0xc8, 0xd2,
-	0xb2, 0x03, 0x1d, 0x00, 0x20, 0xb7, 0x94, 0x16, 0xa9, 0x2f, 0xc0, 0xd3, 0xc2, 0x87, 0xa9, 0x69,
-	0xe1, 0x18, 0x67, 0x9c, 0xf8, 0xd8, 0x04, 0x6c, 0x57, 0x18, 0x27, 0x7f, 0x85, 0x4a, 0x18, 0x27,
-	0xb0, 0x2c, 0xe3, 0x04, 0x8c, 0x3b, 0x68, 0x26, 0x90, 0xcb, 0x24, 0x38, 0xdd, 0x49, 0x69, 0x7e,
-	0xbc, 0x86, 0xe2, 0x69, 0x56, 0x94, 0xd4, 0x34, 0x2b, 0x22, 0x7c, 0x07, 0x21, 0x3b, 0x2d, 0x0f,
-	0xe0, 0x93, 0x79, 0x65, 0xfd, 0x62, 0x62, 0x3d, 0x53, 0x38, 0x6c, 0x18, 0xc3, 0x81, 0xb9, 0x38,
-	0x1a, 0xae, 0xd8, 0x95, 0xcc, 0xb0, 0x30, 0xd8, 0xc9, 0xee, 0x08, 0xcd, 0x05, 0x29, 0x0c, 0xea,
-	0xb6, 0x29, 0x5e, 0x79, 0x09, 0xa6, 0x86, 0x21, 0x85, 0xf1, 0x3b, 0xa8, 0x12, 0x8f, 0x8e, 0x7b,
-	0xc6, 0x1c, 0x98, 0x34, 0x8e, 0x3b, 0x09, 0xf2, 0xb2, 0x4a, 0x52, 0x50, 0xcc, 0xca, 0x96, 0xf0,
-	0xbb, 0x68, 0x3a, 0x69, 0x27, 0xba, 0xde, 0x0e, 0x35, 0xe6, 0x55, 0xcb, 0xd9, 0x4e, 0x22, 0xb7,
-	0xec, 0x8e, 0x50, 0xd5, 0xb2, 0x24, 0xc0, 0x36, 0x9a, 0x0d, 0x94, 0xa3, 0x84, 0x81, 0xc1, 0xf6,
-	0xe5, 0x31, 0xa9, 0x4b, 0x03, 0xfc, 0xcc, 0x70, 0x60, 0x1a, 0xaa, 0x9a, 0x32, 0x43, 0xc6, 0x24,
-	0x0b, 0xb4, 0x9f, 0x7c, 0xf4, 0x36, 0x96, 0xd4, 0x40, 0xab, 0x5f, 0xc3, 0xc5, 0x2b, 0x3e, 0xc1,
-	0xd4, 0x40, 0xa7, 0x30, 0xa3, 0x83, 0x9f, 0xf6, 0x26, 0x8c, 0x65, 0x95, 0x0e, 0x99, 0xae, 0x05,
-	0xa7, 0xc3, 0x68, 0xb8, 0x4a, 0x87, 0x11, 0xbe, 0xa1, 0xa3, 0x22, 0xdc, 0xb0, 0x0d, 0x5b, 0x79,
-	0x5d, 0xaf, 0x96, 0x5b, 0x79, 0x7d, 0xb6, 0x3a, 0xd7, 0xca, 0xeb, 0xd5, 0xea, 0x7c, 0x2b, 0xaf,
-	0x2f, 0x54, 0x17, 0x5b, 0x79, 0x7d, 0xb1, 0xba, 0x54, 0xff, 0x51, 0x0e, 0xcd, 0x65, 0x3a, 0x40,
-	0xf8, 0x6b, 0x28, 0x0f, 0x3b, 0x0e, 0xdf, 0xfe, 0xf1, 0x70, 0x60, 0xce, 0x7a, 0xea, 0x76, 0x03,
-	0x72, 0xbc, 0x8e, 0xf4, 0xa4, 0x13, 0x27, 0x5a, 0x31, 0xb0, 0xf5, 0x27, 0x98, 0xbc, 0xf5, 0x27,
-	0x18, 0x6e, 0xa2, 0x52, 0x9f, 0x6f, 0x15, 0x62, 0xf3, 0x87, 0xb7, 0x84, 0x80, 0xe4, 0x0d, 0x4d,
-	0x40, 0xd2, 0x7e, 0x94, 0x3f, 0x45, 0xb7, 0x31, 0x6d, 0x44, 0x15, 0x9e, 0xa4, 0x11, 0x55, 0xff,
-	0x00, 0x61, 0x08, 0xef, 0x9d, 0x28, 0x20, 0x56, 0x3f, 0xd9, 0xcb, 0x56, 0x51, 0x2e, 0x2d, 0x82,
-	0xaa, 0xc3, 0x81, 0x39, 0xed, 0xca, 0x3b, 0x7c, 0xce, 0x75, 0xf0, 0xc6, 0xe8, 0x69, 0xf8, 0xee,
-	0x34, 0x0f, 0x13, 0xca, 0x3b, 0xe2, 0x49, 0x0f, 0x58, 0xff, 0x65, 0x0e, 0xcd, 0xb4, 0xa0, 0x32,
-	0x6a, 0xf3, 0x3a, 0xee, 0x14, 0xf3, 0x3e, 0x8f, 0x0a, 0xf7, 0xac, 0xc8, 0xde, 0x83, 0x59, 0x75,
-	0xfe, 0x68, 0x00, 0xc8, 0x8f, 0x06, 0x00, 0xde, 0x44, 0x73, 0x3b, 0x01, 0xed, 0x77, 0xc4, 0x74,
-	0xac, 0x7a, 0xe1, 0x81, 0x87, 0x77, 0x16, 0x13, 0x09, 0x47, 0x95, 0xf2, 0x65, 0x46, 0x11, 0x8c,
-	0x0a, 0xb6, 0xfc, 0x89, 0x05, 0xdb, 0xab, 0x68, 0x96, 0x04, 0x01, 0x0d, 0xb6, 0x77, 0x6e, 0xba,
-	0x61, 0xc8, 0x18, 0x5d, 0x00, 0x1f, 0x61, 0x99, 0xa9, 0x12, 0x49, 0x39, 0xa3, 0x53, 0xff, 0xad,
-	0x86, 0xa6, 0xdf, 0x61, 0xfe, 0x27, 0x31, 0x49, 0x3d, 0xd0, 0x4e, 0xf4, 0xe0, 0x6c, 0x35, 0xe9,
-	0x55, 0x54, 0x82, 0x38, 0xa5, 0xf1, 0xe1, 0xfb, 0x4e, 0x40, 0xfb, 0x8a, 0x42, 0x91, 0x23, 0x57,
-	0xde, 0x44, 0x05, 0xa0, 0x15, 0x2e, 0xa3, 0xc2, 0x16, 0xf3, 0xbd, 0x7a, 0x01, 0x57, 0x50, 0x69,
-	0xeb, 0xd0, 0xb5, 0x23, 0xe2, 0x54, 0x35, 0x5c, 0x42, 0x53, 0xb7, 0x6e, 0xdd, 0xac, 0xe6, 0xf0,
-	0x22, 0xaa, 0xbe, 0x4a, 0x2c, 0xa7, 0xe7, 0x7a, 0x64, 0xeb, 0x3e, 0xdf, 0x62, 0xaa, 0x53, 0x78,
-	0x1a, 0xe9, 0x6d, 0xb2, 0x4f, 0x60, 0x70, 0x7e, 0xfd, 0x53, 0x0d, 0x15, 0x78, 0xf1, 0x4d, 0xd0,
-	0xdc, 0xeb, 0x24, 0xe2, 0x7c, 0x00, 0x24, 0xc4, 0x38, 0xdd, 0xcd, 0x52, 0x8a, 0xd4, 0x2e, 0x8e,
-	0x78, 0xa6, 0x70, 0xb6, 0xfe, 0xdc, 0x0f, 0x3f, 0xf9, 0xf7, 0xaf, 0x72, 0xcf, 0xd6, 0x8d, 0xe6,
-	0xe1, 0x37, 0x9a, 0xfb, 0xb4, 0x7b, 0x35, 0x24, 0x51, 0xf3, 0x01, 0x04, 0xe6, 0xc3, 0xe6, 0x03,
-	0xd7, 0xf9, 0xf0, 0xba, 0x76, 0xe5, 0x45, 0x0d, 0x5f, 0x47, 0x05, 0x08, 0x2f, 0xe6, 0x84, 0x95,
-	0x43, 0x7d, 0xbc, 0xed, 0xa9, 0x1f, 0xe7, 0x34, 0xd0, 0x2d, 0xbe, 0x01, 0x77, 0xed, 0xf1, 0xf2,
-	0x63, 0xb5, 0xf8, 0x16, 0x0b, 0x52, 0x8d, 0xbf, 0xcb, 0xf9, 0xa0, 0xcd, 0x3d, 0x62, 0x1f, 0xb4,
-	0x49, 0xe8, 0x53, 0x2f, 0x24, 0x1b, 0xef, 0xfe, 0xfd, 0xe1, 0x8a, 0xf6, 0xd1, 0xc3, 0x15, 0xed,
-	0x9f, 0x0f, 0x57, 0xb4, 0x9f, 0x3f, 0x5a, 0xb9, 0xf0, 0xd1, 0xa3, 0x95, 0x0b, 0xff, 0x78, 0xb4,
-	0x72, 0xe1, 0xfb, 0x5f, 0xdf, 0x75, 0xa3, 0xbd, 0xb8, 0xdb, 0xb0, 0x69, 0xbf, 0x69, 0x05, 0x7d,
-	0xcb, 0xb1, 0xfc, 0x80, 0xb2, 0x00, 0x89, 0x5f, 0xc9, 0x15, 0xfc, 0x3f, 0xe6, 0x16, 0x6f, 0x00,
-	0x70, 0x9b, 0x8b, 0x1b, 0xdb, 0xb4, 0x71, 0xc3, 0x77, 0xbb, 0x45, 0xf0, 0xe1, 0xda, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x84, 0x91, 0xe4, 0x82, 0x61, 0x30, 0x00, 0x00,
+	// 2551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x52, 0xfc, 0x7d, 0xd4, 0x0f, 0x35, 0xfa, 0x5b, 0xd3, 0xb6, 0x28, 0xd0, 0x40, 0xab,
+	0x18, 0x31, 0xe9, 0xca, 0x49, 0x91, 0x1a, 0x05, 0x5a, 0x4b, 0x91, 0x13, 0xb1, 0xfe, 0x51, 0x64,
+	0x07, 0x49, 0x8b, 0x00, 0xec, 0x92, 0x3b, 0x92, 0x56, 0x22, 0x77, 0x36, 0xfb, 0x23, 0x4b, 0x31,
+	0x72, 0x69, 0x2f, 0x3d, 0x14, 0xfd, 0x45, 0x6f, 0x45, 0x5b, 0xf4, 0x58, 0xf4, 0x54, 0xa0, 0xf7,
+	0x1e, 0x8a, 0xa2, 0xc7, 0x34, 0xb9, 0xf4, 0x44, 0xb4, 0x76, 0xd1, 0x02, 0x3c, 0xe5, 0xdc, 0x53,
+	0xb1, 0x6f, 0x66, 0xc9, 0x99, 0x15, 0x55, 0xc9, 0x4c, 0x9c, 0x18, 0x8a, 0x4e, 0xb6, 0xbe, 0x37,
+	0xf3, 0xe6, 0xcd, 0x7b, 0xdf, 0x9b, 0x79, 0xb3, 0x33, 0x84, 0x29, 0x67, 0x77, 0xab, 0x6a, 0x38,
+	0x56, 0x95, 0xee, 0x51, 0xdb, 0xaf, 0x38, 0x2e, 0xf3, 0x19, 0x19, 0x31, 0x1c, 0xab, 0x58, 0xda,
+	0x62, 0x6c, 0xab, 0x45, 0xab, 0x08, 0x35, 0x82, 0xcd, 0xaa, 0x6f, 0xb5, 0xa9, 0xe7, 0x1b, 0x6d,
+	0x87, 0xb7, 0x2a, 0x4e, 0x47, 0x5d, 0xbd, 0xa0, 0xd1, 0xb6, 0xfc, 0x38, 0xba, 0x4d, 0x8d, 0x96,
+	0xbf, 0x2d, 0xd0, 0x0b, 0x71, 0x65, 0xb4, 0xed, 0xf8, 0x07, 0x42, 0x78, 0x51, 0x08, 0xc3, 0x5e,
+	0x86, 0x6d, 0x33, 0xdf, 0xf0, 0x2d, 0x66, 0x7b, 0x42, 0xfa, 0xd2, 0xee, 0x2b, 0x5e, 0xc5, 0x62,
+	0xa1, 0xb4, 0x6d, 0x34, 0xb7, 0x2d, 0x9b, 0xba, 0x07, 0xd5, 0x68, 0x10, 0x97, 0x7a, 0x2c, 0x70,
+	0x9b, 0xb4, 0xba, 0x45, 0x6d, 0xea, 0x1a, 0x3e, 0x35, 0x79, 0xaf, 0xf2, 0x2f, 0x13, 0x30, 0x59,
+	0x63, 0x8d, 0xfb, 0x68, 0x9a, 0x4f, 0xcd, 0xd5, 0x70, 0x7a, 0xe4, 0x0a, 0xa4, 0x77, 0x58, 0xa3,
+	0x6e, 0x99, 0xba, 0xb6, 0xa0, 0x2d, 0xe6, 0x96, 0xa7, 0xba, 0x9d, 0xd2, 0xc4, 0x0e, 0x6b, 0xac,
+	0x99, 0x2f, 0xb2, 0xb6, 0xe5, 0xa3, 0x51, 0x1b, 0x29, 0x04, 0xc8, 0x4b, 0x00, 0x61, 0x5b, 0x8f,
+	0xfa, 0x61, 0xfb, 0x04, 0xb6, 0x9f, 0xed, 0x76, 0x4a, 0x64, 0x87, 0x35, 0xee, 0x53, 0x5f, 0xe9,
+	0x92, 0x8d, 0x30, 0xf2, 0x02, 0xa4, 0xde, 0x0d, 0x68, 0x40, 0xf5, 0x91, 0xfe, 0x00, 0x08, 0xc8,
+	0x03, 0x20, 0x40, 0xbe, 0x05, 0x99, 0xa6, 0x4b, 0x43, 0x9b, 0xf5, 0xe4, 0x82, 0xb6, 0x98, 0x5f,
+	0x2a, 0x56, 0xb8, 0x23, 0x2a, 0x91, 0x97, 0x2a, 0x0f, 0x22, 0x97, 0x2f, 0xcf, 0x74, 0x3b, 0xa5,
+	0x49, 0xd1, 0x5c, 0x52, 0x15, 0x69, 0x20, 0x57, 0x61, 0x64, 0x87, 0x35, 0xf4, 0x14, 0x2a, 0xca,
+	0x56, 0x0c, 0xc7, 0xaa, 0xd4, 0x58, 0x63, 0x79, 0xb2, 0xdb, 0x29, 0x8d, 0xed, 0xb0, 0x86, 0xd4,
+	0x25, 0x6c, 0x57, 0xee, 0x6a, 0x30, 0x5e, 0x63, 0x8d, 0x37, 0x42, 0x43, 0x4e, 0xbb, 0x6f, 0xca,
+	0xbf, 0x4d, 0xe0, 0x64, 0x6f, 0x53, 0xc3, 0x3b, 0xfd, 0x44, 0xf8, 0x2a, 0x40, 0xb3, 0x15, 0x78,
+	0x3e, 0x75, 0x43, 0x6b, 0x53, 0x38, 0xf8, 0x5c, 0xb7, 0x53, 0x9a, 0x12, 0xa8, 0x62, 0x6e, 0xae,
+	0x07, 0x96, 0x7f, 0x9a, 0x84, 0x99, 0xc8, 0x49, 0x1b, 0xd4, 0x0f, 0x5c, 0xfb, 0xcc, 0x57, 0x47,
+	0xf8, 0x8a, 0xbc, 0x08, 0x69, 0x97, 0x1a, 0x1e, 0xb3, 0xf5, 0x34, 0xf6, 0x99, 0xee, 0x76, 0x4a,
+	0x05, 0x8e, 0x48, 0x1d, 0x44, 0x1b, 0xf2, 0x0d, 0x18, 0xdb, 0x0d, 0x1a, 0xd4, 0xb5, 0xa9, 0x4f,
+	0xbd, 0x70, 0xa0, 0x0c, 0x76, 0x2a, 0x76, 0x3b, 0xa5, 0xd9, 0xbe, 0x40, 0x19, 0x6b, 0x54, 0xc6,
+	0x43, 0x33, 0x1d, 0x66, 0xd6, 0xed, 0xa0, 0xdd, 0xa0, 0xae, 0x9e, 0x5d, 0xd0, 0x16, 0x53, 0xdc,
+	0x4c, 0x87, 0x99, 0x77, 0x11, 0x94, 0xcd, 0xec, 0x81, 0xe1, 0xc0, 0x6e, 0x60, 0xd7, 0x0d, 0x1f,
+	0x45, 0xd4, 0xd4, 0x73, 0x0b, 0xda, 0x62, 0x96, 0x0f, 0xec, 0x06, 0xf6, 0xcd, 0x08, 0x97, 0x07,
+	0x96, 0xf1, 0xf2, 0xc7, 0x1a, 0x4c, 0x47, 0x9c, 0x58, 0xdd, 0x77, 0x2c, 0xf7, 0xf4, 0xaf, 0x15,
+	0x3f, 0x4e, 0xc2, 0x44, 0x8d, 0x35, 0xd6, 0xa9, 0x6d, 0x5a, 0xf6, 0xd6, 0x59, 0x02, 0x0c, 0x4e,
+	0x80, 0x43, 0x94, 0x4e, 0x7f, 0x22, 0x4a, 0x67, 0x4e, 0x4c, 0xe9, 0x6b, 0x90, 0xc5, 0x7e, 0x46,
+	0x9b, 0x62, 0x22, 0xe4, 0xf8, 0x14, 0xc3, 0x06, 0x46, 0x5b, 0xf6, 0x56, 0x46, 0x40, 0xa1, 0xa9,
+	0x51, 0x0f, 0xcf, 0x31, 0x9a, 0x14, 0x93, 0x40, 0x98, 0x2a, 0xda, 0x20, 0x2e, 0x9b, 0x2a, 0xe3,
+	0xe5, 0x3f, 0x73, 0x46, 0x6c, 0x04, 0xb6, 0x7d, 0xc6, 0x88, 0x67, 0xc7, 0x88, 0xeb, 0x90, 0xb3,
+	0x99, 0x49, 0x79, 0x68, 0x33, 0x7d, 0x2f, 0x85, 0x60, 0x2c, 0xb6, 0xd9, 0x08, 0x1b, 0x7a, 0x65,
+	0x94, 0x69, 0x94, 0x1b, 0x8e, 0x46, 0xf0, 0x94, 0x34, 0xfa, 0x43, 0x1a, 0xa6, 0x6a, 0xac, 0xb1,
+	0x66, 0x6f, 0xb9, 0xd4, 0xf3, 0xd6, 0xec, 0x4d, 0x76, 0x46, 0xa5, 0xd3, 0x46, 0x25, 0x18, 0x8e,
+	0x4a, 0xf9, 0xa7, 0xa3, 0x12, 0x79, 0x04, 0x93, 0x16, 0xa7, 0x51, 0xdd, 0x30, 0xcd, 0xf0, 0x5f,
+	0xea, 0xe9, 0xb9, 0x85, 0x91, 0xc5, 0xfc, 0x52, 0x25, 0xaa, 0xfc, 0xe3, 0x3c, 0xab, 0x08, 0xe0,
+	0x66, 0xd4, 0x61, 0xd5, 0xf6, 0xdd, 0x83, 0xe5, 0xf9, 0x6e, 0xa7, 0x54, 0xb4, 0x62, 0x22, 0x69,
+	0xe0, 0x42, 0x5c, 0x56, 0xdc, 0x85, 0x99, 0x81, 0xaa, 0xc8, 0x65, 0x18, 0xd9, 0xa5, 0x07, 0xc8,
+	0xe2, 0x14, 0x3f, 0x77, 0xec, 0xd2, 0x03, 0xf9, 0xdc, 0xb1, 0x4b, 0x0f, 0x42, 0x2e, 0xee, 0x19,
+	0xad, 0x80, 0x0a, 0xf2, 0x22, 0x17, 0x11, 0x90, 0xb9, 0x88, 0xc0, 0x8d, 0xc4, 0x2b, 0x5a, 0xf9,
+	0xc3, 0x0c, 0x56, 0xee, 0xb7, 0x0c, 0xab, 0x75, 0x56, 0x8d, 0x7e, 0x3a, 0xd5, 0xe8, 0x3b, 0x00,
+	0x74, 0xdf, 0xf2, 0xeb, 0x4d, 0x66, 0x52, 0x4f, 0xcf, 0x20, 0x6b, 0xca, 0x11, 0x6b, 0x24, 0x47,
+	0x57, 0x56, 0xf7, 0x2d, 0x7f, 0x25, 0x6c, 0xc4, 0x99, 0x72, 0x3e, 0xb4, 0x84, 0x46, 0x58, 0x5f,
+	0xb1, 0xae, 0x6d, 0xe4, 0x7a, 0xf0, 0xe1, 0xdc, 0xcd, 0x7e, 0x92, 0xdc, 0xcd, 0x0d, 0x95, 0xbb,
+	0x30, 0x54, 0xee, 0x8e, 0x0d, 0x97, 0xbb, 0xe3, 0x4f, 0x99, 0xbb, 0x26, 0x90, 0x26, 0xb3, 0x7d,
+	0xc3, 0xb2, 0xa9, 0x5b, 0xf7, 0x7c, 0xc3, 0x0f, 0xc2, 0xe4, 0xcd, 0x63, 0x18, 0xa6, 0x31, 0x0c,
+	0x2b, 0x91, 0xf8, 0x3e, 0x4a, 0x97, 0x4b, 0xdd, 0x4e, 0xe9, 0x42, 0x53, 0x05, 0x95, 0x1c, 0x9d,
+	0x3c, 0x24, 0x24, 0x2f, 0x43, 0xaa, 0x69, 0x04, 0x1e, 0xd5, 0x47, 0x17, 0xb4, 0xc5, 0xf1, 0x25,
+	0xe0, 0x8a, 0x43, 0x84, 0xd3, 0x19, 0x85, 0x32, 0x9d, 0x11, 0x28, 0x9a, 0x30, 0xae, 0x46, 0x5d,
+	0x4e, 0xea, 0xdc, 0xc9, 0x92, 0x3a, 0x75, 0x6c, 0x52, 0x7f, 0x94, 0x00, 0x12, 0x96, 0xd8, 0x2e,
+	0x0d, 0x45, 0x5f, 0x80, 0x9a, 0xea, 0x65, 0xc8, 0xb9, 0xf4, 0xdd, 0x80, 0x7a, 0x3e, 0x73, 0xe5,
+	0xbc, 0xee, 0x81, 0x32, 0x3b, 0x7b, 0xe0, 0xd3, 0xe5, 0x75, 0xf9, 0x17, 0x49, 0xfc, 0xe0, 0x25,
+	0xbc, 0x7a, 0xb6, 0x5a, 0x1e, 0xb5, 0x5a, 0x5e, 0x81, 0x74, 0x78, 0x28, 0xee, 0x95, 0x15, 0x68,
+	0xb0, 0x1b, 0xd8, 0xaa, 0x47, 0x10, 0x20, 0x6b, 0x30, 0xe9, 0x08, 0x96, 0xee, 0xd1, 0xba, 0x70,
+	0x24, 0x2f, 0x28, 0x2e, 0x75, 0x3b, 0xa5, 0xf3, 0x7d, 0x61, 0x2d, 0xe6, 0xd2, 0x89, 0x98, 0x28,
+	0xa6, 0x4a, 0x58, 0x90, 0x1d, 0xa4, 0x6a, 0x23, 0x66, 0xcb, 0x44, 0x4c, 0x24, 0xf1, 0x22, 0x77,
+	0x02, 0x5e, 0x7c, 0x9c, 0x00, 0x5d, 0xca, 0x36, 0x66, 0xdf, 0x65, 0xbe, 0xd5, 0xa4, 0xa7, 0x9d,
+	0x1e, 0xfd, 0x30, 0xa7, 0x8e, 0x0d, 0xf3, 0x5d, 0xc8, 0x9a, 0xd4, 0x30, 0x5b, 0x96, 0x4d, 0x91,
+	0x14, 0xff, 0x7f, 0x64, 0x9c, 0x73, 0xd4, 0x5e, 0x9e, 0x73, 0x84, 0x95, 0xff, 0x92, 0x14, 0xdf,
+	0x9e, 0x9b, 0x4d, 0x4a, 0xcd, 0xb3, 0x54, 0x3c, 0x3b, 0x33, 0x0e, 0x79, 0x66, 0xfc, 0x67, 0x1e,
+	0xcf, 0x8c, 0x6f, 0xfa, 0x56, 0xcb, 0xf2, 0xf0, 0x52, 0xe4, 0x8c, 0x4a, 0xcf, 0x88, 0x4a, 0x3f,
+	0xd2, 0x60, 0xe6, 0x8e, 0xb1, 0xbf, 0x21, 0xee, 0x93, 0xbc, 0x5b, 0xcc, 0x5d, 0xa7, 0xae, 0xc5,
+	0x4c, 0x51, 0x22, 0x5f, 0x8f, 0x4a, 0xe4, 0x78, 0x30, 0x2a, 0x03, 0x7b, 0xf1, 0x9a, 0xf9, 0x72,
+	0xb7, 0x53, 0x2a, 0x0d, 0x94, 0x4b, 0x76, 0x0c, 0x1e, 0x56, 0xe5, 0x76, 0x76, 0x28, 0x6e, 0xe7,
+	0x9e, 0xe7, 0x43, 0xec, 0x0f, 0x35, 0x98, 0xf5, 0x99, 0x6f, 0xb4, 0xea, 0xcd, 0xa0, 0x1d, 0xb4,
+	0x0c, 0xdc, 0x17, 0x03, 0xcf, 0xd8, 0x0a, 0x8b, 0xd6, 0xd0, 0xe3, 0x4b, 0x47, 0x7a, 0xfc, 0x41,
+	0xd8, 0x6d, 0xa5, 0xd7, 0xeb, 0xcd, 0xb0, 0x13, 0x77, 0x78, 0xb9, 0xdb, 0x29, 0xcd, 0xfb, 0x03,
+	0xc4, 0x92, 0x19, 0xd3, 0x83, 0xe4, 0x18, 0xff, 0x9b, 0x7b, 0x5b, 0x03, 0xe2, 0x3f, 0x76, 0x4c,
+	0xfc, 0x07, 0xf6, 0x92, 0xe2, 0x3f, 0x50, 0x2e, 0xc7, 0x7f, 0x60, 0x83, 0xe2, 0x6f, 0x34, 0x28,
+	0x1e, 0x4d, 0xad, 0x93, 0x15, 0xe6, 0xdf, 0x96, 0x0b, 0xf3, 0xfc, 0x52, 0xa5, 0xc2, 0xaf, 0x52,
+	0x2b, 0xf2, 0x55, 0x6a, 0xc5, 0xd9, 0xdd, 0xc2, 0xb9, 0x45, 0x57, 0xa9, 0x95, 0x37, 0x02, 0xc3,
+	0xf6, 0x2d, 0xff, 0xe0, 0xb8, 0x42, 0xbe, 0xf8, 0x6b, 0x0d, 0xce, 0x1f, 0x19, 0x8b, 0xe7, 0xc2,
+	0xc2, 0xd0, 0x89, 0x47, 0xc7, 0xe7, 0x79, 0x30, 0xb1, 0xfc, 0x9f, 0x04, 0xcc, 0xd6, 0x58, 0x63,
+	0x83, 0x3a, 0xae, 0xc5, 0x5c, 0xcb, 0xb7, 0xde, 0xfb, 0x02, 0x9c, 0x88, 0xbe, 0x0e, 0xa3, 0x36,
+	0x7d, 0x58, 0x17, 0x53, 0x3e, 0xc0, 0x85, 0x5e, 0xc3, 0x4f, 0x0c, 0x33, 0x36, 0x7d, 0xb8, 0x2e,
+	0x60, 0xa9, 0x67, 0x5e, 0x82, 0xd5, 0xf3, 0x54, 0xfa, 0xa4, 0xe7, 0xa9, 0xf2, 0xbf, 0x13, 0x78,
+	0xc3, 0x29, 0x79, 0xfa, 0xf4, 0x97, 0x66, 0x9f, 0x8b, 0xa3, 0xc5, 0x01, 0x7f, 0xc5, 0xb0, 0x9b,
+	0xb4, 0xd5, 0x3a, 0x3b, 0xe0, 0x7f, 0x3a, 0x07, 0xfc, 0x0f, 0xf9, 0x8b, 0x16, 0xe1, 0xd5, 0xd3,
+	0x4f, 0xdd, 0xcf, 0xc4, 0xa9, 0x7f, 0x4a, 0x22, 0x55, 0x1f, 0x50, 0xb7, 0x6d, 0xd9, 0xc6, 0xd9,
+	0x67, 0x93, 0xe7, 0xfb, 0xc6, 0xf7, 0xb3, 0x39, 0x76, 0x49, 0x14, 0xca, 0x9e, 0x80, 0x42, 0xbf,
+	0x1f, 0x85, 0x51, 0x64, 0xcd, 0x1d, 0xea, 0x61, 0x29, 0x79, 0x0f, 0x72, 0x5e, 0xf4, 0xec, 0x0c,
+	0xf9, 0x93, 0x5f, 0x9a, 0x8d, 0xaa, 0x47, 0xf5, 0x3d, 0x1a, 0x77, 0x40, 0xaf, 0x71, 0x5f, 0xf9,
+	0xeb, 0xe7, 0x36, 0xfa, 0x3a, 0xc8, 0x0a, 0xa4, 0x91, 0x09, 0xa6, 0x28, 0x41, 0xa6, 0x22, 0x6d,
+	0xd2, 0xf3, 0x2d, 0x6e, 0x24, 0x6f, 0xa6, 0xe8, 0x11, 0x5d, 0x43, 0x25, 0x2d, 0x7c, 0x00, 0x85,
+	0x8c, 0x93, 0x94, 0x48, 0xcf, 0xa2, 0xb8, 0x12, 0xde, 0x4c, 0x55, 0xc2, 0x31, 0xf2, 0x5d, 0x18,
+	0xc7, 0xff, 0xd5, 0x5d, 0xf1, 0x42, 0xa8, 0xc7, 0x48, 0x59, 0x99, 0xf2, 0x7c, 0x68, 0xf9, 0x42,
+	0xb7, 0x53, 0x9a, 0x6b, 0xc9, 0xb8, 0xa2, 0x7a, 0x4c, 0x11, 0x91, 0x77, 0x80, 0x03, 0x75, 0xca,
+	0xdf, 0x9b, 0x88, 0x17, 0x6d, 0xe7, 0x95, 0x01, 0xe4, 0xb7, 0x28, 0x3c, 0xae, 0x2d, 0x09, 0x56,
+	0xd4, 0x8f, 0xca, 0x12, 0xf2, 0x1a, 0x64, 0x1c, 0xfe, 0xb2, 0x43, 0x7c, 0xe8, 0x99, 0x8e, 0xf4,
+	0xca, 0x0f, 0x3e, 0x04, 0xc3, 0x38, 0xa2, 0x68, 0x8b, 0x7a, 0x87, 0x8a, 0x5c, 0xfe, 0x20, 0x00,
+	0xa9, 0x2c, 0x29, 0x92, 0xdf, 0x09, 0x70, 0x45, 0xa2, 0xa1, 0xaa, 0x48, 0x80, 0x61, 0x58, 0x36,
+	0xf1, 0xd2, 0x05, 0xc9, 0x2d, 0x85, 0x45, 0xba, 0x8a, 0xe1, 0x61, 0xe1, 0xcd, 0xd4, 0xb0, 0x70,
+	0x8c, 0x33, 0x4e, 0x7c, 0x6c, 0x42, 0xb6, 0x2b, 0x8c, 0x93, 0xbf, 0x42, 0x45, 0x8c, 0x13, 0x58,
+	0x9c, 0x71, 0x02, 0x26, 0x75, 0x18, 0x73, 0xe5, 0x32, 0x09, 0x4f, 0x77, 0x52, 0x98, 0x0f, 0xd7,
+	0x50, 0x3c, 0xcc, 0x4a, 0x27, 0x35, 0xcc, 0x8a, 0x88, 0xdc, 0x07, 0x68, 0xf6, 0xca, 0x03, 0xbc,
+	0xa5, 0xc8, 0x2f, 0xcd, 0x45, 0xda, 0x63, 0x85, 0xc3, 0xb2, 0xde, 0xed, 0x94, 0xa6, 0xfb, 0xcd,
+	0x15, 0xbd, 0x92, 0x9a, 0xd0, 0x0d, 0xcd, 0x68, 0x77, 0xc4, 0xfb, 0x1c, 0xc9, 0x0d, 0xea, 0xb6,
+	0x29, 0x96, 0xbc, 0x08, 0x53, 0xdd, 0xd0, 0x83, 0xc9, 0x5b, 0x90, 0x0f, 0xfa, 0xc7, 0x3d, 0x7d,
+	0x02, 0x55, 0xea, 0x47, 0x9d, 0x04, 0x79, 0x59, 0x25, 0x75, 0x50, 0xd4, 0xca, 0x9a, 0xc8, 0xdb,
+	0x30, 0x1a, 0xdd, 0xe0, 0x5a, 0xf6, 0x26, 0xd3, 0x27, 0x55, 0xcd, 0xf1, 0xcb, 0x5b, 0xae, 0xd9,
+	0xea, 0xa3, 0xaa, 0x66, 0x49, 0x40, 0x9a, 0x30, 0xee, 0x2a, 0x47, 0x09, 0x9d, 0xa0, 0xee, 0x0b,
+	0x03, 0x42, 0xd7, 0x73, 0xf0, 0xc5, 0x6e, 0xa7, 0xa4, 0xab, 0xdd, 0x94, 0x11, 0x62, 0x2a, 0x43,
+	0x47, 0x3b, 0xd1, 0x3d, 0x83, 0x3e, 0xa3, 0x3a, 0x5a, 0xbd, 0x80, 0x10, 0x4b, 0x7c, 0x84, 0xa9,
+	0x8e, 0xee, 0xc1, 0x21, 0x1d, 0x9c, 0xde, 0x75, 0x90, 0x3e, 0xab, 0xd2, 0x21, 0x76, 0x51, 0xc4,
+	0xe9, 0xd0, 0x6f, 0xae, 0xd2, 0xa1, 0x8f, 0x93, 0x56, 0xff, 0x93, 0x3b, 0xb3, 0xeb, 0x36, 0x7e,
+	0xf6, 0xd6, 0xe7, 0x50, 0xf7, 0xa5, 0xb8, 0x6e, 0xe5, 0xb3, 0x38, 0xbf, 0x15, 0x77, 0x62, 0x22,
+	0x65, 0x9c, 0x42, 0x5c, 0xba, 0x9c, 0x85, 0x34, 0x3e, 0xa1, 0xf6, 0x6a, 0xc9, 0x6c, 0xb6, 0x90,
+	0xab, 0x25, 0xb3, 0xe3, 0x85, 0x89, 0x5a, 0x32, 0x5b, 0x28, 0x4c, 0xd6, 0x92, 0xd9, 0xa9, 0xc2,
+	0x74, 0x2d, 0x99, 0x9d, 0x2e, 0xcc, 0x94, 0xbf, 0x9f, 0x80, 0x89, 0xd8, 0x15, 0x1f, 0xf9, 0x12,
+	0x24, 0x71, 0x7f, 0xe3, 0xc5, 0x06, 0xe9, 0x76, 0x4a, 0xe3, 0xb6, 0xba, 0xb9, 0xa1, 0x9c, 0x2c,
+	0x41, 0x36, 0xba, 0x6a, 0x15, 0x77, 0x6d, 0x58, 0x68, 0x44, 0x98, 0x5c, 0x68, 0x44, 0x18, 0xa9,
+	0x42, 0xa6, 0xcd, 0x37, 0x26, 0x51, 0x6a, 0xe0, 0x9a, 0x24, 0x20, 0x79, 0xfb, 0x14, 0x90, 0xb4,
+	0xfb, 0x25, 0x4f, 0x70, 0x9d, 0xdc, 0xbb, 0x69, 0x4c, 0x3d, 0xcd, 0x4d, 0x63, 0xf9, 0x3d, 0x20,
+	0xe8, 0xea, 0xfb, 0xbe, 0x4b, 0x8d, 0x76, 0xb4, 0x73, 0x2e, 0x40, 0xa2, 0x57, 0x72, 0x15, 0xba,
+	0x9d, 0xd2, 0xa8, 0x25, 0xd7, 0x13, 0x09, 0xcb, 0x24, 0xcb, 0xfd, 0xd9, 0xf0, 0xbd, 0x70, 0x12,
+	0x07, 0x94, 0xf7, 0xdf, 0xe3, 0x26, 0x58, 0xfe, 0x59, 0x02, 0xc6, 0x6a, 0x58, 0x87, 0x6d, 0xf0,
+	0xaa, 0xf1, 0x04, 0xe3, 0xbe, 0x00, 0xa9, 0x87, 0x86, 0xdf, 0xdc, 0xc6, 0x51, 0xb3, 0x7c, 0x6a,
+	0x08, 0xc8, 0x53, 0x43, 0x80, 0xac, 0xc0, 0xc4, 0xa6, 0xcb, 0xda, 0x75, 0x31, 0x5c, 0x58, 0x2b,
+	0x71, 0xc7, 0xe3, 0x0a, 0x19, 0x8a, 0x84, 0xa1, 0x4a, 0xb1, 0x34, 0xa6, 0x08, 0xfa, 0xe5, 0x61,
+	0xf2, 0xd8, 0xf2, 0xf0, 0x55, 0x18, 0xa7, 0xae, 0xcb, 0xdc, 0xb5, 0xcd, 0x3b, 0x96, 0xe7, 0x85,
+	0xf9, 0x93, 0x42, 0x1b, 0x31, 0xa9, 0x55, 0x89, 0xd4, 0x39, 0xd6, 0xa7, 0xfc, 0x2b, 0x0d, 0x46,
+	0xdf, 0x0a, 0xed, 0x8f, 0x7c, 0xd2, 0xb3, 0x40, 0x3b, 0xd6, 0x82, 0xe1, 0x2a, 0xe0, 0xab, 0x90,
+	0x41, 0x3f, 0xf5, 0xfc, 0xc3, 0x77, 0x39, 0x97, 0xb5, 0x95, 0x0e, 0x69, 0x8e, 0x94, 0xff, 0x9b,
+	0x84, 0x49, 0x34, 0x10, 0x8b, 0x9e, 0x21, 0xac, 0xfc, 0x26, 0x8c, 0x47, 0x56, 0x3a, 0x2e, 0xdd,
+	0xb4, 0xf6, 0x85, 0xa5, 0x58, 0x3f, 0x70, 0xab, 0xd6, 0x11, 0x97, 0xeb, 0x42, 0x19, 0x27, 0x5f,
+	0x83, 0x3c, 0xa6, 0x78, 0xdd, 0x3f, 0x70, 0xa8, 0xa7, 0x8f, 0x2c, 0x8c, 0x2c, 0xe6, 0xf8, 0x6a,
+	0x84, 0xf0, 0x83, 0x10, 0x95, 0x3a, 0x43, 0x1f, 0x25, 0xeb, 0x90, 0x6e, 0x19, 0x0d, 0xda, 0xf2,
+	0xf4, 0xa4, 0xf4, 0xe2, 0xe2, 0xd0, 0x7c, 0x2a, 0xb7, 0xb1, 0x11, 0xff, 0x7a, 0xc8, 0x8b, 0x31,
+	0x04, 0x64, 0x7f, 0x70, 0x84, 0xb8, 0x50, 0x88, 0xd1, 0xcc, 0xd3, 0x53, 0xa8, 0xfb, 0xca, 0x11,
+	0xba, 0x6f, 0xc9, 0x0c, 0x13, 0x63, 0x20, 0x49, 0x14, 0xea, 0xc9, 0x63, 0x8d, 0xab, 0x92, 0x7e,
+	0x16, 0xa4, 0x8f, 0xcb, 0x82, 0xa2, 0x01, 0x79, 0x69, 0x2e, 0x43, 0xbc, 0x23, 0x38, 0xf6, 0x71,
+	0x50, 0xd1, 0x82, 0xa9, 0x01, 0x53, 0x7a, 0x16, 0x43, 0x95, 0xff, 0xa8, 0xc1, 0x1c, 0xfa, 0x72,
+	0xc0, 0xa2, 0xa5, 0xb2, 0x5f, 0x3b, 0x21, 0xfb, 0xf9, 0x92, 0x93, 0x38, 0xd9, 0x52, 0x37, 0x32,
+	0xe4, 0x52, 0x77, 0xe5, 0x36, 0xa4, 0x70, 0x2d, 0x26, 0x39, 0x48, 0xad, 0x86, 0x09, 0x5f, 0x38,
+	0x47, 0xf2, 0x90, 0x59, 0xdd, 0xb3, 0x9a, 0x3e, 0x35, 0x0b, 0x1a, 0xc9, 0xc0, 0xc8, 0xbd, 0x7b,
+	0x77, 0x0a, 0x09, 0x32, 0x0d, 0x85, 0x57, 0xc5, 0xfd, 0xe5, 0xea, 0x3e, 0xaf, 0x02, 0x0b, 0x23,
+	0x64, 0x14, 0xb2, 0x1b, 0x74, 0x87, 0x62, 0xe3, 0xe4, 0xd2, 0xdf, 0x12, 0x90, 0xe2, 0xe7, 0x63,
+	0x0a, 0x13, 0xaf, 0x51, 0x9f, 0x2f, 0xa2, 0x88, 0x78, 0x84, 0xf4, 0x0a, 0xce, 0xde, 0xba, 0x5a,
+	0x9c, 0xeb, 0x5b, 0xac, 0xf8, 0xac, 0x7c, 0xf9, 0x7b, 0x1f, 0xfd, 0xeb, 0xe7, 0x89, 0x4b, 0x65,
+	0xbd, 0xba, 0xf7, 0x95, 0xea, 0x0e, 0x6b, 0x5c, 0xf5, 0xa8, 0x5f, 0x7d, 0x84, 0x79, 0xfa, 0x7e,
+	0xf5, 0x91, 0x65, 0xbe, 0x7f, 0x43, 0xbb, 0x72, 0x4d, 0x23, 0x37, 0x20, 0x85, 0x34, 0x26, 0x93,
+	0x7d, 0x4a, 0x1f, 0xab, 0x7b, 0xe4, 0x07, 0x09, 0xed, 0x9a, 0x46, 0x2c, 0x80, 0x7e, 0x0a, 0x90,
+	0xd9, 0xc1, 0x39, 0x51, 0xbc, 0x88, 0xf8, 0x11, 0xa1, 0x55, 0xcd, 0x44, 0xeb, 0x7a, 0x46, 0xf2,
+	0xbd, 0x3e, 0x32, 0x33, 0xfd, 0x3a, 0xfe, 0xd8, 0x89, 0xcc, 0x1e, 0x3a, 0x99, 0xaf, 0x86, 0x01,
+	0x29, 0xf2, 0xca, 0x8e, 0x37, 0x5a, 0xd9, 0xa6, 0xcd, 0xdd, 0x0d, 0xea, 0x39, 0xcc, 0xf6, 0xe8,
+	0xf2, 0xdb, 0x7f, 0x7d, 0x3c, 0xaf, 0x7d, 0xf0, 0x78, 0x5e, 0xfb, 0xc7, 0xe3, 0x79, 0xed, 0x27,
+	0x4f, 0xe6, 0xcf, 0x7d, 0xf0, 0x64, 0xfe, 0xdc, 0xdf, 0x9f, 0xcc, 0x9f, 0xfb, 0xce, 0x97, 0xb7,
+	0x2c, 0x7f, 0x3b, 0x68, 0x54, 0x9a, 0xac, 0x5d, 0x35, 0xdc, 0xb6, 0x61, 0x1a, 0x8e, 0xcb, 0xc2,
+	0x58, 0x88, 0xbf, 0xa2, 0xdf, 0x40, 0xfd, 0x2e, 0x31, 0x7d, 0x13, 0x81, 0x75, 0x2e, 0xae, 0xac,
+	0xb1, 0xca, 0x4d, 0xc7, 0x6a, 0xa4, 0xd1, 0x86, 0xeb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd6,
+	0x51, 0x27, 0x60, 0xe2, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2599,6 +2874,8 @@ const _ = grpc.SupportPackageIsVersion4
 type EventClient interface {
 	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
 	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error)
+	// WatchQueue streams the events of all job sets in a queue.
+	WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (Event_WatchQueueClient, error)
 	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -2675,6 +2952,38 @@ func (x *eventWatchClient) Recv() (*EventStreamMessage, error) {
 	return m, nil
 }
 
+func (c *eventClient) WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (Event_WatchQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[2], "/api.Event/WatchQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventWatchQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Event_WatchQueueClient interface {
+	Recv() (*QueueEventStreamMessage, error)
+	grpc.ClientStream
+}
+
+type eventWatchQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventWatchQueueClient) Recv() (*QueueEventStreamMessage, error) {
+	m := new(QueueEventStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *eventClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/api.Event/Health", in, out, opts...)
@@ -2688,6 +2997,8 @@ func (c *eventClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.
 type EventServer interface {
 	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
 	Watch(*WatchRequest, Event_WatchServer) error
+	// WatchQueue streams the events of all job sets in a queue.
+	WatchQueue(*WatchQueueRequest, Event_WatchQueueServer) error
 	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
 }
 
@@ -2701,6 +3012,9 @@ func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_G
 func (*UnimplementedEventServer) Watch(req *WatchRequest, srv Event_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
+func (*UnimplementedEventServer) WatchQueue(req *WatchQueueRequest, srv Event_WatchQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQueue not implemented")
+}
 func (*UnimplementedEventServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
@@ -2751,6 +3065,27 @@ func (x *eventWatchServer) Send(m *EventStreamMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Event_WatchQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServer).WatchQueue(m, &eventWatchQueueServer{stream})
+}
+
+type Event_WatchQueueServer interface {
+	Send(*QueueEventStreamMessage) error
+	grpc.ServerStream
+}
+
+type eventWatchQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventWatchQueueServer) Send(m *QueueEventStreamMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Event_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
@@ -2789,6 +3124,11 @@ var _Event_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Event_Watch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchQueue",
+			Handler:       _Event_WatchQueue_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/api/event.proto",
 }
@@ -3726,7 +4066,7 @@ func (m *JobPreemptedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobPreemptionNoticeEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3736,38 +4076,113 @@ func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobPreemptionNoticeEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobPreemptionNoticeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+	if m.Deadline != nil {
+		{
+			size, err := m.Deadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x32
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x2a
 	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
 		i--
 		dAtA[i] = 0x3a
@@ -4767,6 +5182,29 @@ func (m *EventMessage_Preempting) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessage_PreemptionNotice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_PreemptionNotice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PreemptionNotice != nil {
+		{
+			size, err := m.PreemptionNotice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4971,6 +5409,149 @@ func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FromMessageIds) > 0 {
+		for k := range m.FromMessageIds {
+			v := m.FromMessageIds[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.JobSetPrefix) > 0 {
+		i -= len(m.JobSetPrefix)
+		copy(dAtA[i:], m.JobSetPrefix)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEventStreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -5428,6 +6009,39 @@ func (m *JobPreemptedEvent) Size() (n int) {
 	return n
 }
 
+func (m *JobPreemptionNoticeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = m.Deadline.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *JobSucceededEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5957,6 +6571,18 @@ func (m *EventMessage_Preempting) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessage_PreemptionNotice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreemptionNotice != nil {
+		l = m.PreemptionNotice.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
 func (m *ContainerStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6049,9 +6675,72 @@ func (m *WatchRequest) Size() (n int) {
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *WatchQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetPrefix)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FromMessageIds) > 0 {
+		for k, v := range m.FromMessageIds {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if m.Watch {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueueEventStreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozEvent(x uint64) (n int) {
 	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -9479,7 +10168,7 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
+func (m *JobPreemptionNoticeEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9502,10 +10191,10 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobPreemptionNoticeEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobPreemptionNoticeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9642,7 +10331,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9670,96 +10359,13 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9769,55 +10375,27 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if m.Deadline == nil {
+				m.Deadline = &types.Timestamp{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9840,7 +10418,7 @@ func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
+func (m *JobSucceededEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9863,10 +10441,10 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSucceededEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSucceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10067,9 +10645,9 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10079,67 +10657,428 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxResourcesForPeriod == nil {
-				m.MaxResourcesForPeriod = make(map[string]*resource.Quantity)
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var mapkey string
-			var mapvalue *resource.Quantity
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUtilisationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUtilisationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxResourcesForPeriod == nil {
+				m.MaxResourcesForPeriod = make(map[string]*resource.Quantity)
+			}
+			var mapkey string
+			var mapvalue *resource.Quantity
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
 					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
@@ -10565,7 +11504,232 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AvgResourcesForPeriod[mapkey] = mapvalue
+			m.AvgResourcesForPeriod[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10588,7 +11752,7 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10611,10 +11775,10 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10813,7 +11977,7 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10836,10 +12000,10 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10975,19 +12139,40 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11015,7 +12200,7 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11038,7 +12223,7 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11061,10 +12246,10 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11284,7 +12469,7 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
+func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11307,15 +12492,147 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11343,11 +12660,11 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11375,13 +12692,13 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
 			}
-			var stringLen uint64
+			m.PodNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11391,29 +12708,16 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PodNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11423,31 +12727,27 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11475,11 +12775,11 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11507,7 +12807,7 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11530,7 +12830,7 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
+func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11553,17 +12853,17 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11573,29 +12873,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobSubmittedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Submitted{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11605,29 +12908,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobQueuedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Queued{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11637,27 +12943,30 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobLeasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Leased{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11684,18 +12993,17 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobLeaseReturnedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_LeaseReturned{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11705,80 +13013,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			v := &JobLeaseExpiredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.Events = &EventMessage_LeaseExpired{v}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11788,61 +13048,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			v := &JobPendingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.Events = &EventMessage_Pending{v}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -11852,77 +13083,30 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
+			v := &JobRunningEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Events = &EventMessage_Running{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11949,15 +13133,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSubmittedEvent{}
+			v := &JobFailedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Submitted{v}
+			m.Events = &EventMessage_Failed{v}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11984,15 +13168,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobQueuedEvent{}
+			v := &JobSucceededEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Queued{v}
+			m.Events = &EventMessage_Succeeded{v}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12019,15 +13203,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeasedEvent{}
+			v := &JobReprioritizedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Leased{v}
+			m.Events = &EventMessage_Reprioritized{v}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12054,15 +13238,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseReturnedEvent{}
+			v := &JobCancellingEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_LeaseReturned{v}
+			m.Events = &EventMessage_Cancelling{v}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12089,15 +13273,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseExpiredEvent{}
+			v := &JobCancelledEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_LeaseExpired{v}
+			m.Events = &EventMessage_Cancelled{v}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12124,15 +13308,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPendingEvent{}
+			v := &JobUtilisationEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Pending{v}
+			m.Events = &EventMessage_Utilisation{v}
 			iNdEx = postIndex
-		case 7:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12159,15 +13343,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunningEvent{}
+			v := &JobIngressInfoEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Running{v}
+			m.Events = &EventMessage_IngressInfo{v}
 			iNdEx = postIndex
-		case 9:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12194,15 +13378,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobFailedEvent{}
+			v := &JobReprioritizingEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Failed{v}
+			m.Events = &EventMessage_Reprioritizing{v}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12229,15 +13413,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSucceededEvent{}
+			v := &JobPreemptedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Succeeded{v}
+			m.Events = &EventMessage_Preempted{v}
 			iNdEx = postIndex
-		case 11:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12264,15 +13448,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizedEvent{}
+			v := &JobPreemptingEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Reprioritized{v}
+			m.Events = &EventMessage_Preempting{v}
 			iNdEx = postIndex
-		case 12:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptionNotice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12299,17 +13483,67 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancellingEvent{}
+			v := &JobPreemptionNoticeEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Cancelling{v}
+			m.Events = &EventMessage_PreemptionNotice{v}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12319,32 +13553,48 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancelledEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Cancelled{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12354,32 +13604,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &JobUtilisationEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Utilisation{v}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12389,32 +13636,29 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobIngressInfoEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_IngressInfo{v}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
 			}
-			var msglen int
+			m.Cause = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12424,32 +13668,66 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Cause |= Cause(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			m.Events = &EventMessage_Reprioritizing{v}
-			iNdEx = postIndex
-		case 21:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12459,30 +13737,27 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPreemptedEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Events = &EventMessage_Preempted{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preempting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12509,11 +13784,12 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPreemptingEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Message == nil {
+				m.Message = &EventMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Preempting{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12536,7 +13812,7 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
+func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12559,15 +13835,15 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12595,13 +13871,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
-			m.ExitCode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12611,14 +13887,15 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExitCode |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Watch = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12646,11 +13923,11 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.FromMessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12678,13 +13955,13 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIfMissing", wireType)
 			}
-			m.Cause = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12694,11 +13971,12 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ErrorIfMissing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -12720,7 +13998,7 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12743,15 +14021,15 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12779,13 +14057,13 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12795,27 +14073,55 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &EventMessage{}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.FromId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12838,7 +14144,7 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12861,15 +14167,15 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12897,13 +14203,13 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetPrefix", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12913,17 +14219,61 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Watch = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12933,29 +14283,124 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromMessageId = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMessageIds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -12965,27 +14410,122 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			if m.FromMessageIds == nil {
+				m.FromMessageIds = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FromMessageIds[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorIfMissing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13002,7 +14542,7 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ErrorIfMissing = bool(v != 0)
+			m.Watch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -13024,7 +14564,7 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+func (m *QueueEventStreamMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13047,15 +14587,15 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueEventStreamMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueEventStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13083,11 +14623,11 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13115,13 +14655,13 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13131,23 +14671,27 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromId = string(dAtA[iNdEx:postIndex])
+			if m.Message == nil {
+				m.Message = &EventMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,7 +3,9 @@ package api
 import (
 	"encoding/json"
 	"reflect"
+	"sync"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 )
@@ -69,6 +71,32 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Preempting, nil
 	case *EventMessage_Preempted:
 		return event.Preempted, nil
+	case *EventMessage_PreemptionNotice:
+		return event.PreemptionNotice, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
+
+// eventTypesByWrapper maps each EventMessage oneof wrapper type to the name of its oneof field.
+var eventTypesByWrapper = sync.OnceValue(func() map[reflect.Type]string {
+	eventTypes := make(map[reflect.Type]string)
+	for name, oneof := range proto.GetProperties(reflect.TypeOf(EventMessage{})).OneofTypes {
+		eventTypes[oneof.Type] = name
+	}
+	return eventTypes
+})
+
+// EventType returns the type of the event in message, named as in the EventMessage oneof, e.g., "lease_returned".
+// The empty string is returned if the message contains no event.
+func EventType(message *EventMessage) string {
+	if message == nil || message.Events == nil {
+		return ""
+	}
+	return eventTypesByWrapper()[reflect.TypeOf(message.Events)]
+}
+
+// IsValidEventType returns true if eventType names a field of the EventMessage oneof.
+func IsValidEventType(eventType string) bool {
+	_, ok := proto.GetProperties(reflect.TypeOf(EventMessage{})).OneofTypes[eventType]
+	return ok
+}
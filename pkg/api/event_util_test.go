@@ -0,0 +1,17 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventType(t *testing.T) {
+	assert.Equal(t, "submitted", EventType(&EventMessage{Events: &EventMessage_Submitted{}}))
+	assert.Equal(t, "lease_returned", EventType(&EventMessage{Events: &EventMessage_LeaseReturned{}}))
+	assert.Equal(t, "", EventType(&EventMessage{}))
+	assert.Equal(t, "", EventType(nil))
+
+	assert.True(t, IsValidEventType("lease_returned"))
+	assert.False(t, IsValidEventType("leaseReturned"))
+}
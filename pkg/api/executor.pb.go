@@ -255,49 +255,207 @@ func (m *ExecutorCancelRequest) GetPriorityClasses() []string {
 	return nil
 }
 
+// Marks nodes of the specified executor as draining. Exactly one of nodeName and nodeSelector must be provided.
+// No new jobs will be scheduled onto draining nodes. If preempt is set, preemptible jobs running on them are preempted;
+// all other jobs are left to finish.
+type ExecutorNodeDrainRequest struct {
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeName     string            `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Preempt      bool              `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	Reason       string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ExecutorNodeDrainRequest) Reset()         { *m = ExecutorNodeDrainRequest{} }
+func (m *ExecutorNodeDrainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutorNodeDrainRequest) ProtoMessage()    {}
+func (*ExecutorNodeDrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_506cd9cd149291ea, []int{4}
+}
+func (m *ExecutorNodeDrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorNodeDrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorNodeDrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorNodeDrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorNodeDrainRequest.Merge(m, src)
+}
+func (m *ExecutorNodeDrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorNodeDrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorNodeDrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorNodeDrainRequest proto.InternalMessageInfo
+
+func (m *ExecutorNodeDrainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecutorNodeDrainRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *ExecutorNodeDrainRequest) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *ExecutorNodeDrainRequest) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
+func (m *ExecutorNodeDrainRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Removes a drain previously created with the same executor, nodeName and nodeSelector
+type ExecutorNodeUndrainRequest struct {
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeName     string            `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ExecutorNodeUndrainRequest) Reset()         { *m = ExecutorNodeUndrainRequest{} }
+func (m *ExecutorNodeUndrainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutorNodeUndrainRequest) ProtoMessage()    {}
+func (*ExecutorNodeUndrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_506cd9cd149291ea, []int{5}
+}
+func (m *ExecutorNodeUndrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorNodeUndrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorNodeUndrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutorNodeUndrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorNodeUndrainRequest.Merge(m, src)
+}
+func (m *ExecutorNodeUndrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorNodeUndrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorNodeUndrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutorNodeUndrainRequest proto.InternalMessageInfo
+
+func (m *ExecutorNodeUndrainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecutorNodeUndrainRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *ExecutorNodeUndrainRequest) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecutorSettingsUpsertRequest)(nil), "api.ExecutorSettingsUpsertRequest")
 	proto.RegisterType((*ExecutorSettingsDeleteRequest)(nil), "api.ExecutorSettingsDeleteRequest")
 	proto.RegisterType((*ExecutorPreemptRequest)(nil), "api.ExecutorPreemptRequest")
 	proto.RegisterType((*ExecutorCancelRequest)(nil), "api.ExecutorCancelRequest")
+	proto.RegisterType((*ExecutorNodeDrainRequest)(nil), "api.ExecutorNodeDrainRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ExecutorNodeDrainRequest.NodeSelectorEntry")
+	proto.RegisterType((*ExecutorNodeUndrainRequest)(nil), "api.ExecutorNodeUndrainRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ExecutorNodeUndrainRequest.NodeSelectorEntry")
 }
 
 func init() { proto.RegisterFile("pkg/api/executor.proto", fileDescriptor_506cd9cd149291ea) }
 
 var fileDescriptor_506cd9cd149291ea = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x09, 0xaa, 0xd2, 0x13, 0x82, 0x62, 0x81, 0x65, 0x9c, 0xd6, 0x0e, 0x96, 0x68,
-	0xa3, 0xaa, 0xb2, 0x45, 0xd9, 0x18, 0x90, 0x48, 0xa9, 0x3a, 0x82, 0x82, 0x58, 0x58, 0xd0, 0xc5,
-	0x79, 0x18, 0x43, 0xec, 0xbb, 0xfa, 0xce, 0x88, 0x0a, 0x75, 0xe1, 0x13, 0x20, 0xf1, 0x5d, 0x60,
-	0xe0, 0x0b, 0x30, 0x56, 0x62, 0x61, 0xb2, 0x50, 0xc2, 0x82, 0x3f, 0x05, 0xf2, 0x9d, 0x1d, 0xec,
-	0xa0, 0x52, 0x3a, 0x76, 0xcb, 0xbd, 0xf7, 0xff, 0xbf, 0xdf, 0xbb, 0xf8, 0x6f, 0x63, 0x9d, 0xbd,
-	0x0e, 0x3c, 0xc2, 0x42, 0x0f, 0xde, 0x82, 0x9f, 0x0a, 0x9a, 0xb8, 0x2c, 0xa1, 0x82, 0x6a, 0x1d,
-	0xc2, 0x42, 0x73, 0x3d, 0xa0, 0x34, 0x98, 0x82, 0xec, 0x93, 0x38, 0xa6, 0x82, 0x88, 0x90, 0xc6,
-	0x5c, 0x49, 0xcc, 0x5e, 0xd9, 0x95, 0xa7, 0x71, 0xfa, 0xc2, 0x83, 0x88, 0x89, 0x23, 0xd5, 0x74,
-	0xbe, 0x20, 0xbc, 0xb1, 0x5f, 0x8e, 0x7c, 0x02, 0x42, 0x84, 0x71, 0xc0, 0x9f, 0x32, 0x0e, 0x89,
-	0x18, 0xc1, 0x61, 0x0a, 0x5c, 0x68, 0x9b, 0xf8, 0x52, 0x4c, 0x22, 0x30, 0x50, 0x1f, 0x0d, 0x56,
-	0x87, 0x5a, 0x9e, 0xd9, 0x57, 0x8a, 0xf3, 0x0e, 0x8d, 0x42, 0x21, 0x27, 0x8d, 0x64, 0x5f, 0xdb,
-	0xc5, 0x5d, 0x9f, 0x26, 0x13, 0x1a, 0xc3, 0xc4, 0x68, 0xf7, 0xd1, 0xa0, 0x3b, 0xd4, 0xf3, 0xcc,
-	0xd6, 0xaa, 0x5a, 0x4d, 0xbf, 0xd0, 0x69, 0xf7, 0xf1, 0x65, 0xf5, 0x7b, 0x04, 0x84, 0xd3, 0xd8,
-	0xe8, 0x48, 0x86, 0x99, 0x67, 0xb6, 0x5e, 0xaf, 0xd7, 0xbc, 0x0d, 0xbd, 0x73, 0xf0, 0xf7, 0xf2,
-	0x0f, 0x61, 0x0a, 0x02, 0xce, 0xb9, 0xbc, 0xf3, 0x19, 0x61, 0xbd, 0x9a, 0xf4, 0x38, 0x81, 0xa2,
-	0x75, 0xde, 0xfb, 0xef, 0xe0, 0x95, 0xc3, 0x14, 0x52, 0xe0, 0x46, 0xbb, 0xdf, 0x19, 0xac, 0x0e,
-	0xaf, 0xe7, 0x99, 0xbd, 0xa6, 0x2a, 0x35, 0x6d, 0xa9, 0xd1, 0x0e, 0xf0, 0x55, 0x96, 0x84, 0x34,
-	0x09, 0xc5, 0xd1, 0xde, 0x94, 0x70, 0x0e, 0xdc, 0xe8, 0x48, 0xdb, 0x46, 0x9e, 0xd9, 0x37, 0x97,
-	0x5a, 0x35, 0xff, 0xb2, 0xcb, 0xf9, 0x84, 0xf0, 0x8d, 0x6a, 0xf3, 0x3d, 0x12, 0xfb, 0x30, 0xbd,
-	0x18, 0x8b, 0xef, 0xfe, 0xea, 0xe0, 0x6e, 0xb5, 0xb8, 0x76, 0x8c, 0x75, 0x95, 0xba, 0xe5, 0xc7,
-	0xa9, 0x39, 0x2e, 0x61, 0xa1, 0xfb, 0xcf, 0x88, 0x9a, 0xba, 0xab, 0x22, 0xee, 0x56, 0x11, 0x77,
-	0xf7, 0x0b, 0xa6, 0xb3, 0xf5, 0xfe, 0xdb, 0xcf, 0x8f, 0xed, 0x5b, 0xe6, 0xba, 0xf7, 0xe6, 0xce,
-	0xe2, 0xc5, 0x79, 0xce, 0xcb, 0x19, 0xde, 0xbb, 0xe2, 0xfa, 0xc7, 0xf7, 0xd0, 0x76, 0x81, 0x57,
-	0xb9, 0xf9, 0x4f, 0x7c, 0x23, 0x64, 0x67, 0xe1, 0xb7, 0xcf, 0xc4, 0x33, 0x7c, 0xad, 0x0c, 0xdd,
-	0xa3, 0x78, 0xf1, 0x97, 0xf4, 0x1a, 0xe4, 0x66, 0x28, 0x4f, 0x45, 0x6e, 0x4a, 0x64, 0xdf, 0xe9,
-	0xd5, 0x91, 0x1e, 0x53, 0xe6, 0x1a, 0x31, 0xc2, 0x6b, 0x2a, 0x2c, 0x35, 0xa0, 0xd9, 0x00, 0x36,
-	0xb2, 0x74, 0x2a, 0xef, 0xb6, 0xe4, 0xd9, 0x8e, 0xd9, 0xe0, 0xf9, 0xd2, 0xfb, 0x07, 0x37, 0x7c,
-	0xf0, 0x75, 0x66, 0xa1, 0x93, 0x99, 0x85, 0x7e, 0xcc, 0x2c, 0xf4, 0x61, 0x6e, 0xb5, 0x4e, 0xe6,
-	0x56, 0xeb, 0xfb, 0xdc, 0x6a, 0x3d, 0xdb, 0x0a, 0x42, 0xf1, 0x32, 0x1d, 0xbb, 0x3e, 0x8d, 0x3c,
-	0x92, 0x44, 0x64, 0x42, 0x58, 0x42, 0x5f, 0x81, 0x2f, 0xca, 0x93, 0x57, 0x7e, 0xf7, 0xc6, 0x2b,
-	0x92, 0x7c, 0xf7, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0x8b, 0x50, 0xc7, 0x09, 0x05, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xe9, 0x2e, 0x20, 0x8c, 0x28, 0xec, 0x28, 0x9b, 0x52, 0xa0, 0x5d, 0xab, 0x02, 0x12,
+	0xd2, 0x06, 0xbc, 0x18, 0x0e, 0x26, 0x2e, 0x10, 0x6e, 0x68, 0x20, 0x5c, 0xbc, 0x98, 0xa1, 0x3b,
+	0xae, 0x95, 0xdd, 0x99, 0x32, 0x9d, 0x12, 0x37, 0x86, 0x8b, 0x7f, 0x81, 0x89, 0x7f, 0x88, 0x37,
+	0x3d, 0x78, 0x36, 0xf1, 0x48, 0xa2, 0x07, 0x4f, 0x8d, 0x01, 0x4f, 0xfd, 0x2b, 0x4c, 0x67, 0x5a,
+	0x32, 0x5d, 0x58, 0x11, 0x4d, 0x48, 0xbc, 0x75, 0xde, 0x8f, 0xf9, 0xbc, 0x99, 0xf7, 0xe6, 0xbb,
+	0x0b, 0xaa, 0xc1, 0x6e, 0xd3, 0x45, 0x81, 0xef, 0xe2, 0x57, 0xd8, 0x8b, 0x38, 0x65, 0x4e, 0xc0,
+	0x28, 0xa7, 0xb0, 0x8c, 0x02, 0xdf, 0x98, 0x6a, 0x52, 0xda, 0x6c, 0x61, 0xe1, 0x47, 0x84, 0x50,
+	0x8e, 0xb8, 0x4f, 0x49, 0x28, 0x43, 0x8c, 0xc9, 0xcc, 0x2b, 0x56, 0x3b, 0xd1, 0x73, 0x17, 0xb7,
+	0x03, 0xde, 0x91, 0x4e, 0xfb, 0x93, 0x06, 0xa6, 0xd7, 0xb2, 0x2d, 0xb7, 0x30, 0xe7, 0x3e, 0x69,
+	0x86, 0xdb, 0x41, 0x88, 0x19, 0xdf, 0xc4, 0x7b, 0x11, 0x0e, 0x39, 0x9c, 0x01, 0xfd, 0x04, 0xb5,
+	0xb1, 0xae, 0xd5, 0xb4, 0xb9, 0xe1, 0x3a, 0x4c, 0x62, 0xeb, 0x7a, 0xba, 0x5e, 0xa0, 0x6d, 0x9f,
+	0x8b, 0x9d, 0x36, 0x85, 0x1f, 0x2e, 0x81, 0x21, 0x8f, 0xb2, 0x06, 0x25, 0xb8, 0xa1, 0x97, 0x6a,
+	0xda, 0xdc, 0x50, 0xbd, 0x9a, 0xc4, 0x16, 0xcc, 0x6d, 0x4a, 0xfc, 0x49, 0x1c, 0x7c, 0x08, 0x46,
+	0xe4, 0xf7, 0x26, 0x46, 0x21, 0x25, 0x7a, 0x59, 0x30, 0x8c, 0x24, 0xb6, 0xaa, 0xaa, 0x5d, 0xc9,
+	0x2d, 0xc4, 0xdb, 0xeb, 0xa7, 0x8b, 0x5f, 0xc5, 0x2d, 0xcc, 0xf1, 0x05, 0x8b, 0xb7, 0x3f, 0x6a,
+	0xa0, 0x9a, 0xef, 0xf4, 0x84, 0xe1, 0xd4, 0x75, 0xd1, 0xf3, 0x2f, 0x80, 0xc1, 0xbd, 0x08, 0x47,
+	0x38, 0xd4, 0x4b, 0xb5, 0xf2, 0xdc, 0x70, 0xfd, 0x66, 0x12, 0x5b, 0x63, 0xd2, 0xa2, 0xc4, 0x66,
+	0x31, 0x70, 0x1d, 0x8c, 0x06, 0xcc, 0xa7, 0xcc, 0xe7, 0x9d, 0x95, 0x16, 0x0a, 0x43, 0x1c, 0xea,
+	0x65, 0x91, 0x36, 0x9d, 0xc4, 0xd6, 0x44, 0x97, 0x4b, 0xc9, 0xef, 0xce, 0xb2, 0x3f, 0x68, 0x60,
+	0x3c, 0xaf, 0x7c, 0x05, 0x11, 0x0f, 0xb7, 0xfe, 0x93, 0xc2, 0xdf, 0x97, 0x81, 0x9e, 0x17, 0xbe,
+	0x41, 0x1b, 0x78, 0x95, 0x21, 0x9f, 0xfc, 0xc5, 0xd0, 0x11, 0xda, 0xc0, 0x1b, 0x69, 0x6c, 0x49,
+	0xc4, 0x8a, 0xa1, 0xcb, 0x6d, 0xea, 0xd0, 0xe5, 0x36, 0x48, 0xc1, 0x48, 0xfa, 0xbd, 0x85, 0x5b,
+	0xd8, 0xe3, 0x94, 0x89, 0xf2, 0xaf, 0x2e, 0xb9, 0x0e, 0x0a, 0x7c, 0xa7, 0x57, 0x41, 0xce, 0x86,
+	0x92, 0xb1, 0x46, 0x38, 0xeb, 0xc8, 0x29, 0x55, 0x37, 0x52, 0xa7, 0x54, 0xb5, 0x43, 0x17, 0x5c,
+	0x09, 0xe4, 0x4c, 0xe9, 0xfd, 0xe2, 0x61, 0x8c, 0x27, 0xb1, 0x55, 0xc9, 0x4c, 0x4a, 0x56, 0x1e,
+	0x95, 0x76, 0x84, 0xc9, 0x07, 0x31, 0x20, 0xce, 0x24, 0x3a, 0xc2, 0xba, 0x9f, 0x42, 0x16, 0x63,
+	0x34, 0x41, 0xe5, 0x54, 0x75, 0xf0, 0x36, 0x28, 0xef, 0xe2, 0x4e, 0x76, 0x7f, 0x95, 0x24, 0xb6,
+	0xae, 0xed, 0xe2, 0x8e, 0x92, 0x9c, 0x7a, 0xe1, 0x3d, 0x30, 0xb0, 0x8f, 0x5a, 0x51, 0x7e, 0x75,
+	0x37, 0x92, 0xd8, 0x1a, 0x15, 0x06, 0x25, 0x50, 0x46, 0x2c, 0x97, 0x1e, 0x68, 0xf6, 0xb7, 0x12,
+	0x30, 0xd4, 0x0b, 0xda, 0x26, 0x8d, 0xcb, 0xea, 0xd9, 0xde, 0x99, 0x3d, 0x5b, 0x3c, 0xd5, 0xb3,
+	0x62, 0x49, 0xff, 0xd2, 0xb5, 0x4b, 0xbb, 0xd6, 0xa5, 0xcf, 0x03, 0x60, 0x28, 0x3f, 0x03, 0x3c,
+	0x00, 0x55, 0x29, 0xbf, 0xdd, 0xba, 0x06, 0xed, 0xc2, 0x61, 0xcf, 0xd4, 0x6a, 0xa3, 0xea, 0x48,
+	0xad, 0x77, 0x72, 0xad, 0x77, 0xd6, 0x52, 0x9a, 0x3d, 0xfb, 0xe6, 0xeb, 0xcf, 0x77, 0xa5, 0x5b,
+	0xc6, 0x94, 0xbb, 0xbf, 0x78, 0xf2, 0x0b, 0xf2, 0x2c, 0xcc, 0xf6, 0x70, 0x5f, 0xa7, 0x7d, 0x39,
+	0x58, 0xd6, 0xe6, 0x53, 0xbc, 0x14, 0xd0, 0x3f, 0xc4, 0x17, 0xd4, 0xf6, 0x3c, 0xfc, 0xfc, 0xb9,
+	0xf8, 0x00, 0x54, 0x32, 0xf5, 0x7d, 0x4c, 0x4e, 0xae, 0x64, 0xb2, 0x40, 0x2e, 0xaa, 0x73, 0x4f,
+	0xe4, 0x8c, 0x40, 0xd6, 0xec, 0x49, 0x15, 0xe9, 0x66, 0x0f, 0x4c, 0x21, 0xb6, 0xc1, 0x98, 0x54,
+	0x4d, 0x05, 0x68, 0x14, 0x80, 0x05, 0x51, 0xed, 0xc9, 0xbb, 0x2b, 0x78, 0x96, 0x6d, 0x14, 0x78,
+	0x9e, 0xc8, 0x55, 0x70, 0x3e, 0x00, 0x42, 0x56, 0xd2, 0xc9, 0x0a, 0xe1, 0xf4, 0x6f, 0x35, 0xa7,
+	0x27, 0xeb, 0x8e, 0x60, 0x99, 0xf6, 0x44, 0x81, 0x25, 0x06, 0x5f, 0x41, 0x51, 0x30, 0x92, 0xbd,
+	0x06, 0x09, 0xb3, 0xce, 0x79, 0x2c, 0x17, 0xbc, 0xca, 0x88, 0x74, 0x01, 0xeb, 0x8f, 0xbe, 0x1c,
+	0x99, 0xda, 0xe1, 0x91, 0xa9, 0xfd, 0x38, 0x32, 0xb5, 0xb7, 0xc7, 0x66, 0xdf, 0xe1, 0xb1, 0xd9,
+	0xf7, 0xfd, 0xd8, 0xec, 0x7b, 0x3a, 0xdb, 0xf4, 0xf9, 0x8b, 0x68, 0xc7, 0xf1, 0x68, 0xdb, 0x45,
+	0xac, 0x8d, 0x1a, 0x28, 0x60, 0xf4, 0x25, 0xf6, 0x78, 0xb6, 0x72, 0xb3, 0x3f, 0x37, 0x3b, 0x83,
+	0x02, 0x7d, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0xec, 0xfc, 0x59, 0xee, 0x08, 0x00,
 	0x00,
 }
 
@@ -317,6 +475,8 @@ type ExecutorClient interface {
 	DeleteExecutorSettings(ctx context.Context, in *ExecutorSettingsDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	PreemptOnExecutor(ctx context.Context, in *ExecutorPreemptRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CancelOnExecutor(ctx context.Context, in *ExecutorCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DrainNodes(ctx context.Context, in *ExecutorNodeDrainRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UndrainNodes(ctx context.Context, in *ExecutorNodeUndrainRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type executorClient struct {
@@ -363,12 +523,32 @@ func (c *executorClient) CancelOnExecutor(ctx context.Context, in *ExecutorCance
 	return out, nil
 }
 
+func (c *executorClient) DrainNodes(ctx context.Context, in *ExecutorNodeDrainRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Executor/DrainNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) UndrainNodes(ctx context.Context, in *ExecutorNodeUndrainRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Executor/UndrainNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorServer is the server API for Executor service.
 type ExecutorServer interface {
 	UpsertExecutorSettings(context.Context, *ExecutorSettingsUpsertRequest) (*types.Empty, error)
 	DeleteExecutorSettings(context.Context, *ExecutorSettingsDeleteRequest) (*types.Empty, error)
 	PreemptOnExecutor(context.Context, *ExecutorPreemptRequest) (*types.Empty, error)
 	CancelOnExecutor(context.Context, *ExecutorCancelRequest) (*types.Empty, error)
+	DrainNodes(context.Context, *ExecutorNodeDrainRequest) (*types.Empty, error)
+	UndrainNodes(context.Context, *ExecutorNodeUndrainRequest) (*types.Empty, error)
 }
 
 // UnimplementedExecutorServer can be embedded to have forward compatible implementations.
@@ -387,6 +567,12 @@ func (*UnimplementedExecutorServer) PreemptOnExecutor(ctx context.Context, req *
 func (*UnimplementedExecutorServer) CancelOnExecutor(ctx context.Context, req *ExecutorCancelRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOnExecutor not implemented")
 }
+func (*UnimplementedExecutorServer) DrainNodes(ctx context.Context, req *ExecutorNodeDrainRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainNodes not implemented")
+}
+func (*UnimplementedExecutorServer) UndrainNodes(ctx context.Context, req *ExecutorNodeUndrainRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndrainNodes not implemented")
+}
 
 func RegisterExecutorServer(s *grpc.Server, srv ExecutorServer) {
 	s.RegisterService(&_Executor_serviceDesc, srv)
@@ -464,6 +650,42 @@ func _Executor_CancelOnExecutor_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Executor_DrainNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutorNodeDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).DrainNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Executor/DrainNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).DrainNodes(ctx, req.(*ExecutorNodeDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_UndrainNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutorNodeUndrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).UndrainNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Executor/UndrainNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).UndrainNodes(ctx, req.(*ExecutorNodeUndrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Executor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Executor",
 	HandlerType: (*ExecutorServer)(nil),
@@ -484,6 +706,14 @@ var _Executor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOnExecutor",
 			Handler:    _Executor_CancelOnExecutor_Handler,
 		},
+		{
+			MethodName: "DrainNodes",
+			Handler:    _Executor_DrainNodes_Handler,
+		},
+		{
+			MethodName: "UndrainNodes",
+			Handler:    _Executor_UndrainNodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/executor.proto",
@@ -662,6 +892,135 @@ func (m *ExecutorCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutorNodeDrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorNodeDrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorNodeDrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintExecutor(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutor(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutor(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorNodeUndrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorNodeUndrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorNodeUndrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintExecutor(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutor(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutor(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintExecutor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovExecutor(v)
 	base := offset
@@ -756,6 +1115,63 @@ func (m *ExecutorCancelRequest) Size() (n int) {
 	return n
 }
 
+func (m *ExecutorNodeDrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutor(uint64(len(k))) + 1 + len(v) + sovExecutor(uint64(len(v)))
+			n += mapEntrySize + 1 + sovExecutor(uint64(mapEntrySize))
+		}
+	}
+	if m.Preempt {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutorNodeUndrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutor(uint64(len(k))) + 1 + len(v) + sovExecutor(uint64(len(v)))
+			n += mapEntrySize + 1 + sovExecutor(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func sovExecutor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1270,6 +1686,540 @@ func (m *ExecutorCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecutorNodeDrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorNodeDrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorNodeDrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutor(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempt = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorNodeUndrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorNodeUndrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorNodeUndrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutor(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthExecutor
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipExecutor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -88,6 +88,9 @@ type JobRunDetails struct {
 	PendingTs  *types.Timestamp `protobuf:"bytes,8,opt,name=pending_ts,json=pendingTs,proto3" json:"pendingTs,omitempty"`
 	StartedTs  *types.Timestamp `protobuf:"bytes,9,opt,name=started_ts,json=startedTs,proto3" json:"startedTs,omitempty"`
 	FinishedTs *types.Timestamp `protobuf:"bytes,10,opt,name=finished_ts,json=finishedTs,proto3" json:"finishedTs,omitempty"`
+	Pool       string           `protobuf:"bytes,11,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Exit code of the run's main container. Only meaningful if the run finished after its container exited.
+	ExitCode int32 `protobuf:"varint,12,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
 }
 
 func (m *JobRunDetails) Reset()         { *m = JobRunDetails{} }
@@ -186,6 +189,20 @@ func (m *JobRunDetails) GetFinishedTs() *types.Timestamp {
 	return nil
 }
 
+func (m *JobRunDetails) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *JobRunDetails) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 type JobDetails struct {
 	JobId            string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	Queue            string           `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
@@ -878,6 +895,177 @@ func (m *JobStatusResponse) GetJobStates() map[string]JobState {
 	return nil
 }
 
+type ListJobsRequest struct {
+	// Only jobs in this queue are returned. If empty, jobs in all queues the caller may watch are returned.
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Only jobs in this job set are returned. Requires queue to be set.
+	Jobset string `protobuf:"bytes,2,opt,name=jobset,proto3" json:"jobset,omitempty"`
+	// Only jobs in one of these states are returned. If empty, jobs in any state are returned.
+	States []JobState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=api.JobState" json:"states,omitempty"`
+	// Only jobs with all of these labels are returned.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only jobs with all of these annotations are returned.
+	Annotations map[string]string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only jobs submitted at or after this time are returned.
+	SubmittedAfter *types.Timestamp `protobuf:"bytes,6,opt,name=submitted_after,json=submittedAfter,proto3" json:"submittedAfter,omitempty"`
+	// Only jobs submitted before this time are returned.
+	SubmittedBefore *types.Timestamp `protobuf:"bytes,7,opt,name=submitted_before,json=submittedBefore,proto3" json:"submittedBefore,omitempty"`
+	// Maximum number of jobs to return. Defaults to, and is capped at, the server's maximum number of query items.
+	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"pageSize,omitempty"`
+	// Token returned as next_page_token by a previous request with the same filters; used to get the next page of jobs.
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{14}
+}
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(m, src)
+}
+func (m *ListJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
+
+func (m *ListJobsRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *ListJobsRequest) GetJobset() string {
+	if m != nil {
+		return m.Jobset
+	}
+	return ""
+}
+
+func (m *ListJobsRequest) GetStates() []JobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetSubmittedAfter() *types.Timestamp {
+	if m != nil {
+		return m.SubmittedAfter
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetSubmittedBefore() *types.Timestamp {
+	if m != nil {
+		return m.SubmittedBefore
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJobsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListJobsResponse struct {
+	// Jobs matching the request, most recently submitted first. Job specs aren't included.
+	Jobs []*JobDetails `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// Token to pass as page_token to get the next page of jobs. Empty if there are no more jobs.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()    {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e45f6b75bfad87a4, []int{15}
+}
+func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsResponse.Merge(m, src)
+}
+func (m *ListJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsResponse proto.InternalMessageInfo
+
+func (m *ListJobsResponse) GetJobs() []*JobDetails {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *ListJobsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("api.JobRunState", JobRunState_name, JobRunState_value)
 	proto.RegisterType((*JobRunDetails)(nil), "api.JobRunDetails")
@@ -899,111 +1087,134 @@ func init() {
 	proto.RegisterType((*JobStatusUsingExternalJobUriRequest)(nil), "api.JobStatusUsingExternalJobUriRequest")
 	proto.RegisterType((*JobStatusResponse)(nil), "api.JobStatusResponse")
 	proto.RegisterMapType((map[string]JobState)(nil), "api.JobStatusResponse.JobStatesEntry")
+	proto.RegisterType((*ListJobsRequest)(nil), "api.ListJobsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ListJobsRequest.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.ListJobsRequest.LabelsEntry")
+	proto.RegisterType((*ListJobsResponse)(nil), "api.ListJobsResponse")
 }
 
 func init() { proto.RegisterFile("pkg/api/job.proto", fileDescriptor_e45f6b75bfad87a4) }
 
 var fileDescriptor_e45f6b75bfad87a4 = []byte{
-	// 1569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0xe5, 0xbf, 0x7a, 0xb2, 0x6c, 0x7a, 0xfc, 0x4f, 0x56, 0xbc, 0xa6, 0x97, 0xc1, 0x26,
-	0x8e, 0x91, 0x48, 0x58, 0xef, 0x2e, 0x90, 0xf5, 0x26, 0xd8, 0xda, 0x16, 0x13, 0xd8, 0x4d, 0x15,
-	0x97, 0xb6, 0xd0, 0xa0, 0x68, 0x21, 0x90, 0xd2, 0xc4, 0xa1, 0x22, 0x91, 0x0c, 0x87, 0x34, 0x62,
-	0xa0, 0x87, 0xa2, 0x40, 0x0f, 0xb9, 0xb5, 0xe8, 0x97, 0xe8, 0x27, 0x29, 0xda, 0x43, 0x81, 0x00,
-	0xed, 0x21, 0x27, 0xa1, 0x48, 0x0a, 0x14, 0xd0, 0xa9, 0xdf, 0xa0, 0x05, 0x67, 0x48, 0x6a, 0x86,
-	0xb2, 0x2b, 0x27, 0x47, 0xfd, 0xde, 0x7b, 0xbf, 0x79, 0xf3, 0xfe, 0xcd, 0xa3, 0x60, 0xce, 0x7d,
-	0x7a, 0x52, 0x36, 0x5c, 0xab, 0xdc, 0x72, 0xcc, 0x92, 0xeb, 0x39, 0xbe, 0x83, 0x46, 0x0d, 0xd7,
-	0x2a, 0x2e, 0xc4, 0x38, 0x09, 0xcc, 0x8e, 0xe5, 0x33, 0x51, 0x51, 0x39, 0x71, 0x9c, 0x93, 0x36,
-	0x2e, 0xd3, 0x5f, 0x66, 0xf0, 0xb8, 0xec, 0x5b, 0x1d, 0x4c, 0x7c, 0xa3, 0xe3, 0x46, 0x0a, 0xab,
-	0x91, 0x42, 0x68, 0x69, 0xd8, 0xb6, 0xe3, 0x1b, 0xbe, 0xe5, 0xd8, 0x84, 0x49, 0xd5, 0x1f, 0xc7,
-	0x20, 0x7f, 0xe0, 0x98, 0x7a, 0x60, 0x57, 0xb0, 0x6f, 0x58, 0x6d, 0x82, 0x36, 0x61, 0xc2, 0x0b,
-	0xec, 0xba, 0xd5, 0x2c, 0x48, 0xeb, 0xd2, 0x46, 0x76, 0x77, 0xbe, 0xd7, 0x55, 0x66, 0xbd, 0xc0,
-	0xde, 0x6f, 0xde, 0x74, 0x3a, 0x96, 0x8f, 0x3b, 0xae, 0x7f, 0xa6, 0x8f, 0x53, 0x20, 0xd4, 0x6d,
-	0x39, 0x66, 0xa8, 0x9b, 0xe9, 0xeb, 0xb6, 0x1c, 0x53, 0xd4, 0xa5, 0x00, 0xfa, 0x1f, 0x8c, 0x13,
-	0xdf, 0xf0, 0x71, 0x61, 0x74, 0x5d, 0xda, 0x98, 0xd9, 0x92, 0x4b, 0x86, 0x6b, 0x95, 0xd8, 0xd1,
-	0x47, 0x21, 0xce, 0x8c, 0xa9, 0x0a, 0x6f, 0x4c, 0x01, 0x54, 0x86, 0xc9, 0x46, 0x3b, 0x20, 0x3e,
-	0xf6, 0x0a, 0x63, 0xf4, 0xa4, 0xc5, 0x5e, 0x57, 0x99, 0x8b, 0x20, 0x4e, 0x3d, 0xd6, 0x42, 0xd7,
-	0x60, 0xcc, 0x76, 0x9a, 0xb8, 0x30, 0x4e, 0xb5, 0x51, 0xaf, 0xab, 0xcc, 0x84, 0xbf, 0x39, 0x55,
-	0x2a, 0x47, 0x0f, 0x21, 0xdb, 0xc6, 0x06, 0xc1, 0xcd, 0xba, 0x4f, 0x0a, 0x93, 0xeb, 0xd2, 0x46,
-	0x6e, 0xab, 0x58, 0x62, 0x11, 0x2b, 0xc5, 0x21, 0x2d, 0x1d, 0xc7, 0x21, 0xdd, 0x5d, 0xea, 0x75,
-	0x15, 0xc4, 0x0c, 0x8e, 0x09, 0x47, 0x36, 0x15, 0x63, 0x48, 0x07, 0x70, 0xb1, 0xdd, 0xb4, 0xec,
-	0x93, 0x90, 0x71, 0x6a, 0x28, 0xe3, 0x72, 0xaf, 0xab, 0xcc, 0x47, 0x16, 0x02, 0x65, 0x36, 0x01,
-	0x43, 0x4e, 0xe2, 0x1b, 0x9e, 0xcf, 0xbc, 0xcc, 0x5e, 0x8e, 0x33, 0xb2, 0x10, 0x39, 0x13, 0x10,
-	0xd5, 0x20, 0xf7, 0xd8, 0xb2, 0x2d, 0xf2, 0x84, 0x91, 0xc2, 0x50, 0xd2, 0x42, 0xaf, 0xab, 0x2c,
-	0xc4, 0x26, 0x02, 0x2b, 0xf4, 0x51, 0xf5, 0xe7, 0x09, 0x80, 0x03, 0xc7, 0xe4, 0x8a, 0x29, 0x2a,
-	0x10, 0x69, 0x68, 0x81, 0xdc, 0x80, 0xf1, 0x67, 0x01, 0x0e, 0x30, 0x5f, 0x4b, 0x14, 0xe0, 0x55,
-	0x29, 0x80, 0x6e, 0x52, 0x5a, 0x82, 0x7d, 0x5a, 0x4c, 0xd9, 0xdd, 0x85, 0x5e, 0x57, 0x91, 0x19,
-	0xc2, 0x29, 0x47, 0x3a, 0xe8, 0x3f, 0x90, 0xb5, 0x8d, 0x0e, 0x26, 0xae, 0xd1, 0xc0, 0x51, 0xf9,
-	0xd0, 0x08, 0x25, 0x20, 0x1f, 0xa1, 0x04, 0x44, 0xb7, 0xe3, 0x82, 0x1d, 0xa7, 0x05, 0x9b, 0x8f,
-	0x0b, 0x76, 0x78, 0xb5, 0x3e, 0x82, 0x69, 0xd6, 0xa3, 0x51, 0xc6, 0x26, 0x86, 0x06, 0x77, 0xa5,
-	0xd7, 0x55, 0x16, 0x13, 0x1b, 0x21, 0xba, 0x39, 0x0e, 0x0e, 0xcb, 0xb5, 0x61, 0xd8, 0x0d, 0xdc,
-	0x7e, 0x8b, 0x72, 0x65, 0x06, 0x62, 0xb9, 0xc6, 0x18, 0xfa, 0x3f, 0xe4, 0x23, 0x42, 0x0f, 0x1b,
-	0xc4, 0xb1, 0x69, 0xc5, 0x66, 0x77, 0x8b, 0xbd, 0xae, 0xb2, 0xc4, 0x04, 0x3a, 0xc5, 0x39, 0xe3,
-	0x69, 0x1e, 0x47, 0x4f, 0x00, 0xb5, 0x0d, 0xe2, 0xd7, 0x7d, 0xcf, 0xb0, 0x89, 0x15, 0x8e, 0x96,
-	0xcb, 0xd5, 0xe8, 0x5a, 0xaf, 0xab, 0x14, 0x43, 0xcb, 0xe3, 0xc4, 0x50, 0x70, 0x51, 0x4e, 0xcb,
-	0xd0, 0x5d, 0xc8, 0xb7, 0x0d, 0x1f, 0x13, 0xbf, 0x1e, 0xcd, 0x27, 0xa0, 0xae, 0xd2, 0xd0, 0x31,
-	0x81, 0x9e, 0x9a, 0x52, 0x39, 0x0e, 0x46, 0xdb, 0x30, 0x15, 0x96, 0x22, 0x71, 0x71, 0xa3, 0x90,
-	0xa3, 0xee, 0x4d, 0xc5, 0x19, 0x65, 0xd3, 0xa4, 0xe5, 0x98, 0x47, 0x2e, 0x6e, 0xf0, 0xd3, 0x24,
-	0x82, 0x50, 0x85, 0xd9, 0x7a, 0x81, 0x4d, 0x0a, 0xd3, 0xeb, 0xa3, 0x1b, 0xb9, 0x2d, 0xc4, 0x8d,
-	0xaf, 0xa8, 0xd8, 0x13, 0x16, 0x3d, 0xb0, 0x49, 0x8a, 0x25, 0x84, 0xd0, 0x7f, 0x21, 0x17, 0xc5,
-	0x3a, 0x20, 0xd8, 0x2b, 0xe4, 0xa9, 0xfb, 0xb4, 0xad, 0x18, 0x5c, 0x23, 0xc2, 0x2c, 0x83, 0x3e,
-	0xaa, 0xfe, 0x20, 0xc1, 0x5c, 0xbf, 0xad, 0x74, 0xfc, 0x2c, 0xc0, 0xc4, 0x47, 0xb7, 0x60, 0x92,
-	0x75, 0x17, 0x29, 0x48, 0xeb, 0xa3, 0x5c, 0x1f, 0xec, 0x37, 0x49, 0xaa, 0x0f, 0xf6, 0x9b, 0x04,
-	0xed, 0xc1, 0x2c, 0x7e, 0xee, 0x1a, 0x76, 0xb3, 0x9e, 0x04, 0x22, 0x6c, 0xb5, 0xa9, 0xdd, 0x2b,
-	0xbd, 0xae, 0xb2, 0xcc, 0x44, 0x07, 0x03, 0x41, 0xc8, 0x0b, 0x02, 0xf4, 0x1e, 0xcc, 0x70, 0x24,
-	0x5e, 0x60, 0xd3, 0x16, 0x9c, 0x62, 0x15, 0x93, 0xa8, 0xea, 0x81, 0x50, 0x31, 0x3c, 0xae, 0xfe,
-	0x2e, 0x01, 0xe2, 0xef, 0x42, 0x5c, 0xc7, 0x26, 0x18, 0x99, 0x90, 0x0b, 0x19, 0x9b, 0x0c, 0xa6,
-	0x17, 0xca, 0x6d, 0x5d, 0x8f, 0xc3, 0x9c, 0xd2, 0xe6, 0x20, 0xcd, 0xf6, 0xbd, 0x33, 0x16, 0xc6,
-	0x56, 0x02, 0xf2, 0x61, 0xec, 0xa3, 0xc5, 0x53, 0x98, 0x4d, 0x19, 0xa2, 0xab, 0x30, 0xfa, 0x14,
-	0x9f, 0x45, 0xe3, 0x69, 0xae, 0xd7, 0x55, 0xf2, 0x4f, 0xf1, 0x19, 0x67, 0x1e, 0x4a, 0xd1, 0x36,
-	0x8c, 0x9f, 0x1a, 0xed, 0x68, 0x34, 0xe5, 0xb6, 0x66, 0x53, 0x5e, 0xb1, 0x61, 0x40, 0x35, 0xf8,
-	0x61, 0x40, 0x81, 0xed, 0xcc, 0x6d, 0x49, 0x7d, 0x91, 0x81, 0x45, 0xa1, 0x56, 0x92, 0x5b, 0x7b,
-	0x30, 0x1b, 0xc5, 0x31, 0x75, 0xf3, 0x5b, 0x83, 0x05, 0xc6, 0x5f, 0xbe, 0x8f, 0xb2, 0xfb, 0xd3,
-	0x14, 0xb6, 0x78, 0x9c, 0x4f, 0xa1, 0x20, 0x28, 0x7e, 0x46, 0xe3, 0x9f, 0x62, 0xb8, 0x5c, 0x20,
-	0xee, 0x8a, 0x81, 0x38, 0xaf, 0x0b, 0x86, 0xc5, 0x42, 0x83, 0x85, 0xd4, 0xad, 0x92, 0x62, 0x66,
-	0x7d, 0x2d, 0x14, 0x33, 0xdd, 0x33, 0x84, 0x62, 0x66, 0x88, 0xba, 0x03, 0xf2, 0x81, 0x63, 0x6a,
-	0x9e, 0xe7, 0x78, 0xef, 0xd8, 0x0f, 0xea, 0x2b, 0xd6, 0x54, 0x31, 0x47, 0x94, 0x91, 0x4f, 0x21,
-	0xac, 0x98, 0x3a, 0xa6, 0x68, 0x94, 0x8c, 0x7f, 0xc4, 0xf7, 0x14, 0x75, 0xfb, 0x08, 0x4b, 0x02,
-	0x7d, 0x55, 0x5a, 0x31, 0xc6, 0xbf, 0x2a, 0x09, 0x58, 0x6c, 0xc2, 0x8c, 0x68, 0x75, 0xb9, 0xc0,
-	0xdf, 0xe0, 0x03, 0x9f, 0x1d, 0x1a, 0x64, 0x16, 0x9d, 0xf0, 0xa5, 0x0a, 0xde, 0x35, 0x3a, 0x77,
-	0x60, 0x7a, 0xa7, 0xe1, 0x5b, 0xa7, 0xf8, 0xc3, 0xf0, 0xc9, 0x25, 0xe1, 0x9b, 0x4b, 0x1f, 0x5f,
-	0xc1, 0x9a, 0x21, 0xbc, 0x35, 0x43, 0xd4, 0x02, 0x2c, 0xdd, 0xc7, 0x3e, 0x4f, 0x10, 0xb9, 0xa1,
-	0x7e, 0x9b, 0x81, 0xe5, 0x01, 0x51, 0x14, 0xfb, 0x17, 0x12, 0x2c, 0x1a, 0x54, 0x50, 0x67, 0x3c,
-	0x75, 0xf3, 0xac, 0xee, 0x3a, 0x4e, 0x3b, 0xca, 0xc3, 0xbf, 0x69, 0x1e, 0x2e, 0xb0, 0x2e, 0xf1,
-	0xe0, 0xee, 0xd9, 0xa1, 0xe3, 0xb4, 0x59, 0x5a, 0xd6, 0x7b, 0x5d, 0x65, 0xd5, 0x18, 0x10, 0x72,
-	0x5e, 0xa3, 0x41, 0x69, 0xf1, 0x73, 0x09, 0x96, 0x2f, 0x60, 0xbc, 0x5c, 0xca, 0xee, 0x88, 0xbd,
-	0x32, 0x47, 0x7d, 0x17, 0x18, 0x87, 0x65, 0xf1, 0x3b, 0x09, 0xae, 0x26, 0x69, 0xac, 0x11, 0xcb,
-	0x3e, 0xd1, 0x9e, 0xfb, 0xd8, 0xb3, 0x8d, 0xf6, 0x81, 0x63, 0xd6, 0x3c, 0x2b, 0xce, 0x6c, 0xb2,
-	0x39, 0x49, 0x6f, 0xb1, 0x39, 0x65, 0x2e, 0xb1, 0x39, 0xdd, 0x03, 0x19, 0x47, 0x27, 0xd2, 0x71,
-	0x1f, 0x78, 0x56, 0xb4, 0x71, 0xad, 0xf6, 0xba, 0x4a, 0x01, 0x0b, 0xde, 0x70, 0xf6, 0x33, 0xa2,
-	0x44, 0xfd, 0x8d, 0x75, 0x5a, 0x5c, 0x8f, 0x62, 0xa7, 0xd1, 0x9d, 0x69, 0xa0, 0xd3, 0x44, 0xdd,
-	0x64, 0xef, 0x4a, 0x75, 0x1a, 0xc3, 0x52, 0x9d, 0xc6, 0xc0, 0x22, 0xa1, 0x9d, 0xc6, 0x59, 0x5d,
-	0x2e, 0x6d, 0xb7, 0xf9, 0xb4, 0x9d, 0xbf, 0xf6, 0x5d, 0x9c, 0xb2, 0xcd, 0x2f, 0x33, 0x90, 0xe3,
-	0x3e, 0x6a, 0xd0, 0x22, 0xcc, 0xe9, 0xb5, 0x6a, 0xfd, 0xe8, 0x78, 0xe7, 0x58, 0xab, 0xd7, 0xaa,
-	0xef, 0x57, 0x1f, 0x7e, 0x54, 0x95, 0x47, 0xd0, 0x02, 0xc8, 0x7d, 0xf8, 0x81, 0xb6, 0x73, 0xa4,
-	0x55, 0x64, 0x49, 0x54, 0x3e, 0xd4, 0xaa, 0x95, 0xfd, 0xea, 0x7d, 0x39, 0x23, 0xc2, 0x7a, 0xad,
-	0x5a, 0x0d, 0xe1, 0x51, 0xb4, 0x0c, 0xf3, 0x7d, 0xf8, 0xa8, 0xb6, 0xb7, 0xa7, 0x69, 0x15, 0xad,
-	0x22, 0x8f, 0x89, 0xe4, 0xf7, 0x76, 0xf6, 0x1f, 0x68, 0x15, 0x79, 0x5c, 0x54, 0x3f, 0xd4, 0x35,
-	0xed, 0x83, 0xc3, 0x63, 0xad, 0x22, 0x4f, 0x88, 0x82, 0xbd, 0x9d, 0xea, 0x9e, 0xf6, 0x20, 0xb4,
-	0x98, 0x44, 0x57, 0x60, 0x39, 0xe5, 0x64, 0x5d, 0x7b, 0x74, 0xb8, 0xaf, 0x6b, 0x15, 0x79, 0x0a,
-	0xfd, 0x0d, 0x56, 0xf4, 0x5a, 0xf5, 0x48, 0x90, 0xea, 0xda, 0x71, 0x4d, 0xaf, 0x6a, 0x15, 0x39,
-	0xbb, 0xf5, 0xc7, 0x18, 0x8c, 0x1d, 0x38, 0x26, 0x09, 0x77, 0xe1, 0xfb, 0xd8, 0x4f, 0x12, 0x8a,
-	0x16, 0xd3, 0x09, 0xa6, 0x25, 0x5c, 0x5c, 0x3a, 0x3f, 0xef, 0xea, 0xca, 0x17, 0x3f, 0xfd, 0xfa,
-	0x4d, 0x66, 0x5e, 0x9d, 0x29, 0x9f, 0xfe, 0x33, 0xfc, 0x20, 0x2e, 0x13, 0x2a, 0xdf, 0x96, 0x36,
-	0xd1, 0xd7, 0x12, 0x28, 0x3c, 0xf5, 0x39, 0x0d, 0x82, 0x36, 0x44, 0xda, 0x8b, 0x7b, 0xe8, 0x42,
-	0x07, 0x6e, 0x52, 0x07, 0xae, 0xa9, 0x7f, 0x17, 0x1d, 0x38, 0x87, 0x29, 0xf4, 0xe9, 0x13, 0xc8,
-	0x33, 0x97, 0xe2, 0x0f, 0xa0, 0xa5, 0x81, 0x05, 0x86, 0x1d, 0xb7, 0x7c, 0xc1, 0x62, 0xa3, 0x16,
-	0xe9, 0x79, 0x0b, 0xea, 0x6c, 0x7c, 0x5e, 0xb4, 0x16, 0x84, 0xec, 0x49, 0x2c, 0xd9, 0xf3, 0xd1,
-	0x8f, 0xa5, 0xf0, 0x0c, 0xf6, 0xaf, 0x22, 0xbe, 0x56, 0x83, 0xb1, 0x64, 0x6f, 0x5c, 0xc8, 0x8c,
-	0x41, 0x66, 0xcc, 0xdc, 0x1f, 0x01, 0x2b, 0xe7, 0x6d, 0x20, 0xec, 0x84, 0xe2, 0xc5, 0xcb, 0x89,
-	0x78, 0x01, 0x2f, 0xb0, 0xf9, 0x0b, 0xb4, 0x60, 0x36, 0x35, 0xbc, 0xd1, 0x95, 0xf3, 0x47, 0x3a,
-	0x3b, 0x67, 0xf5, 0xaf, 0xe6, 0xbd, 0xba, 0x4a, 0x4f, 0x5a, 0x52, 0xe7, 0xc2, 0x93, 0xd8, 0x7b,
-	0x51, 0x66, 0x83, 0x7c, 0x5b, 0xda, 0xdc, 0xdd, 0xf9, 0xfe, 0xf5, 0x9a, 0xf4, 0xf2, 0xf5, 0x9a,
-	0xf4, 0xcb, 0xeb, 0x35, 0xe9, 0xab, 0x37, 0x6b, 0x23, 0x2f, 0xdf, 0xac, 0x8d, 0xbc, 0x7a, 0xb3,
-	0x36, 0xf2, 0xf1, 0xf5, 0x13, 0xcb, 0x7f, 0x12, 0x98, 0xa5, 0x86, 0xd3, 0x29, 0x1b, 0x5e, 0xc7,
-	0x68, 0x1a, 0xae, 0xe7, 0xb4, 0x70, 0xc3, 0x8f, 0x7e, 0x95, 0xa3, 0x3f, 0x5a, 0xcc, 0x09, 0xfa,
-	0xdd, 0xf2, 0xaf, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xf8, 0xa4, 0x20, 0x92, 0x11, 0x00,
-	0x00,
+	// 1881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0xe3, 0xc6,
+	0x15, 0x5e, 0xda, 0x96, 0xd7, 0x3a, 0xb2, 0x2c, 0x7a, 0xd6, 0x17, 0x59, 0xeb, 0x98, 0x2a, 0x83,
+	0x26, 0xce, 0x62, 0x23, 0xa1, 0x4e, 0x2f, 0x5b, 0x27, 0x41, 0x2b, 0x5b, 0xcc, 0xc2, 0xae, 0xab,
+	0xb8, 0xb2, 0x85, 0x06, 0x45, 0x0b, 0x81, 0x94, 0xc6, 0x5e, 0x6a, 0x65, 0x52, 0xe1, 0x90, 0x8b,
+	0x75, 0xd0, 0x87, 0xa2, 0x40, 0x1f, 0xf2, 0x96, 0xa2, 0x7f, 0xa2, 0xe8, 0x0f, 0x29, 0xda, 0xb7,
+	0x14, 0xed, 0x43, 0x1e, 0x0a, 0xa1, 0xd8, 0x2d, 0x50, 0x40, 0x4f, 0xfd, 0x09, 0xc1, 0x5c, 0x48,
+	0xcd, 0x50, 0x72, 0xe4, 0x2c, 0xb0, 0x8f, 0xfc, 0xce, 0x39, 0xdf, 0xcc, 0x9c, 0xdb, 0x9c, 0x21,
+	0xac, 0x0e, 0x9e, 0x5e, 0x56, 0xed, 0x81, 0x5b, 0xed, 0xf9, 0x4e, 0x65, 0x10, 0xf8, 0xa1, 0x8f,
+	0xe6, 0xed, 0x81, 0x5b, 0x5a, 0x8b, 0x71, 0x12, 0x39, 0x57, 0x6e, 0xc8, 0x45, 0x25, 0xe3, 0xd2,
+	0xf7, 0x2f, 0xfb, 0xb8, 0xca, 0xbe, 0x9c, 0xe8, 0xa2, 0x1a, 0xba, 0x57, 0x98, 0x84, 0xf6, 0xd5,
+	0x40, 0x28, 0x6c, 0x0b, 0x05, 0x6a, 0x69, 0x7b, 0x9e, 0x1f, 0xda, 0xa1, 0xeb, 0x7b, 0x84, 0x4b,
+	0xcd, 0xbf, 0x64, 0x20, 0x7f, 0xec, 0x3b, 0xcd, 0xc8, 0xab, 0xe3, 0xd0, 0x76, 0xfb, 0x04, 0x3d,
+	0x80, 0xc5, 0x20, 0xf2, 0xda, 0x6e, 0xb7, 0xa8, 0x95, 0xb5, 0xdd, 0xec, 0xc1, 0xbd, 0xd1, 0xd0,
+	0x28, 0x04, 0x91, 0x77, 0xd4, 0x7d, 0xe8, 0x5f, 0xb9, 0x21, 0xbe, 0x1a, 0x84, 0xd7, 0xcd, 0x0c,
+	0x03, 0xa8, 0x6e, 0xcf, 0x77, 0xa8, 0xee, 0xdc, 0x58, 0xb7, 0xe7, 0x3b, 0xaa, 0x2e, 0x03, 0xd0,
+	0xfb, 0x90, 0x21, 0xa1, 0x1d, 0xe2, 0xe2, 0x7c, 0x59, 0xdb, 0x5d, 0xd9, 0xd3, 0x2b, 0xf6, 0xc0,
+	0xad, 0xf0, 0xa5, 0xcf, 0x28, 0xce, 0x8d, 0x99, 0x8a, 0x6c, 0xcc, 0x00, 0x54, 0x85, 0xbb, 0x9d,
+	0x7e, 0x44, 0x42, 0x1c, 0x14, 0x17, 0xd8, 0x4a, 0xeb, 0xa3, 0xa1, 0xb1, 0x2a, 0x20, 0x49, 0x3d,
+	0xd6, 0x42, 0x6f, 0xc1, 0x82, 0xe7, 0x77, 0x71, 0x31, 0xc3, 0xb4, 0xd1, 0x68, 0x68, 0xac, 0xd0,
+	0x6f, 0x49, 0x95, 0xc9, 0xd1, 0xc7, 0x90, 0xed, 0x63, 0x9b, 0xe0, 0x6e, 0x3b, 0x24, 0xc5, 0xbb,
+	0x65, 0x6d, 0x37, 0xb7, 0x57, 0xaa, 0x70, 0x8f, 0x55, 0x62, 0x97, 0x56, 0xce, 0x63, 0x97, 0x1e,
+	0x6c, 0x8c, 0x86, 0x06, 0xe2, 0x06, 0xe7, 0x44, 0x22, 0x5b, 0x8a, 0x31, 0xd4, 0x04, 0x18, 0x60,
+	0xaf, 0xeb, 0x7a, 0x97, 0x94, 0x71, 0x69, 0x26, 0xe3, 0xe6, 0x68, 0x68, 0xdc, 0x13, 0x16, 0x0a,
+	0x65, 0x36, 0x01, 0x29, 0x27, 0x09, 0xed, 0x20, 0xe4, 0xbb, 0xcc, 0xde, 0x8e, 0x53, 0x58, 0xa8,
+	0x9c, 0x09, 0x88, 0x5a, 0x90, 0xbb, 0x70, 0x3d, 0x97, 0x3c, 0xe1, 0xa4, 0x30, 0x93, 0xb4, 0x38,
+	0x1a, 0x1a, 0x6b, 0xb1, 0x89, 0xc2, 0x0a, 0x63, 0x94, 0xfa, 0x7d, 0xe0, 0xfb, 0xfd, 0x62, 0x6e,
+	0xec, 0x77, 0xfa, 0x2d, 0xfb, 0x9d, 0x7e, 0xa3, 0xf7, 0x20, 0x8b, 0x9f, 0xbb, 0x61, 0xbb, 0x43,
+	0x83, 0xb4, 0x5c, 0xd6, 0x76, 0x33, 0xdc, 0xb7, 0x14, 0x3c, 0x54, 0x03, 0xb5, 0x14, 0x63, 0xe6,
+	0xbf, 0x16, 0x01, 0x8e, 0x7d, 0x47, 0xca, 0x54, 0x91, 0x7d, 0xda, 0xcc, 0xec, 0x7b, 0x07, 0x32,
+	0x9f, 0x46, 0x38, 0xc2, 0x72, 0xa2, 0x32, 0x40, 0x56, 0x65, 0x00, 0x7a, 0xc8, 0x68, 0x09, 0x0e,
+	0x59, 0xa6, 0x66, 0x0f, 0xd6, 0x46, 0x43, 0x43, 0xe7, 0x88, 0xa4, 0x2c, 0x74, 0xd0, 0x0f, 0x20,
+	0xeb, 0xd9, 0x57, 0x98, 0x0c, 0xec, 0x0e, 0x16, 0xb9, 0xc9, 0xdc, 0x9f, 0x80, 0xb2, 0xfb, 0x13,
+	0x10, 0x3d, 0x8a, 0xab, 0x21, 0xc3, 0xaa, 0x21, 0x1f, 0x57, 0xc3, 0xec, 0x52, 0xf8, 0x04, 0x96,
+	0x79, 0x03, 0x10, 0xe9, 0xb0, 0x38, 0x33, 0x72, 0x5b, 0xa3, 0xa1, 0xb1, 0x9e, 0xd8, 0x28, 0xa1,
+	0xcb, 0x49, 0x30, 0xad, 0x85, 0x8e, 0xed, 0x75, 0x70, 0xff, 0x5b, 0xd4, 0x02, 0x37, 0x50, 0x6b,
+	0x21, 0xc6, 0xd0, 0x4f, 0x20, 0x2f, 0x08, 0x03, 0x6c, 0x13, 0xdf, 0x63, 0xe5, 0x90, 0x3d, 0x28,
+	0x8d, 0x86, 0xc6, 0x06, 0x17, 0x34, 0x19, 0x2e, 0x19, 0x2f, 0xcb, 0x38, 0x7a, 0x02, 0xa8, 0x6f,
+	0x93, 0xb0, 0x1d, 0x06, 0xb6, 0x47, 0x5c, 0xda, 0xb7, 0x6e, 0x57, 0x00, 0x3b, 0xa3, 0xa1, 0x51,
+	0xa2, 0x96, 0xe7, 0x89, 0xa1, 0xb2, 0x45, 0x3d, 0x2d, 0x43, 0x1f, 0x42, 0xbe, 0x6f, 0x87, 0x98,
+	0x84, 0x6d, 0xd1, 0xfc, 0x80, 0x6d, 0x95, 0xb9, 0x8e, 0x0b, 0x9a, 0xa9, 0x16, 0x98, 0x93, 0x60,
+	0xb4, 0x0f, 0x4b, 0x34, 0x15, 0xc9, 0x00, 0x77, 0x58, 0xea, 0xe7, 0xf6, 0x96, 0xe2, 0x88, 0xf2,
+	0x56, 0xd5, 0xf3, 0x9d, 0xb3, 0x01, 0xee, 0xc8, 0xad, 0x4a, 0x40, 0xa8, 0xce, 0x6d, 0x83, 0xc8,
+	0x23, 0xc5, 0xe5, 0xf2, 0xfc, 0x6e, 0x6e, 0x0f, 0x49, 0xbd, 0x51, 0x24, 0x7b, 0xc2, 0xd2, 0x8c,
+	0x3c, 0x92, 0x62, 0xa1, 0x10, 0xfa, 0x31, 0xe4, 0x84, 0xaf, 0x23, 0x82, 0x83, 0x62, 0x9e, 0x6d,
+	0x9f, 0xd5, 0x2c, 0x87, 0x5b, 0x44, 0x69, 0x94, 0x30, 0x46, 0xcd, 0xbf, 0x6b, 0xb0, 0x3a, 0x2e,
+	0xab, 0x26, 0xfe, 0x34, 0xc2, 0x24, 0x44, 0xef, 0xc2, 0x5d, 0x5e, 0x5d, 0xa4, 0xa8, 0x95, 0xe7,
+	0xa5, 0x3a, 0x38, 0xea, 0x92, 0x54, 0x1d, 0x1c, 0x75, 0x09, 0x3a, 0x84, 0x02, 0x7e, 0x3e, 0xb0,
+	0xbd, 0x6e, 0x3b, 0x71, 0x04, 0x2d, 0xb5, 0xa5, 0x83, 0xfb, 0xa3, 0xa1, 0xb1, 0xc9, 0x45, 0xc7,
+	0x13, 0x4e, 0xc8, 0x2b, 0x02, 0xf4, 0x53, 0x58, 0x91, 0x48, 0x82, 0xc8, 0x63, 0x25, 0xb8, 0xc4,
+	0x33, 0x26, 0x51, 0x6d, 0x46, 0x4a, 0xc6, 0xc8, 0xb8, 0xf9, 0x7f, 0x0d, 0x90, 0x7c, 0x16, 0x32,
+	0xf0, 0x3d, 0x82, 0x91, 0x03, 0x39, 0xca, 0xd8, 0xe5, 0x30, 0x3b, 0x50, 0x6e, 0xef, 0xed, 0xd8,
+	0xcd, 0x29, 0x6d, 0x09, 0xb2, 0xbc, 0x30, 0xb8, 0xe6, 0x6e, 0xec, 0x25, 0xa0, 0xec, 0xc6, 0x31,
+	0x5a, 0x7a, 0x06, 0x85, 0x94, 0x21, 0x7a, 0x13, 0xe6, 0x9f, 0xe2, 0x6b, 0xd1, 0x9e, 0x56, 0x47,
+	0x43, 0x23, 0xff, 0x14, 0x5f, 0x4b, 0xe6, 0x54, 0x8a, 0xf6, 0x21, 0xf3, 0xcc, 0xee, 0x8b, 0xd6,
+	0x94, 0xdb, 0x2b, 0xa4, 0x76, 0xc5, 0x9b, 0x01, 0xd3, 0x90, 0x9b, 0x01, 0x03, 0xf6, 0xe7, 0x1e,
+	0x69, 0xe6, 0xe7, 0x73, 0xb0, 0xae, 0xe4, 0x4a, 0x72, 0xea, 0x00, 0x0a, 0xc2, 0x8f, 0xa9, 0x93,
+	0xbf, 0x3b, 0x99, 0x60, 0xf2, 0xe1, 0xc7, 0x28, 0x3f, 0x3f, 0x0b, 0x61, 0x4f, 0xc6, 0xe5, 0x10,
+	0x2a, 0x82, 0xd2, 0x6f, 0x99, 0xff, 0x53, 0x0c, 0xb7, 0x73, 0xc4, 0x87, 0xaa, 0x23, 0xa6, 0x55,
+	0xc1, 0x2c, 0x5f, 0x58, 0xb0, 0x96, 0x3a, 0x55, 0x92, 0xcc, 0xbc, 0xae, 0x95, 0x64, 0x66, 0x43,
+	0x8c, 0x92, 0xcc, 0x1c, 0x31, 0x6b, 0xa0, 0x1f, 0xfb, 0x8e, 0x15, 0x04, 0x7e, 0xf0, 0x8a, 0xf5,
+	0x60, 0x7e, 0xc5, 0x8b, 0x2a, 0xe6, 0x10, 0x11, 0xf9, 0x0d, 0xd0, 0x8c, 0x69, 0x63, 0x86, 0x8a,
+	0x60, 0x7c, 0x37, 0x3e, 0xa7, 0xaa, 0x3b, 0x46, 0x78, 0x10, 0xd8, 0xad, 0xd2, 0x8b, 0x31, 0xf9,
+	0x56, 0x49, 0xc0, 0x52, 0x17, 0x56, 0x54, 0xab, 0xdb, 0x39, 0xfe, 0x1d, 0xd9, 0xf1, 0xd9, 0x99,
+	0x4e, 0xe6, 0xde, 0xa1, 0x37, 0x55, 0xf4, 0xaa, 0xde, 0xf9, 0x00, 0x96, 0x6b, 0x9d, 0xd0, 0x7d,
+	0x86, 0x7f, 0x41, 0xaf, 0x5c, 0x42, 0xef, 0x5c, 0x76, 0xf9, 0x2a, 0xd6, 0x1c, 0x91, 0xad, 0x39,
+	0x62, 0x16, 0x61, 0xe3, 0x31, 0x0e, 0x65, 0x02, 0xb1, 0x0d, 0xf3, 0xcf, 0x73, 0xb0, 0x39, 0x21,
+	0x12, 0xbe, 0xff, 0x5c, 0x83, 0x75, 0x9b, 0x09, 0xda, 0x9c, 0xa7, 0xed, 0x5c, 0xb7, 0xd9, 0xb0,
+	0xc2, 0xe3, 0xf0, 0x7d, 0x16, 0x87, 0x1b, 0xac, 0x2b, 0x32, 0x78, 0x70, 0x7d, 0xea, 0xfb, 0x7d,
+	0x1e, 0x96, 0xf2, 0x68, 0x68, 0x6c, 0xdb, 0x13, 0x42, 0x69, 0xd7, 0x68, 0x52, 0x5a, 0xfa, 0x9d,
+	0x06, 0x9b, 0x37, 0x30, 0xde, 0x2e, 0x64, 0x1f, 0xa8, 0xb5, 0xb2, 0xca, 0xf6, 0xae, 0x30, 0xce,
+	0x8a, 0xe2, 0x5f, 0x35, 0x78, 0x33, 0x09, 0x63, 0x8b, 0xb8, 0xde, 0xa5, 0xf5, 0x3c, 0xc4, 0x81,
+	0x67, 0xf7, 0x8f, 0x7d, 0xa7, 0x15, 0xb8, 0x71, 0x64, 0x93, 0xc9, 0x49, 0xfb, 0x16, 0x93, 0xd3,
+	0xdc, 0x2d, 0x26, 0xa7, 0x8f, 0x40, 0xc7, 0x62, 0x45, 0xd6, 0xee, 0xa3, 0xc0, 0x15, 0x13, 0xd7,
+	0xf6, 0x68, 0x68, 0x14, 0xb1, 0xb2, 0x1b, 0xc9, 0x7e, 0x45, 0x95, 0x98, 0xff, 0xe3, 0x95, 0x16,
+	0xe7, 0xa3, 0x5a, 0x69, 0x6c, 0x66, 0x9a, 0xa8, 0x34, 0x55, 0x37, 0x99, 0xbb, 0x52, 0x95, 0xc6,
+	0xb1, 0x54, 0xa5, 0x71, 0xb0, 0x44, 0x58, 0xa5, 0x49, 0x56, 0xb7, 0x0b, 0xdb, 0x23, 0x39, 0x6c,
+	0xd3, 0xc7, 0xbe, 0x6f, 0x08, 0xd9, 0xbf, 0x17, 0xa1, 0x70, 0xe2, 0x92, 0xf0, 0xd8, 0x77, 0xc8,
+	0x6b, 0x0f, 0xcf, 0xfb, 0xb0, 0x28, 0x9c, 0x37, 0x5f, 0x9e, 0x9f, 0xdc, 0x2b, 0x33, 0x26, 0x69,
+	0x0f, 0x09, 0x13, 0xd4, 0x80, 0xc5, 0xbe, 0xed, 0xe0, 0x3e, 0x29, 0x2e, 0x30, 0xcf, 0x97, 0x99,
+	0x71, 0x6a, 0xef, 0x95, 0x13, 0xa6, 0xc2, 0x9d, 0xce, 0xf8, 0xb8, 0x8d, 0xcc, 0xc7, 0x11, 0x7a,
+	0x7f, 0x4b, 0x6f, 0xd7, 0x62, 0x46, 0x0a, 0x67, 0x9a, 0xb4, 0x36, 0xd6, 0xe3, 0xcc, 0x6c, 0x86,
+	0x93, 0xac, 0xe5, 0x19, 0x4e, 0x82, 0x91, 0x0d, 0x85, 0xf1, 0x60, 0x6d, 0x5f, 0xd0, 0xb7, 0xe6,
+	0xec, 0xd9, 0x9a, 0xa5, 0x6a, 0x62, 0x56, 0xbb, 0x50, 0x9f, 0xa3, 0x2b, 0xaa, 0x04, 0x75, 0x41,
+	0x1f, 0x2f, 0xe1, 0xe0, 0x0b, 0x3f, 0xc0, 0xb7, 0x18, 0xb4, 0xdf, 0x18, 0x0d, 0x8d, 0xad, 0xc4,
+	0xee, 0x80, 0x99, 0x49, 0x8b, 0x14, 0x52, 0x22, 0xfa, 0xb6, 0x1a, 0xd8, 0x97, 0xb8, 0x4d, 0xdc,
+	0xcf, 0x30, 0x1b, 0xb9, 0xc5, 0xdb, 0x8a, 0x82, 0x67, 0xee, 0x67, 0xca, 0xdb, 0x2a, 0xc6, 0xd0,
+	0x0f, 0x01, 0x98, 0x51, 0xe8, 0x3f, 0xc5, 0x1e, 0x1b, 0xb1, 0xc5, 0x43, 0x86, 0xa2, 0xe7, 0x14,
+	0x54, 0xde, 0xa6, 0x31, 0x58, 0xb2, 0x21, 0x27, 0x85, 0xf1, 0x75, 0xdc, 0x37, 0xa5, 0x0b, 0xd0,
+	0xd3, 0x41, 0x7d, 0x2d, 0xf7, 0xda, 0x17, 0x1a, 0xe8, 0xe3, 0x6c, 0x12, 0x7d, 0xe4, 0x47, 0xb0,
+	0x40, 0x0b, 0x42, 0x74, 0x90, 0x89, 0xe1, 0x8c, 0xbd, 0x70, 0xa9, 0x82, 0xfc, 0xc2, 0xa5, 0xdf,
+	0x74, 0x20, 0xf6, 0xf0, 0xf3, 0xb0, 0x2d, 0x79, 0x95, 0x6f, 0x83, 0x4d, 0x53, 0x54, 0x74, 0x3a,
+	0xc5, 0xb3, 0x79, 0x45, 0xf0, 0xe0, 0x0f, 0x73, 0x90, 0x93, 0xfe, 0x91, 0xa0, 0x75, 0x58, 0x6d,
+	0xb6, 0x1a, 0xed, 0xb3, 0xf3, 0xda, 0xb9, 0xd5, 0x6e, 0x35, 0x7e, 0xd6, 0xf8, 0xf8, 0x97, 0x0d,
+	0xfd, 0x0e, 0x5a, 0x03, 0x7d, 0x0c, 0x9f, 0x58, 0xb5, 0x33, 0xab, 0xae, 0x6b, 0xaa, 0xf2, 0xa9,
+	0xd5, 0xa8, 0x1f, 0x35, 0x1e, 0xeb, 0x73, 0x2a, 0xdc, 0x6c, 0x35, 0x1a, 0x14, 0x9e, 0x47, 0x9b,
+	0x70, 0x6f, 0x0c, 0x9f, 0xb5, 0x0e, 0x0f, 0x2d, 0xab, 0x6e, 0xd5, 0xf5, 0x05, 0x95, 0xfc, 0xa3,
+	0xda, 0xd1, 0x89, 0x55, 0xd7, 0x33, 0xaa, 0xfa, 0x69, 0xd3, 0xb2, 0x7e, 0x7e, 0x7a, 0x6e, 0xd5,
+	0xf5, 0x45, 0x55, 0x70, 0x58, 0x6b, 0x1c, 0x5a, 0x27, 0xd4, 0xe2, 0x2e, 0xba, 0x0f, 0x9b, 0xa9,
+	0x4d, 0xb6, 0xad, 0x4f, 0x4e, 0x8f, 0x9a, 0x56, 0x5d, 0x5f, 0x42, 0x6f, 0xc0, 0x56, 0xb3, 0xd5,
+	0x38, 0x53, 0xa4, 0x4d, 0xeb, 0xbc, 0xd5, 0x6c, 0x58, 0x75, 0x3d, 0xbb, 0xf7, 0x8f, 0x0c, 0x2c,
+	0xd0, 0xb0, 0xd0, 0xd7, 0xef, 0x63, 0x1c, 0x26, 0x2d, 0x1c, 0xad, 0xa7, 0x5b, 0x3a, 0x6b, 0x02,
+	0xa5, 0x8d, 0xe9, 0x9d, 0xde, 0xdc, 0xfa, 0xfd, 0x3f, 0xff, 0xfb, 0xa7, 0xb9, 0x7b, 0xe6, 0x4a,
+	0xf5, 0xd9, 0xf7, 0xaa, 0x3d, 0xdf, 0xa9, 0x12, 0x26, 0xdf, 0xd7, 0x1e, 0xa0, 0x3f, 0x6a, 0x60,
+	0xc8, 0xd4, 0x53, 0xae, 0x44, 0xb4, 0xab, 0xd2, 0xde, 0x7c, 0x6b, 0xde, 0xb8, 0x81, 0x87, 0x6c,
+	0x03, 0x6f, 0x99, 0xdf, 0x51, 0x37, 0x30, 0x85, 0x89, 0xee, 0xe9, 0xd7, 0x90, 0xe7, 0x5b, 0x8a,
+	0x7f, 0x79, 0x6c, 0x4c, 0x3c, 0x59, 0xf8, 0x72, 0x9b, 0x37, 0x3c, 0x65, 0xcc, 0x12, 0x5b, 0x6f,
+	0xcd, 0x2c, 0xc4, 0xeb, 0x89, 0x87, 0x00, 0x65, 0x4f, 0x7c, 0xc9, 0x07, 0xc6, 0xb1, 0x2f, 0x95,
+	0xc1, 0x77, 0x7c, 0x14, 0x75, 0x3e, 0x9d, 0xf4, 0x25, 0x9f, 0x6a, 0x29, 0x33, 0x06, 0x9d, 0x33,
+	0x4b, 0xff, 0x15, 0xb7, 0xa6, 0xbd, 0x39, 0xf8, 0x0a, 0xa5, 0x9b, 0x9f, 0x23, 0xea, 0x01, 0x82,
+	0xc8, 0x93, 0x0f, 0x70, 0x0a, 0x4b, 0x71, 0xbd, 0xa2, 0xb5, 0x69, 0x97, 0x41, 0x69, 0x3d, 0x85,
+	0x0a, 0xd2, 0x4d, 0x46, 0xba, 0x6a, 0x2e, 0xc7, 0x5b, 0xef, 0xbb, 0x24, 0xa4, 0x8c, 0x3d, 0x28,
+	0xa4, 0x06, 0x40, 0x74, 0x7f, 0xfa, 0x58, 0xc8, 0xf9, 0xb7, 0xbf, 0x69, 0x66, 0x34, 0xb7, 0xd9,
+	0x32, 0x1b, 0xe6, 0x2a, 0x5d, 0x86, 0xcf, 0x9c, 0x55, 0x3e, 0x0c, 0xee, 0x6b, 0x0f, 0x0e, 0x6a,
+	0x7f, 0x7b, 0xb1, 0xa3, 0x7d, 0xf9, 0x62, 0x47, 0xfb, 0xcf, 0x8b, 0x1d, 0xed, 0x8b, 0x97, 0x3b,
+	0x77, 0xbe, 0x7c, 0xb9, 0x73, 0xe7, 0xab, 0x97, 0x3b, 0x77, 0x7e, 0xf5, 0xf6, 0xa5, 0x1b, 0x3e,
+	0x89, 0x9c, 0x4a, 0xc7, 0xbf, 0xaa, 0xda, 0xc1, 0x95, 0xdd, 0xb5, 0x07, 0x81, 0xdf, 0xc3, 0x9d,
+	0x50, 0x7c, 0x55, 0xc5, 0x9f, 0x60, 0x67, 0x91, 0xdd, 0x16, 0xef, 0x7d, 0x1d, 0x00, 0x00, 0xff,
+	0xff, 0x98, 0x22, 0x11, 0xde, 0x33, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1023,6 +1234,7 @@ type JobsClient interface {
 	GetJobDetails(ctx context.Context, in *JobDetailsRequest, opts ...grpc.CallOption) (*JobDetailsResponse, error)
 	GetJobErrors(ctx context.Context, in *JobErrorsRequest, opts ...grpc.CallOption) (*JobErrorsResponse, error)
 	GetJobRunDetails(ctx context.Context, in *JobRunDetailsRequest, opts ...grpc.CallOption) (*JobRunDetailsResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	GetActiveQueues(ctx context.Context, in *GetActiveQueuesRequest, opts ...grpc.CallOption) (*GetActiveQueuesResponse, error)
 }
 
@@ -1079,6 +1291,15 @@ func (c *jobsClient) GetJobRunDetails(ctx context.Context, in *JobRunDetailsRequ
 	return out, nil
 }
 
+func (c *jobsClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/api.Jobs/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobsClient) GetActiveQueues(ctx context.Context, in *GetActiveQueuesRequest, opts ...grpc.CallOption) (*GetActiveQueuesResponse, error) {
 	out := new(GetActiveQueuesResponse)
 	err := c.cc.Invoke(ctx, "/api.Jobs/GetActiveQueues", in, out, opts...)
@@ -1095,6 +1316,7 @@ type JobsServer interface {
 	GetJobDetails(context.Context, *JobDetailsRequest) (*JobDetailsResponse, error)
 	GetJobErrors(context.Context, *JobErrorsRequest) (*JobErrorsResponse, error)
 	GetJobRunDetails(context.Context, *JobRunDetailsRequest) (*JobRunDetailsResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	GetActiveQueues(context.Context, *GetActiveQueuesRequest) (*GetActiveQueuesResponse, error)
 }
 
@@ -1117,6 +1339,9 @@ func (*UnimplementedJobsServer) GetJobErrors(ctx context.Context, req *JobErrors
 func (*UnimplementedJobsServer) GetJobRunDetails(ctx context.Context, req *JobRunDetailsRequest) (*JobRunDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunDetails not implemented")
 }
+func (*UnimplementedJobsServer) ListJobs(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
 func (*UnimplementedJobsServer) GetActiveQueues(ctx context.Context, req *GetActiveQueuesRequest) (*GetActiveQueuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveQueues not implemented")
 }
@@ -1215,6 +1440,24 @@ func _Jobs_GetJobRunDetails_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Jobs_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Jobs/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Jobs_GetActiveQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetActiveQueuesRequest)
 	if err := dec(in); err != nil {
@@ -1257,6 +1500,10 @@ var _Jobs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobRunDetails",
 			Handler:    _Jobs_GetJobRunDetails_Handler,
 		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _Jobs_ListJobs_Handler,
+		},
 		{
 			MethodName: "GetActiveQueues",
 			Handler:    _Jobs_GetActiveQueues_Handler,
@@ -1286,6 +1533,18 @@ func (m *JobRunDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExitCode != 0 {
+		i = encodeVarintJob(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.FinishedTs != nil {
 		{
 			size, err := m.FinishedTs.MarshalToSizedBuffer(dAtA[:i])
@@ -1985,68 +2244,248 @@ func (m *JobStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintJob(dAtA []byte, offset int, v uint64) int {
-	offset -= sovJob(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *JobRunDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ListJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovJob(uint64(l))
+	if m.PageSize != 0 {
+		i = encodeVarintJob(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.State != 0 {
-		n += 1 + sovJob(uint64(m.State))
+	if m.SubmittedBefore != nil {
+		{
+			size, err := m.SubmittedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJob(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovJob(uint64(l))
+	if m.SubmittedAfter != nil {
+		{
+			size, err := m.SubmittedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJob(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintJob(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintJob(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintJob(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.LeasedTs != nil {
-		l = m.LeasedTs.Size()
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintJob(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintJob(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintJob(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.PendingTs != nil {
-		l = m.PendingTs.Size()
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.States) > 0 {
+		dAtA15 := make([]byte, len(m.States)*10)
+		var j14 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintJob(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.StartedTs != nil {
-		l = m.StartedTs.Size()
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.Jobset) > 0 {
+		i -= len(m.Jobset)
+		copy(dAtA[i:], m.Jobset)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.Jobset)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FinishedTs != nil {
-		l = m.FinishedTs.Size()
-		n += 1 + l + sovJob(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
+func (m *ListJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintJob(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJob(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJob(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJob(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobRunDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovJob(uint64(m.State))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.LeasedTs != nil {
+		l = m.LeasedTs.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.PendingTs != nil {
+		l = m.PendingTs.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.StartedTs != nil {
+		l = m.StartedTs.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.FinishedTs != nil {
+		l = m.FinishedTs.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovJob(uint64(m.ExitCode))
+	}
+	return n
+}
+
+func (m *JobDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
 	if l > 0 {
 		n += 1 + l + sovJob(uint64(l))
 	}
@@ -2313,6 +2752,80 @@ func (m *JobStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	l = len(m.Jobset)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovJob(uint64(e))
+		}
+		n += 1 + sovJob(uint64(l)) + l
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovJob(uint64(len(k))) + 1 + len(v) + sovJob(uint64(len(v)))
+			n += mapEntrySize + 1 + sovJob(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovJob(uint64(len(k))) + 1 + len(v) + sovJob(uint64(len(v)))
+			n += mapEntrySize + 1 + sovJob(uint64(mapEntrySize))
+		}
+	}
+	if m.SubmittedAfter != nil {
+		l = m.SubmittedAfter.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.SubmittedBefore != nil {
+		l = m.SubmittedBefore.Size()
+		n += 1 + l + sovJob(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovJob(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	return n
+}
+
+func (m *ListJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovJob(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovJob(uint64(l))
+	}
+	return n
+}
+
 func sovJob(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2639,6 +3152,57 @@ func (m *JobRunDetails) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJob(dAtA[iNdEx:])
@@ -4654,6 +5218,682 @@ func (m *JobStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthJob
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthJob
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthJob
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthJob
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthJob
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthJob
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipJob(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthJob
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowJob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthJob
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthJob
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowJob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthJob
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthJob
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipJob(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthJob
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmittedAfter == nil {
+				m.SubmittedAfter = &types.Timestamp{}
+			}
+			if err := m.SubmittedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmittedBefore == nil {
+				m.SubmittedBefore = &types.Timestamp{}
+			}
+			if err := m.SubmittedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &JobDetails{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipJob(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -166,26 +166,341 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"schedulerobjectsDrainingNodeReport\": {\n" +
+		"      \"description\": \"Progress of draining a particular node.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"executor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"node\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"preempt\": {\n" +
+		"          \"description\": \"True if preemptible jobs on the node are preempted rather than left to finish.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"remainingJobIds\": {\n" +
+		"          \"description\": \"Jobs still allocated to the node.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"remainingRunIds\": {\n" +
+		"          \"description\": \"Runs the executor still reports on the node, including those of preempted jobs whose pods are yet to exit.\\nThe node is empty once there are no remaining jobs or runs.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"schedulerobjectsJobReport\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"pools\": {\n" +
+		"          \"description\": \"Most recent scheduling round that affected the job, for each pool.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsPoolJobReport\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"report\": {\n" +
+		"          \"description\": \"Human-readable rendering of pools.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsJobSchedulingReport\": {\n" +
+		"      \"description\": \"Outcome of the most recent attempt to schedule a particular job.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeId\": {\n" +
+		"          \"description\": \"Node the job was assigned to, if any.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeTypes\": {\n" +
+		"          \"description\": \"Outcome for each type of node the job was tried on, in the order they were tried:\\nthe job's home nodes first, followed by each of its away node types.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsNodeTypeSchedulingReport\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"numNodes\": {\n" +
+		"          \"description\": \"Number of nodes considered.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"time\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"unschedulableReason\": {\n" +
+		"          \"description\": \"Empty if the job was scheduled successfully.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsNodeTypeSchedulingReport\": {\n" +
+		"      \"description\": \"Outcome of trying to schedule a job on nodes of a particular type.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"nodeType\": {\n" +
+		"          \"description\": \"Well-known node type the job was tried on as an away job; empty for the job's home nodes.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"numExcludedNodesByReason\": {\n" +
+		"          \"description\": \"Number of nodes that could not run the job, by reason.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"unschedulableReason\": {\n" +
+		"          \"description\": \"Empty if the job was scheduled on a node of this type; otherwise, the reason that excluded the most nodes.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsPoolJobReport\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"job\": {\n" +
+		"          \"description\": \"Unset if no recent scheduling round of this pool affected the job.\",\n" +
+		"          \"$ref\": \"#/definitions/schedulerobjectsJobSchedulingReport\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsPoolQueueReport\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"description\": \"Unset if no recent scheduling round of this pool considered the queue.\",\n" +
+		"          \"$ref\": \"#/definitions/schedulerobjectsQueueSchedulingReport\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsPoolSchedulingReport\": {\n" +
+		"      \"description\": \"Outcome of the most recent scheduling round of a pool.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allocatedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"drainingNodes\": {\n" +
+		"          \"description\": \"Draining nodes of this pool, ordered by executor and node.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsDrainingNodeReport\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"finished\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"numPreemptedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numScheduledGangs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numScheduledJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"preemptedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queues\": {\n" +
+		"          \"description\": \"Queues considered in this round, ordered by name.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsQueueSchedulingReport\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"scheduledResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"terminationReason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"totalResources\": {\n" +
+		"          \"description\": \"Resources are given as maps from resource name to quantity, e.g., {\\\"cpu\\\": \\\"10\\\", \\\"memory\\\": \\\"1Gi\\\"}.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
 		"    \"schedulerobjectsQueueReport\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"pools\": {\n" +
+		"          \"description\": \"Most recent scheduling round that considered the queue, for each pool.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsPoolQueueReport\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"report\": {\n" +
+		"          \"description\": \"Human-readable rendering of pools.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"schedulerobjectsQueueSchedulingReport\": {\n" +
+		"      \"description\": \"Outcome of the most recent scheduling round of a pool for a particular queue.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"actualShare\": {\n" +
+		"          \"description\": \"Share of the pool allocated to this queue after scheduling.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"adjustedFairShare\": {\n" +
+		"          \"description\": \"Fair share including this queue's share of the unused fair share of other queues, capped by the demand of this queue.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"allocatedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"constrainedDemand\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"demand\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"exampleUnschedulableJobIdByReason\": {\n" +
+		"          \"description\": \"One of the jobs that could not be scheduled for each reason in num_unschedulable_jobs_by_reason.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"fairShare\": {\n" +
+		"          \"description\": \"Weight of this queue over the sum of the weights of all queues.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"numPreemptedByOptimiserJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numPreemptedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numScheduledJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numUnschedulableJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"numUnschedulableJobsByReason\": {\n" +
+		"          \"description\": \"Number of jobs that could not be scheduled, by reason.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"preemptedByOptimiserResources\": {\n" +
+		"          \"description\": \"Resources of the jobs preempted by the optimiser.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"preemptedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"scheduledResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"time\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"uncappedAdjustedFairShare\": {\n" +
+		"          \"description\": \"As adjusted_fair_share, but not capped by the demand of this queue.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"weight\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
 		"    \"schedulerobjectsSchedulingReport\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"pools\": {\n" +
+		"          \"description\": \"Most recent scheduling round of each pool.\\nUnset if most_recent_for_queue or most_recent_for_job is set, since those filters only return the report field;\\nGetQueueReport and GetJobReport return the structured reports of a queue or job.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/schedulerobjectsPoolSchedulingReport\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"report\": {\n" +
+		"          \"description\": \"Human-readable rendering of pools, or of the queue or job reports if most_recent_for_queue or most_recent_for_job is set.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
@@ -5,12 +5,14 @@ package schedulerobjects
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -213,7 +215,12 @@ func (*SchedulingReportRequest) XXX_OneofWrappers() []interface{} {
 }
 
 type SchedulingReport struct {
+	// Human-readable rendering of pools, or of the queue or job reports if most_recent_for_queue or most_recent_for_job is set.
 	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// Most recent scheduling round of each pool.
+	// Unset if most_recent_for_queue or most_recent_for_job is set, since those filters only return the report field;
+	// GetQueueReport and GetJobReport return the structured reports of a queue or job.
+	Pools []*PoolSchedulingReport `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
 func (m *SchedulingReport) Reset()         { *m = SchedulingReport{} }
@@ -256,23 +263,45 @@ func (m *SchedulingReport) GetReport() string {
 	return ""
 }
 
-type QueueReportRequest struct {
-	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
-	Verbosity int32  `protobuf:"varint,2,opt,name=verbosity,proto3" json:"verbosity,omitempty"`
+func (m *SchedulingReport) GetPools() []*PoolSchedulingReport {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QueueReportRequest) Reset()         { *m = QueueReportRequest{} }
-func (m *QueueReportRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueReportRequest) ProtoMessage()    {}
-func (*QueueReportRequest) Descriptor() ([]byte, []int) {
+// Outcome of the most recent scheduling round of a pool.
+type PoolSchedulingReport struct {
+	Pool              string           `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Started           *types.Timestamp `protobuf:"bytes,2,opt,name=started,proto3" json:"started,omitempty"`
+	Finished          *types.Timestamp `protobuf:"bytes,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	TerminationReason string           `protobuf:"bytes,4,opt,name=termination_reason,json=terminationReason,proto3" json:"terminationReason,omitempty"`
+	// Resources are given as maps from resource name to quantity, e.g., {"cpu": "10", "memory": "1Gi"}.
+	TotalResources     map[string]string `protobuf:"bytes,5,rep,name=total_resources,json=totalResources,proto3" json:"totalResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllocatedResources map[string]string `protobuf:"bytes,6,rep,name=allocated_resources,json=allocatedResources,proto3" json:"allocatedResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ScheduledResources map[string]string `protobuf:"bytes,7,rep,name=scheduled_resources,json=scheduledResources,proto3" json:"scheduledResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PreemptedResources map[string]string `protobuf:"bytes,8,rep,name=preempted_resources,json=preemptedResources,proto3" json:"preemptedResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumScheduledJobs   int32             `protobuf:"varint,9,opt,name=num_scheduled_jobs,json=numScheduledJobs,proto3" json:"numScheduledJobs,omitempty"`
+	NumScheduledGangs  int32             `protobuf:"varint,10,opt,name=num_scheduled_gangs,json=numScheduledGangs,proto3" json:"numScheduledGangs,omitempty"`
+	NumPreemptedJobs   int32             `protobuf:"varint,11,opt,name=num_preempted_jobs,json=numPreemptedJobs,proto3" json:"numPreemptedJobs,omitempty"`
+	// Queues considered in this round, ordered by name.
+	Queues []*QueueSchedulingReport `protobuf:"bytes,12,rep,name=queues,proto3" json:"queues,omitempty"`
+	// Draining nodes of this pool, ordered by executor and node.
+	DrainingNodes []*DrainingNodeReport `protobuf:"bytes,13,rep,name=draining_nodes,json=drainingNodes,proto3" json:"drainingNodes,omitempty"`
+}
+
+func (m *PoolSchedulingReport) Reset()         { *m = PoolSchedulingReport{} }
+func (m *PoolSchedulingReport) String() string { return proto.CompactTextString(m) }
+func (*PoolSchedulingReport) ProtoMessage()    {}
+func (*PoolSchedulingReport) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c6edb75717835892, []int{4}
 }
-func (m *QueueReportRequest) XXX_Unmarshal(b []byte) error {
+func (m *PoolSchedulingReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PoolSchedulingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueReportRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PoolSchedulingReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -282,92 +311,135 @@ func (m *QueueReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueReportRequest.Merge(m, src)
+func (m *PoolSchedulingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSchedulingReport.Merge(m, src)
 }
-func (m *QueueReportRequest) XXX_Size() int {
+func (m *PoolSchedulingReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueReportRequest.DiscardUnknown(m)
+func (m *PoolSchedulingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSchedulingReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_PoolSchedulingReport proto.InternalMessageInfo
 
-func (m *QueueReportRequest) GetQueueName() string {
+func (m *PoolSchedulingReport) GetPool() string {
 	if m != nil {
-		return m.QueueName
+		return m.Pool
 	}
 	return ""
 }
 
-func (m *QueueReportRequest) GetVerbosity() int32 {
+func (m *PoolSchedulingReport) GetStarted() *types.Timestamp {
 	if m != nil {
-		return m.Verbosity
+		return m.Started
 	}
-	return 0
+	return nil
 }
 
-type QueueReport struct {
-	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+func (m *PoolSchedulingReport) GetFinished() *types.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
 }
 
-func (m *QueueReport) Reset()         { *m = QueueReport{} }
-func (m *QueueReport) String() string { return proto.CompactTextString(m) }
-func (*QueueReport) ProtoMessage()    {}
-func (*QueueReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6edb75717835892, []int{5}
+func (m *PoolSchedulingReport) GetTerminationReason() string {
+	if m != nil {
+		return m.TerminationReason
+	}
+	return ""
 }
-func (m *QueueReport) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *PoolSchedulingReport) GetTotalResources() map[string]string {
+	if m != nil {
+		return m.TotalResources
+	}
+	return nil
 }
-func (m *QueueReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueueReport.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *PoolSchedulingReport) GetAllocatedResources() map[string]string {
+	if m != nil {
+		return m.AllocatedResources
 	}
+	return nil
 }
-func (m *QueueReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueReport.Merge(m, src)
+
+func (m *PoolSchedulingReport) GetScheduledResources() map[string]string {
+	if m != nil {
+		return m.ScheduledResources
+	}
+	return nil
 }
-func (m *QueueReport) XXX_Size() int {
-	return m.Size()
+
+func (m *PoolSchedulingReport) GetPreemptedResources() map[string]string {
+	if m != nil {
+		return m.PreemptedResources
+	}
+	return nil
 }
-func (m *QueueReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueReport.DiscardUnknown(m)
+
+func (m *PoolSchedulingReport) GetNumScheduledJobs() int32 {
+	if m != nil {
+		return m.NumScheduledJobs
+	}
+	return 0
 }
 
-var xxx_messageInfo_QueueReport proto.InternalMessageInfo
+func (m *PoolSchedulingReport) GetNumScheduledGangs() int32 {
+	if m != nil {
+		return m.NumScheduledGangs
+	}
+	return 0
+}
 
-func (m *QueueReport) GetReport() string {
+func (m *PoolSchedulingReport) GetNumPreemptedJobs() int32 {
 	if m != nil {
-		return m.Report
+		return m.NumPreemptedJobs
 	}
-	return ""
+	return 0
 }
 
-type JobReportRequest struct {
-	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+func (m *PoolSchedulingReport) GetQueues() []*QueueSchedulingReport {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
 }
 
-func (m *JobReportRequest) Reset()         { *m = JobReportRequest{} }
-func (m *JobReportRequest) String() string { return proto.CompactTextString(m) }
-func (*JobReportRequest) ProtoMessage()    {}
-func (*JobReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6edb75717835892, []int{6}
+func (m *PoolSchedulingReport) GetDrainingNodes() []*DrainingNodeReport {
+	if m != nil {
+		return m.DrainingNodes
+	}
+	return nil
 }
-func (m *JobReportRequest) XXX_Unmarshal(b []byte) error {
+
+// Progress of draining a particular node.
+type DrainingNodeReport struct {
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	Node     string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// True if preemptible jobs on the node are preempted rather than left to finish.
+	Preempt bool `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	// Jobs still allocated to the node.
+	RemainingJobIds []string `protobuf:"bytes,5,rep,name=remaining_job_ids,json=remainingJobIds,proto3" json:"remainingJobIds,omitempty"`
+	// Runs the executor still reports on the node, including those of preempted jobs whose pods are yet to exit.
+	// The node is empty once there are no remaining jobs or runs.
+	RemainingRunIds []string `protobuf:"bytes,6,rep,name=remaining_run_ids,json=remainingRunIds,proto3" json:"remainingRunIds,omitempty"`
+}
+
+func (m *DrainingNodeReport) Reset()         { *m = DrainingNodeReport{} }
+func (m *DrainingNodeReport) String() string { return proto.CompactTextString(m) }
+func (*DrainingNodeReport) ProtoMessage()    {}
+func (*DrainingNodeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{5}
+}
+func (m *DrainingNodeReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DrainingNodeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobReportRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DrainingNodeReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -377,41 +449,102 @@ func (m *JobReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *JobReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobReportRequest.Merge(m, src)
+func (m *DrainingNodeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainingNodeReport.Merge(m, src)
 }
-func (m *JobReportRequest) XXX_Size() int {
+func (m *DrainingNodeReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobReportRequest.DiscardUnknown(m)
+func (m *DrainingNodeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainingNodeReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_DrainingNodeReport proto.InternalMessageInfo
 
-func (m *JobReportRequest) GetJobId() string {
+func (m *DrainingNodeReport) GetExecutor() string {
 	if m != nil {
-		return m.JobId
+		return m.Executor
 	}
 	return ""
 }
 
-type JobReport struct {
-	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+func (m *DrainingNodeReport) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
 }
 
-func (m *JobReport) Reset()         { *m = JobReport{} }
-func (m *JobReport) String() string { return proto.CompactTextString(m) }
-func (*JobReport) ProtoMessage()    {}
-func (*JobReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6edb75717835892, []int{7}
+func (m *DrainingNodeReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *JobReport) XXX_Unmarshal(b []byte) error {
+
+func (m *DrainingNodeReport) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
+func (m *DrainingNodeReport) GetRemainingJobIds() []string {
+	if m != nil {
+		return m.RemainingJobIds
+	}
+	return nil
+}
+
+func (m *DrainingNodeReport) GetRemainingRunIds() []string {
+	if m != nil {
+		return m.RemainingRunIds
+	}
+	return nil
+}
+
+// Outcome of the most recent scheduling round of a pool for a particular queue.
+type QueueSchedulingReport struct {
+	Queue  string           `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Time   *types.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Weight float64          `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Weight of this queue over the sum of the weights of all queues.
+	FairShare float64 `protobuf:"fixed64,4,opt,name=fair_share,json=fairShare,proto3" json:"fairShare,omitempty"`
+	// Fair share including this queue's share of the unused fair share of other queues, capped by the demand of this queue.
+	AdjustedFairShare float64 `protobuf:"fixed64,5,opt,name=adjusted_fair_share,json=adjustedFairShare,proto3" json:"adjustedFairShare,omitempty"`
+	// As adjusted_fair_share, but not capped by the demand of this queue.
+	UncappedAdjustedFairShare float64 `protobuf:"fixed64,6,opt,name=uncapped_adjusted_fair_share,json=uncappedAdjustedFairShare,proto3" json:"uncappedAdjustedFairShare,omitempty"`
+	// Share of the pool allocated to this queue after scheduling.
+	ActualShare          float64           `protobuf:"fixed64,7,opt,name=actual_share,json=actualShare,proto3" json:"actualShare,omitempty"`
+	AllocatedResources   map[string]string `protobuf:"bytes,8,rep,name=allocated_resources,json=allocatedResources,proto3" json:"allocatedResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Demand               map[string]string `protobuf:"bytes,9,rep,name=demand,proto3" json:"demand,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConstrainedDemand    map[string]string `protobuf:"bytes,10,rep,name=constrained_demand,json=constrainedDemand,proto3" json:"constrainedDemand,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ScheduledResources   map[string]string `protobuf:"bytes,11,rep,name=scheduled_resources,json=scheduledResources,proto3" json:"scheduledResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PreemptedResources   map[string]string `protobuf:"bytes,12,rep,name=preempted_resources,json=preemptedResources,proto3" json:"preemptedResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumScheduledJobs     int32             `protobuf:"varint,13,opt,name=num_scheduled_jobs,json=numScheduledJobs,proto3" json:"numScheduledJobs,omitempty"`
+	NumPreemptedJobs     int32             `protobuf:"varint,14,opt,name=num_preempted_jobs,json=numPreemptedJobs,proto3" json:"numPreemptedJobs,omitempty"`
+	NumUnschedulableJobs int32             `protobuf:"varint,15,opt,name=num_unschedulable_jobs,json=numUnschedulableJobs,proto3" json:"numUnschedulableJobs,omitempty"`
+	// Number of jobs that could not be scheduled, by reason.
+	NumUnschedulableJobsByReason map[string]int32 `protobuf:"bytes,16,rep,name=num_unschedulable_jobs_by_reason,json=numUnschedulableJobsByReason,proto3" json:"numUnschedulableJobsByReason,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// One of the jobs that could not be scheduled for each reason in num_unschedulable_jobs_by_reason.
+	ExampleUnschedulableJobIdByReason map[string]string `protobuf:"bytes,17,rep,name=example_unschedulable_job_id_by_reason,json=exampleUnschedulableJobIdByReason,proto3" json:"exampleUnschedulableJobIdByReason,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Resources of the jobs preempted by the optimiser.
+	PreemptedByOptimiserResources map[string]string `protobuf:"bytes,18,rep,name=preempted_by_optimiser_resources,json=preemptedByOptimiserResources,proto3" json:"preemptedByOptimiserResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumPreemptedByOptimiserJobs   int32             `protobuf:"varint,19,opt,name=num_preempted_by_optimiser_jobs,json=numPreemptedByOptimiserJobs,proto3" json:"numPreemptedByOptimiserJobs,omitempty"`
+}
+
+func (m *QueueSchedulingReport) Reset()         { *m = QueueSchedulingReport{} }
+func (m *QueueSchedulingReport) String() string { return proto.CompactTextString(m) }
+func (*QueueSchedulingReport) ProtoMessage()    {}
+func (*QueueSchedulingReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{6}
+}
+func (m *QueueSchedulingReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueSchedulingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobReport.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueSchedulingReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -421,684 +554,5311 @@ func (m *JobReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobReport.Merge(m, src)
+func (m *QueueSchedulingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueSchedulingReport.Merge(m, src)
 }
-func (m *JobReport) XXX_Size() int {
+func (m *QueueSchedulingReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobReport.DiscardUnknown(m)
+func (m *QueueSchedulingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueSchedulingReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobReport proto.InternalMessageInfo
+var xxx_messageInfo_QueueSchedulingReport proto.InternalMessageInfo
 
-func (m *JobReport) GetReport() string {
+func (m *QueueSchedulingReport) GetQueue() string {
 	if m != nil {
-		return m.Report
+		return m.Queue
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MostRecentForQueue)(nil), "schedulerobjects.MostRecentForQueue")
-	proto.RegisterType((*MostRecentForJob)(nil), "schedulerobjects.MostRecentForJob")
-	proto.RegisterType((*SchedulingReportRequest)(nil), "schedulerobjects.SchedulingReportRequest")
-	proto.RegisterType((*SchedulingReport)(nil), "schedulerobjects.SchedulingReport")
-	proto.RegisterType((*QueueReportRequest)(nil), "schedulerobjects.QueueReportRequest")
-	proto.RegisterType((*QueueReport)(nil), "schedulerobjects.QueueReport")
-	proto.RegisterType((*JobReportRequest)(nil), "schedulerobjects.JobReportRequest")
-	proto.RegisterType((*JobReport)(nil), "schedulerobjects.JobReport")
+func (m *QueueSchedulingReport) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("pkg/api/schedulerobjects/scheduler_reporting.proto", fileDescriptor_c6edb75717835892)
+func (m *QueueSchedulingReport) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
 }
 
-var fileDescriptor_c6edb75717835892 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xa6, 0x34, 0x98, 0xa9, 0xe8, 0x32, 0xb1, 0x24, 0xc4, 0x76, 0x13, 0x47, 0xc1, 0x46,
-	0x6a, 0x16, 0x23, 0x8a, 0x22, 0x14, 0xc9, 0xc1, 0x68, 0x50, 0xc1, 0xed, 0xcd, 0xcb, 0xb2, 0x93,
-	0x4c, 0xb7, 0x1b, 0xb3, 0xfb, 0xb6, 0xb3, 0x93, 0x42, 0x0d, 0x5e, 0x2c, 0x78, 0x16, 0xfc, 0x03,
-	0xfc, 0x77, 0x3c, 0x78, 0x28, 0x78, 0xf1, 0x14, 0x24, 0xf1, 0x94, 0xbf, 0x42, 0x32, 0x9b, 0x9f,
-	0xbb, 0xfd, 0x61, 0x7a, 0x4b, 0xbe, 0x79, 0xfb, 0xbe, 0xef, 0x9b, 0xef, 0xcd, 0x43, 0x15, 0xff,
-	0x83, 0xad, 0x5b, 0xbe, 0xa3, 0x07, 0x8d, 0x7d, 0xd6, 0xec, 0xb4, 0x19, 0x07, 0xda, 0x62, 0x0d,
-	0x11, 0xcc, 0x00, 0x93, 0x33, 0x1f, 0xb8, 0x70, 0x3c, 0xbb, 0xec, 0x73, 0x10, 0x80, 0xd5, 0x68,
-	0x6d, 0x7e, 0xc3, 0x06, 0xb0, 0xdb, 0x4c, 0x36, 0xb2, 0x3c, 0x0f, 0x84, 0x25, 0x1c, 0xf0, 0x82,
-	0xb0, 0x9e, 0xbc, 0x46, 0xf8, 0x0d, 0x04, 0xc2, 0x60, 0x0d, 0xe6, 0x89, 0x17, 0xc0, 0xdf, 0x75,
-	0x58, 0x87, 0xe1, 0xc7, 0x08, 0x1d, 0x8c, 0x7e, 0x98, 0x9e, 0xe5, 0xb2, 0x9c, 0x52, 0x54, 0xb6,
-	0xd2, 0xd5, 0xec, 0xb0, 0x57, 0xc8, 0x48, 0xf4, 0xad, 0xe5, 0xb2, 0x6d, 0x70, 0x1d, 0xc1, 0x5c,
-	0x5f, 0x1c, 0x19, 0xe9, 0x29, 0x48, 0x76, 0x90, 0xba, 0xd0, 0xad, 0x0e, 0x14, 0xdf, 0x43, 0xa9,
-	0x16, 0x50, 0xd3, 0x69, 0x8e, 0xfb, 0x64, 0x86, 0xbd, 0xc2, 0xf5, 0x16, 0xd0, 0x57, 0xcd, 0xb9,
-	0x1e, 0xab, 0x12, 0x20, 0x3f, 0x93, 0x28, 0xbb, 0x1b, 0x1a, 0x70, 0x3c, 0xdb, 0x90, 0xde, 0x0c,
-	0x76, 0xd0, 0x61, 0x81, 0xc0, 0x5d, 0xb4, 0xee, 0x42, 0x20, 0x4c, 0x2e, 0x9b, 0x9b, 0x7b, 0xc0,
-	0x4d, 0x49, 0x2c, 0xdb, 0xae, 0x55, 0xee, 0x94, 0xa3, 0xce, 0xcb, 0x71, 0x63, 0xd5, 0xe2, 0xb0,
-	0x57, 0xd8, 0x70, 0x63, 0xf8, 0x4c, 0xc9, 0xcb, 0x84, 0x81, 0xe3, 0xe7, 0x38, 0x40, 0x99, 0x28,
-	0x79, 0x0b, 0x68, 0x2e, 0x29, 0xa9, 0xc9, 0x05, 0xd4, 0x75, 0xa0, 0x55, 0x6d, 0xd8, 0x2b, 0xe4,
-	0xdd, 0x08, 0xba, 0x40, 0xab, 0x46, 0x4f, 0xf1, 0x23, 0x94, 0x3e, 0x64, 0x9c, 0x42, 0xe0, 0x88,
-	0xa3, 0xdc, 0x4a, 0x51, 0xd9, 0x5a, 0x0d, 0x43, 0x98, 0x82, 0xf3, 0x21, 0x4c, 0xc1, 0xea, 0x15,
-	0x94, 0xda, 0x73, 0xda, 0x82, 0x71, 0xf2, 0x1c, 0xa9, 0xd1, 0xdb, 0xc4, 0xdb, 0x28, 0x15, 0xce,
-	0xcc, 0x38, 0x8e, 0x1b, 0xc3, 0x5e, 0x41, 0x0d, 0x91, 0xb9, 0x76, 0xe3, 0x1a, 0x72, 0xac, 0x20,
-	0x2c, 0x6f, 0x60, 0x31, 0x8b, 0x4b, 0xce, 0xc7, 0xa2, 0xa3, 0xe4, 0xff, 0x3a, 0x22, 0xcf, 0xd0,
-	0xda, 0x9c, 0x88, 0x25, 0x2d, 0xec, 0x20, 0xb5, 0x0e, 0x74, 0x51, 0xff, 0x32, 0x33, 0xf9, 0x14,
-	0xa5, 0xa7, 0xdf, 0x2f, 0x47, 0x5d, 0xf9, 0xbe, 0x82, 0xf0, 0xee, 0x64, 0x34, 0x8c, 0xc9, 0x4b,
-	0xc5, 0xc7, 0x0a, 0xca, 0xd4, 0x98, 0x88, 0x45, 0x53, 0x8a, 0xcf, 0xd1, 0x19, 0x8f, 0x21, 0x4f,
-	0x2e, 0x2e, 0x25, 0x9b, 0x9f, 0x7f, 0xfd, 0xfd, 0x96, 0xcc, 0xe2, 0x75, 0xfd, 0xf0, 0xc1, 0x64,
-	0x63, 0x38, 0x9e, 0x7d, 0x3f, 0x14, 0x87, 0xbf, 0x28, 0xe8, 0x5a, 0x8d, 0x89, 0xf9, 0x8b, 0x3d,
-	0xe5, 0x0d, 0xc5, 0xc3, 0xcf, 0x6f, 0x9e, 0x5b, 0x45, 0x74, 0x49, 0x5b, 0xc2, 0x77, 0x47, 0xb4,
-	0x32, 0x7a, 0xbd, 0x3b, 0x1b, 0x96, 0x4f, 0xb3, 0xdd, 0x35, 0x11, 0xf2, 0x11, 0x5d, 0xad, 0x31,
-	0x31, 0xbb, 0xe3, 0x53, 0xbc, 0x45, 0x03, 0xcc, 0xdf, 0x3c, 0xa7, 0x86, 0x94, 0xa4, 0x82, 0xdb,
-	0xf8, 0xd6, 0x48, 0x41, 0x0b, 0xa8, 0xde, 0x0d, 0xc3, 0x8e, 0x73, 0x57, 0x8d, 0x1f, 0x7d, 0x4d,
-	0x39, 0xe9, 0x6b, 0xca, 0x9f, 0xbe, 0xa6, 0x7c, 0x1d, 0x68, 0x89, 0x93, 0x81, 0x96, 0xf8, 0x3d,
-	0xd0, 0x12, 0xef, 0x9f, 0xd8, 0x8e, 0xd8, 0xef, 0xd0, 0x72, 0x03, 0x5c, 0xdd, 0xe2, 0xae, 0xd5,
-	0xb4, 0x7c, 0x0e, 0x23, 0xa6, 0xf1, 0x3f, 0xfd, 0xac, 0xe5, 0x4c, 0x53, 0x72, 0xb3, 0x3e, 0xfc,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0xde, 0x68, 0xbf, 0x05, 0x00, 0x00,
+func (m *QueueSchedulingReport) GetFairShare() float64 {
+	if m != nil {
+		return m.FairShare
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueueSchedulingReport) GetAdjustedFairShare() float64 {
+	if m != nil {
+		return m.AdjustedFairShare
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueueSchedulingReport) GetUncappedAdjustedFairShare() float64 {
+	if m != nil {
+		return m.UncappedAdjustedFairShare
+	}
+	return 0
+}
 
-// SchedulerReportingClient is the client API for SchedulerReporting service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SchedulerReportingClient interface {
-	// Return the most recent scheduling report for each executor.
-	GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error)
-	// Return the most recent report scheduling for each executor for the given queue.
-	GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error)
-	// Return the most recent scheduling report for each executor for the given job.
-	GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error)
+func (m *QueueSchedulingReport) GetActualShare() float64 {
+	if m != nil {
+		return m.ActualShare
+	}
+	return 0
 }
 
-type schedulerReportingClient struct {
-	cc *grpc.ClientConn
+func (m *QueueSchedulingReport) GetAllocatedResources() map[string]string {
+	if m != nil {
+		return m.AllocatedResources
+	}
+	return nil
 }
 
-func NewSchedulerReportingClient(cc *grpc.ClientConn) SchedulerReportingClient {
-	return &schedulerReportingClient{cc}
+func (m *QueueSchedulingReport) GetDemand() map[string]string {
+	if m != nil {
+		return m.Demand
+	}
+	return nil
 }
 
-func (c *schedulerReportingClient) GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error) {
-	out := new(SchedulingReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetSchedulingReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueSchedulingReport) GetConstrainedDemand() map[string]string {
+	if m != nil {
+		return m.ConstrainedDemand
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerReportingClient) GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error) {
-	out := new(QueueReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueSchedulingReport) GetScheduledResources() map[string]string {
+	if m != nil {
+		return m.ScheduledResources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerReportingClient) GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error) {
-	out := new(JobReport)
-	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetJobReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueSchedulingReport) GetPreemptedResources() map[string]string {
+	if m != nil {
+		return m.PreemptedResources
 	}
-	return out, nil
+	return nil
 }
 
-// SchedulerReportingServer is the server API for SchedulerReporting service.
-type SchedulerReportingServer interface {
-	// Return the most recent scheduling report for each executor.
-	GetSchedulingReport(context.Context, *SchedulingReportRequest) (*SchedulingReport, error)
-	// Return the most recent report scheduling for each executor for the given queue.
-	GetQueueReport(context.Context, *QueueReportRequest) (*QueueReport, error)
-	// Return the most recent scheduling report for each executor for the given job.
-	GetJobReport(context.Context, *JobReportRequest) (*JobReport, error)
+func (m *QueueSchedulingReport) GetNumScheduledJobs() int32 {
+	if m != nil {
+		return m.NumScheduledJobs
+	}
+	return 0
 }
 
-// UnimplementedSchedulerReportingServer can be embedded to have forward compatible implementations.
-type UnimplementedSchedulerReportingServer struct {
+func (m *QueueSchedulingReport) GetNumPreemptedJobs() int32 {
+	if m != nil {
+		return m.NumPreemptedJobs
+	}
+	return 0
 }
 
-func (*UnimplementedSchedulerReportingServer) GetSchedulingReport(ctx context.Context, req *SchedulingReportRequest) (*SchedulingReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingReport not implemented")
-}
-func (*UnimplementedSchedulerReportingServer) GetQueueReport(ctx context.Context, req *QueueReportRequest) (*QueueReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueueReport not implemented")
-}
-func (*UnimplementedSchedulerReportingServer) GetJobReport(ctx context.Context, req *JobReportRequest) (*JobReport, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobReport not implemented")
+func (m *QueueSchedulingReport) GetNumUnschedulableJobs() int32 {
+	if m != nil {
+		return m.NumUnschedulableJobs
+	}
+	return 0
 }
 
-func RegisterSchedulerReportingServer(s *grpc.Server, srv SchedulerReportingServer) {
-	s.RegisterService(&_SchedulerReporting_serviceDesc, srv)
+func (m *QueueSchedulingReport) GetNumUnschedulableJobsByReason() map[string]int32 {
+	if m != nil {
+		return m.NumUnschedulableJobsByReason
+	}
+	return nil
 }
 
-func _SchedulerReporting_GetSchedulingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchedulingReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetSchedulingReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, req.(*SchedulingReportRequest))
+func (m *QueueSchedulingReport) GetExampleUnschedulableJobIdByReason() map[string]string {
+	if m != nil {
+		return m.ExampleUnschedulableJobIdByReason
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _SchedulerReporting_GetQueueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetQueueReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetQueueReport(ctx, req.(*QueueReportRequest))
+func (m *QueueSchedulingReport) GetPreemptedByOptimiserResources() map[string]string {
+	if m != nil {
+		return m.PreemptedByOptimiserResources
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _SchedulerReporting_GetJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerReportingServer).GetJobReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerobjects.SchedulerReporting/GetJobReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerReportingServer).GetJobReport(ctx, req.(*JobReportRequest))
+func (m *QueueSchedulingReport) GetNumPreemptedByOptimiserJobs() int32 {
+	if m != nil {
+		return m.NumPreemptedByOptimiserJobs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _SchedulerReporting_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schedulerobjects.SchedulerReporting",
-	HandlerType: (*SchedulerReportingServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSchedulingReport",
-			Handler:    _SchedulerReporting_GetSchedulingReport_Handler,
-		},
-		{
-			MethodName: "GetQueueReport",
-			Handler:    _SchedulerReporting_GetQueueReport_Handler,
-		},
-		{
-			MethodName: "GetJobReport",
-			Handler:    _SchedulerReporting_GetJobReport_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/schedulerobjects/scheduler_reporting.proto",
+type QueueReportRequest struct {
+	QueueName string `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queueName,omitempty"`
+	Verbosity int32  `protobuf:"varint,2,opt,name=verbosity,proto3" json:"verbosity,omitempty"`
 }
 
-func (m *MostRecentForQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueReportRequest) Reset()         { *m = QueueReportRequest{} }
+func (m *QueueReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueReportRequest) ProtoMessage()    {}
+func (*QueueReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{7}
+}
+func (m *QueueReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueReportRequest.Merge(m, src)
+}
+func (m *QueueReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueReportRequest.DiscardUnknown(m)
 }
 
-func (m *MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueueName) > 0 {
-		i -= len(m.QueueName)
-		copy(dAtA[i:], m.QueueName)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.QueueName)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueueReportRequest proto.InternalMessageInfo
+
+func (m *QueueReportRequest) GetQueueName() string {
+	if m != nil {
+		return m.QueueName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MostRecentForJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueReportRequest) GetVerbosity() int32 {
+	if m != nil {
+		return m.Verbosity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueReport struct {
+	// Human-readable rendering of pools.
+	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// Most recent scheduling round that considered the queue, for each pool.
+	Pools []*PoolQueueReport `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (m *MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueueReport) Reset()         { *m = QueueReport{} }
+func (m *QueueReport) String() string { return proto.CompactTextString(m) }
+func (*QueueReport) ProtoMessage()    {}
+func (*QueueReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{8}
+}
+func (m *QueueReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueReport.Merge(m, src)
+}
+func (m *QueueReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueReport.DiscardUnknown(m)
 }
 
-func (m *SchedulingReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueueReport proto.InternalMessageInfo
+
+func (m *QueueReport) GetReport() string {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SchedulingReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueueReport) GetPools() []*PoolQueueReport {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *SchedulingReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verbosity != 0 {
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.Verbosity))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Filter != nil {
-		{
-			size := m.Filter.Size()
-			i -= size
-			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+type PoolQueueReport struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Unset if no recent scheduling round of this pool considered the queue.
+	Queue *QueueSchedulingReport `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (m *PoolQueueReport) Reset()         { *m = PoolQueueReport{} }
+func (m *PoolQueueReport) String() string { return proto.CompactTextString(m) }
+func (*PoolQueueReport) ProtoMessage()    {}
+func (*PoolQueueReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{9}
+}
+func (m *PoolQueueReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolQueueReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolQueueReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SchedulingReportRequest_MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolQueueReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolQueueReport.Merge(m, src)
+}
+func (m *PoolQueueReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolQueueReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolQueueReport.DiscardUnknown(m)
 }
 
-func (m *SchedulingReportRequest_MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MostRecentForQueue != nil {
-		{
-			size, err := m.MostRecentForQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_PoolQueueReport proto.InternalMessageInfo
+
+func (m *PoolQueueReport) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *SchedulingReportRequest_MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *PoolQueueReport) GetQueue() *QueueSchedulingReport {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
 }
 
-func (m *SchedulingReportRequest_MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MostRecentForJob != nil {
-		{
-			size, err := m.MostRecentForJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+type JobReportRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobReportRequest) Reset()         { *m = JobReportRequest{} }
+func (m *JobReportRequest) String() string { return proto.CompactTextString(m) }
+func (*JobReportRequest) ProtoMessage()    {}
+func (*JobReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{10}
+}
+func (m *JobReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *SchedulingReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReportRequest.Merge(m, src)
+}
+func (m *JobReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobReportRequest proto.InternalMessageInfo
+
+func (m *JobReportRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SchedulingReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobReport struct {
+	// Human-readable rendering of pools.
+	Report string `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// Most recent scheduling round that affected the job, for each pool.
+	Pools []*PoolJobReport `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-func (m *SchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+func (m *JobReport) Reset()         { *m = JobReport{} }
+func (m *JobReport) String() string { return proto.CompactTextString(m) }
+func (*JobReport) ProtoMessage()    {}
+func (*JobReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{11}
+}
+func (m *JobReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReport.Merge(m, src)
+}
+func (m *JobReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReport.DiscardUnknown(m)
 }
 
-func (m *QueueReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobReport proto.InternalMessageInfo
+
+func (m *JobReport) GetReport() string {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueueReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobReport) GetPools() []*PoolJobReport {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
 }
 
-func (m *QueueReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verbosity != 0 {
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.Verbosity))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.QueueName) > 0 {
-		i -= len(m.QueueName)
-		copy(dAtA[i:], m.QueueName)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.QueueName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type PoolJobReport struct {
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Unset if no recent scheduling round of this pool affected the job.
+	Job *JobSchedulingReport `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (m *QueueReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolJobReport) Reset()         { *m = PoolJobReport{} }
+func (m *PoolJobReport) String() string { return proto.CompactTextString(m) }
+func (*PoolJobReport) ProtoMessage()    {}
+func (*PoolJobReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{12}
+}
+func (m *PoolJobReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolJobReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolJobReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueueReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolJobReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolJobReport.Merge(m, src)
+}
+func (m *PoolJobReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolJobReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolJobReport.DiscardUnknown(m)
 }
 
-func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_PoolJobReport proto.InternalMessageInfo
+
+func (m *PoolJobReport) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolJobReport) GetJob() *JobSchedulingReport {
+	if m != nil {
+		return m.Job
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Outcome of the most recent attempt to schedule a particular job.
+type JobSchedulingReport struct {
+	JobId string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Queue string           `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Time  *types.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Empty if the job was scheduled successfully.
+	UnschedulableReason string `protobuf:"bytes,4,opt,name=unschedulable_reason,json=unschedulableReason,proto3" json:"unschedulableReason,omitempty"`
+	// Node the job was assigned to, if any.
+	NodeId string `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"nodeId,omitempty"`
+	// Number of nodes considered.
+	NumNodes int32 `protobuf:"varint,6,opt,name=num_nodes,json=numNodes,proto3" json:"numNodes,omitempty"`
+	// Outcome for each type of node the job was tried on, in the order they were tried:
+	// the job's home nodes first, followed by each of its away node types.
+	NodeTypes []*NodeTypeSchedulingReport `protobuf:"bytes,7,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
 }
 
-func (m *JobReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *JobSchedulingReport) Reset()         { *m = JobSchedulingReport{} }
+func (m *JobSchedulingReport) String() string { return proto.CompactTextString(m) }
+func (*JobSchedulingReport) ProtoMessage()    {}
+func (*JobSchedulingReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{13}
+}
+func (m *JobSchedulingReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSchedulingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSchedulingReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JobSchedulingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSchedulingReport.Merge(m, src)
+}
+func (m *JobSchedulingReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSchedulingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSchedulingReport.DiscardUnknown(m)
 }
 
-func (m *JobReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSchedulingReport proto.InternalMessageInfo
+
+func (m *JobSchedulingReport) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0xa
+func (m *JobSchedulingReport) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintSchedulerReporting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSchedulerReporting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobSchedulingReport) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MostRecentForQueue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.QueueName)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+
+func (m *JobSchedulingReport) GetUnschedulableReason() string {
+	if m != nil {
+		return m.UnschedulableReason
 	}
-	return n
+	return ""
 }
 
-func (m *MostRecentForJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+func (m *JobSchedulingReport) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return n
+	return ""
 }
 
-func (m *SchedulingReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Filter != nil {
-		n += m.Filter.Size()
-	}
-	if m.Verbosity != 0 {
-		n += 1 + sovSchedulerReporting(uint64(m.Verbosity))
+func (m *JobSchedulingReport) GetNumNodes() int32 {
+	if m != nil {
+		return m.NumNodes
 	}
-	return n
+	return 0
 }
 
-func (m *SchedulingReportRequest_MostRecentForQueue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MostRecentForQueue != nil {
-		l = m.MostRecentForQueue.Size()
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+func (m *JobSchedulingReport) GetNodeTypes() []*NodeTypeSchedulingReport {
+	if m != nil {
+		return m.NodeTypes
 	}
-	return n
+	return nil
 }
-func (m *SchedulingReportRequest_MostRecentForJob) Size() (n int) {
-	if m == nil {
-		return 0
+
+// Outcome of trying to schedule a job on nodes of a particular type.
+type NodeTypeSchedulingReport struct {
+	// Well-known node type the job was tried on as an away job; empty for the job's home nodes.
+	NodeType string `protobuf:"bytes,1,opt,name=node_type,json=nodeType,proto3" json:"nodeType,omitempty"`
+	// Empty if the job was scheduled on a node of this type; otherwise, the reason that excluded the most nodes.
+	UnschedulableReason string `protobuf:"bytes,2,opt,name=unschedulable_reason,json=unschedulableReason,proto3" json:"unschedulableReason,omitempty"`
+	// Number of nodes that could not run the job, by reason.
+	NumExcludedNodesByReason map[string]int32 `protobuf:"bytes,3,rep,name=num_excluded_nodes_by_reason,json=numExcludedNodesByReason,proto3" json:"numExcludedNodesByReason,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *NodeTypeSchedulingReport) Reset()         { *m = NodeTypeSchedulingReport{} }
+func (m *NodeTypeSchedulingReport) String() string { return proto.CompactTextString(m) }
+func (*NodeTypeSchedulingReport) ProtoMessage()    {}
+func (*NodeTypeSchedulingReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6edb75717835892, []int{14}
+}
+func (m *NodeTypeSchedulingReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTypeSchedulingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeTypeSchedulingReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.MostRecentForJob != nil {
-		l = m.MostRecentForJob.Size()
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+}
+func (m *NodeTypeSchedulingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTypeSchedulingReport.Merge(m, src)
+}
+func (m *NodeTypeSchedulingReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTypeSchedulingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTypeSchedulingReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTypeSchedulingReport proto.InternalMessageInfo
+
+func (m *NodeTypeSchedulingReport) GetNodeType() string {
+	if m != nil {
+		return m.NodeType
 	}
-	return n
+	return ""
 }
-func (m *SchedulingReport) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *NodeTypeSchedulingReport) GetUnschedulableReason() string {
+	if m != nil {
+		return m.UnschedulableReason
 	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+	return ""
+}
+
+func (m *NodeTypeSchedulingReport) GetNumExcludedNodesByReason() map[string]int32 {
+	if m != nil {
+		return m.NumExcludedNodesByReason
 	}
-	return n
+	return nil
 }
 
-func (m *QueueReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*MostRecentForQueue)(nil), "schedulerobjects.MostRecentForQueue")
+	proto.RegisterType((*MostRecentForJob)(nil), "schedulerobjects.MostRecentForJob")
+	proto.RegisterType((*SchedulingReportRequest)(nil), "schedulerobjects.SchedulingReportRequest")
+	proto.RegisterType((*SchedulingReport)(nil), "schedulerobjects.SchedulingReport")
+	proto.RegisterType((*PoolSchedulingReport)(nil), "schedulerobjects.PoolSchedulingReport")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PoolSchedulingReport.AllocatedResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PoolSchedulingReport.PreemptedResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PoolSchedulingReport.ScheduledResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PoolSchedulingReport.TotalResourcesEntry")
+	proto.RegisterType((*DrainingNodeReport)(nil), "schedulerobjects.DrainingNodeReport")
+	proto.RegisterType((*QueueSchedulingReport)(nil), "schedulerobjects.QueueSchedulingReport")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.AllocatedResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.ConstrainedDemandEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.DemandEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.ExampleUnschedulableJobIdByReasonEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "schedulerobjects.QueueSchedulingReport.NumUnschedulableJobsByReasonEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.PreemptedByOptimiserResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.PreemptedResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.QueueSchedulingReport.ScheduledResourcesEntry")
+	proto.RegisterType((*QueueReportRequest)(nil), "schedulerobjects.QueueReportRequest")
+	proto.RegisterType((*QueueReport)(nil), "schedulerobjects.QueueReport")
+	proto.RegisterType((*PoolQueueReport)(nil), "schedulerobjects.PoolQueueReport")
+	proto.RegisterType((*JobReportRequest)(nil), "schedulerobjects.JobReportRequest")
+	proto.RegisterType((*JobReport)(nil), "schedulerobjects.JobReport")
+	proto.RegisterType((*PoolJobReport)(nil), "schedulerobjects.PoolJobReport")
+	proto.RegisterType((*JobSchedulingReport)(nil), "schedulerobjects.JobSchedulingReport")
+	proto.RegisterType((*NodeTypeSchedulingReport)(nil), "schedulerobjects.NodeTypeSchedulingReport")
+	proto.RegisterMapType((map[string]int32)(nil), "schedulerobjects.NodeTypeSchedulingReport.NumExcludedNodesByReasonEntry")
+}
+
+func init() {
+	proto.RegisterFile("pkg/api/schedulerobjects/scheduler_reporting.proto", fileDescriptor_c6edb75717835892)
+}
+
+var fileDescriptor_c6edb75717835892 = []byte{
+	// 1986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xd7,
+	0x11, 0xf7, 0x8a, 0x16, 0x25, 0x8e, 0xac, 0x7f, 0x8f, 0x96, 0x45, 0xcb, 0x92, 0x56, 0x5a, 0x27,
+	0xf2, 0x9f, 0x24, 0x22, 0x2a, 0xa3, 0x45, 0x10, 0x14, 0x2e, 0xc2, 0xc6, 0x71, 0xcc, 0xba, 0x4a,
+	0x42, 0x3b, 0x40, 0xd1, 0x0b, 0xb1, 0xcb, 0x7d, 0xa2, 0x56, 0xe6, 0xee, 0x5b, 0xef, 0x1f, 0xc7,
+	0xac, 0xdb, 0x43, 0x6b, 0xb4, 0x40, 0x0f, 0x2d, 0xda, 0xe4, 0x03, 0xf4, 0x2b, 0xf4, 0x0b, 0x14,
+	0xe8, 0xb1, 0x87, 0xb6, 0x48, 0xdb, 0x4b, 0x4f, 0x8b, 0xc2, 0x6e, 0x7b, 0xd8, 0x4f, 0x51, 0xec,
+	0xbc, 0x5d, 0xf2, 0xed, 0x1f, 0x52, 0xa4, 0x13, 0xe5, 0xd4, 0x1b, 0x39, 0x33, 0xfb, 0x9b, 0x79,
+	0x33, 0xf3, 0x66, 0x66, 0x67, 0xe1, 0xc0, 0x7e, 0xd4, 0xad, 0xab, 0xb6, 0x51, 0x77, 0x3b, 0xc7,
+	0x54, 0xf7, 0x7b, 0xd4, 0x61, 0xda, 0x09, 0xed, 0x78, 0xee, 0x90, 0xd0, 0x76, 0xa8, 0xcd, 0x1c,
+	0xcf, 0xb0, 0xba, 0xfb, 0xb6, 0xc3, 0x3c, 0x46, 0x56, 0xb2, 0xb2, 0x1b, 0x9b, 0x5d, 0xc6, 0xba,
+	0x3d, 0x8a, 0x40, 0xaa, 0x65, 0x31, 0x4f, 0xf5, 0x0c, 0x66, 0xb9, 0x5c, 0x7e, 0x43, 0x8e, 0xb9,
+	0xf8, 0x4f, 0xf3, 0x8f, 0xea, 0x9e, 0x61, 0x52, 0xd7, 0x53, 0x4d, 0x9b, 0x0b, 0x28, 0xf7, 0x81,
+	0x7c, 0x9f, 0xb9, 0x5e, 0x8b, 0x76, 0xa8, 0xe5, 0xbd, 0xcf, 0x9c, 0x8f, 0x7d, 0xea, 0x53, 0xf2,
+	0x2d, 0x80, 0xc7, 0xd1, 0x8f, 0xb6, 0xa5, 0x9a, 0xb4, 0x26, 0xed, 0x48, 0xd7, 0x2b, 0x8d, 0xf5,
+	0x30, 0x90, 0xab, 0x48, 0x3d, 0x54, 0x4d, 0xfa, 0x26, 0x33, 0x0d, 0x8f, 0x9a, 0xb6, 0xd7, 0x6f,
+	0x55, 0x06, 0x44, 0xe5, 0x36, 0xac, 0xa4, 0xd0, 0x9a, 0x4c, 0x23, 0x37, 0xa1, 0x7c, 0xc2, 0xb4,
+	0xb6, 0xa1, 0xc7, 0x38, 0xd5, 0x30, 0x90, 0x97, 0x4f, 0x98, 0x76, 0x4f, 0x17, 0x30, 0x66, 0x91,
+	0xa0, 0xfc, 0x79, 0x06, 0xd6, 0x1f, 0xf0, 0x13, 0x1a, 0x56, 0xb7, 0x85, 0x87, 0x6f, 0xd1, 0xc7,
+	0x3e, 0x75, 0x3d, 0xf2, 0x0c, 0xd6, 0x4c, 0xe6, 0x7a, 0x6d, 0x07, 0xc1, 0xdb, 0x47, 0xcc, 0x69,
+	0xa3, 0x62, 0x84, 0x5d, 0x38, 0x78, 0x6d, 0x3f, 0xeb, 0x9a, 0xfd, 0xfc, 0xc1, 0x1a, 0x3b, 0x61,
+	0x20, 0x6f, 0x9a, 0x39, 0xfa, 0xd0, 0x92, 0x0f, 0xce, 0xb5, 0x48, 0x9e, 0x4f, 0x5c, 0xa8, 0x66,
+	0x95, 0x9f, 0x30, 0xad, 0x36, 0x83, 0xaa, 0x95, 0x53, 0x54, 0x37, 0x99, 0xd6, 0xd8, 0x0e, 0x03,
+	0x79, 0xc3, 0xcc, 0x50, 0x53, 0x6a, 0x57, 0xb2, 0x5c, 0xf2, 0x4d, 0xa8, 0x3c, 0xa1, 0x8e, 0xc6,
+	0x5c, 0xc3, 0xeb, 0xd7, 0x4a, 0x3b, 0xd2, 0xf5, 0x59, 0x1e, 0x84, 0x01, 0x51, 0x0c, 0xc2, 0x80,
+	0xd8, 0x98, 0x87, 0xf2, 0x91, 0xd1, 0xf3, 0xa8, 0xa3, 0x7c, 0x2e, 0xc1, 0x4a, 0xd6, 0x9d, 0xe4,
+	0x4d, 0x28, 0xf3, 0xac, 0x8a, 0xe3, 0x71, 0x31, 0x0c, 0xe4, 0x15, 0x4e, 0x11, 0xf0, 0x62, 0x19,
+	0xf2, 0x31, 0xcc, 0xda, 0x8c, 0xf5, 0xdc, 0xda, 0xcc, 0x4e, 0xe9, 0xfa, 0xc2, 0xc1, 0x5e, 0xfe,
+	0xa8, 0x1f, 0x31, 0xd6, 0xcb, 0x2a, 0xe1, 0x41, 0xc6, 0x07, 0xc5, 0x20, 0x23, 0x41, 0xf9, 0xdb,
+	0x22, 0x5c, 0x2c, 0x7a, 0x88, 0xec, 0xc1, 0xf9, 0x48, 0x22, 0xb6, 0x8b, 0x84, 0x81, 0xbc, 0x14,
+	0xfd, 0x17, 0x10, 0x90, 0x4f, 0xbe, 0x07, 0x73, 0xae, 0xa7, 0x3a, 0x1e, 0xd5, 0xe3, 0x00, 0x6c,
+	0xec, 0xf3, 0x34, 0xdf, 0x4f, 0xd2, 0x7c, 0xff, 0x61, 0x92, 0xe6, 0x8d, 0xb5, 0x30, 0x90, 0x57,
+	0x63, 0x71, 0x01, 0x29, 0x41, 0x20, 0x87, 0x30, 0x7f, 0x64, 0x58, 0x86, 0x7b, 0x4c, 0x75, 0xf4,
+	0xf1, 0x78, 0xb4, 0x4b, 0x61, 0x20, 0x93, 0x44, 0x5e, 0x80, 0x1b, 0x60, 0x90, 0x43, 0x20, 0x1e,
+	0x75, 0x4c, 0xc3, 0xc2, 0x7b, 0xd8, 0x76, 0xa8, 0xea, 0x32, 0xab, 0x76, 0x1e, 0x8f, 0x24, 0x87,
+	0x81, 0x7c, 0x45, 0xe0, 0xb6, 0x90, 0x29, 0xc0, 0xac, 0xe6, 0x98, 0xe4, 0xa7, 0x12, 0x2c, 0x7b,
+	0xcc, 0x53, 0x7b, 0x6d, 0x87, 0xba, 0xcc, 0x77, 0x3a, 0xd4, 0xad, 0xcd, 0x62, 0x2c, 0xde, 0x99,
+	0x2c, 0x16, 0xfb, 0x0f, 0xa3, 0xa7, 0x5b, 0xc9, 0xc3, 0x77, 0x2c, 0xcf, 0xe9, 0x37, 0x36, 0xc3,
+	0x40, 0xae, 0x79, 0x29, 0x86, 0x60, 0xc6, 0x52, 0x9a, 0x43, 0x7e, 0x2b, 0x41, 0x55, 0xed, 0xf5,
+	0x58, 0x47, 0xf5, 0xa8, 0x2e, 0xd8, 0x51, 0x46, 0x3b, 0x6e, 0x4f, 0x68, 0xc7, 0xbb, 0x09, 0x42,
+	0xc6, 0x16, 0xbc, 0x93, 0x6a, 0x8e, 0x29, 0xd8, 0x43, 0xf2, 0x5c, 0xb4, 0x29, 0xd1, 0x2b, 0xda,
+	0x34, 0x37, 0x95, 0x4d, 0x0f, 0x12, 0x84, 0x22, 0x9b, 0xdc, 0x1c, 0x53, 0xb4, 0x29, 0xcf, 0x45,
+	0x9b, 0x6c, 0x87, 0x46, 0x02, 0x29, 0x9b, 0xe6, 0xa7, 0xb2, 0xe9, 0xa3, 0x04, 0xa1, 0xc8, 0x26,
+	0x3b, 0xc7, 0x14, 0x6d, 0xca, 0x73, 0xc9, 0x7d, 0x20, 0x96, 0x6f, 0xb6, 0x87, 0xae, 0x3a, 0x61,
+	0x9a, 0x5b, 0xab, 0x60, 0x35, 0xc1, 0xa2, 0x64, 0xf9, 0xe6, 0xc0, 0x0b, 0x4d, 0xa6, 0x89, 0x78,
+	0x2b, 0x59, 0x1e, 0xf9, 0x10, 0xaa, 0x69, 0xb4, 0xae, 0x6a, 0x75, 0xdd, 0x1a, 0x20, 0x1c, 0xa6,
+	0xb7, 0xf8, 0xc8, 0xdd, 0x88, 0x29, 0xa6, 0x77, 0x8e, 0x99, 0x98, 0x37, 0xf4, 0x1a, 0x9a, 0xb7,
+	0x90, 0x32, 0x6f, 0xe0, 0x90, 0x02, 0xf3, 0x52, 0x3c, 0xf2, 0x09, 0x94, 0xb1, 0x27, 0xb8, 0xb5,
+	0x0b, 0xe8, 0xf2, 0x6b, 0x79, 0x97, 0x63, 0x3d, 0xcf, 0xd5, 0x2b, 0x2c, 0x82, 0xfc, 0x51, 0xb1,
+	0x08, 0x72, 0x0a, 0x39, 0x86, 0x25, 0xdd, 0x51, 0x0d, 0xcb, 0xb0, 0xba, 0x6d, 0x8b, 0xe9, 0xd4,
+	0xad, 0x2d, 0x22, 0x7c, 0x41, 0xcf, 0x79, 0x2f, 0x96, 0x3b, 0x64, 0x3a, 0x8d, 0xb1, 0xaf, 0x84,
+	0x81, 0xbc, 0xae, 0x0b, 0x74, 0x51, 0xc5, 0x62, 0x8a, 0xb1, 0x61, 0x40, 0xb5, 0xe0, 0xba, 0x92,
+	0xab, 0x50, 0x7a, 0x44, 0xfb, 0x71, 0x61, 0x5c, 0x0d, 0x03, 0x79, 0xf1, 0x11, 0x15, 0xab, 0x7f,
+	0xc4, 0x25, 0x37, 0x60, 0xf6, 0x89, 0xda, 0xf3, 0x29, 0x16, 0xc5, 0xb8, 0xcf, 0x22, 0x41, 0x2c,
+	0xc1, 0x48, 0x78, 0x67, 0xe6, 0x6d, 0x69, 0xc3, 0x84, 0xf5, 0x11, 0x37, 0xf2, 0xac, 0xd4, 0x8d,
+	0xb8, 0x6c, 0x67, 0xa5, 0x6e, 0xc4, 0x3d, 0x3a, 0x0b, 0x75, 0xca, 0x7f, 0x66, 0x80, 0xe4, 0x43,
+	0x4f, 0x0e, 0x60, 0x9e, 0x3e, 0xa5, 0x1d, 0xdf, 0x63, 0x4e, 0xac, 0x0f, 0x1b, 0x48, 0x42, 0x13,
+	0x1b, 0x48, 0x42, 0x8b, 0xba, 0x60, 0x94, 0x63, 0xb1, 0x62, 0xec, 0x82, 0xd1, 0x7f, 0xb1, 0x0b,
+	0x46, 0xff, 0x79, 0x1f, 0xc7, 0xe6, 0x52, 0x12, 0xfb, 0x78, 0xa6, 0xa3, 0xc4, 0x32, 0xa4, 0x0e,
+	0x73, 0xf1, 0x1d, 0xc3, 0x5e, 0x34, 0xcf, 0xfb, 0x62, 0x4c, 0x12, 0xfb, 0x62, 0x4c, 0x22, 0xf7,
+	0x60, 0xd5, 0xa1, 0x66, 0x9c, 0xf4, 0x7c, 0x80, 0xe3, 0x8d, 0xa7, 0xd2, 0xd8, 0x0a, 0x03, 0xf9,
+	0xf2, 0x80, 0xd9, 0x8c, 0x26, 0x37, 0x31, 0xa5, 0x97, 0x33, 0xac, 0x34, 0x94, 0xe3, 0x5b, 0x08,
+	0x55, 0x2e, 0x80, 0x6a, 0xf9, 0xd6, 0x28, 0x28, 0xce, 0x52, 0xfe, 0xbb, 0x0e, 0x6b, 0x85, 0x37,
+	0x38, 0x0a, 0xd8, 0x70, 0x1c, 0x8c, 0x03, 0xf6, 0x38, 0x3d, 0xdb, 0xb5, 0xb8, 0x04, 0x69, 0xc0,
+	0xf9, 0x68, 0x0c, 0x9e, 0x60, 0x78, 0x40, 0xef, 0x47, 0xb2, 0xa2, 0xf7, 0xa3, 0xff, 0x91, 0xf7,
+	0x3f, 0xa5, 0x46, 0xf7, 0xd8, 0x43, 0xef, 0x4b, 0xdc, 0xfb, 0x9c, 0x22, 0x7a, 0x9f, 0x53, 0xa2,
+	0x79, 0xfa, 0x48, 0x35, 0x9c, 0xb6, 0x7b, 0xac, 0x3a, 0x14, 0x03, 0x20, 0xf1, 0x51, 0x2e, 0xa2,
+	0x3e, 0x88, 0x88, 0xe2, 0x28, 0x37, 0x20, 0x46, 0xe5, 0x56, 0xd5, 0x4f, 0x7c, 0x37, 0x2a, 0x8c,
+	0x02, 0xc0, 0x2c, 0x02, 0x60, 0xb9, 0x4d, 0xd8, 0xef, 0x17, 0x00, 0xad, 0xe6, 0x98, 0xe4, 0x18,
+	0x36, 0x7d, 0xab, 0xa3, 0xda, 0x36, 0xd5, 0xdb, 0x45, 0xc8, 0x65, 0x44, 0xbe, 0x16, 0x06, 0xf2,
+	0xd5, 0x44, 0xee, 0xdd, 0x31, 0x1a, 0x2e, 0x8f, 0x14, 0x22, 0xdf, 0x86, 0x0b, 0x6a, 0xc7, 0xf3,
+	0xd5, 0x5e, 0x8c, 0x3c, 0x87, 0xc8, 0x97, 0xc3, 0x40, 0x5e, 0xe3, 0xf4, 0x2c, 0xd6, 0x82, 0x40,
+	0x26, 0x9f, 0x8d, 0x98, 0x38, 0x78, 0x27, 0xfd, 0xce, 0x84, 0x65, 0xfd, 0x2b, 0x1e, 0x39, 0x54,
+	0x28, 0xeb, 0xd4, 0x54, 0x2d, 0xbd, 0x56, 0x41, 0x33, 0x6e, 0x4d, 0x6a, 0xc6, 0x7b, 0xf8, 0x14,
+	0x57, 0x8d, 0x89, 0xc2, 0x61, 0xc4, 0x44, 0xe1, 0x14, 0xf2, 0x6b, 0x09, 0x48, 0x87, 0x59, 0xae,
+	0x17, 0xd5, 0x12, 0xaa, 0xb7, 0x63, 0x7d, 0x30, 0x6a, 0x80, 0x28, 0xd6, 0xf7, 0xdd, 0x21, 0x82,
+	0xa8, 0x1a, 0x13, 0xa6, 0x93, 0xe5, 0x89, 0x09, 0x93, 0x63, 0x62, 0x20, 0x8a, 0xc6, 0xac, 0x85,
+	0xe9, 0x02, 0xf1, 0xd5, 0xce, 0x59, 0x9f, 0x8d, 0x98, 0xb3, 0x2e, 0x4c, 0x67, 0xd4, 0xd7, 0x31,
+	0x68, 0x2d, 0xbe, 0xe2, 0xa0, 0x55, 0x3c, 0x17, 0x2d, 0xbd, 0xe2, 0x5c, 0xf4, 0x03, 0xb8, 0x14,
+	0xa1, 0xf9, 0x56, 0x6c, 0x9d, 0xaa, 0xf5, 0x28, 0x47, 0x5c, 0x46, 0x44, 0x25, 0x0c, 0xe4, 0x6d,
+	0xcb, 0x37, 0x3f, 0x11, 0x05, 0x32, 0xa8, 0x17, 0x8b, 0xf8, 0xe4, 0x0f, 0x12, 0xec, 0x14, 0x43,
+	0xb7, 0xb5, 0x7e, 0xf2, 0xf6, 0xb3, 0x82, 0x71, 0xb9, 0x37, 0x69, 0x5c, 0x0e, 0x0b, 0x14, 0x35,
+	0xfa, 0xfc, 0x7d, 0x88, 0x47, 0xe8, 0x66, 0x18, 0xc8, 0x7b, 0xd6, 0x18, 0x31, 0xc1, 0xee, 0xcd,
+	0x71, 0x72, 0xe4, 0xef, 0x12, 0xec, 0xd1, 0xa7, 0xaa, 0x69, 0xf7, 0x68, 0xfe, 0x0c, 0x6d, 0x43,
+	0x17, 0x4e, 0xb1, 0x8a, 0xa7, 0x38, 0x9c, 0xf4, 0x14, 0x77, 0x38, 0x6a, 0x56, 0xf5, 0x3d, 0x3d,
+	0x7d, 0x94, 0x7a, 0x18, 0xc8, 0x6f, 0xd0, 0xd3, 0x64, 0x85, 0xf3, 0xec, 0x9e, 0x2a, 0x4c, 0xfe,
+	0x28, 0xc1, 0xce, 0x30, 0x73, 0xb4, 0x7e, 0x9b, 0xd9, 0x9e, 0x61, 0x1a, 0x2e, 0x2e, 0x94, 0x92,
+	0xcb, 0x42, 0xf0, 0x38, 0xcd, 0xa9, 0x2f, 0x4b, 0xa3, 0xff, 0x61, 0x82, 0x96, 0xb9, 0x37, 0x6f,
+	0x84, 0x81, 0x7c, 0xcd, 0x1e, 0x27, 0x27, 0x1c, 0x63, 0x6b, 0xac, 0x20, 0x61, 0x20, 0xa7, 0xf3,
+	0x3f, 0x75, 0x0a, 0x4c, 0xdd, 0x2a, 0xa6, 0xee, 0x8d, 0x30, 0x90, 0x5f, 0x17, 0x13, 0x5e, 0x80,
+	0xcb, 0x64, 0xf0, 0x95, 0x31, 0x62, 0x5f, 0xf7, 0x38, 0xac, 0xc2, 0x82, 0x50, 0x9a, 0xcf, 0x44,
+	0x45, 0x0f, 0x2e, 0x15, 0x37, 0x82, 0xff, 0xcf, 0xf7, 0x53, 0xab, 0xfb, 0x14, 0x76, 0x4f, 0xad,
+	0x4a, 0xaf, 0xa0, 0x78, 0xf6, 0x54, 0xc5, 0x3f, 0x86, 0xbd, 0xc9, 0x0a, 0xc9, 0x99, 0x1c, 0xfb,
+	0x29, 0x28, 0xa7, 0xdf, 0xfb, 0x33, 0x79, 0xa1, 0x7a, 0x2e, 0x01, 0xc1, 0x42, 0x94, 0x5e, 0x02,
+	0xbf, 0xe2, 0x62, 0x3a, 0xbd, 0x4a, 0x9d, 0x99, 0x74, 0x95, 0xaa, 0xfc, 0x52, 0x82, 0x05, 0xc1,
+	0x8a, 0x29, 0x77, 0xa7, 0xf7, 0xd3, 0xbb, 0xd3, 0xdd, 0xe2, 0xfd, 0x8f, 0x80, 0x3f, 0x76, 0x6d,
+	0xfa, 0x2b, 0x09, 0x96, 0x33, 0xf2, 0x13, 0x6f, 0x4c, 0x5b, 0xc9, 0xcb, 0x11, 0x7f, 0xe5, 0x99,
+	0x78, 0x2d, 0x32, 0xe6, 0x2d, 0x4a, 0xb9, 0x0d, 0x2b, 0x4d, 0xa6, 0xa5, 0xc3, 0x33, 0xcd, 0xae,
+	0xff, 0xe7, 0x12, 0x54, 0x06, 0x00, 0x53, 0x7a, 0xb6, 0x99, 0xf6, 0xac, 0x5c, 0xec, 0xd9, 0x01,
+	0xfa, 0x58, 0xbf, 0x3e, 0x97, 0x60, 0x31, 0x25, 0x3d, 0xb1, 0x57, 0x9b, 0x50, 0x1a, 0x7e, 0x04,
+	0x78, 0x3d, 0x6f, 0x43, 0x93, 0x69, 0x39, 0x8f, 0xe2, 0xf5, 0x38, 0x11, 0x57, 0xff, 0xad, 0x08,
+	0x44, 0xf9, 0x6b, 0x09, 0xaa, 0x05, 0xf2, 0xd3, 0x78, 0x74, 0xf8, 0x0a, 0x3c, 0x33, 0xf1, 0x2b,
+	0x70, 0xe9, 0x4b, 0xbc, 0x02, 0x3f, 0x84, 0x8b, 0xe9, 0x89, 0x29, 0xb5, 0xeb, 0xde, 0x0d, 0x03,
+	0x79, 0x2b, 0xc5, 0xcf, 0x4d, 0x32, 0xd5, 0x02, 0x36, 0x79, 0x0b, 0xe6, 0x2c, 0xa6, 0xd3, 0xe8,
+	0xc4, 0xb3, 0xc3, 0x4c, 0x88, 0x48, 0xa9, 0x23, 0x97, 0x39, 0x85, 0xdc, 0x82, 0x4a, 0x34, 0x27,
+	0xf0, 0xad, 0x5c, 0x19, 0x2f, 0x36, 0xae, 0x58, 0x2c, 0xdf, 0xcc, 0xae, 0xda, 0xe6, 0x13, 0x1a,
+	0xd1, 0x00, 0x50, 0x87, 0xd7, 0xb7, 0x07, 0x1b, 0xe3, 0x9b, 0xf9, 0xf8, 0x45, 0xc2, 0x0f, 0xfb,
+	0x76, 0xfe, 0x5a, 0x60, 0xe9, 0xb0, 0x62, 0xae, 0xa8, 0xa2, 0x32, 0x20, 0x2a, 0x7f, 0x29, 0x41,
+	0x6d, 0x14, 0x00, 0x5a, 0x9d, 0x18, 0x20, 0x2e, 0x86, 0x92, 0xc7, 0x53, 0x56, 0xc7, 0xb4, 0x91,
+	0xfe, 0x9e, 0xf9, 0x52, 0xfe, 0xfe, 0xbd, 0x04, 0xd1, 0x84, 0xdc, 0xa6, 0x4f, 0x3b, 0x3d, 0x5f,
+	0xa7, 0x3a, 0x77, 0xa5, 0x30, 0xf6, 0x96, 0xd0, 0x3d, 0x1f, 0x4c, 0xee, 0x9e, 0x68, 0x7e, 0xbf,
+	0x13, 0xa3, 0xa1, 0xbf, 0xd3, 0x03, 0xef, 0x5e, 0x18, 0xc8, 0x8a, 0x35, 0x42, 0x44, 0xb0, 0xb6,
+	0x36, 0x4a, 0x66, 0xc3, 0x85, 0xad, 0xb1, 0x2a, 0xce, 0xa2, 0x11, 0x1f, 0xfc, 0xae, 0x04, 0x24,
+	0x19, 0x70, 0x9c, 0x56, 0xf2, 0x59, 0x96, 0x3c, 0x97, 0xa0, 0x7a, 0x97, 0x7a, 0xf9, 0x75, 0x54,
+	0xde, 0x5f, 0x23, 0x3e, 0x6c, 0x6e, 0x28, 0xa7, 0x8b, 0x2a, 0x5b, 0x3f, 0xfb, 0xc7, 0xbf, 0x3f,
+	0x9f, 0x59, 0x27, 0x6b, 0xf5, 0x27, 0xdf, 0x48, 0x3e, 0x0f, 0x1b, 0x56, 0xf7, 0xad, 0xb8, 0x1e,
+	0xfe, 0x42, 0x82, 0xa5, 0xbb, 0xd4, 0x13, 0x5b, 0xc3, 0x6b, 0x23, 0x6a, 0x7c, 0x5a, 0xf7, 0xd6,
+	0x58, 0x29, 0xa5, 0x8e, 0x6a, 0x6f, 0x90, 0x6b, 0x91, 0x5a, 0xac, 0x1c, 0xf5, 0x67, 0xc3, 0xfe,
+	0xfb, 0x93, 0xe1, 0x87, 0xea, 0xc4, 0x90, 0x1f, 0xc1, 0x85, 0xbb, 0xd4, 0x1b, 0x96, 0x52, 0xa5,
+	0xb0, 0x2a, 0xa6, 0x6d, 0xb8, 0x32, 0x46, 0x46, 0xb9, 0x81, 0x16, 0x5c, 0x25, 0xbb, 0x91, 0x05,
+	0x27, 0x4c, 0xab, 0x3f, 0xe3, 0xe5, 0x30, 0xaf, 0xbb, 0xd1, 0xfa, 0xd3, 0x8b, 0x6d, 0xe9, 0x8b,
+	0x17, 0xdb, 0xd2, 0xbf, 0x5e, 0x6c, 0x4b, 0xbf, 0x79, 0xb9, 0x7d, 0xee, 0x8b, 0x97, 0xdb, 0xe7,
+	0xfe, 0xf9, 0x72, 0xfb, 0xdc, 0x0f, 0xdf, 0xee, 0x1a, 0xde, 0xb1, 0xaf, 0xed, 0x77, 0x98, 0x59,
+	0x57, 0x1d, 0x53, 0xd5, 0x55, 0xdb, 0x61, 0x91, 0xa6, 0xf8, 0x5f, 0x7d, 0xd4, 0x97, 0x78, 0xad,
+	0x8c, 0x15, 0xf1, 0xd6, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x43, 0x2a, 0xe9, 0xac, 0x1f,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SchedulerReportingClient is the client API for SchedulerReporting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SchedulerReportingClient interface {
+	// Return the most recent scheduling report for each executor.
+	GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error)
+	// Return the most recent report scheduling for each executor for the given queue.
+	GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error)
+	// Return the most recent scheduling report for each executor for the given job.
+	GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error)
+}
+
+type schedulerReportingClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSchedulerReportingClient(cc *grpc.ClientConn) SchedulerReportingClient {
+	return &schedulerReportingClient{cc}
+}
+
+func (c *schedulerReportingClient) GetSchedulingReport(ctx context.Context, in *SchedulingReportRequest, opts ...grpc.CallOption) (*SchedulingReport, error) {
+	out := new(SchedulingReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetSchedulingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.QueueName)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetQueueReport(ctx context.Context, in *QueueReportRequest, opts ...grpc.CallOption) (*QueueReport, error) {
+	out := new(QueueReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetQueueReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Verbosity != 0 {
-		n += 1 + sovSchedulerReporting(uint64(m.Verbosity))
+	return out, nil
+}
+
+func (c *schedulerReportingClient) GetJobReport(ctx context.Context, in *JobReportRequest, opts ...grpc.CallOption) (*JobReport, error) {
+	out := new(JobReport)
+	err := c.cc.Invoke(ctx, "/schedulerobjects.SchedulerReporting/GetJobReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueueReport) Size() (n int) {
-	if m == nil {
-		return 0
+// SchedulerReportingServer is the server API for SchedulerReporting service.
+type SchedulerReportingServer interface {
+	// Return the most recent scheduling report for each executor.
+	GetSchedulingReport(context.Context, *SchedulingReportRequest) (*SchedulingReport, error)
+	// Return the most recent report scheduling for each executor for the given queue.
+	GetQueueReport(context.Context, *QueueReportRequest) (*QueueReport, error)
+	// Return the most recent scheduling report for each executor for the given job.
+	GetJobReport(context.Context, *JobReportRequest) (*JobReport, error)
+}
+
+// UnimplementedSchedulerReportingServer can be embedded to have forward compatible implementations.
+type UnimplementedSchedulerReportingServer struct {
+}
+
+func (*UnimplementedSchedulerReportingServer) GetSchedulingReport(ctx context.Context, req *SchedulingReportRequest) (*SchedulingReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetQueueReport(ctx context.Context, req *QueueReportRequest) (*QueueReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueReport not implemented")
+}
+func (*UnimplementedSchedulerReportingServer) GetJobReport(ctx context.Context, req *JobReportRequest) (*JobReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobReport not implemented")
+}
+
+func RegisterSchedulerReportingServer(s *grpc.Server, srv SchedulerReportingServer) {
+	s.RegisterService(&_SchedulerReporting_serviceDesc, srv)
+}
+
+func _SchedulerReporting_GetSchedulingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetSchedulingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetSchedulingReport(ctx, req.(*SchedulingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _SchedulerReporting_GetQueueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetQueueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetQueueReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetQueueReport(ctx, req.(*QueueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerReporting_GetJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerReportingServer).GetJobReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerobjects.SchedulerReporting/GetJobReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerReportingServer).GetJobReport(ctx, req.(*JobReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SchedulerReporting_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schedulerobjects.SchedulerReporting",
+	HandlerType: (*SchedulerReportingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedulingReport",
+			Handler:    _SchedulerReporting_GetSchedulingReport_Handler,
+		},
+		{
+			MethodName: "GetQueueReport",
+			Handler:    _SchedulerReporting_GetQueueReport_Handler,
+		},
+		{
+			MethodName: "GetJobReport",
+			Handler:    _SchedulerReporting_GetJobReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/schedulerobjects/scheduler_reporting.proto",
+}
+
+func (m *MostRecentForQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MostRecentForJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verbosity != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.Verbosity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Filter != nil {
+		{
+			size := m.Filter.Size()
+			i -= size
+			if _, err := m.Filter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MostRecentForQueue != nil {
+		{
+			size, err := m.MostRecentForQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchedulingReportRequest_MostRecentForJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReportRequest_MostRecentForJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MostRecentForJob != nil {
+		{
+			size, err := m.MostRecentForJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrainingNodes) > 0 {
+		for iNdEx := len(m.DrainingNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrainingNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.NumPreemptedJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumPreemptedJobs))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NumScheduledGangs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumScheduledGangs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NumScheduledJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumScheduledJobs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PreemptedResources) > 0 {
+		for k := range m.PreemptedResources {
+			v := m.PreemptedResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScheduledResources) > 0 {
+		for k := range m.ScheduledResources {
+			v := m.ScheduledResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllocatedResources) > 0 {
+		for k := range m.AllocatedResources {
+			v := m.AllocatedResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalResources) > 0 {
+		for k := range m.TotalResources {
+			v := m.TotalResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TerminationReason) > 0 {
+		i -= len(m.TerminationReason)
+		copy(dAtA[i:], m.TerminationReason)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.TerminationReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainingNodeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainingNodeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainingNodeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingRunIds) > 0 {
+		for iNdEx := len(m.RemainingRunIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemainingRunIds[iNdEx])
+			copy(dAtA[i:], m.RemainingRunIds[iNdEx])
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.RemainingRunIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemainingJobIds) > 0 {
+		for iNdEx := len(m.RemainingJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemainingJobIds[iNdEx])
+			copy(dAtA[i:], m.RemainingJobIds[iNdEx])
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.RemainingJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueSchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueSchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueSchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPreemptedByOptimiserJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumPreemptedByOptimiserJobs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PreemptedByOptimiserResources) > 0 {
+		for k := range m.PreemptedByOptimiserResources {
+			v := m.PreemptedByOptimiserResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ExampleUnschedulableJobIdByReason) > 0 {
+		for k := range m.ExampleUnschedulableJobIdByReason {
+			v := m.ExampleUnschedulableJobIdByReason[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.NumUnschedulableJobsByReason) > 0 {
+		for k := range m.NumUnschedulableJobsByReason {
+			v := m.NumUnschedulableJobsByReason[k]
+			baseI := i
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NumUnschedulableJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumUnschedulableJobs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.NumPreemptedJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumPreemptedJobs))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NumScheduledJobs != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumScheduledJobs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PreemptedResources) > 0 {
+		for k := range m.PreemptedResources {
+			v := m.PreemptedResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScheduledResources) > 0 {
+		for k := range m.ScheduledResources {
+			v := m.ScheduledResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConstrainedDemand) > 0 {
+		for k := range m.ConstrainedDemand {
+			v := m.ConstrainedDemand[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Demand) > 0 {
+		for k := range m.Demand {
+			v := m.Demand[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllocatedResources) > 0 {
+		for k := range m.AllocatedResources {
+			v := m.AllocatedResources[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ActualShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ActualShare))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.UncappedAdjustedFairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UncappedAdjustedFairShare))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.AdjustedFairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AdjustedFairShare))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.FairShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FairShare))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Weight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verbosity != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.Verbosity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueueName) > 0 {
+		i -= len(m.QueueName)
+		copy(dAtA[i:], m.QueueName)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.QueueName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolQueueReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolQueueReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolQueueReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolJobReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolJobReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolJobReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for iNdEx := len(m.NodeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NumNodes != 0 {
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(m.NumNodes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UnschedulableReason) > 0 {
+		i -= len(m.UnschedulableReason)
+		copy(dAtA[i:], m.UnschedulableReason)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.UnschedulableReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeTypeSchedulingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTypeSchedulingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTypeSchedulingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NumExcludedNodesByReason) > 0 {
+		for k := range m.NumExcludedNodesByReason {
+			v := m.NumExcludedNodesByReason[k]
+			baseI := i
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerReporting(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UnschedulableReason) > 0 {
+		i -= len(m.UnschedulableReason)
+		copy(dAtA[i:], m.UnschedulableReason)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.UnschedulableReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeType) > 0 {
+		i -= len(m.NodeType)
+		copy(dAtA[i:], m.NodeType)
+		i = encodeVarintSchedulerReporting(dAtA, i, uint64(len(m.NodeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedulerReporting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedulerReporting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MostRecentForQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *MostRecentForJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulingReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != nil {
+		n += m.Filter.Size()
+	}
+	if m.Verbosity != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.Verbosity))
+	}
+	return n
+}
+
+func (m *SchedulingReportRequest_MostRecentForQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MostRecentForQueue != nil {
+		l = m.MostRecentForQueue.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+func (m *SchedulingReportRequest_MostRecentForJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MostRecentForJob != nil {
+		l = m.MostRecentForJob.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+func (m *SchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolSchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.TerminationReason)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if len(m.TotalResources) > 0 {
+		for k, v := range m.TotalResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AllocatedResources) > 0 {
+		for k, v := range m.AllocatedResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ScheduledResources) > 0 {
+		for k, v := range m.ScheduledResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PreemptedResources) > 0 {
+		for k, v := range m.PreemptedResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if m.NumScheduledJobs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumScheduledJobs))
+	}
+	if m.NumScheduledGangs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumScheduledGangs))
+	}
+	if m.NumPreemptedJobs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumPreemptedJobs))
+	}
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	if len(m.DrainingNodes) > 0 {
+		for _, e := range m.DrainingNodes {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DrainingNodeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Preempt {
+		n += 2
+	}
+	if len(m.RemainingJobIds) > 0 {
+		for _, s := range m.RemainingJobIds {
+			l = len(s)
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	if len(m.RemainingRunIds) > 0 {
+		for _, s := range m.RemainingRunIds {
+			l = len(s)
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueSchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
+	if m.FairShare != 0 {
+		n += 9
+	}
+	if m.AdjustedFairShare != 0 {
+		n += 9
+	}
+	if m.UncappedAdjustedFairShare != 0 {
+		n += 9
+	}
+	if m.ActualShare != 0 {
+		n += 9
+	}
+	if len(m.AllocatedResources) > 0 {
+		for k, v := range m.AllocatedResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Demand) > 0 {
+		for k, v := range m.Demand {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ConstrainedDemand) > 0 {
+		for k, v := range m.ConstrainedDemand {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ScheduledResources) > 0 {
+		for k, v := range m.ScheduledResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PreemptedResources) > 0 {
+		for k, v := range m.PreemptedResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if m.NumScheduledJobs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumScheduledJobs))
+	}
+	if m.NumPreemptedJobs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumPreemptedJobs))
+	}
+	if m.NumUnschedulableJobs != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumUnschedulableJobs))
+	}
+	if len(m.NumUnschedulableJobsByReason) > 0 {
+		for k, v := range m.NumUnschedulableJobsByReason {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + sovSchedulerReporting(uint64(v))
+			n += mapEntrySize + 2 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ExampleUnschedulableJobIdByReason) > 0 {
+		for k, v := range m.ExampleUnschedulableJobIdByReason {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 2 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PreemptedByOptimiserResources) > 0 {
+		for k, v := range m.PreemptedByOptimiserResources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + len(v) + sovSchedulerReporting(uint64(len(v)))
+			n += mapEntrySize + 2 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	if m.NumPreemptedByOptimiserJobs != 0 {
+		n += 2 + sovSchedulerReporting(uint64(m.NumPreemptedByOptimiserJobs))
+	}
+	return n
+}
+
+func (m *QueueReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueueName)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Verbosity != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.Verbosity))
+	}
+	return n
+}
+
+func (m *QueueReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolQueueReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolJobReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.UnschedulableReason)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovSchedulerReporting(uint64(m.NumNodes))
+	}
+	if len(m.NodeTypes) > 0 {
+		for _, e := range m.NodeTypes {
+			l = e.Size()
+			n += 1 + l + sovSchedulerReporting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeTypeSchedulingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeType)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	l = len(m.UnschedulableReason)
+	if l > 0 {
+		n += 1 + l + sovSchedulerReporting(uint64(l))
+	}
+	if len(m.NumExcludedNodesByReason) > 0 {
+		for k, v := range m.NumExcludedNodesByReason {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerReporting(uint64(len(k))) + 1 + sovSchedulerReporting(uint64(v))
+			n += mapEntrySize + 1 + sovSchedulerReporting(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovSchedulerReporting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedulerReporting(x uint64) (n int) {
+	return sovSchedulerReporting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MostRecentForQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MostRecentForQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MostRecentForJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MostRecentForJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MostRecentForQueue{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Filter = &SchedulingReportRequest_MostRecentForQueue{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MostRecentForJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Filter = &SchedulingReportRequest_MostRecentForJob{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			}
+			m.Verbosity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verbosity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolSchedulingReport{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolSchedulingReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSchedulingReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalResources == nil {
+				m.TotalResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatedResources == nil {
+				m.AllocatedResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatedResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledResources == nil {
+				m.ScheduledResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ScheduledResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreemptedResources == nil {
+				m.PreemptedResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PreemptedResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumScheduledJobs", wireType)
+			}
+			m.NumScheduledJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumScheduledJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumScheduledGangs", wireType)
+			}
+			m.NumScheduledGangs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumScheduledGangs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPreemptedJobs", wireType)
+			}
+			m.NumPreemptedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPreemptedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueSchedulingReport{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainingNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrainingNodes = append(m.DrainingNodes, &DrainingNodeReport{})
+			if err := m.DrainingNodes[len(m.DrainingNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainingNodeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainingNodeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainingNodeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempt = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingJobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingJobIds = append(m.RemainingJobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRunIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingRunIds = append(m.RemainingRunIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueSchedulingReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueSchedulingReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueSchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Weight = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FairShare = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedFairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AdjustedFairShare = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncappedAdjustedFairShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UncappedAdjustedFairShare = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualShare", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ActualShare = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatedResources == nil {
+				m.AllocatedResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatedResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Demand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Demand == nil {
+				m.Demand = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Demand[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstrainedDemand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConstrainedDemand == nil {
+				m.ConstrainedDemand = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConstrainedDemand[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledResources == nil {
+				m.ScheduledResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ScheduledResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreemptedResources == nil {
+				m.PreemptedResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PreemptedResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumScheduledJobs", wireType)
+			}
+			m.NumScheduledJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumScheduledJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPreemptedJobs", wireType)
+			}
+			m.NumPreemptedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPreemptedJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnschedulableJobs", wireType)
+			}
+			m.NumUnschedulableJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUnschedulableJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnschedulableJobsByReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumUnschedulableJobsByReason == nil {
+				m.NumUnschedulableJobsByReason = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumUnschedulableJobsByReason[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleUnschedulableJobIdByReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExampleUnschedulableJobIdByReason == nil {
+				m.ExampleUnschedulableJobIdByReason = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExampleUnschedulableJobIdByReason[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedByOptimiserResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreemptedByOptimiserResources == nil {
+				m.PreemptedByOptimiserResources = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PreemptedByOptimiserResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPreemptedByOptimiserJobs", wireType)
+			}
+			m.NumPreemptedByOptimiserJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPreemptedByOptimiserJobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			}
+			m.Verbosity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verbosity |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *JobReport) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovSchedulerReporting(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSchedulerReporting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSchedulerReporting(x uint64) (n int) {
-	return sovSchedulerReporting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
+func (m *QueueReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1121,15 +5881,15 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MostRecentForQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MostRecentForQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1157,7 +5917,41 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueName = string(dAtA[iNdEx:postIndex])
+			m.Report = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolQueueReport{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1180,7 +5974,7 @@ func (m *MostRecentForQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
+func (m *PoolQueueReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1203,15 +5997,15 @@ func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MostRecentForJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolQueueReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MostRecentForJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolQueueReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1239,7 +6033,43 @@ func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queue == nil {
+				m.Queue = &QueueSchedulingReport{}
+			}
+			if err := m.Queue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1262,7 +6092,7 @@ func (m *MostRecentForJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
+func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1285,17 +6115,17 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingReportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerReporting
@@ -1305,32 +6135,79 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MostRecentForQueue{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Filter = &SchedulingReportRequest_MostRecentForQueue{v}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerReporting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentForJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerReporting
@@ -1340,32 +6217,29 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MostRecentForJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Filter = &SchedulingReportRequest_MostRecentForJob{v}
+			m.Report = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			m.Verbosity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerReporting
@@ -1375,11 +6249,26 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Verbosity |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolJobReport{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
@@ -1401,7 +6290,7 @@ func (m *SchedulingReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
+func (m *PoolJobReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,15 +6313,15 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolJobReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolJobReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1460,7 +6349,43 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &JobSchedulingReport{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1483,7 +6408,7 @@ func (m *SchedulingReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSchedulingReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,15 +6431,15 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueReportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSchedulingReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1542,13 +6467,13 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueName = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			m.Verbosity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerReporting
@@ -1558,64 +6483,63 @@ func (m *QueueReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Verbosity |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueueReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerReporting
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnschedulableReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1643,63 +6567,64 @@ func (m *QueueReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
+			m.UnschedulableReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSchedulerReporting
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerReporting
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReportRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerReporting
@@ -1709,23 +6634,25 @@ func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedulerReporting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.NodeTypes = append(m.NodeTypes, &NodeTypeSchedulingReport{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1748,7 +6675,7 @@ func (m *JobReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReport) Unmarshal(dAtA []byte) error {
+func (m *NodeTypeSchedulingReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,15 +6698,15 @@ func (m *JobReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeTypeSchedulingReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeTypeSchedulingReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1807,7 +6734,152 @@ func (m *JobReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = string(dAtA[iNdEx:postIndex])
+			m.NodeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnschedulableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnschedulableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExcludedNodesByReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerReporting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerReporting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumExcludedNodesByReason == nil {
+				m.NumExcludedNodesByReason = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerReporting
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerReporting
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerReporting(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerReporting
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumExcludedNodesByReason[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19,6 +19,7 @@ import (
 	status "google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	v11 "k8s.io/api/networking/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -32,6 +33,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Determines what happens to a job when one of the jobs it depends on fails or is cancelled.
+type DependencyFailurePolicy int32
+
+const (
+	// The job is cancelled.
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL DependencyFailurePolicy = 0
+	// The job is failed.
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL DependencyFailurePolicy = 1
+	// The job is scheduled regardless.
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY DependencyFailurePolicy = 2
+)
+
+var DependencyFailurePolicy_name = map[int32]string{
+	0: "DEPENDENCY_FAILURE_POLICY_CANCEL",
+	1: "DEPENDENCY_FAILURE_POLICY_FAIL",
+	2: "DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY",
+}
+
+var DependencyFailurePolicy_value = map[string]int32{
+	"DEPENDENCY_FAILURE_POLICY_CANCEL":     0,
+	"DEPENDENCY_FAILURE_POLICY_FAIL":       1,
+	"DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY": 2,
+}
+
+func (x DependencyFailurePolicy) String() string {
+	return proto.EnumName(DependencyFailurePolicy_name, int32(x))
+}
+
+func (DependencyFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{0}
+}
+
+type RetryAction int32
+
+const (
+	// The job is retried, provided it has not reached its maximum number of attempts.
+	RetryAction_RETRY_ACTION_RETRY RetryAction = 0
+	// The job is failed immediately.
+	RetryAction_RETRY_ACTION_FAIL_FAST RetryAction = 1
+)
+
+var RetryAction_name = map[int32]string{
+	0: "RETRY_ACTION_RETRY",
+	1: "RETRY_ACTION_FAIL_FAST",
+}
+
+var RetryAction_value = map[string]int32{
+	"RETRY_ACTION_RETRY":     0,
+	"RETRY_ACTION_FAIL_FAST": 1,
+}
+
+func (x RetryAction) String() string {
+	return proto.EnumName(RetryAction_name, int32(x))
+}
+
+func (RetryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+
 // Ingress type is being kept here to maintain backwards compatibility for a while.
 type IngressType int32
 
@@ -52,7 +112,7 @@ func (x IngressType) String() string {
 }
 
 func (IngressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{0}
+	return fileDescriptor_e998bacb27df16c1, []int{2}
 }
 
 type ServiceType int32
@@ -77,7 +137,7 @@ func (x ServiceType) String() string {
 }
 
 func (ServiceType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
 }
 
 // swagger:model
@@ -130,7 +190,7 @@ func (x JobState) String() string {
 }
 
 func (JobState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 
 type JobSubmitRequestItem struct {
@@ -147,6 +207,26 @@ type JobSubmitRequestItem struct {
 	// Indicates which scheduler should manage this job.
 	// If empty, the default scheduler is used.
 	Scheduler string `protobuf:"bytes,11,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// Jobs that must succeed before this job may be scheduled.
+	Dependencies []*JobDependency `protobuf:"bytes,13,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// What to do with this job if any of its dependencies fails or is cancelled.
+	DependencyFailurePolicy DependencyFailurePolicy `protobuf:"varint,14,opt,name=dependency_failure_policy,json=dependencyFailurePolicy,proto3,enum=api.DependencyFailurePolicy" json:"dependencyFailurePolicy,omitempty"`
+	// If non-zero, this item is expanded into a job array of array_size jobs, which share the same spec.
+	// Each job is given its index in the array via the ARMADA_JOB_ARRAY_INDEX environment variable
+	// and the armadaproject.io/jobArrayIndex annotation.
+	ArraySize uint32 `protobuf:"varint,15,opt,name=array_size,json=arraySize,proto3" json:"arraySize,omitempty"`
+	// If set, the job remains queued but may not be scheduled until this time.
+	NotBefore *types.Timestamp `protobuf:"bytes,16,opt,name=not_before,json=notBefore,proto3" json:"notBefore,omitempty"`
+	// If non-zero, the job is cancelled if it hasn't been scheduled within this many seconds of becoming eligible for
+	// scheduling, i.e., of being submitted, of not_before, or of its dependencies having succeeded, whichever is later.
+	QueueTtlSeconds uint32 `protobuf:"varint,17,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// Determines whether the job is retried if one of its runs fails. If not set, the queue's default retry policy applies.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,18,opt,name=retry_policy,json=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	// ConfigMaps and Secrets created alongside the job's pod and deleted with it.
+	// Each must be named; the pod refers to them by that name, e.g., in volumes or envFrom,
+	// and the executor gives them a name unique to the job when creating them.
+	ConfigMaps []*v1.ConfigMap `protobuf:"bytes,19,rep,name=config_maps,json=configMaps,proto3" json:"configMaps,omitempty"`
+	Secrets    []*v1.Secret    `protobuf:"bytes,20,rep,name=secrets,proto3" json:"secrets,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()         { *m = JobSubmitRequestItem{} }
@@ -261,6 +341,255 @@ func (m *JobSubmitRequestItem) GetScheduler() string {
 	return ""
 }
 
+func (m *JobSubmitRequestItem) GetDependencies() []*JobDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetDependencyFailurePolicy() DependencyFailurePolicy {
+	if m != nil {
+		return m.DependencyFailurePolicy
+	}
+	return DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL
+}
+
+func (m *JobSubmitRequestItem) GetArraySize() uint32 {
+	if m != nil {
+		return m.ArraySize
+	}
+	return 0
+}
+
+func (m *JobSubmitRequestItem) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetQueueTtlSeconds() uint32 {
+	if m != nil {
+		return m.QueueTtlSeconds
+	}
+	return 0
+}
+
+func (m *JobSubmitRequestItem) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetConfigMaps() []*v1.ConfigMap {
+	if m != nil {
+		return m.ConfigMaps
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetSecrets() []*v1.Secret {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+// Identifies a job that another job depends on. Exactly one of job_id and client_id must be set.
+type JobDependency struct {
+	// Id of a previously submitted job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Client id of a job in the same queue, submitted either previously or as part of the same request.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientId,omitempty"`
+}
+
+func (m *JobDependency) Reset()         { *m = JobDependency{} }
+func (m *JobDependency) String() string { return proto.CompactTextString(m) }
+func (*JobDependency) ProtoMessage()    {}
+func (*JobDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+func (m *JobDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobDependency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDependency.Merge(m, src)
+}
+func (m *JobDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobDependency proto.InternalMessageInfo
+
+func (m *JobDependency) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobDependency) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// Determines whether a job is retried if one of its runs fails.
+type RetryPolicy struct {
+	// Maximum number of runs of the job. If zero, the maximum number of attempts configured for the scheduler applies.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// Minimum number of seconds between a run failing and the job being scheduled again.
+	BackoffSeconds uint32 `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoffSeconds,omitempty"`
+	// Rules matched, in order, against the error of a failed run. The first matching rule determines whether the job
+	// is retried. If no rule matches, the job is retried only if the executor returned the run because its pod never
+	// started, e.g., because it couldn't be scheduled onto the node it was assigned to.
+	Rules []*RetryRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{2}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffSeconds() uint32 {
+	if m != nil {
+		return m.BackoffSeconds
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRules() []*RetryRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// A rule matching the error of a failed run. A rule matches if all of its non-empty criteria match.
+type RetryRule struct {
+	Action RetryAction `protobuf:"varint,1,opt,name=action,proto3,enum=api.RetryAction" json:"action,omitempty"`
+	// Matches if any container exited with one of these exit codes.
+	ExitCodes []int32 `protobuf:"varint,2,rep,packed,name=exit_codes,json=exitCodes,proto3" json:"exitCodes,omitempty"`
+	// Matches if any container terminated with one of these reasons, e.g., OOMKilled.
+	ContainerReasons []string `protobuf:"bytes,3,rep,name=container_reasons,json=containerReasons,proto3" json:"containerReasons,omitempty"`
+	// Matches if the kind of the error is one of these, e.g., PodError or PodLeaseReturned.
+	ErrorKinds []string `protobuf:"bytes,4,rep,name=error_kinds,json=errorKinds,proto3" json:"errorKinds,omitempty"`
+}
+
+func (m *RetryRule) Reset()         { *m = RetryRule{} }
+func (m *RetryRule) String() string { return proto.CompactTextString(m) }
+func (*RetryRule) ProtoMessage()    {}
+func (*RetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{3}
+}
+func (m *RetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryRule.Merge(m, src)
+}
+func (m *RetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryRule proto.InternalMessageInfo
+
+func (m *RetryRule) GetAction() RetryAction {
+	if m != nil {
+		return m.Action
+	}
+	return RetryAction_RETRY_ACTION_RETRY
+}
+
+func (m *RetryRule) GetExitCodes() []int32 {
+	if m != nil {
+		return m.ExitCodes
+	}
+	return nil
+}
+
+func (m *RetryRule) GetContainerReasons() []string {
+	if m != nil {
+		return m.ContainerReasons
+	}
+	return nil
+}
+
+func (m *RetryRule) GetErrorKinds() []string {
+	if m != nil {
+		return m.ErrorKinds
+	}
+	return nil
+}
+
 type IngressConfig struct {
 	Type         IngressType       `protobuf:"varint,1,opt,name=type,proto3,enum=api.IngressType" json:"type,omitempty"` // Deprecated: Do not use.
 	Ports        []uint32          `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty"`
@@ -274,7 +603,7 @@ func (m *IngressConfig) Reset()         { *m = IngressConfig{} }
 func (m *IngressConfig) String() string { return proto.CompactTextString(m) }
 func (*IngressConfig) ProtoMessage()    {}
 func (*IngressConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 func (m *IngressConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +685,7 @@ func (m *ServiceConfig) Reset()         { *m = ServiceConfig{} }
 func (m *ServiceConfig) String() string { return proto.CompactTextString(m) }
 func (*ServiceConfig) ProtoMessage()    {}
 func (*ServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{5}
 }
 func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,7 +746,7 @@ func (m *JobSubmitRequest) Reset()         { *m = JobSubmitRequest{} }
 func (m *JobSubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitRequest) ProtoMessage()    {}
 func (*JobSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{3}
+	return fileDescriptor_e998bacb27df16c1, []int{6}
 }
 func (m *JobSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +808,7 @@ func (m *JobPreemptRequest) Reset()         { *m = JobPreemptRequest{} }
 func (m *JobPreemptRequest) String() string { return proto.CompactTextString(m) }
 func (*JobPreemptRequest) ProtoMessage()    {}
 func (*JobPreemptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{4}
+	return fileDescriptor_e998bacb27df16c1, []int{7}
 }
 func (m *JobPreemptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,13 +872,15 @@ type JobCancelRequest struct {
 	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobIds   []string `protobuf:"bytes,4,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
 	Reason   string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// If set, all jobs in the job array with this id are cancelled.
+	ArrayId string `protobuf:"bytes,6,opt,name=array_id,json=arrayId,proto3" json:"arrayId,omitempty"`
 }
 
 func (m *JobCancelRequest) Reset()         { *m = JobCancelRequest{} }
 func (m *JobCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*JobCancelRequest) ProtoMessage()    {}
 func (*JobCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{5}
+	return fileDescriptor_e998bacb27df16c1, []int{8}
 }
 func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +944,13 @@ func (m *JobCancelRequest) GetReason() string {
 	return ""
 }
 
+func (m *JobCancelRequest) GetArrayId() string {
+	if m != nil {
+		return m.ArrayId
+	}
+	return ""
+}
+
 // swagger:model
 type JobSetCancelRequest struct {
 	JobSetId string        `protobuf:"bytes,1,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -625,7 +963,7 @@ func (m *JobSetCancelRequest) Reset()         { *m = JobSetCancelRequest{} }
 func (m *JobSetCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSetCancelRequest) ProtoMessage()    {}
 func (*JobSetCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{6}
+	return fileDescriptor_e998bacb27df16c1, []int{9}
 }
 func (m *JobSetCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +1029,7 @@ func (m *JobSetFilter) Reset()         { *m = JobSetFilter{} }
 func (m *JobSetFilter) String() string { return proto.CompactTextString(m) }
 func (*JobSetFilter) ProtoMessage()    {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{7}
+	return fileDescriptor_e998bacb27df16c1, []int{10}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,13 +1105,19 @@ type Job struct {
 	// Indicates which scheduler should manage this job.
 	// If empty, the default scheduler is used.
 	Scheduler string `protobuf:"bytes,20,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// Ids of the jobs that must succeed before this job may be scheduled.
+	Dependencies            []string                `protobuf:"bytes,23,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	DependencyFailurePolicy DependencyFailurePolicy `protobuf:"varint,24,opt,name=dependency_failure_policy,json=dependencyFailurePolicy,proto3,enum=api.DependencyFailurePolicy" json:"dependencyFailurePolicy,omitempty"`
+	// ConfigMaps created alongside the job's pod. Secrets are deliberately not included, so that their contents aren't
+	// exposed by APIs returning jobs.
+	K8SConfigMap []*v1.ConfigMap `protobuf:"bytes,25,rep,name=k8s_config_map,json=k8sConfigMap,proto3" json:"k8sConfigMap,omitempty"`
 }
 
 func (m *Job) Reset()         { *m = Job{} }
 func (m *Job) String() string { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()    {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{8}
+	return fileDescriptor_e998bacb27df16c1, []int{11}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,19 +1295,42 @@ func (m *Job) GetScheduler() string {
 	return ""
 }
 
+func (m *Job) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *Job) GetDependencyFailurePolicy() DependencyFailurePolicy {
+	if m != nil {
+		return m.DependencyFailurePolicy
+	}
+	return DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL
+}
+
+func (m *Job) GetK8SConfigMap() []*v1.ConfigMap {
+	if m != nil {
+		return m.K8SConfigMap
+	}
+	return nil
+}
+
 // swagger:model
 type JobReprioritizeRequest struct {
 	JobIds      []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
 	JobSetId    string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue       string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	NewPriority float64  `protobuf:"fixed64,4,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+	// If set, all jobs in the job array with this id are re-prioritised.
+	ArrayId string `protobuf:"bytes,5,opt,name=array_id,json=arrayId,proto3" json:"arrayId,omitempty"`
 }
 
 func (m *JobReprioritizeRequest) Reset()         { *m = JobReprioritizeRequest{} }
 func (m *JobReprioritizeRequest) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizeRequest) ProtoMessage()    {}
 func (*JobReprioritizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+	return fileDescriptor_e998bacb27df16c1, []int{12}
 }
 func (m *JobReprioritizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,6 +1387,13 @@ func (m *JobReprioritizeRequest) GetNewPriority() float64 {
 	return 0
 }
 
+func (m *JobReprioritizeRequest) GetArrayId() string {
+	if m != nil {
+		return m.ArrayId
+	}
+	return ""
+}
+
 // swagger:model
 type JobReprioritizeResponse struct {
 	ReprioritizationResults map[string]string `protobuf:"bytes,1,rep,name=reprioritization_results,json=reprioritizationResults,proto3" json:"reprioritizationResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1029,7 +1403,7 @@ func (m *JobReprioritizeResponse) Reset()         { *m = JobReprioritizeResponse
 func (m *JobReprioritizeResponse) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizeResponse) ProtoMessage()    {}
 func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{10}
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
 func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,15 +1440,18 @@ func (m *JobReprioritizeResponse) GetReprioritizationResults() map[string]string
 }
 
 type JobSubmitResponseItem struct {
+	// Id of the submitted job. For job arrays, this is the id of the array.
 	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// For job arrays, the ids of the jobs that make up the array, ordered by index.
+	ArrayJobIds []string `protobuf:"bytes,3,rep,name=array_job_ids,json=arrayJobIds,proto3" json:"arrayJobIds,omitempty"`
 }
 
 func (m *JobSubmitResponseItem) Reset()         { *m = JobSubmitResponseItem{} }
 func (m *JobSubmitResponseItem) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponseItem) ProtoMessage()    {}
 func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11}
+	return fileDescriptor_e998bacb27df16c1, []int{14}
 }
 func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,6 +1494,13 @@ func (m *JobSubmitResponseItem) GetError() string {
 	return ""
 }
 
+func (m *JobSubmitResponseItem) GetArrayJobIds() []string {
+	if m != nil {
+		return m.ArrayJobIds
+	}
+	return nil
+}
+
 // swagger:model
 type JobSubmitResponse struct {
 	JobResponseItems []*JobSubmitResponseItem `protobuf:"bytes,1,rep,name=job_response_items,json=jobResponseItems,proto3" json:"jobResponseItems,omitempty"`
@@ -1126,7 +1510,7 @@ func (m *JobSubmitResponse) Reset()         { *m = JobSubmitResponse{} }
 func (m *JobSubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponse) ProtoMessage()    {}
 func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{12}
+	return fileDescriptor_e998bacb27df16c1, []int{15}
 }
 func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1179,13 +1563,20 @@ type Queue struct {
 	// A list of Kubernetes-like key-value labels, e.g. armadaproject.io/priority=critical
 	LabelsDeprecated []string          `protobuf:"bytes,9,rep,name=labels_deprecated,json=labelsDeprecated,proto3" json:"labelsDeprecated,omitempty"` // Deprecated: Do not use.
 	Labels           map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Retry policy of jobs submitted to this queue that don't specify one.
+	DefaultRetryPolicy *RetryPolicy `protobuf:"bytes,11,opt,name=default_retry_policy,json=defaultRetryPolicy,proto3" json:"defaultRetryPolicy,omitempty"`
+	// Name of the queue this queue is nested under, if any.
+	// Fair share is first divided among top-level queues and then, recursively, among the children of each queue.
+	Parent string `protobuf:"bytes,12,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Map from pool name to the absolute quota of this queue in that pool.
+	QuotaByPool map[string]*QueueQuota `protobuf:"bytes,13,rep,name=quota_by_pool,json=quotaByPool,proto3" json:"quotaByPool,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Queue) Reset()         { *m = Queue{} }
 func (m *Queue) String() string { return proto.CompactTextString(m) }
 func (*Queue) ProtoMessage()    {}
 func (*Queue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13}
+	return fileDescriptor_e998bacb27df16c1, []int{16}
 }
 func (m *Queue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1286,6 +1677,27 @@ func (m *Queue) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *Queue) GetDefaultRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.DefaultRetryPolicy
+	}
+	return nil
+}
+
+func (m *Queue) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *Queue) GetQuotaByPool() map[string]*QueueQuota {
+	if m != nil {
+		return m.QuotaByPool
+	}
+	return nil
+}
+
 type Queue_Permissions struct {
 	Subjects []*Queue_Permissions_Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
 	Verbs    []string                     `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
@@ -1295,7 +1707,7 @@ func (m *Queue_Permissions) Reset()         { *m = Queue_Permissions{} }
 func (m *Queue_Permissions) String() string { return proto.CompactTextString(m) }
 func (*Queue_Permissions) ProtoMessage()    {}
 func (*Queue_Permissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13, 0}
+	return fileDescriptor_e998bacb27df16c1, []int{16, 0}
 }
 func (m *Queue_Permissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1347,7 +1759,7 @@ func (m *Queue_Permissions_Subject) Reset()         { *m = Queue_Permissions_Sub
 func (m *Queue_Permissions_Subject) String() string { return proto.CompactTextString(m) }
 func (*Queue_Permissions_Subject) ProtoMessage()    {}
 func (*Queue_Permissions_Subject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13, 0, 0}
+	return fileDescriptor_e998bacb27df16c1, []int{16, 0, 0}
 }
 func (m *Queue_Permissions_Subject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,8 +1802,65 @@ func (m *Queue_Permissions_Subject) GetName() string {
 	return ""
 }
 
-type PriorityClassResourceLimits struct {
-	// Limits resources assigned to jobs of this priority class.
+// Absolute amount of resources guaranteed to a queue in a particular pool.
+type QueueQuota struct {
+	// Resources guaranteed to the queue, e.g., {"cpu": "2000", "nvidia.com/gpu": "64"}.
+	// The queue is not limited with respect to resources not listed here.
+	Guaranteed map[string]*resource.Quantity `protobuf:"bytes,1,rep,name=guaranteed,proto3" json:"guaranteed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, the queue may use idle resources beyond its guaranteed quota.
+	// Borrowed resources may be preempted to make room for jobs of queues below their guaranteed quota.
+	BorrowingEnabled bool `protobuf:"varint,2,opt,name=borrowing_enabled,json=borrowingEnabled,proto3" json:"borrowingEnabled,omitempty"`
+}
+
+func (m *QueueQuota) Reset()         { *m = QueueQuota{} }
+func (m *QueueQuota) String() string { return proto.CompactTextString(m) }
+func (*QueueQuota) ProtoMessage()    {}
+func (*QueueQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{17}
+}
+func (m *QueueQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueQuota.Merge(m, src)
+}
+func (m *QueueQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueQuota proto.InternalMessageInfo
+
+func (m *QueueQuota) GetGuaranteed() map[string]*resource.Quantity {
+	if m != nil {
+		return m.Guaranteed
+	}
+	return nil
+}
+
+func (m *QueueQuota) GetBorrowingEnabled() bool {
+	if m != nil {
+		return m.BorrowingEnabled
+	}
+	return false
+}
+
+type PriorityClassResourceLimits struct {
+	// Limits resources assigned to jobs of this priority class.
 	// Specifically, jobs of this priority class are only scheduled if doing so does not exceed this limit.
 	MaximumResourceFraction map[string]float64 `protobuf:"bytes,1,rep,name=maximum_resource_fraction,json=maximumResourceFraction,proto3" json:"maximumResourceFraction,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	// Per-pool override of maximum_resource_fraction.
@@ -1403,7 +1872,7 @@ func (m *PriorityClassResourceLimits) Reset()         { *m = PriorityClassResour
 func (m *PriorityClassResourceLimits) String() string { return proto.CompactTextString(m) }
 func (*PriorityClassResourceLimits) ProtoMessage()    {}
 func (*PriorityClassResourceLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{14}
+	return fileDescriptor_e998bacb27df16c1, []int{18}
 }
 func (m *PriorityClassResourceLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1923,7 @@ func (m *PriorityClassPoolResourceLimits) Reset()         { *m = PriorityClassPo
 func (m *PriorityClassPoolResourceLimits) String() string { return proto.CompactTextString(m) }
 func (*PriorityClassPoolResourceLimits) ProtoMessage()    {}
 func (*PriorityClassPoolResourceLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{15}
+	return fileDescriptor_e998bacb27df16c1, []int{19}
 }
 func (m *PriorityClassPoolResourceLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1968,7 @@ func (m *QueueList) Reset()         { *m = QueueList{} }
 func (m *QueueList) String() string { return proto.CompactTextString(m) }
 func (*QueueList) ProtoMessage()    {}
 func (*QueueList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{16}
+	return fileDescriptor_e998bacb27df16c1, []int{20}
 }
 func (m *QueueList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +2013,7 @@ func (m *CancellationResult) Reset()         { *m = CancellationResult{} }
 func (m *CancellationResult) String() string { return proto.CompactTextString(m) }
 func (*CancellationResult) ProtoMessage()    {}
 func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{17}
+	return fileDescriptor_e998bacb27df16c1, []int{21}
 }
 func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,7 +2058,7 @@ func (m *QueueGetRequest) Reset()         { *m = QueueGetRequest{} }
 func (m *QueueGetRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueGetRequest) ProtoMessage()    {}
 func (*QueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{18}
+	return fileDescriptor_e998bacb27df16c1, []int{22}
 }
 func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1634,7 +2103,7 @@ func (m *QueueCordonRequest) Reset()         { *m = QueueCordonRequest{} }
 func (m *QueueCordonRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueCordonRequest) ProtoMessage()    {}
 func (*QueueCordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{19}
+	return fileDescriptor_e998bacb27df16c1, []int{23}
 }
 func (m *QueueCordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1679,7 +2148,7 @@ func (m *QueueUncordonRequest) Reset()         { *m = QueueUncordonRequest{} }
 func (m *QueueUncordonRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueUncordonRequest) ProtoMessage()    {}
 func (*QueueUncordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{20}
+	return fileDescriptor_e998bacb27df16c1, []int{24}
 }
 func (m *QueueUncordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +2193,7 @@ func (m *StreamingQueueGetRequest) Reset()         { *m = StreamingQueueGetReque
 func (m *StreamingQueueGetRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamingQueueGetRequest) ProtoMessage()    {}
 func (*StreamingQueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{21}
+	return fileDescriptor_e998bacb27df16c1, []int{25}
 }
 func (m *StreamingQueueGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +2238,7 @@ func (m *QueueDeleteRequest) Reset()         { *m = QueueDeleteRequest{} }
 func (m *QueueDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueDeleteRequest) ProtoMessage()    {}
 func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{22}
+	return fileDescriptor_e998bacb27df16c1, []int{26}
 }
 func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2284,7 @@ func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
 func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
 func (*JobSetInfo) ProtoMessage()    {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{23}
+	return fileDescriptor_e998bacb27df16c1, []int{27}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,7 +2343,7 @@ func (m *QueueUpdateResponse) Reset()         { *m = QueueUpdateResponse{} }
 func (m *QueueUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueueUpdateResponse) ProtoMessage()    {}
 func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{24}
+	return fileDescriptor_e998bacb27df16c1, []int{28}
 }
 func (m *QueueUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1925,7 +2394,7 @@ func (m *BatchQueueUpdateResponse) Reset()         { *m = BatchQueueUpdateRespon
 func (m *BatchQueueUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchQueueUpdateResponse) ProtoMessage()    {}
 func (*BatchQueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{25}
+	return fileDescriptor_e998bacb27df16c1, []int{29}
 }
 func (m *BatchQueueUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1970,7 +2439,7 @@ func (m *QueueCreateResponse) Reset()         { *m = QueueCreateResponse{} }
 func (m *QueueCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueueCreateResponse) ProtoMessage()    {}
 func (*QueueCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{26}
+	return fileDescriptor_e998bacb27df16c1, []int{30}
 }
 func (m *QueueCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2021,7 +2490,7 @@ func (m *BatchQueueCreateResponse) Reset()         { *m = BatchQueueCreateRespon
 func (m *BatchQueueCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchQueueCreateResponse) ProtoMessage()    {}
 func (*BatchQueueCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{27}
+	return fileDescriptor_e998bacb27df16c1, []int{31}
 }
 func (m *BatchQueueCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2065,7 +2534,7 @@ func (m *EndMarker) Reset()         { *m = EndMarker{} }
 func (m *EndMarker) String() string { return proto.CompactTextString(m) }
 func (*EndMarker) ProtoMessage()    {}
 func (*EndMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{28}
+	return fileDescriptor_e998bacb27df16c1, []int{32}
 }
 func (m *EndMarker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2574,7 @@ func (m *StreamingQueueMessage) Reset()         { *m = StreamingQueueMessage{} }
 func (m *StreamingQueueMessage) String() string { return proto.CompactTextString(m) }
 func (*StreamingQueueMessage) ProtoMessage()    {}
 func (*StreamingQueueMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{29}
+	return fileDescriptor_e998bacb27df16c1, []int{33}
 }
 func (m *StreamingQueueMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2188,7 +2657,7 @@ func (m *QueuePreemptRequest) Reset()         { *m = QueuePreemptRequest{} }
 func (m *QueuePreemptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueuePreemptRequest) ProtoMessage()    {}
 func (*QueuePreemptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{30}
+	return fileDescriptor_e998bacb27df16c1, []int{34}
 }
 func (m *QueuePreemptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2242,7 +2711,7 @@ func (m *QueueCancelRequest) Reset()         { *m = QueueCancelRequest{} }
 func (m *QueueCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*QueueCancelRequest) ProtoMessage()    {}
 func (*QueueCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{31}
+	return fileDescriptor_e998bacb27df16c1, []int{35}
 }
 func (m *QueueCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2293,6 +2762,8 @@ func (m *QueueCancelRequest) GetJobStates() []JobState {
 }
 
 func init() {
+	proto.RegisterEnum("api.DependencyFailurePolicy", DependencyFailurePolicy_name, DependencyFailurePolicy_value)
+	proto.RegisterEnum("api.RetryAction", RetryAction_name, RetryAction_value)
 	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
 	proto.RegisterEnum("api.ServiceType", ServiceType_name, ServiceType_value)
 	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
@@ -2300,6 +2771,9 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*JobDependency)(nil), "api.JobDependency")
+	proto.RegisterType((*RetryPolicy)(nil), "api.RetryPolicy")
+	proto.RegisterType((*RetryRule)(nil), "api.RetryRule")
 	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
 	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
 	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
@@ -2319,10 +2793,13 @@ func init() {
 	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
 	proto.RegisterType((*Queue)(nil), "api.Queue")
 	proto.RegisterMapType((map[string]string)(nil), "api.Queue.LabelsEntry")
+	proto.RegisterMapType((map[string]*QueueQuota)(nil), "api.Queue.QuotaByPoolEntry")
 	proto.RegisterMapType((map[string]*PriorityClassResourceLimits)(nil), "api.Queue.ResourceLimitsByPriorityClassNameEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
 	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
 	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
+	proto.RegisterType((*QueueQuota)(nil), "api.QueueQuota")
+	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "api.QueueQuota.GuaranteedEntry")
 	proto.RegisterType((*PriorityClassResourceLimits)(nil), "api.PriorityClassResourceLimits")
 	proto.RegisterMapType((map[string]*PriorityClassPoolResourceLimits)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionByPoolEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionEntry")
@@ -2349,203 +2826,256 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
 
 var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 3129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4b, 0x73, 0x1b, 0x49,
-	0xd9, 0xe3, 0xb7, 0x3e, 0x59, 0xb6, 0xdc, 0x71, 0x9c, 0xb1, 0x92, 0xb5, 0x9c, 0xd9, 0xdd, 0xac,
-	0x93, 0x0d, 0xf2, 0xc6, 0xcb, 0x16, 0x49, 0x58, 0x08, 0x91, 0xac, 0x24, 0x76, 0x12, 0xc7, 0x6b,
-	0xc7, 0xfb, 0xa0, 0x28, 0xc4, 0x48, 0xd3, 0x96, 0xc7, 0x96, 0x66, 0xb4, 0x33, 0xa3, 0x04, 0x43,
-	0xed, 0x85, 0xa2, 0xd8, 0x03, 0x97, 0x2d, 0x38, 0x42, 0x01, 0x07, 0xa8, 0xa2, 0x96, 0x33, 0x17,
-	0x8a, 0x1f, 0x40, 0xc1, 0x65, 0x29, 0x2e, 0x70, 0x51, 0x51, 0xbb, 0x3c, 0xaa, 0x74, 0xe3, 0xce,
-	0x81, 0xea, 0xaf, 0x7b, 0x66, 0x7a, 0xf4, 0xb2, 0x94, 0xc4, 0xd9, 0x0b, 0x37, 0xf5, 0xd7, 0xdf,
-	0xbb, 0xbf, 0xfe, 0x1e, 0x3d, 0x82, 0xb9, 0xda, 0x61, 0x79, 0x45, 0xaf, 0x99, 0x2b, 0x6e, 0xbd,
-	0x58, 0x35, 0xbd, 0x4c, 0xcd, 0xb1, 0x3d, 0x9b, 0x8c, 0xe8, 0x35, 0x33, 0x75, 0xb6, 0x6c, 0xdb,
-	0xe5, 0x0a, 0x5d, 0x41, 0x50, 0xb1, 0xbe, 0xb7, 0x42, 0xab, 0x35, 0xef, 0x88, 0x63, 0xa4, 0xd2,
-	0xad, 0x9b, 0x9e, 0x59, 0xa5, 0xae, 0xa7, 0x57, 0x6b, 0x02, 0x41, 0x3b, 0xbc, 0xea, 0x66, 0x4c,
-	0x1b, 0x79, 0x97, 0x6c, 0x87, 0xae, 0x3c, 0xba, 0xb2, 0x52, 0xa6, 0x16, 0x75, 0x74, 0x8f, 0x1a,
-	0x02, 0x67, 0x59, 0xc2, 0xb1, 0xa8, 0xf7, 0xd8, 0x76, 0x0e, 0x4d, 0xab, 0xdc, 0x09, 0xf3, 0x9c,
-	0x10, 0xc7, 0x30, 0x75, 0xcb, 0xb2, 0x3d, 0xdd, 0x33, 0x6d, 0xcb, 0x15, 0xbb, 0x81, 0x11, 0xfb,
-	0x54, 0xaf, 0x78, 0xfb, 0x1c, 0xaa, 0xfd, 0x2a, 0x06, 0x73, 0x1b, 0x76, 0x71, 0x07, 0x0d, 0xdb,
-	0xa6, 0xef, 0xd7, 0xa9, 0xeb, 0xad, 0x7b, 0xb4, 0x4a, 0x56, 0x61, 0xb2, 0xe6, 0x98, 0xb6, 0x63,
-	0x7a, 0x47, 0xaa, 0xb2, 0xa4, 0x2c, 0x2b, 0xd9, 0xf9, 0x66, 0x23, 0x4d, 0x7c, 0xd8, 0x65, 0xbb,
-	0x6a, 0x7a, 0x68, 0xeb, 0x76, 0x80, 0x47, 0xde, 0x80, 0x98, 0xa5, 0x57, 0xa9, 0x5b, 0xd3, 0x4b,
-	0x54, 0x1d, 0x59, 0x52, 0x96, 0x63, 0xd9, 0x33, 0xcd, 0x46, 0xfa, 0x54, 0x00, 0x94, 0xa8, 0x42,
-	0x4c, 0xf2, 0x3a, 0xc4, 0x4a, 0x15, 0x93, 0x5a, 0x5e, 0xc1, 0x34, 0xd4, 0x49, 0x24, 0x43, 0x59,
-	0x1c, 0xb8, 0x6e, 0xc8, 0xb2, 0x7c, 0x18, 0xd9, 0x81, 0xf1, 0x8a, 0x5e, 0xa4, 0x15, 0x57, 0x1d,
-	0x5d, 0x1a, 0x59, 0x8e, 0xaf, 0xbe, 0x9c, 0xd1, 0x6b, 0x66, 0xa6, 0x93, 0x29, 0x99, 0x7b, 0x88,
-	0x97, 0xb7, 0x3c, 0xe7, 0x28, 0x3b, 0xd7, 0x6c, 0xa4, 0x93, 0x9c, 0x50, 0x62, 0x2b, 0x58, 0x91,
-	0x32, 0xc4, 0x25, 0xc7, 0xa9, 0x63, 0xc8, 0xf9, 0x52, 0x77, 0xce, 0x37, 0x43, 0x64, 0xce, 0x7e,
-	0xa1, 0xd9, 0x48, 0x9f, 0x96, 0x58, 0x48, 0x32, 0x64, 0xce, 0xe4, 0x43, 0x05, 0xe6, 0x1c, 0xfa,
-	0x7e, 0xdd, 0x74, 0xa8, 0x51, 0xb0, 0x6c, 0x83, 0x16, 0x84, 0x31, 0xe3, 0x28, 0xf2, 0x4a, 0x77,
-	0x91, 0xdb, 0x82, 0x6a, 0xd3, 0x36, 0xa8, 0x6c, 0x98, 0xd6, 0x6c, 0xa4, 0xcf, 0x39, 0x6d, 0x9b,
-	0xa1, 0x02, 0xaa, 0xb2, 0x4d, 0xda, 0xf7, 0xc9, 0x03, 0x98, 0xac, 0xd9, 0x46, 0xc1, 0xad, 0xd1,
-	0x92, 0x3a, 0xbc, 0xa4, 0x2c, 0xc7, 0x57, 0xcf, 0x66, 0x78, 0xc4, 0xa1, 0x0e, 0x2c, 0x2a, 0x33,
-	0x8f, 0xae, 0x64, 0xb6, 0x6c, 0x63, 0xa7, 0x46, 0x4b, 0x78, 0x9e, 0xb3, 0x35, 0xbe, 0x88, 0xf0,
-	0x9e, 0x10, 0x40, 0xb2, 0x05, 0x31, 0x9f, 0xa1, 0xab, 0x4e, 0xa0, 0x39, 0x3d, 0x39, 0xf2, 0xb0,
-	0xe2, 0x0b, 0x37, 0x12, 0x56, 0x02, 0x46, 0x72, 0x30, 0x61, 0x5a, 0x65, 0x87, 0xba, 0xae, 0x1a,
-	0x43, 0x7e, 0x04, 0x19, 0xad, 0x73, 0x58, 0xce, 0xb6, 0xf6, 0xcc, 0x72, 0xf6, 0x34, 0x53, 0x4c,
-	0xa0, 0x49, 0x5c, 0x7c, 0x4a, 0x72, 0x0b, 0x26, 0x5d, 0xea, 0x3c, 0x32, 0x4b, 0xd4, 0x55, 0x41,
-	0xe2, 0xb2, 0xc3, 0x81, 0x82, 0x0b, 0x2a, 0xe3, 0xe3, 0xc9, 0xca, 0xf8, 0x30, 0x16, 0xe3, 0x6e,
-	0x69, 0x9f, 0x1a, 0xf5, 0x0a, 0x75, 0xd4, 0x78, 0x18, 0xe3, 0x01, 0x50, 0x8e, 0xf1, 0x00, 0x98,
-	0xd2, 0x21, 0x2e, 0x9d, 0x16, 0x79, 0x11, 0x46, 0x0e, 0x29, 0xbf, 0x58, 0xb1, 0xec, 0x6c, 0xb3,
-	0x91, 0x4e, 0x1c, 0x52, 0xf9, 0x4e, 0xb1, 0x5d, 0x72, 0x11, 0xc6, 0x1e, 0xe9, 0x95, 0x3a, 0xc5,
-	0x73, 0x89, 0x65, 0x4f, 0x35, 0x1b, 0xe9, 0x19, 0x04, 0x48, 0x88, 0x1c, 0xe3, 0xfa, 0xf0, 0x55,
-	0x25, 0xb5, 0x07, 0xc9, 0xd6, 0x78, 0x3c, 0x11, 0x39, 0x55, 0x38, 0xd3, 0x25, 0x08, 0x4f, 0x42,
-	0xdc, 0xc6, 0xe8, 0xe4, 0x54, 0x32, 0xa1, 0xfd, 0x67, 0x04, 0x12, 0x91, 0x03, 0x27, 0xd7, 0x61,
-	0xd4, 0x3b, 0xaa, 0x51, 0x14, 0x36, 0xbd, 0x9a, 0x94, 0x43, 0xe2, 0xe1, 0x51, 0x8d, 0xe2, 0x4d,
-	0x9f, 0x66, 0x18, 0x91, 0x30, 0x45, 0x1a, 0xa6, 0x42, 0xcd, 0x76, 0x3c, 0x57, 0x1d, 0x5e, 0x1a,
-	0x59, 0x4e, 0x70, 0x15, 0x10, 0x20, 0xab, 0x80, 0x00, 0xf2, 0xad, 0x68, 0x4a, 0x18, 0xc1, 0xd0,
-	0x79, 0xb1, 0x3d, 0x00, 0x9f, 0x3c, 0x17, 0x5c, 0x83, 0xb8, 0x57, 0x71, 0x0b, 0xd4, 0xd2, 0x8b,
-	0x15, 0x6a, 0xa8, 0xa3, 0x4b, 0xca, 0xf2, 0x64, 0x56, 0x6d, 0x36, 0xd2, 0x73, 0x1e, 0xf3, 0x2b,
-	0x42, 0x25, 0x5a, 0x08, 0xa1, 0x98, 0x39, 0xa9, 0xe3, 0x15, 0x58, 0x2e, 0x55, 0xc7, 0xa4, 0xcc,
-	0x49, 0x1d, 0x6f, 0x53, 0xaf, 0xd2, 0x48, 0xe6, 0x14, 0x30, 0x72, 0x03, 0x12, 0x75, 0x97, 0x16,
-	0x4a, 0x95, 0xba, 0xeb, 0x51, 0x67, 0x7d, 0x4b, 0x1d, 0x47, 0x89, 0xa9, 0x66, 0x23, 0x3d, 0x5f,
-	0x77, 0x69, 0xce, 0x87, 0x4b, 0xc4, 0x53, 0x32, 0xfc, 0x79, 0x05, 0x9a, 0xf6, 0x53, 0x05, 0x12,
-	0x91, 0xeb, 0x49, 0xae, 0x76, 0x38, 0x73, 0x81, 0x81, 0x67, 0x4e, 0xda, 0xcf, 0x7c, 0xf0, 0x13,
-	0xbf, 0x00, 0xa3, 0xe8, 0x4f, 0x5e, 0xc0, 0x90, 0xa5, 0x15, 0xf5, 0x25, 0xee, 0x6b, 0x7f, 0x53,
-	0x20, 0xd9, 0x9a, 0xa2, 0x99, 0x9c, 0xf7, 0xeb, 0xb4, 0x4e, 0x85, 0x27, 0x50, 0x0e, 0x02, 0x64,
-	0x39, 0x08, 0x20, 0x5f, 0x04, 0x38, 0xb0, 0x8b, 0x05, 0x97, 0x62, 0xdd, 0x1b, 0x0e, 0x4f, 0xef,
-	0xc0, 0x2e, 0xee, 0xd0, 0x96, 0xba, 0xe7, 0xc3, 0x88, 0x01, 0xb3, 0x8c, 0xca, 0xe1, 0xf2, 0x0a,
-	0x0c, 0xc1, 0x8f, 0xca, 0x85, 0xae, 0x55, 0x23, 0xfb, 0x42, 0xb3, 0x91, 0x5e, 0x38, 0xb0, 0x8b,
-	0x12, 0x4c, 0xb6, 0x7c, 0xa6, 0x65, 0x4b, 0xfb, 0xb3, 0x02, 0xb3, 0x1b, 0x76, 0x71, 0xcb, 0xa1,
-	0x0c, 0xe1, 0xb9, 0x19, 0xf7, 0x05, 0x98, 0x60, 0x54, 0xa6, 0xc1, 0x4d, 0x8a, 0xf1, 0x72, 0x7d,
-	0x60, 0x17, 0xd7, 0x8d, 0x48, 0xb9, 0xe6, 0x10, 0x72, 0x19, 0xc6, 0x1d, 0xaa, 0xbb, 0xb6, 0x85,
-	0x97, 0x46, 0x60, 0x73, 0x88, 0x8c, 0xcd, 0x21, 0xda, 0x7f, 0xf9, 0x79, 0xe5, 0x74, 0xab, 0x44,
-	0x2b, 0xbe, 0x49, 0x97, 0x60, 0x9c, 0x4b, 0x94, 0x6d, 0x42, 0xf6, 0xb2, 0x4d, 0x08, 0x78, 0x42,
-	0x9b, 0x02, 0xa7, 0x8d, 0x1c, 0xeb, 0x34, 0xc9, 0xfc, 0xd1, 0x81, 0xcc, 0x1f, 0xeb, 0xc3, 0xfc,
-	0x7f, 0x2a, 0x70, 0x6a, 0x03, 0x95, 0x8a, 0x7a, 0x20, 0x6a, 0x95, 0x32, 0xa8, 0x55, 0xc3, 0xc7,
-	0x5a, 0x75, 0x03, 0xc6, 0xf7, 0xcc, 0x8a, 0x47, 0x1d, 0xf4, 0x40, 0x7c, 0x75, 0x36, 0x08, 0x53,
-	0xea, 0xdd, 0xc2, 0x0d, 0xae, 0x39, 0x47, 0x92, 0x35, 0xe7, 0x90, 0x01, 0x8f, 0xf9, 0x2e, 0x4c,
-	0xc9, 0xbc, 0xc9, 0x97, 0x61, 0xdc, 0xf5, 0x74, 0x8f, 0xba, 0xaa, 0xb2, 0x34, 0xb2, 0x3c, 0xbd,
-	0x9a, 0x08, 0xc4, 0x33, 0x28, 0x67, 0xc6, 0x11, 0x64, 0x66, 0x1c, 0xa2, 0xfd, 0x7a, 0x06, 0x46,
-	0x36, 0xec, 0x22, 0x59, 0x82, 0xe1, 0xc0, 0x39, 0xc9, 0x66, 0x23, 0x3d, 0x65, 0xca, 0x6e, 0x19,
-	0x36, 0x8d, 0x68, 0x13, 0x9b, 0xe8, 0xb3, 0x89, 0x3d, 0xf1, 0x88, 0x8a, 0x74, 0xe4, 0x13, 0x7d,
-	0x77, 0xe4, 0xd9, 0xa0, 0xb9, 0xe6, 0x0d, 0xd7, 0x9c, 0xef, 0xb3, 0x01, 0x7a, 0xe9, 0xb7, 0xa3,
-	0x85, 0x13, 0xa2, 0x29, 0xea, 0xc9, 0xcb, 0xe5, 0xa3, 0x2e, 0x9d, 0x73, 0x1c, 0x05, 0x2c, 0x05,
-	0x02, 0x9e, 0x75, 0xa3, 0x7c, 0x11, 0xc6, 0xec, 0xc7, 0x16, 0x75, 0xc4, 0x84, 0x82, 0x5e, 0x47,
-	0x80, 0xec, 0x75, 0x04, 0x10, 0x0a, 0x67, 0xd1, 0xfd, 0x05, 0x5c, 0xba, 0xfb, 0x66, 0xad, 0x50,
-	0x77, 0xa9, 0x53, 0x28, 0x3b, 0x76, 0xbd, 0xe6, 0xaa, 0x33, 0x78, 0xb7, 0x2f, 0x34, 0x1b, 0x69,
-	0x0d, 0xd1, 0x1e, 0xf8, 0x58, 0xbb, 0x2e, 0x75, 0x6e, 0x23, 0x8e, 0xc4, 0x53, 0xed, 0x86, 0x43,
-	0xbe, 0xaf, 0xc0, 0x85, 0x92, 0x5d, 0xad, 0xb1, 0x26, 0x84, 0x1a, 0x85, 0x5e, 0x22, 0x4f, 0x2d,
-	0x29, 0xcb, 0x53, 0xd9, 0xd7, 0x9a, 0x8d, 0xf4, 0xe5, 0x90, 0xe2, 0xad, 0xe3, 0x85, 0x6b, 0xc7,
-	0x63, 0x47, 0x26, 0xc5, 0xd1, 0x3e, 0x27, 0x45, 0x79, 0xea, 0x18, 0x7b, 0xe6, 0x53, 0xc7, 0xd4,
-	0xb3, 0x98, 0x3a, 0x7e, 0xa1, 0xc0, 0x92, 0xe8, 0xdf, 0x4d, 0xab, 0x5c, 0x70, 0xa8, 0x6b, 0xd7,
-	0x9d, 0x12, 0x2d, 0x88, 0xd0, 0xa8, 0x52, 0xcb, 0x73, 0xd5, 0xd3, 0xa8, 0xfb, 0x72, 0x27, 0x49,
-	0xdb, 0x82, 0x60, 0x5b, 0xc2, 0xcf, 0x5e, 0x6e, 0x36, 0xd2, 0xcb, 0x21, 0xd7, 0x4e, 0x38, 0x92,
-	0x32, 0x8b, 0xbd, 0x31, 0xc9, 0x5d, 0x98, 0x28, 0x39, 0x54, 0xf7, 0xa8, 0x81, 0x3d, 0x5c, 0x7c,
-	0x35, 0x95, 0xe1, 0x4f, 0x00, 0x19, 0xff, 0xc5, 0x21, 0xf3, 0xd0, 0x7f, 0x71, 0xe0, 0x03, 0x92,
-	0x40, 0x97, 0x07, 0x24, 0x01, 0x92, 0xa7, 0xac, 0xe9, 0x67, 0x32, 0x65, 0x25, 0x9f, 0x62, 0xca,
-	0xfa, 0x06, 0xc4, 0x0f, 0xaf, 0xba, 0x05, 0x5f, 0xa1, 0x59, 0x64, 0x75, 0x5e, 0x76, 0x73, 0xf8,
-	0x14, 0xc2, 0x9c, 0x2d, 0xb4, 0xe4, 0x6d, 0xf3, 0xe1, 0x55, 0x77, 0xbd, 0x4d, 0x45, 0x08, 0xa1,
-	0x2c, 0x35, 0x31, 0xee, 0x42, 0x9a, 0x4a, 0xba, 0x87, 0x8b, 0xd0, 0x3b, 0xe0, 0x2b, 0xd6, 0x2d,
-	0x7c, 0x05, 0x34, 0x3a, 0x1b, 0xce, 0xfd, 0x7f, 0x36, 0x7c, 0x0e, 0xb3, 0xe1, 0x7c, 0xf2, 0xcc,
-	0xc6, 0xe8, 0xe4, 0x62, 0x32, 0xad, 0xfd, 0x4b, 0x81, 0xf9, 0x0d, 0xd6, 0xc6, 0x8a, 0x24, 0x63,
-	0x7e, 0x87, 0xfa, 0x2d, 0x8e, 0xd4, 0x57, 0x29, 0x7d, 0xf4, 0x55, 0x27, 0x5e, 0x95, 0xdf, 0x84,
-	0x29, 0x8b, 0x3e, 0x2e, 0xb4, 0x64, 0x4d, 0x2c, 0x80, 0x16, 0x7d, 0xbc, 0xd5, 0x9e, 0x38, 0xe3,
-	0x12, 0x58, 0xfb, 0xcd, 0x30, 0x9c, 0x69, 0x33, 0xd4, 0xad, 0xd9, 0x96, 0x4b, 0xc9, 0x4f, 0x14,
-	0x50, 0x9d, 0x70, 0x03, 0x8f, 0x9b, 0xa5, 0xae, 0x7a, 0xc5, 0xe3, 0xb6, 0xc7, 0x57, 0xaf, 0xf9,
-	0x15, 0xb2, 0x13, 0x83, 0xcc, 0x76, 0x0b, 0xf1, 0x36, 0xa7, 0xe5, 0xa5, 0xf3, 0xe5, 0x66, 0x23,
-	0x7d, 0xde, 0xe9, 0x8c, 0x21, 0x69, 0x7b, 0xa6, 0x0b, 0x4a, 0xca, 0x81, 0x73, 0xbd, 0xf8, 0x9f,
-	0xc8, 0x10, 0x69, 0xc1, 0x69, 0x69, 0x22, 0xe2, 0x56, 0xe2, 0x03, 0xe7, 0x20, 0x9d, 0xff, 0x45,
-	0x18, 0xa3, 0x8e, 0x63, 0x3b, 0xb2, 0x4c, 0x04, 0xc8, 0xa8, 0x08, 0xd0, 0x3e, 0xc0, 0xc1, 0x29,
-	0x2a, 0x8f, 0xec, 0x03, 0xe1, 0x43, 0x1b, 0x5f, 0x8b, 0xa9, 0x8d, 0x9f, 0x47, 0xaa, 0x75, 0x6a,
-	0x0b, 0x75, 0xcc, 0x2e, 0x36, 0x1b, 0xe9, 0x14, 0xce, 0x66, 0x21, 0x50, 0xf6, 0x74, 0xb2, 0x75,
-	0x4f, 0xfb, 0x30, 0x0e, 0x63, 0x58, 0xa9, 0x83, 0x31, 0x56, 0xe9, 0x3d, 0xc6, 0x92, 0x3c, 0xcc,
-	0xf8, 0x81, 0x58, 0xd8, 0xd3, 0x4b, 0x9e, 0xb0, 0x52, 0xc9, 0x9e, 0x6b, 0x36, 0xd2, 0xaa, 0xbf,
-	0x75, 0x0b, 0x77, 0x24, 0xe2, 0xe9, 0xe8, 0x0e, 0xb9, 0x06, 0x71, 0x6c, 0x38, 0x78, 0xff, 0x21,
-	0xc6, 0x37, 0x4c, 0x9b, 0x0c, 0xcc, 0xfb, 0x06, 0x39, 0x6d, 0x86, 0x50, 0x76, 0x1d, 0xb0, 0x4d,
-	0xf1, 0x69, 0xf9, 0xec, 0x83, 0xd7, 0x01, 0xe1, 0x6d, 0xc4, 0x71, 0x09, 0x4c, 0xca, 0x30, 0x13,
-	0xd4, 0xe6, 0x8a, 0x59, 0x35, 0x3d, 0xff, 0xdd, 0x76, 0x11, 0x1d, 0x8b, 0xce, 0x08, 0x8a, 0xf1,
-	0x3d, 0x44, 0xe0, 0xd1, 0xcc, 0x9c, 0xab, 0x3a, 0x91, 0x8d, 0x48, 0x6f, 0x31, 0x1d, 0xdd, 0x23,
-	0xbf, 0x55, 0xe0, 0x42, 0x8b, 0xa4, 0x42, 0xf1, 0x28, 0xb8, 0xc5, 0x85, 0x52, 0x45, 0x77, 0x5d,
-	0xfe, 0x14, 0x33, 0x21, 0xbd, 0xe2, 0x76, 0x52, 0x20, 0x7b, 0xe4, 0xdf, 0xe6, 0x1c, 0x23, 0xda,
-	0xd4, 0xab, 0x94, 0xeb, 0xb4, 0xd2, 0x6c, 0xa4, 0x5f, 0x75, 0x8e, 0xc3, 0x95, 0x5c, 0x71, 0xfe,
-	0x58, 0x64, 0xb2, 0x03, 0xf1, 0x1a, 0x75, 0xaa, 0xa6, 0xeb, 0x62, 0x23, 0xce, 0x5f, 0x98, 0xe7,
-	0x25, 0xdd, 0xb6, 0xc2, 0x5d, 0xee, 0x75, 0x09, 0x5d, 0xf6, 0xba, 0x04, 0x66, 0x4d, 0x5f, 0xc9,
-	0x76, 0x0c, 0xdb, 0xa2, 0xfc, 0xc9, 0x7e, 0x52, 0x4c, 0x3b, 0x02, 0x16, 0x99, 0x76, 0x04, 0x8c,
-	0xdc, 0x87, 0x59, 0xde, 0xab, 0x17, 0x0c, 0x5a, 0x73, 0x68, 0x09, 0x1b, 0x97, 0x18, 0x1e, 0xf6,
-	0x12, 0x0b, 0x74, 0xbe, 0xb9, 0x16, 0xec, 0x45, 0x4e, 0x23, 0xd9, 0xba, 0x4b, 0xd6, 0x82, 0x21,
-	0x05, 0xda, 0x4c, 0xea, 0x7b, 0x4c, 0x49, 0xfd, 0x5b, 0x81, 0xb8, 0xe4, 0x00, 0xb2, 0x0d, 0x93,
-	0x6e, 0xbd, 0x78, 0x40, 0x4b, 0x41, 0xc2, 0x5c, 0xec, 0xec, 0xaa, 0xcc, 0x0e, 0x47, 0x13, 0xdd,
-	0x8c, 0xa0, 0x89, 0x74, 0x33, 0x02, 0x86, 0x29, 0x8b, 0x3a, 0x45, 0xfe, 0xf8, 0xe4, 0xa7, 0x2c,
-	0x06, 0x88, 0xa4, 0x2c, 0x06, 0x48, 0xbd, 0x07, 0x13, 0x82, 0x2f, 0xbb, 0xc0, 0x87, 0xa6, 0x65,
-	0xc8, 0x17, 0x98, 0xad, 0xe5, 0x0b, 0xcc, 0xd6, 0xc1, 0x45, 0x1f, 0xee, 0x7d, 0xd1, 0x53, 0x26,
-	0x9c, 0xea, 0x70, 0x0d, 0x9e, 0x20, 0xe9, 0x2a, 0xc7, 0xb6, 0x01, 0x3f, 0x53, 0xe0, 0x42, 0x7f,
-	0x11, 0xdf, 0x9f, 0xf8, 0xbb, 0xb2, 0x78, 0x7f, 0xc8, 0x8b, 0x30, 0x6c, 0x91, 0x76, 0x9c, 0x82,
-	0x27, 0xdf, 0x72, 0x69, 0x3f, 0x1a, 0x83, 0xb3, 0x3d, 0x54, 0x64, 0xf3, 0xc5, 0x42, 0x55, 0xff,
-	0xb6, 0x59, 0xad, 0x57, 0xc3, 0xe1, 0x62, 0xcf, 0xd1, 0x4b, 0xac, 0x2c, 0x8a, 0xd0, 0xfb, 0xca,
-	0x71, 0x86, 0x66, 0xee, 0x73, 0x0e, 0x3e, 0xf4, 0x96, 0xa0, 0x97, 0xea, 0x75, 0xb5, 0x33, 0x86,
-	0x5c, 0xaf, 0xbb, 0xa0, 0x90, 0xdf, 0x29, 0x70, 0xbe, 0xab, 0x8a, 0x98, 0xfb, 0x6c, 0xbb, 0x82,
-	0x41, 0x1d, 0x5f, 0xcd, 0x3d, 0xa9, 0xaa, 0xd9, 0xa3, 0x2d, 0xdb, 0xae, 0x70, 0x85, 0x5f, 0x6d,
-	0x36, 0xd2, 0xaf, 0x54, 0x7b, 0xe1, 0x49, 0x6a, 0xbf, 0xd0, 0x13, 0x91, 0x35, 0x1b, 0xbd, 0x9c,
-	0x73, 0x52, 0x71, 0xaf, 0x1d, 0x6f, 0x66, 0x7f, 0xa2, 0x1f, 0x44, 0x63, 0xfe, 0xa5, 0x76, 0xff,
-	0x32, 0x86, 0x83, 0xc5, 0xbd, 0xf6, 0xfb, 0x61, 0x48, 0x1f, 0xc3, 0x83, 0xfc, 0xb2, 0x8f, 0xc0,
-	0xbc, 0xd9, 0x8f, 0x36, 0x27, 0x1a, 0x9c, 0x9f, 0xc7, 0xf9, 0x6a, 0x79, 0x88, 0x61, 0x1d, 0xb8,
-	0x67, 0xba, 0x1e, 0xb9, 0x0a, 0xe3, 0xd8, 0xce, 0xfb, 0x75, 0x02, 0xc2, 0x3a, 0xc1, 0x6b, 0x0e,
-	0xdf, 0x95, 0x6b, 0x0e, 0x87, 0x68, 0xbb, 0x40, 0xf8, 0x1b, 0x6c, 0x45, 0xea, 0x81, 0xc9, 0x0d,
-	0x48, 0x94, 0x38, 0x94, 0x1a, 0xd2, 0xac, 0x82, 0xdf, 0x65, 0x82, 0x8d, 0xe8, 0xc4, 0x32, 0x25,
-	0xc3, 0xb5, 0x6b, 0x30, 0x83, 0xd2, 0x6f, 0xd3, 0xe0, 0xc5, 0xbe, 0xcf, 0x26, 0x50, 0x7b, 0x13,
-	0x08, 0x92, 0xe6, 0xb0, 0x56, 0x0f, 0x4a, 0xfd, 0x55, 0x98, 0x43, 0xea, 0x5d, 0xab, 0xf4, 0x44,
-	0xf4, 0x37, 0x40, 0xdd, 0xf1, 0x1c, 0xaa, 0x57, 0x4d, 0xab, 0xdc, 0x6a, 0xc1, 0x8b, 0x30, 0x62,
-	0xd5, 0xab, 0xc8, 0x22, 0xc1, 0x8f, 0xd1, 0xaa, 0x57, 0xe5, 0x63, 0xb4, 0xea, 0xd5, 0x40, 0xfd,
-	0x35, 0x5a, 0xa1, 0x1e, 0x1d, 0x54, 0xfc, 0xc7, 0x0a, 0x00, 0x7f, 0x32, 0x5e, 0xb7, 0xf6, 0xec,
-	0xbe, 0x1b, 0xe7, 0x6b, 0x10, 0xc7, 0xf3, 0x34, 0x0a, 0x07, 0x36, 0xd6, 0x76, 0x65, 0x79, 0x8c,
-	0x77, 0xbc, 0x1c, 0xbc, 0x61, 0x47, 0x0a, 0x3c, 0x84, 0x50, 0x46, 0x5a, 0xa1, 0xba, 0xeb, 0x93,
-	0x8e, 0x84, 0xa4, 0x1c, 0xdc, 0x4a, 0x1a, 0x42, 0xb5, 0xc7, 0x70, 0x8a, 0xfb, 0xba, 0x66, 0xe8,
-	0x5e, 0x38, 0xf8, 0xbd, 0x21, 0x7f, 0x9a, 0x89, 0xc6, 0x62, 0xaf, 0x49, 0x74, 0x80, 0xc1, 0xa6,
-	0x0e, 0x6a, 0x56, 0xf7, 0x4a, 0xfb, 0x9d, 0xa4, 0xbf, 0x07, 0x89, 0x3d, 0xdd, 0xac, 0xf8, 0x8f,
-	0x90, 0xfe, 0x8d, 0x50, 0x43, 0x2d, 0xa2, 0x04, 0x3c, 0xa8, 0x39, 0xc9, 0x5b, 0xad, 0xb7, 0x64,
-	0x4a, 0x86, 0x07, 0xf6, 0xe6, 0xf0, 0x99, 0xea, 0xf3, 0xb2, 0xb7, 0x45, 0xfa, 0xf1, 0xf6, 0x46,
-	0x09, 0x06, 0xb0, 0x37, 0x0e, 0xb1, 0xbc, 0x65, 0xdc, 0xd7, 0x9d, 0x43, 0xea, 0x68, 0x1f, 0x29,
-	0x70, 0x3a, 0x7a, 0x33, 0xee, 0x53, 0xd7, 0xd5, 0xcb, 0x94, 0x7c, 0x69, 0x30, 0xfb, 0xef, 0x0c,
-	0x85, 0x5f, 0x04, 0x46, 0xa8, 0x65, 0x88, 0xa2, 0x32, 0x8d, 0x64, 0x81, 0x3c, 0x7e, 0xbf, 0xa8,
-	0xdc, 0x63, 0xde, 0x19, 0xda, 0x66, 0xf8, 0xd9, 0x09, 0x18, 0xa3, 0x8f, 0xa8, 0xe5, 0x69, 0x3f,
-	0x50, 0xc4, 0x81, 0xb4, 0x7c, 0x1b, 0xec, 0xf7, 0xd6, 0xdc, 0x0e, 0xc7, 0x4d, 0x2c, 0x1b, 0xd4,
-	0xef, 0x8a, 0xf1, 0x13, 0x65, 0xcb, 0x96, 0xfc, 0x89, 0xb2, 0x65, 0x4b, 0xfb, 0x93, 0xe2, 0xe7,
-	0xac, 0xc8, 0xe7, 0xac, 0xe7, 0xad, 0x07, 0x59, 0x83, 0xd8, 0x81, 0xf8, 0x98, 0xc4, 0xc7, 0xde,
-	0xb6, 0x4f, 0x4c, 0xf8, 0x06, 0x18, 0xe0, 0xc8, 0x6f, 0x80, 0x01, 0xf0, 0x52, 0x0a, 0xe2, 0xd2,
-	0x9f, 0x17, 0x48, 0x1c, 0x26, 0xc4, 0x32, 0x39, 0x74, 0xe9, 0x22, 0xc4, 0xa5, 0x8f, 0xdc, 0x64,
-	0x0a, 0x26, 0x37, 0x6d, 0x83, 0x6e, 0xd9, 0x8e, 0x97, 0x1c, 0x62, 0xab, 0x3b, 0x54, 0x37, 0x2a,
-	0x0c, 0x55, 0xb9, 0xf4, 0x73, 0x05, 0x26, 0x7d, 0xb9, 0x04, 0x60, 0xfc, 0xad, 0xdd, 0xfc, 0x6e,
-	0x7e, 0x2d, 0x39, 0xc4, 0x18, 0x6e, 0xe5, 0x37, 0xd7, 0xd6, 0x37, 0x6f, 0x27, 0x15, 0xb6, 0xd8,
-	0xde, 0xdd, 0xdc, 0x64, 0x8b, 0x61, 0x92, 0x80, 0xd8, 0xce, 0x6e, 0x2e, 0x97, 0xcf, 0xaf, 0xe5,
-	0xd7, 0x92, 0x23, 0x8c, 0xe8, 0xd6, 0xcd, 0xf5, 0x7b, 0xf9, 0xb5, 0xe4, 0x28, 0xc3, 0xdb, 0xdd,
-	0xbc, 0xbb, 0xf9, 0xe0, 0x9d, 0xcd, 0xe4, 0x18, 0xc7, 0xcb, 0xde, 0x5f, 0x7f, 0xf8, 0x30, 0xbf,
-	0x96, 0x1c, 0x67, 0x78, 0xf7, 0xf2, 0x37, 0x77, 0xf2, 0x6b, 0xc9, 0x09, 0xb6, 0xb5, 0xb5, 0x9d,
-	0xcf, 0xdf, 0xdf, 0x62, 0x5b, 0x93, 0x6c, 0x99, 0xbb, 0xb9, 0x99, 0xcb, 0xdf, 0x63, 0x5c, 0x62,
-	0x4c, 0xc3, 0xed, 0xfc, 0x46, 0x3e, 0xc7, 0x36, 0x61, 0xf5, 0x8f, 0x63, 0x30, 0x85, 0xc7, 0xe6,
-	0x3f, 0x9a, 0xbe, 0x0e, 0x71, 0x7e, 0x59, 0xf8, 0xb3, 0x85, 0x14, 0xc9, 0xa9, 0xf9, 0xb6, 0xe7,
-	0xec, 0x3c, 0xf3, 0x9b, 0x36, 0x44, 0x6e, 0xc0, 0x94, 0x44, 0xe4, 0x92, 0xe9, 0x90, 0x8a, 0xd5,
-	0xe6, 0xd4, 0x0b, 0xb8, 0xee, 0x76, 0x7f, 0xb5, 0x21, 0x26, 0x95, 0xa7, 0xa4, 0x01, 0xa5, 0x4a,
-	0x44, 0xc7, 0x4b, 0x8d, 0x26, 0x3d, 0x6d, 0x88, 0x7c, 0x0d, 0xe2, 0xbc, 0x44, 0x71, 0xa9, 0x67,
-	0x42, 0xfa, 0x48, 0xe5, 0xea, 0xa1, 0x42, 0x06, 0x26, 0x6f, 0x53, 0x8f, 0x93, 0xcf, 0x85, 0xe4,
-	0x61, 0xc1, 0x4c, 0x49, 0xa6, 0x68, 0x43, 0x64, 0x03, 0x62, 0x3e, 0xbe, 0x4b, 0xb8, 0x7e, 0xdd,
-	0x4a, 0x6d, 0x2a, 0xd5, 0x61, 0x5b, 0xe4, 0x1b, 0x6d, 0xe8, 0x35, 0x85, 0x69, 0xcf, 0xfb, 0x83,
-	0x36, 0xed, 0x23, 0x6d, 0x43, 0x0f, 0xed, 0xd7, 0x20, 0xe1, 0xf7, 0x08, 0x9c, 0xc7, 0x82, 0x54,
-	0x21, 0xa2, 0xcd, 0x43, 0x4f, 0x2e, 0xd3, 0x22, 0xf9, 0x3c, 0x10, 0x6c, 0xa4, 0xc4, 0x1b, 0x4d,
-	0x4b, 0x3d, 0xb8, 0x64, 0x21, 0xc1, 0x33, 0xc7, 0x83, 0x0e, 0xf6, 0xc8, 0x29, 0xa5, 0x3b, 0x8f,
-	0xd5, 0x1f, 0xc6, 0x60, 0x9c, 0x3f, 0xdb, 0x91, 0xb7, 0x01, 0xf8, 0x2f, 0x2c, 0xf0, 0xa7, 0x3b,
-	0xfe, 0x15, 0x23, 0x35, 0xdf, 0xf9, 0xad, 0x4f, 0x5b, 0xf8, 0xde, 0x5f, 0xfe, 0xf1, 0xe3, 0xe1,
-	0x53, 0xda, 0xf4, 0xca, 0xa3, 0x2b, 0x2b, 0x07, 0x76, 0x51, 0xfc, 0xd1, 0xf4, 0xba, 0x72, 0x89,
-	0xbc, 0x03, 0xc0, 0xb5, 0x89, 0xf2, 0x8d, 0x6a, 0xc8, 0x55, 0x6f, 0xef, 0x29, 0xdb, 0x19, 0xf3,
-	0x86, 0x91, 0x31, 0xfe, 0x26, 0x4c, 0x05, 0x8c, 0x77, 0xa8, 0x27, 0x7c, 0xd8, 0xe1, 0x1f, 0x02,
-	0x5d, 0xed, 0x3f, 0x87, 0xcc, 0xe7, 0xb5, 0x59, 0xc1, 0xdc, 0xa5, 0x9e, 0xc4, 0xdf, 0x82, 0xa4,
-	0xfc, 0xc2, 0x8c, 0xea, 0x9f, 0xed, 0xfc, 0xf6, 0xcc, 0xc5, 0x9c, 0xeb, 0xf5, 0x30, 0xad, 0xa5,
-	0x51, 0xd8, 0x82, 0x36, 0xe7, 0x5b, 0x22, 0x3d, 0x32, 0x53, 0x26, 0xef, 0x3d, 0x88, 0x8b, 0xb3,
-	0x47, 0x51, 0x81, 0xab, 0xfb, 0x0c, 0x88, 0x14, 0xf2, 0x9f, 0xd3, 0x66, 0x7c, 0xfe, 0x35, 0x4e,
-	0xc7, 0x58, 0xdf, 0x1e, 0x3c, 0x45, 0xcd, 0x21, 0xbb, 0x69, 0x2d, 0xc6, 0xd8, 0x61, 0xe5, 0x65,
-	0x8c, 0x4a, 0x4f, 0x97, 0xb6, 0x5e, 0x42, 0xa6, 0x8b, 0xda, 0x02, 0x63, 0x5a, 0x64, 0x58, 0xd4,
-	0x58, 0xe1, 0xdf, 0xef, 0x44, 0x23, 0xc2, 0x84, 0x6c, 0x0e, 0x9e, 0xda, 0xce, 0x22, 0xe3, 0xd3,
-	0xa9, 0x64, 0xa0, 0xed, 0xca, 0x77, 0x59, 0x95, 0xfc, 0x40, 0x28, 0xfd, 0x34, 0x59, 0x4f, 0x28,
-	0x9d, 0x8a, 0x28, 0x5d, 0x47, 0x1c, 0x49, 0xe9, 0x77, 0x9f, 0x32, 0x33, 0xaa, 0x28, 0x85, 0x5c,
-	0x6a, 0xb3, 0x80, 0xdc, 0x1a, 0x28, 0x63, 0x0a, 0x3e, 0xa4, 0x9d, 0x8f, 0xf1, 0x8c, 0x32, 0xa9,
-	0x08, 0x34, 0x42, 0x64, 0x7f, 0x70, 0x47, 0xbc, 0xa6, 0x90, 0xeb, 0x30, 0x7e, 0x07, 0xff, 0x9f,
-	0x4d, 0xba, 0x58, 0x9a, 0xe2, 0xf7, 0x94, 0x23, 0xe5, 0xf6, 0x69, 0xe9, 0x30, 0x68, 0x32, 0xdf,
-	0xfd, 0xc3, 0xa7, 0x8b, 0xca, 0x27, 0x9f, 0x2e, 0x2a, 0x7f, 0xff, 0x74, 0x51, 0xf9, 0xe8, 0xb3,
-	0xc5, 0xa1, 0x4f, 0x3e, 0x5b, 0x1c, 0xfa, 0xeb, 0x67, 0x8b, 0x43, 0x5f, 0x7f, 0xa5, 0x6c, 0x7a,
-	0xfb, 0xf5, 0x62, 0xa6, 0x64, 0x57, 0x57, 0x74, 0xa7, 0xaa, 0x1b, 0x7a, 0xcd, 0xb1, 0x0f, 0x68,
-	0xc9, 0x13, 0xab, 0x15, 0xf1, 0xdf, 0xf0, 0x8f, 0x87, 0xe7, 0x6e, 0x22, 0x60, 0x8b, 0x6f, 0x67,
-	0xd6, 0xed, 0xcc, 0xcd, 0x9a, 0x59, 0x1c, 0x47, 0x1d, 0x5e, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0xd4, 0xc6, 0x26, 0x09, 0x2f, 0x00, 0x00,
+	// 3974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x6f, 0x1b, 0x49,
+	0x72, 0xd7, 0x50, 0x9f, 0x2c, 0x8a, 0x12, 0xd5, 0x96, 0xe5, 0x11, 0xed, 0x15, 0xb5, 0xb3, 0x7b,
+	0x7b, 0x5a, 0x9d, 0x43, 0xed, 0x6a, 0xef, 0x12, 0xdb, 0xd9, 0x5b, 0x47, 0xa4, 0x68, 0xaf, 0x64,
+	0x9b, 0x96, 0x29, 0xeb, 0x76, 0x75, 0x08, 0x32, 0x19, 0x0e, 0x5b, 0xd2, 0x58, 0xe4, 0x0c, 0x3d,
+	0x33, 0xb4, 0x57, 0x1b, 0x1c, 0x02, 0x04, 0x41, 0xf2, 0x70, 0x08, 0x70, 0x48, 0x10, 0x20, 0x40,
+	0x82, 0xe4, 0x1e, 0xf2, 0x74, 0x41, 0xf2, 0x96, 0x97, 0x20, 0x7f, 0xc0, 0xe1, 0x92, 0x00, 0x17,
+	0xe4, 0x25, 0x79, 0x21, 0x82, 0xdd, 0x04, 0x01, 0xf8, 0x94, 0xfc, 0x07, 0x41, 0x57, 0xf7, 0xcc,
+	0xf4, 0xf0, 0x4b, 0x94, 0xbf, 0xee, 0xe5, 0xde, 0x34, 0xbf, 0xaa, 0xae, 0xaa, 0xae, 0xee, 0xae,
+	0xaa, 0xae, 0xa6, 0x60, 0xb1, 0x79, 0x7a, 0xbc, 0x61, 0x34, 0xad, 0x0d, 0xaf, 0x55, 0x6d, 0x58,
+	0x7e, 0xbe, 0xe9, 0x3a, 0xbe, 0x43, 0xc6, 0x8d, 0xa6, 0x95, 0xbd, 0x7a, 0xec, 0x38, 0xc7, 0x75,
+	0xba, 0x81, 0x50, 0xb5, 0x75, 0xb4, 0x41, 0x1b, 0x4d, 0xff, 0x8c, 0x73, 0x64, 0x73, 0xdd, 0x44,
+	0xdf, 0x6a, 0x50, 0xcf, 0x37, 0x1a, 0x4d, 0xc1, 0xa0, 0x9d, 0xde, 0xf0, 0xf2, 0x96, 0x83, 0xb2,
+	0x4d, 0xc7, 0xa5, 0x1b, 0xcf, 0x3e, 0xdc, 0x38, 0xa6, 0x36, 0x75, 0x0d, 0x9f, 0xd6, 0x04, 0xcf,
+	0x9a, 0xc4, 0x63, 0x53, 0xff, 0xb9, 0xe3, 0x9e, 0x5a, 0xf6, 0x71, 0x3f, 0xce, 0x6f, 0x47, 0x9c,
+	0x0d, 0xc3, 0x3c, 0xb1, 0x6c, 0xea, 0x9e, 0x6d, 0x04, 0xa6, 0xbb, 0xd4, 0x73, 0x5a, 0xae, 0x49,
+	0x7b, 0x46, 0x5d, 0x13, 0x46, 0x32, 0x26, 0xc3, 0xb6, 0x1d, 0xdf, 0xf0, 0x2d, 0xc7, 0xf6, 0x04,
+	0x35, 0x9c, 0xfa, 0x09, 0x35, 0xea, 0xfe, 0x09, 0x47, 0xb5, 0x9f, 0xce, 0xc1, 0xe2, 0xae, 0x53,
+	0xdd, 0x47, 0x77, 0x54, 0xe8, 0xd3, 0x16, 0xf5, 0xfc, 0x1d, 0x9f, 0x36, 0xc8, 0x26, 0xcc, 0x34,
+	0x5d, 0xcb, 0x71, 0x2d, 0xff, 0x4c, 0x55, 0x56, 0x95, 0x35, 0xa5, 0xb0, 0xd4, 0x69, 0xe7, 0x48,
+	0x80, 0x5d, 0x77, 0x1a, 0x96, 0x8f, 0x1e, 0xaa, 0x84, 0x7c, 0xe4, 0x3b, 0x90, 0xb4, 0x8d, 0x06,
+	0xf5, 0x9a, 0x86, 0x49, 0xd5, 0xf1, 0x55, 0x65, 0x2d, 0x59, 0xb8, 0xd2, 0x69, 0xe7, 0x2e, 0x85,
+	0xa0, 0x34, 0x2a, 0xe2, 0x24, 0x1f, 0x41, 0xd2, 0xac, 0x5b, 0xd4, 0xf6, 0x75, 0xab, 0xa6, 0xce,
+	0xe0, 0x30, 0xd4, 0xc5, 0xc1, 0x9d, 0x9a, 0xac, 0x2b, 0xc0, 0xc8, 0x3e, 0x4c, 0xd5, 0x8d, 0x2a,
+	0xad, 0x7b, 0xea, 0xc4, 0xea, 0xf8, 0x5a, 0x6a, 0xf3, 0x1b, 0x79, 0xa3, 0x69, 0xe5, 0xfb, 0x4d,
+	0x25, 0x7f, 0x1f, 0xf9, 0x4a, 0xb6, 0xef, 0x9e, 0x15, 0x16, 0x3b, 0xed, 0x5c, 0x86, 0x0f, 0x94,
+	0xc4, 0x0a, 0x51, 0xe4, 0x18, 0x52, 0x92, 0xe3, 0xd4, 0x49, 0x94, 0xbc, 0x3e, 0x58, 0xf2, 0x56,
+	0xc4, 0xcc, 0xc5, 0x2f, 0x77, 0xda, 0xb9, 0xcb, 0x92, 0x08, 0x49, 0x87, 0x2c, 0x99, 0xfc, 0xa1,
+	0x02, 0x8b, 0x2e, 0x7d, 0xda, 0xb2, 0x5c, 0x5a, 0xd3, 0x6d, 0xa7, 0x46, 0x75, 0x31, 0x99, 0x29,
+	0x54, 0xf9, 0xe1, 0x60, 0x95, 0x15, 0x31, 0xaa, 0xec, 0xd4, 0xa8, 0x3c, 0x31, 0xad, 0xd3, 0xce,
+	0x5d, 0x73, 0x7b, 0x88, 0x91, 0x01, 0xaa, 0x52, 0x21, 0xbd, 0x74, 0xf2, 0x10, 0x66, 0x9a, 0x4e,
+	0x4d, 0xf7, 0x9a, 0xd4, 0x54, 0x13, 0xab, 0xca, 0x5a, 0x6a, 0xf3, 0x6a, 0x9e, 0xef, 0x3e, 0xb4,
+	0x81, 0xed, 0xe5, 0xfc, 0xb3, 0x0f, 0xf3, 0x7b, 0x4e, 0x6d, 0xbf, 0x49, 0x4d, 0x5c, 0xcf, 0x85,
+	0x26, 0xff, 0x88, 0xc9, 0x9e, 0x16, 0x20, 0xd9, 0x83, 0x64, 0x20, 0xd0, 0x53, 0xa7, 0x71, 0x3a,
+	0x43, 0x25, 0xf2, 0x6d, 0xc5, 0x3f, 0xbc, 0xd8, 0xb6, 0x12, 0x18, 0x29, 0xc2, 0xb4, 0x65, 0x1f,
+	0xbb, 0xd4, 0xf3, 0xd4, 0x24, 0xca, 0x23, 0x28, 0x68, 0x87, 0x63, 0x45, 0xc7, 0x3e, 0xb2, 0x8e,
+	0x0b, 0x97, 0x99, 0x61, 0x82, 0x4d, 0x92, 0x12, 0x8c, 0x24, 0x77, 0x60, 0xc6, 0xa3, 0xee, 0x33,
+	0xcb, 0xa4, 0x9e, 0x0a, 0x92, 0x94, 0x7d, 0x0e, 0x0a, 0x29, 0x68, 0x4c, 0xc0, 0x27, 0x1b, 0x13,
+	0x60, 0x6c, 0x8f, 0x7b, 0xe6, 0x09, 0xad, 0xb5, 0xea, 0xd4, 0x55, 0x53, 0xd1, 0x1e, 0x0f, 0x41,
+	0x79, 0x8f, 0x87, 0x20, 0xa9, 0xc0, 0x6c, 0x8d, 0x36, 0xa9, 0x5d, 0xa3, 0xb6, 0x69, 0x51, 0x4f,
+	0x4d, 0x4b, 0x26, 0xec, 0x3a, 0xd5, 0xed, 0x80, 0x76, 0x56, 0xc8, 0x76, 0xda, 0xb9, 0x25, 0x99,
+	0x57, 0x12, 0x18, 0x93, 0x41, 0x7e, 0x17, 0x96, 0xc3, 0xef, 0x33, 0xfd, 0xc8, 0xb0, 0xea, 0x2d,
+	0x97, 0xea, 0x4d, 0xa7, 0x6e, 0x99, 0x67, 0xea, 0xdc, 0xaa, 0xb2, 0x36, 0xb7, 0x79, 0x0d, 0x15,
+	0x44, 0xd2, 0xef, 0x70, 0xa6, 0x3d, 0xe4, 0x29, 0x7c, 0xa3, 0xd3, 0xce, 0xbd, 0x5d, 0xeb, 0x4f,
+	0x94, 0xb4, 0x5e, 0x19, 0xc0, 0x42, 0x7e, 0x15, 0xc0, 0x70, 0x5d, 0xe3, 0x4c, 0xf7, 0xac, 0x2f,
+	0xa9, 0x3a, 0xbf, 0xaa, 0xac, 0xa5, 0xb9, 0x33, 0x10, 0xdd, 0xb7, 0xbe, 0x8c, 0x1d, 0xf8, 0x10,
+	0x24, 0x15, 0x00, 0xdb, 0xf1, 0xf5, 0x2a, 0x3d, 0x72, 0x5c, 0xaa, 0x66, 0x70, 0xd7, 0x65, 0xf3,
+	0x3c, 0x7a, 0xe5, 0x83, 0x10, 0x9b, 0x7f, 0x1c, 0x84, 0x58, 0x11, 0x44, 0x1c, 0xbf, 0x80, 0x03,
+	0x62, 0x41, 0x24, 0x00, 0xc9, 0x0e, 0x2c, 0x3c, 0x6d, 0xd1, 0x16, 0xd5, 0x7d, 0xbf, 0xae, 0x7b,
+	0xd4, 0x74, 0xec, 0x9a, 0xa7, 0x2e, 0xa0, 0x49, 0x6f, 0x75, 0xda, 0xb9, 0x65, 0x24, 0x3e, 0xf6,
+	0xeb, 0xfb, 0x9c, 0x24, 0x09, 0x99, 0xef, 0x22, 0x91, 0x32, 0xcc, 0xba, 0xd4, 0x77, 0xcf, 0x02,
+	0x57, 0x12, 0x34, 0x30, 0x83, 0xae, 0xac, 0x30, 0x82, 0x70, 0x1f, 0x1e, 0x76, 0x37, 0x02, 0xe4,
+	0xc3, 0x2e, 0xc1, 0xe4, 0x73, 0x48, 0x99, 0xb8, 0xbd, 0xf4, 0x86, 0xd1, 0xf4, 0xd4, 0x4b, 0xb8,
+	0xf4, 0x6f, 0xf5, 0x3b, 0x13, 0x7c, 0x17, 0x3e, 0x30, 0x9a, 0x05, 0xb5, 0xd3, 0xce, 0x2d, 0x9a,
+	0xc1, 0xa7, 0x6c, 0x2e, 0x44, 0x28, 0xb9, 0x07, 0xd3, 0x1e, 0x35, 0x5d, 0xea, 0x7b, 0xea, 0x22,
+	0x4a, 0xcd, 0xf6, 0x93, 0xba, 0x8f, 0x2c, 0xfc, 0x84, 0x08, 0x76, 0xf9, 0x84, 0x08, 0x28, 0x6b,
+	0x40, 0x4a, 0x0a, 0x28, 0xe4, 0x1d, 0x18, 0x3f, 0xa5, 0x3c, 0xf6, 0x27, 0x0b, 0x0b, 0x9d, 0x76,
+	0x2e, 0x7d, 0x4a, 0xe5, 0x29, 0x32, 0x2a, 0x79, 0x1f, 0x26, 0x9f, 0x19, 0xf5, 0x16, 0xc5, 0xd0,
+	0x91, 0x2c, 0x5c, 0xea, 0xb4, 0x73, 0xf3, 0x08, 0x48, 0x8c, 0x9c, 0xe3, 0x56, 0xe2, 0x86, 0x92,
+	0x3d, 0x82, 0x4c, 0x77, 0xc8, 0x7c, 0x2d, 0x7a, 0x1a, 0x70, 0x65, 0x40, 0x9c, 0x7c, 0x1d, 0xea,
+	0x76, 0x27, 0x66, 0x66, 0x33, 0x69, 0xad, 0x09, 0xe9, 0xd8, 0x49, 0x26, 0xeb, 0x30, 0xf5, 0xc4,
+	0xa9, 0xb2, 0xa4, 0xa6, 0x44, 0x62, 0x9e, 0x38, 0xd5, 0x58, 0x46, 0x9b, 0x44, 0x20, 0x9e, 0x03,
+	0x13, 0xa3, 0xe5, 0x40, 0xed, 0x5f, 0x14, 0x48, 0x49, 0x1b, 0x92, 0x7c, 0x0c, 0xb3, 0x0d, 0xe3,
+	0x0b, 0xdd, 0xf0, 0x91, 0xd3, 0x43, 0xb5, 0x69, 0xbe, 0x4d, 0x1b, 0xc6, 0x17, 0x5b, 0x02, 0x96,
+	0xb7, 0xa9, 0x04, 0x93, 0x12, 0xcc, 0x57, 0x0d, 0xf3, 0xd4, 0x39, 0x3a, 0x0a, 0xcf, 0x4f, 0x02,
+	0x05, 0x5c, 0xeb, 0xb4, 0x73, 0xaa, 0x20, 0xf5, 0x1e, 0x9f, 0xb9, 0x38, 0x85, 0xdc, 0x84, 0x49,
+	0xb7, 0x55, 0xa7, 0x9e, 0x3a, 0x8e, 0x3b, 0x72, 0x2e, 0x3a, 0x36, 0x95, 0x56, 0x9d, 0x72, 0x27,
+	0x20, 0x83, 0xec, 0x04, 0x04, 0xb4, 0x1f, 0x26, 0x20, 0x19, 0x72, 0x92, 0x4f, 0x60, 0xca, 0x30,
+	0xd9, 0x46, 0xc1, 0x79, 0xcc, 0xc9, 0x07, 0x70, 0x0b, 0x71, 0x9e, 0xcc, 0x39, 0x8f, 0x9c, 0xcc,
+	0x39, 0xc2, 0xa2, 0x13, 0xfd, 0xc2, 0xf2, 0x75, 0xd3, 0xa9, 0x51, 0x36, 0x95, 0xf1, 0xb5, 0x49,
+	0x1e, 0x49, 0x18, 0x5a, 0x64, 0xa0, 0x1c, 0x49, 0x42, 0x90, 0xdc, 0x83, 0x05, 0xd3, 0xb1, 0x7d,
+	0x83, 0x55, 0x5d, 0xba, 0x4b, 0x0d, 0x8f, 0x95, 0x02, 0x6c, 0x32, 0xc9, 0xc2, 0x4a, 0xa7, 0x9d,
+	0xcb, 0x86, 0xc4, 0x0a, 0xa7, 0x49, 0x52, 0x32, 0xdd, 0x34, 0x72, 0x13, 0x52, 0xd4, 0x75, 0x1d,
+	0x57, 0x3f, 0xb5, 0x98, 0x43, 0x27, 0x50, 0x0c, 0x1e, 0x6e, 0x84, 0xef, 0x59, 0x71, 0x67, 0x42,
+	0x84, 0x6a, 0xff, 0x37, 0x0e, 0xe9, 0x58, 0x8e, 0x23, 0xb7, 0x60, 0xc2, 0x3f, 0x6b, 0xd2, 0x98,
+	0x3f, 0x04, 0xc7, 0xe3, 0xb3, 0x26, 0x45, 0x7f, 0xcc, 0x31, 0x8e, 0x58, 0x66, 0xc6, 0x31, 0x6c,
+	0x4b, 0x37, 0x1d, 0xd7, 0xe7, 0x8e, 0x48, 0xf3, 0x65, 0x40, 0x40, 0x5e, 0x06, 0x04, 0xc8, 0x6f,
+	0xc7, 0xab, 0x20, 0xbe, 0x8e, 0xef, 0xf4, 0xe6, 0xdc, 0x17, 0x2f, 0x7f, 0x6e, 0x42, 0xca, 0xaf,
+	0x7b, 0x3a, 0xb5, 0x8d, 0x6a, 0x9d, 0xd6, 0xd4, 0x89, 0x55, 0x65, 0x6d, 0x86, 0x7b, 0xc5, 0x67,
+	0xe7, 0x14, 0x51, 0xd9, 0x2b, 0x11, 0x8a, 0x07, 0x85, 0xba, 0xbe, 0xce, 0xca, 0x47, 0x75, 0x52,
+	0x3a, 0x28, 0xd4, 0xf5, 0xcb, 0x46, 0x83, 0xc6, 0x0e, 0x8a, 0xc0, 0xc8, 0x6d, 0x48, 0xb7, 0x3c,
+	0xaa, 0x9b, 0xf5, 0x96, 0xe7, 0x53, 0x77, 0x67, 0x4f, 0x9d, 0x42, 0x8d, 0x98, 0x6a, 0x5b, 0x1e,
+	0x2d, 0x06, 0xb8, 0x9c, 0x6a, 0x65, 0xfc, 0x4d, 0x05, 0x2e, 0xed, 0x2f, 0x14, 0x48, 0xc7, 0x2a,
+	0x12, 0x72, 0xa3, 0xcf, 0x9a, 0x0b, 0x0e, 0x5c, 0x73, 0xd2, 0xbb, 0xe6, 0x17, 0x5f, 0xf1, 0xf7,
+	0x60, 0x02, 0xfd, 0xc9, 0x6b, 0x76, 0x14, 0x69, 0xc7, 0x7d, 0x89, 0x74, 0xed, 0x3f, 0x14, 0xc8,
+	0x74, 0x57, 0xa5, 0x4c, 0x0f, 0x66, 0x50, 0x39, 0xca, 0x21, 0x20, 0xeb, 0x41, 0x80, 0x7c, 0x1b,
+	0x80, 0x45, 0x44, 0x8f, 0x76, 0x87, 0xb9, 0x27, 0x4e, 0x75, 0x9f, 0x76, 0x85, 0xb9, 0x00, 0x23,
+	0x35, 0x58, 0x60, 0xa3, 0x5c, 0xae, 0x4f, 0x67, 0x0c, 0xc1, 0xae, 0x5c, 0x1e, 0x58, 0x28, 0xf3,
+	0xac, 0xff, 0xc4, 0xa9, 0x4a, 0x58, 0x2c, 0xeb, 0x77, 0x91, 0xb4, 0x7f, 0x55, 0x60, 0x61, 0xd7,
+	0xa9, 0xee, 0xb9, 0x94, 0x31, 0xbc, 0xb1, 0xc9, 0xfd, 0x0a, 0x4c, 0xf3, 0x24, 0x11, 0xc4, 0x18,
+	0x0c, 0x6a, 0x98, 0x14, 0x62, 0x37, 0x14, 0x8e, 0x90, 0xeb, 0x30, 0xc5, 0x43, 0x12, 0x1e, 0x1a,
+	0xc1, 0xcd, 0x11, 0x99, 0x9b, 0x23, 0xda, 0xdf, 0x25, 0x70, 0xbd, 0x8a, 0x86, 0x6d, 0xd2, 0x7a,
+	0x30, 0xa5, 0x8b, 0xa4, 0xa5, 0x17, 0x9b, 0x53, 0xe8, 0xb4, 0xf1, 0x73, 0x9d, 0x26, 0x4d, 0x7f,
+	0xe2, 0x42, 0xd3, 0x9f, 0x3c, 0x7f, 0xfa, 0xe4, 0x03, 0x98, 0xe1, 0xf5, 0xa9, 0x55, 0xc3, 0x13,
+	0x9f, 0xe4, 0x35, 0x10, 0x62, 0x31, 0xd3, 0xa7, 0x05, 0xa4, 0xfd, 0xb7, 0x02, 0x97, 0x76, 0x71,
+	0x1a, 0x71, 0x9f, 0xc5, 0xfd, 0xa0, 0x5c, 0xd4, 0x0f, 0x89, 0x73, 0xfd, 0x70, 0x1b, 0xa6, 0x8e,
+	0xac, 0xba, 0x4f, 0x5d, 0xf4, 0x59, 0x6a, 0x73, 0x21, 0xdc, 0xd8, 0xd4, 0xbf, 0x83, 0x04, 0x3e,
+	0x57, 0xce, 0x24, 0xcf, 0x95, 0x23, 0x17, 0xdc, 0x18, 0xf7, 0x60, 0x56, 0x96, 0x4d, 0x7e, 0x1d,
+	0xa6, 0x3c, 0xdf, 0xf0, 0x29, 0xab, 0x19, 0xc6, 0xd7, 0xe6, 0x36, 0xd3, 0xa1, 0x7a, 0x86, 0x72,
+	0x61, 0x9c, 0x41, 0x16, 0xc6, 0x11, 0xed, 0x67, 0x0b, 0x30, 0xbe, 0xeb, 0x54, 0xc9, 0x2a, 0x24,
+	0x42, 0xe7, 0x64, 0x3a, 0xed, 0xdc, 0xac, 0x25, 0xbb, 0x25, 0x61, 0x75, 0x55, 0x39, 0xe9, 0x11,
+	0x6f, 0xfa, 0xaf, 0x7d, 0x0f, 0xc6, 0xda, 0x16, 0xd3, 0x23, 0xb7, 0x2d, 0x0a, 0x61, 0x07, 0x82,
+	0xdf, 0x4a, 0x17, 0x03, 0x9f, 0x5d, 0xa0, 0xe1, 0xf0, 0xbd, 0x78, 0xaa, 0x85, 0x78, 0x50, 0x7b,
+	0xf1, 0x04, 0xfb, 0x6c, 0x40, 0x7b, 0x21, 0x85, 0x0a, 0x56, 0x43, 0x05, 0xaf, 0xba, 0x9b, 0xf0,
+	0x3e, 0x4c, 0x3a, 0xcf, 0x6d, 0xea, 0x8a, 0x36, 0x0e, 0x7a, 0x1d, 0x01, 0xd9, 0xeb, 0x08, 0x10,
+	0x0a, 0x57, 0xf9, 0x85, 0x0d, 0x3f, 0xbd, 0x13, 0xab, 0xa9, 0xb7, 0x3c, 0xea, 0xea, 0xc7, 0xae,
+	0xd3, 0x6a, 0x7a, 0xea, 0x3c, 0x46, 0x83, 0xf7, 0x3a, 0xed, 0x9c, 0x86, 0x6c, 0x0f, 0x03, 0xae,
+	0x03, 0x8f, 0xba, 0x77, 0x91, 0x47, 0x92, 0xa9, 0x0e, 0xe2, 0x21, 0xbf, 0xaf, 0xc0, 0x7b, 0xa6,
+	0xd3, 0x68, 0xb2, 0xb2, 0x85, 0xd6, 0xf4, 0x61, 0x2a, 0x2f, 0xad, 0x2a, 0x6b, 0xb3, 0x85, 0x0f,
+	0x3a, 0xed, 0xdc, 0xf5, 0x68, 0xc4, 0xa3, 0xf3, 0x95, 0x6b, 0xe7, 0x73, 0xc7, 0xda, 0x69, 0x13,
+	0x23, 0xb6, 0xd3, 0xe4, 0xd6, 0xcc, 0xe4, 0x2b, 0x6f, 0xcd, 0xcc, 0xbe, 0x8a, 0xd6, 0xcc, 0x8f,
+	0x15, 0x58, 0x15, 0x4d, 0x0e, 0xcb, 0x3e, 0xd6, 0x83, 0xd6, 0xa4, 0x2e, 0xb6, 0x46, 0x83, 0xda,
+	0xbe, 0xa7, 0x5e, 0x46, 0xdb, 0xd7, 0xfa, 0x69, 0xaa, 0x88, 0x01, 0x15, 0x89, 0xbf, 0x70, 0xbd,
+	0xd3, 0xce, 0xad, 0x45, 0x52, 0xfb, 0xf1, 0x48, 0xc6, 0xac, 0x0c, 0xe7, 0x64, 0x77, 0x64, 0xd3,
+	0xa5, 0x86, 0x4f, 0x79, 0x0e, 0x18, 0xde, 0x69, 0xc0, 0xfc, 0x20, 0xd8, 0xe5, 0xfc, 0x20, 0x20,
+	0xb9, 0x15, 0x35, 0xf7, 0x4a, 0x5a, 0x51, 0x99, 0x97, 0x68, 0x45, 0xfd, 0x26, 0xa4, 0x4e, 0x6f,
+	0x78, 0x7a, 0x60, 0xd0, 0x02, 0x8a, 0x7a, 0x5b, 0x76, 0x73, 0xd4, 0x65, 0x66, 0xce, 0x16, 0x56,
+	0xf2, 0x42, 0xfb, 0xf4, 0x86, 0xb7, 0xd3, 0x63, 0x22, 0x44, 0x28, 0x0b, 0x4d, 0x4c, 0xba, 0xd0,
+	0xa6, 0x92, 0xc1, 0xdb, 0x45, 0xd8, 0x1d, 0xca, 0x15, 0xdf, 0x5d, 0x72, 0x05, 0x1a, 0x6f, 0xa0,
+	0x2d, 0x8e, 0xdc, 0x40, 0xfb, 0xa4, 0xab, 0x81, 0x76, 0x05, 0xe3, 0xc3, 0x2b, 0x6a, 0x96, 0xa9,
+	0x6f, 0xa0, 0x59, 0xa6, 0xc3, 0x1c, 0xf3, 0x67, 0xd4, 0x09, 0x52, 0x97, 0x47, 0x69, 0x04, 0xe1,
+	0x0c, 0x4f, 0x6f, 0x78, 0x21, 0x22, 0xcf, 0x50, 0xc6, 0x7f, 0xd9, 0xbf, 0x79, 0x89, 0xfe, 0xcd,
+	0x52, 0xe6, 0xca, 0xee, 0xc4, 0xcc, 0x4a, 0x26, 0xa7, 0xfd, 0x59, 0x02, 0x96, 0x76, 0xd9, 0xd5,
+	0x40, 0x84, 0x61, 0xeb, 0x4b, 0x1a, 0x14, 0x81, 0x52, 0xad, 0xaa, 0x8c, 0x50, 0xab, 0xbe, 0xf6,
+	0xba, 0xe5, 0x63, 0x98, 0xb5, 0xe9, 0x73, 0xbd, 0x2b, 0xaf, 0x60, 0x89, 0x60, 0xd3, 0xe7, 0x7b,
+	0xbd, 0xa9, 0x25, 0x25, 0xc1, 0xb1, 0xe2, 0x78, 0x72, 0xa4, 0xe2, 0xf8, 0x6f, 0x12, 0x70, 0xa5,
+	0xc7, 0x35, 0x5e, 0xd3, 0xb1, 0x3d, 0x4a, 0xfe, 0x5c, 0x01, 0xd5, 0x8d, 0x08, 0xb8, 0x41, 0x58,
+	0x3a, 0x68, 0xd5, 0x7d, 0xee, 0xad, 0xd4, 0xe6, 0xcd, 0xa0, 0xea, 0xe8, 0x27, 0x20, 0x5f, 0xe9,
+	0x1a, 0x5c, 0xe1, 0x63, 0x79, 0x39, 0x82, 0x67, 0xcf, 0xed, 0xcf, 0x21, 0x9f, 0xbd, 0x01, 0x2c,
+	0x59, 0x17, 0xae, 0x0d, 0x93, 0xff, 0x5a, 0xae, 0xf2, 0x7f, 0xab, 0xc0, 0x65, 0xe9, 0x62, 0xca,
+	0xa7, 0x89, 0x4f, 0x6b, 0x17, 0xb9, 0x80, 0xbd, 0x0f, 0x93, 0xd8, 0x12, 0x92, 0x95, 0x22, 0x20,
+	0xb3, 0x22, 0x40, 0xbe, 0x0b, 0x69, 0xbe, 0xa0, 0xf1, 0xfb, 0x24, 0x2f, 0x19, 0x19, 0x61, 0xb7,
+	0x7b, 0xa7, 0xa6, 0x24, 0x58, 0xfb, 0x01, 0x5e, 0x7f, 0xe3, 0xe6, 0x92, 0x13, 0x20, 0xfc, 0xea,
+	0xcd, 0xbf, 0xc5, 0xdd, 0x5b, 0x11, 0xbd, 0xe6, 0xae, 0xbb, 0x77, 0x34, 0x45, 0xde, 0x28, 0xc3,
+	0x1b, 0x76, 0x04, 0xc6, 0x1a, 0x65, 0xdd, 0x34, 0xed, 0x7f, 0xd3, 0x30, 0x89, 0xd5, 0x53, 0xd8,
+	0x8c, 0x50, 0x86, 0x37, 0x23, 0x48, 0x09, 0xe6, 0x83, 0xad, 0xaf, 0x1f, 0x19, 0xa6, 0x2f, 0x9c,
+	0xa4, 0xf0, 0x7e, 0x65, 0x40, 0xba, 0x83, 0x14, 0xb9, 0x5f, 0x19, 0xa7, 0x90, 0x9b, 0x90, 0xc2,
+	0x22, 0x90, 0xd7, 0x84, 0xc2, 0x69, 0x98, 0xca, 0x18, 0xcc, 0x6b, 0x39, 0x39, 0x95, 0x45, 0x28,
+	0x3b, 0x80, 0x58, 0x3a, 0x06, 0x63, 0x27, 0x22, 0x87, 0x23, 0xde, 0x33, 0x38, 0x25, 0xc1, 0xe4,
+	0x18, 0xe6, 0xc3, 0x7a, 0xa9, 0x6e, 0x35, 0x2c, 0x3f, 0x78, 0x70, 0x5c, 0x41, 0xc7, 0xa2, 0x33,
+	0xc2, 0x02, 0xe9, 0x3e, 0x32, 0xf0, 0xd3, 0xc0, 0x9c, 0xab, 0xba, 0x31, 0x42, 0xac, 0xde, 0x9b,
+	0x8b, 0xd3, 0xc8, 0xdf, 0x2b, 0xf0, 0x5e, 0x97, 0x26, 0xbd, 0x7a, 0x16, 0xc6, 0x0d, 0xdd, 0xac,
+	0x1b, 0x9e, 0xc7, 0x1b, 0x6a, 0xd3, 0xd2, 0xf3, 0x63, 0x3f, 0x03, 0x0a, 0x67, 0x41, 0xfc, 0x28,
+	0xb2, 0x41, 0x65, 0xa3, 0x41, 0xb9, 0x4d, 0x1b, 0x9d, 0x76, 0xee, 0x5b, 0xee, 0x79, 0xbc, 0x92,
+	0x2b, 0xde, 0x3e, 0x97, 0x99, 0xec, 0x43, 0xaa, 0x49, 0xdd, 0x86, 0xe5, 0x79, 0x78, 0x39, 0xe2,
+	0x4f, 0xa3, 0x4b, 0x92, 0x6d, 0x7b, 0x11, 0x95, 0x7b, 0x5d, 0x62, 0x97, 0xbd, 0x2e, 0xc1, 0xac,
+	0x10, 0x37, 0x1d, 0xb7, 0xe6, 0xd8, 0x94, 0xbf, 0x35, 0xcf, 0x88, 0x1b, 0xa8, 0xc0, 0x62, 0x37,
+	0x50, 0x81, 0x91, 0x07, 0xb0, 0xc0, 0xef, 0x4f, 0x7a, 0x8d, 0x36, 0x5d, 0x6a, 0x62, 0x31, 0x99,
+	0xc4, 0xc5, 0x5e, 0x65, 0x1b, 0x9d, 0x13, 0xb7, 0x43, 0x5a, 0x6c, 0x35, 0x32, 0xdd, 0x54, 0xb2,
+	0x1d, 0x5e, 0x1c, 0xa1, 0x67, 0x4a, 0xa3, 0x5f, 0x1d, 0x6b, 0xb0, 0x58, 0xa3, 0x47, 0x46, 0xab,
+	0xee, 0xeb, 0xb1, 0xd7, 0xaa, 0xd4, 0x80, 0xd7, 0x2a, 0x66, 0xe9, 0x35, 0x31, 0xa2, 0xd2, 0xf7,
+	0xd1, 0x8a, 0xf4, 0x52, 0xc9, 0x75, 0x98, 0x6a, 0x1a, 0x2e, 0xb5, 0x7d, 0x75, 0x36, 0x6a, 0x2b,
+	0x70, 0x44, 0xb6, 0x89, 0x23, 0xe4, 0xfb, 0x90, 0x7e, 0xda, 0x72, 0x7c, 0x03, 0xb7, 0x97, 0xe3,
+	0xd4, 0xc5, 0x33, 0xe7, 0x55, 0x69, 0x82, 0x8f, 0x18, 0xbd, 0x70, 0xb6, 0xe7, 0x38, 0x75, 0xe9,
+	0x4a, 0xfb, 0x34, 0x42, 0xe5, 0x85, 0x93, 0xe0, 0xec, 0xff, 0x28, 0x90, 0x92, 0x16, 0x9c, 0x54,
+	0x60, 0xc6, 0x6b, 0x55, 0x9f, 0x50, 0x33, 0x4c, 0x30, 0x2b, 0xfd, 0xb7, 0x46, 0x7e, 0x9f, 0xb3,
+	0x89, 0x8a, 0x5a, 0x8c, 0x89, 0x55, 0xd4, 0x02, 0xc3, 0x10, 0x4f, 0xdd, 0x2a, 0x6f, 0x99, 0x06,
+	0x21, 0x9e, 0x01, 0xb1, 0x10, 0xcf, 0x80, 0xec, 0x21, 0x4c, 0x0b, 0xb9, 0x2c, 0x60, 0x9d, 0x5a,
+	0x76, 0x4d, 0x0e, 0x58, 0xec, 0x5b, 0x0e, 0x58, 0xec, 0x3b, 0x0c, 0x6c, 0x89, 0xe1, 0x81, 0x2d,
+	0x6b, 0xc1, 0xa5, 0x3e, 0xc7, 0xfe, 0x05, 0x92, 0x94, 0x72, 0x6e, 0xa1, 0xf5, 0x97, 0x0a, 0xbc,
+	0x37, 0xda, 0x09, 0x1f, 0x4d, 0xfd, 0x3d, 0x59, 0x7d, 0xd0, 0x68, 0x88, 0x09, 0xec, 0xd2, 0x76,
+	0x9e, 0x81, 0x6f, 0xa0, 0xa8, 0x7d, 0x0e, 0x99, 0xee, 0x4d, 0x39, 0x9a, 0x9e, 0x5b, 0xf1, 0xc9,
+	0xce, 0x47, 0xdb, 0x8f, 0xcb, 0x3b, 0xaf, 0x42, 0xf8, 0xe7, 0x04, 0x40, 0xc4, 0x4e, 0x0e, 0x01,
+	0x8e, 0x5b, 0x86, 0x6b, 0xd8, 0x3e, 0xa5, 0x35, 0xb1, 0xa5, 0x73, 0x5d, 0x32, 0xf3, 0x77, 0x43,
+	0x0e, 0x7e, 0x7a, 0x30, 0x51, 0x45, 0xc3, 0xe4, 0x44, 0x15, 0xa1, 0xe4, 0x1e, 0x2c, 0x54, 0x1d,
+	0xd7, 0x75, 0x9e, 0xb3, 0x4b, 0x7a, 0xf0, 0xea, 0x92, 0xc0, 0xe8, 0x87, 0x99, 0x3a, 0x24, 0xf6,
+	0xbe, 0xbd, 0x64, 0xba, 0x69, 0xd9, 0x3f, 0x55, 0x60, 0xbe, 0xcb, 0x8c, 0xd1, 0xfc, 0x75, 0x18,
+	0xf7, 0x57, 0x5e, 0xba, 0xf8, 0x84, 0xbf, 0x72, 0xca, 0x37, 0x4f, 0x8f, 0x71, 0xce, 0x41, 0x7a,
+	0xc8, 0x3f, 0x6a, 0x19, 0xb6, 0x6f, 0xf9, 0x67, 0xe7, 0xba, 0xf3, 0x8f, 0x27, 0xe1, 0xea, 0x90,
+	0xad, 0x46, 0x7e, 0xac, 0xc0, 0x72, 0xc3, 0xf8, 0xc2, 0x6a, 0xb4, 0x1a, 0x51, 0xa3, 0xe2, 0xc8,
+	0x0d, 0xdf, 0x18, 0x99, 0xbf, 0xbf, 0x7b, 0xde, 0x86, 0xcd, 0x3f, 0xe0, 0x12, 0x02, 0xf4, 0x8e,
+	0x18, 0x2f, 0xd5, 0xa9, 0x8d, 0xfe, 0x1c, 0x72, 0x9d, 0x3a, 0x80, 0x85, 0xfc, 0x83, 0x02, 0x6f,
+	0x0f, 0x34, 0x31, 0x0c, 0xaa, 0x09, 0x34, 0xb5, 0xf8, 0xa2, 0xa6, 0xca, 0xc1, 0xf7, 0x5b, 0x9d,
+	0x76, 0xee, 0x9b, 0x8d, 0x61, 0x7c, 0x92, 0xd9, 0x6f, 0x0d, 0x65, 0x64, 0x45, 0xf6, 0x30, 0xe7,
+	0xbc, 0xae, 0xf8, 0xa5, 0x9d, 0x3f, 0xcd, 0xd1, 0x54, 0x3f, 0x8c, 0x6f, 0xcf, 0x77, 0x7b, 0xfd,
+	0xcb, 0x04, 0x5e, 0x2c, 0x7e, 0x69, 0xff, 0x98, 0x80, 0xdc, 0x39, 0x32, 0xc8, 0x5f, 0x8f, 0xb0,
+	0x31, 0xb7, 0x46, 0xb1, 0xe6, 0xb5, 0x6e, 0xce, 0x5f, 0xc4, 0xfa, 0x6a, 0x25, 0x48, 0x62, 0xf0,
+	0xbb, 0x6f, 0x79, 0x3e, 0xb9, 0x01, 0x53, 0x78, 0xf1, 0x0d, 0xf2, 0x3d, 0x44, 0xc1, 0x91, 0xd7,
+	0x25, 0x9c, 0x2a, 0xd7, 0x25, 0x1c, 0xd1, 0x0e, 0x80, 0xf0, 0xf7, 0x9c, 0xba, 0x74, 0xf7, 0x23,
+	0xb7, 0x21, 0x6d, 0x72, 0x94, 0xd6, 0xa4, 0x5b, 0x3d, 0x76, 0x5c, 0x42, 0x42, 0xfc, 0xc6, 0x34,
+	0x2b, 0xe3, 0xda, 0x4d, 0x98, 0x47, 0xed, 0x77, 0x69, 0xf8, 0x5e, 0x38, 0xe2, 0xe5, 0x45, 0xfb,
+	0x18, 0x08, 0x0e, 0x2d, 0x62, 0x8d, 0x79, 0xd1, 0xd1, 0x9f, 0xc0, 0x22, 0x8e, 0x3e, 0xb0, 0xcd,
+	0x17, 0x1a, 0x7f, 0x1b, 0xd4, 0x7d, 0xdf, 0xa5, 0x46, 0xc3, 0xb2, 0x8f, 0xbb, 0x67, 0xf0, 0x0e,
+	0x8c, 0xdb, 0xad, 0x86, 0xf8, 0xed, 0x08, 0x2e, 0xa3, 0xdd, 0x6a, 0xc8, 0xcb, 0x68, 0xb7, 0x1a,
+	0xa1, 0xf9, 0xdb, 0xb4, 0x4e, 0x7d, 0x7a, 0x51, 0xf5, 0x3f, 0x51, 0x00, 0xf8, 0xf3, 0xd3, 0x8e,
+	0x7d, 0xe4, 0x8c, 0x7c, 0xe1, 0xbb, 0x09, 0x29, 0x5c, 0xcf, 0x1a, 0xbb, 0xe1, 0xf2, 0x1f, 0xa7,
+	0x4c, 0xf2, 0x04, 0xc8, 0xe1, 0x5d, 0x27, 0x56, 0xa8, 0x41, 0x84, 0xb2, 0xa1, 0x75, 0x6a, 0x78,
+	0xc1, 0xd0, 0xf1, 0x68, 0x28, 0x87, 0xbb, 0x87, 0x46, 0xa8, 0xf6, 0x1c, 0x2e, 0x71, 0x5f, 0x37,
+	0x6b, 0x86, 0x1f, 0x35, 0x3c, 0xbe, 0x23, 0x3f, 0x0c, 0xc7, 0xf7, 0xe2, 0xb0, 0x9e, 0xcd, 0xe8,
+	0xf7, 0x79, 0xad, 0x05, 0x6a, 0xc1, 0xf0, 0xcd, 0x93, 0x7e, 0xda, 0x0f, 0x21, 0x7d, 0x64, 0x58,
+	0xf5, 0xe0, 0x41, 0x23, 0x38, 0x11, 0x6a, 0x64, 0x45, 0x7c, 0x00, 0xdf, 0xd4, 0x7c, 0xc8, 0xa3,
+	0xee, 0x53, 0x32, 0x2b, 0xe3, 0xe1, 0x7c, 0x8b, 0xd8, 0xf2, 0xfe, 0x45, 0xcd, 0xb7, 0x4b, 0xfb,
+	0xf9, 0xf3, 0x8d, 0x0f, 0xb8, 0xc0, 0x7c, 0x53, 0x90, 0x2c, 0xd9, 0xb5, 0x07, 0x86, 0x7b, 0x4a,
+	0x5d, 0xed, 0x47, 0x0a, 0x5c, 0x8e, 0x9f, 0x8c, 0x07, 0xd4, 0xf3, 0x8c, 0x63, 0x4a, 0x7e, 0xed,
+	0x62, 0xf3, 0xff, 0x74, 0x2c, 0x7a, 0x5d, 0x1c, 0xa7, 0x76, 0x4d, 0x24, 0x15, 0xfe, 0x6b, 0xa8,
+	0x50, 0x1f, 0x3f, 0x5f, 0x54, 0xbe, 0x2b, 0x7c, 0x3a, 0x56, 0x61, 0xfc, 0x85, 0x69, 0x98, 0xa4,
+	0xcf, 0xa8, 0xed, 0x6b, 0x7f, 0xa0, 0x88, 0x05, 0xe9, 0xfa, 0x65, 0xc2, 0xa8, 0xa7, 0xe6, 0x6e,
+	0xd4, 0x26, 0xc1, 0xb4, 0x41, 0x83, 0xdb, 0x0d, 0xfe, 0x40, 0xa2, 0x8b, 0x24, 0xff, 0x40, 0xa2,
+	0x8b, 0xa4, 0xfd, 0x93, 0x12, 0xc4, 0xac, 0xd8, 0xd3, 0xf8, 0x9b, 0xb6, 0x83, 0x6c, 0x43, 0xf2,
+	0x89, 0x78, 0x98, 0xe6, 0xed, 0x9a, 0x9e, 0xe7, 0x6a, 0x7c, 0x4f, 0x08, 0x79, 0xe4, 0xf7, 0x84,
+	0x10, 0x5c, 0xff, 0x23, 0x05, 0xae, 0x0c, 0x68, 0xf5, 0x93, 0x77, 0x61, 0x75, 0xbb, 0xb4, 0x57,
+	0x2a, 0x6f, 0x97, 0xca, 0xc5, 0x43, 0xfd, 0xce, 0xd6, 0xce, 0xfd, 0x83, 0x4a, 0x49, 0xdf, 0x7b,
+	0x78, 0x7f, 0xa7, 0x78, 0xa8, 0x17, 0xb7, 0xca, 0xc5, 0xd2, 0xfd, 0xcc, 0x18, 0xd1, 0x60, 0x65,
+	0x30, 0x17, 0xfb, 0xcc, 0x28, 0x64, 0x0d, 0xde, 0x1d, 0xcc, 0x53, 0x39, 0x28, 0xeb, 0x5b, 0xe5,
+	0xc3, 0xcf, 0xb6, 0x0e, 0x33, 0x89, 0xf5, 0x2d, 0xf1, 0x53, 0x3e, 0xfe, 0xd3, 0x36, 0xb2, 0x04,
+	0xa4, 0x52, 0x7a, 0x5c, 0x39, 0xd4, 0xb7, 0x8a, 0x8f, 0x77, 0x1e, 0x96, 0x75, 0xfc, 0xc8, 0x8c,
+	0x91, 0x2c, 0x2c, 0xc5, 0x70, 0x26, 0x52, 0xbf, 0xb3, 0xb5, 0xff, 0x38, 0xa3, 0xac, 0x67, 0x21,
+	0x25, 0xfd, 0x1a, 0x8c, 0xa4, 0x60, 0x5a, 0x7c, 0x66, 0xc6, 0xd6, 0xdf, 0x87, 0x94, 0xf4, 0xab,
+	0x21, 0x32, 0x0b, 0x33, 0x65, 0xa7, 0x46, 0xf7, 0x1c, 0xd7, 0xcf, 0x8c, 0xb1, 0xaf, 0x4f, 0xa9,
+	0x51, 0xab, 0x33, 0x56, 0x65, 0xfd, 0xaf, 0x14, 0x98, 0x09, 0x5c, 0x49, 0x00, 0xa6, 0x1e, 0x1d,
+	0x94, 0x0e, 0x4a, 0xdb, 0x99, 0x31, 0x26, 0x90, 0x4d, 0x65, 0xa7, 0x7c, 0x37, 0xa3, 0xb0, 0x8f,
+	0xca, 0x41, 0xb9, 0xcc, 0x3e, 0x12, 0x24, 0x0d, 0xc9, 0xfd, 0x83, 0x62, 0xb1, 0x54, 0xda, 0x2e,
+	0x6d, 0x67, 0xc6, 0xd9, 0x20, 0x66, 0x57, 0x69, 0x3b, 0x33, 0xc1, 0xf8, 0x0e, 0xca, 0xf7, 0xca,
+	0x0f, 0x3f, 0x2b, 0x67, 0x26, 0x39, 0x5f, 0xe1, 0xc1, 0xce, 0xe3, 0xc7, 0xa5, 0xed, 0xcc, 0x14,
+	0xe3, 0xbb, 0x5f, 0xda, 0xda, 0x2f, 0x6d, 0x67, 0xa6, 0x19, 0x69, 0xaf, 0x52, 0x2a, 0x3d, 0xd8,
+	0x63, 0xa4, 0x19, 0xf6, 0xc9, 0x1d, 0xcd, 0xa4, 0x24, 0x99, 0x85, 0x95, 0xd2, 0x6e, 0xa9, 0xc8,
+	0x88, 0xb0, 0xf9, 0xb3, 0x49, 0x98, 0xc5, 0x9d, 0x18, 0xbc, 0x29, 0x7d, 0x04, 0x29, 0x7e, 0xfe,
+	0x79, 0x07, 0x51, 0x3a, 0x9c, 0xd9, 0xa5, 0x9e, 0xd7, 0xbe, 0x12, 0xdb, 0x0a, 0xda, 0x18, 0xb9,
+	0x0d, 0xb3, 0xd2, 0x20, 0x8f, 0xcc, 0x45, 0xa3, 0x58, 0xb9, 0x91, 0x7d, 0x0b, 0xbf, 0x07, 0x85,
+	0x24, 0x6d, 0x8c, 0x69, 0xe5, 0x51, 0xf6, 0x82, 0x5a, 0xa5, 0x41, 0xe7, 0x6b, 0x8d, 0xc7, 0x71,
+	0x6d, 0x8c, 0xfc, 0x06, 0xa4, 0x78, 0xd6, 0xe5, 0x5a, 0xaf, 0x44, 0xe3, 0x63, 0xc9, 0x78, 0x88,
+	0x09, 0x79, 0x98, 0xb9, 0x4b, 0x7d, 0x3e, 0x7c, 0x31, 0x1a, 0x1e, 0xd5, 0x00, 0x59, 0x69, 0x2a,
+	0xda, 0x18, 0xd9, 0x85, 0x64, 0xc0, 0xef, 0x11, 0x6e, 0xdf, 0xa0, 0xea, 0x21, 0x9b, 0xed, 0x43,
+	0x16, 0x21, 0x54, 0x1b, 0xfb, 0x40, 0x61, 0xd6, 0xf3, 0x92, 0xa7, 0xc7, 0xfa, 0x58, 0x25, 0x34,
+	0xc4, 0xfa, 0x6d, 0x48, 0x07, 0x65, 0x0f, 0x97, 0xb1, 0x2c, 0x25, 0xbd, 0x78, 0x3d, 0x34, 0x54,
+	0xca, 0x9c, 0x88, 0xa7, 0x0f, 0x85, 0x18, 0x29, 0x97, 0xc4, 0x23, 0xed, 0x10, 0x29, 0x05, 0x48,
+	0xf3, 0x60, 0xf8, 0xb0, 0xcf, 0x7c, 0xe4, 0x28, 0x39, 0x58, 0xc6, 0xe6, 0x0f, 0x93, 0x30, 0xc5,
+	0x3b, 0xe8, 0xe4, 0x7b, 0x00, 0xfc, 0x2f, 0xac, 0x59, 0x2e, 0xf7, 0xfd, 0x6d, 0x5b, 0x76, 0xa9,
+	0x7f, 0xdb, 0x5d, 0x5b, 0xfe, 0xbd, 0x7f, 0xfb, 0xaf, 0x3f, 0x49, 0x5c, 0xd2, 0xe6, 0x36, 0x9e,
+	0x7d, 0xb8, 0xf1, 0xc4, 0xa9, 0x8a, 0x7f, 0x71, 0xba, 0xa5, 0xac, 0x93, 0xcf, 0x00, 0xb8, 0x35,
+	0x71, 0xb9, 0x71, 0x0b, 0xb9, 0xe9, 0xbd, 0x65, 0x72, 0xaf, 0x60, 0x5e, 0x03, 0x33, 0xc1, 0xbf,
+	0x05, 0xb3, 0xa1, 0xe0, 0x7d, 0xea, 0x0b, 0x1f, 0xf6, 0xf9, 0x01, 0xd5, 0xc0, 0xf9, 0x5f, 0x43,
+	0xe1, 0x4b, 0xda, 0x82, 0x10, 0xee, 0x51, 0x5f, 0x92, 0x6f, 0x43, 0x46, 0x7e, 0x2c, 0x42, 0xf3,
+	0xaf, 0xf6, 0x7f, 0x46, 0xe2, 0x6a, 0xae, 0x0d, 0x7b, 0x63, 0xd2, 0x72, 0xa8, 0x6c, 0x59, 0x5b,
+	0x0c, 0x66, 0x22, 0xbd, 0x17, 0x51, 0xa6, 0xef, 0x10, 0x52, 0x62, 0xed, 0x51, 0x55, 0xe8, 0xea,
+	0x11, 0x37, 0x44, 0x16, 0xe5, 0x2f, 0x6a, 0xf3, 0x81, 0xfc, 0x26, 0x1f, 0xc7, 0x44, 0xdf, 0xbd,
+	0x78, 0x88, 0x5a, 0x44, 0x71, 0x73, 0x5a, 0x92, 0x89, 0xc3, 0x62, 0x82, 0x09, 0x32, 0x5f, 0x2e,
+	0x6c, 0xbd, 0x8b, 0x42, 0x57, 0xb4, 0x65, 0x26, 0xb4, 0xca, 0xb8, 0x68, 0x6d, 0x83, 0xff, 0xbc,
+	0x41, 0xd4, 0x56, 0x4c, 0x49, 0xf9, 0xe2, 0xa1, 0xed, 0x2a, 0x0a, 0xbe, 0x9c, 0xcd, 0x84, 0xd6,
+	0x6e, 0xfc, 0x0e, 0x4b, 0xfc, 0x3f, 0x10, 0x46, 0xbf, 0x4c, 0xd4, 0x13, 0x46, 0x67, 0x63, 0x46,
+	0xb7, 0x90, 0x47, 0x32, 0xfa, 0xf3, 0x97, 0x8c, 0x8c, 0x2a, 0x6a, 0x21, 0xeb, 0x3d, 0x33, 0x20,
+	0x77, 0x2e, 0x14, 0x31, 0x85, 0x1c, 0xd2, 0x2b, 0xa7, 0xf6, 0x8a, 0x22, 0xa9, 0xd8, 0x68, 0x84,
+	0xc8, 0xfe, 0xe0, 0x8e, 0xf8, 0x40, 0x21, 0xb7, 0x60, 0xea, 0x53, 0xfc, 0x1f, 0x3f, 0x32, 0x60,
+	0xa6, 0x59, 0x7e, 0x4e, 0x39, 0x53, 0xf1, 0x84, 0x9a, 0xa7, 0x61, 0xdd, 0xfc, 0xf9, 0x4f, 0xbf,
+	0x5a, 0x51, 0x7e, 0xfe, 0xd5, 0x8a, 0xf2, 0x9f, 0x5f, 0xad, 0x28, 0x3f, 0xfa, 0x7a, 0x65, 0xec,
+	0xe7, 0x5f, 0xaf, 0x8c, 0xfd, 0xfb, 0xd7, 0x2b, 0x63, 0xdf, 0xff, 0xe6, 0xb1, 0xe5, 0x9f, 0xb4,
+	0xaa, 0x79, 0xd3, 0x69, 0x6c, 0x18, 0x6e, 0xc3, 0xa8, 0x19, 0x4d, 0xd7, 0x79, 0x42, 0x4d, 0x5f,
+	0x7c, 0x05, 0xff, 0x9f, 0xf8, 0x93, 0xc4, 0xe2, 0x16, 0x02, 0x7b, 0x9c, 0x9c, 0xdf, 0x71, 0xf2,
+	0x5b, 0x4d, 0xab, 0x3a, 0x85, 0x36, 0x7c, 0xf4, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xac, 0x60,
+	0x72, 0x02, 0x83, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3568,6 +4098,97 @@ func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ConfigMaps) > 0 {
+		for iNdEx := len(m.ConfigMaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigMaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.QueueTtlSeconds != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueueTtlSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ArraySize != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.ArraySize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DependencyFailurePolicy != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.DependencyFailurePolicy))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Scheduler) > 0 {
 		i -= len(m.Scheduler)
 		copy(dAtA[i:], m.Scheduler)
@@ -3709,6 +4330,155 @@ func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BackoffSeconds != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.BackoffSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorKinds) > 0 {
+		for iNdEx := len(m.ErrorKinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ErrorKinds[iNdEx])
+			copy(dAtA[i:], m.ErrorKinds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ErrorKinds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContainerReasons) > 0 {
+		for iNdEx := len(m.ContainerReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContainerReasons[iNdEx])
+			copy(dAtA[i:], m.ContainerReasons[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ContainerReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExitCodes) > 0 {
+		dAtA5 := make([]byte, len(m.ExitCodes)*10)
+		var j4 int
+		for _, num1 := range m.ExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintSubmit(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3776,20 +4546,20 @@ func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Ports) > 0 {
-		dAtA3 := make([]byte, len(m.Ports)*10)
-		var j2 int
+		dAtA7 := make([]byte, len(m.Ports)*10)
+		var j6 int
 		for _, num := range m.Ports {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j6++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintSubmit(dAtA, i, uint64(j2))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintSubmit(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3829,20 +4599,20 @@ func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.Ports) > 0 {
-		dAtA5 := make([]byte, len(m.Ports)*10)
-		var j4 int
+		dAtA9 := make([]byte, len(m.Ports)*10)
+		var j8 int
 		for _, num := range m.Ports {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j8++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintSubmit(dAtA, i, uint64(j4))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSubmit(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3978,7 +4748,14 @@ func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
+	if len(m.ArrayId) > 0 {
+		i -= len(m.ArrayId)
+		copy(dAtA[i:], m.ArrayId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ArrayId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
 		i -= len(m.Reason)
 		copy(dAtA[i:], m.Reason)
 		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
@@ -4095,20 +4872,20 @@ func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.States) > 0 {
-		dAtA8 := make([]byte, len(m.States)*10)
-		var j7 int
+		dAtA12 := make([]byte, len(m.States)*10)
+		var j11 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j11++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSubmit(dAtA, i, uint64(j7))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintSubmit(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4135,6 +4912,40 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.K8SConfigMap) > 0 {
+		for iNdEx := len(m.K8SConfigMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.K8SConfigMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.DependencyFailurePolicy != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.DependencyFailurePolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.SchedulingResourceRequirements != nil {
 		{
 			size, err := m.SchedulingResourceRequirements.MarshalToSizedBuffer(dAtA[:i])
@@ -4404,6 +5215,13 @@ func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArrayId) > 0 {
+		i -= len(m.ArrayId)
+		copy(dAtA[i:], m.ArrayId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ArrayId)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.NewPriority != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
@@ -4498,6 +5316,15 @@ func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArrayJobIds) > 0 {
+		for iNdEx := len(m.ArrayJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArrayJobIds[iNdEx])
+			copy(dAtA[i:], m.ArrayJobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.ArrayJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -4572,6 +5399,51 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuotaByPool) > 0 {
+		for k := range m.QuotaByPool {
+			v := m.QuotaByPool[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSubmit(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DefaultRetryPolicy != nil {
+		{
+			size, err := m.DefaultRetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
 			v := m.Labels[k]
@@ -4785,6 +5657,65 @@ func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueueQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BorrowingEnabled {
+		i--
+		if m.BorrowingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guaranteed) > 0 {
+		for k := range m.Guaranteed {
+			v := m.Guaranteed[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSubmit(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PriorityClassResourceLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5465,20 +6396,20 @@ func (m *QueueCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.JobStates) > 0 {
-		dAtA19 := make([]byte, len(m.JobStates)*10)
-		var j18 int
+		dAtA26 := make([]byte, len(m.JobStates)*10)
+		var j25 int
 		for _, num := range m.JobStates {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j25++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA26[j25] = uint8(num)
+			j25++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintSubmit(dAtA, i, uint64(j18))
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintSubmit(dAtA, i, uint64(j25))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5579,6 +6510,110 @@ func (m *JobSubmitRequestItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSubmit(uint64(l))
 	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.DependencyFailurePolicy != 0 {
+		n += 1 + sovSubmit(uint64(m.DependencyFailurePolicy))
+	}
+	if m.ArraySize != 0 {
+		n += 1 + sovSubmit(uint64(m.ArraySize))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if m.QueueTtlSeconds != 0 {
+		n += 2 + sovSubmit(uint64(m.QueueTtlSeconds))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ConfigMaps) > 0 {
+		for _, e := range m.ConfigMaps {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovSubmit(uint64(m.MaxAttempts))
+	}
+	if m.BackoffSeconds != 0 {
+		n += 1 + sovSubmit(uint64(m.BackoffSeconds))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovSubmit(uint64(m.Action))
+	}
+	if len(m.ExitCodes) > 0 {
+		l = 0
+		for _, e := range m.ExitCodes {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	if len(m.ContainerReasons) > 0 {
+		for _, s := range m.ContainerReasons {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ErrorKinds) > 0 {
+		for _, s := range m.ErrorKinds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5720,6 +6755,10 @@ func (m *JobCancelRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSubmit(uint64(l))
 	}
+	l = len(m.ArrayId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
 	return n
 }
 
@@ -5877,6 +6916,21 @@ func (m *Job) Size() (n int) {
 		l = m.SchedulingResourceRequirements.Size()
 		n += 2 + l + sovSubmit(uint64(l))
 	}
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	if m.DependencyFailurePolicy != 0 {
+		n += 2 + sovSubmit(uint64(m.DependencyFailurePolicy))
+	}
+	if len(m.K8SConfigMap) > 0 {
+		for _, e := range m.K8SConfigMap {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5903,6 +6957,10 @@ func (m *JobReprioritizeRequest) Size() (n int) {
 	if m.NewPriority != 0 {
 		n += 9
 	}
+	l = len(m.ArrayId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
 	return n
 }
 
@@ -5937,6 +6995,12 @@ func (m *JobSubmitResponseItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSubmit(uint64(l))
 	}
+	if len(m.ArrayJobIds) > 0 {
+		for _, s := range m.ArrayJobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6024,6 +7088,27 @@ func (m *Queue) Size() (n int) {
 			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
 		}
 	}
+	if m.DefaultRetryPolicy != nil {
+		l = m.DefaultRetryPolicy.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.QuotaByPool) > 0 {
+		for k, v := range m.QuotaByPool {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSubmit(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -6065,6 +7150,31 @@ func (m *Queue_Permissions_Subject) Size() (n int) {
 	return n
 }
 
+func (m *QueueQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guaranteed) > 0 {
+		for k, v := range m.Guaranteed {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSubmit(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.BorrowingEnabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *PriorityClassResourceLimits) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7040,17 +8150,693 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Scheduler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, &JobDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyFailurePolicy", wireType)
+			}
+			m.DependencyFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependencyFailurePolicy |= DependencyFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArraySize", wireType)
+			}
+			m.ArraySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArraySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			}
+			m.QueueTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueTtlSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMaps = append(m.ConfigMaps, &v1.ConfigMap{})
+			if err := m.ConfigMaps[len(m.ConfigMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &v1.Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			m.BackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &RetryRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RetryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerReasons = append(m.ContainerReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorKinds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorKinds = append(m.ErrorKinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -8097,6 +9883,38 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -9370,11 +11188,98 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingResourceRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingResourceRequirements == nil {
+				m.SchedulingResourceRequirements = &v1.ResourceRequirements{}
+			}
+			if err := m.SchedulingResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyFailurePolicy", wireType)
+			}
+			m.DependencyFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependencyFailurePolicy |= DependencyFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingResourceRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9401,10 +11306,8 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingResourceRequirements == nil {
-				m.SchedulingResourceRequirements = &v1.ResourceRequirements{}
-			}
-			if err := m.SchedulingResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.K8SConfigMap = append(m.K8SConfigMap, &v1.ConfigMap{})
+			if err := m.K8SConfigMap[len(m.K8SConfigMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9565,6 +11468,38 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.NewPriority = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -9856,6 +11791,38 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayJobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrayJobIds = append(m.ArrayJobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -10341,14 +12308,193 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					if postmsgIndex < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					if postmsgIndex > l {
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriorityClassResourceLimits{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceLimitsByPriorityClassName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsDeprecated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelsDeprecated = append(m.LabelsDeprecated, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &PriorityClassResourceLimits{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -10364,13 +12510,13 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourceLimitsByPriorityClassName[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRetryPolicy", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -10380,15 +12526,31 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Cordoned = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultRetryPolicy == nil {
+				m.DefaultRetryPolicy = &RetryPolicy{}
+			}
+			if err := m.DefaultRetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelsDeprecated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10416,11 +12578,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelsDeprecated = append(m.LabelsDeprecated, string(dAtA[iNdEx:postIndex]))
+			m.Parent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaByPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10447,11 +12609,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.QuotaByPool == nil {
+				m.QuotaByPool = make(map[string]*QueueQuota)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *QueueQuota
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -10500,7 +12662,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -10510,24 +12672,26 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthSubmit
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &QueueQuota{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -10543,7 +12707,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.QuotaByPool[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10796,6 +12960,205 @@ func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueueQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guaranteed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Guaranteed == nil {
+				m.Guaranteed = make(map[string]*resource.Quantity)
+			}
+			var mapkey string
+			var mapvalue *resource.Quantity
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Guaranteed[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
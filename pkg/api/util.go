@@ -165,6 +165,8 @@ func JobIdFromApiEvent(msg *EventMessage) string {
 		return e.Reprioritizing.JobId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobId
+	case *EventMessage_PreemptionNotice:
+		return e.PreemptionNotice.JobId
 	}
 	return ""
 }
@@ -203,6 +205,8 @@ func JobSetIdFromApiEvent(msg *EventMessage) string {
 		return e.Reprioritizing.JobSetId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobSetId
+	case *EventMessage_PreemptionNotice:
+		return e.PreemptionNotice.JobSetId
 	}
 	return ""
 }
@@ -29,6 +29,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Determines what happens to a job when one of the jobs it depends on fails or is cancelled.
+type DependencyFailurePolicy int32
+
+const (
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL     DependencyFailurePolicy = 0
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_FAIL       DependencyFailurePolicy = 1
+	DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY DependencyFailurePolicy = 2
+)
+
+var DependencyFailurePolicy_name = map[int32]string{
+	0: "DEPENDENCY_FAILURE_POLICY_CANCEL",
+	1: "DEPENDENCY_FAILURE_POLICY_FAIL",
+	2: "DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY",
+}
+
+var DependencyFailurePolicy_value = map[string]int32{
+	"DEPENDENCY_FAILURE_POLICY_CANCEL":     0,
+	"DEPENDENCY_FAILURE_POLICY_FAIL":       1,
+	"DEPENDENCY_FAILURE_POLICY_RUN_ANYWAY": 2,
+}
+
+func (x DependencyFailurePolicy) String() string {
+	return proto.EnumName(DependencyFailurePolicy_name, int32(x))
+}
+
+func (DependencyFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{0}
+}
+
 type JobState int32
 
 const (
@@ -54,7 +83,7 @@ func (x JobState) String() string {
 }
 
 func (JobState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{0}
+	return fileDescriptor_6aab92ca59e015f8, []int{1}
 }
 
 // Reason reported by Kubernetes.
@@ -86,7 +115,7 @@ func (x KubernetesReason) String() string {
 }
 
 func (KubernetesReason) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{1}
+	return fileDescriptor_6aab92ca59e015f8, []int{2}
 }
 
 // Message representing a sequence of state transitions.
@@ -201,6 +230,10 @@ type EventSequence_Event struct {
 	//	*EventSequence_Event_JobPreemptionRequested
 	//	*EventSequence_Event_JobRunCancelled
 	//	*EventSequence_Event_JobValidated
+	//	*EventSequence_Event_CancelJobArray
+	//	*EventSequence_Event_ReprioritiseJobArray
+	//	*EventSequence_Event_JobDeferralEnded
+	//	*EventSequence_Event_JobRunPreemptionNotice
 	Event isEventSequence_Event_Event `protobuf_oneof:"event"`
 }
 
@@ -312,6 +345,18 @@ type EventSequence_Event_JobRunCancelled struct {
 type EventSequence_Event_JobValidated struct {
 	JobValidated *JobValidated `protobuf:"bytes,25,opt,name=jobValidated,proto3,oneof" json:"jobValidated,omitempty"`
 }
+type EventSequence_Event_CancelJobArray struct {
+	CancelJobArray *CancelJobArray `protobuf:"bytes,26,opt,name=cancelJobArray,proto3,oneof" json:"cancelJobArray,omitempty"`
+}
+type EventSequence_Event_ReprioritiseJobArray struct {
+	ReprioritiseJobArray *ReprioritiseJobArray `protobuf:"bytes,27,opt,name=reprioritiseJobArray,proto3,oneof" json:"reprioritiseJobArray,omitempty"`
+}
+type EventSequence_Event_JobDeferralEnded struct {
+	JobDeferralEnded *JobDeferralEnded `protobuf:"bytes,28,opt,name=jobDeferralEnded,proto3,oneof" json:"jobDeferralEnded,omitempty"`
+}
+type EventSequence_Event_JobRunPreemptionNotice struct {
+	JobRunPreemptionNotice *JobRunPreemptionNotice `protobuf:"bytes,29,opt,name=jobRunPreemptionNotice,proto3,oneof" json:"jobRunPreemptionNotice,omitempty"`
+}
 
 func (*EventSequence_Event_SubmitJob) isEventSequence_Event_Event()                 {}
 func (*EventSequence_Event_ReprioritiseJob) isEventSequence_Event_Event()           {}
@@ -336,6 +381,10 @@ func (*EventSequence_Event_JobRequeued) isEventSequence_Event_Event()
 func (*EventSequence_Event_JobPreemptionRequested) isEventSequence_Event_Event()    {}
 func (*EventSequence_Event_JobRunCancelled) isEventSequence_Event_Event()           {}
 func (*EventSequence_Event_JobValidated) isEventSequence_Event_Event()              {}
+func (*EventSequence_Event_CancelJobArray) isEventSequence_Event_Event()            {}
+func (*EventSequence_Event_ReprioritiseJobArray) isEventSequence_Event_Event()      {}
+func (*EventSequence_Event_JobDeferralEnded) isEventSequence_Event_Event()          {}
+func (*EventSequence_Event_JobRunPreemptionNotice) isEventSequence_Event_Event()    {}
 
 func (m *EventSequence_Event) GetEvent() isEventSequence_Event_Event {
 	if m != nil {
@@ -512,6 +561,34 @@ func (m *EventSequence_Event) GetJobValidated() *JobValidated {
 	return nil
 }
 
+func (m *EventSequence_Event) GetCancelJobArray() *CancelJobArray {
+	if x, ok := m.GetEvent().(*EventSequence_Event_CancelJobArray); ok {
+		return x.CancelJobArray
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetReprioritiseJobArray() *ReprioritiseJobArray {
+	if x, ok := m.GetEvent().(*EventSequence_Event_ReprioritiseJobArray); ok {
+		return x.ReprioritiseJobArray
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobDeferralEnded() *JobDeferralEnded {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobDeferralEnded); ok {
+		return x.JobDeferralEnded
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobRunPreemptionNotice() *JobRunPreemptionNotice {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobRunPreemptionNotice); ok {
+		return x.JobRunPreemptionNotice
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -538,6 +615,10 @@ func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 		(*EventSequence_Event_JobPreemptionRequested)(nil),
 		(*EventSequence_Event_JobRunCancelled)(nil),
 		(*EventSequence_Event_JobValidated)(nil),
+		(*EventSequence_Event_CancelJobArray)(nil),
+		(*EventSequence_Event_ReprioritiseJobArray)(nil),
+		(*EventSequence_Event_JobDeferralEnded)(nil),
+		(*EventSequence_Event_JobRunPreemptionNotice)(nil),
 	}
 }
 
@@ -663,6 +744,20 @@ type SubmitJob struct {
 	IsDuplicate bool `protobuf:"varint,12,opt,name=isDuplicate,proto3" json:"isDuplicate,omitempty"`
 	// The job id
 	JobId string `protobuf:"bytes,14,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Ids of the jobs that must succeed before this job may be scheduled.
+	Dependencies []string `protobuf:"bytes,16,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// What to do with this job if any of its dependencies fails or is cancelled.
+	DependencyFailurePolicy DependencyFailurePolicy `protobuf:"varint,17,opt,name=dependency_failure_policy,json=dependencyFailurePolicy,proto3,enum=armadaevents.DependencyFailurePolicy" json:"dependencyFailurePolicy,omitempty"`
+	// If non-zero, this message describes a job array of array_size jobs that share this spec. The job id is then
+	// the id of the array, and each job is created by expanding the array on ingestion.
+	ArraySize uint32 `protobuf:"varint,18,opt,name=array_size,json=arraySize,proto3" json:"arraySize,omitempty"`
+	// If set, the job may not be scheduled until this time.
+	NotBefore *types.Timestamp `protobuf:"bytes,19,opt,name=not_before,json=notBefore,proto3" json:"notBefore,omitempty"`
+	// If non-zero, the job is cancelled if it hasn't been scheduled within this many seconds of becoming eligible for
+	// scheduling, i.e., of being submitted or of not_before, whichever is later.
+	QueueTtlSeconds uint32 `protobuf:"varint,20,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// Determines whether the job is retried if one of its runs fails. If not set, scheduler-wide defaults apply.
+	RetryPolicy *schedulerobjects.RetryPolicy `protobuf:"bytes,21,opt,name=retry_policy,json=retryPolicy,proto3" json:"retryPolicy,omitempty"`
 }
 
 func (m *SubmitJob) Reset()         { *m = SubmitJob{} }
@@ -782,6 +877,48 @@ func (m *SubmitJob) GetJobId() string {
 	return ""
 }
 
+func (m *SubmitJob) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *SubmitJob) GetDependencyFailurePolicy() DependencyFailurePolicy {
+	if m != nil {
+		return m.DependencyFailurePolicy
+	}
+	return DependencyFailurePolicy_DEPENDENCY_FAILURE_POLICY_CANCEL
+}
+
+func (m *SubmitJob) GetArraySize() uint32 {
+	if m != nil {
+		return m.ArraySize
+	}
+	return 0
+}
+
+func (m *SubmitJob) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *SubmitJob) GetQueueTtlSeconds() uint32 {
+	if m != nil {
+		return m.QueueTtlSeconds
+	}
+	return 0
+}
+
+func (m *SubmitJob) GetRetryPolicy() *schedulerobjects.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 // Kubernetes objects that can serve as main objects for an Armada job.
 type KubernetesMainObject struct {
 	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
@@ -871,6 +1008,7 @@ type KubernetesObject struct {
 	//	*KubernetesObject_Ingress
 	//	*KubernetesObject_Service
 	//	*KubernetesObject_ConfigMap
+	//	*KubernetesObject_Secret
 	Object isKubernetesObject_Object `protobuf_oneof:"object"`
 }
 
@@ -925,11 +1063,15 @@ type KubernetesObject_Service struct {
 type KubernetesObject_ConfigMap struct {
 	ConfigMap *v11.ConfigMap `protobuf:"bytes,5,opt,name=configMap,proto3,oneof" json:"configMap,omitempty"`
 }
+type KubernetesObject_Secret struct {
+	Secret *v11.Secret `protobuf:"bytes,6,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+}
 
 func (*KubernetesObject_PodSpec) isKubernetesObject_Object()   {}
 func (*KubernetesObject_Ingress) isKubernetesObject_Object()   {}
 func (*KubernetesObject_Service) isKubernetesObject_Object()   {}
 func (*KubernetesObject_ConfigMap) isKubernetesObject_Object() {}
+func (*KubernetesObject_Secret) isKubernetesObject_Object()    {}
 
 func (m *KubernetesObject) GetObject() isKubernetesObject_Object {
 	if m != nil {
@@ -973,6 +1115,13 @@ func (m *KubernetesObject) GetConfigMap() *v11.ConfigMap {
 	return nil
 }
 
+func (m *KubernetesObject) GetSecret() *v11.Secret {
+	if x, ok := m.GetObject().(*KubernetesObject_Secret); ok {
+		return x.Secret
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*KubernetesObject) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -980,6 +1129,7 @@ func (*KubernetesObject) XXX_OneofWrappers() []interface{} {
 		(*KubernetesObject_Ingress)(nil),
 		(*KubernetesObject_Service)(nil),
 		(*KubernetesObject_ConfigMap)(nil),
+		(*KubernetesObject_Secret)(nil),
 	}
 }
 
@@ -1291,7 +1441,60 @@ func (m *ReprioritiseJobSet) GetPriority() uint32 {
 	return 0
 }
 
-// Generated by the scheduler in response to ReprioritiseJob and ReprioritiseJobSet.
+// Re-prioritise all jobs in a job array.
+type ReprioritiseJobArray struct {
+	ArrayId  string `protobuf:"bytes,1,opt,name=array_id,json=arrayId,proto3" json:"arrayId,omitempty"`
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *ReprioritiseJobArray) Reset()         { *m = ReprioritiseJobArray{} }
+func (m *ReprioritiseJobArray) String() string { return proto.CompactTextString(m) }
+func (*ReprioritiseJobArray) ProtoMessage()    {}
+func (*ReprioritiseJobArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{10}
+}
+func (m *ReprioritiseJobArray) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReprioritiseJobArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReprioritiseJobArray.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReprioritiseJobArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprioritiseJobArray.Merge(m, src)
+}
+func (m *ReprioritiseJobArray) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReprioritiseJobArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprioritiseJobArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprioritiseJobArray proto.InternalMessageInfo
+
+func (m *ReprioritiseJobArray) GetArrayId() string {
+	if m != nil {
+		return m.ArrayId
+	}
+	return ""
+}
+
+func (m *ReprioritiseJobArray) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// Generated by the scheduler in response to ReprioritiseJob, ReprioritiseJobSet and ReprioritiseJobArray.
 // One such message is generated per job that was re-prioritised and includes the new priority.
 type ReprioritisedJob struct {
 	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
@@ -1302,7 +1505,7 @@ func (m *ReprioritisedJob) Reset()         { *m = ReprioritisedJob{} }
 func (m *ReprioritisedJob) String() string { return proto.CompactTextString(m) }
 func (*ReprioritisedJob) ProtoMessage()    {}
 func (*ReprioritisedJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{10}
+	return fileDescriptor_6aab92ca59e015f8, []int{11}
 }
 func (m *ReprioritisedJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1560,7 @@ func (m *CancelJob) Reset()         { *m = CancelJob{} }
 func (m *CancelJob) String() string { return proto.CompactTextString(m) }
 func (*CancelJob) ProtoMessage()    {}
 func (*CancelJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{11}
+	return fileDescriptor_6aab92ca59e015f8, []int{12}
 }
 func (m *CancelJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1410,7 +1613,7 @@ func (m *JobSetFilter) Reset()         { *m = JobSetFilter{} }
 func (m *JobSetFilter) String() string { return proto.CompactTextString(m) }
 func (*JobSetFilter) ProtoMessage()    {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{12}
+	return fileDescriptor_6aab92ca59e015f8, []int{13}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1660,7 @@ func (m *CancelJobSet) Reset()         { *m = CancelJobSet{} }
 func (m *CancelJobSet) String() string { return proto.CompactTextString(m) }
 func (*CancelJobSet) ProtoMessage()    {}
 func (*CancelJobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{13}
+	return fileDescriptor_6aab92ca59e015f8, []int{14}
 }
 func (m *CancelJobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1703,60 @@ func (m *CancelJobSet) GetReason() string {
 	return ""
 }
 
-// Generated by the scheduler in response to CancelJob and CancelJobSet.
+// Cancel all jobs in a job array.
+type CancelJobArray struct {
+	ArrayId string `protobuf:"bytes,1,opt,name=array_id,json=arrayId,proto3" json:"arrayId,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *CancelJobArray) Reset()         { *m = CancelJobArray{} }
+func (m *CancelJobArray) String() string { return proto.CompactTextString(m) }
+func (*CancelJobArray) ProtoMessage()    {}
+func (*CancelJobArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{15}
+}
+func (m *CancelJobArray) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelJobArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelJobArray.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelJobArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobArray.Merge(m, src)
+}
+func (m *CancelJobArray) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelJobArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelJobArray proto.InternalMessageInfo
+
+func (m *CancelJobArray) GetArrayId() string {
+	if m != nil {
+		return m.ArrayId
+	}
+	return ""
+}
+
+func (m *CancelJobArray) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Generated by the scheduler in response to CancelJob, CancelJobSet and CancelJobArray.
 // One such message is generated per job that was cancelled.
 type CancelledJob struct {
 	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -1512,7 +1768,7 @@ func (m *CancelledJob) Reset()         { *m = CancelledJob{} }
 func (m *CancelledJob) String() string { return proto.CompactTextString(m) }
 func (*CancelledJob) ProtoMessage()    {}
 func (*CancelledJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{14}
+	return fileDescriptor_6aab92ca59e015f8, []int{16}
 }
 func (m *CancelledJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1830,7 @@ func (m *JobSucceeded) Reset()         { *m = JobSucceeded{} }
 func (m *JobSucceeded) String() string { return proto.CompactTextString(m) }
 func (*JobSucceeded) ProtoMessage()    {}
 func (*JobSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{15}
+	return fileDescriptor_6aab92ca59e015f8, []int{17}
 }
 func (m *JobSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,7 +1906,7 @@ func (m *JobRunLeased) Reset()         { *m = JobRunLeased{} }
 func (m *JobRunLeased) String() string { return proto.CompactTextString(m) }
 func (*JobRunLeased) ProtoMessage()    {}
 func (*JobRunLeased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{16}
+	return fileDescriptor_6aab92ca59e015f8, []int{18}
 }
 func (m *JobRunLeased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +2012,7 @@ func (m *JobRunAssigned) Reset()         { *m = JobRunAssigned{} }
 func (m *JobRunAssigned) String() string { return proto.CompactTextString(m) }
 func (*JobRunAssigned) ProtoMessage()    {}
 func (*JobRunAssigned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{17}
+	return fileDescriptor_6aab92ca59e015f8, []int{19}
 }
 func (m *JobRunAssigned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,7 +2075,7 @@ func (m *JobRunRunning) Reset()         { *m = JobRunRunning{} }
 func (m *JobRunRunning) String() string { return proto.CompactTextString(m) }
 func (*JobRunRunning) ProtoMessage()    {}
 func (*JobRunRunning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{18}
+	return fileDescriptor_6aab92ca59e015f8, []int{20}
 }
 func (m *JobRunRunning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1882,7 +2138,7 @@ func (m *KubernetesResourceInfo) Reset()         { *m = KubernetesResourceInfo{}
 func (m *KubernetesResourceInfo) String() string { return proto.CompactTextString(m) }
 func (*KubernetesResourceInfo) ProtoMessage()    {}
 func (*KubernetesResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{19}
+	return fileDescriptor_6aab92ca59e015f8, []int{21}
 }
 func (m *KubernetesResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1973,7 +2229,7 @@ func (m *PodInfo) Reset()         { *m = PodInfo{} }
 func (m *PodInfo) String() string { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()    {}
 func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{20}
+	return fileDescriptor_6aab92ca59e015f8, []int{22}
 }
 func (m *PodInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2284,7 @@ func (m *IngressInfo) Reset()         { *m = IngressInfo{} }
 func (m *IngressInfo) String() string { return proto.CompactTextString(m) }
 func (*IngressInfo) ProtoMessage()    {}
 func (*IngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{21}
+	return fileDescriptor_6aab92ca59e015f8, []int{23}
 }
 func (m *IngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2091,7 +2347,7 @@ func (m *StandaloneIngressInfo) Reset()         { *m = StandaloneIngressInfo{} }
 func (m *StandaloneIngressInfo) String() string { return proto.CompactTextString(m) }
 func (*StandaloneIngressInfo) ProtoMessage()    {}
 func (*StandaloneIngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{22}
+	return fileDescriptor_6aab92ca59e015f8, []int{24}
 }
 func (m *StandaloneIngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2190,7 +2446,7 @@ func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
 func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
 func (*JobRunSucceeded) ProtoMessage()    {}
 func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{23}
+	return fileDescriptor_6aab92ca59e015f8, []int{25}
 }
 func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,7 +2509,7 @@ func (m *JobErrors) Reset()         { *m = JobErrors{} }
 func (m *JobErrors) String() string { return proto.CompactTextString(m) }
 func (*JobErrors) ProtoMessage()    {}
 func (*JobErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{24}
+	return fileDescriptor_6aab92ca59e015f8, []int{26}
 }
 func (m *JobErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2570,7 @@ func (m *JobRunErrors) Reset()         { *m = JobRunErrors{} }
 func (m *JobRunErrors) String() string { return proto.CompactTextString(m) }
 func (*JobRunErrors) ProtoMessage()    {}
 func (*JobRunErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{25}
+	return fileDescriptor_6aab92ca59e015f8, []int{27}
 }
 func (m *JobRunErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2388,6 +2644,8 @@ type Error struct {
 	//	*Error_JobRunPreemptedError
 	//	*Error_GangJobUnschedulable
 	//	*Error_JobRejected
+	//	*Error_JobDependencyFailed
+	//	*Error_RetryDecision
 	Reason isError_Reason `protobuf_oneof:"reason"`
 }
 
@@ -2395,7 +2653,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{26}
+	return fileDescriptor_6aab92ca59e015f8, []int{28}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2460,6 +2718,12 @@ type Error_GangJobUnschedulable struct {
 type Error_JobRejected struct {
 	JobRejected *JobRejected `protobuf:"bytes,13,opt,name=jobRejected,proto3,oneof" json:"jobRejected,omitempty"`
 }
+type Error_JobDependencyFailed struct {
+	JobDependencyFailed *JobDependencyFailed `protobuf:"bytes,14,opt,name=jobDependencyFailed,proto3,oneof" json:"jobDependencyFailed,omitempty"`
+}
+type Error_RetryDecision struct {
+	RetryDecision *RetryDecision `protobuf:"bytes,15,opt,name=retryDecision,proto3,oneof" json:"retryDecision,omitempty"`
+}
 
 func (*Error_KubernetesError) isError_Reason()      {}
 func (*Error_ContainerError) isError_Reason()       {}
@@ -2471,6 +2735,8 @@ func (*Error_PodLeaseReturned) isError_Reason()     {}
 func (*Error_JobRunPreemptedError) isError_Reason() {}
 func (*Error_GangJobUnschedulable) isError_Reason() {}
 func (*Error_JobRejected) isError_Reason()          {}
+func (*Error_JobDependencyFailed) isError_Reason()  {}
+func (*Error_RetryDecision) isError_Reason()        {}
 
 func (m *Error) GetReason() isError_Reason {
 	if m != nil {
@@ -2556,6 +2822,20 @@ func (m *Error) GetJobRejected() *JobRejected {
 	return nil
 }
 
+func (m *Error) GetJobDependencyFailed() *JobDependencyFailed {
+	if x, ok := m.GetReason().(*Error_JobDependencyFailed); ok {
+		return x.JobDependencyFailed
+	}
+	return nil
+}
+
+func (m *Error) GetRetryDecision() *RetryDecision {
+	if x, ok := m.GetReason().(*Error_RetryDecision); ok {
+		return x.RetryDecision
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Error) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2569,6 +2849,8 @@ func (*Error) XXX_OneofWrappers() []interface{} {
 		(*Error_JobRunPreemptedError)(nil),
 		(*Error_GangJobUnschedulable)(nil),
 		(*Error_JobRejected)(nil),
+		(*Error_JobDependencyFailed)(nil),
+		(*Error_RetryDecision)(nil),
 	}
 }
 
@@ -2582,7 +2864,7 @@ func (m *KubernetesError) Reset()         { *m = KubernetesError{} }
 func (m *KubernetesError) String() string { return proto.CompactTextString(m) }
 func (*KubernetesError) ProtoMessage()    {}
 func (*KubernetesError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{27}
+	return fileDescriptor_6aab92ca59e015f8, []int{29}
 }
 func (m *KubernetesError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2634,7 +2916,7 @@ func (m *PodError) Reset()         { *m = PodError{} }
 func (m *PodError) String() string { return proto.CompactTextString(m) }
 func (*PodError) ProtoMessage()    {}
 func (*PodError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{28}
+	return fileDescriptor_6aab92ca59e015f8, []int{30}
 }
 func (m *PodError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2729,7 +3011,7 @@ func (m *ContainerError) Reset()         { *m = ContainerError{} }
 func (m *ContainerError) String() string { return proto.CompactTextString(m) }
 func (*ContainerError) ProtoMessage()    {}
 func (*ContainerError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{29}
+	return fileDescriptor_6aab92ca59e015f8, []int{31}
 }
 func (m *ContainerError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2806,7 +3088,7 @@ func (m *PodLeaseReturned) Reset()         { *m = PodLeaseReturned{} }
 func (m *PodLeaseReturned) String() string { return proto.CompactTextString(m) }
 func (*PodLeaseReturned) ProtoMessage()    {}
 func (*PodLeaseReturned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{30}
+	return fileDescriptor_6aab92ca59e015f8, []int{32}
 }
 func (m *PodLeaseReturned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +3166,7 @@ func (m *PodTerminated) Reset()         { *m = PodTerminated{} }
 func (m *PodTerminated) String() string { return proto.CompactTextString(m) }
 func (*PodTerminated) ProtoMessage()    {}
 func (*PodTerminated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{31}
+	return fileDescriptor_6aab92ca59e015f8, []int{33}
 }
 func (m *PodTerminated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2948,7 +3230,7 @@ func (m *ExecutorError) Reset()         { *m = ExecutorError{} }
 func (m *ExecutorError) String() string { return proto.CompactTextString(m) }
 func (*ExecutorError) ProtoMessage()    {}
 func (*ExecutorError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{32}
+	return fileDescriptor_6aab92ca59e015f8, []int{34}
 }
 func (m *ExecutorError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2989,7 +3271,7 @@ func (m *PodUnschedulable) Reset()         { *m = PodUnschedulable{} }
 func (m *PodUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*PodUnschedulable) ProtoMessage()    {}
 func (*PodUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{33}
+	return fileDescriptor_6aab92ca59e015f8, []int{35}
 }
 func (m *PodUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3053,7 +3335,7 @@ func (m *LeaseExpired) Reset()         { *m = LeaseExpired{} }
 func (m *LeaseExpired) String() string { return proto.CompactTextString(m) }
 func (*LeaseExpired) ProtoMessage()    {}
 func (*LeaseExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{34}
+	return fileDescriptor_6aab92ca59e015f8, []int{36}
 }
 func (m *LeaseExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3090,7 +3372,7 @@ func (m *MaxRunsExceeded) Reset()         { *m = MaxRunsExceeded{} }
 func (m *MaxRunsExceeded) String() string { return proto.CompactTextString(m) }
 func (*MaxRunsExceeded) ProtoMessage()    {}
 func (*MaxRunsExceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{35}
+	return fileDescriptor_6aab92ca59e015f8, []int{37}
 }
 func (m *MaxRunsExceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3134,7 +3416,7 @@ func (m *JobRunPreemptedError) Reset()         { *m = JobRunPreemptedError{} }
 func (m *JobRunPreemptedError) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptedError) ProtoMessage()    {}
 func (*JobRunPreemptedError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{36}
+	return fileDescriptor_6aab92ca59e015f8, []int{38}
 }
 func (m *JobRunPreemptedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3178,7 +3460,7 @@ func (m *GangJobUnschedulable) Reset()         { *m = GangJobUnschedulable{} }
 func (m *GangJobUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*GangJobUnschedulable) ProtoMessage()    {}
 func (*GangJobUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{37}
+	return fileDescriptor_6aab92ca59e015f8, []int{39}
 }
 func (m *GangJobUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3222,7 +3504,7 @@ func (m *JobRejected) Reset()         { *m = JobRejected{} }
 func (m *JobRejected) String() string { return proto.CompactTextString(m) }
 func (*JobRejected) ProtoMessage()    {}
 func (*JobRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{38}
+	return fileDescriptor_6aab92ca59e015f8, []int{40}
 }
 func (m *JobRejected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3258,25 +3540,24 @@ func (m *JobRejected) GetMessage() string {
 	return ""
 }
 
-// Message to indicate that a JobRun has been preempted.
-type JobRunPreempted struct {
-	PreemptedJobId string `protobuf:"bytes,5,opt,name=preempted_job_id,json=preemptedJobId,proto3" json:"preemptedJobId,omitempty"`
-	PreemptedRunId string `protobuf:"bytes,6,opt,name=preempted_run_id,json=preemptedRunId,proto3" json:"preemptedRunId,omitempty"`
-	Reason         string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+// Indicates that a job was failed because a job it depends on failed or was cancelled.
+type JobDependencyFailed struct {
+	Message         string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DependencyJobId string `protobuf:"bytes,2,opt,name=dependency_job_id,json=dependencyJobId,proto3" json:"dependencyJobId,omitempty"`
 }
 
-func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
-func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
-func (*JobRunPreempted) ProtoMessage()    {}
-func (*JobRunPreempted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{39}
+func (m *JobDependencyFailed) Reset()         { *m = JobDependencyFailed{} }
+func (m *JobDependencyFailed) String() string { return proto.CompactTextString(m) }
+func (*JobDependencyFailed) ProtoMessage()    {}
+func (*JobDependencyFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{41}
 }
-func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
+func (m *JobDependencyFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunPreempted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobDependencyFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunPreempted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobDependencyFailed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3286,59 +3567,55 @@ func (m *JobRunPreempted) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *JobRunPreempted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunPreempted.Merge(m, src)
+func (m *JobDependencyFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDependencyFailed.Merge(m, src)
 }
-func (m *JobRunPreempted) XXX_Size() int {
+func (m *JobDependencyFailed) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunPreempted) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunPreempted.DiscardUnknown(m)
+func (m *JobDependencyFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDependencyFailed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunPreempted proto.InternalMessageInfo
-
-func (m *JobRunPreempted) GetPreemptedJobId() string {
-	if m != nil {
-		return m.PreemptedJobId
-	}
-	return ""
-}
+var xxx_messageInfo_JobDependencyFailed proto.InternalMessageInfo
 
-func (m *JobRunPreempted) GetPreemptedRunId() string {
+func (m *JobDependencyFailed) GetMessage() string {
 	if m != nil {
-		return m.PreemptedRunId
+		return m.Message
 	}
 	return ""
 }
 
-func (m *JobRunPreempted) GetReason() string {
+func (m *JobDependencyFailed) GetDependencyJobId() string {
 	if m != nil {
-		return m.Reason
+		return m.DependencyJobId
 	}
 	return ""
 }
 
-// Message used internally by Armada to see if messages can be propagated through a pulsar partition
-type PartitionMarker struct {
-	// Group id identifies the group of partition markers, one per partition
-	GroupId string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
-	// The pulsar partition on which this message was sent
-	Partition uint32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+// Records whether the scheduler decided to retry a job after one of its runs failed, according to the job's retry policy.
+// Never terminal.
+type RetryDecision struct {
+	// True if the job was requeued.
+	Retry   bool   `protobuf:"varint,1,opt,name=retry,proto3" json:"retry,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Number of runs of the job attempted so far.
+	Attempts    uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts uint32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"maxAttempts,omitempty"`
 }
 
-func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
-func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
-func (*PartitionMarker) ProtoMessage()    {}
-func (*PartitionMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{40}
+func (m *RetryDecision) Reset()         { *m = RetryDecision{} }
+func (m *RetryDecision) String() string { return proto.CompactTextString(m) }
+func (*RetryDecision) ProtoMessage()    {}
+func (*RetryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{42}
 }
-func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
+func (m *RetryDecision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PartitionMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PartitionMarker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryDecision.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3348,10 +3625,202 @@ func (m *PartitionMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PartitionMarker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PartitionMarker.Merge(m, src)
+func (m *RetryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryDecision.Merge(m, src)
 }
-func (m *PartitionMarker) XXX_Size() int {
+func (m *RetryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryDecision proto.InternalMessageInfo
+
+func (m *RetryDecision) GetRetry() bool {
+	if m != nil {
+		return m.Retry
+	}
+	return false
+}
+
+func (m *RetryDecision) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RetryDecision) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *RetryDecision) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+// Message to indicate that a JobRun has been preempted.
+type JobRunPreempted struct {
+	PreemptedJobId string `protobuf:"bytes,5,opt,name=preempted_job_id,json=preemptedJobId,proto3" json:"preemptedJobId,omitempty"`
+	PreemptedRunId string `protobuf:"bytes,6,opt,name=preempted_run_id,json=preemptedRunId,proto3" json:"preemptedRunId,omitempty"`
+	Reason         string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
+func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
+func (*JobRunPreempted) ProtoMessage()    {}
+func (*JobRunPreempted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{43}
+}
+func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunPreempted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunPreempted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunPreempted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunPreempted.Merge(m, src)
+}
+func (m *JobRunPreempted) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunPreempted) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunPreempted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunPreempted proto.InternalMessageInfo
+
+func (m *JobRunPreempted) GetPreemptedJobId() string {
+	if m != nil {
+		return m.PreemptedJobId
+	}
+	return ""
+}
+
+func (m *JobRunPreempted) GetPreemptedRunId() string {
+	if m != nil {
+		return m.PreemptedRunId
+	}
+	return ""
+}
+
+func (m *JobRunPreempted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Generated by the executor when it gives a run notice that it's about to be preempted.
+// The run is killed once the deadline has passed.
+type JobRunPreemptionNotice struct {
+	JobId    string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	RunId    string           `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	Deadline *types.Timestamp `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *JobRunPreemptionNotice) Reset()         { *m = JobRunPreemptionNotice{} }
+func (m *JobRunPreemptionNotice) String() string { return proto.CompactTextString(m) }
+func (*JobRunPreemptionNotice) ProtoMessage()    {}
+func (*JobRunPreemptionNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{44}
+}
+func (m *JobRunPreemptionNotice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunPreemptionNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunPreemptionNotice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunPreemptionNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunPreemptionNotice.Merge(m, src)
+}
+func (m *JobRunPreemptionNotice) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunPreemptionNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunPreemptionNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunPreemptionNotice proto.InternalMessageInfo
+
+func (m *JobRunPreemptionNotice) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobRunPreemptionNotice) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *JobRunPreemptionNotice) GetDeadline() *types.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+// Message used internally by Armada to see if messages can be propagated through a pulsar partition
+type PartitionMarker struct {
+	// Group id identifies the group of partition markers, one per partition
+	GroupId string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	// The pulsar partition on which this message was sent
+	Partition uint32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
+func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
+func (*PartitionMarker) ProtoMessage()    {}
+func (*PartitionMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{45}
+}
+func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionMarker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionMarker.Merge(m, src)
+}
+func (m *PartitionMarker) XXX_Size() int {
 	return m.Size()
 }
 func (m *PartitionMarker) XXX_DiscardUnknown() {
@@ -3384,7 +3853,7 @@ func (m *JobRunPreemptionRequested) Reset()         { *m = JobRunPreemptionReque
 func (m *JobRunPreemptionRequested) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptionRequested) ProtoMessage()    {}
 func (*JobRunPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{41}
+	return fileDescriptor_6aab92ca59e015f8, []int{46}
 }
 func (m *JobRunPreemptionRequested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3437,7 +3906,7 @@ func (m *JobPreemptionRequested) Reset()         { *m = JobPreemptionRequested{}
 func (m *JobPreemptionRequested) String() string { return proto.CompactTextString(m) }
 func (*JobPreemptionRequested) ProtoMessage()    {}
 func (*JobPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{42}
+	return fileDescriptor_6aab92ca59e015f8, []int{47}
 }
 func (m *JobPreemptionRequested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3480,6 +3949,59 @@ func (m *JobPreemptionRequested) GetReason() string {
 	return ""
 }
 
+// Generated by the scheduler when a job submitted with a not_before time becomes eligible for scheduling.
+type JobDeferralEnded struct {
+	JobId     string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	NotBefore *types.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"notBefore,omitempty"`
+}
+
+func (m *JobDeferralEnded) Reset()         { *m = JobDeferralEnded{} }
+func (m *JobDeferralEnded) String() string { return proto.CompactTextString(m) }
+func (*JobDeferralEnded) ProtoMessage()    {}
+func (*JobDeferralEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{48}
+}
+func (m *JobDeferralEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobDeferralEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobDeferralEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobDeferralEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDeferralEnded.Merge(m, src)
+}
+func (m *JobDeferralEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobDeferralEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDeferralEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobDeferralEnded proto.InternalMessageInfo
+
+func (m *JobDeferralEnded) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobDeferralEnded) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
 // Indicates that the scheduler is happy with the job
 type JobValidated struct {
 	Pools []string `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
@@ -3490,7 +4012,7 @@ func (m *JobValidated) Reset()         { *m = JobValidated{} }
 func (m *JobValidated) String() string { return proto.CompactTextString(m) }
 func (*JobValidated) ProtoMessage()    {}
 func (*JobValidated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{43}
+	return fileDescriptor_6aab92ca59e015f8, []int{49}
 }
 func (m *JobValidated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +4066,7 @@ func (m *JobRunCancelled) Reset()         { *m = JobRunCancelled{} }
 func (m *JobRunCancelled) String() string { return proto.CompactTextString(m) }
 func (*JobRunCancelled) ProtoMessage()    {}
 func (*JobRunCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{44}
+	return fileDescriptor_6aab92ca59e015f8, []int{50}
 }
 func (m *JobRunCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3588,6 +4110,7 @@ func (m *JobRunCancelled) GetRunId() string {
 }
 
 func init() {
+	proto.RegisterEnum("armadaevents.DependencyFailurePolicy", DependencyFailurePolicy_name, DependencyFailurePolicy_value)
 	proto.RegisterEnum("armadaevents.JobState", JobState_name, JobState_value)
 	proto.RegisterEnum("armadaevents.KubernetesReason", KubernetesReason_name, KubernetesReason_value)
 	proto.RegisterType((*EventSequence)(nil), "armadaevents.EventSequence")
@@ -3606,10 +4129,12 @@ func init() {
 	proto.RegisterType((*ReprioritiseJob)(nil), "armadaevents.ReprioritiseJob")
 	proto.RegisterType((*JobRequeued)(nil), "armadaevents.JobRequeued")
 	proto.RegisterType((*ReprioritiseJobSet)(nil), "armadaevents.ReprioritiseJobSet")
+	proto.RegisterType((*ReprioritiseJobArray)(nil), "armadaevents.ReprioritiseJobArray")
 	proto.RegisterType((*ReprioritisedJob)(nil), "armadaevents.ReprioritisedJob")
 	proto.RegisterType((*CancelJob)(nil), "armadaevents.CancelJob")
 	proto.RegisterType((*JobSetFilter)(nil), "armadaevents.JobSetFilter")
 	proto.RegisterType((*CancelJobSet)(nil), "armadaevents.CancelJobSet")
+	proto.RegisterType((*CancelJobArray)(nil), "armadaevents.CancelJobArray")
 	proto.RegisterType((*CancelledJob)(nil), "armadaevents.CancelledJob")
 	proto.RegisterType((*JobSucceeded)(nil), "armadaevents.JobSucceeded")
 	proto.RegisterType((*JobRunLeased)(nil), "armadaevents.JobRunLeased")
@@ -3637,10 +4162,14 @@ func init() {
 	proto.RegisterType((*JobRunPreemptedError)(nil), "armadaevents.JobRunPreemptedError")
 	proto.RegisterType((*GangJobUnschedulable)(nil), "armadaevents.GangJobUnschedulable")
 	proto.RegisterType((*JobRejected)(nil), "armadaevents.JobRejected")
+	proto.RegisterType((*JobDependencyFailed)(nil), "armadaevents.JobDependencyFailed")
+	proto.RegisterType((*RetryDecision)(nil), "armadaevents.RetryDecision")
 	proto.RegisterType((*JobRunPreempted)(nil), "armadaevents.JobRunPreempted")
+	proto.RegisterType((*JobRunPreemptionNotice)(nil), "armadaevents.JobRunPreemptionNotice")
 	proto.RegisterType((*PartitionMarker)(nil), "armadaevents.PartitionMarker")
 	proto.RegisterType((*JobRunPreemptionRequested)(nil), "armadaevents.JobRunPreemptionRequested")
 	proto.RegisterType((*JobPreemptionRequested)(nil), "armadaevents.JobPreemptionRequested")
+	proto.RegisterType((*JobDeferralEnded)(nil), "armadaevents.JobDeferralEnded")
 	proto.RegisterType((*JobValidated)(nil), "armadaevents.JobValidated")
 	proto.RegisterType((*JobRunCancelled)(nil), "armadaevents.JobRunCancelled")
 }
@@ -3648,236 +4177,276 @@ func init() {
 func init() { proto.RegisterFile("pkg/armadaevents/events.proto", fileDescriptor_6aab92ca59e015f8) }
 
 var fileDescriptor_6aab92ca59e015f8 = []byte{
-	// 3660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x4d, 0x6f, 0x1b, 0x47,
-	0x96, 0x6e, 0x7e, 0xf3, 0x51, 0x1f, 0x74, 0xe9, 0xc3, 0xb4, 0x12, 0x8b, 0x32, 0x9d, 0x4d, 0xec,
-	0x20, 0xa1, 0x1c, 0x67, 0xb3, 0xc8, 0xc7, 0x22, 0x81, 0x68, 0x2b, 0xb6, 0x15, 0xcb, 0x56, 0x28,
-	0x2b, 0xeb, 0x5d, 0x04, 0x60, 0x9a, 0xec, 0x12, 0xd5, 0x16, 0xd9, 0xdd, 0xe9, 0x0f, 0x45, 0x02,
-	0x02, 0x6c, 0xb2, 0xc8, 0xee, 0x39, 0x97, 0x05, 0x16, 0xb9, 0x6c, 0x2e, 0x7b, 0xd8, 0x05, 0xf6,
-	0x32, 0xc0, 0xcc, 0x6f, 0x98, 0xc3, 0x60, 0x90, 0xcb, 0x00, 0x73, 0x98, 0x10, 0x83, 0x04, 0x73,
-	0xe1, 0x61, 0x7e, 0xc3, 0xa0, 0x3e, 0xba, 0xbb, 0xaa, 0x59, 0xb4, 0x28, 0xc7, 0x32, 0x3c, 0x99,
-	0x93, 0xd4, 0xef, 0xb3, 0xfa, 0xbd, 0x57, 0xaf, 0xdf, 0x7b, 0x55, 0x84, 0x0b, 0xce, 0x7e, 0x77,
-	0x55, 0x77, 0xfb, 0xba, 0xa1, 0xe3, 0x03, 0x6c, 0xf9, 0xde, 0x2a, 0xfb, 0x53, 0x77, 0x5c, 0xdb,
-	0xb7, 0xd1, 0x94, 0x88, 0x5a, 0xaa, 0xed, 0xbf, 0xe9, 0xd5, 0x4d, 0x7b, 0x55, 0x77, 0xcc, 0xd5,
-	0x8e, 0xed, 0xe2, 0xd5, 0x83, 0xd7, 0x56, 0xbb, 0xd8, 0xc2, 0xae, 0xee, 0x63, 0x83, 0x71, 0x2c,
-	0x5d, 0x16, 0x68, 0x2c, 0xec, 0x7f, 0x66, 0xbb, 0xfb, 0xa6, 0xd5, 0x55, 0x51, 0x56, 0xbb, 0xb6,
-	0xdd, 0xed, 0xe1, 0x55, 0xfa, 0xd4, 0x0e, 0x76, 0x57, 0x7d, 0xb3, 0x8f, 0x3d, 0x5f, 0xef, 0x3b,
-	0x9c, 0xe0, 0xef, 0x63, 0x51, 0x7d, 0xbd, 0xb3, 0x67, 0x5a, 0xd8, 0x3d, 0x5a, 0xa5, 0xeb, 0x75,
-	0xcc, 0x55, 0x17, 0x7b, 0x76, 0xe0, 0x76, 0xf0, 0x88, 0xd8, 0xb7, 0x4d, 0xcb, 0xc7, 0xae, 0xa5,
-	0xf7, 0x56, 0xbd, 0xce, 0x1e, 0x36, 0x82, 0x1e, 0x76, 0xe3, 0xff, 0xec, 0xf6, 0x43, 0xdc, 0xf1,
-	0xbd, 0x11, 0x00, 0xe3, 0xad, 0x7d, 0xbf, 0x00, 0xd3, 0xeb, 0xe4, 0x5d, 0xb7, 0xf1, 0xa7, 0x01,
-	0xb6, 0x3a, 0x18, 0x5d, 0x81, 0xec, 0xa7, 0x01, 0x0e, 0x70, 0x45, 0x5b, 0xd1, 0x2e, 0x17, 0x1b,
-	0x73, 0xc3, 0x41, 0x75, 0x96, 0x02, 0x5e, 0xb1, 0xfb, 0xa6, 0x8f, 0xfb, 0x8e, 0x7f, 0xd4, 0x64,
-	0x14, 0xe8, 0x6d, 0x98, 0x7a, 0x68, 0xb7, 0x5b, 0x1e, 0xf6, 0x5b, 0x96, 0xde, 0xc7, 0x95, 0x14,
-	0xe5, 0xa8, 0x0c, 0x07, 0xd5, 0xf9, 0x87, 0x76, 0x7b, 0x1b, 0xfb, 0x77, 0xf5, 0xbe, 0xc8, 0x06,
-	0x31, 0x14, 0xbd, 0x0a, 0xf9, 0xc0, 0xc3, 0x6e, 0xcb, 0x34, 0x2a, 0x69, 0xca, 0x36, 0x3f, 0x1c,
-	0x54, 0xcb, 0x04, 0x74, 0xdb, 0x10, 0x58, 0x72, 0x0c, 0x82, 0x5e, 0x81, 0x5c, 0xd7, 0xb5, 0x03,
-	0xc7, 0xab, 0x64, 0x56, 0xd2, 0x21, 0x35, 0x83, 0x88, 0xd4, 0x0c, 0x82, 0xee, 0x41, 0x8e, 0x39,
-	0xb0, 0x92, 0x5d, 0x49, 0x5f, 0x2e, 0x5d, 0xbb, 0x58, 0x17, 0xbd, 0x5a, 0x97, 0x5e, 0x98, 0x3d,
-	0x31, 0x81, 0x0c, 0x2f, 0x0a, 0xe4, 0x71, 0xf0, 0xab, 0x39, 0xc8, 0x52, 0x3a, 0xf4, 0x01, 0xe4,
-	0x3b, 0x2e, 0x26, 0xd6, 0xaf, 0xa0, 0x15, 0xed, 0x72, 0xe9, 0xda, 0x52, 0x9d, 0x79, 0xb5, 0x1e,
-	0x7a, 0xb5, 0x7e, 0x3f, 0xf4, 0x6a, 0x63, 0x61, 0x38, 0xa8, 0x9e, 0xe5, 0xe4, 0x82, 0xd4, 0x50,
-	0x02, 0xda, 0x82, 0xa2, 0x17, 0xb4, 0xfb, 0xa6, 0xbf, 0x61, 0xb7, 0xa9, 0xbd, 0x4b, 0xd7, 0xce,
-	0xc9, 0x4b, 0xdd, 0x0e, 0xd1, 0x8d, 0x73, 0xc3, 0x41, 0x75, 0x2e, 0xa2, 0x8e, 0xa5, 0xdd, 0x3a,
-	0xd3, 0x8c, 0x85, 0xa0, 0x3d, 0x98, 0x75, 0xb1, 0xe3, 0x9a, 0xb6, 0x6b, 0xfa, 0xa6, 0x87, 0x89,
-	0xdc, 0x14, 0x95, 0x7b, 0x41, 0x96, 0xdb, 0x94, 0x89, 0x1a, 0x17, 0x86, 0x83, 0xea, 0xf9, 0x04,
-	0xa7, 0xa4, 0x23, 0x29, 0x16, 0xf9, 0x80, 0x12, 0xa0, 0x6d, 0xec, 0x53, 0x5f, 0x96, 0xae, 0xad,
-	0x3c, 0x52, 0xd9, 0x36, 0xf6, 0x1b, 0x2b, 0xc3, 0x41, 0xf5, 0xf9, 0x51, 0x7e, 0x49, 0xa5, 0x42,
-	0x3e, 0xea, 0x41, 0x59, 0x84, 0x1a, 0xe4, 0x05, 0x33, 0x54, 0xe7, 0xf2, 0x78, 0x9d, 0x84, 0xaa,
-	0xb1, 0x3c, 0x1c, 0x54, 0x97, 0x92, 0xbc, 0x92, 0xbe, 0x11, 0xc9, 0xc4, 0x3f, 0x1d, 0xdd, 0xea,
-	0xe0, 0x1e, 0x51, 0x93, 0x55, 0xf9, 0xe7, 0x7a, 0x88, 0x66, 0xfe, 0x89, 0xa8, 0x65, 0xff, 0x44,
-	0x60, 0xf4, 0x31, 0x4c, 0x45, 0x0f, 0xc4, 0x5e, 0x39, 0x1e, 0x43, 0x6a, 0xa1, 0xc4, 0x52, 0x4b,
-	0xc3, 0x41, 0x75, 0x51, 0xe4, 0x91, 0x44, 0x4b, 0xd2, 0x62, 0xe9, 0x3d, 0x66, 0x99, 0xfc, 0x78,
-	0xe9, 0x8c, 0x42, 0x94, 0xde, 0x1b, 0xb5, 0x88, 0x24, 0x8d, 0x48, 0x27, 0x1b, 0x38, 0xe8, 0x74,
-	0x30, 0x36, 0xb0, 0x51, 0x29, 0xa8, 0xa4, 0x6f, 0x08, 0x14, 0x4c, 0xba, 0xc8, 0x23, 0x4b, 0x17,
-	0x31, 0xc4, 0xd6, 0x0f, 0xed, 0xf6, 0xba, 0xeb, 0xda, 0xae, 0x57, 0x29, 0xaa, 0x6c, 0xbd, 0x11,
-	0xa2, 0x99, 0xad, 0x23, 0x6a, 0xd9, 0xd6, 0x11, 0x98, 0xaf, 0xb7, 0x19, 0x58, 0x77, 0xb0, 0xee,
-	0x61, 0xa3, 0x02, 0x63, 0xd6, 0x1b, 0x51, 0x44, 0xeb, 0x8d, 0x20, 0x23, 0xeb, 0x8d, 0x30, 0xc8,
-	0x80, 0x19, 0xf6, 0xbc, 0xe6, 0x79, 0x66, 0xd7, 0xc2, 0x46, 0xa5, 0x44, 0xe5, 0x3f, 0xaf, 0x92,
-	0x1f, 0xd2, 0x34, 0x9e, 0x1f, 0x0e, 0xaa, 0x15, 0x99, 0x4f, 0xd2, 0x91, 0x90, 0x89, 0x3e, 0x81,
-	0x69, 0x06, 0x69, 0x06, 0x96, 0x65, 0x5a, 0xdd, 0xca, 0x14, 0x55, 0xf2, 0x9c, 0x4a, 0x09, 0x27,
-	0x69, 0x3c, 0x37, 0x1c, 0x54, 0xcf, 0x49, 0x5c, 0x92, 0x0a, 0x59, 0x20, 0xc9, 0x18, 0x0c, 0x10,
-	0x3b, 0x76, 0x5a, 0x95, 0x31, 0x36, 0x64, 0x22, 0x96, 0x31, 0x12, 0x9c, 0x72, 0xc6, 0x48, 0x20,
-	0x63, 0x7f, 0x70, 0x27, 0xcf, 0x8c, 0xf7, 0x07, 0xf7, 0xb3, 0xe0, 0x0f, 0x85, 0xab, 0x25, 0x69,
-	0xe8, 0x0b, 0x0d, 0x16, 0x3c, 0x5f, 0xb7, 0x0c, 0xbd, 0x67, 0x5b, 0xf8, 0xb6, 0xd5, 0x75, 0xb1,
-	0xe7, 0xdd, 0xb6, 0x76, 0xed, 0x4a, 0x99, 0xea, 0xb9, 0x94, 0x48, 0xac, 0x2a, 0xd2, 0xc6, 0xa5,
-	0xe1, 0xa0, 0x5a, 0x55, 0x4a, 0x91, 0x34, 0xab, 0x15, 0xa1, 0x43, 0x98, 0x0b, 0x3f, 0xd2, 0x3b,
-	0xbe, 0xd9, 0x33, 0x3d, 0xdd, 0x37, 0x6d, 0xab, 0x72, 0x96, 0xea, 0xbf, 0x98, 0xcc, 0x4f, 0x23,
-	0x84, 0x8d, 0x8b, 0xc3, 0x41, 0xf5, 0x82, 0x42, 0x82, 0xa4, 0x5b, 0xa5, 0x22, 0x76, 0xe2, 0x96,
-	0x8b, 0x09, 0x21, 0x36, 0x2a, 0x73, 0xe3, 0x9d, 0x18, 0x11, 0x89, 0x4e, 0x8c, 0x80, 0x2a, 0x27,
-	0x46, 0x48, 0xa2, 0xc9, 0xd1, 0x5d, 0xdf, 0x24, 0x6a, 0x37, 0x75, 0x77, 0x1f, 0xbb, 0x95, 0x79,
-	0x95, 0xa6, 0x2d, 0x99, 0x88, 0x69, 0x4a, 0x70, 0xca, 0x9a, 0x12, 0x48, 0xf4, 0xb5, 0x06, 0xf2,
-	0xd2, 0x4c, 0xdb, 0x6a, 0x92, 0x8f, 0xb6, 0x47, 0x5e, 0x6f, 0x81, 0x2a, 0x7d, 0xe9, 0x11, 0xaf,
-	0x27, 0x92, 0x37, 0x5e, 0x1a, 0x0e, 0xaa, 0x97, 0xc6, 0x4a, 0x93, 0x16, 0x32, 0x5e, 0x29, 0x7a,
-	0x00, 0x25, 0x82, 0xc4, 0xb4, 0xfc, 0x31, 0x2a, 0x8b, 0x74, 0x0d, 0xe7, 0x47, 0xd7, 0xc0, 0x09,
-	0x1a, 0xe7, 0x87, 0x83, 0xea, 0x82, 0xc0, 0x21, 0xe9, 0x11, 0x45, 0xa1, 0xaf, 0x34, 0x20, 0x81,
-	0xae, 0x7a, 0xd3, 0x73, 0x54, 0xcb, 0x0b, 0x23, 0x5a, 0x54, 0xaf, 0xf9, 0xc2, 0x70, 0x50, 0x5d,
-	0x51, 0xcb, 0x91, 0x74, 0x8f, 0xd1, 0x15, 0xc7, 0x51, 0xf4, 0x91, 0xa8, 0x54, 0xc6, 0xc7, 0x51,
-	0x44, 0x24, 0xc6, 0x51, 0x04, 0x54, 0xc5, 0x51, 0x84, 0xe4, 0xc9, 0xe0, 0x23, 0xbd, 0x67, 0x1a,
-	0xb4, 0x98, 0x3a, 0x3f, 0x26, 0x19, 0x44, 0x14, 0x51, 0x32, 0x88, 0x20, 0x23, 0xc9, 0x20, 0xa6,
-	0xcd, 0x43, 0x96, 0x8a, 0xa8, 0x7d, 0x53, 0x84, 0x39, 0xc5, 0x56, 0x43, 0x18, 0xa6, 0xc3, 0x7d,
-	0xd4, 0x32, 0x49, 0x92, 0x48, 0xab, 0xac, 0xfc, 0x41, 0xd0, 0xc6, 0xae, 0x85, 0x7d, 0xec, 0x85,
-	0x32, 0x68, 0x96, 0xa0, 0x2b, 0x71, 0x05, 0x88, 0x50, 0xdb, 0x4d, 0x89, 0x70, 0xf4, 0x8d, 0x06,
-	0x95, 0xbe, 0x7e, 0xd8, 0x0a, 0x81, 0x5e, 0x6b, 0xd7, 0x76, 0x5b, 0x0e, 0x76, 0x4d, 0xdb, 0xa0,
-	0x95, 0x6c, 0xe9, 0xda, 0x3f, 0x1e, 0x9b, 0x17, 0xea, 0x9b, 0xfa, 0x61, 0x08, 0xf6, 0xde, 0xb7,
-	0xdd, 0x2d, 0xca, 0xbe, 0x6e, 0xf9, 0xee, 0x11, 0x4b, 0x58, 0x7d, 0x15, 0x5e, 0x58, 0xd3, 0x82,
-	0x92, 0x00, 0xfd, 0xa7, 0x06, 0x8b, 0xbe, 0xed, 0xeb, 0xbd, 0x56, 0x27, 0xe8, 0x07, 0x3d, 0xdd,
-	0x37, 0x0f, 0x70, 0x2b, 0xf0, 0xf4, 0x2e, 0xe6, 0x65, 0xf3, 0x3b, 0xc7, 0x2f, 0xed, 0x3e, 0xe1,
-	0xbf, 0x1e, 0xb1, 0xef, 0x10, 0x6e, 0xb6, 0xb2, 0xda, 0x70, 0x50, 0x5d, 0xf6, 0x15, 0x68, 0x61,
-	0x61, 0xf3, 0x2a, 0x3c, 0x7a, 0x19, 0x72, 0xa4, 0xad, 0x30, 0x0d, 0x5a, 0x1d, 0xf1, 0x16, 0xe4,
-	0xa1, 0xdd, 0x96, 0x1a, 0x83, 0x2c, 0x05, 0x10, 0x5a, 0x37, 0xb0, 0x08, 0x6d, 0x3e, 0xa6, 0x75,
-	0x03, 0x4b, 0xa6, 0xa5, 0x00, 0xea, 0x0c, 0xfd, 0xa0, 0xab, 0x76, 0x46, 0x61, 0x52, 0x67, 0xac,
-	0x1d, 0x74, 0x1f, 0xe9, 0x0c, 0x5d, 0x85, 0x17, 0x9d, 0xa1, 0x24, 0x58, 0xfa, 0x56, 0x83, 0xa5,
-	0xf1, 0x7e, 0x46, 0x97, 0x20, 0xbd, 0x8f, 0x8f, 0x78, 0x4f, 0x76, 0x76, 0x38, 0xa8, 0x4e, 0xef,
-	0xe3, 0x23, 0x41, 0x2a, 0xc1, 0xa2, 0x7f, 0x86, 0xec, 0x81, 0xde, 0x0b, 0x30, 0x2f, 0xf9, 0xeb,
-	0x75, 0xd6, 0x4e, 0xd6, 0xc5, 0x76, 0xb2, 0xee, 0xec, 0x77, 0x09, 0xa0, 0x1e, 0x5a, 0xa1, 0xfe,
-	0x61, 0xa0, 0x5b, 0xbe, 0xe9, 0x1f, 0x31, 0xdb, 0x51, 0x01, 0xa2, 0xed, 0x28, 0xe0, 0xed, 0xd4,
-	0x9b, 0xda, 0xd2, 0x7f, 0x6b, 0x70, 0x7e, 0xac, 0xbf, 0x9f, 0x89, 0x15, 0x12, 0x23, 0x8e, 0xf7,
-	0xcf, 0xb3, 0xb0, 0xc4, 0x8d, 0x4c, 0x41, 0x2b, 0xa7, 0x36, 0x32, 0x85, 0x54, 0x39, 0x5d, 0xfb,
-	0x45, 0x0e, 0x8a, 0x51, 0x83, 0x87, 0x6e, 0x41, 0xd9, 0xc0, 0x46, 0xe0, 0xf4, 0xcc, 0x0e, 0x8d,
-	0x34, 0x12, 0xd4, 0xac, 0xa3, 0xa6, 0xd9, 0x55, 0xc2, 0x49, 0xe1, 0x3d, 0x9b, 0x40, 0xa1, 0x6b,
-	0x50, 0xe0, 0x8d, 0xcc, 0x11, 0xcd, 0x6b, 0xd3, 0x8d, 0xc5, 0xe1, 0xa0, 0x8a, 0x42, 0x98, 0xc0,
-	0x1a, 0xd1, 0xa1, 0x26, 0x00, 0x9b, 0x0c, 0x6c, 0x62, 0x5f, 0xe7, 0x2d, 0x55, 0x45, 0xde, 0x0d,
-	0xf7, 0x22, 0x3c, 0xeb, 0xf1, 0x63, 0x7a, 0xb1, 0xc7, 0x8f, 0xa1, 0xe8, 0x63, 0x80, 0xbe, 0x6e,
-	0x5a, 0x8c, 0x8f, 0xf7, 0x4f, 0xb5, 0x71, 0x19, 0x76, 0x33, 0xa2, 0x64, 0xd2, 0x63, 0x4e, 0x51,
-	0x7a, 0x0c, 0x45, 0xf7, 0x20, 0xcf, 0x67, 0x19, 0x95, 0x1c, 0xdd, 0xbc, 0xcb, 0xe3, 0x44, 0x73,
-	0xb1, 0xb4, 0x1b, 0xe7, 0x2c, 0x62, 0x37, 0xce, 0x41, 0xc4, 0x6c, 0x3d, 0x73, 0x17, 0xfb, 0x66,
-	0x1f, 0xd3, 0x6c, 0xc2, 0xcd, 0x16, 0xc2, 0x44, 0xb3, 0x85, 0x30, 0xf4, 0x26, 0x80, 0xee, 0x6f,
-	0xda, 0x9e, 0x7f, 0xcf, 0xea, 0x60, 0xda, 0x11, 0x15, 0xd8, 0xf2, 0x63, 0xa8, 0xb8, 0xfc, 0x18,
-	0x8a, 0xde, 0x81, 0x92, 0xc3, 0xbf, 0xc0, 0xed, 0x1e, 0xa6, 0x1d, 0x4f, 0x81, 0x15, 0x0c, 0x02,
-	0x58, 0xe0, 0x15, 0xa9, 0xd1, 0x4d, 0x98, 0xed, 0xd8, 0x56, 0x27, 0x70, 0x5d, 0x6c, 0x75, 0x8e,
-	0xb6, 0xf5, 0x5d, 0x4c, 0xbb, 0x9b, 0x02, 0x0b, 0x95, 0x04, 0x4a, 0x0c, 0x95, 0x04, 0x0a, 0xbd,
-	0x01, 0xc5, 0x68, 0x32, 0x44, 0x1b, 0x98, 0x22, 0x1f, 0x34, 0x84, 0x40, 0x81, 0x39, 0xa6, 0x24,
-	0x8b, 0x37, 0xbd, 0x1b, 0x3c, 0xe8, 0x30, 0x6d, 0x4a, 0xf8, 0xe2, 0x05, 0xb0, 0xb8, 0x78, 0x01,
-	0x2c, 0xe4, 0xf7, 0x99, 0xe3, 0xf2, 0x7b, 0xb4, 0x5d, 0xa6, 0xcb, 0x33, 0x1b, 0x99, 0xc2, 0x6c,
-	0xb9, 0x5c, 0xfb, 0x8d, 0x06, 0xf3, 0xaa, 0xa8, 0x49, 0x44, 0xb0, 0xf6, 0x44, 0x22, 0xf8, 0x23,
-	0x28, 0x38, 0xb6, 0xd1, 0xf2, 0x1c, 0xdc, 0xe1, 0xf9, 0x20, 0x11, 0xbf, 0x5b, 0xb6, 0xb1, 0xed,
-	0xe0, 0xce, 0x3f, 0x99, 0xfe, 0xde, 0xda, 0x81, 0x6d, 0x1a, 0x77, 0x4c, 0x8f, 0x07, 0x9a, 0xc3,
-	0x30, 0x52, 0x91, 0x92, 0xe7, 0xc0, 0x46, 0x01, 0x72, 0x4c, 0x4b, 0xed, 0xb7, 0x69, 0x28, 0x27,
-	0x23, 0xf5, 0xaf, 0xe9, 0x55, 0xd0, 0x03, 0xc8, 0x9b, 0xac, 0x07, 0xe2, 0x35, 0xd4, 0xdf, 0x09,
-	0x19, 0xb3, 0x1e, 0x0f, 0x44, 0xeb, 0x07, 0xaf, 0xd5, 0x79, 0xb3, 0x44, 0x4d, 0x40, 0x25, 0x73,
-	0x4e, 0x59, 0x32, 0x07, 0xa2, 0x26, 0xe4, 0x3d, 0xec, 0x1e, 0x98, 0x1d, 0xcc, 0xf3, 0x51, 0x55,
-	0x94, 0xdc, 0xb1, 0x5d, 0x4c, 0x64, 0x6e, 0x33, 0x92, 0x58, 0x26, 0xe7, 0x91, 0x65, 0x72, 0x20,
-	0xfa, 0x08, 0x8a, 0x1d, 0xdb, 0xda, 0x35, 0xbb, 0x9b, 0xba, 0xc3, 0x33, 0xd2, 0x05, 0x95, 0xd4,
-	0xeb, 0x21, 0x11, 0x9f, 0xeb, 0x84, 0x8f, 0x89, 0xb9, 0x4e, 0x44, 0x15, 0x3b, 0xf4, 0xcf, 0x19,
-	0x80, 0xd8, 0x39, 0xe8, 0x2d, 0x28, 0xe1, 0x43, 0xdc, 0x09, 0x7c, 0x9b, 0xce, 0x3a, 0xb5, 0x78,
-	0x44, 0x1a, 0x82, 0xa5, 0xb0, 0x87, 0x18, 0x4a, 0xf6, 0xa6, 0xa5, 0xf7, 0xb1, 0xe7, 0xe8, 0x9d,
-	0x70, 0xb6, 0x4a, 0x17, 0x13, 0x01, 0xc5, 0xbd, 0x19, 0x01, 0xd1, 0x8b, 0x90, 0xa1, 0xd3, 0x58,
-	0x36, 0x56, 0x45, 0xc3, 0x41, 0x75, 0xc6, 0x92, 0xe7, 0xb0, 0x14, 0x8f, 0xde, 0x83, 0xe9, 0xfd,
-	0x28, 0xf0, 0xc8, 0xda, 0x32, 0x94, 0x81, 0x16, 0xb7, 0x31, 0x42, 0x5a, 0xdd, 0x94, 0x08, 0x47,
-	0xbb, 0x50, 0xd2, 0x2d, 0xcb, 0xf6, 0xe9, 0x67, 0x27, 0x1c, 0xb5, 0x5e, 0x19, 0x17, 0xa6, 0xf5,
-	0xb5, 0x98, 0x96, 0x95, 0x4b, 0x34, 0x5f, 0x08, 0x12, 0xc4, 0x7c, 0x21, 0x80, 0x51, 0x13, 0x72,
-	0x3d, 0xbd, 0x8d, 0x7b, 0x61, 0x9e, 0x7f, 0x61, 0xac, 0x8a, 0x3b, 0x94, 0x8c, 0x49, 0xa7, 0x03,
-	0x5d, 0xc6, 0x27, 0x0e, 0x74, 0x19, 0x64, 0x69, 0x17, 0xca, 0xc9, 0xf5, 0x4c, 0x56, 0x1e, 0x5c,
-	0x11, 0xcb, 0x83, 0xe2, 0xb1, 0x15, 0x89, 0x0e, 0x25, 0x61, 0x51, 0xa7, 0xa1, 0xa2, 0xf6, 0xbf,
-	0x1a, 0xcc, 0xab, 0xf6, 0x2e, 0xda, 0x14, 0x76, 0xbc, 0xc6, 0xc7, 0x46, 0x8a, 0x50, 0xe7, 0xbc,
-	0x63, 0xb6, 0x7a, 0xbc, 0xd1, 0x1b, 0x30, 0x63, 0xd9, 0x06, 0x6e, 0xe9, 0x44, 0x41, 0xcf, 0xf4,
-	0xfc, 0x4a, 0x8a, 0x8e, 0xe2, 0xe9, 0xb8, 0x89, 0x60, 0xd6, 0x42, 0x84, 0xc0, 0x3d, 0x2d, 0x21,
-	0x6a, 0x9f, 0xc1, 0x6c, 0x62, 0x18, 0x2c, 0x15, 0x2b, 0xa9, 0x09, 0x8b, 0x95, 0xf8, 0x0b, 0x92,
-	0x9e, 0xec, 0x0b, 0x52, 0xfb, 0xf7, 0x14, 0x94, 0x84, 0xce, 0x1c, 0x3d, 0x84, 0x59, 0xfe, 0x35,
-	0x33, 0xad, 0x2e, 0xeb, 0x00, 0x53, 0x7c, 0x4c, 0x34, 0x72, 0x52, 0xb2, 0x61, 0xb7, 0xb7, 0x23,
-	0x5a, 0xda, 0x00, 0xd2, 0x29, 0x9e, 0x27, 0xc1, 0x04, 0xc5, 0x33, 0x32, 0x06, 0x3d, 0x80, 0xc5,
-	0xc0, 0x21, 0x7d, 0x69, 0xcb, 0xe3, 0x67, 0x0e, 0x2d, 0x2b, 0xe8, 0xb7, 0xb1, 0x4b, 0x57, 0x9f,
-	0x65, 0x9d, 0x12, 0xa3, 0x08, 0x0f, 0x25, 0xee, 0x52, 0xbc, 0xd8, 0x29, 0xa9, 0xf0, 0x82, 0x1d,
-	0x32, 0x13, 0xda, 0xe1, 0x16, 0xa0, 0xd1, 0x69, 0xbc, 0xe4, 0x03, 0x6d, 0x32, 0x1f, 0xd4, 0x0e,
-	0xa1, 0x9c, 0x9c, 0xb1, 0x3f, 0x25, 0x5f, 0xee, 0x43, 0x31, 0x9a, 0x90, 0xa3, 0x57, 0x20, 0xe7,
-	0x62, 0xdd, 0xb3, 0x2d, 0xbe, 0x5b, 0xe8, 0xb6, 0x67, 0x10, 0x71, 0xdb, 0x33, 0xc8, 0x63, 0x28,
-	0xbb, 0x0f, 0x53, 0xcc, 0x48, 0xef, 0x9b, 0x3d, 0x1f, 0xbb, 0xe8, 0x06, 0xe4, 0x3c, 0x5f, 0xf7,
-	0xb1, 0x57, 0xd1, 0x56, 0xd2, 0x97, 0x67, 0xae, 0x2d, 0x8e, 0x8e, 0xbf, 0x09, 0x9a, 0xad, 0x83,
-	0x51, 0x8a, 0xeb, 0x60, 0x90, 0xda, 0xbf, 0x69, 0x30, 0x25, 0x4e, 0xf9, 0x9f, 0x8c, 0xd8, 0x93,
-	0x19, 0x83, 0x24, 0x8e, 0x29, 0xf1, 0x30, 0xe0, 0xf4, 0x6c, 0x49, 0xbe, 0x82, 0xec, 0x28, 0xa1,
-	0x15, 0x78, 0xd8, 0xe5, 0xd1, 0x4a, 0xbf, 0x82, 0x0c, 0xbc, 0xe3, 0x49, 0xd1, 0x0e, 0x31, 0x94,
-	0xbb, 0x81, 0xac, 0x55, 0x3c, 0x5a, 0x40, 0xdd, 0x78, 0x80, 0x43, 0x36, 0x99, 0x47, 0x93, 0xd1,
-	0xa4, 0x03, 0x1c, 0x9a, 0xb2, 0x24, 0x76, 0x31, 0x65, 0x49, 0x88, 0xc7, 0x08, 0x99, 0x6f, 0xb3,
-	0x74, 0xad, 0xf1, 0x51, 0x41, 0xa2, 0x06, 0x48, 0x9f, 0xa0, 0x06, 0x78, 0x15, 0xf2, 0x34, 0xe9,
-	0x46, 0x5b, 0x9c, 0xfa, 0x84, 0x80, 0xe4, 0x63, 0x52, 0x06, 0x79, 0x44, 0xaa, 0xc9, 0xfe, 0xc4,
-	0x54, 0xd3, 0x82, 0xf3, 0x7b, 0xba, 0xd7, 0x0a, 0x93, 0xa3, 0xd1, 0xd2, 0xfd, 0x56, 0xb4, 0xd7,
-	0x73, 0xb4, 0xfe, 0xa7, 0xc3, 0xc7, 0x3d, 0xdd, 0xdb, 0x0e, 0x69, 0xd6, 0xfc, 0xad, 0xd1, 0x9d,
-	0xbf, 0xa8, 0xa6, 0x40, 0x3b, 0xb0, 0xa0, 0x16, 0x9e, 0xa7, 0x2b, 0xa7, 0xb3, 0x71, 0xef, 0x91,
-	0x92, 0xe7, 0x14, 0x68, 0xf4, 0xa5, 0x06, 0x15, 0xf2, 0x15, 0x74, 0xf1, 0xa7, 0x81, 0xe9, 0xe2,
-	0x3e, 0x09, 0x8b, 0x96, 0x7d, 0x80, 0xdd, 0x9e, 0x7e, 0xc4, 0x8f, 0x99, 0x2e, 0x8e, 0xa6, 0xfc,
-	0x2d, 0xdb, 0x68, 0x0a, 0x0c, 0xec, 0xd5, 0x1c, 0x19, 0x78, 0x8f, 0x09, 0x11, 0x5f, 0x4d, 0x4d,
-	0x21, 0x84, 0x10, 0x9c, 0x60, 0xa0, 0x55, 0x3a, 0x76, 0xa0, 0xf5, 0x22, 0x64, 0x1c, 0xdb, 0xee,
-	0xd1, 0xf6, 0x8b, 0x57, 0x7a, 0xe4, 0x59, 0xac, 0xf4, 0xc8, 0xb3, 0x38, 0x73, 0xd8, 0xc8, 0x14,
-	0x0a, 0xe5, 0x62, 0xed, 0x7b, 0x0d, 0x66, 0xe4, 0x93, 0xa9, 0xd1, 0x0d, 0x95, 0x3e, 0xf5, 0x0d,
-	0x95, 0x39, 0x81, 0x35, 0xb2, 0xc7, 0x59, 0x43, 0x9a, 0xac, 0xfc, 0x41, 0x83, 0x69, 0xe9, 0x50,
-	0xec, 0xe7, 0xf5, 0x7a, 0xff, 0x95, 0x82, 0x45, 0xf5, 0x52, 0x4f, 0xa5, 0x75, 0xbc, 0x05, 0xa4,
-	0x08, 0xbc, 0x1d, 0x17, 0x49, 0x0b, 0x23, 0x9d, 0x23, 0x35, 0x53, 0x58, 0x41, 0x8e, 0x9c, 0x97,
-	0x85, 0xec, 0xe8, 0x01, 0x94, 0x4c, 0xe1, 0x64, 0x2e, 0xad, 0x3a, 0x40, 0x11, 0xcf, 0xe3, 0xd8,
-	0x48, 0x61, 0xcc, 0x29, 0x9c, 0x28, 0xaa, 0x91, 0x83, 0x0c, 0xa9, 0xe2, 0x6a, 0x07, 0x90, 0xe7,
-	0xcb, 0x41, 0xaf, 0x43, 0x91, 0xe6, 0x4e, 0xda, 0x0d, 0xb1, 0x92, 0x9b, 0x96, 0x23, 0x04, 0x98,
-	0xb8, 0x99, 0x52, 0x08, 0x61, 0xe8, 0x1f, 0x00, 0x48, 0xba, 0xe0, 0x59, 0x33, 0x45, 0x73, 0x0f,
-	0xed, 0xba, 0x1c, 0xdb, 0x18, 0x49, 0x95, 0xc5, 0x08, 0x58, 0xfb, 0xff, 0x14, 0x94, 0xc4, 0xb3,
-	0xc0, 0xc7, 0x52, 0xfe, 0x39, 0x84, 0x1d, 0x71, 0x4b, 0x37, 0x0c, 0xf2, 0x17, 0x87, 0x1f, 0xb6,
-	0xd5, 0xb1, 0x46, 0x0a, 0xff, 0x5f, 0x0b, 0x39, 0x58, 0xff, 0x43, 0xef, 0x3b, 0x98, 0x09, 0x94,
-	0xa0, 0xb5, 0x9c, 0xc4, 0x2d, 0xed, 0xc3, 0x82, 0x52, 0x94, 0xd8, 0xb5, 0x64, 0x9f, 0x54, 0xd7,
-	0xf2, 0x3f, 0x59, 0x58, 0x50, 0x9e, 0xc1, 0x26, 0x22, 0x38, 0xfd, 0x44, 0x22, 0xf8, 0x3f, 0x34,
-	0x95, 0x65, 0xd9, 0x01, 0xcc, 0x5b, 0x13, 0x1c, 0x0c, 0x3f, 0x29, 0x1b, 0xcb, 0x61, 0x91, 0x7d,
-	0xac, 0x98, 0xcc, 0x4d, 0x1a, 0x93, 0xe8, 0x2a, 0x6b, 0x00, 0xa9, 0x2e, 0x76, 0x3c, 0x12, 0xee,
-	0xd0, 0x84, 0xaa, 0x3c, 0x07, 0xa1, 0xf7, 0x60, 0x3a, 0xe4, 0x60, 0x63, 0x87, 0x42, 0x3c, 0x13,
-	0xe0, 0x34, 0xc9, 0xc9, 0xc3, 0x94, 0x08, 0x17, 0xb2, 0x5f, 0xf1, 0x04, 0xd9, 0x0f, 0x8e, 0xcb,
-	0x7e, 0x4f, 0x35, 0x36, 0xa5, 0x54, 0x3b, 0xd0, 0x60, 0x36, 0x71, 0xf5, 0xe1, 0xe7, 0xf5, 0x2d,
-	0xf9, 0x42, 0x83, 0x62, 0x74, 0xb3, 0x06, 0xad, 0x41, 0x0e, 0xb3, 0xdb, 0x19, 0x2c, 0xed, 0xcc,
-	0x25, 0x6e, 0xce, 0x11, 0x1c, 0xbf, 0x2b, 0x97, 0xb8, 0x90, 0xd1, 0xe4, 0x8c, 0x8f, 0x51, 0x30,
-	0xff, 0x52, 0x0b, 0x0b, 0xe6, 0x91, 0x55, 0xa4, 0x7f, 0xfa, 0x2a, 0x4e, 0xcf, 0x74, 0xdf, 0x17,
-	0x20, 0x4b, 0xd7, 0x42, 0x1a, 0x5f, 0x1f, 0xbb, 0x7d, 0xd3, 0xd2, 0x7b, 0x34, 0x14, 0x0b, 0x6c,
-	0x57, 0x87, 0x30, 0x71, 0x57, 0x87, 0x30, 0xb4, 0x07, 0xb3, 0xf1, 0x38, 0x8d, 0x8a, 0x51, 0x5f,
-	0xd5, 0xfb, 0x40, 0x26, 0x62, 0x23, 0xfe, 0x04, 0xa7, 0x7c, 0xd6, 0x9e, 0x40, 0x22, 0x03, 0x66,
-	0x3a, 0xb6, 0xe5, 0xeb, 0xa6, 0x85, 0x5d, 0xa6, 0x28, 0xad, 0xba, 0xaa, 0x74, 0x5d, 0xa2, 0x61,
-	0x43, 0x0e, 0x99, 0x4f, 0xbe, 0xaa, 0x24, 0xe3, 0xd0, 0x27, 0x30, 0x1d, 0x36, 0x2e, 0x4c, 0x49,
-	0x46, 0x75, 0x55, 0x69, 0x5d, 0x24, 0x61, 0x9b, 0x41, 0xe2, 0x92, 0xaf, 0x2a, 0x49, 0x28, 0xf4,
-	0x31, 0x4c, 0xf5, 0x48, 0x47, 0xb5, 0x7e, 0xe8, 0x98, 0x2e, 0x36, 0xd4, 0x97, 0xe7, 0xee, 0x08,
-	0x14, 0x2c, 0x71, 0x89, 0x3c, 0xf2, 0x9d, 0x01, 0x11, 0x43, 0xfc, 0xd1, 0xd7, 0x0f, 0x9b, 0x81,
-	0xe5, 0xad, 0x1f, 0xf2, 0x8b, 0x50, 0x79, 0x95, 0x3f, 0x36, 0x65, 0x22, 0xe6, 0x8f, 0x04, 0xa7,
-	0xec, 0x8f, 0x04, 0x12, 0xdd, 0xa1, 0x79, 0x99, 0x19, 0x89, 0x5d, 0xa2, 0x5b, 0x1c, 0x29, 0xa8,
-	0x98, 0x7d, 0xd8, 0xf8, 0x84, 0x3f, 0x49, 0x42, 0x23, 0x09, 0xa8, 0x07, 0x65, 0xc7, 0x36, 0xe8,
-	0x6b, 0x37, 0xb1, 0x1f, 0xb8, 0x16, 0x36, 0x78, 0x63, 0xb3, 0x3c, 0x22, 0x55, 0xa2, 0x62, 0x9f,
-	0xaf, 0x24, 0xaf, 0x7c, 0x25, 0x32, 0x89, 0x45, 0x9f, 0xc3, 0x7c, 0xe2, 0x4a, 0x10, 0x7b, 0x8f,
-	0x92, 0xea, 0x48, 0x61, 0x43, 0x41, 0xc9, 0x7a, 0x50, 0x95, 0x0c, 0x49, 0xb3, 0x52, 0x0b, 0xd1,
-	0xde, 0xd5, 0xad, 0xee, 0x86, 0xdd, 0xde, 0xb1, 0x78, 0xd3, 0xa6, 0xb7, 0x7b, 0x98, 0xdf, 0x8a,
-	0x4b, 0x68, 0xbf, 0xa9, 0xa0, 0x64, 0xda, 0x55, 0x32, 0x64, 0xed, 0x2a, 0x8a, 0xe8, 0xfa, 0x0f,
-	0x29, 0x2b, 0xa2, 0x6b, 0x72, 0xaa, 0xeb, 0x3f, 0x8c, 0x40, 0xb8, 0xfe, 0xc3, 0x00, 0x8a, 0xeb,
-	0x3f, 0x9c, 0xb2, 0x10, 0x4e, 0x5e, 0x36, 0x32, 0x85, 0x6c, 0x39, 0xb7, 0x91, 0x29, 0x40, 0xb9,
-	0x54, 0xfb, 0x10, 0x66, 0x13, 0x9b, 0x1f, 0xbd, 0x0b, 0xd1, 0x05, 0x93, 0xfb, 0x47, 0x4e, 0x58,
-	0x59, 0x4a, 0x17, 0x52, 0x08, 0x5c, 0x75, 0x21, 0x85, 0xc0, 0x6b, 0x5f, 0x67, 0xa0, 0x10, 0x46,
-	0xd7, 0xa9, 0xf4, 0x0a, 0xab, 0x90, 0xef, 0x63, 0x8f, 0x5e, 0x22, 0x49, 0xc5, 0x25, 0x07, 0x07,
-	0x89, 0x25, 0x07, 0x07, 0xc9, 0x15, 0x51, 0xfa, 0xb1, 0x2a, 0xa2, 0xcc, 0xc4, 0x15, 0x11, 0xa6,
-	0xe7, 0xa6, 0x42, 0xd6, 0x0a, 0x8f, 0x2d, 0x1e, 0x9d, 0x0a, 0xc3, 0x53, 0x55, 0x91, 0x31, 0x71,
-	0xaa, 0x2a, 0xa2, 0xd0, 0x3e, 0x9c, 0x15, 0x8e, 0x56, 0xf8, 0x4c, 0x8d, 0x64, 0xab, 0x99, 0xf1,
-	0x87, 0xd4, 0x4d, 0x4a, 0xc5, 0xf6, 0xe4, 0x7e, 0x02, 0x2a, 0x96, 0x94, 0x49, 0x1c, 0x09, 0x09,
-	0x03, 0xb7, 0x83, 0xee, 0x26, 0x37, 0x7b, 0x3e, 0x0e, 0x09, 0x11, 0x2e, 0x86, 0x84, 0x08, 0xaf,
-	0xfd, 0x29, 0x05, 0x33, 0xf2, 0xfb, 0x9e, 0x4a, 0x60, 0xbc, 0x0e, 0x45, 0x7c, 0x68, 0xfa, 0xad,
-	0x8e, 0x6d, 0x60, 0xde, 0x57, 0x51, 0x3f, 0x13, 0xe0, 0x75, 0xdb, 0x90, 0xfc, 0x1c, 0xc2, 0xc4,
-	0x68, 0x4a, 0x4f, 0x14, 0x4d, 0xf1, 0x08, 0x33, 0x33, 0xc1, 0x08, 0x53, 0xe9, 0xa7, 0xe2, 0xe9,
-	0xf8, 0xa9, 0xf6, 0x5d, 0x0a, 0xca, 0xc9, 0x14, 0xfc, 0x6c, 0x6c, 0x41, 0x79, 0x37, 0xa5, 0x27,
-	0xde, 0x4d, 0xef, 0xc1, 0x34, 0xa9, 0x9b, 0x74, 0xdf, 0xe7, 0x77, 0x4e, 0x33, 0xb4, 0xf4, 0x61,
-	0xd9, 0x28, 0xb0, 0xd6, 0x42, 0xb8, 0x94, 0x8d, 0x04, 0xf8, 0x48, 0xe8, 0x66, 0x4f, 0x18, 0xba,
-	0x5f, 0xa6, 0x60, 0x7a, 0xcb, 0x36, 0xee, 0xb3, 0x92, 0xca, 0x7f, 0x56, 0xec, 0xf9, 0x34, 0x53,
-	0x5a, 0x6d, 0x16, 0xa6, 0xa5, 0x9a, 0xaa, 0xf6, 0x15, 0x8b, 0x33, 0xf9, 0xd3, 0xf5, 0xb7, 0x67,
-	0x97, 0x19, 0x98, 0x12, 0x4b, 0xc1, 0x5a, 0x03, 0x66, 0x13, 0x95, 0x9b, 0xf8, 0x02, 0xda, 0x24,
-	0x2f, 0x50, 0xbb, 0x01, 0xf3, 0xaa, 0x92, 0x46, 0xc8, 0x3a, 0xda, 0x04, 0xe7, 0x2e, 0x37, 0x61,
-	0x5e, 0x55, 0x9a, 0x9c, 0x7c, 0x39, 0xef, 0xf2, 0x33, 0x4d, 0x56, 0x44, 0x9c, 0x9c, 0xff, 0x77,
-	0x51, 0x6f, 0x1b, 0xdf, 0xef, 0x7e, 0x1f, 0xca, 0x4e, 0xf8, 0xd0, 0xe2, 0x1d, 0x14, 0xdb, 0x96,
-	0xb4, 0x1f, 0x88, 0x70, 0x1b, 0x89, 0x56, 0x6a, 0x46, 0xc6, 0xc8, 0x72, 0x78, 0x77, 0x95, 0x53,
-	0xc8, 0x69, 0x26, 0xda, 0xac, 0x19, 0x19, 0x23, 0x98, 0x36, 0x7f, 0xbc, 0x69, 0x69, 0x77, 0x96,
-	0x25, 0x2d, 0xed, 0x6c, 0xe2, 0xfe, 0x39, 0xba, 0x0a, 0x05, 0xfa, 0xe3, 0xb0, 0xb8, 0x2f, 0xa5,
-	0xd6, 0xa1, 0x30, 0x69, 0x01, 0x79, 0x0e, 0x42, 0x6f, 0x40, 0x31, 0xba, 0x92, 0xce, 0x4f, 0x33,
-	0x59, 0xdc, 0x85, 0x40, 0x29, 0xee, 0x42, 0x20, 0x6f, 0x69, 0xff, 0x15, 0xce, 0x8f, 0xbd, 0x8c,
-	0x7e, 0xa2, 0x93, 0xb3, 0xb8, 0x37, 0xcd, 0x9c, 0xa8, 0x37, 0x3d, 0x84, 0x45, 0xf5, 0x1d, 0x71,
-	0x41, 0x7b, 0xea, 0x58, 0xed, 0xb1, 0xf5, 0xd3, 0x13, 0x5a, 0x3f, 0x55, 0xdb, 0xa7, 0xcd, 0x7c,
-	0x74, 0x17, 0x1b, 0x5d, 0x81, 0xac, 0x63, 0xdb, 0x3d, 0x8f, 0x5f, 0x17, 0xa0, 0xea, 0x28, 0x40,
-	0x54, 0x47, 0x01, 0x8f, 0x31, 0x3a, 0x08, 0xc2, 0x08, 0x8e, 0x6f, 0x96, 0x3f, 0x05, 0xeb, 0xbe,
-	0x7c, 0x15, 0x0a, 0xe1, 0x91, 0x2c, 0x02, 0xc8, 0x7d, 0xb8, 0xb3, 0xbe, 0xb3, 0x7e, 0xa3, 0x7c,
-	0x06, 0x95, 0x20, 0xbf, 0xb5, 0x7e, 0xf7, 0xc6, 0xed, 0xbb, 0x37, 0xcb, 0x1a, 0x79, 0x68, 0xee,
-	0xdc, 0xbd, 0x4b, 0x1e, 0x52, 0x2f, 0xdf, 0x11, 0xaf, 0x79, 0xf1, 0xca, 0x6d, 0x0a, 0x0a, 0x6b,
-	0x8e, 0x43, 0x53, 0x08, 0xe3, 0x5d, 0x3f, 0x30, 0xc9, 0x4e, 0x2e, 0x6b, 0x28, 0x0f, 0xe9, 0x7b,
-	0xf7, 0x36, 0xcb, 0x29, 0x34, 0x0f, 0xe5, 0x1b, 0x58, 0x37, 0x7a, 0xa6, 0x85, 0xc3, 0xbc, 0x55,
-	0x4e, 0x37, 0x1e, 0xfe, 0xfa, 0x87, 0x65, 0xed, 0xbb, 0x1f, 0x96, 0xb5, 0x3f, 0xfe, 0xb0, 0xac,
-	0x7d, 0xfd, 0xe3, 0xf2, 0x99, 0xef, 0x7e, 0x5c, 0x3e, 0xf3, 0xfb, 0x1f, 0x97, 0xcf, 0xfc, 0xcb,
-	0xd5, 0xae, 0xe9, 0xef, 0x05, 0xed, 0x7a, 0xc7, 0xee, 0xf3, 0x5f, 0xb9, 0x3a, 0xae, 0x4d, 0x12,
-	0x04, 0x7f, 0x5a, 0x4d, 0xfe, 0xfc, 0xf5, 0xff, 0x52, 0x17, 0xd6, 0xe8, 0xe3, 0x16, 0xa3, 0xab,
-	0xdf, 0xb6, 0xeb, 0x0c, 0x40, 0x7f, 0xf0, 0xe8, 0xb5, 0x73, 0xf4, 0x87, 0x8d, 0xaf, 0xff, 0x25,
-	0x00, 0x00, 0xff, 0xff, 0x5c, 0x9c, 0x29, 0x15, 0x39, 0x3b, 0x00, 0x00,
+	// 4291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0xcd, 0x6f, 0x23, 0x47,
+	0x76, 0x9f, 0xe6, 0x37, 0x1f, 0x45, 0x89, 0x53, 0xfa, 0x18, 0x4a, 0xf6, 0x88, 0x1a, 0x7a, 0xbc,
+	0x3b, 0x63, 0x78, 0x29, 0xaf, 0x9c, 0x5d, 0x78, 0xbd, 0x0b, 0x1b, 0xa4, 0xa4, 0xb1, 0x25, 0x4b,
+	0x1a, 0x99, 0x1a, 0x79, 0x3d, 0x89, 0x01, 0x6e, 0x93, 0x5d, 0xe2, 0xf4, 0x88, 0xec, 0xa6, 0xfb,
+	0x43, 0x2b, 0x2d, 0x0c, 0xc4, 0x1b, 0x6c, 0x82, 0x5c, 0x82, 0x38, 0x01, 0x02, 0x04, 0xbe, 0x64,
+	0x2f, 0x39, 0x6c, 0x80, 0x1c, 0x72, 0x48, 0x80, 0xdc, 0x72, 0xcc, 0x21, 0x07, 0x5f, 0x02, 0xe4,
+	0x90, 0x25, 0x02, 0x1b, 0xb9, 0xf0, 0x90, 0xbf, 0x21, 0xa8, 0x8f, 0xee, 0xae, 0x6a, 0x16, 0xf5,
+	0xb5, 0x33, 0x86, 0xe3, 0x9c, 0x66, 0xfa, 0xf7, 0xbe, 0xaa, 0xab, 0x5e, 0xbf, 0x7a, 0xf5, 0xea,
+	0x51, 0x70, 0x7b, 0x70, 0xdc, 0x5d, 0xd5, 0x9d, 0xbe, 0x6e, 0xe8, 0xf8, 0x04, 0x5b, 0x9e, 0xbb,
+	0xca, 0xfe, 0xa9, 0x0d, 0x1c, 0xdb, 0xb3, 0xd1, 0x94, 0x48, 0x5a, 0xaa, 0x1e, 0xbf, 0xe1, 0xd6,
+	0x4c, 0x7b, 0x55, 0x1f, 0x98, 0xab, 0x1d, 0xdb, 0xc1, 0xab, 0x27, 0xdf, 0x5f, 0xed, 0x62, 0x0b,
+	0x3b, 0xba, 0x87, 0x0d, 0x26, 0xb1, 0x74, 0x4f, 0xe0, 0xb1, 0xb0, 0xf7, 0x73, 0xdb, 0x39, 0x36,
+	0xad, 0xae, 0x8a, 0xb3, 0xd2, 0xb5, 0xed, 0x6e, 0x0f, 0xaf, 0xd2, 0xa7, 0xb6, 0x7f, 0xb4, 0xea,
+	0x99, 0x7d, 0xec, 0x7a, 0x7a, 0x7f, 0xc0, 0x19, 0x7e, 0x2f, 0x52, 0xd5, 0xd7, 0x3b, 0x4f, 0x4c,
+	0x0b, 0x3b, 0x67, 0xab, 0x74, 0xbc, 0x03, 0x73, 0xd5, 0xc1, 0xae, 0xed, 0x3b, 0x1d, 0x3c, 0xa6,
+	0xf6, 0x4d, 0xd3, 0xf2, 0xb0, 0x63, 0xe9, 0xbd, 0x55, 0xb7, 0xf3, 0x04, 0x1b, 0x7e, 0x0f, 0x3b,
+	0xd1, 0xff, 0xec, 0xf6, 0x53, 0xdc, 0xf1, 0xdc, 0x31, 0x80, 0xc9, 0x56, 0xff, 0x69, 0x11, 0x8a,
+	0x9b, 0xe4, 0x5d, 0x0f, 0xf0, 0xc7, 0x3e, 0xb6, 0x3a, 0x18, 0xdd, 0x87, 0xf4, 0xc7, 0x3e, 0xf6,
+	0x71, 0x59, 0x5b, 0xd1, 0xee, 0xe5, 0x1b, 0xb3, 0xa3, 0x61, 0x65, 0x86, 0x02, 0xaf, 0xda, 0x7d,
+	0xd3, 0xc3, 0xfd, 0x81, 0x77, 0xd6, 0x64, 0x1c, 0xe8, 0x4d, 0x98, 0x7a, 0x6a, 0xb7, 0x5b, 0x2e,
+	0xf6, 0x5a, 0x96, 0xde, 0xc7, 0xe5, 0x04, 0x95, 0x28, 0x8f, 0x86, 0x95, 0xb9, 0xa7, 0x76, 0xfb,
+	0x00, 0x7b, 0x7b, 0x7a, 0x5f, 0x14, 0x83, 0x08, 0x45, 0xdf, 0x83, 0xac, 0xef, 0x62, 0xa7, 0x65,
+	0x1a, 0xe5, 0x24, 0x15, 0x9b, 0x1b, 0x0d, 0x2b, 0x25, 0x02, 0x6d, 0x19, 0x82, 0x48, 0x86, 0x21,
+	0xe8, 0x55, 0xc8, 0x74, 0x1d, 0xdb, 0x1f, 0xb8, 0xe5, 0xd4, 0x4a, 0x32, 0xe0, 0x66, 0x88, 0xc8,
+	0xcd, 0x10, 0xf4, 0x10, 0x32, 0x6c, 0x01, 0xcb, 0xe9, 0x95, 0xe4, 0xbd, 0xc2, 0xda, 0x9d, 0x9a,
+	0xb8, 0xaa, 0x35, 0xe9, 0x85, 0xd9, 0x13, 0x53, 0xc8, 0xe8, 0xa2, 0x42, 0xee, 0x07, 0x7f, 0x7e,
+	0x0b, 0xd2, 0x94, 0x0f, 0xbd, 0x07, 0xd9, 0x8e, 0x83, 0xc9, 0xec, 0x97, 0xd1, 0x8a, 0x76, 0xaf,
+	0xb0, 0xb6, 0x54, 0x63, 0xab, 0x5a, 0x0b, 0x56, 0xb5, 0xf6, 0x28, 0x58, 0xd5, 0xc6, 0xfc, 0x68,
+	0x58, 0xb9, 0xc9, 0xd9, 0x05, 0xad, 0x81, 0x06, 0xb4, 0x0f, 0x79, 0xd7, 0x6f, 0xf7, 0x4d, 0x6f,
+	0xdb, 0x6e, 0xd3, 0xf9, 0x2e, 0xac, 0xdd, 0x92, 0x87, 0x7a, 0x10, 0x90, 0x1b, 0xb7, 0x46, 0xc3,
+	0xca, 0x6c, 0xc8, 0x1d, 0x69, 0x7b, 0xf7, 0x46, 0x33, 0x52, 0x82, 0x9e, 0xc0, 0x8c, 0x83, 0x07,
+	0x8e, 0x69, 0x3b, 0xa6, 0x67, 0xba, 0x98, 0xe8, 0x4d, 0x50, 0xbd, 0xb7, 0x65, 0xbd, 0x4d, 0x99,
+	0xa9, 0x71, 0x7b, 0x34, 0xac, 0x2c, 0xc6, 0x24, 0x25, 0x1b, 0x71, 0xb5, 0xc8, 0x03, 0x14, 0x83,
+	0x0e, 0xb0, 0x47, 0xd7, 0xb2, 0xb0, 0xb6, 0x72, 0xae, 0xb1, 0x03, 0xec, 0x35, 0x56, 0x46, 0xc3,
+	0xca, 0x8b, 0xe3, 0xf2, 0x92, 0x49, 0x85, 0x7e, 0xd4, 0x83, 0x92, 0x88, 0x1a, 0xe4, 0x05, 0x53,
+	0xd4, 0xe6, 0xf2, 0x64, 0x9b, 0x84, 0xab, 0xb1, 0x3c, 0x1a, 0x56, 0x96, 0xe2, 0xb2, 0x92, 0xbd,
+	0x31, 0xcd, 0x64, 0x7d, 0x3a, 0xba, 0xd5, 0xc1, 0x3d, 0x62, 0x26, 0xad, 0x5a, 0x9f, 0xf5, 0x80,
+	0xcc, 0xd6, 0x27, 0xe4, 0x96, 0xd7, 0x27, 0x84, 0xd1, 0x47, 0x30, 0x15, 0x3e, 0x90, 0xf9, 0xca,
+	0x70, 0x1f, 0x52, 0x2b, 0x25, 0x33, 0xb5, 0x34, 0x1a, 0x56, 0x16, 0x44, 0x19, 0x49, 0xb5, 0xa4,
+	0x2d, 0xd2, 0xde, 0x63, 0x33, 0x93, 0x9d, 0xac, 0x9d, 0x71, 0x88, 0xda, 0x7b, 0xe3, 0x33, 0x22,
+	0x69, 0x23, 0xda, 0xc9, 0x07, 0xec, 0x77, 0x3a, 0x18, 0x1b, 0xd8, 0x28, 0xe7, 0x54, 0xda, 0xb7,
+	0x05, 0x0e, 0xa6, 0x5d, 0x94, 0x91, 0xb5, 0x8b, 0x14, 0x32, 0xd7, 0x4f, 0xed, 0xf6, 0xa6, 0xe3,
+	0xd8, 0x8e, 0x5b, 0xce, 0xab, 0xe6, 0x7a, 0x3b, 0x20, 0xb3, 0xb9, 0x0e, 0xb9, 0xe5, 0xb9, 0x0e,
+	0x61, 0x3e, 0xde, 0xa6, 0x6f, 0xed, 0x60, 0xdd, 0xc5, 0x46, 0x19, 0x26, 0x8c, 0x37, 0xe4, 0x08,
+	0xc7, 0x1b, 0x22, 0x63, 0xe3, 0x0d, 0x29, 0xc8, 0x80, 0x69, 0xf6, 0x5c, 0x77, 0x5d, 0xb3, 0x6b,
+	0x61, 0xa3, 0x5c, 0xa0, 0xfa, 0x5f, 0x54, 0xe9, 0x0f, 0x78, 0x1a, 0x2f, 0x8e, 0x86, 0x95, 0xb2,
+	0x2c, 0x27, 0xd9, 0x88, 0xe9, 0x44, 0x3f, 0x83, 0x22, 0x43, 0x9a, 0xbe, 0x65, 0x99, 0x56, 0xb7,
+	0x3c, 0x45, 0x8d, 0xbc, 0xa0, 0x32, 0xc2, 0x59, 0x1a, 0x2f, 0x8c, 0x86, 0x95, 0x5b, 0x92, 0x94,
+	0x64, 0x42, 0x56, 0x48, 0x22, 0x06, 0x03, 0xa2, 0x85, 0x2d, 0xaa, 0x22, 0xc6, 0xb6, 0xcc, 0xc4,
+	0x22, 0x46, 0x4c, 0x52, 0x8e, 0x18, 0x31, 0x62, 0xb4, 0x1e, 0x7c, 0x91, 0xa7, 0x27, 0xaf, 0x07,
+	0x5f, 0x67, 0x61, 0x3d, 0x14, 0x4b, 0x2d, 0x69, 0x43, 0x9f, 0x6a, 0x30, 0xef, 0x7a, 0xba, 0x65,
+	0xe8, 0x3d, 0xdb, 0xc2, 0x5b, 0x56, 0xd7, 0xc1, 0xae, 0xbb, 0x65, 0x1d, 0xd9, 0xe5, 0x12, 0xb5,
+	0xf3, 0x52, 0x2c, 0xb0, 0xaa, 0x58, 0x1b, 0x2f, 0x8d, 0x86, 0x95, 0x8a, 0x52, 0x8b, 0x64, 0x59,
+	0x6d, 0x08, 0x9d, 0xc2, 0x6c, 0xb0, 0x49, 0x1f, 0x7a, 0x66, 0xcf, 0x74, 0x75, 0xcf, 0xb4, 0xad,
+	0xf2, 0x4d, 0x6a, 0xff, 0x4e, 0x3c, 0x3e, 0x8d, 0x31, 0x36, 0xee, 0x8c, 0x86, 0x95, 0xdb, 0x0a,
+	0x0d, 0x92, 0x6d, 0x95, 0x89, 0x68, 0x11, 0xf7, 0x1d, 0x4c, 0x18, 0xb1, 0x51, 0x9e, 0x9d, 0xbc,
+	0x88, 0x21, 0x93, 0xb8, 0x88, 0x21, 0xa8, 0x5a, 0xc4, 0x90, 0x48, 0x2c, 0x0d, 0x74, 0xc7, 0x33,
+	0x89, 0xd9, 0x5d, 0xdd, 0x39, 0xc6, 0x4e, 0x79, 0x4e, 0x65, 0x69, 0x5f, 0x66, 0x62, 0x96, 0x62,
+	0x92, 0xb2, 0xa5, 0x18, 0x11, 0x7d, 0xa6, 0x81, 0x3c, 0x34, 0xd3, 0xb6, 0x9a, 0x64, 0xd3, 0x76,
+	0xc9, 0xeb, 0xcd, 0x53, 0xa3, 0xdf, 0x3d, 0xe7, 0xf5, 0x44, 0xf6, 0xc6, 0x77, 0x47, 0xc3, 0xca,
+	0x4b, 0x13, 0xb5, 0x49, 0x03, 0x99, 0x6c, 0x14, 0x7d, 0x08, 0x05, 0x42, 0xc4, 0x34, 0xfd, 0x31,
+	0xca, 0x0b, 0x74, 0x0c, 0x8b, 0xe3, 0x63, 0xe0, 0x0c, 0x8d, 0xc5, 0xd1, 0xb0, 0x32, 0x2f, 0x48,
+	0x48, 0x76, 0x44, 0x55, 0xe8, 0x57, 0x1a, 0x10, 0x47, 0x57, 0xbd, 0xe9, 0x2d, 0x6a, 0xe5, 0xee,
+	0x98, 0x15, 0xd5, 0x6b, 0xde, 0x1d, 0x0d, 0x2b, 0x2b, 0x6a, 0x3d, 0x92, 0xed, 0x09, 0xb6, 0x22,
+	0x3f, 0x0a, 0x37, 0x89, 0x72, 0x79, 0xb2, 0x1f, 0x85, 0x4c, 0xa2, 0x1f, 0x85, 0xa0, 0xca, 0x8f,
+	0x42, 0x22, 0x0f, 0x06, 0x1f, 0xe8, 0x3d, 0xd3, 0xa0, 0xc9, 0xd4, 0xe2, 0x84, 0x60, 0x10, 0x72,
+	0x84, 0xc1, 0x20, 0x44, 0xc6, 0x82, 0x41, 0x48, 0x21, 0xc1, 0x39, 0xdc, 0x18, 0xeb, 0x8e, 0xa3,
+	0x9f, 0x95, 0x97, 0x54, 0xc1, 0x79, 0x5d, 0xe2, 0x61, 0xc1, 0x59, 0x96, 0x93, 0x83, 0xb3, 0x4c,
+	0x43, 0x9f, 0xc0, 0x5c, 0x2c, 0x45, 0x61, 0xb6, 0x5e, 0xa0, 0xb6, 0xaa, 0xe7, 0x26, 0x41, 0xcc,
+	0x62, 0x75, 0x34, 0xac, 0x2c, 0xab, 0x74, 0x48, 0x76, 0x95, 0x56, 0x48, 0x2a, 0xf4, 0xd4, 0x6e,
+	0x6f, 0xe0, 0x23, 0xec, 0x38, 0x7a, 0x6f, 0xd3, 0x22, 0x91, 0xfb, 0x45, 0x55, 0x2a, 0xb4, 0x1d,
+	0xe3, 0x62, 0xa9, 0x50, 0x5c, 0x56, 0x4e, 0x85, 0xe2, 0xd4, 0xc0, 0x41, 0xa5, 0x0f, 0x63, 0xcf,
+	0xf6, 0xcc, 0x0e, 0x2e, 0xdf, 0x9e, 0xe0, 0xa0, 0x0a, 0xde, 0xd0, 0x41, 0x15, 0xb4, 0x31, 0x07,
+	0x55, 0xc9, 0x67, 0x21, 0x4d, 0x0d, 0x54, 0x3f, 0xcf, 0xc3, 0xac, 0x22, 0x86, 0x22, 0x0c, 0xc5,
+	0x20, 0x40, 0xb6, 0x4c, 0x12, 0xfd, 0x93, 0xaa, 0xd1, 0xbd, 0xe7, 0xb7, 0xb1, 0x63, 0x61, 0x0f,
+	0xbb, 0x81, 0x0e, 0x1a, 0xfe, 0xa9, 0x8b, 0x39, 0x02, 0x22, 0x24, 0xed, 0x53, 0x22, 0x8e, 0x3e,
+	0xd7, 0xa0, 0xdc, 0xd7, 0x4f, 0x5b, 0x01, 0xe8, 0xb6, 0x8e, 0x6c, 0xa7, 0x35, 0xc0, 0x8e, 0x69,
+	0x1b, 0xf4, 0x88, 0x52, 0x58, 0xfb, 0xc9, 0x85, 0x01, 0xbf, 0xb6, 0xab, 0x9f, 0x06, 0xb0, 0xfb,
+	0xc0, 0x76, 0xf6, 0xa9, 0xf8, 0xa6, 0xe5, 0x39, 0x67, 0x6c, 0x27, 0xea, 0xab, 0xe8, 0xc2, 0x98,
+	0xe6, 0x95, 0x0c, 0xe8, 0xaf, 0x34, 0x58, 0xf0, 0x6c, 0x4f, 0xef, 0xb5, 0x3a, 0x7e, 0xdf, 0xef,
+	0xe9, 0x9e, 0x79, 0x82, 0x5b, 0xbe, 0xab, 0x77, 0x31, 0x3f, 0x0f, 0xfd, 0xf8, 0xe2, 0xa1, 0x3d,
+	0x22, 0xf2, 0xeb, 0xa1, 0xf8, 0x21, 0x91, 0x66, 0x23, 0xa3, 0x3e, 0xeb, 0x29, 0xc8, 0xc2, 0xc0,
+	0xe6, 0x54, 0x74, 0xf4, 0x0a, 0x64, 0xc8, 0x79, 0xd1, 0x34, 0x68, 0xda, 0xcb, 0xcf, 0x96, 0x4f,
+	0xed, 0xb6, 0x74, 0xe2, 0x4b, 0x53, 0x80, 0xf0, 0x3a, 0xbe, 0x45, 0x78, 0xb3, 0x11, 0xaf, 0xe3,
+	0x5b, 0x32, 0x2f, 0x05, 0xe8, 0x62, 0xe8, 0x27, 0x5d, 0xf5, 0x62, 0xe4, 0x2e, 0xbb, 0x18, 0xf5,
+	0x93, 0xee, 0xb9, 0x8b, 0xa1, 0xab, 0xe8, 0xe2, 0x62, 0x28, 0x19, 0x96, 0x7e, 0xad, 0xc1, 0xd2,
+	0xe4, 0x75, 0x46, 0x2f, 0x41, 0xf2, 0x18, 0x9f, 0xf1, 0xc3, 0xf6, 0xcd, 0xd1, 0xb0, 0x52, 0x3c,
+	0xc6, 0xc2, 0xd7, 0xdf, 0x24, 0x54, 0xf4, 0x18, 0xd2, 0x27, 0x7a, 0xcf, 0xc7, 0xfc, 0x2c, 0x57,
+	0xab, 0xb1, 0x3a, 0x41, 0x4d, 0xac, 0x13, 0xd4, 0x06, 0xc7, 0x5d, 0x02, 0xd4, 0x82, 0x59, 0xa8,
+	0xbd, 0xef, 0xeb, 0x96, 0x67, 0x7a, 0x67, 0x6c, 0xee, 0xa8, 0x02, 0x71, 0xee, 0x28, 0xf0, 0x66,
+	0xe2, 0x0d, 0x6d, 0xe9, 0x6f, 0x34, 0x58, 0x9c, 0xb8, 0xde, 0xdf, 0x88, 0x11, 0x92, 0x49, 0x9c,
+	0xbc, 0x3e, 0xdf, 0x84, 0x21, 0x6e, 0xa7, 0x72, 0x5a, 0x29, 0xb1, 0x9d, 0xca, 0x25, 0x4a, 0xc9,
+	0xea, 0x3f, 0x00, 0xe4, 0xc3, 0x93, 0x3b, 0x7a, 0x17, 0x4a, 0x06, 0x36, 0xfc, 0x41, 0xcf, 0xec,
+	0x50, 0x4f, 0x23, 0x4e, 0xcd, 0x4a, 0x25, 0x74, 0xdb, 0x94, 0x68, 0x92, 0x7b, 0xcf, 0xc4, 0x48,
+	0x68, 0x0d, 0x72, 0x7c, 0x23, 0x38, 0xa3, 0x71, 0xad, 0xd8, 0x58, 0x18, 0x0d, 0x2b, 0x28, 0xc0,
+	0x04, 0xd1, 0x90, 0x0f, 0x35, 0x01, 0x58, 0xc9, 0x67, 0x17, 0x7b, 0x3a, 0x3f, 0x2b, 0x97, 0xe5,
+	0xaf, 0xe1, 0x61, 0x48, 0x67, 0xc5, 0x9b, 0x88, 0x5f, 0x2c, 0xde, 0x44, 0x28, 0xfa, 0x08, 0xa0,
+	0xaf, 0x9b, 0x16, 0x93, 0xe3, 0x07, 0xe3, 0xea, 0xa4, 0x08, 0xbb, 0x1b, 0x72, 0x32, 0xed, 0x91,
+	0xa4, 0xa8, 0x3d, 0x42, 0xd1, 0x43, 0xc8, 0xf2, 0x22, 0x55, 0x39, 0x43, 0x3f, 0xde, 0xe5, 0x49,
+	0xaa, 0xb9, 0x5a, 0x5a, 0x66, 0xe1, 0x22, 0x62, 0x99, 0x85, 0x43, 0x64, 0xda, 0x7a, 0xe6, 0x11,
+	0xf6, 0xcc, 0x3e, 0xa6, 0xd1, 0x84, 0x4f, 0x5b, 0x80, 0x89, 0xd3, 0x16, 0x60, 0xe8, 0x0d, 0x00,
+	0xdd, 0xdb, 0xb5, 0x5d, 0xef, 0xa1, 0xd5, 0xc1, 0xf4, 0xa8, 0x9b, 0x63, 0xc3, 0x8f, 0x50, 0x71,
+	0xf8, 0x11, 0x8a, 0x7e, 0x0c, 0x85, 0x01, 0xdf, 0xb6, 0xda, 0x3d, 0x4c, 0x8f, 0xb2, 0x39, 0x96,
+	0x09, 0x0a, 0xb0, 0x20, 0x2b, 0x72, 0xa3, 0x77, 0x60, 0xa6, 0x63, 0x5b, 0x1d, 0xdf, 0x71, 0xb0,
+	0xd5, 0x39, 0x3b, 0xd0, 0x8f, 0x30, 0x3d, 0xb6, 0xe6, 0x98, 0xab, 0xc4, 0x48, 0xa2, 0xab, 0xc4,
+	0x48, 0xe8, 0x07, 0x90, 0x0f, 0x4b, 0x7e, 0xf4, 0x64, 0x9a, 0xe7, 0x15, 0xa4, 0x00, 0x14, 0x84,
+	0x23, 0x4e, 0x32, 0x78, 0xd3, 0xdd, 0xe0, 0x4e, 0x87, 0xe9, 0x69, 0x93, 0x0f, 0x5e, 0x80, 0xc5,
+	0xc1, 0x0b, 0xb0, 0x10, 0xdf, 0xa7, 0x2f, 0x8c, 0xef, 0x6f, 0xc1, 0x94, 0x81, 0x07, 0xd8, 0x32,
+	0xb0, 0xd5, 0x31, 0xb1, 0x5b, 0x2e, 0xd1, 0xb2, 0x1e, 0xdd, 0x80, 0x45, 0x5c, 0xdc, 0x80, 0x45,
+	0x1c, 0xfd, 0xa9, 0x06, 0x8b, 0x21, 0x70, 0xd6, 0x3a, 0xd2, 0xcd, 0x9e, 0xef, 0xe0, 0xd6, 0xc0,
+	0xee, 0x99, 0x9d, 0x33, 0x7a, 0xe4, 0x9a, 0x5e, 0x7b, 0x59, 0xf6, 0x9b, 0x8d, 0x90, 0xfd, 0x01,
+	0xe3, 0xde, 0xa7, 0xcc, 0x8d, 0x97, 0x47, 0xc3, 0xca, 0x1d, 0x43, 0x4d, 0x14, 0xec, 0xdf, 0x9a,
+	0xc0, 0x82, 0x7e, 0x08, 0xa0, 0x93, 0x8c, 0xac, 0xe5, 0x9a, 0xbf, 0xc0, 0xb4, 0x2a, 0x58, 0x64,
+	0x73, 0x4d, 0xd1, 0x03, 0xf3, 0x17, 0xe2, 0x84, 0xe5, 0x43, 0x90, 0x7c, 0x99, 0x96, 0xed, 0xb5,
+	0xda, 0xf8, 0xc8, 0x76, 0x30, 0x3f, 0xaf, 0x9d, 0x57, 0x4d, 0xa4, 0x3a, 0x2d, 0xdb, 0x6b, 0x50,
+	0x01, 0x51, 0x67, 0x08, 0xa2, 0x2d, 0xb8, 0x49, 0x4f, 0x14, 0x2d, 0xcf, 0xeb, 0xb5, 0x5c, 0xdc,
+	0xb1, 0x2d, 0xc3, 0xa5, 0x07, 0xb4, 0x22, 0xf3, 0x20, 0x4a, 0x7c, 0xe4, 0xf5, 0x0e, 0x18, 0x49,
+	0xf4, 0xa0, 0x18, 0x09, 0xfd, 0x01, 0x4c, 0x39, 0xd8, 0x73, 0xce, 0x82, 0x39, 0x9d, 0xe7, 0x07,
+	0x81, 0xb1, 0x4a, 0x72, 0x93, 0x70, 0xf1, 0xb9, 0xa4, 0xae, 0xe2, 0x44, 0x80, 0xe8, 0x2a, 0x02,
+	0x1c, 0x46, 0xcb, 0x62, 0x69, 0x7a, 0x3b, 0x95, 0x9b, 0x29, 0x95, 0xaa, 0xff, 0xa6, 0xc1, 0x9c,
+	0x2a, 0x68, 0xc4, 0x02, 0x98, 0xf6, 0x4c, 0x02, 0xd8, 0x07, 0x90, 0x1b, 0xd8, 0x46, 0xcb, 0x1d,
+	0xe0, 0x0e, 0xdf, 0x0e, 0x62, 0xe1, 0x6b, 0xdf, 0x36, 0x0e, 0x06, 0xb8, 0xf3, 0x53, 0xd3, 0x7b,
+	0x52, 0x3f, 0xb1, 0x4d, 0x63, 0xc7, 0x74, 0x79, 0x9c, 0x19, 0x30, 0x8a, 0x94, 0xad, 0x66, 0x39,
+	0xd8, 0xc8, 0x41, 0x86, 0x59, 0xa9, 0x7e, 0x9e, 0x82, 0x52, 0x3c, 0x50, 0xfd, 0x5f, 0x7a, 0x15,
+	0xf4, 0x21, 0x64, 0x4d, 0x56, 0xdb, 0xe0, 0x29, 0xf4, 0xcb, 0xc2, 0x86, 0x59, 0x8b, 0x2e, 0x3a,
+	0x6a, 0x27, 0xdf, 0xaf, 0xf1, 0x22, 0x08, 0x9d, 0x02, 0xaa, 0x99, 0x4b, 0xca, 0x9a, 0x39, 0x88,
+	0x9a, 0x90, 0x75, 0xb1, 0x73, 0x42, 0x8e, 0x0e, 0x6c, 0x3b, 0xaa, 0x88, 0x9a, 0x3b, 0xb6, 0x83,
+	0x89, 0xce, 0x03, 0xc6, 0x12, 0xe9, 0xe4, 0x32, 0xb2, 0x4e, 0x0e, 0xa2, 0x0f, 0x20, 0xdf, 0xb1,
+	0xad, 0x23, 0xb3, 0xbb, 0xab, 0x0f, 0xf8, 0x86, 0x74, 0x5b, 0xa5, 0x75, 0x3d, 0x60, 0xe2, 0xf5,
+	0xda, 0xe0, 0x31, 0x56, 0xaf, 0x0d, 0x60, 0xb4, 0x0d, 0x19, 0x17, 0x77, 0x1c, 0xa1, 0x52, 0xab,
+	0x1c, 0x2a, 0xe1, 0x60, 0x57, 0x08, 0x8c, 0x5b, 0x52, 0xc7, 0x35, 0x08, 0xce, 0xf1, 0x3f, 0x29,
+	0x80, 0x68, 0xa1, 0xd1, 0x8f, 0xa0, 0x80, 0x4f, 0x71, 0xc7, 0xf7, 0x6c, 0x7a, 0x1f, 0xa2, 0x45,
+	0xd7, 0x28, 0x01, 0x2c, 0x45, 0x50, 0x88, 0x50, 0x12, 0xe6, 0x2d, 0xbd, 0x8f, 0xdd, 0x81, 0xde,
+	0x09, 0xee, 0x5f, 0x58, 0x98, 0x08, 0x40, 0x29, 0x4c, 0x04, 0x20, 0xfa, 0x0e, 0xa4, 0xe8, 0x8d,
+	0x0d, 0xbb, 0x7a, 0x41, 0xa3, 0x61, 0x65, 0xda, 0x92, 0xef, 0x6a, 0x28, 0x1d, 0xbd, 0x0d, 0xc5,
+	0xe3, 0xd0, 0x89, 0xc9, 0xd8, 0x52, 0x54, 0x80, 0x86, 0xe9, 0x88, 0x20, 0x8d, 0x6e, 0x4a, 0xc4,
+	0xd1, 0x11, 0x14, 0x74, 0xcb, 0xb2, 0x3d, 0x9a, 0xc1, 0x04, 0xd7, 0x31, 0xf7, 0x27, 0xb9, 0x7c,
+	0xad, 0x1e, 0xf1, 0xb2, 0xcc, 0x9b, 0xc6, 0x13, 0x41, 0x83, 0x18, 0x4f, 0x04, 0x18, 0x35, 0x21,
+	0xd3, 0xd3, 0xdb, 0xb8, 0x17, 0xa4, 0x0c, 0x77, 0x27, 0x9a, 0xd8, 0xa1, 0x6c, 0x4c, 0x3b, 0x5d,
+	0x31, 0x26, 0x27, 0x5e, 0xfa, 0x30, 0x64, 0xe9, 0x08, 0x4a, 0xf1, 0xf1, 0x5c, 0x2e, 0xd3, 0xbc,
+	0x2f, 0x66, 0x9a, 0xf9, 0x0b, 0x93, 0x5b, 0x1d, 0x0a, 0xc2, 0xa0, 0x9e, 0x87, 0x89, 0xea, 0x6f,
+	0x34, 0x98, 0x53, 0xc5, 0x01, 0xb4, 0x2b, 0x44, 0x0f, 0x8d, 0x97, 0x96, 0x15, 0x1e, 0xce, 0x65,
+	0x27, 0x84, 0x8d, 0x28, 0x68, 0x34, 0x60, 0xda, 0xb2, 0x0d, 0xdc, 0xd2, 0x89, 0x81, 0x9e, 0xe9,
+	0x7a, 0xe5, 0x04, 0xdd, 0xd7, 0x69, 0x49, 0x9a, 0x50, 0xea, 0x01, 0x41, 0x90, 0x2e, 0x4a, 0x84,
+	0xea, 0xcf, 0x61, 0x26, 0x56, 0x2b, 0x91, 0xf2, 0xde, 0xc4, 0x25, 0xf3, 0xde, 0x28, 0x19, 0x49,
+	0x5e, 0x94, 0x8c, 0xb0, 0xdd, 0xa8, 0xfa, 0xc7, 0x09, 0x28, 0x08, 0xd5, 0x3b, 0xf4, 0x14, 0x66,
+	0xf8, 0x5e, 0x67, 0x5a, 0x5d, 0x56, 0x4c, 0x48, 0xf0, 0x52, 0xf2, 0xd8, 0x1e, 0xb8, 0x6d, 0xb7,
+	0x0f, 0x42, 0x5e, 0x5a, 0x4b, 0xa0, 0xc5, 0x24, 0x57, 0xc2, 0x04, 0xc3, 0xd3, 0x32, 0x05, 0x7d,
+	0x08, 0x0b, 0xfe, 0xc0, 0xd0, 0x3d, 0xdc, 0x72, 0xf9, 0xbd, 0x64, 0xcb, 0xf2, 0xfb, 0x6d, 0xec,
+	0xd0, 0xd1, 0xa7, 0xd9, 0xa1, 0x9b, 0x71, 0x04, 0x17, 0x97, 0x7b, 0x94, 0x2e, 0x1e, 0xba, 0x55,
+	0x74, 0x61, 0x1e, 0x52, 0x97, 0x9c, 0x87, 0x77, 0x01, 0x8d, 0xdf, 0xd8, 0x49, 0x6b, 0xa0, 0x5d,
+	0x6e, 0x0d, 0xaa, 0x9f, 0xc0, 0x9c, 0xaa, 0xec, 0x85, 0x5e, 0x83, 0x1c, 0xcb, 0x98, 0xc2, 0x68,
+	0x47, 0x1d, 0x8b, 0x62, 0xd2, 0xb8, 0xb2, 0x1c, 0xba, 0x8e, 0x07, 0x54, 0x4f, 0xa1, 0x14, 0xbf,
+	0x05, 0xfc, 0x9a, 0x3c, 0xe9, 0x18, 0xf2, 0x61, 0x69, 0x11, 0xbd, 0x0a, 0x19, 0x07, 0xeb, 0xae,
+	0x6d, 0xf1, 0x6f, 0x95, 0x06, 0x1d, 0x86, 0x88, 0x41, 0x87, 0x21, 0xd7, 0x30, 0xf6, 0x08, 0xa6,
+	0xd8, 0x12, 0x3d, 0x30, 0x7b, 0x1e, 0x76, 0xd0, 0x06, 0x64, 0x5c, 0x4f, 0xf7, 0xb0, 0x5b, 0xd6,
+	0x56, 0x92, 0xf7, 0xa6, 0xd7, 0x16, 0xc6, 0x2f, 0xe8, 0x08, 0x99, 0x6f, 0x57, 0x94, 0x53, 0x1c,
+	0x07, 0x43, 0xaa, 0x7f, 0xa4, 0xc1, 0x94, 0x78, 0x0f, 0xf9, 0x6c, 0xd4, 0x5e, 0x6d, 0x32, 0xaa,
+	0x03, 0x98, 0x96, 0x4b, 0xb4, 0xd7, 0xf0, 0x9c, 0xab, 0x59, 0xfc, 0x4d, 0xf8, 0xda, 0xfc, 0xd2,
+	0xf3, 0xb9, 0xad, 0x1e, 0xd9, 0xf5, 0x59, 0x3d, 0xb9, 0xe5, 0xbb, 0xd8, 0xe1, 0x5f, 0x27, 0xdd,
+	0xf5, 0x19, 0x7c, 0xe8, 0x4a, 0x5f, 0x37, 0x44, 0x28, 0x5f, 0x78, 0x32, 0x56, 0xf1, 0xba, 0x15,
+	0x75, 0xa3, 0xda, 0x27, 0x09, 0x2a, 0x2e, 0x0d, 0xbe, 0x97, 0xad, 0x7d, 0xd2, 0x10, 0x2d, 0x89,
+	0x8b, 0x21, 0x5a, 0x22, 0x5c, 0xc3, 0x49, 0x7f, 0x9d, 0xa6, 0x63, 0x8d, 0xae, 0x4f, 0x63, 0x39,
+	0x4f, 0xf2, 0x0a, 0x39, 0xcf, 0xf7, 0x20, 0x4b, 0x37, 0x99, 0x30, 0xa4, 0xd1, 0x35, 0x21, 0x90,
+	0xdc, 0x3a, 0xc2, 0x90, 0x73, 0x42, 0x6b, 0xfa, 0x77, 0x0c, 0xad, 0x2d, 0x58, 0x7c, 0xa2, 0xbb,
+	0xad, 0x60, 0x33, 0x30, 0x5a, 0xba, 0xd7, 0x0a, 0xa3, 0x4b, 0x86, 0x1e, 0x9d, 0x69, 0xbd, 0xfb,
+	0x89, 0xee, 0x1e, 0x04, 0x3c, 0x75, 0x6f, 0x7f, 0x3c, 0xd6, 0x2c, 0xa8, 0x39, 0xd0, 0x21, 0xcc,
+	0xab, 0x95, 0x67, 0xe9, 0xc8, 0xe9, 0x7d, 0xa1, 0x7b, 0xae, 0xe6, 0x59, 0x05, 0x19, 0xfd, 0x52,
+	0x83, 0x32, 0xd9, 0xf5, 0x1d, 0xfc, 0xb1, 0x6f, 0x3a, 0xb8, 0x4f, 0xdc, 0xa2, 0x65, 0x9f, 0x60,
+	0xa7, 0xa7, 0x9f, 0xf1, 0xab, 0xf7, 0x3b, 0xe3, 0x5b, 0xdc, 0xbe, 0x6d, 0x34, 0x05, 0x01, 0xf6,
+	0x6a, 0x03, 0x19, 0x7c, 0xc8, 0x94, 0x88, 0xaf, 0xa6, 0xe6, 0x10, 0x5c, 0x08, 0xae, 0x50, 0x0b,
+	0x2e, 0x5c, 0x58, 0x0b, 0xfe, 0x0e, 0xa4, 0x06, 0xb6, 0xdd, 0xa3, 0x95, 0x0b, 0x9e, 0xd9, 0x92,
+	0x67, 0x31, 0xb3, 0x25, 0xcf, 0x62, 0xb9, 0x6e, 0x3b, 0x95, 0xcb, 0x95, 0xf2, 0xd5, 0xdf, 0x6a,
+	0x30, 0x2d, 0xdf, 0xd6, 0x8f, 0x7f, 0x50, 0xc9, 0xe7, 0xfe, 0x41, 0xa5, 0xae, 0x30, 0x1b, 0xe9,
+	0x8b, 0x66, 0x43, 0x2a, 0x4a, 0xfe, 0xa7, 0x06, 0x45, 0xa9, 0x51, 0xe0, 0xdb, 0xf5, 0x7a, 0x7f,
+	0x9d, 0x80, 0x05, 0xf5, 0x50, 0x9f, 0xcb, 0xb1, 0xfb, 0x5d, 0x20, 0x49, 0xef, 0x56, 0x94, 0x14,
+	0xce, 0x8f, 0x9d, 0xba, 0xe9, 0x34, 0x05, 0x19, 0xf3, 0x58, 0x0f, 0x41, 0x20, 0x8e, 0x3e, 0x84,
+	0x82, 0x29, 0x74, 0x2b, 0x24, 0x55, 0x97, 0xca, 0x62, 0x8f, 0x02, 0xab, 0xc6, 0x4d, 0xe8, 0x4c,
+	0x10, 0x55, 0x35, 0x32, 0x90, 0x22, 0x59, 0x6b, 0xf5, 0x04, 0xb2, 0x7c, 0x38, 0xe8, 0x75, 0xc8,
+	0xd3, 0xd8, 0x49, 0x4f, 0x7f, 0x6c, 0x03, 0xa5, 0x09, 0x10, 0x01, 0x63, 0xdd, 0x7a, 0xb9, 0x00,
+	0x43, 0x3f, 0x04, 0x20, 0xe1, 0x82, 0x47, 0xcd, 0x04, 0x8d, 0x3d, 0xf4, 0x94, 0x39, 0xb0, 0x8d,
+	0xb1, 0x50, 0x99, 0x0f, 0xc1, 0xea, 0xdf, 0x27, 0xa0, 0x20, 0xf6, 0x47, 0x5c, 0xcb, 0xf8, 0x27,
+	0x10, 0x54, 0x13, 0x5a, 0xba, 0x61, 0x90, 0x7f, 0x71, 0xb0, 0xb1, 0xad, 0x4e, 0x9c, 0xa4, 0xe0,
+	0xff, 0xf5, 0x40, 0x82, 0x9d, 0xf7, 0xe8, 0xc5, 0xa7, 0x19, 0x23, 0x09, 0x56, 0x4b, 0x71, 0xda,
+	0xd2, 0x31, 0xcc, 0x2b, 0x55, 0x89, 0xa7, 0xb4, 0xf4, 0xb3, 0x3a, 0xa5, 0xfd, 0x6d, 0x1a, 0xe6,
+	0x95, 0x7d, 0x29, 0x31, 0x0f, 0x4e, 0x3e, 0x13, 0x0f, 0xfe, 0x13, 0x4d, 0x35, 0xb3, 0xec, 0xee,
+	0xf2, 0x47, 0x97, 0x68, 0x96, 0x79, 0x56, 0x73, 0x2c, 0xbb, 0x45, 0xfa, 0x5a, 0x3e, 0x99, 0xb9,
+	0xac, 0x4f, 0x92, 0x04, 0x92, 0xca, 0xe9, 0xfc, 0x2e, 0x20, 0x1f, 0x7e, 0xa1, 0x31, 0x53, 0x59,
+	0x0e, 0xa1, 0xb7, 0xa1, 0x18, 0x48, 0xb0, 0x32, 0x4b, 0x2e, 0xaa, 0x81, 0x70, 0x9e, 0x78, 0xa5,
+	0x65, 0x4a, 0xc4, 0x85, 0xe8, 0x97, 0xbf, 0x42, 0xf4, 0x83, 0x8b, 0xa2, 0xdf, 0xd7, 0xea, 0x9b,
+	0x52, 0xa8, 0x1d, 0x6a, 0x30, 0x13, 0x6b, 0x07, 0xfb, 0x76, 0xed, 0x25, 0x9f, 0x6a, 0x90, 0x0f,
+	0xbb, 0x0d, 0x51, 0x1d, 0x32, 0x98, 0x75, 0xac, 0xb1, 0xb0, 0x33, 0x1b, 0xeb, 0x26, 0x26, 0x34,
+	0xde, 0x3f, 0x1c, 0x6b, 0x52, 0x6b, 0x72, 0xc1, 0x6b, 0x24, 0xcc, 0xff, 0xa8, 0x05, 0x09, 0xf3,
+	0xd8, 0x28, 0x92, 0xbf, 0xfb, 0x28, 0x9e, 0xdf, 0xd4, 0xfd, 0x33, 0x40, 0x9a, 0x8e, 0x85, 0x1c,
+	0xb5, 0x3d, 0xec, 0xf4, 0x4d, 0x4b, 0xef, 0x51, 0x57, 0xcc, 0xb1, 0xaf, 0x3a, 0xc0, 0xc4, 0xaf,
+	0x3a, 0xc0, 0xd0, 0x13, 0x98, 0x89, 0xca, 0x87, 0x54, 0x8d, 0xba, 0x7d, 0xf9, 0x3d, 0x99, 0x89,
+	0xdd, 0x6d, 0xc4, 0x24, 0xe5, 0xfe, 0xa3, 0x18, 0x91, 0x76, 0x08, 0xd9, 0x96, 0xa7, 0x9b, 0x16,
+	0x76, 0x98, 0xa1, 0xa4, 0xb2, 0x43, 0x48, 0xe2, 0xe1, 0x1d, 0x42, 0x12, 0x16, 0xeb, 0x10, 0x92,
+	0x68, 0xe8, 0x67, 0x50, 0x0c, 0x0e, 0x2e, 0xcc, 0x48, 0x4a, 0xd5, 0xbe, 0xb9, 0x29, 0xb2, 0xb0,
+	0x8f, 0x41, 0x92, 0x92, 0xdb, 0x37, 0x25, 0x12, 0xfa, 0x08, 0xa6, 0x7a, 0xe4, 0x44, 0xb5, 0x79,
+	0x3a, 0x30, 0x1d, 0x6c, 0xa8, 0x1b, 0x8a, 0x77, 0x04, 0x0e, 0x16, 0xb8, 0x44, 0x19, 0xb9, 0x8f,
+	0x4a, 0xa4, 0x90, 0xf5, 0xe8, 0xeb, 0xa7, 0x4d, 0xdf, 0x72, 0x37, 0x4f, 0x79, 0x73, 0x68, 0x56,
+	0xb5, 0x1e, 0xbb, 0x32, 0x13, 0x5b, 0x8f, 0x98, 0xa4, 0xbc, 0x1e, 0x31, 0x22, 0xda, 0xa1, 0x71,
+	0x99, 0x4d, 0x12, 0x6b, 0x2c, 0x5e, 0x18, 0x4b, 0xa8, 0xd8, 0xfc, 0xb0, 0x82, 0x0d, 0x7f, 0x92,
+	0x94, 0x86, 0x1a, 0x50, 0x0f, 0x4a, 0x03, 0xdb, 0xa0, 0xaf, 0xdd, 0xc4, 0x9e, 0xef, 0x58, 0xd8,
+	0xe0, 0x07, 0x9b, 0xe5, 0x31, 0xad, 0x12, 0x17, 0xdb, 0xbe, 0xe2, 0xb2, 0x72, 0x6f, 0x54, 0x9c,
+	0x8a, 0x3e, 0x81, 0xb9, 0x58, 0x9b, 0x24, 0x7b, 0x8f, 0x82, 0xea, 0x3a, 0x66, 0x5b, 0xc1, 0xc9,
+	0xce, 0xa0, 0x2a, 0x1d, 0x72, 0x1f, 0x98, 0x8a, 0x83, 0x58, 0xef, 0xea, 0x56, 0x77, 0xdb, 0x6e,
+	0x1f, 0x5a, 0xfc, 0xd0, 0xa6, 0xb7, 0x7b, 0x98, 0x77, 0x0a, 0xc7, 0xac, 0xbf, 0xa3, 0xe0, 0x64,
+	0xd6, 0x55, 0x3a, 0x64, 0xeb, 0x2a, 0x8e, 0xb0, 0x25, 0x92, 0xa4, 0x15, 0x61, 0xeb, 0xb0, 0xaa,
+	0x25, 0x92, 0x31, 0x08, 0x2d, 0x91, 0x0c, 0x50, 0xb4, 0x44, 0x32, 0x02, 0x3a, 0x85, 0x59, 0xda,
+	0x85, 0x26, 0x5e, 0xba, 0x62, 0x83, 0x77, 0x0d, 0xdf, 0x51, 0xb4, 0xb8, 0xc9, 0x8c, 0xec, 0x74,
+	0xac, 0xd0, 0x20, 0x77, 0xd3, 0x2a, 0x18, 0xc8, 0x57, 0x4b, 0xef, 0x2a, 0x37, 0x70, 0xc7, 0x74,
+	0x4d, 0xdb, 0x2a, 0xcf, 0xa8, 0xbe, 0xda, 0xa6, 0xc8, 0x12, 0x6c, 0x61, 0x02, 0x24, 0x7f, 0xb5,
+	0x12, 0xa9, 0x91, 0x0b, 0xaa, 0x4a, 0xdb, 0xa9, 0x5c, 0xba, 0x94, 0xd9, 0x4e, 0xe5, 0xa0, 0x54,
+	0xa8, 0xbe, 0x0f, 0x33, 0xb1, 0xc0, 0x86, 0xde, 0x82, 0xb0, 0xef, 0xec, 0xd1, 0xd9, 0x20, 0xc8,
+	0x9a, 0xa5, 0x3e, 0x35, 0x82, 0xab, 0xfa, 0xd4, 0x08, 0x5e, 0xfd, 0x2c, 0x05, 0xb9, 0xe0, 0xcb,
+	0x79, 0x2e, 0xe7, 0xa0, 0x55, 0xc8, 0xf6, 0xb1, 0x4b, 0x7b, 0xcb, 0x12, 0x51, 0x3a, 0xc5, 0x21,
+	0x31, 0x9d, 0xe2, 0x90, 0x9c, 0xed, 0x25, 0xaf, 0x95, 0xed, 0xa5, 0x2e, 0x9d, 0xed, 0x61, 0xda,
+	0x4e, 0x21, 0x44, 0xe4, 0xe0, 0x0a, 0xea, 0xfc, 0x30, 0x1f, 0x34, 0x5b, 0x88, 0x82, 0xb1, 0x66,
+	0x0b, 0x91, 0x84, 0x8e, 0xe1, 0xa6, 0x70, 0x4d, 0xc6, 0xeb, 0x85, 0x19, 0xda, 0x83, 0xb0, 0x3c,
+	0x39, 0x01, 0x22, 0x5c, 0x2c, 0xde, 0x1c, 0xc7, 0x50, 0x31, 0x5d, 0x8e, 0xd3, 0x58, 0xe7, 0x44,
+	0xdb, 0xef, 0xee, 0xf2, 0x69, 0xcf, 0x46, 0x2e, 0x21, 0xe2, 0x72, 0xe7, 0x44, 0x84, 0x57, 0xff,
+	0x3b, 0x01, 0xd3, 0xf2, 0xfb, 0x3e, 0x17, 0xc7, 0x78, 0x1d, 0xf2, 0xf8, 0xd4, 0xf4, 0x5a, 0x1d,
+	0xdb, 0xc0, 0xfc, 0xcc, 0x48, 0xd7, 0x99, 0x80, 0xeb, 0xb6, 0x21, 0xad, 0x73, 0x80, 0x89, 0xde,
+	0x94, 0xbc, 0x94, 0x37, 0x45, 0xe5, 0xd9, 0xd4, 0x25, 0xca, 0xb3, 0xca, 0x75, 0xca, 0x3f, 0x9f,
+	0x75, 0xaa, 0x7e, 0x91, 0x80, 0x52, 0x7c, 0x7b, 0xf9, 0x66, 0x7c, 0x82, 0xf2, 0xd7, 0x94, 0xbc,
+	0xf4, 0xd7, 0xf4, 0x36, 0x14, 0x49, 0x4e, 0xa8, 0x7b, 0x1e, 0xff, 0x8d, 0x41, 0x8a, 0xa6, 0x75,
+	0x2c, 0x1a, 0xf9, 0x56, 0x3d, 0xc0, 0xa5, 0x68, 0x24, 0xe0, 0x63, 0xae, 0x9b, 0xbe, 0xa2, 0xeb,
+	0xfe, 0x32, 0x01, 0xc5, 0x7d, 0xdb, 0x78, 0xc4, 0xd2, 0x45, 0xef, 0x9b, 0x32, 0x9f, 0x5f, 0x67,
+	0x48, 0xab, 0xce, 0x40, 0x51, 0xca, 0x17, 0xab, 0xbf, 0x62, 0x7e, 0x26, 0x6f, 0xcb, 0xff, 0xff,
+	0xe6, 0x65, 0x1a, 0xa6, 0xc4, 0x34, 0xb7, 0xda, 0x80, 0x99, 0x58, 0x56, 0x2a, 0xbe, 0x80, 0x76,
+	0x99, 0x17, 0xa8, 0x6e, 0xc0, 0x9c, 0x2a, 0x5d, 0x13, 0xa2, 0x8e, 0x76, 0x89, 0x3b, 0xa5, 0x77,
+	0x60, 0x4e, 0x95, 0x76, 0x5d, 0x7d, 0x38, 0x6f, 0xf1, 0xfb, 0x69, 0x9e, 0x20, 0x5d, 0x59, 0xfe,
+	0x2f, 0x34, 0x98, 0x55, 0x64, 0x4a, 0x57, 0x56, 0x84, 0xb6, 0xe0, 0xa6, 0xd0, 0x7b, 0xc7, 0xcf,
+	0x94, 0x52, 0x4b, 0x6b, 0x40, 0xdc, 0x8e, 0x9d, 0x2e, 0x67, 0x62, 0xa4, 0xea, 0x6f, 0x35, 0x28,
+	0x4a, 0x99, 0x14, 0xba, 0x0f, 0x69, 0x9a, 0x2c, 0xf1, 0x43, 0x23, 0x3b, 0x78, 0x12, 0x40, 0x3a,
+	0x78, 0x12, 0xe0, 0xea, 0x1e, 0xb9, 0x06, 0x39, 0x1e, 0xbd, 0x5c, 0xb1, 0x81, 0x36, 0xc0, 0x44,
+	0x87, 0x0c, 0x30, 0xf4, 0x13, 0x98, 0xea, 0xeb, 0xa7, 0xad, 0x50, 0x2e, 0x45, 0xe5, 0x68, 0x1e,
+	0xdb, 0xd7, 0x4f, 0xeb, 0xe3, 0xa2, 0x05, 0x01, 0xae, 0xfe, 0x7b, 0x58, 0x2b, 0x89, 0x7e, 0x43,
+	0xf5, 0x00, 0x4a, 0x83, 0xe0, 0x21, 0x98, 0x3d, 0x16, 0x0a, 0xe9, 0xf9, 0x32, 0xa4, 0xc5, 0x27,
+	0x6f, 0x5a, 0xa6, 0xc8, 0x7a, 0xf8, 0x69, 0x3d, 0xa3, 0xd0, 0xd3, 0x8c, 0x1d, 0xdb, 0xa7, 0x65,
+	0x8a, 0xe0, 0xce, 0xd9, 0x8b, 0xdd, 0x99, 0x9e, 0xf6, 0xd3, 0xd5, 0x7f, 0xd1, 0x60, 0x41, 0xfd,
+	0x1b, 0x0f, 0xa1, 0xcc, 0xa0, 0x5d, 0xa1, 0xcc, 0x90, 0xb8, 0xf0, 0x6a, 0x67, 0x0f, 0x72, 0x06,
+	0xd6, 0x8d, 0x9e, 0x69, 0x61, 0x7e, 0x58, 0x3f, 0xaf, 0x5b, 0x92, 0x2e, 0x6c, 0xc0, 0x2f, 0x2e,
+	0x6c, 0x80, 0x55, 0x3f, 0xd5, 0x60, 0x26, 0xf6, 0x33, 0x35, 0xf4, 0x1a, 0xe4, 0xe8, 0x6f, 0xc8,
+	0xa3, 0x52, 0x0d, 0x75, 0x29, 0x8a, 0xc9, 0xf7, 0xcb, 0x1c, 0x42, 0x3f, 0x80, 0x7c, 0xf8, 0xcb,
+	0x35, 0xde, 0x52, 0xc0, 0xc2, 0x55, 0x00, 0x4a, 0xe1, 0x2a, 0x00, 0x79, 0x95, 0xe7, 0x0f, 0x61,
+	0x71, 0xe2, 0x6f, 0xd6, 0xae, 0x74, 0x99, 0x1c, 0xcd, 0x63, 0xea, 0x4a, 0xe5, 0x9a, 0x53, 0xba,
+	0x8a, 0xe7, 0x5b, 0x4f, 0x5c, 0x68, 0x3d, 0x72, 0xa0, 0xe4, 0x25, 0x1d, 0x28, 0x51, 0xfd, 0x4b,
+	0x0d, 0x4a, 0xf1, 0x5f, 0x26, 0x5d, 0xc9, 0x75, 0xe4, 0xf6, 0xd9, 0xc4, 0xb3, 0x68, 0x9f, 0xad,
+	0x1e, 0xd3, 0xa2, 0x5b, 0xf4, 0x3b, 0xb2, 0xfb, 0x90, 0x1e, 0xd8, 0x76, 0xcf, 0xe5, 0x6d, 0x4c,
+	0x74, 0x38, 0x14, 0x10, 0x87, 0x43, 0x81, 0x6b, 0x94, 0xf8, 0xfc, 0x20, 0x32, 0x44, 0xbf, 0x8a,
+	0xfb, 0x1a, 0x96, 0xfc, 0x95, 0x3f, 0xd3, 0xe0, 0xd6, 0x84, 0x56, 0x68, 0x74, 0x17, 0x56, 0x36,
+	0x36, 0xf7, 0x37, 0xf7, 0x36, 0x36, 0xf7, 0xd6, 0x1f, 0xb7, 0x1e, 0xd4, 0xb7, 0x76, 0x0e, 0x9b,
+	0x9b, 0xad, 0xfd, 0x87, 0x3b, 0x5b, 0xeb, 0x8f, 0x5b, 0xeb, 0xf5, 0xbd, 0xf5, 0xcd, 0x9d, 0xd2,
+	0x0d, 0x54, 0x85, 0xe5, 0xc9, 0x5c, 0xe4, 0xb1, 0xa4, 0xa1, 0x7b, 0x70, 0x77, 0x32, 0x4f, 0xf3,
+	0x70, 0xaf, 0x55, 0xdf, 0x7b, 0xfc, 0xd3, 0xfa, 0xe3, 0x52, 0xe2, 0x95, 0xd7, 0x20, 0x17, 0x34,
+	0x8f, 0x20, 0x80, 0xcc, 0xfb, 0x87, 0x9b, 0x87, 0x9b, 0x1b, 0xa5, 0x1b, 0xa8, 0x00, 0x59, 0x22,
+	0xbf, 0xb5, 0xf7, 0x4e, 0x49, 0x23, 0x0f, 0xcd, 0xc3, 0xbd, 0x3d, 0xf2, 0x90, 0x78, 0x65, 0x47,
+	0x6c, 0xad, 0xe5, 0xa7, 0xa2, 0x29, 0xc8, 0xd5, 0x07, 0x03, 0xba, 0x3d, 0x33, 0xd9, 0xcd, 0x13,
+	0x93, 0xec, 0x92, 0x25, 0x0d, 0x65, 0x21, 0xf9, 0xf0, 0xe1, 0x6e, 0x29, 0x81, 0xe6, 0xa0, 0xb4,
+	0xc1, 0x3f, 0xfe, 0x20, 0x27, 0x28, 0x25, 0x1b, 0x4f, 0xff, 0xf5, 0xcb, 0x65, 0xed, 0x8b, 0x2f,
+	0x97, 0xb5, 0xff, 0xfa, 0x72, 0x59, 0xfb, 0xec, 0xab, 0xe5, 0x1b, 0x5f, 0x7c, 0xb5, 0x7c, 0xe3,
+	0x3f, 0xbe, 0x5a, 0xbe, 0xf1, 0xfb, 0xaf, 0x75, 0x4d, 0xef, 0x89, 0xdf, 0xae, 0x75, 0xec, 0x3e,
+	0xff, 0x8b, 0x21, 0x03, 0xc7, 0x26, 0x9b, 0x2f, 0x7f, 0x5a, 0x8d, 0xff, 0x29, 0x91, 0xbf, 0x4b,
+	0xdc, 0xae, 0xd3, 0xc7, 0x7d, 0xc6, 0x57, 0xdb, 0xb2, 0x6b, 0x0c, 0xa0, 0x7f, 0x3c, 0xc2, 0x6d,
+	0x67, 0xa8, 0x5f, 0xbe, 0xfe, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xbd, 0x31, 0xd2, 0x85,
+	0x44, 0x00, 0x00,
 }
 
 func (m *EventSequence) Marshal() (dAtA []byte, err error) {
@@ -4494,59 +5063,151 @@ func (m *EventSequence_Event_JobValidated) MarshalToSizedBuffer(dAtA []byte) (in
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
+func (m *EventSequence_Event_CancelJobArray) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelJobArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelJobArray != nil {
+		{
+			size, err := m.CancelJobArray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_ReprioritiseJobArray) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ReprioritiseJobArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvgResourcesForPeriod) > 0 {
-		for k := range m.AvgResourcesForPeriod {
-			v := m.AvgResourcesForPeriod[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintEvents(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.ReprioritiseJobArray != nil {
+		{
+			size, err := m.ReprioritiseJobArray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x3a
-	}
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobDeferralEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobDeferralEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobDeferralEnded != nil {
+		{
+			size, err := m.JobDeferralEnded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobRunPreemptionNotice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobRunPreemptionNotice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobRunPreemptionNotice != nil {
+		{
+			size, err := m.JobRunPreemptionNotice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvgResourcesForPeriod) > 0 {
+		for k := range m.AvgResourcesForPeriod {
+			v := m.AvgResourcesForPeriod[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEvents(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.JobId) > 0 {
 		i -= len(m.JobId)
 		copy(dAtA[i:], m.JobId)
@@ -4641,6 +5302,66 @@ func (m *SubmitJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.QueueTtlSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QueueTtlSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ArraySize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ArraySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DependencyFailurePolicy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DependencyFailurePolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.JobId) > 0 {
 		i -= len(m.JobId)
 		copy(dAtA[i:], m.JobId)
@@ -4946,6 +5667,27 @@ func (m *KubernetesObject_ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *KubernetesObject_Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5189,6 +5931,41 @@ func (m *ReprioritiseJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReprioritiseJobArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReprioritiseJobArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReprioritiseJobArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ArrayId) > 0 {
+		i -= len(m.ArrayId)
+		copy(dAtA[i:], m.ArrayId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ArrayId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReprioritisedJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5282,20 +6059,20 @@ func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.States) > 0 {
-		dAtA41 := make([]byte, len(m.States)*10)
-		var j40 int
+		dAtA48 := make([]byte, len(m.States)*10)
+		var j47 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j47++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintEvents(dAtA, i, uint64(j40))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintEvents(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5330,20 +6107,57 @@ func (m *CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.States) > 0 {
-		dAtA43 := make([]byte, len(m.States)*10)
-		var j42 int
+		dAtA50 := make([]byte, len(m.States)*10)
+		var j49 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j49++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA50[j49] = uint8(num)
+			j49++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintEvents(dAtA, i, uint64(j42))
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintEvents(dAtA, i, uint64(j49))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelJobArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelJobArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelJobArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ArrayId) > 0 {
+		i -= len(m.ArrayId)
+		copy(dAtA[i:], m.ArrayId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ArrayId)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6288,6 +7102,48 @@ func (m *Error_JobRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Error_JobDependencyFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_JobDependencyFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobDependencyFailed != nil {
+		{
+			size, err := m.JobDependencyFailed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Error_RetryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_RetryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RetryDecision != nil {
+		{
+			size, err := m.RetryDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *KubernetesError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6795,7 +7651,7 @@ func (m *JobRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
+func (m *JobDependencyFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6805,28 +7661,115 @@ func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobDependencyFailed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobDependencyFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+	if len(m.DependencyJobId) > 0 {
+		i -= len(m.DependencyJobId)
+		copy(dAtA[i:], m.DependencyJobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DependencyJobId)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	if len(m.PreemptedRunId) > 0 {
-		i -= len(m.PreemptedRunId)
-		copy(dAtA[i:], m.PreemptedRunId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreemptedRunId)))
-		i--
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Retry {
+		i--
+		if m.Retry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PreemptedRunId) > 0 {
+		i -= len(m.PreemptedRunId)
+		copy(dAtA[i:], m.PreemptedRunId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreemptedRunId)))
+		i--
 		dAtA[i] = 0x32
 	}
 	if len(m.PreemptedJobId) > 0 {
@@ -6839,6 +7782,55 @@ func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobRunPreemptionNotice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunPreemptionNotice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunPreemptionNotice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		{
+			size, err := m.Deadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PartitionMarker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6948,6 +7940,48 @@ func (m *JobPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *JobDeferralEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDeferralEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDeferralEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JobValidated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7360,6 +8394,54 @@ func (m *EventSequence_Event_JobValidated) Size() (n int) {
 	}
 	return n
 }
+func (m *EventSequence_Event_CancelJobArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelJobArray != nil {
+		l = m.CancelJobArray.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ReprioritiseJobArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReprioritiseJobArray != nil {
+		l = m.ReprioritiseJobArray.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobDeferralEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobDeferralEnded != nil {
+		l = m.JobDeferralEnded.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunPreemptionNotice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunPreemptionNotice != nil {
+		l = m.JobRunPreemptionNotice.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ResourceUtilisation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7470,6 +8552,29 @@ func (m *SubmitJob) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.DependencyFailurePolicy != 0 {
+		n += 2 + sovEvents(uint64(m.DependencyFailurePolicy))
+	}
+	if m.ArraySize != 0 {
+		n += 2 + sovEvents(uint64(m.ArraySize))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.QueueTtlSeconds != 0 {
+		n += 2 + sovEvents(uint64(m.QueueTtlSeconds))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -7565,6 +8670,18 @@ func (m *KubernetesObject_ConfigMap) Size() (n int) {
 	}
 	return n
 }
+func (m *KubernetesObject_Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ObjectMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7673,6 +8790,22 @@ func (m *ReprioritiseJobSet) Size() (n int) {
 	return n
 }
 
+func (m *ReprioritiseJobArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ArrayId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	return n
+}
+
 func (m *ReprioritisedJob) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7742,6 +8875,23 @@ func (m *CancelJobSet) Size() (n int) {
 	return n
 }
 
+func (m *CancelJobArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ArrayId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *CancelledJob) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8191,30 +9341,54 @@ func (m *Error_JobRejected) Size() (n int) {
 	}
 	return n
 }
-func (m *KubernetesError) Size() (n int) {
+func (m *Error_JobDependencyFailed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ResourceType)
-	if l > 0 {
+	if m.JobDependencyFailed != nil {
+		l = m.JobDependencyFailed.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
-
-func (m *PodError) Size() (n int) {
+func (m *Error_RetryDecision) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
+	if m.RetryDecision != nil {
+		l = m.RetryDecision.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Message)
+	return n
+}
+func (m *KubernetesError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *PodError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -8413,6 +9587,45 @@ func (m *JobRejected) Size() (n int) {
 	return n
 }
 
+func (m *JobDependencyFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DependencyJobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Retry {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovEvents(uint64(m.Attempts))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovEvents(uint64(m.MaxAttempts))
+	}
+	return n
+}
+
 func (m *JobRunPreempted) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8434,6 +9647,27 @@ func (m *JobRunPreempted) Size() (n int) {
 	return n
 }
 
+func (m *JobRunPreemptionNotice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = m.Deadline.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *PartitionMarker) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8484,6 +9718,23 @@ func (m *JobPreemptionRequested) Size() (n int) {
 	return n
 }
 
+func (m *JobDeferralEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *JobValidated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9608,6 +10859,146 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &EventSequence_Event_JobValidated{v}
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelJobArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelJobArray{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_CancelJobArray{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritiseJobArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReprioritiseJobArray{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ReprioritiseJobArray{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDeferralEnded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobDeferralEnded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobDeferralEnded{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunPreemptionNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunPreemptionNotice{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunPreemptionNotice{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10515,30 +11906,191 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 			}
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyFailurePolicy", wireType)
+			}
+			m.DependencyFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependencyFailurePolicy |= DependencyFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArraySize", wireType)
+			}
+			m.ArraySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArraySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			}
+			m.QueueTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueTtlSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &schedulerobjects.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -10862,6 +12414,41 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 			}
 			m.Object = &KubernetesObject_ConfigMap{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v11.Secret{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Object = &KubernetesObject_Secret{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11740,7 +13327,7 @@ func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
+func (m *ReprioritiseJobArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11763,34 +13350,15 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReprioritiseJobArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReprioritiseJobArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			m.Priority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11818,8 +13386,27 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.ArrayId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11841,7 +13428,7 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelJob) Unmarshal(dAtA []byte) error {
+func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11864,17 +13451,17 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11884,24 +13471,11 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Priority |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
@@ -11955,7 +13529,7 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+func (m *CancelJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11978,21 +13552,135 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
@@ -12225,6 +13913,120 @@ func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CancelJobArray) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelJobArray: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelJobArray: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArrayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14728,13 +16530,83 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = &Error_JobRejected{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDependencyFailed", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobDependencyFailed{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Reason = &Error_JobDependencyFailed{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDecision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RetryDecision{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Reason = &Error_RetryDecision{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if (iNdEx + skippy) > l {
@@ -16228,7 +18100,7 @@ func (m *JobRejected) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
+func (m *JobDependencyFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16251,47 +18123,15 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunPreempted: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobDependencyFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunPreempted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobDependencyFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedJobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreemptedJobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedRunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16319,11 +18159,11 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptedRunId = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyJobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16351,7 +18191,7 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.DependencyJobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16374,7 +18214,7 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
+func (m *RetryDecision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16397,17 +18237,17 @@ func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionMarker: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryDecision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionMarker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			m.Partition = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -16417,14 +18257,15 @@ func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Retry = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16452,8 +18293,46 @@ func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -16475,7 +18354,7 @@ func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
+func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16498,15 +18377,15 @@ func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunPreemptionRequested: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunPreempted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunPreemptionRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunPreempted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedJobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16534,11 +18413,11 @@ func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.PreemptedJobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptedRunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16566,7 +18445,39 @@ func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.PreemptedRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16589,7 +18500,7 @@ func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobPreemptionRequested) Unmarshal(dAtA []byte) error {
+func (m *JobRunPreemptionNotice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16612,13 +18523,13 @@ func (m *JobPreemptionRequested) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobPreemptionRequested: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRunPreemptionNotice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPreemptionRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRunPreemptionNotice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -16650,9 +18561,9 @@ func (m *JobPreemptionRequested) Unmarshal(dAtA []byte) error {
 			}
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16680,7 +18591,490 @@ func (m *JobPreemptionRequested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = &types.Timestamp{}
+			}
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionMarker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionMarker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionMarker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunPreemptionRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunPreemptionRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunPreemptionRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobPreemptionRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobPreemptionRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobPreemptionRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobDeferralEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobDeferralEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobDeferralEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24,10 +24,14 @@ func (ev *EventSequence_Event) GetEventName() string {
 		return "ReprioritiseJob"
 	case *EventSequence_Event_ReprioritiseJobSet:
 		return "ReprioritiseJobSet"
+	case *EventSequence_Event_ReprioritiseJobArray:
+		return "ReprioritiseJobArray"
 	case *EventSequence_Event_CancelJob:
 		return "CancelJob"
 	case *EventSequence_Event_CancelJobSet:
 		return "CancelJobSet"
+	case *EventSequence_Event_CancelJobArray:
+		return "CancelJobArray"
 	case *EventSequence_Event_CancelledJob:
 		return "CancelledJob"
 	case *EventSequence_Event_JobRunCancelled:
@@ -38,10 +42,14 @@ func (ev *EventSequence_Event) GetEventName() string {
 		return "PartitionMarker"
 	case *EventSequence_Event_JobRunPreempted:
 		return "JobRunPreemped"
+	case *EventSequence_Event_JobRunPreemptionNotice:
+		return "JobRunPreemptionNotice"
 	case *EventSequence_Event_JobRunAssigned:
 		return "JobRunAssigned"
 	case *EventSequence_Event_JobValidated:
 		return "JobValidated"
+	case *EventSequence_Event_JobDeferralEnded:
+		return "JobDeferralEnded"
 	case *EventSequence_Event_ReprioritisedJob:
 		return "ReprioritisedJob"
 	case *EventSequence_Event_ResourceUtilisation:
@@ -51,3 +59,55 @@ func (ev *EventSequence_Event) GetEventName() string {
 	}
 	return "Unknown"
 }
+
+// errorReasonNames contains the name of each kind of error, as returned by Error.GetReasonName.
+var errorReasonNames = map[string]bool{
+	"KubernetesError":      true,
+	"ContainerError":       true,
+	"ExecutorError":        true,
+	"LeaseExpired":         true,
+	"MaxRunsExceeded":      true,
+	"PodError":             true,
+	"PodLeaseReturned":     true,
+	"JobRunPreemptedError": true,
+	"GangJobUnschedulable": true,
+	"JobRejected":          true,
+	"JobDependencyFailed":  true,
+	"RetryDecision":        true,
+}
+
+// GetReasonName returns the name of the kind of error, e.g., "PodError".
+func (e *Error) GetReasonName() string {
+	switch e.GetReason().(type) {
+	case *Error_KubernetesError:
+		return "KubernetesError"
+	case *Error_ContainerError:
+		return "ContainerError"
+	case *Error_ExecutorError:
+		return "ExecutorError"
+	case *Error_LeaseExpired:
+		return "LeaseExpired"
+	case *Error_MaxRunsExceeded:
+		return "MaxRunsExceeded"
+	case *Error_PodError:
+		return "PodError"
+	case *Error_PodLeaseReturned:
+		return "PodLeaseReturned"
+	case *Error_JobRunPreemptedError:
+		return "JobRunPreemptedError"
+	case *Error_GangJobUnschedulable:
+		return "GangJobUnschedulable"
+	case *Error_JobRejected:
+		return "JobRejected"
+	case *Error_JobDependencyFailed:
+		return "JobDependencyFailed"
+	case *Error_RetryDecision:
+		return "RetryDecision"
+	}
+	return "Unknown"
+}
+
+// IsErrorReasonName returns true if name is the name of a kind of error.
+func IsErrorReasonName(name string) bool {
+	return errorReasonNames[name]
+}
@@ -36,12 +36,16 @@ func JobIdFromEvent(event *EventSequence_Event) (string, error) {
 		return e.StandaloneIngressInfo.JobId, nil
 	case *EventSequence_Event_JobRunPreempted:
 		return e.JobRunPreempted.PreemptedJobId, nil
+	case *EventSequence_Event_JobRunPreemptionNotice:
+		return e.JobRunPreemptionNotice.JobId, nil
 	case *EventSequence_Event_JobRunCancelled:
 		return e.JobRunCancelled.JobId, nil
 	case *EventSequence_Event_JobRequeued:
 		return e.JobRequeued.JobId, nil
 	case *EventSequence_Event_JobValidated:
 		return e.JobValidated.JobId, nil
+	case *EventSequence_Event_JobDeferralEnded:
+		return e.JobDeferralEnded.JobId, nil
 	default:
 		err := errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "event.Event",
@@ -0,0 +1,88 @@
+package kerberos
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/pkg/errors"
+)
+
+type ClientDetails struct {
+	Enabled bool
+	// Service principal of the Armada server, e.g. HTTP/armada.example.com.
+	ServicePrincipalName string
+	// Ticket cache to read credentials from. Defaults to $KRB5CCNAME, or /tmp/krb5cc_<uid> if that isn't set.
+	CCachePath string
+	// Kerberos configuration file. Defaults to $KRB5_CONFIG, or /etc/krb5.conf if that isn't set.
+	ConfigPath string
+}
+
+// AuthenticateWithTicketCache returns credentials which authenticate every request with a SPNEGO token
+// created from the tickets in the user's ticket cache, e.g. as populated by kinit.
+func AuthenticateWithTicketCache(details ClientDetails) (*NegotiateCredentials, error) {
+	if details.ServicePrincipalName == "" {
+		return nil, errors.New("kerberos auth requires a service principal name")
+	}
+	configPath := firstNonEmpty(details.ConfigPath, os.Getenv("KRB5_CONFIG"), "/etc/krb5.conf")
+	krb5Config, err := config.Load(configPath)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error loading kerberos config %s", configPath)
+	}
+	ccachePath := firstNonEmpty(
+		details.CCachePath,
+		strings.TrimPrefix(os.Getenv("KRB5CCNAME"), "FILE:"),
+		fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid()),
+	)
+	ccache, err := credentials.LoadCCache(ccachePath)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error loading ticket cache %s", ccachePath)
+	}
+	cl, err := client.NewFromCCache(ccache, krb5Config)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error creating kerberos client from ticket cache %s", ccachePath)
+	}
+	return &NegotiateCredentials{
+		client:               cl,
+		servicePrincipalName: details.ServicePrincipalName,
+	}, nil
+}
+
+type NegotiateCredentials struct {
+	client               *client.Client
+	servicePrincipalName string
+}
+
+func (c *NegotiateCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	// A new token is created for every request, since the server rejects authenticators it has already seen.
+	token, err := spnego.SPNEGOClient(c.client, c.servicePrincipalName).InitSecContext()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error creating spnego token for %s", c.servicePrincipalName)
+	}
+	payload, err := token.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"authorization": "Negotiate " + base64.StdEncoding.EncodeToString(payload),
+	}, nil
+}
+
+func (c *NegotiateCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/pkg/client/auth/exec"
+	"github.com/armadaproject/armada/pkg/client/auth/kerberos"
 	"github.com/armadaproject/armada/pkg/client/auth/kubernetes"
 	"github.com/armadaproject/armada/pkg/client/auth/oidc"
 )
@@ -23,6 +24,9 @@ import (
 type ApiConnectionDetails struct {
 	ArmadaUrl     string
 	ArmadaRestUrl string
+	// gRPC address of the Binoculars instance of each executor cluster, with {CLUSTER_ID} in place of the cluster name.
+	// E.g. binoculars.{CLUSTER_ID}.example.com:443. Used to fetch job logs.
+	BinocularsUrlPattern string
 	// Names of executor clusters as they appear in the local kubeconfig file.
 	// Used by the test suite to download logs from pods running tests.
 	ExecutorClusters []string
@@ -45,6 +49,7 @@ type ApiConnectionDetails struct {
 	OpenIdKubernetesAuth        oidc.KubernetesDetails
 	ForceNoTls                  bool
 	ExecAuth                    exec.CommandDetails
+	KerberosAuth                kerberos.ClientDetails
 }
 
 type ConnectionDetails func() (*ApiConnectionDetails, error)
@@ -112,6 +117,8 @@ func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredenti
 		return oidc.AuthenticateKubernetes(config.OpenIdKubernetesAuth)
 	} else if config.ExecAuth.Cmd != "" {
 		return exec.NewAuthenticator(config.ExecAuth), nil
+	} else if config.KerberosAuth.Enabled {
+		return kerberos.AuthenticateWithTicketCache(config.KerberosAuth)
 	}
 	return nil, nil
 }
@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type DrainAPI func(executor string, nodeName string, nodeSelector map[string]string, preempt bool, reason string) error
+
+func DrainNodes(getConnectionDetails client.ConnectionDetails) DrainAPI {
+	return func(executor string, nodeName string, nodeSelector map[string]string, preempt bool, reason string) error {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		executorClient := api.NewExecutorClient(conn)
+		drainRequest := &api.ExecutorNodeDrainRequest{
+			Name:         executor,
+			NodeName:     nodeName,
+			NodeSelector: nodeSelector,
+			Preempt:      preempt,
+			Reason:       reason,
+		}
+		if _, err = executorClient.DrainNodes(ctx, drainRequest); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+type UndrainAPI func(executor string, nodeName string, nodeSelector map[string]string) error
+
+func UndrainNodes(getConnectionDetails client.ConnectionDetails) UndrainAPI {
+	return func(executor string, nodeName string, nodeSelector map[string]string) error {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		executorClient := api.NewExecutorClient(conn)
+		undrainRequest := &api.ExecutorNodeUndrainRequest{
+			Name:         executor,
+			NodeName:     nodeName,
+			NodeSelector: nodeSelector,
+		}
+		if _, err = executorClient.UndrainNodes(ctx, undrainRequest); err != nil {
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type GetDetailsAPI func(jobIds []string, expandJobSpec bool, expandJobRun bool) (map[string]*api.JobDetails, error)
+
+func GetDetails(getConnectionDetails client.ConnectionDetails) GetDetailsAPI {
+	return func(jobIds []string, expandJobSpec bool, expandJobRun bool) (map[string]*api.JobDetails, error) {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		client := api.NewJobsClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		response, err := client.GetJobDetails(ctx, &api.JobDetailsRequest{
+			JobIds:        jobIds,
+			ExpandJobSpec: expandJobSpec,
+			ExpandJobRun:  expandJobRun,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get job details request failed: %s", err)
+		}
+
+		return response.JobDetails, nil
+	}
+}
@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type GetErrorsAPI func(jobIds []string) (map[string]string, error)
+
+func GetErrors(getConnectionDetails client.ConnectionDetails) GetErrorsAPI {
+	return func(jobIds []string) (map[string]string, error) {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		client := api.NewJobsClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		response, err := client.GetJobErrors(ctx, &api.JobErrorsRequest{JobIds: jobIds})
+		if err != nil {
+			return nil, fmt.Errorf("get job errors request failed: %s", err)
+		}
+
+		return response.JobErrors, nil
+	}
+}
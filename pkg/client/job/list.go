@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type ListAPI func(request *api.ListJobsRequest) (*api.ListJobsResponse, error)
+
+func List(getConnectionDetails client.ConnectionDetails) ListAPI {
+	return func(request *api.ListJobsRequest) (*api.ListJobsResponse, error) {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		client := api.NewJobsClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		response, err := client.ListJobs(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("list jobs request failed: %s", err)
+		}
+
+		return response, nil
+	}
+}
@@ -14,6 +14,9 @@ type Queue struct {
 	ResourceLimitsByPriorityClassName map[string]api.PriorityClassResourceLimits
 	Cordoned                          bool              `json:"cordoned"`
 	Labels                            map[string]string `json:"labels"`
+	DefaultRetryPolicy                *api.RetryPolicy  `json:"defaultRetryPolicy"`
+	Parent                            string            `json:"parent"`
+	QuotaByPool                       map[string]Quota  `json:"quotaByPool"`
 }
 
 // NewQueue returns new Queue using the in parameter. Error is returned if
@@ -55,6 +58,22 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		}
 	}
 
+	quotaByPool := make(map[string]Quota, len(in.QuotaByPool))
+	for pool, q := range in.QuotaByPool {
+		if q == nil {
+			continue
+		}
+		quota, err := NewQuota(q)
+		if err != nil {
+			return Queue{}, fmt.Errorf("failed to map quota for pool %s. %s", pool, err)
+		}
+		quotaByPool[pool] = quota
+	}
+
+	if in.Parent != "" && in.Parent == in.Name {
+		return Queue{}, fmt.Errorf("queue %s must not be its own parent", in.Name)
+	}
+
 	return Queue{
 		Name:                              in.Name,
 		PriorityFactor:                    priorityFactor,
@@ -62,6 +81,9 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		ResourceLimitsByPriorityClassName: resourceLimitsByPriorityClassName,
 		Cordoned:                          in.Cordoned,
 		Labels:                            in.Labels,
+		DefaultRetryPolicy:                in.DefaultRetryPolicy,
+		Parent:                            in.Parent,
+		QuotaByPool:                       quotaByPool,
 	}, nil
 }
 
@@ -75,8 +97,11 @@ func (q Queue) ToAPI() *api.Queue {
 			func(p api.PriorityClassResourceLimits) *api.PriorityClassResourceLimits {
 				return &p
 			}),
-		Cordoned: q.Cordoned,
-		Labels:   q.Labels,
+		Cordoned:           q.Cordoned,
+		Labels:             q.Labels,
+		DefaultRetryPolicy: q.DefaultRetryPolicy,
+		Parent:             q.Parent,
+		QuotaByPool:        armadamaps.MapValues(q.QuotaByPool, Quota.ToAPI),
 	}
 	for _, permission := range q.Permissions {
 		rv.Permissions = append(rv.Permissions, permission.ToAPI())
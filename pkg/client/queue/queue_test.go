@@ -6,8 +6,11 @@ import (
 	"testing"
 	"testing/quick"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/common/pointer"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -35,6 +38,7 @@ func TestQueueWithLabels(t *testing.T) {
 		Permissions:                       []Permissions{},
 		Labels:                            map[string]string{"armadaproject.io/gpu-category": "gang-user", "armadaproject.io/priority": "critical"},
 		ResourceLimitsByPriorityClassName: make(map[string]api.PriorityClassResourceLimits),
+		QuotaByPool:                       make(map[string]Quota),
 	}
 	queue2, err := NewQueue(queue1.ToAPI())
 	if err != nil {
@@ -54,6 +58,17 @@ func TestQueueWithIncorrectLabels(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestQueueWithNegativeQuota(t *testing.T) {
+	_, err := NewQueue(&api.Queue{
+		Name:           "queue-a",
+		PriorityFactor: 100,
+		QuotaByPool: map[string]*api.QueueQuota{
+			"pool-a": {Guaranteed: map[string]*resource.Quantity{"cpu": pointer.MustParseResource("-1")}},
+		},
+	})
+	require.Error(t, err)
+}
+
 func TestQueueMarshalUnmarshal(t *testing.T) {
 	testCase := func(queue1 Queue) bool {
 		var queue2 Queue
@@ -92,6 +107,12 @@ func TestQueueMarshalUnmarshal(t *testing.T) {
 		}
 		queue2.ResourceLimitsByPriorityClassName = newResourceLimits
 
+		// Empty slices within the retry policy are omitted when marshalling, and hence unmarshalled as nil
+		if !proto.Equal(queue1.DefaultRetryPolicy, queue2.DefaultRetryPolicy) {
+			return false
+		}
+		queue2.DefaultRetryPolicy = queue1.DefaultRetryPolicy
+
 		return reflect.DeepEqual(queue1, queue2)
 	}
 
@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Quota is the absolute amount of resources guaranteed to a queue in a particular pool.
+type Quota struct {
+	Guaranteed       map[string]resource.Quantity `json:"guaranteed"`
+	BorrowingEnabled bool                         `json:"borrowingEnabled"`
+}
+
+// NewQuota returns Quota using the value of in. If any of the guaranteed quantities
+// is missing or negative an error is returned.
+func NewQuota(in *api.QueueQuota) (Quota, error) {
+	guaranteed := make(map[string]resource.Quantity, len(in.Guaranteed))
+	for name, quantity := range in.Guaranteed {
+		if quantity == nil {
+			return Quota{}, fmt.Errorf("quota for resource %s is missing", name)
+		}
+		if quantity.Sign() < 0 {
+			return Quota{}, fmt.Errorf("quota for resource %s must not be negative. Value: %s", name, quantity.String())
+		}
+		guaranteed[name] = *quantity
+	}
+	return Quota{
+		Guaranteed:       guaranteed,
+		BorrowingEnabled: in.BorrowingEnabled,
+	}, nil
+}
+
+// ToAPI transforms Quota to *api.QueueQuota structure
+func (q Quota) ToAPI() *api.QueueQuota {
+	guaranteed := make(map[string]*resource.Quantity, len(q.Guaranteed))
+	for name, quantity := range q.Guaranteed {
+		quantity := quantity
+		guaranteed[name] = &quantity
+	}
+	return &api.QueueQuota{
+		Guaranteed:       guaranteed,
+		BorrowingEnabled: q.BorrowingEnabled,
+	}
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (Quota) Generate(rand *rand.Rand, size int) reflect.Value {
+	resourceNames := []string{"cpu", "memory", "nvidia.com/gpu"}
+	guaranteed := make(map[string]resource.Quantity)
+	for _, name := range resourceNames {
+		if rand.Intn(2) == 0 {
+			guaranteed[name] = resource.MustParse(strconv.FormatInt(rand.Int63n(10000), 10))
+		}
+	}
+	return reflect.ValueOf(Quota{
+		Guaranteed:       guaranteed,
+		BorrowingEnabled: rand.Intn(2) == 0,
+	})
+}
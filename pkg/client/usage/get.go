@@ -0,0 +1,37 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type GetAPI func(request *api.UsageRequest) (*api.UsageResponse, error)
+
+func Get(getConnectionDetails client.ConnectionDetails) GetAPI {
+	return func(request *api.UsageRequest) (*api.UsageResponse, error) {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		client := api.NewUsageClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		response, err := client.GetUsage(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("get usage request failed: %s", err)
+		}
+
+		return response, nil
+	}
+}
@@ -122,6 +122,85 @@ func WatchJobSetWithJobIdsFilter(
 	}
 }
 
+// WatchQueue streams the events of the job sets in a queue matching request, calling onUpdate with each event and the
+// state of the jobs seen so far. It reconnects if the stream is interrupted, resuming each job set after the last
+// message received from it. It returns when onUpdate returns true, the context is cancelled, or, if request.Watch is
+// false, once all events have been received.
+func WatchQueue(
+	client api.EventClient,
+	request *api.WatchQueueRequest,
+	context context.Context,
+	onUpdate func(state *domain.WatchContext, jobSetId string, event api.Event) bool,
+) *domain.WatchContext {
+	state := domain.NewWatchContext()
+
+	lastMessageIds := make(map[string]string, len(request.FromMessageIds))
+	for jobSetId, messageId := range request.FromMessageIds {
+		lastMessageIds[jobSetId] = messageId
+	}
+
+	for {
+		select {
+		case <-context.Done():
+			return state
+		default:
+		}
+
+		clientStream, e := client.WatchQueue(context,
+			&api.WatchQueueRequest{
+				Queue:          request.Queue,
+				JobSetPrefix:   request.JobSetPrefix,
+				EventTypes:     request.EventTypes,
+				Labels:         request.Labels,
+				FromMessageIds: lastMessageIds,
+				Watch:          request.Watch,
+			},
+		)
+
+		if e != nil {
+			log.Error(e.Error())
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		for {
+			msg, e := clientStream.Recv()
+			if e != nil {
+				if err, ok := status.FromError(e); ok {
+					switch err.Code() {
+					case codes.NotFound, codes.PermissionDenied, codes.InvalidArgument:
+						log.Error(err.Message())
+						return state
+					}
+				}
+				if e == io.EOF {
+					return state
+				}
+				if !isTransportClosingError(e) {
+					log.Error(e.Error())
+				}
+				time.Sleep(5 * time.Second)
+				break
+			}
+			lastMessageIds[msg.JobSetId] = msg.Id
+
+			event, e := api.UnwrapEvent(msg.Message)
+			if e != nil {
+				// This can mean that the event type reported from server is unknown to the client
+				log.Error(e.Error())
+				continue
+			}
+
+			state.ProcessEvent(event)
+
+			shouldExit := onUpdate(state, msg.JobSetId, event)
+			if shouldExit {
+				return state
+			}
+		}
+	}
+}
+
 func isTransportClosingError(e error) bool {
 	if err, ok := status.FromError(e); ok {
 		switch err.Code() {
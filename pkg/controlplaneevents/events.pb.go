@@ -68,6 +68,8 @@ type Event struct {
 	//	*Event_CancelOnExecutor
 	//	*Event_PreemptOnQueue
 	//	*Event_CancelOnQueue
+	//	*Event_NodeDrainUpsert
+	//	*Event_NodeDrainDelete
 	Event isEvent_Event `protobuf_oneof:"event"`
 }
 
@@ -128,6 +130,12 @@ type Event_PreemptOnQueue struct {
 type Event_CancelOnQueue struct {
 	CancelOnQueue *CancelOnQueue `protobuf:"bytes,7,opt,name=cancelOnQueue,proto3,oneof" json:"cancelOnQueue,omitempty"`
 }
+type Event_NodeDrainUpsert struct {
+	NodeDrainUpsert *NodeDrainUpsert `protobuf:"bytes,8,opt,name=nodeDrainUpsert,proto3,oneof" json:"nodeDrainUpsert,omitempty"`
+}
+type Event_NodeDrainDelete struct {
+	NodeDrainDelete *NodeDrainDelete `protobuf:"bytes,9,opt,name=nodeDrainDelete,proto3,oneof" json:"nodeDrainDelete,omitempty"`
+}
 
 func (*Event_ExecutorSettingsUpsert) isEvent_Event() {}
 func (*Event_ExecutorSettingsDelete) isEvent_Event() {}
@@ -135,6 +143,8 @@ func (*Event_PreemptOnExecutor) isEvent_Event()      {}
 func (*Event_CancelOnExecutor) isEvent_Event()       {}
 func (*Event_PreemptOnQueue) isEvent_Event()         {}
 func (*Event_CancelOnQueue) isEvent_Event()          {}
+func (*Event_NodeDrainUpsert) isEvent_Event()        {}
+func (*Event_NodeDrainDelete) isEvent_Event()        {}
 
 func (m *Event) GetEvent() isEvent_Event {
 	if m != nil {
@@ -192,6 +202,20 @@ func (m *Event) GetCancelOnQueue() *CancelOnQueue {
 	return nil
 }
 
+func (m *Event) GetNodeDrainUpsert() *NodeDrainUpsert {
+	if x, ok := m.GetEvent().(*Event_NodeDrainUpsert); ok {
+		return x.NodeDrainUpsert
+	}
+	return nil
+}
+
+func (m *Event) GetNodeDrainDelete() *NodeDrainDelete {
+	if x, ok := m.GetEvent().(*Event_NodeDrainDelete); ok {
+		return x.NodeDrainDelete
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -201,6 +225,8 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_CancelOnExecutor)(nil),
 		(*Event_PreemptOnQueue)(nil),
 		(*Event_CancelOnQueue)(nil),
+		(*Event_NodeDrainUpsert)(nil),
+		(*Event_NodeDrainDelete)(nil),
 	}
 }
 
@@ -548,6 +574,150 @@ func (m *CancelOnQueue) GetJobStates() []ActiveJobState {
 	return nil
 }
 
+type NodeDrainUpsert struct {
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeName     string            `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Preempt      bool              `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	Reason       string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SetByUser    string            `protobuf:"bytes,6,opt,name=setByUser,proto3" json:"setByUser,omitempty"`
+}
+
+func (m *NodeDrainUpsert) Reset()         { *m = NodeDrainUpsert{} }
+func (m *NodeDrainUpsert) String() string { return proto.CompactTextString(m) }
+func (*NodeDrainUpsert) ProtoMessage()    {}
+func (*NodeDrainUpsert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ccee8bdbf348752, []int{7}
+}
+func (m *NodeDrainUpsert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDrainUpsert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDrainUpsert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeDrainUpsert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDrainUpsert.Merge(m, src)
+}
+func (m *NodeDrainUpsert) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDrainUpsert) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDrainUpsert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDrainUpsert proto.InternalMessageInfo
+
+func (m *NodeDrainUpsert) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeDrainUpsert) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *NodeDrainUpsert) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *NodeDrainUpsert) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
+func (m *NodeDrainUpsert) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *NodeDrainUpsert) GetSetByUser() string {
+	if m != nil {
+		return m.SetByUser
+	}
+	return ""
+}
+
+type NodeDrainDelete struct {
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeName     string            `protobuf:"bytes,2,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NodeDrainDelete) Reset()         { *m = NodeDrainDelete{} }
+func (m *NodeDrainDelete) String() string { return proto.CompactTextString(m) }
+func (*NodeDrainDelete) ProtoMessage()    {}
+func (*NodeDrainDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ccee8bdbf348752, []int{8}
+}
+func (m *NodeDrainDelete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDrainDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDrainDelete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeDrainDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDrainDelete.Merge(m, src)
+}
+func (m *NodeDrainDelete) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDrainDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDrainDelete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDrainDelete proto.InternalMessageInfo
+
+func (m *NodeDrainDelete) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeDrainDelete) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *NodeDrainDelete) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("controlplaneevents.ActiveJobState", ActiveJobState_name, ActiveJobState_value)
 	proto.RegisterType((*Event)(nil), "controlplaneevents.Event")
@@ -557,6 +727,10 @@ func init() {
 	proto.RegisterType((*CancelOnExecutor)(nil), "controlplaneevents.CancelOnExecutor")
 	proto.RegisterType((*PreemptOnQueue)(nil), "controlplaneevents.PreemptOnQueue")
 	proto.RegisterType((*CancelOnQueue)(nil), "controlplaneevents.CancelOnQueue")
+	proto.RegisterType((*NodeDrainUpsert)(nil), "controlplaneevents.NodeDrainUpsert")
+	proto.RegisterMapType((map[string]string)(nil), "controlplaneevents.NodeDrainUpsert.NodeSelectorEntry")
+	proto.RegisterType((*NodeDrainDelete)(nil), "controlplaneevents.NodeDrainDelete")
+	proto.RegisterMapType((map[string]string)(nil), "controlplaneevents.NodeDrainDelete.NodeSelectorEntry")
 }
 
 func init() {
@@ -564,54 +738,67 @@ func init() {
 }
 
 var fileDescriptor_2ccee8bdbf348752 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x18, 0xc5, 0xe3, 0xa4, 0x4d, 0x9a, 0xe9, 0x6d, 0x6e, 0x3a, 0xf7, 0x92, 0x9a, 0x14, 0x32, 0x25,
-	0x2d, 0xa8, 0xaa, 0x2a, 0x47, 0x2a, 0x82, 0x25, 0xa2, 0x6e, 0xa2, 0x52, 0x8a, 0xdc, 0x34, 0x25,
-	0x42, 0xb0, 0x73, 0x9c, 0x69, 0x70, 0x89, 0x3d, 0xc6, 0x9e, 0x44, 0x74, 0xc9, 0x96, 0x15, 0xcf,
-	0xc1, 0x0e, 0x36, 0xbc, 0x02, 0xcb, 0x2e, 0x91, 0x90, 0x2c, 0xd4, 0xee, 0xcc, 0x4b, 0x20, 0xcf,
-	0x24, 0xa9, 0xff, 0x51, 0x45, 0x2c, 0x10, 0xab, 0x78, 0x66, 0x7e, 0xe7, 0x3b, 0x67, 0x46, 0xf3,
-	0xc5, 0x06, 0xab, 0xd6, 0xab, 0x5e, 0x4d, 0x23, 0x26, 0xb5, 0x49, 0xdf, 0xea, 0xab, 0x26, 0xc6,
-	0x43, 0x6c, 0x52, 0xa7, 0xc6, 0x7f, 0x24, 0xcb, 0x26, 0x94, 0x40, 0x18, 0x07, 0xca, 0xa8, 0x47,
-	0x48, 0xaf, 0x8f, 0x6b, 0x8c, 0xe8, 0x0c, 0x8e, 0x6b, 0x54, 0x37, 0xb0, 0x43, 0x55, 0xc3, 0xe2,
-	0xa2, 0xea, 0xe7, 0x2c, 0x98, 0x6d, 0xf8, 0x2c, 0xdc, 0x07, 0x39, 0xcd, 0xc6, 0x2a, 0xc5, 0x5d,
-	0x51, 0x58, 0x11, 0xd6, 0xe7, 0xb7, 0xca, 0x12, 0x17, 0x4b, 0x63, 0xb1, 0xf4, 0x74, 0x2c, 0x96,
-	0xaf, 0x79, 0x2e, 0x5a, 0x1c, 0xe1, 0x9b, 0xc4, 0xd0, 0x29, 0x36, 0x2c, 0x7a, 0xda, 0x1a, 0x57,
-	0x80, 0xef, 0x04, 0x50, 0xc2, 0x6f, 0xb0, 0x36, 0xa0, 0xc4, 0x3e, 0xc2, 0x94, 0xea, 0x66, 0xcf,
-	0x69, 0x5b, 0x0e, 0xb6, 0xa9, 0x98, 0x66, 0xc5, 0x37, 0xa4, 0x78, 0x5a, 0xa9, 0x91, 0xa8, 0x90,
-	0xd7, 0x3c, 0x17, 0xad, 0x24, 0x57, 0xbb, 0xf4, 0x7e, 0x94, 0x6a, 0xfd, 0xc2, 0x31, 0x31, 0x4c,
-	0x1d, 0xf7, 0x31, 0xc5, 0x62, 0x66, 0xfa, 0x30, 0x5c, 0x91, 0x1c, 0x86, 0xaf, 0x5d, 0x1d, 0x86,
-	0x33, 0x70, 0x08, 0x16, 0x2d, 0x1b, 0xfb, 0xd4, 0x81, 0x39, 0xb6, 0x10, 0x67, 0x58, 0x8c, 0xdb,
-	0x49, 0x31, 0x9a, 0x51, 0x58, 0x46, 0x9e, 0x8b, 0x96, 0x63, 0x35, 0x42, 0xe6, 0x71, 0x0b, 0x68,
-	0x83, 0xa2, 0xa6, 0x9a, 0x1a, 0xee, 0x07, 0x6c, 0x67, 0x99, 0xed, 0x5a, 0x92, 0xed, 0x4e, 0x84,
-	0x95, 0x2b, 0x9e, 0x8b, 0xca, 0xd1, 0x0a, 0x21, 0xd3, 0x58, 0x7d, 0x78, 0x02, 0x0a, 0x93, 0x20,
-	0x87, 0x03, 0x3c, 0xc0, 0x62, 0x96, 0x39, 0x56, 0xaf, 0xdc, 0x28, 0x23, 0xe5, 0x1b, 0x9e, 0x8b,
-	0xc4, 0xb0, 0x3a, 0xe4, 0x16, 0xa9, 0x0c, 0x8f, 0xc1, 0xc2, 0xd8, 0x9f, 0x5b, 0xe5, 0x98, 0xd5,
-	0xad, 0xab, 0x36, 0xc7, 0x9d, 0x96, 0x3d, 0x17, 0x2d, 0x85, 0xb4, 0x21, 0xa3, 0x70, 0x59, 0x39,
-	0x07, 0x66, 0x59, 0x95, 0xea, 0x0f, 0x01, 0x94, 0x92, 0x2f, 0x2c, 0xbc, 0x03, 0x66, 0x4c, 0xd5,
-	0xc0, 0xac, 0x8f, 0xf2, 0x32, 0xf4, 0x5c, 0x54, 0xf0, 0xc7, 0x81, 0x46, 0x61, 0xeb, 0x70, 0x0b,
-	0xcc, 0x69, 0xc4, 0xee, 0x12, 0x13, 0x77, 0x59, 0x5b, 0xcc, 0xc9, 0x25, 0xcf, 0x45, 0x70, 0x3c,
-	0x17, 0xe0, 0x27, 0x1c, 0x7c, 0x00, 0xfe, 0xe1, 0xcf, 0x2d, 0xac, 0x3a, 0xc4, 0x64, 0x37, 0x38,
-	0x2f, 0x97, 0x3d, 0x17, 0x95, 0x82, 0xf3, 0x01, 0x6d, 0x88, 0x87, 0xf7, 0x40, 0xde, 0xc1, 0x54,
-	0x3e, 0x6d, 0x3b, 0x98, 0xdf, 0xbb, 0xbc, 0xbc, 0xe4, 0xb9, 0xe8, 0xbf, 0xc9, 0x64, 0x40, 0x79,
-	0x49, 0x56, 0x1f, 0xc6, 0x37, 0x3b, 0xba, 0xd0, 0x53, 0x6e, 0xb6, 0xfa, 0x49, 0x00, 0x8b, 0xb1,
-	0xcb, 0x3c, 0xf5, 0x51, 0x6d, 0x82, 0xec, 0x6b, 0xff, 0xfc, 0x1d, 0x31, 0xbd, 0x92, 0x59, 0xcf,
-	0xcb, 0xff, 0x7b, 0x2e, 0x2a, 0xf2, 0x99, 0x00, 0x3b, 0x62, 0xe0, 0x2e, 0xf8, 0xd7, 0xb2, 0x75,
-	0x62, 0xeb, 0xf4, 0x74, 0xa7, 0xaf, 0x3a, 0x0e, 0x76, 0xc4, 0x0c, 0x93, 0xdd, 0xf4, 0x5c, 0x74,
-	0x3d, 0xb2, 0x14, 0xd0, 0x47, 0x55, 0xd5, 0x8f, 0x02, 0x28, 0x46, 0x5b, 0xe1, 0x6f, 0xcf, 0xfc,
-	0x56, 0x00, 0x85, 0x70, 0x33, 0x4d, 0x9d, 0x38, 0x21, 0x43, 0xfa, 0xb7, 0x32, 0x7c, 0x13, 0xc0,
-	0x42, 0xa8, 0xcb, 0xfe, 0x78, 0x04, 0xf8, 0x1c, 0xe4, 0x4f, 0x48, 0xe7, 0x88, 0xaa, 0x74, 0x74,
-	0x92, 0x85, 0xe4, 0xff, 0x9d, 0x6d, 0x8d, 0xea, 0x43, 0xfc, 0x78, 0x84, 0xf2, 0x66, 0x98, 0x08,
-	0x83, 0xcd, 0x30, 0x99, 0xdc, 0x38, 0x00, 0x85, 0xb0, 0x0a, 0xce, 0x83, 0x5c, 0x5b, 0xd9, 0x57,
-	0x0e, 0x9e, 0x29, 0xc5, 0x14, 0x04, 0x20, 0x7b, 0xd8, 0x6e, 0xb4, 0x1b, 0xf5, 0xa2, 0xe0, 0x3f,
-	0x3f, 0x69, 0x6c, 0x1f, 0x35, 0xea, 0xc5, 0xb4, 0x0f, 0x35, 0x1b, 0x4a, 0x7d, 0x4f, 0xd9, 0x2d,
-	0x66, 0xfc, 0x41, 0xab, 0xad, 0x28, 0xfe, 0x60, 0x46, 0x1e, 0x7e, 0x39, 0xaf, 0x08, 0x67, 0xe7,
-	0x15, 0xe1, 0xfb, 0x79, 0x45, 0x78, 0x7f, 0x51, 0x49, 0x9d, 0x5d, 0x54, 0x52, 0x5f, 0x2f, 0x2a,
-	0xa9, 0x17, 0xf7, 0x7b, 0x3a, 0x7d, 0x39, 0xe8, 0x48, 0x1a, 0x31, 0x6a, 0xaa, 0x6d, 0xa8, 0x5d,
-	0xd5, 0xb2, 0xc9, 0x09, 0xd6, 0xe8, 0x68, 0x54, 0x4b, 0xfe, 0x32, 0xf8, 0x90, 0x5e, 0xdd, 0x66,
-	0xeb, 0x4d, 0x4e, 0x4b, 0x7b, 0x44, 0xda, 0xe1, 0x54, 0xd3, 0xa7, 0xd8, 0x2b, 0xdf, 0xe9, 0x64,
-	0xd9, 0xab, 0xfd, 0xee, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xd1, 0xf1, 0xaf, 0x60, 0x08,
-	0x00, 0x00,
+	// 955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xe6, 0xd7, 0x74, 0x9b, 0x26, 0xb3, 0xd0, 0x0d, 0x59, 0x88, 0x4b, 0xba, 0xa0,
+	0x65, 0xb5, 0x72, 0xa4, 0xa2, 0x45, 0x88, 0x03, 0xa2, 0x6e, 0xa2, 0x65, 0x59, 0xe4, 0x76, 0x5b,
+	0x22, 0x04, 0x37, 0xd7, 0x79, 0x1b, 0xd2, 0x26, 0x1e, 0x63, 0x4f, 0x22, 0x72, 0xe4, 0xca, 0x89,
+	0x1b, 0xff, 0x03, 0x37, 0xf8, 0x2b, 0xb8, 0x20, 0xed, 0x11, 0x09, 0xc9, 0x42, 0xcd, 0xcd, 0xfc,
+	0x13, 0xc8, 0x33, 0x76, 0x76, 0xc6, 0x36, 0x55, 0x0a, 0x52, 0xb5, 0xa7, 0x78, 0xde, 0x7c, 0xdf,
+	0xfb, 0xde, 0xfc, 0x78, 0x5f, 0x6c, 0xb4, 0xe7, 0x5c, 0x8c, 0xba, 0x16, 0xb1, 0xa9, 0x4b, 0x26,
+	0xce, 0xc4, 0xb4, 0x01, 0xe6, 0x60, 0x53, 0xaf, 0xcb, 0x7f, 0x34, 0xc7, 0x25, 0x94, 0x60, 0x9c,
+	0x06, 0xb4, 0xd4, 0x11, 0x21, 0xa3, 0x09, 0x74, 0x19, 0xe2, 0x6c, 0xf6, 0xbc, 0x4b, 0xc7, 0x53,
+	0xf0, 0xa8, 0x39, 0x75, 0x38, 0xa9, 0xf3, 0x53, 0x05, 0x15, 0xfb, 0x21, 0x16, 0x3f, 0x45, 0x65,
+	0xcb, 0x05, 0x93, 0xc2, 0xb0, 0xa9, 0xec, 0x2a, 0xf7, 0x37, 0xf7, 0x5b, 0x1a, 0x27, 0x6b, 0x31,
+	0x59, 0xfb, 0x22, 0x26, 0xeb, 0xaf, 0x07, 0xbe, 0xda, 0x88, 0xe0, 0x0f, 0xc9, 0x74, 0x4c, 0x61,
+	0xea, 0xd0, 0xc5, 0x49, 0x9c, 0x01, 0xff, 0xa0, 0xa0, 0x1d, 0xf8, 0x0e, 0xac, 0x19, 0x25, 0xee,
+	0x29, 0x50, 0x3a, 0xb6, 0x47, 0xde, 0xc0, 0xf1, 0xc0, 0xa5, 0xcd, 0x3c, 0x4b, 0xfe, 0x40, 0x4b,
+	0x57, 0xab, 0xf5, 0x33, 0x19, 0xfa, 0xbd, 0xc0, 0x57, 0x77, 0xb3, 0xb3, 0xbd, 0xd4, 0xfe, 0x34,
+	0x77, 0xf2, 0x2f, 0x8a, 0x99, 0xc5, 0xf4, 0x60, 0x02, 0x14, 0x9a, 0x85, 0xf5, 0x8b, 0xe1, 0x8c,
+	0xec, 0x62, 0xf8, 0xdc, 0xd5, 0xc5, 0x70, 0x0c, 0x9e, 0xa3, 0x86, 0xe3, 0x42, 0x88, 0x3a, 0xb2,
+	0x63, 0x89, 0xe6, 0x06, 0x2b, 0xe3, 0x9d, 0xac, 0x32, 0x8e, 0x93, 0x60, 0x5d, 0x0d, 0x7c, 0xf5,
+	0x6e, 0x2a, 0x87, 0x24, 0x9e, 0x96, 0xc0, 0x2e, 0xaa, 0x5b, 0xa6, 0x6d, 0xc1, 0x44, 0x90, 0x2d,
+	0x32, 0xd9, 0x7b, 0x59, 0xb2, 0x87, 0x09, 0xac, 0xde, 0x0e, 0x7c, 0xb5, 0x95, 0xcc, 0x20, 0x89,
+	0xa6, 0xf2, 0xe3, 0x73, 0x54, 0x5b, 0x15, 0xf2, 0x6c, 0x06, 0x33, 0x68, 0x96, 0x98, 0x62, 0xe7,
+	0xca, 0x85, 0x32, 0xa4, 0xfe, 0x66, 0xe0, 0xab, 0x4d, 0x99, 0x2d, 0xa9, 0x25, 0x32, 0xe3, 0xe7,
+	0x68, 0x2b, 0xd6, 0xe7, 0x52, 0x65, 0x26, 0xf5, 0xf6, 0x55, 0x8b, 0xe3, 0x4a, 0x77, 0x03, 0x5f,
+	0xbd, 0x23, 0x71, 0x25, 0x21, 0x39, 0x2d, 0xb6, 0xd1, 0xb6, 0x4d, 0x86, 0xd0, 0x73, 0xcd, 0xb1,
+	0x1d, 0xdd, 0xe8, 0x0a, 0x53, 0xda, 0xcb, 0x52, 0x32, 0x64, 0xa8, 0xfe, 0x56, 0xe0, 0xab, 0x6f,
+	0x24, 0xf8, 0x92, 0x5a, 0x32, 0xb9, 0xa4, 0x17, 0x5d, 0xda, 0xea, 0x1a, 0x7a, 0xd1, 0x6d, 0x95,
+	0xf5, 0x32, 0xae, 0x69, 0x32, 0xb9, 0x5e, 0x46, 0x45, 0x96, 0xab, 0xf3, 0xb7, 0x82, 0x76, 0xb2,
+	0x1b, 0x12, 0xbf, 0x8b, 0x36, 0x6c, 0x73, 0x0a, 0xcc, 0x27, 0xaa, 0x3a, 0x0e, 0x7c, 0xb5, 0x16,
+	0x8e, 0x05, 0x23, 0x60, 0xf3, 0x78, 0x1f, 0x55, 0x2c, 0xe2, 0x0e, 0x89, 0x0d, 0x43, 0xd6, 0xf6,
+	0x15, 0x7d, 0x27, 0xf0, 0x55, 0x1c, 0xc7, 0x04, 0xfc, 0x0a, 0x87, 0x3f, 0x46, 0xb7, 0xf8, 0xf3,
+	0x09, 0x98, 0x1e, 0xb1, 0x59, 0x87, 0x56, 0xf5, 0x56, 0xe0, 0xab, 0x3b, 0x62, 0x5c, 0xe0, 0x4a,
+	0x78, 0xfc, 0x08, 0x55, 0x3d, 0xa0, 0xfa, 0x62, 0xe0, 0x01, 0xef, 0xab, 0xaa, 0x7e, 0x27, 0xf0,
+	0xd5, 0xdb, 0xab, 0xa0, 0xc0, 0x7c, 0x89, 0xec, 0x7c, 0x92, 0x5e, 0x6c, 0xd4, 0xb0, 0x6b, 0x2e,
+	0xb6, 0xf3, 0xab, 0x82, 0x1a, 0xa9, 0x66, 0x5d, 0x7b, 0xab, 0x1e, 0xa2, 0xd2, 0xb7, 0xe1, 0xfd,
+	0xf2, 0x9a, 0xf9, 0xdd, 0xc2, 0xfd, 0xaa, 0xfe, 0x5a, 0xe0, 0xab, 0x75, 0x1e, 0x11, 0xb0, 0x11,
+	0x06, 0x3f, 0x46, 0xdb, 0x8e, 0x3b, 0x26, 0xee, 0x98, 0x2e, 0x0e, 0x27, 0xa6, 0xe7, 0x81, 0xd7,
+	0x2c, 0x30, 0x1a, 0x3b, 0xef, 0xc4, 0x94, 0xc0, 0x4f, 0xb2, 0x3a, 0xbf, 0x28, 0xa8, 0x9e, 0x6c,
+	0xf5, 0x57, 0xbd, 0xe6, 0xef, 0x15, 0x54, 0x93, 0xcd, 0x62, 0xed, 0x8a, 0x33, 0x6a, 0xc8, 0xff,
+	0xa7, 0x1a, 0xfe, 0x54, 0xd0, 0x96, 0xe4, 0x22, 0x37, 0x5e, 0x02, 0xfe, 0x0a, 0x55, 0xcf, 0xc9,
+	0xd9, 0x29, 0x35, 0x69, 0xb4, 0x93, 0xb5, 0x6c, 0x5f, 0x3d, 0xb0, 0xe8, 0x78, 0x0e, 0x9f, 0x45,
+	0x50, 0xde, 0x0c, 0x2b, 0xa2, 0xd8, 0x0c, 0xab, 0x60, 0x67, 0x59, 0x40, 0xdb, 0x09, 0xe7, 0xba,
+	0x4e, 0xcf, 0x87, 0x96, 0x62, 0x84, 0xd8, 0x3c, 0xc3, 0xb2, 0x9e, 0x8f, 0x63, 0x62, 0xcf, 0xc7,
+	0x31, 0x3c, 0x43, 0xb7, 0xc2, 0xe7, 0x53, 0x98, 0x80, 0x15, 0xfe, 0x2f, 0x85, 0xab, 0xd9, 0xdc,
+	0x7f, 0xb4, 0x86, 0xa1, 0xb2, 0x71, 0xcc, 0xeb, 0xdb, 0xd4, 0x5d, 0x70, 0xab, 0x10, 0xd3, 0x89,
+	0x56, 0x21, 0xc6, 0x71, 0x17, 0x95, 0xa3, 0x3f, 0x11, 0x66, 0x14, 0x15, 0xfe, 0x56, 0x13, 0x85,
+	0xc4, 0xb7, 0x9a, 0x28, 0x14, 0x5e, 0x78, 0x97, 0xbb, 0x52, 0x91, 0xad, 0x8c, 0x5d, 0x78, 0x37,
+	0xe9, 0x47, 0x11, 0x46, 0x76, 0xa2, 0xd2, 0xba, 0x4e, 0xd4, 0x1a, 0xa1, 0x46, 0x6a, 0x51, 0x78,
+	0x0f, 0x15, 0x2e, 0x60, 0x11, 0x6d, 0x7e, 0x23, 0xf0, 0xd5, 0xad, 0x0b, 0x58, 0x08, 0xfc, 0x70,
+	0x16, 0xbf, 0x87, 0x8a, 0x73, 0x73, 0x32, 0x8b, 0xf7, 0xfd, 0x76, 0xe0, 0xab, 0xdb, 0x2c, 0x20,
+	0x00, 0x39, 0xe2, 0xa3, 0xfc, 0x87, 0x4a, 0xe7, 0xf7, 0xbc, 0x70, 0xca, 0xd7, 0x33, 0xbb, 0x1b,
+	0x3b, 0x65, 0x5e, 0xd6, 0xff, 0x39, 0xe5, 0x1b, 0xdb, 0xcf, 0x07, 0x47, 0xa8, 0x26, 0xf7, 0x1a,
+	0xde, 0x44, 0xe5, 0x81, 0xf1, 0xd4, 0x38, 0xfa, 0xd2, 0xa8, 0xe7, 0x30, 0x42, 0xa5, 0x67, 0x83,
+	0xfe, 0xa0, 0xdf, 0xab, 0x2b, 0xe1, 0xf3, 0xe7, 0xfd, 0x83, 0xd3, 0x7e, 0xaf, 0x9e, 0x0f, 0x41,
+	0xc7, 0x7d, 0xa3, 0xf7, 0xc4, 0x78, 0x5c, 0x2f, 0x84, 0x83, 0x93, 0x81, 0x61, 0x84, 0x83, 0x0d,
+	0x7d, 0xfe, 0xdb, 0x65, 0x5b, 0x79, 0x71, 0xd9, 0x56, 0xfe, 0xba, 0x6c, 0x2b, 0x3f, 0x2e, 0xdb,
+	0xb9, 0x17, 0xcb, 0x76, 0xee, 0x8f, 0x65, 0x3b, 0xf7, 0xf5, 0x07, 0xa3, 0x31, 0xfd, 0x66, 0x76,
+	0xa6, 0x59, 0x64, 0xda, 0x35, 0xdd, 0xa9, 0x39, 0x34, 0x1d, 0x97, 0x9c, 0x83, 0x45, 0xa3, 0x51,
+	0x37, 0xfb, 0x7b, 0xe1, 0xe7, 0xfc, 0xde, 0x01, 0x9b, 0x3f, 0xe6, 0x68, 0xed, 0x09, 0xd1, 0x0e,
+	0x39, 0xea, 0x38, 0x44, 0xb1, 0x0f, 0x01, 0xef, 0xac, 0xc4, 0x5e, 0xf8, 0xdf, 0xff, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x1f, 0xe4, 0x57, 0x5a, 0x76, 0x0c, 0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -784,6 +971,48 @@ func (m *Event_CancelOnQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Event_NodeDrainUpsert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_NodeDrainUpsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NodeDrainUpsert != nil {
+		{
+			size, err := m.NodeDrainUpsert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_NodeDrainDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_NodeDrainDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NodeDrainDelete != nil {
+		{
+			size, err := m.NodeDrainDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ExecutorSettingsUpsert) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1024,20 +1253,20 @@ func (m *CancelOnQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.JobStates) > 0 {
-		dAtA9 := make([]byte, len(m.JobStates)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.JobStates)*10)
+		var j10 int
 		for _, num := range m.JobStates {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintEvents(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintEvents(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1060,6 +1289,142 @@ func (m *CancelOnQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeDrainUpsert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDrainUpsert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDrainUpsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SetByUser) > 0 {
+		i -= len(m.SetByUser)
+		copy(dAtA[i:], m.SetByUser)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SetByUser)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeDrainDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDrainDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeDrainDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1159,6 +1524,30 @@ func (m *Event_CancelOnQueue) Size() (n int) {
 	}
 	return n
 }
+func (m *Event_NodeDrainUpsert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeDrainUpsert != nil {
+		l = m.NodeDrainUpsert.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Event_NodeDrainDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeDrainDelete != nil {
+		l = m.NodeDrainDelete.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ExecutorSettingsUpsert) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1291,22 +1680,83 @@ func (m *CancelOnQueue) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
+func (m *NodeDrainUpsert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if m.Preempt {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SetByUser)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeDrainDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1572,6 +2022,76 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_CancelOnQueue{v}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeDrainUpsert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NodeDrainUpsert{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_NodeDrainUpsert{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeDrainDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NodeDrainDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_NodeDrainDelete{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2430,6 +2950,572 @@ func (m *CancelOnQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NodeDrainUpsert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDrainUpsert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDrainUpsert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempt = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetByUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetByUser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeDrainDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDrainDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDrainDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
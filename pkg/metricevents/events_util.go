@@ -0,0 +1,9 @@
+package metricevents
+
+func (ev *Event) GetEventName() string {
+	switch ev.GetEvent().(type) {
+	case *Event_CycleMetrics:
+		return "CycleMetrics"
+	}
+	return "Unknown"
+}